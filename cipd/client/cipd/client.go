@@ -289,6 +289,15 @@ func (am ActionMap) Log(ctx context.Context) {
 				logging.Infof(ctx, "    %s", pin)
 			}
 		}
+		if len(actions.ToRepair) != 0 {
+			logging.Infof(ctx, "  to repair:")
+			for _, broken := range actions.ToRepair {
+				logging.Infof(ctx, "    %s", broken.Pin)
+				for _, p := range broken.Problems {
+					logging.Infof(ctx, "      %s (%s)", p.Name, p.Kind)
+				}
+			}
+		}
 	}
 }
 
@@ -300,12 +309,13 @@ type Actions struct {
 	ToInstall common.PinSlice `json:"to_install,omitempty"` // pins to be installed
 	ToUpdate  []UpdatedPin    `json:"to_update,omitempty"`  // pins to be replaced
 	ToRemove  common.PinSlice `json:"to_remove,omitempty"`  // pins to be removed
+	ToRepair  []BrokenPin     `json:"to_repair,omitempty"`  // pins to be redeployed
 	Errors    []ActionError   `json:"errors,omitempty"`     // all individual errors
 }
 
 // Empty is true if there are no actions specified.
 func (a *Actions) Empty() bool {
-	return len(a.ToInstall) == 0 && len(a.ToUpdate) == 0 && len(a.ToRemove) == 0
+	return len(a.ToInstall) == 0 && len(a.ToUpdate) == 0 && len(a.ToRemove) == 0 && len(a.ToRepair) == 0
 }
 
 // UpdatedPin specifies a pair of pins: old and new version of a package.
@@ -314,6 +324,42 @@ type UpdatedPin struct {
 	To   common.Pin `json:"to"`
 }
 
+// BrokenPin specifies a deployed pin that failed file verification.
+type BrokenPin struct {
+	Pin      common.Pin          `json:"pin"`
+	Problems []local.FileProblem `json:"problems,omitempty"` // empty if unknown
+}
+
+// VerifyMode specifies how EnsurePackages verifies already deployed packages.
+type VerifyMode string
+
+const (
+	// VerifyNone trusts deployed packages to be intact.
+	VerifyNone VerifyMode = ""
+
+	// VerifyFiles checks files of deployed packages and reports damaged packages
+	// as errors.
+	VerifyFiles VerifyMode = "verify"
+
+	// VerifyAndRepair checks files of deployed packages and redeploys damaged
+	// packages.
+	VerifyAndRepair VerifyMode = "repair"
+)
+
+// EnsureOptions is passed to EnsurePackagesWithOptions.
+type EnsureOptions struct {
+	// Verify specifies how to verify packages that are already deployed.
+	//
+	// If not VerifyNone, their files are checked against digests recorded at
+	// deployment time. Damaged packages are either reported as errors
+	// (VerifyFiles) or redeployed (VerifyAndRepair).
+	Verify VerifyMode
+
+	// DryRun, if true, makes EnsurePackagesWithOptions just check for changes
+	// and return them, without actually performing them.
+	DryRun bool
+}
+
 // ActionError holds an error that happened when installing or removing the pin.
 type ActionError struct {
 	Action string     `json:"action"`
@@ -456,6 +502,10 @@ type Client interface {
 	// If the update was only partially applied, returns both Actions and error.
 	EnsurePackages(ctx context.Context, pkgs common.PinSliceBySubdir, dryRun bool) (ActionMap, error)
 
+	// EnsurePackagesWithOptions is like EnsurePackages, but also accepts options
+	// that control how already deployed packages are verified.
+	EnsurePackagesWithOptions(ctx context.Context, pkgs common.PinSliceBySubdir, opts EnsureOptions) (ActionMap, error)
+
 	// IncrementCounter adds delta to the counter's value and updates its last
 	// updated timestamp.
 	//
@@ -483,6 +533,10 @@ type ClientOptions struct {
 
 	// CacheDir is a directory for shared cache.
 	//
+	// It can be shared by multiple site roots (and concurrently running clients)
+	// on the machine. Instances are extracted there once and hardlinked into
+	// site roots, if the file system permits.
+	//
 	// If empty, instances are not cached and tags are cached inside the site
 	// root. If both Root and CacheDir are empty, tag cache is disabled.
 	CacheDir string
@@ -555,6 +609,11 @@ func NewClient(opts ClientOptions) (Client, error) {
 	}
 	opts.ServiceURL = fmt.Sprintf("%s://%s", parsed.Scheme, parsed.Host)
 
+	deployer := local.NewDeployer(opts.Root)
+	if opts.CacheDir != "" {
+		deployer = local.NewDeployerWithLinkCache(opts.Root, filepath.Join(opts.CacheDir, "extracted"))
+	}
+
 	return &clientImpl{
 		ClientOptions: opts,
 		remote: &remoteImpl{
@@ -567,7 +626,7 @@ func NewClient(opts ClientOptions) (Client, error) {
 			userAgent: opts.UserAgent,
 			client:    opts.AnonymousClient,
 		},
-		deployer: local.NewDeployer(opts.Root),
+		deployer: deployer,
 	}, nil
 }
 
@@ -657,6 +716,7 @@ func (client *clientImpl) getInstanceCache(ctx context.Context) *internal.Instan
 		}
 		path := filepath.Join(client.CacheDir, "instances")
 		client.instanceCache = internal.NewInstanceCache(local.NewFileSystem(path, ""))
+		client.instanceCache.ExtractedFS = local.NewFileSystem(filepath.Join(client.CacheDir, "extracted"), "")
 		logging.Infof(ctx, "cipd: using instance cache at %q", path)
 	})
 	return client.instanceCache
@@ -1199,8 +1259,9 @@ func (client *clientImpl) fetchInstanceWithCache(ctx context.Context, pin common
 
 		// Download the package into the cache. 'remoteFetchInstance' verifies the
 		// hash. When reading from the cache, we can skip the hash check (and we
-		// indeed do, see 'cipd: instance cache hit' case above).
-		err := cache.Put(ctx, pin, now, func(f *os.File) error {
+		// indeed do, see 'cipd: instance cache hit' case above). If some other
+		// process sharing the cache is fetching it already, Fetch waits for it.
+		err := cache.Fetch(ctx, pin, now, func(f *os.File) error {
 			return client.remoteFetchInstance(ctx, pin, f)
 		})
 		if err != nil {
@@ -1303,7 +1364,11 @@ func (client *clientImpl) FetchAndDeployInstance(ctx context.Context, subdir str
 	return err
 }
 
-func (client *clientImpl) EnsurePackages(ctx context.Context, allPins common.PinSliceBySubdir, dryRun bool) (aMap ActionMap, err error) {
+func (client *clientImpl) EnsurePackages(ctx context.Context, allPins common.PinSliceBySubdir, dryRun bool) (ActionMap, error) {
+	return client.EnsurePackagesWithOptions(ctx, allPins, EnsureOptions{DryRun: dryRun})
+}
+
+func (client *clientImpl) EnsurePackagesWithOptions(ctx context.Context, allPins common.PinSliceBySubdir, opts EnsureOptions) (aMap ActionMap, err error) {
 	if err = allPins.Validate(); err != nil {
 		return
 	}
@@ -1319,6 +1384,9 @@ func (client *clientImpl) EnsurePackages(ctx context.Context, allPins common.Pin
 
 	// Figure out what needs to be updated and deleted, log it.
 	aMap = buildActionPlan(allPins, existing)
+	if opts.Verify != VerifyNone {
+		aMap = client.verifyDeployed(ctx, allPins, existing, aMap)
+	}
 	if len(aMap) == 0 {
 		logging.Debugf(ctx, "Everything is up-to-date.")
 		return
@@ -1326,13 +1394,28 @@ func (client *clientImpl) EnsurePackages(ctx context.Context, allPins common.Pin
 	// TODO(iannucci): ensure that no packages cross root boundaries
 	aMap.Log(ctx)
 
-	if dryRun {
+	if opts.DryRun {
 		logging.Infof(ctx, "Dry run, not actually doing anything.")
 		return
 	}
 
 	hasErrors := false
 
+	// Report damaged packages if not asked to repair them.
+	if opts.Verify != VerifyAndRepair {
+		aMap.LoopOrdered(func(subdir string, actions *Actions) {
+			for _, broken := range actions.ToRepair {
+				logging.Errorf(ctx, "Package %s is damaged (subdir %q)", broken.Pin, subdir)
+				hasErrors = true
+				actions.Errors = append(actions.Errors, ActionError{
+					Action: "verify",
+					Pin:    broken.Pin,
+					Error:  JSONError{fmt.Errorf("%d damaged file(s)", len(broken.Problems))},
+				})
+			}
+		})
+	}
+
 	// Remove all unneeded stuff.
 	aMap.LoopOrdered(func(subdir string, actions *Actions) {
 		for _, pin := range actions.ToRemove {
@@ -1359,16 +1442,28 @@ func (client *clientImpl) EnsurePackages(ctx context.Context, allPins common.Pin
 		for _, pair := range actions.ToUpdate {
 			toDeploy[pair.To.PackageName] = true
 		}
+		toRepair := make(map[string]bool, len(actions.ToRepair))
+		if opts.Verify == VerifyAndRepair {
+			for _, broken := range actions.ToRepair {
+				toRepair[broken.Pin.PackageName] = true
+			}
+		}
 		for _, pin := range allPins[subdir] {
-			if !toDeploy[pin.PackageName] {
+			var action string
+			switch {
+			case toDeploy[pin.PackageName]:
+				action = "install"
+			case toRepair[pin.PackageName]:
+				action = "repair"
+			default:
 				continue
 			}
 			err = client.FetchAndDeployInstance(ctx, subdir, pin)
 			if err != nil {
-				logging.Errorf(ctx, "Failed to install %s - %s", pin, err)
+				logging.Errorf(ctx, "Failed to %s %s - %s", action, pin, err)
 				hasErrors = true
 				actions.Errors = append(actions.Errors, ActionError{
-					Action: "install",
+					Action: action,
 					Pin:    pin,
 					Error:  JSONError{err},
 				})
@@ -1387,6 +1482,39 @@ func (client *clientImpl) EnsurePackages(ctx context.Context, allPins common.Pin
 	return
 }
 
+// verifyDeployed checks files of desired packages that are already deployed,
+// adding damaged ones to ToRepair in aMap. Returns the updated aMap (that may
+// be allocated here if aMap was nil).
+func (client *clientImpl) verifyDeployed(ctx context.Context, desired, existing common.PinSliceBySubdir, aMap ActionMap) ActionMap {
+	for subdir, pins := range desired {
+		haveMap := existing[subdir].ToMap()
+		for _, pin := range pins {
+			if haveMap[pin.PackageName] != pin.InstanceID {
+				continue // will be installed or updated anyway
+			}
+			problems, err := client.deployer.CheckIntegrity(ctx, subdir, pin.PackageName)
+			if err != nil {
+				logging.Warningf(ctx, "Failed to verify %s - %s (subdir %q)", pin, err, subdir)
+			} else if len(problems) == 0 {
+				continue
+			}
+			if aMap == nil {
+				aMap = ActionMap{}
+			}
+			actions := aMap[subdir]
+			if actions == nil {
+				actions = &Actions{}
+				aMap[subdir] = actions
+			}
+			actions.ToRepair = append(actions.ToRepair, BrokenPin{
+				Pin:      pin,
+				Problems: problems,
+			})
+		}
+	}
+	return aMap
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Private structs and interfaces.
 
@@ -727,6 +727,7 @@ func TestEnsurePackages(t *testing.T) {
 			// to serve only 'fetched' packages. callEnsure will ensure the state
 			// reflected by the 'state' variable.
 			state := map[string][]local.PackageInstance{}
+			verify := VerifyNone
 			callEnsure := func(fetched ...local.PackageInstance) (ActionMap, error) {
 				client := mockClientForFetch(c, tempDir, fetched)
 				pins := PinSliceBySubdir{}
@@ -735,7 +736,10 @@ func TestEnsurePackages(t *testing.T) {
 						pins[subdir] = append(pins[subdir], i.Pin())
 					}
 				}
-				return client.EnsurePackages(ctx, pins, false)
+				if verify == VerifyNone {
+					return client.EnsurePackages(ctx, pins, false)
+				}
+				return client.EnsurePackagesWithOptions(ctx, pins, EnsureOptions{Verify: verify})
 			}
 
 			shouldBeDeployed := func(expect interface{}, _ ...interface{}) string {
@@ -879,6 +883,37 @@ func TestEnsurePackages(t *testing.T) {
 			So(PinSliceBySubdir{
 				"": PinSlice{a1.Pin(), b.Pin()},
 			}, shouldBeDeployed)
+
+			// Verification of intact packages is a noop.
+			verify = VerifyFiles
+			actions, err = callEnsure()
+			So(err, ShouldBeNil)
+			So(actions, ShouldResemble, ActionMap(nil))
+
+			// Corrupt a1. Verification reports it, but doesn't fix it.
+			err = ioutil.WriteFile(filepath.Join(tempDir, "file a 1"), []byte("corrupted"), 0666)
+			So(err, ShouldBeNil)
+			actions, err = callEnsure()
+			So(err, ShouldNotBeNil)
+			So(actions[""].ToRepair, ShouldResemble, []BrokenPin{
+				{
+					Pin: a1.Pin(),
+					Problems: []local.FileProblem{
+						{Name: "file a 1", Kind: local.FileCorrupted},
+					},
+				},
+			})
+			So(actions[""].Errors, ShouldHaveLength, 1)
+			So("file a 1", shouldHaveContent, "corrupted")
+
+			// Repair mode redeploys only the damaged package.
+			verify = VerifyAndRepair
+			actions, err = callEnsure(a1)
+			So(err, ShouldBeNil)
+			So(actions[""].ToRepair, ShouldHaveLength, 1)
+			So(actions[""].Errors, ShouldBeNil)
+			So("file a 1", shouldHaveContent, "test data")
+			So("file b", shouldHaveContent, "test data")
 		})
 	})
 }
@@ -98,6 +98,27 @@ var badEnsureFiles = []struct {
 		"$ServiceURL may only be set once per file",
 	},
 
+	{
+		"empty verified platform",
+		"$VerifiedPlatform",
+		"expecting '$VerifiedPlatform <os>-<arch>",
+	},
+
+	{
+		"bad verified platform",
+		"$VerifiedPlatform linux",
+		`bad platform "linux"`,
+	},
+
+	{
+		"duplicate verified platform",
+		f(
+			"$VerifiedPlatform linux-amd64 mac-amd64",
+			"$VerifiedPlatform linux-amd64",
+		),
+		"$VerifiedPlatform linux-amd64 is specified more than once",
+	},
+
 	{
 		"bad setting",
 		"$nurbs thingy",
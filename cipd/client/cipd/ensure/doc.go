@@ -20,10 +20,16 @@
 //
 // Settings
 //
-// A setting looks like `$name value`. Settings are global and can only be set
-// once per file. The following settings are allowed:
+// A setting looks like `$name value`. Settings are global and, unless noted
+// otherwise, can only be set once per file. The following settings are
+// allowed:
 //   - ServiceURL is the url for the cipd service. It can be used in lieu of
 //     the -service-url command line parameter.
+//   - VerifiedPlatform is a whitespace-separated list of platforms (as
+//     ${platform} expands to, e.g. `linux-amd64`) the file is expected to
+//     work on. It can be specified multiple times, the lists are merged. The
+//     cipd client 'ensure-file-verify' command checks that all packages in
+//     the file resolve on each of them.
 //
 // Directives
 //
@@ -69,12 +75,22 @@
 //
 // That's all there is to it.
 //
+// Resolved Versions
+//
+// Refs and tags (e.g. `latest`) are resolved to instance IDs at install time,
+// so the same file may install different instances at different times. To
+// avoid this, File.ResolveVersions can resolve all versions referenced by the
+// file (for each of a set of platforms) once, and store them in a companion
+// ResolvedVersions file. Its Resolver can then be used in place of the backend
+// resolver to install exactly the pinned instances.
+//
 // Example
 //
 // Here is an example ensure file which demonstrates all the various features.
 //
 //   # This is an ensure file!
 //   $ServiceURL https://chrome-infra-packages.appspot.com/
+//   $VerifiedPlatform linux-amd64 mac-amd64 windows-amd64
 //
 //   # This is the cipd client itself
 //   infra/tools/cipd/${os}-${arch}  latest
@@ -24,6 +24,10 @@ type File struct {
 	ServiceURL string
 
 	PackagesBySubdir map[string]PackageSlice
+
+	// VerifiedPlatforms is a list of platforms the file is expected to work on,
+	// as declared by $VerifiedPlatform settings. See Verify.
+	VerifiedPlatforms []Platform
 }
 
 // ParseFile parses an ensure file from the given reader. See the package docs
@@ -181,6 +185,30 @@ func (f *File) ResolveWith(rslv VersionResolver, templateArgs map[string]string)
 	return ret, nil
 }
 
+// Verify checks that the File can be resolved on each of its
+// VerifiedPlatforms: that all package templates expand to valid package names
+// and that all versions are resolved by the provided VersionResolver.
+//
+// Each {package, version} pair is resolved only once, even if it is used by
+// multiple platforms. Returns an errors.MultiError with an error per each
+// platform that failed to resolve, or nil if all of them are fine.
+func (f *File) Verify(rslv VersionResolver) error {
+	if len(f.VerifiedPlatforms) == 0 {
+		return errors.Reason("no platforms to verify, add $VerifiedPlatform to the ensure file").Err()
+	}
+	rslv = cachingResolver(rslv)
+	var merr errors.MultiError
+	for _, plat := range f.VerifiedPlatforms {
+		if _, err := f.ResolveWith(rslv, plat.TemplateArgs()); err != nil {
+			merr = append(merr, errors.Annotate(err, "resolving for platform %s", plat).Err())
+		}
+	}
+	if len(merr) > 0 {
+		return merr
+	}
+	return nil
+}
+
 // Serialize writes the File to an io.Writer in canonical order.
 func (f *File) Serialize(w io.Writer) (int, error) {
 	return iotools.WriteTracker(w, func(w io.Writer) error {
@@ -195,6 +223,23 @@ func (f *File) Serialize(w io.Writer) (int, error) {
 		if f.ServiceURL != "" {
 			maybeAddNL()
 			fmt.Fprintf(w, "$ServiceURL %s", f.ServiceURL)
+			needsNLs = 1
+		}
+
+		if len(f.VerifiedPlatforms) > 0 {
+			plats := make(sort.StringSlice, len(f.VerifiedPlatforms))
+			for i, p := range f.VerifiedPlatforms {
+				plats[i] = p.String()
+			}
+			plats.Sort()
+			for _, p := range plats {
+				maybeAddNL()
+				fmt.Fprintf(w, "$VerifiedPlatform %s", p)
+				needsNLs = 1
+			}
+		}
+
+		if needsNLs > 0 {
 			needsNLs = 2
 		}
 
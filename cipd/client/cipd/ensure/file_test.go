@@ -6,8 +6,13 @@ package ensure
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
+	"github.com/luci/luci-go/cipd/client/cipd/common"
+	"github.com/luci/luci-go/common/errors"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -24,7 +29,7 @@ var fileSerializationTests = []struct {
 
 	{
 		"ServiceURL",
-		&File{"https://something.example.com", nil},
+		&File{"https://something.example.com", nil, nil},
 		f(
 			"$ServiceURL https://something.example.com",
 		),
@@ -37,7 +42,7 @@ var fileSerializationTests = []struct {
 				PackageDef{"some/thing", "version", 0},
 				PackageDef{"some/other_thing", "latest", 0},
 			},
-		}},
+		}, nil},
 		f(
 			"some/other_thing@latest",
 			"some/thing@version",
@@ -54,9 +59,11 @@ var fileSerializationTests = []struct {
 			"path/to dir/with/spaces": {
 				PackageDef{"different/package", "some_tag:thingy", 0},
 			},
-		}},
+		}, []Platform{{"mac", "amd64"}, {"linux", "amd64"}}},
 		f(
 			"$ServiceURL https://some.example.com",
+			"$VerifiedPlatform linux-amd64",
+			"$VerifiedPlatform mac-amd64",
 			"",
 			"some/other_thing@latest",
 			"some/thing@version",
@@ -67,6 +74,56 @@ var fileSerializationTests = []struct {
 	},
 }
 
+func TestFileVerify(t *testing.T) {
+	t.Parallel()
+
+	Convey("File.Verify", t, func() {
+		ef, err := ParseFile(strings.NewReader(f(
+			"$VerifiedPlatform linux-amd64 mac-amd64",
+			"$VerifiedPlatform windows-386",
+			"",
+			"tool/${platform} latest",
+			"lib/${os=linux,mac} version:1",
+		)))
+		So(err, ShouldBeNil)
+		So(ef.VerifiedPlatforms, ShouldResemble, []Platform{
+			{"linux", "amd64"}, {"mac", "amd64"}, {"windows", "386"},
+		})
+
+		calls := 0
+		resolver := func(broken ...string) VersionResolver {
+			return func(pkg, vers string) (common.Pin, error) {
+				calls++
+				for _, b := range broken {
+					if pkg == b {
+						return common.Pin{}, errors.New("no such package")
+					}
+				}
+				return common.Pin{PackageName: pkg, InstanceID: vers}, nil
+			}
+		}
+
+		Convey("all platforms resolve", func() {
+			So(ef.Verify(resolver()), ShouldBeNil)
+			So(calls, ShouldEqual, 5)
+		})
+
+		Convey("reports each broken platform", func() {
+			err := ef.Verify(resolver("tool/mac-amd64", "lib/linux"))
+			So(err, ShouldHaveSameTypeAs, errors.MultiError{})
+			merr := err.(errors.MultiError)
+			So(merr, ShouldHaveLength, 2)
+			So(merr[0], ShouldErrLike, "resolving for platform linux-amd64")
+			So(merr[1], ShouldErrLike, "resolving for platform mac-amd64")
+		})
+
+		Convey("requires platforms", func() {
+			ef.VerifiedPlatforms = nil
+			So(ef.Verify(resolver()), ShouldErrLike, "no platforms to verify")
+		})
+	})
+}
+
 func TestFileSerialization(t *testing.T) {
 	t.Parallel()
 
@@ -7,6 +7,7 @@ package ensure
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/luci/luci-go/cipd/client/cipd/common"
 )
@@ -40,10 +41,32 @@ func serviceURLParser(_ *itemParserState, f *File, val string) error {
 	return nil
 }
 
+func verifiedPlatformParser(_ *itemParserState, f *File, val string) error {
+	platforms := strings.Fields(val)
+	if len(platforms) == 0 {
+		return fmt.Errorf("expecting '$VerifiedPlatform <os>-<arch> [<os>-<arch> ...]'")
+	}
+	for _, v := range platforms {
+		plat, err := ParsePlatform(v)
+		if err != nil {
+			return err
+		}
+		for _, existing := range f.VerifiedPlatforms {
+			if existing == plat {
+				return fmt.Errorf("$VerifiedPlatform %s is specified more than once", plat)
+			}
+		}
+		f.VerifiedPlatforms = append(f.VerifiedPlatforms, plat)
+	}
+	return nil
+}
+
 // itemParsers is the main way that the ensure file format is extended. If you
 // need to add a new setting or directive, please add an appropriate function
 // above and then add it to this map.
 var itemParsers = map[string]itemParser{
 	"@subdir":     subdirParser,
 	"$serviceurl": serviceURLParser,
+
+	"$verifiedplatform": verifiedPlatformParser,
 }
@@ -35,6 +35,25 @@ func (p *PackageDef) String() string {
 // can provide a pass-through resolver, if you like).
 type VersionResolver func(pkg, vers string) (common.Pin, error)
 
+// cachingResolver wraps rslv, so that each {pkg, vers} pair is resolved by it
+// only once. Both results and errors are cached.
+func cachingResolver(rslv VersionResolver) VersionResolver {
+	type result struct {
+		pin common.Pin
+		err error
+	}
+	cache := map[string]result{}
+	return func(pkg, vers string) (common.Pin, error) {
+		key := pkg + "@" + vers
+		res, ok := cache[key]
+		if !ok {
+			res.pin, res.err = rslv(pkg, vers)
+			cache[key] = res
+		}
+		return res.pin, res.err
+	}
+}
+
 // Resolve takes a Package definition containing a possibly templated package
 // name, and a possibly unresolved version string and attempts to resolve them
 // into a Pin.
@@ -0,0 +1,54 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ensure
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Platform is a cipd-style os and architecture pair, e.g. what the ${os} and
+// ${arch} template parameters expand to on some machine.
+type Platform struct {
+	OS   string
+	Arch string
+}
+
+// KnownPlatforms is a list of platforms packages are commonly built for.
+//
+// It is used as a default set of platforms to resolve package templates for
+// when the set isn't specified explicitly.
+var KnownPlatforms = []Platform{
+	{"linux", "386"},
+	{"linux", "amd64"},
+	{"linux", "armv6l"},
+	{"mac", "amd64"},
+	{"windows", "386"},
+	{"windows", "amd64"},
+}
+
+// ParsePlatform parses a platform in the form "<os>-<arch>", e.g.
+// "linux-amd64", as ${platform} expands to.
+func ParsePlatform(v string) (Platform, error) {
+	chunks := strings.SplitN(v, "-", 2)
+	if len(chunks) != 2 || chunks[0] == "" || chunks[1] == "" {
+		return Platform{}, fmt.Errorf("bad platform %q, expecting <os>-<arch>", v)
+	}
+	return Platform{chunks[0], chunks[1]}, nil
+}
+
+func (p Platform) String() string {
+	return fmt.Sprintf("%s-%s", p.OS, p.Arch)
+}
+
+// TemplateArgs returns the template args to expand package templates for this
+// platform, like common.TemplateArgs() does for the current one.
+func (p Platform) TemplateArgs() map[string]string {
+	return map[string]string{
+		"os":       p.OS,
+		"arch":     p.Arch,
+		"platform": p.String(),
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ensure
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/luci/luci-go/cipd/client/cipd/common"
+	"github.com/luci/luci-go/common/errors"
+)
+
+// ResolvedVersions is a companion "lock" file for an ensure file.
+//
+// It pins each {package, version} pair the ensure file references (for each
+// platform the file was resolved for) to a concrete instance ID, so that refs
+// and tags moving on the backend don't change what gets installed.
+//
+// It is produced by File.ResolveVersions and consumed via Resolver.
+type ResolvedVersions struct {
+	Versions ResolvedVersionSlice `json:"versions"`
+}
+
+// ResolvedVersion is a single pinned {package, version} pair.
+type ResolvedVersion struct {
+	Package    string `json:"package"`
+	Version    string `json:"version"`
+	InstanceID string `json:"instance_id"`
+}
+
+// ResolvedVersionSlice is a sortable slice of ResolvedVersion.
+type ResolvedVersionSlice []ResolvedVersion
+
+func (s ResolvedVersionSlice) Len() int      { return len(s) }
+func (s ResolvedVersionSlice) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s ResolvedVersionSlice) Less(i, j int) bool {
+	if s[i].Package != s[j].Package {
+		return s[i].Package < s[j].Package
+	}
+	return s[i].Version < s[j].Version
+}
+
+// ParseResolvedVersions reads and validates a resolved versions file produced
+// by ResolvedVersions.Serialize.
+func ParseResolvedVersions(r io.Reader) (*ResolvedVersions, error) {
+	ret := &ResolvedVersions{}
+	if err := json.NewDecoder(r).Decode(ret); err != nil {
+		return nil, errors.Annotate(err, "failed to parse resolved versions file").Err()
+	}
+	seen := map[ResolvedVersion]bool{}
+	for _, v := range ret.Versions {
+		if err := common.ValidatePin(common.Pin{PackageName: v.Package, InstanceID: v.InstanceID}); err != nil {
+			return nil, errors.Annotate(err, "bad resolved versions file").Err()
+		}
+		if err := common.ValidateInstanceVersion(v.Version); err != nil {
+			return nil, errors.Annotate(err, "bad resolved versions file").Err()
+		}
+		key := ResolvedVersion{Package: v.Package, Version: v.Version}
+		if seen[key] {
+			return nil, fmt.Errorf("bad resolved versions file: %s@%s is pinned more than once", v.Package, v.Version)
+		}
+		seen[key] = true
+	}
+	return ret, nil
+}
+
+// Serialize writes the ResolvedVersions to an io.Writer in canonical order.
+func (rv *ResolvedVersions) Serialize(w io.Writer) (int, error) {
+	sorted := &ResolvedVersions{Versions: make(ResolvedVersionSlice, len(rv.Versions))}
+	copy(sorted.Versions, rv.Versions)
+	sort.Sort(sorted.Versions)
+	blob, err := json.MarshalIndent(sorted, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	return w.Write(append(blob, '\n'))
+}
+
+// Resolver returns a VersionResolver that resolves versions using only the
+// pins recorded in this ResolvedVersions.
+//
+// Resolving a {package, version} pair that isn't pinned is an error, since it
+// means the resolved versions file is stale (i.e. the ensure file has changed
+// since it was generated).
+func (rv *ResolvedVersions) Resolver() VersionResolver {
+	pins := make(map[ResolvedVersion]string, len(rv.Versions))
+	for _, v := range rv.Versions {
+		pins[ResolvedVersion{Package: v.Package, Version: v.Version}] = v.InstanceID
+	}
+	return func(pkg, vers string) (common.Pin, error) {
+		iid, ok := pins[ResolvedVersion{Package: pkg, Version: vers}]
+		if !ok {
+			return common.Pin{}, errors.Reason(
+				"%s@%s is not in the resolved versions file, it is stale and must be regenerated", pkg, vers).Err()
+		}
+		return common.Pin{PackageName: pkg, InstanceID: iid}, nil
+	}
+}
+
+// ResolveVersions expands all package templates of the File for each of the
+// given platforms and resolves all versions with the provided VersionResolver,
+// returning the pins as ResolvedVersions.
+//
+// Each {package, version} pair is resolved only once, even if it is used by
+// multiple platforms or subdirs.
+func (f *File) ResolveVersions(rslv VersionResolver, platforms []Platform) (*ResolvedVersions, error) {
+	ret := &ResolvedVersions{}
+	rslv = cachingResolver(rslv)
+	seen := map[ResolvedVersion]bool{}
+	recordingRslv := func(pkg, vers string) (common.Pin, error) {
+		pin, err := rslv(pkg, vers)
+		if err != nil {
+			return pin, err
+		}
+		if key := (ResolvedVersion{Package: pkg, Version: vers}); !seen[key] {
+			seen[key] = true
+			ret.Versions = append(ret.Versions, ResolvedVersion{
+				Package:    pkg,
+				Version:    vers,
+				InstanceID: pin.InstanceID,
+			})
+		}
+		return pin, nil
+	}
+
+	for _, plat := range platforms {
+		if _, err := f.ResolveWith(recordingRslv, plat.TemplateArgs()); err != nil {
+			return nil, errors.Annotate(err, "resolving for platform %s", plat).Err()
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ensure
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/luci/luci-go/cipd/client/cipd/common"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestResolvedVersions(t *testing.T) {
+	t.Parallel()
+
+	iid := func(pkg, vers string) string {
+		h := common.DefaultHash()
+		h.Write([]byte(pkg + "@" + vers))
+		return common.InstanceIDFromHash(h)
+	}
+
+	calls := 0
+	backendResolver := func(pkg, vers string) (common.Pin, error) {
+		calls++
+		return common.Pin{PackageName: pkg, InstanceID: iid(pkg, vers)}, nil
+	}
+
+	Convey("ResolvedVersions", t, func() {
+		calls = 0
+
+		ef, err := ParseFile(strings.NewReader(f(
+			"tool/${platform} latest",
+			"lib/${os=linux} version:1",
+			"",
+			"@Subdir sub",
+			"tool/${platform} latest",
+		)))
+		So(err, ShouldBeNil)
+
+		linux := Platform{"linux", "amd64"}
+		mac := Platform{"mac", "amd64"}
+
+		Convey("ResolveVersions pins every platform once", func() {
+			rv, err := ef.ResolveVersions(backendResolver, []Platform{linux, mac})
+			So(err, ShouldBeNil)
+			So(calls, ShouldEqual, 3)
+
+			buf := &bytes.Buffer{}
+			_, err = rv.Serialize(buf)
+			So(err, ShouldBeNil)
+
+			parsed, err := ParseResolvedVersions(buf)
+			So(err, ShouldBeNil)
+			So(parsed.Versions, ShouldResemble, ResolvedVersionSlice{
+				{"lib/linux", "version:1", iid("lib/linux", "version:1")},
+				{"tool/linux-amd64", "latest", iid("tool/linux-amd64", "latest")},
+				{"tool/mac-amd64", "latest", iid("tool/mac-amd64", "latest")},
+			})
+
+			Convey("Resolver uses pinned versions", func() {
+				rf, err := ef.ResolveWith(parsed.Resolver(), mac.TemplateArgs())
+				So(err, ShouldBeNil)
+				So(rf.PackagesBySubdir, ShouldResemble, common.PinSliceBySubdir{
+					"":    {p("tool/mac-amd64", iid("tool/mac-amd64", "latest"))},
+					"sub": {p("tool/mac-amd64", iid("tool/mac-amd64", "latest"))},
+				})
+			})
+
+			Convey("Resolver fails if stale", func() {
+				_, err := ef.ResolveWith(parsed.Resolver(), Platform{"windows", "amd64"}.TemplateArgs())
+				So(err, ShouldErrLike, "tool/windows-amd64@latest is not in the resolved versions file")
+			})
+		})
+
+		Convey("ResolveVersions fails on bad versions", func() {
+			_, err := ef.ResolveVersions(func(pkg, vers string) (common.Pin, error) {
+				if strings.HasPrefix(pkg, "tool/mac") {
+					return common.Pin{}, errors.New("no such package")
+				}
+				return backendResolver(pkg, vers)
+			}, []Platform{linux, mac})
+			So(err, ShouldErrLike, "resolving for platform mac-amd64")
+		})
+
+		Convey("ParseResolvedVersions rejects bad files", func() {
+			_, err := ParseResolvedVersions(strings.NewReader(`{"versions": [
+				{"package": "a", "version": "latest", "instance_id": "not an id"}
+			]}`))
+			So(err, ShouldErrLike, "bad resolved versions file")
+
+			id := iid("a", "latest")
+			_, err = ParseResolvedVersions(strings.NewReader(`{"versions": [
+				{"package": "a", "version": "latest", "instance_id": "` + id + `"},
+				{"package": "a", "version": "latest", "instance_id": "` + id + `"}
+			]}`))
+			So(err, ShouldErrLike, "pinned more than once")
+		})
+	})
+}
@@ -11,9 +11,11 @@ import (
 	"io/ioutil"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
+	"github.com/danjacques/gofslock/fslock"
 	"golang.org/x/net/context"
 
 	"github.com/luci/luci-go/common/clock"
@@ -32,6 +34,12 @@ const (
 	// When this limit is reached, oldest touched packages are purged.
 	instanceCacheMaxSize = 100
 
+	// instanceCacheMaxBytes defines the total size of instance files and their
+	// extracted copies to keep in the cache.
+	//
+	// Works in parallel with 'instanceCacheMaxSize' limit.
+	instanceCacheMaxBytes = 20 * 1024 * 1024 * 1024
+
 	// instanceCacheMaxAge defines when to purge a cached package that is not
 	// being used.
 	//
@@ -44,17 +52,34 @@ const (
 
 	// instanceCacheStateFilename is a name of the file with InstanceCache proto.
 	instanceCacheStateFilename = "state.db"
+
+	// instanceCacheLockSuffix is appended to a name of a file to get a name of
+	// its lock file.
+	//
+	// Lock files are never removed: another process may be waiting on a lock
+	// file while it is deleted, and would then hold a lock no one else sees.
+	instanceCacheLockSuffix = ".lock"
 )
 
 // InstanceCache is a file-system-based, thread-safe, LRU cache of instances.
 //
+// The cache directory can be shared by multiple processes: the state file and
+// downloads of instances are guarded by file locks.
+//
 // Does not validate instance hashes; it is caller's responsibility.
 type InstanceCache struct {
 	fs        local.FileSystem
 	stateLock sync.Mutex // synchronizes access to the state file.
 
+	// ExtractedFS, if set, is rooted at the directory with extracted instances
+	// (see local.NewDeployerWithLinkCache). Extracted instances are purged
+	// together with instance files.
+	ExtractedFS local.FileSystem
+
 	// Defaults to instanceCacheMaxSize, mocked in tests.
 	maxSize int
+	// Defaults to instanceCacheMaxBytes, mocked in tests.
+	maxBytes int64
 	// Defaults to instanceCacheMaxAge, mocked in tests.
 	maxAge time.Duration
 }
@@ -64,9 +89,10 @@ type InstanceCache struct {
 // fs will be the root of the cache.
 func NewInstanceCache(fs local.FileSystem) *InstanceCache {
 	return &InstanceCache{
-		fs:      fs,
-		maxSize: instanceCacheMaxSize,
-		maxAge:  instanceCacheMaxAge,
+		fs:       fs,
+		maxSize:  instanceCacheMaxSize,
+		maxBytes: instanceCacheMaxBytes,
+		maxAge:   instanceCacheMaxAge,
 	}
 }
 
@@ -140,6 +166,21 @@ func (c *InstanceCache) Get(ctx context.Context, pin common.Pin, now time.Time)
 // write must write the instance contents. May remove some instances from the
 // cache that were not accessed for a long time.
 func (c *InstanceCache) Put(ctx context.Context, pin common.Pin, now time.Time, write func(*os.File) error) error {
+	return c.put(ctx, pin, now, write, true)
+}
+
+// Fetch makes sure the instance is in the cache, calling write to fetch it only
+// if it is missing.
+//
+// Concurrent calls for the same instance (including calls from other processes
+// sharing the cache directory) are serialized, so the instance is written only
+// once.
+func (c *InstanceCache) Fetch(ctx context.Context, pin common.Pin, now time.Time, write func(*os.File) error) error {
+	return c.put(ctx, pin, now, write, false)
+}
+
+// put implements Put and Fetch.
+func (c *InstanceCache) put(ctx context.Context, pin common.Pin, now time.Time, write func(*os.File) error, replace bool) error {
 	if err := common.ValidatePin(pin); err != nil {
 		return err
 	}
@@ -148,7 +189,19 @@ func (c *InstanceCache) Put(ctx context.Context, pin common.Pin, now time.Time,
 		return fmt.Errorf("invalid instance ID %q", pin.InstanceID)
 	}
 
-	if err := c.fs.EnsureFile(ctx, path, write); err != nil {
+	if _, err := c.fs.EnsureDirectory(ctx, c.fs.Root()); err != nil {
+		return err
+	}
+	err = local.WithFileLock(ctx, path+instanceCacheLockSuffix, func() error {
+		if !replace {
+			if _, err := os.Stat(path); err == nil {
+				logging.Infof(ctx, "cipd: %s was fetched into the cache by someone else", pin)
+				return nil
+			}
+		}
+		return c.fs.EnsureFile(ctx, path, write)
+	})
+	if err != nil {
 		return err
 	}
 
@@ -169,6 +222,7 @@ func (c *InstanceCache) GC(ctx context.Context, now time.Time) {
 type garbageCandidate struct {
 	instanceID     string
 	lastAccessTime time.Time
+	size           int64
 }
 
 type garbageHeap []*garbageCandidate
@@ -189,10 +243,15 @@ func (h *garbageHeap) Pop() interface{} {
 
 // gc cleans up the old instances.
 //
-// There are two cleanup polices acting at the same time:
+// There are three cleanup polices acting at the same time:
 //   1. Instances that haven't been touched for too long are removed.
 //   2. If the number of instances in the state is greater than maximum, oldest
 //      instances are removed.
+//   3. If the total size of instance files and their extracted copies is
+//      greater than maximum, oldest instances are removed.
+//
+// Instances that are being written or read by someone else right now (i.e.
+// their lock is held) are skipped.
 func (c *InstanceCache) gc(ctx context.Context, state *messages.InstanceCache, now time.Time) {
 	// Kick out entries older than some threshold first.
 	garbage := stringset.New(0)
@@ -204,24 +263,30 @@ func (c *InstanceCache) gc(ctx context.Context, state *messages.InstanceCache, n
 		}
 	}
 
-	// If still have too many entries, kick out oldest. Ignore entries already
-	// designated as garbage.
-	moreGarbage := len(state.Entries) - garbage.Len() - c.maxSize
-	if moreGarbage > 0 {
-		logging.Infof(ctx, "cipd: still need to purge %d cached instance(s)", moreGarbage)
-		garbageHeap := make(garbageHeap, 0, len(state.Entries)-garbage.Len())
-		for instanceID, e := range state.Entries {
-			if !garbage.Has(instanceID) {
-				garbageHeap = append(garbageHeap, &garbageCandidate{
-					instanceID:     instanceID,
-					lastAccessTime: google.TimeFromProto(e.LastAccess),
-				})
-			}
+	// If still have too many entries or they are too large, kick out oldest.
+	// Ignore entries already designated as garbage.
+	garbageHeap := make(garbageHeap, 0, len(state.Entries)-garbage.Len())
+	totalBytes := int64(0)
+	for instanceID, e := range state.Entries {
+		if !garbage.Has(instanceID) {
+			size := c.instanceSize(instanceID)
+			totalBytes += size
+			garbageHeap = append(garbageHeap, &garbageCandidate{
+				instanceID:     instanceID,
+				lastAccessTime: google.TimeFromProto(e.LastAccess),
+				size:           size,
+			})
 		}
+	}
+	if len(garbageHeap) > c.maxSize || totalBytes > c.maxBytes {
+		logging.Infof(
+			ctx, "cipd: still need to purge cached instances (%d instance(s), %d bytes)",
+			len(garbageHeap), totalBytes)
 		heap.Init(&garbageHeap)
-		for i := 0; i < moreGarbage; i++ {
+		for len(garbageHeap) > c.maxSize || totalBytes > c.maxBytes {
 			item := heap.Pop(&garbageHeap).(*garbageCandidate)
 			garbage.Add(item.instanceID)
+			totalBytes -= item.size
 			logging.Infof(ctx, "cipd: purging cached instance %s (age %s)", item.instanceID, now.Sub(item.lastAccessTime))
 		}
 	}
@@ -231,9 +296,19 @@ func (c *InstanceCache) gc(ctx context.Context, state *messages.InstanceCache, n
 		if err != nil {
 			panic("impossible")
 		}
-		// EnsureFileGone logs errors already.
-		if c.fs.EnsureFileGone(ctx, path) == nil {
+		// Don't wait for the instance lock: put takes it before the state lock, so
+		// waiting for it here (under the state lock) may deadlock.
+		err = fslock.With(path+instanceCacheLockSuffix, func() error {
+			// EnsureFileGone logs errors already.
+			if err := c.fs.EnsureFileGone(ctx, path); err != nil {
+				return err
+			}
 			delete(state.Entries, instanceID)
+			c.removeExtracted(ctx, instanceID)
+			return nil
+		})
+		if err == fslock.ErrLockHeld {
+			logging.Infof(ctx, "cipd: not purging cached instance %s, it is in use", instanceID)
 		}
 		return true
 	})
@@ -243,6 +318,76 @@ func (c *InstanceCache) gc(ctx context.Context, state *messages.InstanceCache, n
 	}
 }
 
+// instanceSize returns the size of the cached instance file plus the size of
+// its extracted copy. Missing files count as 0.
+func (c *InstanceCache) instanceSize(instanceID string) int64 {
+	path, err := c.fs.RootRelToAbs(instanceID)
+	if err != nil {
+		panic("impossible")
+	}
+	size := int64(0)
+	if fi, err := os.Stat(path); err == nil {
+		size = fi.Size()
+	}
+	if c.ExtractedFS != nil {
+		if extracted, err := c.ExtractedFS.RootRelToAbs(instanceID); err == nil {
+			size += dirSize(extracted)
+		}
+	}
+	return size
+}
+
+// dirSize returns the total size of regular files in the directory, or 0 if it
+// is missing.
+func dirSize(dir string) int64 {
+	size := int64(0)
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
+
+// removeExtracted removes the extracted copy of the instance, if any.
+func (c *InstanceCache) removeExtracted(ctx context.Context, instanceID string) {
+	if c.ExtractedFS == nil {
+		return
+	}
+	if err := local.RemoveLinkCachedInstance(ctx, c.ExtractedFS, instanceID); err != nil {
+		logging.Warningf(ctx, "cipd: failed to remove extracted instance %s - %s", instanceID, err)
+	}
+}
+
+// syncExtracted removes extracted instances that are not in the cache anymore.
+func (c *InstanceCache) syncExtracted(ctx context.Context, state *messages.InstanceCache) error {
+	if c.ExtractedFS == nil {
+		return nil
+	}
+	root, err := os.Open(c.ExtractedFS.Root())
+	switch {
+	case os.IsNotExist(err):
+		return nil
+	case err != nil:
+		return err
+	}
+	defer root.Close()
+	names, err := root.Readdirnames(0)
+	if err != nil {
+		return err
+	}
+	for _, id := range names {
+		if common.ValidateInstanceID(id) != nil {
+			continue
+		}
+		if _, ok := state.Entries[id]; !ok {
+			c.removeExtracted(ctx, id)
+		}
+	}
+	return nil
+}
+
 // readState loads cache state from the state file.
 // If the file does not exist, corrupted or its state was not synchronized
 // with the instance files for a long time, synchronizes it.
@@ -282,6 +427,9 @@ func (c *InstanceCache) readState(ctx context.Context, state *messages.InstanceC
 			logging.Warningf(ctx, "cipd: failed to sync instance cache - %s", err)
 		}
 		c.gc(ctx, state, now)
+		if err := c.syncExtracted(ctx, state); err != nil {
+			logging.Warningf(ctx, "cipd: failed to sync extracted instances - %s", err)
+		}
 	}
 }
 
@@ -367,10 +515,23 @@ func (c *InstanceCache) withState(ctx context.Context, now time.Time, f func(*me
 	c.stateLock.Lock()
 	defer c.stateLock.Unlock()
 
-	c.readState(ctx, state, now)
-	f(state)
-	if err := c.saveState(ctx, state); err != nil {
-		logging.Warningf(ctx, "cipd: could not save instance cache - %s", err)
+	// Other processes may be using the same cache directory, so the state file
+	// is also guarded by a file lock.
+	statePath, err := c.fs.RootRelToAbs(instanceCacheStateFilename)
+	if err != nil {
+		panic("impossible")
+	}
+	if _, err := c.fs.EnsureDirectory(ctx, c.fs.Root()); err != nil {
+		logging.Warningf(ctx, "cipd: could not create instance cache directory - %s", err)
+		return
+	}
+	err = local.WithFileLock(ctx, statePath+instanceCacheLockSuffix, func() error {
+		c.readState(ctx, state, now)
+		f(state)
+		return c.saveState(ctx, state)
+	})
+	if err != nil {
+		logging.Warningf(ctx, "cipd: could not update instance cache - %s", err)
 	}
 }
 
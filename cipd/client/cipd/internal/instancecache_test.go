@@ -13,6 +13,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/danjacques/gofslock/fslock"
 	"golang.org/x/net/context"
 
 	"github.com/luci/luci-go/cipd/client/cipd/common"
@@ -93,9 +94,18 @@ func TestInstanceCache(t *testing.T) {
 			tempDirFile, err := os.Open(tempDir)
 			So(err, ShouldBeNil)
 
-			files, err := tempDirFile.Readdirnames(0)
+			names, err := tempDirFile.Readdirnames(0)
 			So(err, ShouldBeNil)
-			So(files, ShouldHaveLength, testInstanceCacheMaxSize+1) // 1 for state.db
+			files, locks := []string{}, []string{}
+			for _, name := range names {
+				if strings.HasSuffix(name, instanceCacheLockSuffix) {
+					locks = append(locks, name)
+				} else {
+					files = append(files, name)
+				}
+			}
+			So(files, ShouldHaveLength, testInstanceCacheMaxSize+1)   // 1 for state.db
+			So(locks, ShouldHaveLength, testInstanceCacheMaxSize*2+1) // lock files are kept
 
 			// Try to get.
 			for i := 0; i < testInstanceCacheMaxSize*2; i++ {
@@ -107,6 +117,120 @@ func TestInstanceCache(t *testing.T) {
 			}
 		})
 
+		Convey("GC respects MaxBytes", func() {
+			cache.maxBytes = 10
+			for i := 0; i < 5; i++ {
+				put(cache, pini(i), "blah")
+				now = now.Add(time.Second)
+			}
+
+			// Only two 4 byte instances fit into 10 bytes.
+			alive := []int{}
+			for i := 0; i < 5; i++ {
+				r, _ := cache.Get(ctx, pini(i), now)
+				if r != nil {
+					r.Close(ctx, false)
+					alive = append(alive, i)
+				}
+			}
+			So(alive, ShouldResemble, []int{3, 4})
+		})
+
+		Convey("GC skips instances in use", func() {
+			cache.maxSize = 1
+			put(cache, pini(0), "blah")
+			now = now.Add(time.Second)
+
+			path := filepath.Join(tempDir, pini(0).InstanceID)
+			lock, err := fslock.Lock(path + instanceCacheLockSuffix)
+			So(err, ShouldBeNil)
+			put(cache, pini(1), "blah")
+			So(lock.Unlock(), ShouldBeNil)
+			testHas(cache, pini(0), "blah")
+
+			// Purged once it is not used anymore.
+			now = now.Add(time.Second)
+			put(cache, pini(2), "blah")
+			_, err = os.Stat(path)
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("Fetch doesn't replace existing", func() {
+			pin := pini(0)
+			calls := 0
+			fetch := func(data string) {
+				err := cache.Fetch(ctx, pin, now, func(f *os.File) error {
+					calls++
+					_, err := f.WriteString(data)
+					return err
+				})
+				So(err, ShouldBeNil)
+			}
+			fetch("blah")
+			fetch("huh")
+			So(calls, ShouldEqual, 1)
+			testHas(cache, pin, "blah")
+		})
+
+		Convey("Purges extracted instances", func() {
+			extractedDir := filepath.Join(tempDir, "extracted")
+			cache.ExtractedFS = local.NewFileSystem(extractedDir, "")
+			extract := func(pin common.Pin) string {
+				path := filepath.Join(extractedDir, pin.InstanceID)
+				So(os.MkdirAll(path, 0700), ShouldBeNil)
+				So(ioutil.WriteFile(filepath.Join(path, "file"), []byte("data"), 0600), ShouldBeNil)
+				return path
+			}
+			exists := func(path string) bool {
+				_, err := os.Stat(path)
+				return err == nil
+			}
+
+			Convey("with evicted instances", func() {
+				paths := []string{}
+				for i := 0; i < testInstanceCacheMaxSize+2; i++ {
+					paths = append(paths, extract(pini(i)))
+					put(cache, pini(i), "blah")
+					now = now.Add(time.Second)
+				}
+				So(exists(paths[0]), ShouldBeFalse)
+				So(exists(paths[1]), ShouldBeFalse)
+				So(exists(paths[2]), ShouldBeTrue)
+			})
+
+			Convey("counting their size towards MaxBytes", func() {
+				cache.maxBytes = 10
+				for i := 0; i < 3; i++ {
+					extract(pini(i))
+					put(cache, pini(i), "blah")
+					now = now.Add(time.Second)
+				}
+
+				// Each instance is 4 bytes plus 4 bytes extracted, only one fits.
+				alive := []int{}
+				for i := 0; i < 3; i++ {
+					r, _ := cache.Get(ctx, pini(i), now)
+					if r != nil {
+						r.Close(ctx, false)
+						alive = append(alive, i)
+					}
+				}
+				So(alive, ShouldResemble, []int{2})
+			})
+
+			Convey("orphaned on sync", func() {
+				put(cache, pini(0), "blah")
+				kept := extract(pini(0))
+				orphan := extract(pini(1))
+
+				So(os.Remove(filepath.Join(tempDir, instanceCacheStateFilename)), ShouldBeNil)
+				cache.GC(ctx, now)
+
+				So(exists(kept), ShouldBeTrue)
+				So(exists(orphan), ShouldBeFalse)
+			})
+		})
+
 		Convey("GC respects MaxAge", func() {
 			cache.maxAge = 2500 * time.Millisecond
 			for i := 0; i < 8; i++ {
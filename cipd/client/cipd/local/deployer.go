@@ -5,7 +5,9 @@
 package local
 
 import (
+	"encoding/hex"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math/rand"
 	"os"
@@ -79,6 +81,13 @@ type Deployer interface {
 	// FindDeployed returns a list of packages deployed to a site root.
 	FindDeployed(ctx context.Context) (out common.PinSliceBySubdir, err error)
 
+	// CheckIntegrity verifies files of a package deployed at the given subdir.
+	//
+	// Each file listed in the manifest of the deployed instance is rehashed and
+	// compared to the digest recorded at deployment time. Returns a list of
+	// problems found, which is empty if the package is intact.
+	CheckIntegrity(ctx context.Context, subdir, packageName string) ([]FileProblem, error)
+
 	// RemoveDeployed deletes a package from a subdir given its name.
 	RemoveDeployed(ctx context.Context, subdir, packageName string) error
 
@@ -93,6 +102,29 @@ type Deployer interface {
 	CleanupTrash(ctx context.Context) error
 }
 
+// FileProblemKind describes what is wrong with a deployed file.
+type FileProblemKind string
+
+const (
+	// FileMissing is used when a file listed in the manifest is not deployed.
+	FileMissing FileProblemKind = "missing"
+
+	// FileCorrupted is used when a deployed file doesn't match the manifest.
+	FileCorrupted FileProblemKind = "corrupted"
+
+	// FileExtra is used when a file not listed in the manifest is found in the
+	// package instance directory.
+	FileExtra FileProblemKind = "extra"
+)
+
+// FileProblem is returned by CheckIntegrity for each damaged file.
+type FileProblem struct {
+	// Name is slash separated file path relative to a package root.
+	Name string `json:"name"`
+	// Kind is what is wrong with the file.
+	Kind FileProblemKind `json:"kind"`
+}
+
 // NewDeployer return default Deployer implementation.
 func NewDeployer(root string) Deployer {
 	var err error
@@ -105,7 +137,31 @@ func NewDeployer(root string) Deployer {
 		return errDeployer{err}
 	}
 	trashDir := filepath.Join(root, SiteServiceDir, "trash")
-	return &deployerImpl{NewFileSystem(root, trashDir)}
+	return &deployerImpl{fs: NewFileSystem(root, trashDir)}
+}
+
+// NewDeployerWithLinkCache returns Deployer that keeps extracted instances in
+// the given directory and deploys them into the site root via hardlinks.
+//
+// The directory is usually shared by many site roots (and processes), so each
+// instance is extracted on the machine only once. If hardlinks can't be used
+// (e.g. the directory is on a different filesystem than the site root), falls
+// back to extracting instances directly into the site root.
+//
+// Note that deployed files share their content with the cache, so they must not
+// be modified in place (they are deployed read-only).
+func NewDeployerWithLinkCache(root, linkCacheDir string) Deployer {
+	d := NewDeployer(root)
+	impl, ok := d.(*deployerImpl)
+	if !ok || linkCacheDir == "" {
+		return d
+	}
+	linkCacheDir, err := filepath.Abs(filepath.Clean(linkCacheDir))
+	if err != nil {
+		return errDeployer{err}
+	}
+	impl.linkCache = &linkCache{fs: NewFileSystem(linkCacheDir, "")}
+	return impl
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -124,6 +180,10 @@ func (d errDeployer) CheckDeployed(context.Context, string, string) (common.Pin,
 func (d errDeployer) FindDeployed(context.Context) (out common.PinSliceBySubdir, err error) {
 	return nil, d.err
 }
+func (d errDeployer) CheckIntegrity(context.Context, string, string) ([]FileProblem, error) {
+	return nil, d.err
+}
+
 func (d errDeployer) RemoveDeployed(context.Context, string, string) error { return d.err }
 func (d errDeployer) TempFile(context.Context, string) (*os.File, error)   { return nil, d.err }
 func (d errDeployer) CleanupTrash(context.Context) error                   { return d.err }
@@ -145,6 +205,9 @@ const currentTxt = "_current.txt"
 // deployerImpl implements Deployer interface.
 type deployerImpl struct {
 	fs FileSystem
+
+	// linkCache, if not nil, is used to deploy instances via hardlinks.
+	linkCache *linkCache
 }
 
 func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst PackageInstance) (common.Pin, error) {
@@ -184,7 +247,7 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst P
 	}
 
 	destPath := filepath.Join(pkgPath, pin.InstanceID)
-	if err := ExtractInstance(ctx, inst, NewFileSystemDestination(destPath, d.fs), filterCipd); err != nil {
+	if err := d.extractInstance(ctx, inst, destPath, filterCipd); err != nil {
 		return common.Pin{}, err
 	}
 	newManifest, err := d.readManifest(ctx, destPath)
@@ -330,6 +393,77 @@ func (d *deployerImpl) FindDeployed(ctx context.Context) (common.PinSliceBySubdi
 	return found.ToSlice(), nil
 }
 
+func (d *deployerImpl) CheckIntegrity(ctx context.Context, subdir, packageName string) ([]FileProblem, error) {
+	pin, err := d.CheckDeployed(ctx, subdir, packageName)
+	if err != nil {
+		return nil, err
+	}
+	pkgPath, err := d.packagePath(ctx, subdir, packageName, false)
+	if err != nil {
+		return nil, err
+	}
+	instanceDir := filepath.Join(pkgPath, pin.InstanceID)
+	manifest, err := d.readManifest(ctx, instanceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	installMode := manifest.InstallMode
+	if runtime.GOOS == "windows" {
+		installMode = InstallModeCopy
+	} else if installMode == "" {
+		installMode = InstallModeSymlink
+	}
+
+	var problems []FileProblem
+	listed := make(map[string]bool, len(manifest.Files))
+	for _, f := range manifest.Files {
+		listed[f.Name] = true
+		relPath := filepath.FromSlash(f.Name)
+
+		// The file must be present in the site root in any install mode.
+		siteAbs, err := d.fs.RootRelToAbs(filepath.Join(subdir, relPath))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := os.Lstat(siteAbs); err != nil {
+			if os.IsNotExist(err) {
+				problems = append(problems, FileProblem{f.Name, FileMissing})
+				continue
+			}
+			return nil, err
+		}
+
+		// In "symlink" mode the site root has a symlink to the file body, which
+		// lives in the instance directory.
+		bodyAbs := siteAbs
+		if installMode == InstallModeSymlink {
+			bodyAbs = filepath.Join(instanceDir, relPath)
+		}
+		switch ok, err := checkDeployedFile(bodyAbs, &f); {
+		case os.IsNotExist(err):
+			problems = append(problems, FileProblem{f.Name, FileMissing})
+		case err != nil:
+			return nil, err
+		case !ok:
+			problems = append(problems, FileProblem{f.Name, FileCorrupted})
+		}
+	}
+
+	// Any other files in the instance directory were not put there by CIPD.
+	present, err := scanPackageDir(ctx, instanceDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range present {
+		if !listed[f.Name] {
+			problems = append(problems, FileProblem{f.Name, FileExtra})
+		}
+	}
+
+	return problems, nil
+}
+
 func (d *deployerImpl) RemoveDeployed(ctx context.Context, subdir, packageName string) error {
 	if err := common.ValidateSubdir(subdir); err != nil {
 		return err
@@ -802,6 +936,46 @@ func (d *deployerImpl) removeEmptyDirs(ctx context.Context, root string) {
 ////////////////////////////////////////////////////////////////////////////////
 // Utility functions.
 
+// checkDeployedFile verifies a file on disk matches its manifest entry.
+//
+// Symlinks are verified by their targets and regular files by their size and
+// digest (if recorded in the manifest). Returns false if the file doesn't match.
+func checkDeployedFile(path string, f *FileInfo) (bool, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return false, err
+	}
+
+	if f.Symlink != "" {
+		if info.Mode()&os.ModeSymlink == 0 {
+			return false, nil
+		}
+		target, err := os.Readlink(path)
+		if err != nil {
+			return false, err
+		}
+		return target == f.Symlink, nil
+	}
+
+	if !info.Mode().IsRegular() || uint64(info.Size()) != f.Size {
+		return false, nil
+	}
+	if f.Hash == "" {
+		return true, nil // manifests of older deployments have no digests
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+	h := common.DefaultHash()
+	if _, err := io.Copy(h, file); err != nil {
+		return false, err
+	}
+	return hex.EncodeToString(h.Sum(nil)) == f.Hash, nil
+}
+
 // scanPackageDir finds a set of regular files (and symlinks) in a package
 // instance directory and returns them as FileInfo structs (with slash-separated
 // paths relative to dir directory). Skips package service directories (.cipdpkg
@@ -15,6 +15,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"golang.org/x/net/context"
 
@@ -1096,6 +1097,102 @@ func TestDeployInstanceSwitchingModes(t *testing.T) {
 	})
 }
 
+func TestDeployInstanceWithLinkCachePosix(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping on windows")
+	}
+
+	ctx := context.Background()
+
+	Convey("Given a temp directory", t, func() {
+		tempDir := mkTempDir()
+		cacheDir := filepath.Join(tempDir, "cache")
+		siteRoot := func(name string) string { return filepath.Join(tempDir, name) }
+
+		inst := makeTestInstance("test/package", []File{
+			NewTestFile("some/file/path", "data a", false),
+			NewTestFile("some/executable", "data b", true),
+			NewTestSymlink("some/symlink", "executable"),
+		}, InstallModeCopy)
+
+		deployedFiles := []string{
+			".cipd/pkgs/0/0123456789abcdef00000123456789abcdef0000/.cipdpkg/manifest.json",
+			".cipd/pkgs/0/_current:0123456789abcdef00000123456789abcdef0000",
+			".cipd/pkgs/0/description.json",
+			"some/executable*",
+			"some/file/path",
+			"some/symlink:executable",
+		}
+
+		sameFile := func(a, b string) bool {
+			aInfo, err := os.Stat(a)
+			So(err, ShouldBeNil)
+			bInfo, err := os.Stat(b)
+			So(err, ShouldBeNil)
+			return os.SameFile(aInfo, bInfo)
+		}
+
+		Convey("DeployInstance hardlinks files from the cache", func() {
+			for _, root := range []string{siteRoot("a"), siteRoot("b")} {
+				_, err := NewDeployerWithLinkCache(root, cacheDir).DeployInstance(ctx, "", inst)
+				So(err, ShouldBeNil)
+				So(scanDir(root), ShouldResemble, deployedFiles)
+			}
+			So(scanDir(cacheDir), ShouldResemble, []string{
+				"0123456789abcdef00000123456789abcdef0000/.cipdpkg/manifest.json",
+				"0123456789abcdef00000123456789abcdef0000/.cipdpkg/verified",
+				"0123456789abcdef00000123456789abcdef0000/some/executable*",
+				"0123456789abcdef00000123456789abcdef0000/some/file/path",
+				"0123456789abcdef00000123456789abcdef0000/some/symlink:executable",
+				"0123456789abcdef00000123456789abcdef0000.lock",
+			})
+			So(sameFile(
+				filepath.Join(siteRoot("a"), "some", "file", "path"),
+				filepath.Join(siteRoot("b"), "some", "file", "path")), ShouldBeTrue)
+
+			Convey("corrupted cache is extracted again", func() {
+				path := filepath.Join(cacheDir, inst.Pin().InstanceID, "some", "file", "path")
+				So(os.Chmod(path, 0644), ShouldBeNil)
+				So(ioutil.WriteFile(path, []byte("huh"), 0644), ShouldBeNil)
+
+				_, err := NewDeployerWithLinkCache(siteRoot("c"), cacheDir).DeployInstance(ctx, "", inst)
+				So(err, ShouldBeNil)
+				So(readFile(siteRoot("c"), "some/file/path"), ShouldEqual, "data a")
+			})
+
+			Convey("cache modified in place is extracted again", func() {
+				// Same size, but modified after the cache was verified.
+				path := filepath.Join(cacheDir, inst.Pin().InstanceID, "some", "file", "path")
+				So(os.Chmod(path, 0644), ShouldBeNil)
+				So(ioutil.WriteFile(path, []byte("data X"), 0644), ShouldBeNil)
+				future := time.Now().Add(time.Hour)
+				So(os.Chtimes(path, future, future), ShouldBeNil)
+
+				_, err := NewDeployerWithLinkCache(siteRoot("c"), cacheDir).DeployInstance(ctx, "", inst)
+				So(err, ShouldBeNil)
+				So(readFile(siteRoot("c"), "some/file/path"), ShouldEqual, "data a")
+			})
+
+			Convey("RemoveLinkCachedInstance works", func() {
+				fs := NewFileSystem(cacheDir, "")
+				So(RemoveLinkCachedInstance(ctx, fs, inst.Pin().InstanceID), ShouldBeNil)
+				So(scanDir(cacheDir), ShouldResemble, []string{
+					"0123456789abcdef00000123456789abcdef0000.lock",
+				})
+				So(readFile(siteRoot("a"), "some/file/path"), ShouldEqual, "data a")
+			})
+		})
+
+		Convey("DeployInstance falls back to extraction", func() {
+			// The cache directory can't be created, since it is a file.
+			So(ioutil.WriteFile(cacheDir, []byte("not a dir"), 0644), ShouldBeNil)
+			_, err := NewDeployerWithLinkCache(siteRoot("a"), cacheDir).DeployInstance(ctx, "", inst)
+			So(err, ShouldBeNil)
+			So(scanDir(siteRoot("a")), ShouldResemble, deployedFiles)
+		})
+	})
+}
+
 func TestFindDeployed(t *testing.T) {
 	ctx := context.Background()
 
@@ -1160,6 +1257,107 @@ func TestFindDeployed(t *testing.T) {
 	})
 }
 
+func TestCheckIntegrityPosix(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping on windows")
+	}
+
+	ctx := context.Background()
+
+	Convey("Given a temp directory", t, func() {
+		tempDir := mkTempDir()
+		d := NewDeployer(tempDir)
+
+		write := func(rel, data string) {
+			err := ioutil.WriteFile(filepath.Join(tempDir, filepath.FromSlash(rel)), []byte(data), 0666)
+			So(err, ShouldBeNil)
+		}
+		remove := func(rel string) {
+			So(os.Remove(filepath.Join(tempDir, filepath.FromSlash(rel))), ShouldBeNil)
+		}
+
+		Convey("CheckIntegrity fails for missing package", func() {
+			_, err := d.CheckIntegrity(ctx, "", "test/package")
+			So(err, ShouldErrLike, "is not installed")
+		})
+
+		for _, mode := range []InstallMode{InstallModeSymlink, InstallModeCopy} {
+			mode := mode
+
+			Convey(fmt.Sprintf("With a package deployed in %q mode", mode), func() {
+				inst := makeTestInstance("test/package", []File{
+					NewTestFile("some/file/path", "data a", false),
+					NewTestFile("some/executable", "data b", true),
+					NewTestSymlink("some/symlink", "executable"),
+				}, mode)
+				_, err := d.DeployInstance(ctx, "subdir", inst)
+				So(err, ShouldBeNil)
+
+				// In "symlink" mode the file bodies live in the instance directory.
+				body := func(rel string) string {
+					if mode == InstallModeSymlink {
+						return ".cipd/pkgs/0/0123456789abcdef00000123456789abcdef0000/" + rel
+					}
+					return "subdir/" + rel
+				}
+
+				Convey("reports nothing for intact package", func() {
+					problems, err := d.CheckIntegrity(ctx, "subdir", "test/package")
+					So(err, ShouldBeNil)
+					So(problems, ShouldHaveLength, 0)
+				})
+
+				Convey("detects corrupted files", func() {
+					write(body("some/file/path"), "data A")
+					write(body("some/executable"), "truncated")
+
+					problems, err := d.CheckIntegrity(ctx, "subdir", "test/package")
+					So(err, ShouldBeNil)
+					So(problems, ShouldResemble, []FileProblem{
+						{"some/file/path", FileCorrupted},
+						{"some/executable", FileCorrupted},
+					})
+				})
+
+				Convey("detects missing files", func() {
+					remove("subdir/some/file/path")
+					remove(body("some/symlink"))
+
+					problems, err := d.CheckIntegrity(ctx, "subdir", "test/package")
+					So(err, ShouldBeNil)
+					So(problems, ShouldResemble, []FileProblem{
+						{"some/file/path", FileMissing},
+						{"some/symlink", FileMissing},
+					})
+				})
+
+				Convey("detects extra files", func() {
+					write(".cipd/pkgs/0/0123456789abcdef00000123456789abcdef0000/some/extra", "")
+
+					problems, err := d.CheckIntegrity(ctx, "subdir", "test/package")
+					So(err, ShouldBeNil)
+					So(problems, ShouldResemble, []FileProblem{
+						{"some/extra", FileExtra},
+					})
+				})
+
+				Convey("redeploying repairs the package", func() {
+					write(body("some/file/path"), "data A")
+					remove("subdir/some/executable")
+
+					_, err := d.DeployInstance(ctx, "subdir", inst)
+					So(err, ShouldBeNil)
+
+					problems, err := d.CheckIntegrity(ctx, "subdir", "test/package")
+					So(err, ShouldBeNil)
+					So(problems, ShouldHaveLength, 0)
+					So(readFile(tempDir, "subdir/some/file/path"), ShouldEqual, "data a")
+				})
+			})
+		}
+	})
+}
+
 func TestRemoveDeployedCommon(t *testing.T) {
 	ctx := context.Background()
 
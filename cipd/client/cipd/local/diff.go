@@ -0,0 +1,158 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"encoding/hex"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/luci/luci-go/cipd/client/cipd/common"
+)
+
+// FileDiffKind describes how a file differs between two package instances.
+type FileDiffKind string
+
+const (
+	// FileAdded means the file is present only in the new instance.
+	FileAdded FileDiffKind = "added"
+	// FileRemoved means the file is present only in the old instance.
+	FileRemoved FileDiffKind = "removed"
+	// FileModified means the file is present in both instances, but differs.
+	FileModified FileDiffKind = "modified"
+)
+
+// FileDiff describes a file that differs between two package instances.
+type FileDiff struct {
+	// Name is slash separated file path relative to a package root.
+	Name string `json:"name"`
+	// Kind is how the file differs.
+	Kind FileDiffKind `json:"kind"`
+
+	// Old is the file in the old instance, nil if the file was added.
+	Old *FileInfo `json:"old,omitempty"`
+	// New is the file in the new instance, nil if the file was removed.
+	New *FileInfo `json:"new,omitempty"`
+
+	// ContentChanged is true if the body of a modified regular file changed.
+	ContentChanged bool `json:"content_changed,omitempty"`
+	// ModeChanged is true if executable bit or windows attributes changed.
+	ModeChanged bool `json:"mode_changed,omitempty"`
+	// SymlinkChanged is true if the symlink target changed, or the file was
+	// turned into a symlink or vice versa.
+	SymlinkChanged bool `json:"symlink_changed,omitempty"`
+	// SizeDelta is the change in the file size, in bytes.
+	SizeDelta int64 `json:"size_delta"`
+}
+
+// DiffInstances compares files of two package instances.
+//
+// Bodies of all regular files are read and hashed. Files in the package
+// service directory (e.g. the manifest) are ignored.
+//
+// Returns differing files sorted by name.
+func DiffInstances(oldInst, newInst PackageInstance) ([]FileDiff, error) {
+	oldFiles, err := instanceFileInfos(oldInst)
+	if err != nil {
+		return nil, err
+	}
+	newFiles, err := instanceFileInfos(newInst)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []FileDiff
+	for name, o := range oldFiles {
+		n := newFiles[name]
+		if n == nil {
+			diffs = append(diffs, FileDiff{
+				Name:      name,
+				Kind:      FileRemoved,
+				Old:       o,
+				SizeDelta: -int64(o.Size),
+			})
+			continue
+		}
+		d := FileDiff{
+			Name:           name,
+			Kind:           FileModified,
+			Old:            o,
+			New:            n,
+			ContentChanged: o.Hash != n.Hash,
+			ModeChanged:    o.Executable != n.Executable || o.WinAttrs != n.WinAttrs,
+			SymlinkChanged: o.Symlink != n.Symlink,
+			SizeDelta:      int64(n.Size) - int64(o.Size),
+		}
+		if d.ContentChanged || d.ModeChanged || d.SymlinkChanged {
+			diffs = append(diffs, d)
+		}
+	}
+	for name, n := range newFiles {
+		if oldFiles[name] == nil {
+			diffs = append(diffs, FileDiff{
+				Name:      name,
+				Kind:      FileAdded,
+				New:       n,
+				SizeDelta: int64(n.Size),
+			})
+		}
+	}
+
+	sort.Sort(fileDiffsByName(diffs))
+	return diffs, nil
+}
+
+// instanceFileInfos returns FileInfo (including hashes of regular files) of
+// all files in the instance, keyed by file name.
+func instanceFileInfos(inst PackageInstance) (map[string]*FileInfo, error) {
+	files := make(map[string]*FileInfo, len(inst.Files()))
+	for _, f := range inst.Files() {
+		if strings.HasPrefix(f.Name(), packageServiceDir+"/") {
+			continue
+		}
+		fi := &FileInfo{
+			Name:       f.Name(),
+			Size:       f.Size(),
+			Executable: f.Executable(),
+			WinAttrs:   f.WinAttrs().String(),
+		}
+		if f.Symlink() {
+			target, err := f.SymlinkTarget()
+			if err != nil {
+				return nil, err
+			}
+			fi.Symlink = target
+		} else {
+			hash, err := hashFileBody(f)
+			if err != nil {
+				return nil, err
+			}
+			fi.Hash = hash
+		}
+		files[fi.Name] = fi
+	}
+	return files, nil
+}
+
+// hashFileBody returns a hex-encoded digest of the body of a regular file.
+func hashFileBody(f File) (string, error) {
+	r, err := f.Open()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	h := common.DefaultHash()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+type fileDiffsByName []FileDiff
+
+func (s fileDiffsByName) Len() int           { return len(s) }
+func (s fileDiffsByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
+func (s fileDiffsByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,75 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"io"
+	"testing"
+
+	"github.com/luci/luci-go/cipd/client/cipd/common"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type filesInstance []File
+
+func (filesInstance) Pin() common.Pin           { return common.Pin{} }
+func (f filesInstance) Files() []File           { return f }
+func (filesInstance) DataReader() io.ReadSeeker { return nil }
+
+func TestDiffInstances(t *testing.T) {
+	t.Parallel()
+
+	Convey("DiffInstances", t, func() {
+		oldInst := filesInstance{
+			NewTestFile("same", "data", false),
+			NewTestFile("removed", "12345", false),
+			NewTestFile("content", "old data", false),
+			NewTestFile("mode", "data", false),
+			NewTestSymlink("link", "target1"),
+			NewTestFile("to_link", "data", false),
+			NewTestFile(".cipdpkg/manifest.json", "{}", false),
+		}
+		newInst := filesInstance{
+			NewTestFile("same", "data", false),
+			NewTestFile("added", "123", false),
+			NewTestFile("content", "new data!", false),
+			NewTestFile("mode", "data", true),
+			NewTestSymlink("link", "target2"),
+			NewTestSymlink("to_link", "same"),
+			NewTestFile(".cipdpkg/manifest.json", `{"different": true}`, false),
+		}
+
+		diffs, err := DiffInstances(oldInst, newInst)
+		So(err, ShouldBeNil)
+
+		type summary struct {
+			name    string
+			kind    FileDiffKind
+			content bool
+			mode    bool
+			symlink bool
+			delta   int64
+		}
+		summaries := make([]summary, len(diffs))
+		for i, d := range diffs {
+			summaries[i] = summary{d.Name, d.Kind, d.ContentChanged, d.ModeChanged, d.SymlinkChanged, d.SizeDelta}
+		}
+		So(summaries, ShouldResemble, []summary{
+			{"added", FileAdded, false, false, false, 3},
+			{"content", FileModified, true, false, false, 1},
+			{"link", FileModified, false, false, true, 0},
+			{"mode", FileModified, false, true, false, 0},
+			{"removed", FileRemoved, false, false, false, -5},
+			{"to_link", FileModified, true, false, true, -4},
+		})
+
+		So(diffs[0].Old, ShouldBeNil)
+		So(diffs[0].New.Hash, ShouldNotEqual, "")
+		So(diffs[2].Old.Symlink, ShouldEqual, "target1")
+		So(diffs[2].New.Symlink, ShouldEqual, "target2")
+		So(diffs[4].New, ShouldBeNil)
+	})
+}
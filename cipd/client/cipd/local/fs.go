@@ -16,12 +16,19 @@ import (
 	"sync"
 	"time"
 
+	"github.com/danjacques/gofslock/fslock"
+
+	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/logging"
 
 	"golang.org/x/net/context"
 )
 
+// fileLockRetryDelay is how long to wait before retrying to grab a file lock
+// held by someone else.
+const fileLockRetryDelay = 50 * time.Millisecond
+
 // FileSystem abstracts operations that touch single file system subpath.
 //
 // All functions operate in terms of native file paths. It exists mostly to hide
@@ -118,6 +125,20 @@ func EnsureFile(ctx context.Context, fs FileSystem, path string, content io.Read
 	})
 }
 
+// WithFileLock calls fn while holding an exclusive lock on the given lock file.
+//
+// Such locks are respected across processes, e.g. by multiple cipd clients
+// sharing a cache directory. Waits for the lock if it is held by someone else,
+// until the context is canceled. The parent directory of the lock file must
+// exist.
+func WithFileLock(ctx context.Context, path string, fn func() error) error {
+	blocker := func() error {
+		logging.Debugf(ctx, "cipd: %s is locked, waiting...", path)
+		return clock.Sleep(ctx, fileLockRetryDelay).Err
+	}
+	return fslock.WithBlocking(path, blocker, fn)
+}
+
 // fsImplErr implements FileSystem by returning given error from all methods.
 type fsImplErr struct {
 	err error
@@ -0,0 +1,213 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/danjacques/gofslock/fslock"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/logging"
+)
+
+// File system layout of a link cache directory <cache>:
+// <cache>/
+//   fea3ab83440e9dfb813785e16d4101f331ed44f4/
+//     .cipdpkg/manifest.json
+//     .cipdpkg/verified
+//     <package files>
+//   fea3ab83440e9dfb813785e16d4101f331ed44f4.lock
+//
+// The lock file guards the extracted instance: it is held while the instance is
+// extracted, verified or linked from, and when it is removed. Lock files are
+// never removed: a process may be waiting on a lock file while it is deleted,
+// and would then hold a lock that no one else sees.
+//
+// The modification time of the "verified" file is when the extracted instance
+// was last known to be intact. Files modified after it (e.g. in place, via one
+// of their hardlinks) are considered damaged.
+
+// verifiedStampName is a name of the file inside an extracted instance whose
+// modification time marks when the instance was last known to be intact.
+const verifiedStampName = packageServiceDir + "/verified"
+
+// linkCache is a directory with extracted instances, see
+// NewDeployerWithLinkCache.
+type linkCache struct {
+	fs FileSystem
+}
+
+// RemoveLinkCachedInstance removes an extracted instance from a directory used
+// as a link cache by NewDeployerWithLinkCache.
+//
+// fs must be rooted at the link cache directory. Does nothing if the instance
+// is being used by someone right now. Doesn't touch files already deployed from
+// the cache, they are hardlinks and thus stay intact. Keeps the instance's lock
+// file.
+func RemoveLinkCachedInstance(ctx context.Context, fs FileSystem, instanceID string) error {
+	path, err := fs.RootRelToAbs(instanceID)
+	if err != nil {
+		return err
+	}
+	switch err := fslock.With(path+".lock", func() error { return fs.EnsureDirectoryGone(ctx, path) }); {
+	case err == fslock.ErrLockHeld:
+		logging.Infof(ctx, "cipd: not removing extracted %s, it is in use", instanceID)
+		return nil
+	default:
+		return err
+	}
+}
+
+// extractInstance extracts the instance into destPath, deploying it via the link
+// cache if it is configured.
+func (d *deployerImpl) extractInstance(ctx context.Context, inst PackageInstance, destPath string, exclude ExtractFilter) error {
+	if d.linkCache != nil {
+		err := d.linkCache.deploy(ctx, inst, destPath, d.fs, exclude)
+		if err == nil {
+			return nil
+		}
+		logging.Warningf(ctx, "cipd: failed to hardlink %s from the cache, extracting it instead - %s", inst.Pin(), err)
+	}
+	return ExtractInstance(ctx, inst, NewFileSystemDestination(destPath, d.fs), exclude)
+}
+
+// deploy makes sure the instance is extracted in the cache and hardlinks it into
+// destPath (that must be within destFS).
+func (lc *linkCache) deploy(ctx context.Context, inst PackageInstance, destPath string, destFS FileSystem, exclude ExtractFilter) error {
+	if _, err := lc.fs.EnsureDirectory(ctx, lc.fs.Root()); err != nil {
+		return err
+	}
+	cached, err := lc.fs.RootRelToAbs(inst.Pin().InstanceID)
+	if err != nil {
+		return err
+	}
+	return WithFileLock(ctx, cached+".lock", func() error {
+		files, err := lc.ensureExtracted(ctx, inst, cached, exclude)
+		if err != nil {
+			return err
+		}
+		return linkInstance(ctx, destFS, cached, destPath, files)
+	})
+}
+
+// ensureExtracted extracts the instance into the cache, unless it is already
+// there and intact.
+//
+// Returns files listed in the manifest of the extracted instance. Must be called
+// under the instance lock.
+func (lc *linkCache) ensureExtracted(ctx context.Context, inst PackageInstance, cached string, exclude ExtractFilter) ([]FileInfo, error) {
+	if _, err := os.Stat(cached); err == nil {
+		files, err := verifyExtracted(cached)
+		if err == nil {
+			return files, nil
+		}
+		logging.Warningf(ctx, "cipd: extracted %s in the cache is broken, extracting it again - %s", inst.Pin(), err)
+	}
+	if err := ExtractInstance(ctx, inst, NewFileSystemDestination(cached, lc.fs), exclude); err != nil {
+		return nil, err
+	}
+	manifest, err := readManifestAt(cached)
+	if err != nil {
+		return nil, err
+	}
+	// Everything was just extracted, so it is intact as of now.
+	if err := ioutil.WriteFile(filepath.Join(cached, filepath.FromSlash(verifiedStampName)), nil, 0644); err != nil {
+		return nil, err
+	}
+	return manifest.Files, nil
+}
+
+// verifyExtracted checks that all files of an extracted instance match its
+// manifest in type and size, and weren't modified since the instance was
+// extracted.
+//
+// The cache shares files with all site roots deployed from it, so this catches
+// deployed files that were modified in place. It doesn't read the files, so it
+// is cheap enough to be done on every deployment.
+func verifyExtracted(dir string) ([]FileInfo, error) {
+	stamp, err := os.Stat(filepath.Join(dir, filepath.FromSlash(verifiedStampName)))
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := readManifestAt(dir)
+	if err != nil {
+		return nil, err
+	}
+	for i := range manifest.Files {
+		f := &manifest.Files[i]
+		info, err := os.Lstat(filepath.Join(dir, filepath.FromSlash(f.Name)))
+		if err != nil {
+			return nil, err
+		}
+		ok := false
+		if f.Symlink != "" {
+			target, err := os.Readlink(filepath.Join(dir, filepath.FromSlash(f.Name)))
+			ok = err == nil && target == f.Symlink
+		} else {
+			ok = info.Mode().IsRegular() && uint64(info.Size()) == f.Size && !info.ModTime().After(stamp.ModTime())
+		}
+		if !ok {
+			return nil, fmt.Errorf("file %q doesn't match the manifest", f.Name)
+		}
+	}
+	return manifest.Files, nil
+}
+
+// readManifestAt reads the manifest of an instance extracted into dir.
+func readManifestAt(dir string) (Manifest, error) {
+	r, err := os.Open(filepath.Join(dir, filepath.FromSlash(manifestName)))
+	if err != nil {
+		return Manifest{}, err
+	}
+	defer r.Close()
+	return readManifest(r)
+}
+
+// linkInstance atomically replaces destPath with a directory that has regular
+// files (and the manifest) of an instance extracted into 'src' hardlinked, and
+// its symlinks recreated.
+func linkInstance(ctx context.Context, fs FileSystem, src, destPath string, files []FileInfo) error {
+	destPath, err := fs.CwdRelToAbs(destPath)
+	if err != nil {
+		return err
+	}
+	if _, err := fs.EnsureDirectory(ctx, filepath.Dir(destPath)); err != nil {
+		return err
+	}
+
+	// Stage everything in a temp directory on the same level as destPath, so it
+	// can be atomically moved in place.
+	tmp, err := tempDir(filepath.Dir(destPath), "", 0700)
+	if err != nil {
+		return err
+	}
+	defer fs.EnsureDirectoryGone(ctx, tmp)
+	out := filepath.Join(tmp, "x")
+
+	link := func(name, symlink string) error {
+		path := filepath.Join(out, filepath.FromSlash(name))
+		if _, err := fs.EnsureDirectory(ctx, filepath.Dir(path)); err != nil {
+			return err
+		}
+		if symlink != "" {
+			return os.Symlink(symlink, path)
+		}
+		return os.Link(filepath.Join(src, filepath.FromSlash(name)), path)
+	}
+	for _, f := range files {
+		if err := link(f.Name, f.Symlink); err != nil {
+			return err
+		}
+	}
+	if err := link(manifestName, ""); err != nil {
+		return err
+	}
+
+	return fs.Replace(ctx, out, destPath)
+}
@@ -7,6 +7,7 @@ package local
 import (
 	"archive/zip"
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -141,6 +142,9 @@ func ExtractInstance(ctx context.Context, inst PackageInstance, dest Destination
 
 	progress := newProgressReporter(ctx, files)
 
+	// Digests of extracted regular files, recorded in the deployed manifest.
+	hashes := make(map[string]string, len(files))
+
 	extractManifestFile := func(f File) (err error) {
 		defer progress.advance(f)
 		manifest, err := readManifestFile(f)
@@ -160,6 +164,7 @@ func ExtractInstance(ctx context.Context, inst PackageInstance, dest Destination
 				Size:       file.Size(),
 				Executable: file.Executable(),
 				WinAttrs:   file.WinAttrs().String(),
+				Hash:       hashes[file.Name()],
 			}
 			if file.Symlink() {
 				target, err := file.SymlinkTarget()
@@ -207,8 +212,12 @@ func ExtractInstance(ctx context.Context, inst PackageInstance, dest Destination
 			return err
 		}
 		defer in.Close()
-		_, err = io.Copy(out, in)
-		return err
+		h := common.DefaultHash()
+		if _, err = io.Copy(io.MultiWriter(out, h), in); err != nil {
+			return err
+		}
+		hashes[f.Name()] = hex.EncodeToString(h.Sum(nil))
+		return nil
 	}
 
 	var manifest File
@@ -243,12 +243,14 @@ func TestPackageReading(t *testing.T) {
 			"files": [
 				{
 					"name": "testing/qwerty",
-					"size": 5
+					"size": 5,
+					"hash": "8cb2237d0679ca88db6464eac60da96345513964"
 				},
 				{
 					"name": "abc",
 					"size": 3,
-					"executable": true
+					"executable": true,
+					"hash": "1107c34522e2db80f1bc9713b7326bf2855d740a"
 				},
 				{
 					"name": "rel_symlink",
@@ -262,7 +264,8 @@ func TestPackageReading(t *testing.T) {
 				}%s,
 				{
 					"name": "subpath/version.json",
-					"size": 92
+					"size": 92,
+					"hash": "%s"
 				}
 			]
 		}`
@@ -272,16 +275,18 @@ func TestPackageReading(t *testing.T) {
 			goodManifest = fmt.Sprintf(goodManifest, `,{
 				"name": "secret",
 				"size": 5,
-				"win_attrs": "H"
+				"win_attrs": "H",
+				"hash": "04a4fce796c2cf39c53220ec3b8e22e3b2f24615"
 			},
 			{
 				"name": "system",
 				"size": 7,
-				"win_attrs": "S"
-			}`)
+				"win_attrs": "S",
+				"hash": "7817c52b25607be67ce93c0e5e7081fb6a2346f2"
+			}`, "a8796318b59a716c84d802adc1c341ca060207d5")
 		} else {
 			manifestIdx = 5
-			goodManifest = fmt.Sprintf(goodManifest, "")
+			goodManifest = fmt.Sprintf(goodManifest, "", "51f6eb36e754353060466f9fb22b2fe9215f24db")
 		}
 		So(dest.files[manifestIdx].name, ShouldEqual, ".cipdpkg/manifest.json")
 		So(string(dest.files[manifestIdx].Bytes()), shouldBeSameJSONDict, goodManifest)
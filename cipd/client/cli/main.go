@@ -5,6 +5,7 @@
 package cli
 
 import (
+	"bytes"
 	"crypto/sha1"
 	"encoding/hex"
 	"encoding/json"
@@ -18,6 +19,7 @@ import (
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/kardianos/osext"
 	"github.com/maruel/subcommands"
@@ -25,6 +27,9 @@ import (
 
 	"github.com/luci/luci-go/common/auth"
 	"github.com/luci/luci-go/common/cli"
+	"github.com/luci/luci-go/common/data/text/linediff"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/flag/stringlistflag"
 	"github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/logging/gologger"
 	"github.com/luci/luci-go/common/retry/transient"
@@ -726,6 +731,13 @@ func cmdEnsure(params Parameters) *subcommands.Command {
 				(`An "ensure" file. See syntax described here: ` +
 					`https://godoc.org/github.com/luci/luci-go/cipd/client/cipd/ensure.` +
 					` Providing '-' will read from stdin.`))
+			c.Flags.StringVar(&c.resolvedVersionsFile, "resolved-versions-file", "",
+				"A file with pinned versions produced by 'ensure-file-resolve'. "+
+					"Defaults to '<ensure-file>"+resolvedVersionsFileSuffix+"' if it exists.")
+			c.Flags.BoolVar(&c.verifyFiles, "verify-files", false,
+				"Rehash files of already deployed packages and fail if any of them are corrupted, missing or unexpected.")
+			c.Flags.BoolVar(&c.repair, "repair", false,
+				"Like -verify-files, but redeploys damaged packages instead of failing. Implies -verify-files.")
 			return c
 		},
 	}
@@ -735,8 +747,11 @@ type ensureRun struct {
 	cipdSubcommand
 	clientOptions
 
-	rootDir    string
-	ensureFile string
+	rootDir              string
+	ensureFile           string
+	resolvedVersionsFile string
+	verifyFiles          bool
+	repair               bool
 }
 
 func (c *ensureRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
@@ -744,29 +759,101 @@ func (c *ensureRun) Run(a subcommands.Application, args []string, env subcommand
 		return 1
 	}
 	ctx := cli.GetContext(a, c, env)
-	currentPins, _, err := ensurePackages(ctx, c.rootDir, c.ensureFile, false, c.clientOptions)
+	verify := cipd.VerifyNone
+	switch {
+	case c.repair:
+		verify = cipd.VerifyAndRepair
+	case c.verifyFiles:
+		verify = cipd.VerifyFiles
+	}
+	currentPins, _, err := ensurePackages(ctx, c.rootDir, c.ensureFile, c.resolvedVersionsFile, verify, false, c.clientOptions)
 	return c.done(currentPins, err)
 }
 
-func ensurePackages(ctx context.Context, root string, desiredStateFile string, dryRun bool, clientOpts clientOptions) (common.PinSliceBySubdir, cipd.ActionMap, error) {
+func ensurePackages(ctx context.Context, root, desiredStateFile, resolvedVersionsFile string, verify cipd.VerifyMode, dryRun bool, clientOpts clientOptions) (common.PinSliceBySubdir, cipd.ActionMap, error) {
+	ensureFile, err := loadEnsureFile(ctx, desiredStateFile, &clientOpts)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(ensureFile.VerifiedPlatforms) != 0 {
+		host := ensure.Platform{OS: common.CurrentOS(), Arch: common.CurrentArchitecture()}
+		verified := false
+		for _, p := range ensureFile.VerifiedPlatforms {
+			if p == host {
+				verified = true
+				break
+			}
+		}
+		if !verified {
+			logging.Warningf(ctx, "The ensure file is not verified for %s, see $VerifiedPlatform.", host)
+		}
+	}
+
+	resolvedVersionsFile, err = findResolvedVersionsFile(desiredStateFile, resolvedVersionsFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	var pinned *ensure.ResolvedVersions
+	if resolvedVersionsFile != "" {
+		if pinned, err = loadResolvedVersionsFile(resolvedVersionsFile); err != nil {
+			return nil, nil, err
+		}
+		logging.Infof(ctx, "Using versions pinned in %s", resolvedVersionsFile)
+	}
+
+	client, err := clientOpts.makeCipdClient(ctx, root)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client.BeginBatch(ctx)
+	defer client.EndBatch(ctx)
+
+	resolver := ensure.VersionResolver(func(pkg, vers string) (common.Pin, error) {
+		return client.ResolveVersion(ctx, pkg, vers)
+	})
+	if pinned != nil {
+		resolver = pinned.Resolver()
+	}
+	resolved, err := ensureFile.Resolve(resolver)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actions, err := client.EnsurePackagesWithOptions(ctx, resolved.PackagesBySubdir, cipd.EnsureOptions{
+		Verify: verify,
+		DryRun: dryRun,
+	})
+	if err != nil {
+		return nil, actions, err
+	}
+
+	return resolved.PackagesBySubdir, actions, nil
+}
+
+// loadEnsureFile parses an ensure file ('-' means stdin).
+//
+// Prefers the ServiceURL from the file (if set), updating clientOpts
+// accordingly.
+func loadEnsureFile(ctx context.Context, path string, clientOpts *clientOptions) (*ensure.File, error) {
 	var err error
 	var f io.ReadCloser
-	if desiredStateFile == "-" {
+	if path == "-" {
 		f = os.Stdin
 	} else {
-		if f, err = os.Open(desiredStateFile); err != nil {
-			return nil, nil, err
+		if f, err = os.Open(path); err != nil {
+			return nil, err
 		}
 	}
 	defer f.Close()
 
 	ensureFile, err := ensure.ParseFile(f)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	// Prefer the ServiceURL from the file (if set), and log a warning if the user
-	// provided one on the commandline that doesn't match the one in the file.
+	// Log a warning if the user provided a ServiceURL on the commandline that
+	// doesn't match the one in the file.
 	if ensureFile.ServiceURL != "" {
 		if clientOpts.serviceURL != "" && clientOpts.serviceURL != ensureFile.ServiceURL {
 			logging.Warningf(ctx, "serviceURL in ensure file != serviceURL on CLI (%q v %q). Using %q from file.",
@@ -775,27 +862,225 @@ func ensurePackages(ctx context.Context, root string, desiredStateFile string, d
 		clientOpts.serviceURL = ensureFile.ServiceURL
 	}
 
-	client, err := clientOpts.makeCipdClient(ctx, root)
+	return ensureFile, nil
+}
+
+// resolvedVersionsFileSuffix is appended to a path of an ensure file to get
+// the default path of its companion resolved versions file.
+const resolvedVersionsFileSuffix = ".resolved"
+
+// findResolvedVersionsFile returns a path to the resolved versions file to use
+// with the given ensure file.
+//
+// It is either the explicitly given one, or the companion one, if it exists.
+// Returns "" if there's none.
+func findResolvedVersionsFile(ensureFile, explicit string) (string, error) {
+	if explicit != "" || ensureFile == "-" {
+		return explicit, nil
+	}
+	companion := ensureFile + resolvedVersionsFileSuffix
+	switch _, err := os.Stat(companion); {
+	case err == nil:
+		return companion, nil
+	case os.IsNotExist(err):
+		return "", nil
+	default:
+		return "", err
+	}
+}
+
+// loadResolvedVersionsFile parses a resolved versions file.
+func loadResolvedVersionsFile(path string) (*ensure.ResolvedVersions, error) {
+	f, err := os.Open(path)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+	defer f.Close()
+	return ensure.ParseResolvedVersions(f)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 'ensure-file-resolve' subcommand.
+
+func cmdEnsureFileResolve(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "ensure-file-resolve [options]",
+		ShortDesc: "pins versions of packages in an ensure file",
+		LongDesc: "Pins versions of packages in an ensure file.\n\n" +
+			"Resolves all refs and tags referenced by the ensure file, for all " +
+			"given platforms, into concrete instance IDs and writes them into a " +
+			"companion resolved versions file. 'ensure' installs exactly these " +
+			"instances, and fails if the ensure file references a version that " +
+			"isn't pinned (i.e. the resolved versions file is stale).",
+		CommandRun: func() subcommands.CommandRun {
+			c := &ensureFileResolveRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params)
+			c.Flags.StringVar(&c.ensureFile, "ensure-file", "<path>",
+				(`An "ensure" file. See syntax described here: ` +
+					`https://godoc.org/github.com/luci/luci-go/cipd/client/cipd/ensure.` +
+					` Providing '-' will read from stdin.`))
+			c.Flags.StringVar(&c.resolvedVersionsFile, "resolved-versions-file", "",
+				"A file to write pinned versions to. Defaults to '<ensure-file>"+resolvedVersionsFileSuffix+"'.")
+			c.Flags.Var(&c.platforms, "platform",
+				"A platform (as ${platform} expands to, e.g. 'linux-amd64') to resolve packages for. "+
+					"May be repeated. Defaults to $VerifiedPlatform from the ensure file or, "+
+					"if there's none, to all known platforms.")
+			return c
+		},
+	}
+}
+
+type ensureFileResolveRun struct {
+	cipdSubcommand
+	clientOptions
+
+	ensureFile           string
+	resolvedVersionsFile string
+	platforms            stringlistflag.Flag
+}
+
+func (c *ensureFileResolveRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+
+	out := c.resolvedVersionsFile
+	if out == "" {
+		if c.ensureFile == "-" {
+			c.printError(makeCLIError("-resolved-versions-file is required when reading the ensure file from stdin"))
+			return 1
+		}
+		out = c.ensureFile + resolvedVersionsFileSuffix
+	}
+
+	var platforms []ensure.Platform
+	if len(c.platforms) != 0 {
+		platforms = make([]ensure.Platform, len(c.platforms))
+		for i, p := range c.platforms {
+			var err error
+			if platforms[i], err = ensure.ParsePlatform(p); err != nil {
+				c.printError(makeCLIError("%s", err))
+				return 1
+			}
+		}
+	}
+
+	return c.done(resolveEnsureFile(ctx, c.ensureFile, out, platforms, c.clientOptions))
+}
+
+func resolveEnsureFile(ctx context.Context, ensureFilePath, out string, platforms []ensure.Platform, clientOpts clientOptions) (ensure.ResolvedVersionSlice, error) {
+	ensureFile, err := loadEnsureFile(ctx, ensureFilePath, &clientOpts)
+	if err != nil {
+		return nil, err
+	}
+	if len(platforms) == 0 {
+		if platforms = ensureFile.VerifiedPlatforms; len(platforms) == 0 {
+			platforms = ensure.KnownPlatforms
+		}
+	}
+
+	client, err := clientOpts.makeCipdClient(ctx, "")
+	if err != nil {
+		return nil, err
 	}
 
 	client.BeginBatch(ctx)
 	defer client.EndBatch(ctx)
 
-	resolved, err := ensureFile.Resolve(func(pkg, vers string) (common.Pin, error) {
+	rv, err := ensureFile.ResolveVersions(func(pkg, vers string) (common.Pin, error) {
 		return client.ResolveVersion(ctx, pkg, vers)
-	})
+	}, platforms)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	buf := bytes.Buffer{}
+	if _, err := rv.Serialize(&buf); err != nil {
+		return nil, err
 	}
+	if err := ioutil.WriteFile(out, buf.Bytes(), 0666); err != nil {
+		return nil, err
+	}
+	fmt.Printf("Pinned %d package version(s) in %s.\n", len(rv.Versions), out)
+	return rv.Versions, nil
+}
 
-	actions, err := client.EnsurePackages(ctx, resolved.PackagesBySubdir, dryRun)
+////////////////////////////////////////////////////////////////////////////////
+// 'ensure-file-verify' subcommand.
+
+func cmdEnsureFileVerify(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "ensure-file-verify [options]",
+		ShortDesc: "verifies packages in an ensure file resolve on all declared platforms",
+		LongDesc: "Verifies packages in an ensure file resolve on all declared platforms.\n\n" +
+			"Expands package templates for each platform listed in $VerifiedPlatform " +
+			"settings of the ensure file and checks that all resulting packages " +
+			"exist and all their versions resolve. Doesn't install anything.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &ensureFileVerifyRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params)
+			c.Flags.StringVar(&c.ensureFile, "ensure-file", "<path>",
+				(`An "ensure" file. See syntax described here: ` +
+					`https://godoc.org/github.com/luci/luci-go/cipd/client/cipd/ensure.` +
+					` Providing '-' will read from stdin.`))
+			return c
+		},
+	}
+}
+
+type ensureFileVerifyRun struct {
+	cipdSubcommand
+	clientOptions
+
+	ensureFile string
+}
+
+func (c *ensureFileVerifyRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	return c.done(verifyEnsureFile(ctx, c.ensureFile, c.clientOptions))
+}
+
+func verifyEnsureFile(ctx context.Context, ensureFilePath string, clientOpts clientOptions) ([]string, error) {
+	ensureFile, err := loadEnsureFile(ctx, ensureFilePath, &clientOpts)
 	if err != nil {
-		return nil, actions, err
+		return nil, err
 	}
 
-	return resolved.PackagesBySubdir, actions, nil
+	client, err := clientOpts.makeCipdClient(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	client.BeginBatch(ctx)
+	defer client.EndBatch(ctx)
+
+	err = ensureFile.Verify(func(pkg, vers string) (common.Pin, error) {
+		return client.ResolveVersion(ctx, pkg, vers)
+	})
+	if merr, ok := err.(errors.MultiError); ok {
+		lines := make([]string, len(merr))
+		for i, err := range merr {
+			lines[i] = "  " + err.Error()
+		}
+		return nil, fmt.Errorf("the ensure file doesn't resolve on %d platform(s):\n%s",
+			len(merr), strings.Join(lines, "\n"))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	verified := make([]string, len(ensureFile.VerifiedPlatforms))
+	for i, p := range ensureFile.VerifiedPlatforms {
+		verified[i] = p.String()
+	}
+	fmt.Printf("The ensure file resolves on: %s.\n", strings.Join(verified, ", "))
+	return verified, nil
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -822,6 +1107,9 @@ func cmdPuppetCheckUpdates(params Parameters) *subcommands.Command {
 			c.Flags.StringVar(&c.ensureFile, "ensure-file", "<path>",
 				(`An "ensure" file. See syntax described here: ` +
 					`https://godoc.org/github.com/luci/luci-go/cipd/client/cipd/ensure`))
+			c.Flags.StringVar(&c.resolvedVersionsFile, "resolved-versions-file", "",
+				"A file with pinned versions produced by 'ensure-file-resolve'. "+
+					"Defaults to '<ensure-file>"+resolvedVersionsFileSuffix+"' if it exists.")
 			return c
 		},
 	}
@@ -831,8 +1119,9 @@ type checkUpdatesRun struct {
 	cipdSubcommand
 	clientOptions
 
-	rootDir    string
-	ensureFile string
+	rootDir              string
+	ensureFile           string
+	resolvedVersionsFile string
 }
 
 func (c *checkUpdatesRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
@@ -840,7 +1129,7 @@ func (c *checkUpdatesRun) Run(a subcommands.Application, args []string, env subc
 		return 1
 	}
 	ctx := cli.GetContext(a, c, env)
-	_, actions, err := ensurePackages(ctx, c.rootDir, c.ensureFile, true, c.clientOptions)
+	_, actions, err := ensurePackages(ctx, c.rootDir, c.ensureFile, c.resolvedVersionsFile, cipd.VerifyNone, true, c.clientOptions)
 	if err != nil {
 		ret := c.done(actions, err)
 		if transient.Tag.In(err) {
@@ -1709,6 +1998,232 @@ func inspectInstance(ctx context.Context, inst local.PackageInstance, listFiles
 	}
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'pkg-diff' subcommand.
+
+// maxTextDiffSize is the maximum size of a file to produce a text diff for.
+const maxTextDiffSize = 64 * 1024
+
+func cmdDiff(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		Advanced:  true,
+		UsageLine: "pkg-diff <old instance> <new instance> [options]",
+		ShortDesc: "shows how files differ between two package instances",
+		LongDesc: "Shows how files differ between two package instances.\n\n" +
+			"An instance is either a path to a package instance file, or " +
+			"<package>@<version> to fetch it from the repository. Reports added, " +
+			"removed and modified files, changes of file modes and symlink targets " +
+			"and size deltas.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &diffRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params)
+			c.Flags.BoolVar(&c.unified, "unified", false,
+				fmt.Sprintf("Print a unified diff of modified text files smaller than %d bytes.", maxTextDiffSize))
+			return c
+		},
+	}
+}
+
+type diffRun struct {
+	cipdSubcommand
+	clientOptions
+
+	unified bool
+}
+
+func (c *diffRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 2, 2) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	return c.done(diffInstances(ctx, args[0], args[1], c.unified, c.clientOptions))
+}
+
+type diffOutput struct {
+	Old   common.Pin       `json:"old"`
+	New   common.Pin       `json:"new"`
+	Files []local.FileDiff `json:"files"`
+}
+
+func diffInstances(ctx context.Context, oldSpec, newSpec string, unified bool, clientOpts clientOptions) (*diffOutput, error) {
+	var client cipd.Client
+	getClient := func() (cipd.Client, error) {
+		if client == nil {
+			var err error
+			if client, err = clientOpts.makeCipdClient(ctx, ""); err != nil {
+				return nil, err
+			}
+		}
+		return client, nil
+	}
+
+	oldInst, oldCloser, err := openInstanceForDiff(ctx, oldSpec, getClient)
+	if err != nil {
+		return nil, err
+	}
+	defer oldCloser()
+	newInst, newCloser, err := openInstanceForDiff(ctx, newSpec, getClient)
+	if err != nil {
+		return nil, err
+	}
+	defer newCloser()
+
+	diffs, err := local.DiffInstances(oldInst, newInst)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("Old: %s\n", oldInst.Pin())
+	fmt.Printf("New: %s\n", newInst.Pin())
+	if len(diffs) == 0 {
+		fmt.Println("No differences.")
+	}
+	for _, d := range diffs {
+		fmt.Printf(" %s %s%s\n", strings.ToUpper(string(d.Kind[:1])), d.Name, describeFileDiff(&d))
+	}
+
+	if unified {
+		oldFiles := filesByName(oldInst)
+		newFiles := filesByName(newInst)
+		for _, d := range diffs {
+			if d.Kind != local.FileModified || !d.ContentChanged || d.SymlinkChanged {
+				continue
+			}
+			oldText, ok := readTextFile(oldFiles[d.Name])
+			if !ok {
+				continue
+			}
+			newText, ok := readTextFile(newFiles[d.Name])
+			if !ok {
+				continue
+			}
+			oldName, newName := "a/"+d.Name, "b/"+d.Name
+			diff, err := linediff.Unified(oldName, newName, oldText, newText, 3)
+			if err == linediff.ErrTooLarge {
+				diff = fmt.Sprintf("Files %s and %s differ\n", oldName, newName)
+			} else if err != nil {
+				return nil, err
+			}
+			fmt.Println()
+			fmt.Print(diff)
+		}
+	}
+
+	return &diffOutput{
+		Old:   oldInst.Pin(),
+		New:   newInst.Pin(),
+		Files: diffs,
+	}, nil
+}
+
+// openInstanceForDiff opens a package instance given either a path to an
+// instance file or <package>@<version>, in which case the instance is fetched
+// into a temp file.
+func openInstanceForDiff(ctx context.Context, spec string, getClient func() (cipd.Client, error)) (local.PackageInstance, func() error, error) {
+	if _, err := os.Stat(spec); err == nil || !strings.Contains(spec, "@") {
+		return local.OpenInstanceFile(ctx, spec, "", local.VerifyHash)
+	}
+
+	chunks := strings.SplitN(spec, "@", 2)
+	client, err := getClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	pin, err := client.ResolveVersion(ctx, chunks[0], chunks[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f, err := ioutil.TempFile("", "cipd_diff")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		f.Close()
+		os.Remove(f.Name())
+	}
+	// 'FetchInstanceTo' verifies the hash.
+	if err = client.FetchInstanceTo(ctx, pin, f); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	f.Close()
+
+	inst, closer, err := local.OpenInstanceFile(ctx, f.Name(), pin.InstanceID, local.SkipHashVerification)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return inst, func() error {
+		err := closer()
+		os.Remove(f.Name())
+		return err
+	}, nil
+}
+
+// describeFileDiff returns a human readable summary of how the file changed.
+func describeFileDiff(d *local.FileDiff) string {
+	var changes []string
+	if d.SymlinkChanged {
+		changes = append(changes, fmt.Sprintf("%s -> %s", fileKindForDiff(d.Old), fileKindForDiff(d.New)))
+	}
+	if d.ModeChanged {
+		changes = append(changes, fmt.Sprintf("mode %s -> %s", fileModeForDiff(d.Old), fileModeForDiff(d.New)))
+	}
+	if d.SizeDelta != 0 {
+		changes = append(changes, fmt.Sprintf("%+d bytes", d.SizeDelta))
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (%s)", strings.Join(changes, ", "))
+}
+
+func fileKindForDiff(fi *local.FileInfo) string {
+	if fi.Symlink != "" {
+		return fmt.Sprintf("symlink to %q", fi.Symlink)
+	}
+	return "file"
+}
+
+func fileModeForDiff(fi *local.FileInfo) string {
+	mode := "-x"
+	if fi.Executable {
+		mode = "+x"
+	}
+	if fi.WinAttrs != "" {
+		mode += " " + fi.WinAttrs
+	}
+	return mode
+}
+
+func filesByName(inst local.PackageInstance) map[string]local.File {
+	files := make(map[string]local.File, len(inst.Files()))
+	for _, f := range inst.Files() {
+		files[f.Name()] = f
+	}
+	return files
+}
+
+// readTextFile reads a body of a small text file. Returns false if the file is
+// too large or isn't a text file.
+func readTextFile(f local.File) (string, bool) {
+	if f == nil || f.Symlink() || f.Size() > maxTextDiffSize {
+		return "", false
+	}
+	r, err := f.Open()
+	if err != nil {
+		return "", false
+	}
+	defer r.Close()
+	body, err := ioutil.ReadAll(r)
+	if err != nil || bytes.IndexByte(body, 0) != -1 || !utf8.Valid(body) {
+		return "", false
+	}
+	return string(body), true
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 'pkg-register' subcommand.
 
@@ -2179,6 +2694,8 @@ func GetApplication(params Parameters) *cli.Application {
 			cmdSearch(params),
 			cmdCreate(params),
 			cmdEnsure(params),
+			cmdEnsureFileResolve(params),
+			cmdEnsureFileVerify(params),
 			cmdResolve(params),
 			cmdDescribe(params),
 			cmdSetRef(params),
@@ -2198,6 +2715,7 @@ func GetApplication(params Parameters) *cli.Application {
 			cmdDeploy(),
 			cmdFetch(params),
 			cmdInspect(),
+			cmdDiff(params),
 			cmdRegister(params),
 			cmdDelete(params),
 
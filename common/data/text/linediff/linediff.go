@@ -0,0 +1,223 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package linediff implements line-oriented diffs of small texts.
+package linediff
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// maxTableSize is the maximum number of cells in the table used to find the
+// longest common subsequence of the differing parts of two texts.
+//
+// It limits the memory used by Unified to tens of megabytes.
+const maxTableSize = 4 * 1024 * 1024
+
+// ErrTooLarge is returned by Unified if the texts are too large to diff.
+var ErrTooLarge = errors.New("texts are too large to diff")
+
+type editKind int
+
+const (
+	editEqual editKind = iota
+	editDelete
+	editInsert
+)
+
+// edit is a single step of an edit script that transforms one list of lines
+// into another.
+type edit struct {
+	kind editKind
+	line string
+	a    int // index of the line in the old text this edit happens at
+	b    int // index of the line in the new text this edit happens at
+}
+
+// Unified returns a unified diff (as produced by `diff -u`) between texts a
+// and b, with 'context' unchanged lines around each change. aName and bName
+// are used in the diff header.
+//
+// Returns an empty string if the texts are equal.
+//
+// It uses a simple quadratic (in number of lines) algorithm and thus is
+// suitable only for small texts, or for large texts that differ only in a small
+// region. Returns ErrTooLarge if the texts are too large to diff.
+func Unified(aName, bName, a, b string, context int) (string, error) {
+	edits, err := diffLines(splitLines(a), splitLines(b))
+	if err != nil {
+		return "", err
+	}
+
+	out := bytes.Buffer{}
+	for start := 0; start < len(edits); {
+		// Skip to the next change.
+		for start < len(edits) && edits[start].kind == editEqual {
+			start++
+		}
+		if start == len(edits) {
+			break
+		}
+
+		// Find where the hunk ends, merging changes separated by no more than
+		// 2*context unchanged lines.
+		end := start
+		for {
+			for end < len(edits) && edits[end].kind != editEqual {
+				end++
+			}
+			next := end
+			for next < len(edits) && edits[next].kind == editEqual {
+				next++
+			}
+			if next == len(edits) || next-end > 2*context {
+				break
+			}
+			end = next
+		}
+
+		lo := start - context
+		if lo < 0 {
+			lo = 0
+		}
+		hi := end + context
+		if hi > len(edits) {
+			hi = len(edits)
+		}
+
+		if out.Len() == 0 {
+			fmt.Fprintf(&out, "--- %s\n+++ %s\n", aName, bName)
+		}
+		writeHunk(&out, edits[lo:hi])
+		start = hi
+	}
+	return out.String(), nil
+}
+
+// splitLines splits the text into lines, keeping line terminators.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines returns an edit script that transforms a into b, based on their
+// longest common subsequence.
+//
+// Lines that a and b have in common at their start and end are matched up
+// front, and the rest is diffed with a table quadratic in its size. Returns
+// ErrTooLarge if that table would be larger than maxTableSize.
+func diffLines(a, b []string) ([]edit, error) {
+	pre := 0
+	for pre < len(a) && pre < len(b) && a[pre] == b[pre] {
+		pre++
+	}
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && a[len(a)-1-suf] == b[len(b)-1-suf] {
+		suf++
+	}
+	if int64(len(a)-pre-suf+1)*int64(len(b)-pre-suf+1) > maxTableSize {
+		return nil, ErrTooLarge
+	}
+
+	edits := make([]edit, 0, len(a)+len(b)-pre-suf)
+	for i := 0; i < pre; i++ {
+		edits = append(edits, edit{editEqual, a[i], i, i})
+	}
+	edits = diffMiddle(edits, a[pre:len(a)-suf], b[pre:len(b)-suf], pre)
+	for k := suf; k > 0; k-- {
+		i, j := len(a)-k, len(b)-k
+		edits = append(edits, edit{editEqual, a[i], i, j})
+	}
+	return edits, nil
+}
+
+// diffMiddle appends an edit script that transforms a into b to edits. a and b
+// start at line 'offset' of their texts.
+func diffMiddle(edits []edit, a, b []string, offset int) []edit {
+	n, m := len(a), len(b)
+
+	// lcs[i][j] is a length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < n || j < m {
+		switch {
+		case i < n && j < m && a[i] == b[j]:
+			edits = append(edits, edit{editEqual, a[i], offset + i, offset + j})
+			i++
+			j++
+		case i < n && (j == m || lcs[i+1][j] >= lcs[i][j+1]):
+			edits = append(edits, edit{editDelete, a[i], offset + i, offset + j})
+			i++
+		default:
+			edits = append(edits, edit{editInsert, b[j], offset + i, offset + j})
+			j++
+		}
+	}
+	return edits
+}
+
+// writeHunk writes a single hunk (with its header) to the buffer.
+func writeHunk(out *bytes.Buffer, edits []edit) {
+	aCount, bCount := 0, 0
+	for _, e := range edits {
+		if e.kind != editInsert {
+			aCount++
+		}
+		if e.kind != editDelete {
+			bCount++
+		}
+	}
+	fmt.Fprintf(out, "@@ -%s +%s @@\n", hunkRange(edits[0].a, aCount), hunkRange(edits[0].b, bCount))
+
+	for _, e := range edits {
+		switch e.kind {
+		case editEqual:
+			out.WriteByte(' ')
+		case editDelete:
+			out.WriteByte('-')
+		case editInsert:
+			out.WriteByte('+')
+		}
+		out.WriteString(e.line)
+		if !strings.HasSuffix(e.line, "\n") {
+			out.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// hunkRange formats a range of lines for a hunk header, given a zero-based
+// index of its first line and the number of lines.
+func hunkRange(start, count int) string {
+	switch count {
+	case 0:
+		// An empty range is identified by the line right before it.
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	default:
+		return fmt.Sprintf("%d,%d", start+1, count)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package linediff
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func lines(l ...string) string {
+	return strings.Join(l, "\n") + "\n"
+}
+
+func unified(aName, bName, a, b string, context int) string {
+	diff, err := Unified(aName, bName, a, b, context)
+	if err != nil {
+		panic(err)
+	}
+	return diff
+}
+
+func numLines(start, count int) string {
+	l := make([]string, count)
+	for i := range l {
+		l[i] = strconv.Itoa(start + i)
+	}
+	return lines(l...)
+}
+
+func TestUnified(t *testing.T) {
+	t.Parallel()
+
+	Convey("Unified", t, func() {
+		Convey("equal texts", func() {
+			So(unified("a", "b", lines("1", "2"), lines("1", "2"), 3), ShouldEqual, "")
+			So(unified("a", "b", "", "", 3), ShouldEqual, "")
+		})
+
+		Convey("single change with context", func() {
+			a := lines("1", "2", "3", "4", "5", "6", "7")
+			b := lines("1", "2", "3", "X", "5", "6", "7")
+			So(unified("old", "new", a, b, 1), ShouldEqual, lines(
+				"--- old",
+				"+++ new",
+				"@@ -3,3 +3,3 @@",
+				" 3",
+				"-4",
+				"+X",
+				" 5",
+			))
+		})
+
+		Convey("close changes are merged into one hunk", func() {
+			a := lines("1", "2", "3", "4", "5")
+			b := lines("X", "2", "3", "Y", "5")
+			So(unified("old", "new", a, b, 1), ShouldEqual, lines(
+				"--- old",
+				"+++ new",
+				"@@ -1,5 +1,5 @@",
+				"-1",
+				"+X",
+				" 2",
+				" 3",
+				"-4",
+				"+Y",
+				" 5",
+			))
+		})
+
+		Convey("distant changes are split into hunks", func() {
+			a := lines("1", "2", "3", "4", "5", "6")
+			b := lines("X", "2", "3", "4", "5", "Y")
+			So(unified("old", "new", a, b, 1), ShouldEqual, lines(
+				"--- old",
+				"+++ new",
+				"@@ -1,2 +1,2 @@",
+				"-1",
+				"+X",
+				" 2",
+				"@@ -5,2 +5,2 @@",
+				" 5",
+				"-6",
+				"+Y",
+			))
+		})
+
+		Convey("additions and removals", func() {
+			So(unified("old", "new", "", lines("1", "2"), 3), ShouldEqual, lines(
+				"--- old",
+				"+++ new",
+				"@@ -0,0 +1,2 @@",
+				"+1",
+				"+2",
+			))
+			So(unified("old", "new", lines("1", "2", "3"), lines("1", "3"), 0), ShouldEqual, lines(
+				"--- old",
+				"+++ new",
+				"@@ -2 +1,0 @@",
+				"-2",
+			))
+		})
+
+		Convey("large texts with a small change", func() {
+			a := numLines(0, 10000)
+			b := numLines(0, 5000) + lines("X") + numLines(5001, 4999)
+			So(unified("old", "new", a, b, 1), ShouldEqual, lines(
+				"--- old",
+				"+++ new",
+				"@@ -5000,3 +5000,3 @@",
+				" 4999",
+				"-5000",
+				"+X",
+				" 5001",
+			))
+		})
+
+		Convey("large texts that differ too much", func() {
+			_, err := Unified("old", "new", numLines(0, 10000), numLines(1, 10000), 3)
+			So(err, ShouldEqual, ErrTooLarge)
+		})
+
+		Convey("missing trailing newline", func() {
+			So(unified("old", "new", "1\n2", "1\n2\n", 3), ShouldEqual, lines(
+				"--- old",
+				"+++ new",
+				"@@ -1,2 +1,2 @@",
+				" 1",
+				"-2",
+				`\ No newline at end of file`,
+				"+2",
+			))
+		})
+	})
+}
@@ -149,6 +149,9 @@ func (m *FailureDetails) GetFailedDmDependency() []*DMLink {
 type Step struct {
 	// The display name of the Component.
 	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// The Step's ID, if it was created with one. A Step's ID is unique within its
+	// annotation stream, and identifies it even if other Steps share its name.
+	Id string `protobuf:"bytes,10,opt,name=id" json:"id,omitempty"`
 	// The command-line invocation of the step, expressed as an argument vector.
 	Command *Step_Command `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
 	// The current running status of the Step.
@@ -179,6 +182,11 @@ type Step struct {
 	// the component.
 	OtherLinks []*Link          `protobuf:"bytes,23,rep,name=other_links,json=otherLinks" json:"other_links,omitempty"`
 	Property   []*Step_Property `protobuf:"bytes,24,rep,name=property" json:"property,omitempty"`
+	// Arbitrary key/value tags that describe this Step.
+	Tags map[string]string `protobuf:"bytes,25,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Key/value properties that describe this Step. Unlike `property`, these
+	// are not build properties.
+	StepProperty []*Step_Property `protobuf:"bytes,26,rep,name=step_property,json=stepProperty" json:"step_property,omitempty"`
 }
 
 func (m *Step) Reset()                    { *m = Step{} }
@@ -193,6 +201,13 @@ func (m *Step) GetName() string {
 	return ""
 }
 
+func (m *Step) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 func (m *Step) GetCommand() *Step_Command {
 	if m != nil {
 		return m.Command
@@ -284,6 +299,20 @@ func (m *Step) GetProperty() []*Step_Property {
 	return nil
 }
 
+func (m *Step) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Step) GetStepProperty() []*Step_Property {
+	if m != nil {
+		return m.StepProperty
+	}
+	return nil
+}
+
 // Command contains information about a command-line invocation.
 type Step_Command struct {
 	// The command-line invocation, expressed as an argument vector.
@@ -837,67 +866,70 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 981 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x5d, 0x6f, 0xe3, 0x44,
-	0x14, 0x8d, 0x63, 0x27, 0x6e, 0x6e, 0xd2, 0x62, 0x86, 0xb0, 0x98, 0x08, 0xd1, 0x10, 0x21, 0x51,
-	0xc1, 0xae, 0x83, 0xca, 0x4a, 0xb0, 0x1f, 0xac, 0x94, 0x4d, 0xdc, 0x4d, 0xa4, 0x6c, 0xb6, 0x9a,
-	0xb4, 0xd2, 0xf2, 0x14, 0x39, 0xf1, 0x24, 0x35, 0xb5, 0x3d, 0xc6, 0x1e, 0x97, 0xe6, 0xe7, 0xf0,
-	0x23, 0xf8, 0x6f, 0x3c, 0xf0, 0x80, 0xe6, 0xc3, 0x89, 0x0b, 0xed, 0xf2, 0x62, 0xcd, 0xb9, 0xf7,
-	0xdc, 0x99, 0xb9, 0x67, 0x8e, 0x67, 0xe0, 0xc5, 0x26, 0x60, 0x57, 0xf9, 0xd2, 0x59, 0xd1, 0xa8,
-	0x1f, 0xe6, 0xab, 0x40, 0x7c, 0x9e, 0x6c, 0x68, 0x7f, 0x45, 0xa3, 0x88, 0xc6, 0xfd, 0x24, 0xa5,
-	0x8c, 0xf6, 0xa3, 0x20, 0xa4, 0x7d, 0x2f, 0x8e, 0x29, 0xf3, 0x58, 0x40, 0xe3, 0xcc, 0x11, 0x61,
-	0x64, 0xf0, 0x78, 0xe7, 0x78, 0x43, 0xe9, 0x26, 0x24, 0x92, 0xba, 0xcc, 0xd7, 0x7d, 0x16, 0x44,
-	0x24, 0x63, 0x5e, 0x94, 0x48, 0x5a, 0xef, 0x2f, 0x0d, 0x8e, 0xce, 0xbc, 0x20, 0xcc, 0x53, 0x32,
-	0x22, 0xcc, 0x0b, 0xc2, 0x0c, 0x3d, 0x01, 0x83, 0x6d, 0x13, 0x62, 0x6b, 0x5d, 0xed, 0xe4, 0xe8,
-	0xf4, 0x73, 0x87, 0x4f, 0xe4, 0xdc, 0xe5, 0x38, 0x17, 0xdb, 0x84, 0x60, 0x41, 0x43, 0x08, 0x0c,
-	0x46, 0x6e, 0x99, 0x5d, 0xed, 0x6a, 0x27, 0x0d, 0x2c, 0xc6, 0xe8, 0x15, 0xb4, 0xd7, 0x5e, 0x10,
-	0x12, 0x7f, 0xe1, 0x47, 0x0b, 0x9f, 0x24, 0x24, 0xf6, 0x49, 0xbc, 0xda, 0xda, 0x7a, 0x57, 0x3f,
-	0x69, 0x9e, 0xb6, 0xe4, 0x94, 0xa3, 0xb7, 0xd3, 0x20, 0xbe, 0xc6, 0x48, 0x32, 0x47, 0xd1, 0x68,
-	0xc7, 0xeb, 0xad, 0xc0, 0xe0, 0x2b, 0xa0, 0x26, 0x98, 0x6f, 0xdc, 0x99, 0x8b, 0x07, 0x53, 0xab,
-	0x82, 0x0e, 0xa1, 0xe1, 0xbe, 0x1f, 0xba, 0xe7, 0x17, 0x93, 0x77, 0x33, 0x4b, 0x43, 0x0d, 0xa8,
-	0x4d, 0x66, 0x67, 0x78, 0x60, 0x55, 0x91, 0x0d, 0xed, 0xd1, 0xdb, 0xc5, 0xc8, 0x3d, 0x77, 0x67,
-	0x23, 0x77, 0x36, 0xfc, 0x65, 0x71, 0x36, 0x98, 0x4c, 0xdd, 0x91, 0xa5, 0xf3, 0x9a, 0xe1, 0x60,
-	0x36, 0x74, 0xa7, 0x1c, 0x1a, 0x7c, 0x3e, 0xf7, 0xfd, 0xf9, 0x04, 0xbb, 0x23, 0xab, 0xd6, 0xfb,
-	0xe3, 0x00, 0x8c, 0x39, 0x23, 0x09, 0xef, 0x20, 0xf6, 0x22, 0xd9, 0x70, 0x03, 0x8b, 0x31, 0x7a,
-	0x0c, 0x26, 0x57, 0xd9, 0x8b, 0x7d, 0xd1, 0x58, 0xf3, 0x14, 0xc9, 0x4d, 0xf3, 0x02, 0x67, 0x28,
-	0x33, 0xb8, 0xa0, 0xa0, 0xaf, 0xa1, 0x9e, 0x31, 0x8f, 0xe5, 0x99, 0xad, 0x0b, 0xd1, 0x5a, 0x05,
-	0x99, 0xc7, 0xb0, 0xca, 0xa1, 0x9f, 0xe1, 0xa3, 0xb5, 0x94, 0x71, 0xe1, 0x4b, 0x1d, 0x6d, 0x43,
-	0xcc, 0xdd, 0xbe, 0x4f, 0x63, 0x7c, 0xb4, 0xbe, 0x7b, 0x2e, 0x8f, 0xc1, 0xcc, 0xf2, 0x65, 0xc6,
-	0x48, 0x62, 0xd7, 0x84, 0x8e, 0xe5, 0x2d, 0xcd, 0x65, 0x06, 0x17, 0x14, 0xf4, 0x23, 0x1c, 0x66,
-	0xcc, 0xa7, 0x39, 0x5b, 0x64, 0x2c, 0x25, 0x5e, 0x64, 0xd7, 0xcb, 0x6d, 0x4c, 0xe9, 0xc6, 0xa7,
-	0x9b, 0xb9, 0xc8, 0xe0, 0x96, 0x24, 0x4a, 0xa4, 0x0a, 0x49, 0x9a, 0x16, 0x85, 0xe6, 0x07, 0x0b,
-	0x49, 0x9a, 0xaa, 0xc2, 0xa7, 0x60, 0x66, 0xcc, 0x4b, 0x19, 0xf1, 0xed, 0x03, 0x51, 0xd2, 0x71,
-	0xa4, 0xfb, 0x9c, 0xc2, 0x7d, 0xce, 0x45, 0xe1, 0x3e, 0x5c, 0x50, 0xd1, 0xf7, 0x50, 0xe3, 0xa7,
-	0xee, 0xdb, 0x8d, 0xff, 0xad, 0x91, 0xc4, 0x9d, 0xe1, 0xda, 0x5d, 0x7d, 0x67, 0xb8, 0x3e, 0x1c,
-	0x24, 0x29, 0xdd, 0xa4, 0x24, 0xcb, 0xec, 0x4f, 0xc5, 0x44, 0x9f, 0x94, 0xc4, 0x39, 0x57, 0x29,
-	0xbc, 0x23, 0xa1, 0x2f, 0xc1, 0x08, 0x83, 0xf8, 0xda, 0x7e, 0x24, 0xc8, 0xa0, 0x9a, 0xe3, 0x7e,
-	0x14, 0x71, 0xf4, 0x1d, 0x34, 0x29, 0xbb, 0x22, 0xe9, 0x82, 0xa3, 0xcc, 0xfe, 0x4c, 0x08, 0x5e,
-	0xa6, 0x81, 0x48, 0xf3, 0x61, 0xa6, 0x56, 0x4f, 0x48, 0xca, 0xb6, 0xb6, 0x2d, 0x98, 0xff, 0x5a,
-	0x5d, 0xa4, 0xf0, 0x8e, 0xd4, 0xf9, 0x53, 0x03, 0x53, 0x99, 0x08, 0x7d, 0x05, 0x2d, 0x65, 0x23,
-	0xbe, 0x16, 0x77, 0x21, 0x6f, 0xab, 0xa9, 0x62, 0xd3, 0x20, 0x26, 0xc8, 0x02, 0x7d, 0xf5, 0xbb,
-	0xaf, 0xfe, 0x30, 0x3e, 0x44, 0xcf, 0xc0, 0x24, 0xf1, 0x4d, 0x90, 0xd2, 0x58, 0xfd, 0x53, 0xc7,
-	0xff, 0xb5, 0xa7, 0xe3, 0x4a, 0x86, 0x1b, 0xb3, 0x74, 0x8b, 0x0b, 0x7e, 0xe7, 0x39, 0xb4, 0xca,
-	0x09, 0x3e, 0xf9, 0x35, 0xd9, 0x2a, 0xf3, 0xf3, 0x21, 0x6a, 0x43, 0xed, 0xc6, 0x0b, 0x73, 0xa2,
-	0x16, 0x94, 0xe0, 0x79, 0xf5, 0x27, 0xad, 0xb3, 0x05, 0x53, 0x19, 0x0d, 0x75, 0xc1, 0x10, 0x56,
-	0xd4, 0xca, 0x02, 0xf2, 0xe5, 0xc7, 0x15, 0x2c, 0x32, 0x68, 0x00, 0x1f, 0xef, 0xaf, 0xa5, 0xc2,
-	0x4c, 0xd5, 0x87, 0xcc, 0x34, 0xae, 0x60, 0x6b, 0x4f, 0x97, 0xb1, 0xd7, 0x8d, 0x9d, 0xe5, 0x3b,
-	0xaf, 0xe0, 0xa0, 0x38, 0x46, 0xbe, 0x41, 0x46, 0x99, 0x17, 0x8a, 0xc5, 0x6b, 0x58, 0x02, 0xf4,
-	0x05, 0x34, 0x56, 0x34, 0x4a, 0x42, 0xc2, 0x1d, 0x58, 0x15, 0x99, 0x7d, 0xa0, 0xf3, 0x54, 0xd4,
-	0x0b, 0xf9, 0xef, 0xfd, 0xe1, 0xef, 0x6d, 0xba, 0xf7, 0xb7, 0x06, 0x06, 0x3f, 0x63, 0x9e, 0x0e,
-	0xbd, 0x25, 0x09, 0x55, 0x8d, 0x04, 0xe8, 0x18, 0x9a, 0x5e, 0x18, 0x78, 0xd9, 0x42, 0xe6, 0x64,
-	0x29, 0x88, 0xd0, 0x54, 0x10, 0x10, 0xe8, 0x79, 0x1a, 0x8a, 0x5b, 0xa1, 0x31, 0xae, 0x60, 0x0e,
-	0xd0, 0x33, 0x38, 0x0c, 0x45, 0xe3, 0x85, 0x26, 0xc6, 0x07, 0x34, 0x69, 0x85, 0x25, 0x8c, 0x5e,
-	0xc2, 0x51, 0x90, 0xd1, 0xd0, 0x63, 0x64, 0x41, 0x97, 0xbf, 0x92, 0x15, 0xb3, 0x6b, 0x65, 0xb3,
-	0x4f, 0x64, 0xee, 0x9d, 0x48, 0x8d, 0x2b, 0xf8, 0x30, 0x28, 0x07, 0xd0, 0x37, 0x60, 0xfa, 0x91,
-	0x30, 0xb4, 0xba, 0x0c, 0xee, 0x5c, 0xc4, 0xe3, 0x0a, 0xae, 0xfb, 0x11, 0x1f, 0xbd, 0x36, 0x95,
-	0x16, 0x3d, 0x0c, 0xad, 0xf2, 0x7e, 0xd0, 0x23, 0xa8, 0x67, 0x24, 0xbd, 0x21, 0xa9, 0x92, 0x41,
-	0x21, 0x1e, 0x4f, 0x52, 0xb2, 0x0e, 0x6e, 0x95, 0x04, 0x0a, 0xed, 0x84, 0xd6, 0xf7, 0x42, 0xf7,
-	0x5e, 0xc0, 0xe1, 0x9d, 0x7d, 0x3e, 0x38, 0x29, 0x02, 0xe3, 0xca, 0xcb, 0xae, 0x8a, 0x87, 0x85,
-	0x8f, 0x7b, 0x31, 0xd4, 0xe5, 0x6e, 0x1f, 0xac, 0x6a, 0x43, 0xed, 0xb7, 0x9c, 0x64, 0xc5, 0x7b,
-	0x24, 0x01, 0xb2, 0xc1, 0xf4, 0x18, 0x23, 0x51, 0xc2, 0xc4, 0x5e, 0x74, 0x5c, 0x40, 0xee, 0x1a,
-	0x72, 0x4b, 0x56, 0x39, 0x77, 0x9d, 0x38, 0x09, 0x1d, 0xef, 0x03, 0xdf, 0xbe, 0x84, 0xba, 0xbc,
-	0xc4, 0xf9, 0xd3, 0x81, 0x2f, 0x67, 0xb3, 0xc9, 0xec, 0x8d, 0x55, 0xe1, 0x60, 0x7e, 0x39, 0x1c,
-	0xba, 0xf3, 0xb9, 0xa5, 0x71, 0xc0, 0xdf, 0x9b, 0x4b, 0xec, 0x5a, 0x55, 0x0e, 0xf8, 0x2b, 0xc4,
-	0x69, 0xfa, 0xb2, 0x2e, 0x2e, 0xb1, 0x1f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x81, 0x81,
-	0x89, 0xc9, 0x07, 0x00, 0x00,
+	// 1035 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5d, 0x6f, 0xdb, 0x36,
+	0x14, 0xb5, 0x2d, 0xd9, 0x8a, 0xaf, 0x9d, 0xcc, 0xe3, 0xbc, 0x4e, 0x35, 0x86, 0xd5, 0x33, 0x06,
+	0x2c, 0xd8, 0x5a, 0x7b, 0xc8, 0x0a, 0xf4, 0x73, 0x05, 0x52, 0x5b, 0x69, 0x0c, 0xb8, 0x6e, 0x40,
+	0x27, 0x40, 0xf7, 0x64, 0xd0, 0x16, 0xe3, 0x68, 0x91, 0x44, 0x4d, 0xa4, 0xb2, 0xf8, 0x87, 0xed,
+	0x6f, 0xec, 0xf7, 0xec, 0x61, 0x0f, 0x03, 0x3f, 0x24, 0x3b, 0x6d, 0xd2, 0x61, 0x2f, 0x36, 0xcf,
+	0xbd, 0xe7, 0x5e, 0x92, 0x87, 0x87, 0x22, 0xbc, 0x58, 0x05, 0xe2, 0x22, 0x5b, 0xf4, 0x97, 0x2c,
+	0x1a, 0x84, 0xd9, 0x32, 0x50, 0x3f, 0x8f, 0x56, 0x6c, 0xb0, 0x64, 0x51, 0xc4, 0xe2, 0x41, 0x92,
+	0x32, 0xc1, 0x06, 0x51, 0x10, 0xb2, 0x01, 0x89, 0x63, 0x26, 0x88, 0x08, 0x58, 0xcc, 0xfb, 0x2a,
+	0x8c, 0x6c, 0x19, 0xef, 0x3c, 0x58, 0x31, 0xb6, 0x0a, 0xa9, 0xa6, 0x2e, 0xb2, 0xf3, 0x81, 0x08,
+	0x22, 0xca, 0x05, 0x89, 0x12, 0x4d, 0xeb, 0xfd, 0x5d, 0x86, 0xbd, 0x23, 0x12, 0x84, 0x59, 0x4a,
+	0x47, 0x54, 0x90, 0x20, 0xe4, 0xe8, 0x11, 0xd8, 0x62, 0x9d, 0x50, 0xb7, 0xdc, 0x2d, 0xef, 0xef,
+	0x1d, 0xdc, 0xef, 0xcb, 0x46, 0xfd, 0x9b, 0x9c, 0xfe, 0xe9, 0x3a, 0xa1, 0x58, 0xd1, 0x10, 0x02,
+	0x5b, 0xd0, 0x6b, 0xe1, 0x56, 0xba, 0xe5, 0xfd, 0x3a, 0x56, 0x63, 0xf4, 0x0a, 0xda, 0xe7, 0x24,
+	0x08, 0xa9, 0x3f, 0xf7, 0xa3, 0xb9, 0x4f, 0x13, 0x1a, 0xfb, 0x34, 0x5e, 0xae, 0x5d, 0xab, 0x6b,
+	0xed, 0x37, 0x0e, 0x9a, 0xba, 0xe5, 0xe8, 0xed, 0x24, 0x88, 0x2f, 0x31, 0xd2, 0xcc, 0x51, 0x34,
+	0x2a, 0x78, 0xbd, 0x25, 0xd8, 0x72, 0x06, 0xd4, 0x00, 0xe7, 0x8d, 0x37, 0xf5, 0xf0, 0xe1, 0xa4,
+	0x55, 0x42, 0xbb, 0x50, 0xf7, 0xde, 0x0f, 0xbd, 0x93, 0xd3, 0xf1, 0xbb, 0x69, 0xab, 0x8c, 0xea,
+	0x50, 0x1d, 0x4f, 0x8f, 0xf0, 0x61, 0xab, 0x82, 0x5c, 0x68, 0x8f, 0xde, 0xce, 0x47, 0xde, 0x89,
+	0x37, 0x1d, 0x79, 0xd3, 0xe1, 0xaf, 0xf3, 0xa3, 0xc3, 0xf1, 0xc4, 0x1b, 0xb5, 0x2c, 0x59, 0x33,
+	0x3c, 0x9c, 0x0e, 0xbd, 0x89, 0x84, 0xb6, 0xec, 0xe7, 0xbd, 0x3f, 0x19, 0x63, 0x6f, 0xd4, 0xaa,
+	0xf6, 0xfe, 0xaa, 0x83, 0x3d, 0x13, 0x34, 0x91, 0x3b, 0x88, 0x49, 0xa4, 0x37, 0x5c, 0xc7, 0x6a,
+	0x8c, 0xf6, 0xa0, 0x12, 0xf8, 0x2e, 0xa8, 0x48, 0x25, 0xf0, 0xd1, 0x43, 0x70, 0xa4, 0xea, 0x24,
+	0xf6, 0xd5, 0x46, 0x1b, 0x07, 0x48, 0x6f, 0x42, 0x36, 0xe8, 0x0f, 0x75, 0x06, 0xe7, 0x14, 0xf4,
+	0x1d, 0xd4, 0xb8, 0x20, 0x22, 0xe3, 0xae, 0xa5, 0x44, 0x6c, 0xe6, 0x64, 0x19, 0xc3, 0x26, 0x87,
+	0x7e, 0x81, 0xcf, 0xce, 0xb5, 0xac, 0x73, 0x5f, 0xeb, 0xea, 0xda, 0xaa, 0x77, 0xfb, 0x36, 0xcd,
+	0xf1, 0xde, 0xf9, 0xcd, 0x73, 0x7a, 0x08, 0x0e, 0xcf, 0x16, 0x5c, 0xd0, 0xc4, 0xad, 0x76, 0xad,
+	0x0f, 0x96, 0x34, 0xd3, 0x19, 0x9c, 0x53, 0xd0, 0x13, 0xd8, 0xe5, 0xc2, 0x67, 0x99, 0x98, 0x73,
+	0x91, 0x52, 0x12, 0xb9, 0xb5, 0xed, 0x6d, 0x4c, 0xd8, 0xca, 0x67, 0xab, 0x99, 0xca, 0xe0, 0xa6,
+	0x26, 0x6a, 0x64, 0x0a, 0x69, 0x9a, 0xe6, 0x85, 0xce, 0x27, 0x0b, 0x69, 0x9a, 0x9a, 0xc2, 0xc7,
+	0xe0, 0x70, 0x41, 0x52, 0x41, 0x7d, 0x77, 0x47, 0x95, 0x74, 0xfa, 0xda, 0x8d, 0xfd, 0xdc, 0x8d,
+	0xfd, 0xd3, 0xdc, 0x8d, 0x38, 0xa7, 0xa2, 0x9f, 0xa0, 0x2a, 0x5d, 0xe0, 0xbb, 0xf5, 0xff, 0xac,
+	0xd1, 0xc4, 0xc2, 0x80, 0xed, 0xae, 0x55, 0x18, 0x70, 0x00, 0x3b, 0x49, 0xca, 0x56, 0x29, 0xe5,
+	0xdc, 0xfd, 0x52, 0x35, 0xfa, 0x62, 0x4b, 0x9c, 0x13, 0x93, 0xc2, 0x05, 0x09, 0x7d, 0x03, 0x76,
+	0x18, 0xc4, 0x97, 0xee, 0x3d, 0x45, 0x06, 0xb3, 0x39, 0xe9, 0x4f, 0x15, 0x47, 0x3f, 0x42, 0x83,
+	0x89, 0x0b, 0x9a, 0xce, 0x25, 0xe2, 0xee, 0x57, 0x5d, 0xeb, 0x03, 0x1a, 0xa8, 0xb4, 0x1c, 0x72,
+	0x33, 0x7b, 0x42, 0x53, 0xb1, 0x76, 0xdd, 0xae, 0xf5, 0xf1, 0xec, 0x2a, 0x85, 0x0b, 0x12, 0xda,
+	0x07, 0x5b, 0x90, 0x15, 0x77, 0xef, 0x77, 0xad, 0xcd, 0xf1, 0x2b, 0xf2, 0x29, 0x59, 0x71, 0x2f,
+	0x16, 0xe9, 0x1a, 0x2b, 0x06, 0x7a, 0x2a, 0x4f, 0x83, 0x26, 0xf3, 0xa2, 0x7f, 0xe7, 0xee, 0xfe,
+	0x4d, 0xc9, 0xcc, 0x51, 0xe7, 0xcf, 0x32, 0x38, 0xc6, 0xa8, 0xe8, 0x5b, 0x68, 0x1a, 0xab, 0xca,
+	0xfd, 0x48, 0xe7, 0x4b, 0xe9, 0x1a, 0x26, 0x36, 0x09, 0x62, 0x8a, 0x5a, 0x60, 0x2d, 0xff, 0xf0,
+	0xcd, 0xad, 0x96, 0x43, 0xf4, 0x0c, 0x1c, 0x1a, 0x5f, 0x05, 0x29, 0x8b, 0xcd, 0x3d, 0x7e, 0xf0,
+	0xf1, 0x15, 0xe8, 0x7b, 0x9a, 0xa1, 0x97, 0x9c, 0xf3, 0x3b, 0xcf, 0xa1, 0xb9, 0x9d, 0x90, 0xcd,
+	0x2f, 0xe9, 0xda, 0x5c, 0x38, 0x39, 0x44, 0x6d, 0xa8, 0x5e, 0x91, 0x30, 0xa3, 0x66, 0x42, 0x0d,
+	0x9e, 0x57, 0x9e, 0x96, 0x3b, 0x6b, 0x70, 0x8c, 0x99, 0x51, 0x17, 0x6c, 0xf9, 0xaf, 0xea, 0x0a,
+	0xf5, 0xe5, 0xf4, 0xc7, 0x25, 0xac, 0x32, 0xe8, 0x10, 0x3e, 0xdf, 0x7c, 0x0a, 0x73, 0xc3, 0x56,
+	0xee, 0x32, 0xec, 0x71, 0x09, 0xb7, 0x36, 0x74, 0x1d, 0x7b, 0x5d, 0x2f, 0xae, 0x55, 0xe7, 0x15,
+	0xec, 0xe4, 0x56, 0x91, 0x0b, 0x14, 0x4c, 0x90, 0x50, 0x4d, 0x5e, 0xc5, 0x1a, 0xa0, 0xaf, 0xa1,
+	0xbe, 0x64, 0x51, 0x12, 0x52, 0xe9, 0xf2, 0x8a, 0xca, 0x6c, 0x02, 0x9d, 0xc7, 0xb0, 0x93, 0xcb,
+	0x7f, 0xeb, 0x47, 0xe6, 0xd6, 0x4d, 0x77, 0x9e, 0x40, 0xbd, 0x38, 0xf5, 0xff, 0xa3, 0x54, 0xef,
+	0x9f, 0x32, 0xd8, 0xd2, 0x80, 0x92, 0x12, 0x92, 0x05, 0x0d, 0x4d, 0x99, 0x06, 0xe8, 0x01, 0x34,
+	0x48, 0x18, 0x10, 0x3e, 0xd7, 0x39, 0x5d, 0x0e, 0x2a, 0x34, 0x51, 0x04, 0x04, 0x56, 0x96, 0x86,
+	0xea, 0x93, 0x55, 0x3f, 0x2e, 0x61, 0x09, 0xd0, 0x33, 0xd8, 0x0d, 0x95, 0x62, 0xb9, 0x98, 0xf6,
+	0x27, 0xc4, 0x6c, 0x86, 0x5b, 0x18, 0xbd, 0x84, 0xbd, 0x80, 0xb3, 0x90, 0x08, 0x3a, 0x67, 0x8b,
+	0xdf, 0xe8, 0x52, 0xb8, 0xd5, 0xed, 0x9b, 0x38, 0xd6, 0xb9, 0x77, 0x2a, 0x75, 0x5c, 0xc2, 0xbb,
+	0xc1, 0x76, 0x00, 0x7d, 0x0f, 0x8e, 0x1f, 0xa9, 0xdb, 0x66, 0xbe, 0x54, 0x37, 0x5e, 0x8d, 0xe3,
+	0x12, 0xae, 0xf9, 0x91, 0x1c, 0xbd, 0x76, 0x8c, 0x1e, 0x3d, 0x0c, 0xcd, 0xed, 0xf5, 0xa0, 0x7b,
+	0x50, 0xe3, 0x34, 0xbd, 0xa2, 0xa9, 0x91, 0xc1, 0x20, 0x19, 0x4f, 0x52, 0x7a, 0x1e, 0x5c, 0x1b,
+	0x09, 0x0c, 0x2a, 0x4e, 0xc8, 0xda, 0x9c, 0x50, 0xef, 0x05, 0xec, 0xde, 0x58, 0xe7, 0x9d, 0x4d,
+	0x11, 0xd8, 0x17, 0x84, 0x5f, 0xe4, 0xaf, 0xa0, 0x1c, 0xf7, 0x62, 0xa8, 0xe9, 0xd5, 0xde, 0x59,
+	0xd5, 0x86, 0xea, 0xef, 0x19, 0xe5, 0xf9, 0xe3, 0xa9, 0x01, 0x72, 0xc1, 0x21, 0x42, 0xd0, 0x28,
+	0x11, 0x6a, 0x2d, 0x16, 0xce, 0xa1, 0xb4, 0x1b, 0xbd, 0xa6, 0xcb, 0x4c, 0xda, 0x55, 0x9d, 0x84,
+	0x85, 0x37, 0x81, 0x1f, 0x5e, 0x42, 0x4d, 0xbf, 0x30, 0xf2, 0x9d, 0xc3, 0x67, 0xd3, 0xe9, 0x78,
+	0xfa, 0xa6, 0x55, 0x92, 0x60, 0x76, 0x36, 0x1c, 0x7a, 0xb3, 0x59, 0xab, 0x2c, 0x81, 0x7c, 0x1c,
+	0xcf, 0xb0, 0xd7, 0xaa, 0x48, 0x20, 0x9f, 0x4c, 0x49, 0xb3, 0x16, 0x35, 0xf5, 0x85, 0xfd, 0xf9,
+	0xdf, 0x01, 0x00, 0x9a, 0xb2, 0x01, 0x01, 0x76, 0x08, 0x00, 0x00,
 }
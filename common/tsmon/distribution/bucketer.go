@@ -71,6 +71,15 @@ func (b *Bucketer) UnderflowBucket() int { return 0 }
 // OverflowBucket returns the index of the overflow bucket.
 func (b *Bucketer) OverflowBucket() int { return b.numFiniteBuckets + 1 }
 
+// UpperBound returns the exclusive upper bound of the given bucket. The
+// overflow bucket's upper bound is +Inf.
+func (b *Bucketer) UpperBound(bucket int) float64 {
+	if bucket >= b.OverflowBucket() {
+		return math.Inf(1)
+	}
+	return b.lowerBounds[bucket+1]
+}
+
 // Bucket returns the index of the bucket for sample.
 // TODO(dsansome): consider reimplementing sort.Search inline to avoid overhead
 // of calling a function to compare two values.
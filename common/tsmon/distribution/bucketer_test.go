@@ -5,6 +5,7 @@
 package distribution
 
 import (
+	"math"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -69,3 +70,13 @@ func TestGeometricBucketer(t *testing.T) {
 		So(b.Bucket(64), ShouldEqual, 4)
 	})
 }
+
+func TestUpperBound(t *testing.T) {
+	Convey("UpperBound", t, func() {
+		b := FixedWidthBucketer(10, 2)
+		So(b.UpperBound(0), ShouldEqual, 0)
+		So(b.UpperBound(1), ShouldEqual, 10)
+		So(b.UpperBound(2), ShouldEqual, 20)
+		So(math.IsInf(b.UpperBound(3), 1), ShouldBeTrue)
+	})
+}
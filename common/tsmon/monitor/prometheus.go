@@ -0,0 +1,281 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package monitor
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/tsmon/distribution"
+	pb "github.com/luci/luci-go/common/tsmon/ts_mon_proto"
+	"github.com/luci/luci-go/common/tsmon/types"
+)
+
+// PrometheusContentType is the Content-Type of the Prometheus text exposition
+// format served by PrometheusMonitor.
+const PrometheusContentType = "text/plain; version=0.0.4"
+
+// PrometheusMonitor is a Monitor which remembers the cells from the most recent
+// Send and serves them over HTTP in the Prometheus text exposition format.
+//
+// It is meant to be registered as a handler on a URL which Prometheus scrapes,
+// with tsmon flushing to it periodically.
+//
+// Metric fields are exported as labels. Cumulative ints and floats are exported
+// as counters, non-cumulative ones and bools as gauges, and distributions as
+// histograms using the boundaries of their Bucketer. String metrics are
+// exported as gauges with the value 1 and the string in a "value" label.
+//
+// Bucketer boundaries are exclusive upper bounds, while Prometheus "le" bounds
+// are inclusive, so each "le" is the largest float64 below the boundary.
+//
+// Targets are exported as labels prefixed with "target_" (e.g.
+// "target_host_name"), in addition to "job" and "instance" labels Prometheus
+// attaches to everything it scrapes.
+type PrometheusMonitor struct {
+	lock  sync.RWMutex
+	cells []types.Cell
+}
+
+var _ interface {
+	Monitor
+	http.Handler
+} = (*PrometheusMonitor)(nil)
+
+// NewPrometheusMonitor returns a new, empty PrometheusMonitor.
+func NewPrometheusMonitor() *PrometheusMonitor {
+	return &PrometheusMonitor{}
+}
+
+// ChunkSize implements Monitor. All cells are sent in a single chunk, since
+// each Send replaces the previously sent cells.
+func (m *PrometheusMonitor) ChunkSize() int {
+	return 0
+}
+
+// Send implements Monitor.
+func (m *PrometheusMonitor) Send(ctx context.Context, cells []types.Cell) error {
+	cells = append([]types.Cell(nil), cells...)
+	sort.Stable(cellsByName(cells))
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.cells = cells
+	return nil
+}
+
+// Close implements Monitor.
+func (m *PrometheusMonitor) Close() error {
+	return nil
+}
+
+// ServeHTTP implements http.Handler by writing the most recently sent cells.
+func (m *PrometheusMonitor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.lock.RLock()
+	cells := m.cells
+	m.lock.RUnlock()
+
+	buf := bytes.Buffer{}
+	if err := writePrometheus(&buf, cells); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", PrometheusContentType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes()) // if it fails, the client is gone
+}
+
+type cellsByName []types.Cell
+
+func (s cellsByName) Len() int           { return len(s) }
+func (s cellsByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
+func (s cellsByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// writePrometheus writes cells, which must be sorted by metric name, to w in
+// the Prometheus text exposition format.
+func writePrometheus(w io.Writer, cells []types.Cell) error {
+	bw := bufio.NewWriter(w)
+	targetLabels := map[uint64][]string{}
+
+	lastName := ""
+	for _, c := range cells {
+		name := prometheusName(c.Name)
+		if c.Name != lastName {
+			lastName = c.Name
+			if c.Description != "" {
+				if _, err := fmt.Fprintf(bw, "# HELP %s %s\n", name, prometheusHelpEscaper.Replace(c.Description)); err != nil {
+					return err
+				}
+			}
+			if _, err := fmt.Fprintf(bw, "# TYPE %s %s\n", name, prometheusType(c.ValueType)); err != nil {
+				return err
+			}
+		}
+
+		var labels []string
+		if c.Target != nil {
+			h := c.Target.Hash()
+			tl, ok := targetLabels[h]
+			if !ok {
+				tl = prometheusTargetLabels(c.Target)
+				targetLabels[h] = tl
+			}
+			labels = append(labels, tl...)
+		}
+		for i, f := range c.Fields {
+			labels = append(labels, prometheusLabel(prometheusName(f.Name), fmt.Sprint(c.FieldVals[i])))
+		}
+
+		var err error
+		switch c.ValueType {
+		case types.NonCumulativeIntType, types.CumulativeIntType:
+			err = writePrometheusSample(bw, name, labels, float64(c.Value.(int64)))
+		case types.NonCumulativeFloatType, types.CumulativeFloatType:
+			err = writePrometheusSample(bw, name, labels, c.Value.(float64))
+		case types.BoolType:
+			v := 0.0
+			if c.Value.(bool) {
+				v = 1
+			}
+			err = writePrometheusSample(bw, name, labels, v)
+		case types.StringType:
+			labels = append(labels, prometheusLabel("value", c.Value.(string)))
+			err = writePrometheusSample(bw, name, labels, 1)
+		case types.NonCumulativeDistributionType, types.CumulativeDistributionType:
+			err = writePrometheusHistogram(bw, name, labels, c.Value.(*distribution.Distribution))
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+func writePrometheusHistogram(w io.Writer, name string, labels []string, d *distribution.Distribution) error {
+	b := d.Bucketer()
+	buckets := d.Buckets()
+
+	// Prometheus buckets are cumulative, and the overflow bucket is the "+Inf"
+	// bucket which is always present.
+	var count int64
+	for i := 0; i < b.OverflowBucket(); i++ {
+		if i < len(buckets) {
+			count += buckets[i]
+		}
+		// Values equal to the upper bound belong to the next bucket.
+		le := prometheusLabel("le", formatPrometheusValue(math.Nextafter(b.UpperBound(i), math.Inf(-1))))
+		if err := writePrometheusSample(w, name+"_bucket", append(labels[:len(labels):len(labels)], le), float64(count)); err != nil {
+			return err
+		}
+	}
+	le := prometheusLabel("le", "+Inf")
+	if err := writePrometheusSample(w, name+"_bucket", append(labels[:len(labels):len(labels)], le), float64(d.Count())); err != nil {
+		return err
+	}
+	if err := writePrometheusSample(w, name+"_sum", labels, d.Sum()); err != nil {
+		return err
+	}
+	return writePrometheusSample(w, name+"_count", labels, float64(d.Count()))
+}
+
+func writePrometheusSample(w io.Writer, name string, labels []string, value float64) error {
+	var err error
+	if len(labels) == 0 {
+		_, err = fmt.Fprintf(w, "%s %s\n", name, formatPrometheusValue(value))
+	} else {
+		_, err = fmt.Fprintf(w, "%s{%s} %s\n", name, strings.Join(labels, ","), formatPrometheusValue(value))
+	}
+	return err
+}
+
+// prometheusTargetLabels returns labels describing the target.
+func prometheusTargetLabels(t types.Target) []string {
+	c := &pb.MetricsCollection{}
+	t.PopulateProto(c)
+	if task := c.GetTask(); task != nil {
+		return []string{
+			prometheusLabel("target_service_name", task.GetServiceName()),
+			prometheusLabel("target_job_name", task.GetJobName()),
+			prometheusLabel("target_data_center", task.GetDataCenter()),
+			prometheusLabel("target_host_name", task.GetHostName()),
+			prometheusLabel("target_task_num", fmt.Sprint(task.GetTaskNum())),
+		}
+	}
+	if dev := c.GetNetworkDevice(); dev != nil {
+		return []string{
+			prometheusLabel("target_metro", dev.GetMetro()),
+			prometheusLabel("target_role", dev.GetRole()),
+			prometheusLabel("target_hostname", dev.GetHostname()),
+			prometheusLabel("target_hostgroup", dev.GetHostgroup()),
+		}
+	}
+	return nil
+}
+
+func prometheusType(t types.ValueType) string {
+	switch t {
+	case types.CumulativeIntType, types.CumulativeFloatType:
+		return "counter"
+	case types.NonCumulativeDistributionType, types.CumulativeDistributionType:
+		return "histogram"
+	default:
+		return "gauge"
+	}
+}
+
+// prometheusName converts a tsmon metric or field name, such as
+// "/chrome/infra/foo.bar", into a valid Prometheus name, such as
+// "chrome_infra_foo_bar".
+func prometheusName(name string) string {
+	name = strings.TrimLeft(name, "/")
+	ret := make([]byte, 0, len(name)+1)
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '_', c == ':', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		case '0' <= c && c <= '9':
+			if i == 0 {
+				ret = append(ret, '_')
+			}
+		default:
+			c = '_'
+		}
+		ret = append(ret, c)
+	}
+	return string(ret)
+}
+
+var (
+	prometheusHelpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	prometheusLabelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func prometheusLabel(name, value string) string {
+	return fmt.Sprintf(`%s="%s"`, name, prometheusLabelEscaper.Replace(value))
+}
+
+func formatPrometheusValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package monitor
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/tsmon/distribution"
+	"github.com/luci/luci-go/common/tsmon/field"
+	"github.com/luci/luci-go/common/tsmon/target"
+	"github.com/luci/luci-go/common/tsmon/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPrometheusMonitor(t *testing.T) {
+	Convey("PrometheusMonitor", t, func() {
+		ctx := context.Background()
+		m := NewPrometheusMonitor()
+
+		scrape := func() string {
+			rec := httptest.NewRecorder()
+			m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+			So(rec.Code, ShouldEqual, 200)
+			So(rec.Header().Get("Content-Type"), ShouldEqual, PrometheusContentType)
+			return rec.Body.String()
+		}
+
+		cell := func(name string, vt types.ValueType, fields []field.Field, fieldVals []interface{}, value interface{}) types.Cell {
+			return types.Cell{
+				MetricInfo: types.MetricInfo{
+					Name:        name,
+					Description: "desc of " + name,
+					Fields:      fields,
+					ValueType:   vt,
+				},
+				CellData: types.CellData{
+					FieldVals: fieldVals,
+					Value:     value,
+				},
+			}
+		}
+
+		Convey("serves nothing before the first Send", func() {
+			So(scrape(), ShouldEqual, "")
+		})
+
+		Convey("counters and gauges", func() {
+			fields := []field.Field{field.String("host.name"), field.Int("shard")}
+			So(m.Send(ctx, []types.Cell{
+				cell("/test/requests", types.CumulativeIntType, fields, []interface{}{"a", int64(1)}, int64(5)),
+				cell("/test/load", types.NonCumulativeFloatType, nil, nil, 0.5),
+				cell("/test/requests", types.CumulativeIntType, fields, []interface{}{"b\"\n", int64(2)}, int64(7)),
+				cell("/test/up", types.BoolType, nil, nil, true),
+				cell("/test/version", types.StringType, nil, nil, "1.2.3"),
+			}), ShouldBeNil)
+
+			So(scrape(), ShouldEqual, `# HELP test_load desc of /test/load
+# TYPE test_load gauge
+test_load 0.5
+# HELP test_requests desc of /test/requests
+# TYPE test_requests counter
+test_requests{host_name="a",shard="1"} 5
+test_requests{host_name="b\"\n",shard="2"} 7
+# HELP test_up desc of /test/up
+# TYPE test_up gauge
+test_up 1
+# HELP test_version desc of /test/version
+# TYPE test_version gauge
+test_version{value="1.2.3"} 1
+`)
+		})
+
+		Convey("distributions", func() {
+			d := distribution.New(distribution.FixedWidthBucketer(10, 2))
+			d.Add(-1)
+			d.Add(5)
+			d.Add(6)
+			d.Add(10) // bucket [10, 20)
+			d.Add(100)
+
+			So(m.Send(ctx, []types.Cell{
+				cell("/test/latency", types.CumulativeDistributionType,
+					[]field.Field{field.Bool("ok")}, []interface{}{true}, d),
+			}), ShouldBeNil)
+
+			So(scrape(), ShouldEqual, `# HELP test_latency desc of /test/latency
+# TYPE test_latency histogram
+test_latency_bucket{ok="true",le="-5e-324"} 1
+test_latency_bucket{ok="true",le="9.999999999999998"} 3
+test_latency_bucket{ok="true",le="19.999999999999996"} 4
+test_latency_bucket{ok="true",le="+Inf"} 5
+test_latency_sum{ok="true"} 120
+test_latency_count{ok="true"} 5
+`)
+		})
+
+		Convey("targets", func() {
+			c1 := cell("/test/up", types.BoolType, nil, nil, true)
+			c1.Target = &target.Task{ServiceName: "svc", JobName: "job", HostName: "a", TaskNum: 1}
+			c2 := cell("/test/up", types.BoolType, nil, nil, false)
+			c2.Target = &target.NetworkDevice{Hostname: "b"}
+
+			So(m.Send(ctx, []types.Cell{c1, c2}), ShouldBeNil)
+			So(scrape(), ShouldEqual, `# HELP test_up desc of /test/up
+# TYPE test_up gauge
+test_up{target_service_name="svc",target_job_name="job",target_data_center="",target_host_name="a",target_task_num="1"} 1
+test_up{target_metro="",target_role="",target_hostname="b",target_hostgroup=""} 0
+`)
+		})
+
+		Convey("write errors", func() {
+			cells := []types.Cell{cell("/test/a", types.NonCumulativeIntType, nil, nil, int64(1))}
+			So(writePrometheus(failingWriter{}, cells), ShouldEqual, errWriteFailed)
+		})
+
+		Convey("Send replaces previous cells", func() {
+			So(m.Send(ctx, []types.Cell{
+				cell("/test/a", types.NonCumulativeIntType, nil, nil, int64(1)),
+			}), ShouldBeNil)
+			So(m.Send(ctx, []types.Cell{
+				cell("/test/b", types.NonCumulativeIntType, nil, nil, int64(2)),
+			}), ShouldBeNil)
+			So(scrape(), ShouldNotContainSubstring, "test_a")
+			So(scrape(), ShouldContainSubstring, "test_b 2\n")
+		})
+	})
+}
+
+var errWriteFailed = errors.New("write failed")
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errWriteFailed }
+
+func TestPrometheusName(t *testing.T) {
+	Convey("prometheusName", t, func() {
+		So(prometheusName("/chrome/infra/foo.bar"), ShouldEqual, "chrome_infra_foo_bar")
+		So(prometheusName("9lives"), ShouldEqual, "_9lives")
+		So(prometheusName("a:b_c1"), ShouldEqual, "a:b_c1")
+	})
+}
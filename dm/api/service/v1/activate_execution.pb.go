@@ -1,38 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // source: github.com/luci/luci-go/dm/api/service/v1/activate_execution.proto
 
-/*
-Package dm is a generated protocol buffer package.
-
-It is generated from these files:
-	github.com/luci/luci-go/dm/api/service/v1/activate_execution.proto
-	github.com/luci/luci-go/dm/api/service/v1/ensure_graph_data.proto
-	github.com/luci/luci-go/dm/api/service/v1/finish_attempt.proto
-	github.com/luci/luci-go/dm/api/service/v1/graph_data.proto
-	github.com/luci/luci-go/dm/api/service/v1/graph_query.proto
-	github.com/luci/luci-go/dm/api/service/v1/service.proto
-	github.com/luci/luci-go/dm/api/service/v1/types.proto
-	github.com/luci/luci-go/dm/api/service/v1/walk_graph.proto
-
-It has these top-level messages:
-	ActivateExecutionReq
-	TemplateInstantiation
-	EnsureGraphDataReq
-	EnsureGraphDataRsp
-	FinishAttemptReq
-	AbnormalFinish
-	Quest
-	JsonResult
-	Result
-	Attempt
-	Execution
-	GraphData
-	GraphQuery
-	MultiPropertyValue
-	PropertyValue
-	AttemptList
-	WalkGraphReq
-*/
 package dm
 
 import proto "github.com/golang/protobuf/proto"
@@ -44,12 +12,6 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
-
 // ActivateExecutionReq allows a currently-running Execution to activate itself.
 // Doing this allows DM to know that the Execution has started, and also enables
 // the Execution to access other APIs like WalkGraph, AddDeps, and
@@ -88,7 +50,7 @@ type ActivateExecutionReq struct {
 func (m *ActivateExecutionReq) Reset()                    { *m = ActivateExecutionReq{} }
 func (m *ActivateExecutionReq) String() string            { return proto.CompactTextString(m) }
 func (*ActivateExecutionReq) ProtoMessage()               {}
-func (*ActivateExecutionReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+func (*ActivateExecutionReq) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
 
 func (m *ActivateExecutionReq) GetAuth() *Execution_Auth {
 	if m != nil {
@@ -109,10 +71,10 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/activate_execution.proto", fileDescriptor0)
+	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/activate_execution.proto", fileDescriptor1)
 }
 
-var fileDescriptor0 = []byte{
+var fileDescriptor1 = []byte{
 	// 182 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x8e, 0xb1, 0x0a, 0xc2, 0x30,
 	0x10, 0x40, 0x69, 0x11, 0x87, 0x28, 0x0a, 0xc1, 0xa1, 0x38, 0x15, 0x07, 0xed, 0x62, 0x82, 0xba,
@@ -0,0 +1,26 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package dm
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Normalize returns an error iff the CancelAttemptsReq is invalid.
+func (c *CancelAttemptsReq) Normalize() error {
+	if len(c.GetAttempts().GetTo()) == 0 {
+		return errors.New("attempts field is required")
+	}
+	if err := c.Attempts.Normalize(); err != nil {
+		return err
+	}
+	for qst, nums := range c.Attempts.To {
+		if len(nums.Nums) == 0 {
+			return fmt.Errorf("attempts for quest %q must be listed explicitly", qst)
+		}
+	}
+	return nil
+}
@@ -83,3 +83,18 @@ func (s *DecoratedDeps) WalkGraph(c context.Context, req *WalkGraphReq) (rsp *Gr
 	}
 	return
 }
+
+func (s *DecoratedDeps) CancelAttempts(c context.Context, req *CancelAttemptsReq) (rsp *google_protobuf2.Empty, err error) {
+	var newCtx context.Context
+	if s.Prelude != nil {
+		newCtx, err = s.Prelude(c, "CancelAttempts", req)
+	}
+	if err == nil {
+		c = newCtx
+		rsp, err = s.Service.CancelAttempts(c, req)
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(c, "CancelAttempts", rsp, err)
+	}
+	return
+}
@@ -29,7 +29,7 @@ type TemplateInstantiation struct {
 func (m *TemplateInstantiation) Reset()                    { *m = TemplateInstantiation{} }
 func (m *TemplateInstantiation) String() string            { return proto.CompactTextString(m) }
 func (*TemplateInstantiation) ProtoMessage()               {}
-func (*TemplateInstantiation) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
+func (*TemplateInstantiation) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
 
 func (m *TemplateInstantiation) GetProject() string {
 	if m != nil {
@@ -114,7 +114,7 @@ type EnsureGraphDataReq struct {
 func (m *EnsureGraphDataReq) Reset()                    { *m = EnsureGraphDataReq{} }
 func (m *EnsureGraphDataReq) String() string            { return proto.CompactTextString(m) }
 func (*EnsureGraphDataReq) ProtoMessage()               {}
-func (*EnsureGraphDataReq) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1} }
+func (*EnsureGraphDataReq) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1} }
 
 func (m *EnsureGraphDataReq) GetQuest() []*Quest_Desc {
 	if m != nil {
@@ -186,7 +186,7 @@ type EnsureGraphDataReq_Limit struct {
 func (m *EnsureGraphDataReq_Limit) Reset()                    { *m = EnsureGraphDataReq_Limit{} }
 func (m *EnsureGraphDataReq_Limit) String() string            { return proto.CompactTextString(m) }
 func (*EnsureGraphDataReq_Limit) ProtoMessage()               {}
-func (*EnsureGraphDataReq_Limit) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1, 0} }
+func (*EnsureGraphDataReq_Limit) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1, 0} }
 
 func (m *EnsureGraphDataReq_Limit) GetMaxDataSize() uint32 {
 	if m != nil {
@@ -202,7 +202,7 @@ type EnsureGraphDataReq_Include struct {
 func (m *EnsureGraphDataReq_Include) Reset()                    { *m = EnsureGraphDataReq_Include{} }
 func (m *EnsureGraphDataReq_Include) String() string            { return proto.CompactTextString(m) }
 func (*EnsureGraphDataReq_Include) ProtoMessage()               {}
-func (*EnsureGraphDataReq_Include) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1, 1} }
+func (*EnsureGraphDataReq_Include) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{1, 1} }
 
 func (m *EnsureGraphDataReq_Include) GetAttempt() *EnsureGraphDataReq_Include_Options {
 	if m != nil {
@@ -220,7 +220,7 @@ func (m *EnsureGraphDataReq_Include_Options) Reset()         { *m = EnsureGraphD
 func (m *EnsureGraphDataReq_Include_Options) String() string { return proto.CompactTextString(m) }
 func (*EnsureGraphDataReq_Include_Options) ProtoMessage()    {}
 func (*EnsureGraphDataReq_Include_Options) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{1, 1, 0}
+	return fileDescriptor2, []int{1, 1, 0}
 }
 
 func (m *EnsureGraphDataReq_Include_Options) GetResult() bool {
@@ -287,7 +287,7 @@ type EnsureGraphDataRsp struct {
 func (m *EnsureGraphDataRsp) Reset()                    { *m = EnsureGraphDataRsp{} }
 func (m *EnsureGraphDataRsp) String() string            { return proto.CompactTextString(m) }
 func (*EnsureGraphDataRsp) ProtoMessage()               {}
-func (*EnsureGraphDataRsp) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{2} }
+func (*EnsureGraphDataRsp) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{2} }
 
 func (m *EnsureGraphDataRsp) GetAccepted() bool {
 	if m != nil {
@@ -341,10 +341,10 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/ensure_graph_data.proto", fileDescriptor1)
+	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/ensure_graph_data.proto", fileDescriptor2)
 }
 
-var fileDescriptor1 = []byte{
+var fileDescriptor2 = []byte{
 	// 648 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x5b, 0x6f, 0xd3, 0x30,
 	0x18, 0x55, 0x9a, 0xa4, 0x49, 0xdd, 0x76, 0x8b, 0x2c, 0x40, 0x21, 0x42, 0x30, 0x55, 0x0c, 0x95,
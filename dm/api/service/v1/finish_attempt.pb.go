@@ -24,7 +24,7 @@ type FinishAttemptReq struct {
 func (m *FinishAttemptReq) Reset()                    { *m = FinishAttemptReq{} }
 func (m *FinishAttemptReq) String() string            { return proto.CompactTextString(m) }
 func (*FinishAttemptReq) ProtoMessage()               {}
-func (*FinishAttemptReq) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0} }
+func (*FinishAttemptReq) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
 
 func (m *FinishAttemptReq) GetAuth() *Execution_Auth {
 	if m != nil {
@@ -45,10 +45,10 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/finish_attempt.proto", fileDescriptor2)
+	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/finish_attempt.proto", fileDescriptor3)
 }
 
-var fileDescriptor2 = []byte{
+var fileDescriptor3 = []byte{
 	// 186 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0xcd, 0xb1, 0xca, 0xc2, 0x30,
 	0x14, 0x05, 0x60, 0x5a, 0xca, 0x3f, 0xe4, 0x07, 0x91, 0x4c, 0xc5, 0x49, 0x3a, 0x88, 0x8b, 0x09,
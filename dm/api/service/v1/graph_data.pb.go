@@ -117,7 +117,7 @@ var AbnormalFinish_Status_value = map[string]int32{
 func (x AbnormalFinish_Status) String() string {
 	return proto.EnumName(AbnormalFinish_Status_name, int32(x))
 }
-func (AbnormalFinish_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor3, []int{0, 0} }
+func (AbnormalFinish_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor4, []int{0, 0} }
 
 type Attempt_State int32
 
@@ -152,7 +152,7 @@ var Attempt_State_value = map[string]int32{
 func (x Attempt_State) String() string {
 	return proto.EnumName(Attempt_State_name, int32(x))
 }
-func (Attempt_State) EnumDescriptor() ([]byte, []int) { return fileDescriptor3, []int{4, 0} }
+func (Attempt_State) EnumDescriptor() ([]byte, []int) { return fileDescriptor4, []int{4, 0} }
 
 type Attempt_Partial_Result int32
 
@@ -185,7 +185,7 @@ var Attempt_Partial_Result_value = map[string]int32{
 func (x Attempt_Partial_Result) String() string {
 	return proto.EnumName(Attempt_Partial_Result_name, int32(x))
 }
-func (Attempt_Partial_Result) EnumDescriptor() ([]byte, []int) { return fileDescriptor3, []int{4, 3, 0} }
+func (Attempt_Partial_Result) EnumDescriptor() ([]byte, []int) { return fileDescriptor4, []int{4, 3, 0} }
 
 type Execution_State int32
 
@@ -222,7 +222,7 @@ var Execution_State_value = map[string]int32{
 func (x Execution_State) String() string {
 	return proto.EnumName(Execution_State_name, int32(x))
 }
-func (Execution_State) EnumDescriptor() ([]byte, []int) { return fileDescriptor3, []int{5, 0} }
+func (Execution_State) EnumDescriptor() ([]byte, []int) { return fileDescriptor4, []int{5, 0} }
 
 type AbnormalFinish struct {
 	Status AbnormalFinish_Status `protobuf:"varint,1,opt,name=status,enum=dm.AbnormalFinish_Status" json:"status,omitempty"`
@@ -232,7 +232,7 @@ type AbnormalFinish struct {
 func (m *AbnormalFinish) Reset()                    { *m = AbnormalFinish{} }
 func (m *AbnormalFinish) String() string            { return proto.CompactTextString(m) }
 func (*AbnormalFinish) ProtoMessage()               {}
-func (*AbnormalFinish) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{0} }
+func (*AbnormalFinish) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0} }
 
 func (m *AbnormalFinish) GetStatus() AbnormalFinish_Status {
 	if m != nil {
@@ -264,7 +264,7 @@ type Quest struct {
 func (m *Quest) Reset()                    { *m = Quest{} }
 func (m *Quest) String() string            { return proto.CompactTextString(m) }
 func (*Quest) ProtoMessage()               {}
-func (*Quest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
+func (*Quest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1} }
 
 func (m *Quest) GetId() *Quest_ID {
 	if m != nil {
@@ -308,7 +308,7 @@ type Quest_ID struct {
 func (m *Quest_ID) Reset()                    { *m = Quest_ID{} }
 func (m *Quest_ID) String() string            { return proto.CompactTextString(m) }
 func (*Quest_ID) ProtoMessage()               {}
-func (*Quest_ID) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1, 0} }
+func (*Quest_ID) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1, 0} }
 
 func (m *Quest_ID) GetId() string {
 	if m != nil {
@@ -350,7 +350,7 @@ type Quest_Desc struct {
 func (m *Quest_Desc) Reset()                    { *m = Quest_Desc{} }
 func (m *Quest_Desc) String() string            { return proto.CompactTextString(m) }
 func (*Quest_Desc) ProtoMessage()               {}
-func (*Quest_Desc) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1, 1} }
+func (*Quest_Desc) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1, 1} }
 
 func (m *Quest_Desc) GetDistributorConfigName() string {
 	if m != nil {
@@ -394,7 +394,7 @@ type Quest_Desc_Meta struct {
 func (m *Quest_Desc_Meta) Reset()                    { *m = Quest_Desc_Meta{} }
 func (m *Quest_Desc_Meta) String() string            { return proto.CompactTextString(m) }
 func (*Quest_Desc_Meta) ProtoMessage()               {}
-func (*Quest_Desc_Meta) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1, 1, 0} }
+func (*Quest_Desc_Meta) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1, 1, 0} }
 
 func (m *Quest_Desc_Meta) GetAsAccount() string {
 	if m != nil {
@@ -435,12 +435,24 @@ type Quest_Desc_Meta_Retry struct {
 	// The number of times in a row to retry Executions which have an
 	// ABNORMAL_FINISHED status of TIMED_OUT.
 	TimedOut uint32 `protobuf:"varint,4,opt,name=timed_out,json=timedOut" json:"timed_out,omitempty"`
+	// The amount of time to wait before the first retried Execution of an
+	// Attempt. Each consecutive retry doubles this delay, up to max_delay.
+	//
+	// If unset or 0, retried Executions are scheduled immediately.
+	Delay *google_protobuf.Duration `protobuf:"bytes,16,opt,name=delay" json:"delay,omitempty"`
+	// The maximum amount of time to wait before a retried Execution. If
+	// unset or 0, this defaults to 1 hour.
+	MaxDelay *google_protobuf.Duration `protobuf:"bytes,17,opt,name=max_delay,json=maxDelay" json:"max_delay,omitempty"`
+	// If set, only abnormal results whose reason matches this (RE2) regular
+	// expression are retried. Other abnormal results finish the Attempt as
+	// if it had run out of retries.
+	ReasonRegex string `protobuf:"bytes,18,opt,name=reason_regex,json=reasonRegex" json:"reason_regex,omitempty"`
 }
 
 func (m *Quest_Desc_Meta_Retry) Reset()                    { *m = Quest_Desc_Meta_Retry{} }
 func (m *Quest_Desc_Meta_Retry) String() string            { return proto.CompactTextString(m) }
 func (*Quest_Desc_Meta_Retry) ProtoMessage()               {}
-func (*Quest_Desc_Meta_Retry) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1, 1, 0, 0} }
+func (*Quest_Desc_Meta_Retry) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1, 1, 0, 0} }
 
 func (m *Quest_Desc_Meta_Retry) GetFailed() uint32 {
 	if m != nil {
@@ -470,6 +482,27 @@ func (m *Quest_Desc_Meta_Retry) GetTimedOut() uint32 {
 	return 0
 }
 
+func (m *Quest_Desc_Meta_Retry) GetDelay() *google_protobuf.Duration {
+	if m != nil {
+		return m.Delay
+	}
+	return nil
+}
+
+func (m *Quest_Desc_Meta_Retry) GetMaxDelay() *google_protobuf.Duration {
+	if m != nil {
+		return m.MaxDelay
+	}
+	return nil
+}
+
+func (m *Quest_Desc_Meta_Retry) GetReasonRegex() string {
+	if m != nil {
+		return m.ReasonRegex
+	}
+	return ""
+}
+
 // Timing describes the amount of time that Executions for this Quest
 // should have, on the following timeline:
 //   Event: execution sent to distributor
@@ -496,7 +529,7 @@ func (m *Quest_Desc_Meta_Timeouts) Reset()         { *m = Quest_Desc_Meta_Timeou
 func (m *Quest_Desc_Meta_Timeouts) String() string { return proto.CompactTextString(m) }
 func (*Quest_Desc_Meta_Timeouts) ProtoMessage()    {}
 func (*Quest_Desc_Meta_Timeouts) Descriptor() ([]byte, []int) {
-	return fileDescriptor3, []int{1, 1, 0, 1}
+	return fileDescriptor4, []int{1, 1, 0, 1}
 }
 
 func (m *Quest_Desc_Meta_Timeouts) GetStart() *google_protobuf.Duration {
@@ -530,7 +563,7 @@ type Quest_TemplateSpec struct {
 func (m *Quest_TemplateSpec) Reset()                    { *m = Quest_TemplateSpec{} }
 func (m *Quest_TemplateSpec) String() string            { return proto.CompactTextString(m) }
 func (*Quest_TemplateSpec) ProtoMessage()               {}
-func (*Quest_TemplateSpec) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1, 2} }
+func (*Quest_TemplateSpec) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1, 2} }
 
 func (m *Quest_TemplateSpec) GetProject() string {
 	if m != nil {
@@ -569,7 +602,7 @@ type Quest_Data struct {
 func (m *Quest_Data) Reset()                    { *m = Quest_Data{} }
 func (m *Quest_Data) String() string            { return proto.CompactTextString(m) }
 func (*Quest_Data) ProtoMessage()               {}
-func (*Quest_Data) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1, 3} }
+func (*Quest_Data) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{1, 3} }
 
 func (m *Quest_Data) GetCreated() *google_protobuf1.Timestamp {
 	if m != nil {
@@ -613,7 +646,7 @@ type JsonResult struct {
 func (m *JsonResult) Reset()                    { *m = JsonResult{} }
 func (m *JsonResult) String() string            { return proto.CompactTextString(m) }
 func (*JsonResult) ProtoMessage()               {}
-func (*JsonResult) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{2} }
+func (*JsonResult) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{2} }
 
 func (m *JsonResult) GetObject() string {
 	if m != nil {
@@ -645,7 +678,7 @@ type Result struct {
 func (m *Result) Reset()                    { *m = Result{} }
 func (m *Result) String() string            { return proto.CompactTextString(m) }
 func (*Result) ProtoMessage()               {}
-func (*Result) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{3} }
+func (*Result) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{3} }
 
 func (m *Result) GetData() *JsonResult {
 	if m != nil {
@@ -680,7 +713,7 @@ type Attempt struct {
 func (m *Attempt) Reset()                    { *m = Attempt{} }
 func (m *Attempt) String() string            { return proto.CompactTextString(m) }
 func (*Attempt) ProtoMessage()               {}
-func (*Attempt) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4} }
+func (*Attempt) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{4} }
 
 func (m *Attempt) GetId() *Attempt_ID {
 	if m != nil {
@@ -739,7 +772,7 @@ type Attempt_ID struct {
 func (m *Attempt_ID) Reset()                    { *m = Attempt_ID{} }
 func (m *Attempt_ID) String() string            { return proto.CompactTextString(m) }
 func (*Attempt_ID) ProtoMessage()               {}
-func (*Attempt_ID) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4, 0} }
+func (*Attempt_ID) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{4, 0} }
 
 func (m *Attempt_ID) GetQuest() string {
 	if m != nil {
@@ -771,7 +804,7 @@ type Attempt_Data struct {
 func (m *Attempt_Data) Reset()                    { *m = Attempt_Data{} }
 func (m *Attempt_Data) String() string            { return proto.CompactTextString(m) }
 func (*Attempt_Data) ProtoMessage()               {}
-func (*Attempt_Data) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4, 1} }
+func (*Attempt_Data) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{4, 1} }
 
 type isAttempt_Data_AttemptType interface {
 	isAttempt_Data_AttemptType()
@@ -1001,7 +1034,7 @@ type Attempt_Data_Scheduling struct {
 func (m *Attempt_Data_Scheduling) Reset()                    { *m = Attempt_Data_Scheduling{} }
 func (m *Attempt_Data_Scheduling) String() string            { return proto.CompactTextString(m) }
 func (*Attempt_Data_Scheduling) ProtoMessage()               {}
-func (*Attempt_Data_Scheduling) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4, 1, 0} }
+func (*Attempt_Data_Scheduling) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{4, 1, 0} }
 
 // This attempt has a live Execution (with the specified ID). Check the
 // Execution state for more information.
@@ -1012,7 +1045,7 @@ type Attempt_Data_Executing struct {
 func (m *Attempt_Data_Executing) Reset()                    { *m = Attempt_Data_Executing{} }
 func (m *Attempt_Data_Executing) String() string            { return proto.CompactTextString(m) }
 func (*Attempt_Data_Executing) ProtoMessage()               {}
-func (*Attempt_Data_Executing) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4, 1, 1} }
+func (*Attempt_Data_Executing) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{4, 1, 1} }
 
 func (m *Attempt_Data_Executing) GetCurExecutionId() uint32 {
 	if m != nil {
@@ -1029,7 +1062,7 @@ type Attempt_Data_Waiting struct {
 func (m *Attempt_Data_Waiting) Reset()                    { *m = Attempt_Data_Waiting{} }
 func (m *Attempt_Data_Waiting) String() string            { return proto.CompactTextString(m) }
 func (*Attempt_Data_Waiting) ProtoMessage()               {}
-func (*Attempt_Data_Waiting) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4, 1, 2} }
+func (*Attempt_Data_Waiting) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{4, 1, 2} }
 
 func (m *Attempt_Data_Waiting) GetNumWaiting() uint32 {
 	if m != nil {
@@ -1052,7 +1085,7 @@ type Attempt_Data_Finished struct {
 func (m *Attempt_Data_Finished) Reset()                    { *m = Attempt_Data_Finished{} }
 func (m *Attempt_Data_Finished) String() string            { return proto.CompactTextString(m) }
 func (*Attempt_Data_Finished) ProtoMessage()               {}
-func (*Attempt_Data_Finished) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4, 1, 3} }
+func (*Attempt_Data_Finished) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{4, 1, 3} }
 
 func (m *Attempt_Data_Finished) GetData() *JsonResult {
 	if m != nil {
@@ -1081,7 +1114,7 @@ type Attempt_Partial struct {
 func (m *Attempt_Partial) Reset()                    { *m = Attempt_Partial{} }
 func (m *Attempt_Partial) String() string            { return proto.CompactTextString(m) }
 func (*Attempt_Partial) ProtoMessage()               {}
-func (*Attempt_Partial) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{4, 3} }
+func (*Attempt_Partial) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{4, 3} }
 
 func (m *Attempt_Partial) GetData() bool {
 	if m != nil {
@@ -1129,7 +1162,7 @@ type Execution struct {
 func (m *Execution) Reset()                    { *m = Execution{} }
 func (m *Execution) String() string            { return proto.CompactTextString(m) }
 func (*Execution) ProtoMessage()               {}
-func (*Execution) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5} }
+func (*Execution) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{5} }
 
 func (m *Execution) GetId() *Execution_ID {
 	if m != nil {
@@ -1162,7 +1195,7 @@ type Execution_Auth struct {
 func (m *Execution_Auth) Reset()                    { *m = Execution_Auth{} }
 func (m *Execution_Auth) String() string            { return proto.CompactTextString(m) }
 func (*Execution_Auth) ProtoMessage()               {}
-func (*Execution_Auth) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5, 0} }
+func (*Execution_Auth) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{5, 0} }
 
 func (m *Execution_Auth) GetId() *Execution_ID {
 	if m != nil {
@@ -1187,7 +1220,7 @@ type Execution_ID struct {
 func (m *Execution_ID) Reset()                    { *m = Execution_ID{} }
 func (m *Execution_ID) String() string            { return proto.CompactTextString(m) }
 func (*Execution_ID) ProtoMessage()               {}
-func (*Execution_ID) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5, 1} }
+func (*Execution_ID) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{5, 1} }
 
 func (m *Execution_ID) GetQuest() string {
 	if m != nil {
@@ -1221,12 +1254,15 @@ type Execution_Data struct {
 	//	*Execution_Data_Finished_
 	//	*Execution_Data_AbnormalFinish
 	ExecutionType isExecution_Data_ExecutionType `protobuf_oneof:"execution_type"`
+	// Retry is set iff this Execution finished abnormally and DM retried the
+	// Attempt with a new Execution.
+	Retry *Execution_Data_Retry `protobuf:"bytes,9,opt,name=retry" json:"retry,omitempty"`
 }
 
 func (m *Execution_Data) Reset()                    { *m = Execution_Data{} }
 func (m *Execution_Data) String() string            { return proto.CompactTextString(m) }
 func (*Execution_Data) ProtoMessage()               {}
-func (*Execution_Data) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5, 2} }
+func (*Execution_Data) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{5, 2} }
 
 type isExecution_Data_ExecutionType interface {
 	isExecution_Data_ExecutionType()
@@ -1317,6 +1353,13 @@ func (m *Execution_Data) GetAbnormalFinish() *AbnormalFinish {
 	return nil
 }
 
+func (m *Execution_Data) GetRetry() *Execution_Data_Retry {
+	if m != nil {
+		return m.Retry
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Execution_Data) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Execution_Data_OneofMarshaler, _Execution_Data_OneofUnmarshaler, _Execution_Data_OneofSizer, []interface{}{
@@ -1459,7 +1502,7 @@ func (m *Execution_Data_DistributorInfo) Reset()         { *m = Execution_Data_D
 func (m *Execution_Data_DistributorInfo) String() string { return proto.CompactTextString(m) }
 func (*Execution_Data_DistributorInfo) ProtoMessage()    {}
 func (*Execution_Data_DistributorInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor3, []int{5, 2, 0}
+	return fileDescriptor4, []int{5, 2, 0}
 }
 
 func (m *Execution_Data_DistributorInfo) GetConfigName() string {
@@ -1496,7 +1539,7 @@ type Execution_Data_Scheduling struct {
 func (m *Execution_Data_Scheduling) Reset()                    { *m = Execution_Data_Scheduling{} }
 func (m *Execution_Data_Scheduling) String() string            { return proto.CompactTextString(m) }
 func (*Execution_Data_Scheduling) ProtoMessage()               {}
-func (*Execution_Data_Scheduling) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5, 2, 1} }
+func (*Execution_Data_Scheduling) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{5, 2, 1} }
 
 type Execution_Data_Running struct {
 }
@@ -1504,7 +1547,7 @@ type Execution_Data_Running struct {
 func (m *Execution_Data_Running) Reset()                    { *m = Execution_Data_Running{} }
 func (m *Execution_Data_Running) String() string            { return proto.CompactTextString(m) }
 func (*Execution_Data_Running) ProtoMessage()               {}
-func (*Execution_Data_Running) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5, 2, 2} }
+func (*Execution_Data_Running) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{5, 2, 2} }
 
 type Execution_Data_Stopping struct {
 }
@@ -1512,7 +1555,7 @@ type Execution_Data_Stopping struct {
 func (m *Execution_Data_Stopping) Reset()                    { *m = Execution_Data_Stopping{} }
 func (m *Execution_Data_Stopping) String() string            { return proto.CompactTextString(m) }
 func (*Execution_Data_Stopping) ProtoMessage()               {}
-func (*Execution_Data_Stopping) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5, 2, 3} }
+func (*Execution_Data_Stopping) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{5, 2, 3} }
 
 type Execution_Data_Finished struct {
 	Data *JsonResult `protobuf:"bytes,1,opt,name=data" json:"data,omitempty"`
@@ -1521,7 +1564,7 @@ type Execution_Data_Finished struct {
 func (m *Execution_Data_Finished) Reset()                    { *m = Execution_Data_Finished{} }
 func (m *Execution_Data_Finished) String() string            { return proto.CompactTextString(m) }
 func (*Execution_Data_Finished) ProtoMessage()               {}
-func (*Execution_Data_Finished) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{5, 2, 4} }
+func (*Execution_Data_Finished) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{5, 2, 4} }
 
 func (m *Execution_Data_Finished) GetData() *JsonResult {
 	if m != nil {
@@ -1530,6 +1573,34 @@ func (m *Execution_Data_Finished) GetData() *JsonResult {
 	return nil
 }
 
+// Retry describes the retry that DM scheduled after this Execution
+// finished abnormally.
+type Execution_Data_Retry struct {
+	// Reason is a human-readable description of why DM retried.
+	Reason string `protobuf:"bytes,1,opt,name=reason" json:"reason,omitempty"`
+	// Delay is how long DM waited before scheduling the next Execution.
+	Delay *google_protobuf.Duration `protobuf:"bytes,2,opt,name=delay" json:"delay,omitempty"`
+}
+
+func (m *Execution_Data_Retry) Reset()                    { *m = Execution_Data_Retry{} }
+func (m *Execution_Data_Retry) String() string            { return proto.CompactTextString(m) }
+func (*Execution_Data_Retry) ProtoMessage()               {}
+func (*Execution_Data_Retry) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{5, 2, 5} }
+
+func (m *Execution_Data_Retry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Execution_Data_Retry) GetDelay() *google_protobuf.Duration {
+	if m != nil {
+		return m.Delay
+	}
+	return nil
+}
+
 // GraphData defines all of the DM graph data that may be returned from DM.
 //
 // Currently only WalkGraph returns GraphData, but in the future other APIs will
@@ -1565,7 +1636,7 @@ type GraphData struct {
 func (m *GraphData) Reset()                    { *m = GraphData{} }
 func (m *GraphData) String() string            { return proto.CompactTextString(m) }
 func (*GraphData) ProtoMessage()               {}
-func (*GraphData) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{6} }
+func (*GraphData) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{6} }
 
 func (m *GraphData) GetQuests() map[string]*Quest {
 	if m != nil {
@@ -1617,6 +1688,7 @@ func init() {
 	proto.RegisterType((*Execution_Data_Running)(nil), "dm.Execution.Data.Running")
 	proto.RegisterType((*Execution_Data_Stopping)(nil), "dm.Execution.Data.Stopping")
 	proto.RegisterType((*Execution_Data_Finished)(nil), "dm.Execution.Data.Finished")
+	proto.RegisterType((*Execution_Data_Retry)(nil), "dm.Execution.Data.Retry")
 	proto.RegisterType((*GraphData)(nil), "dm.GraphData")
 	proto.RegisterEnum("dm.AbnormalFinish_Status", AbnormalFinish_Status_name, AbnormalFinish_Status_value)
 	proto.RegisterEnum("dm.Attempt_State", Attempt_State_name, Attempt_State_value)
@@ -1625,120 +1697,125 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/graph_data.proto", fileDescriptor3)
-}
-
-var fileDescriptor3 = []byte{
-	// 1763 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4b, 0x93, 0x1b, 0x49,
-	0x11, 0x1e, 0x8d, 0x5e, 0xad, 0x9c, 0x91, 0x46, 0xd4, 0x7a, 0xbd, 0x72, 0x7b, 0xd7, 0x36, 0x82,
-	0x05, 0x87, 0xc1, 0x52, 0xd8, 0x8b, 0xcd, 0xe2, 0x8d, 0x85, 0x90, 0xa7, 0x7b, 0x56, 0xbd, 0x21,
-	0x69, 0x86, 0x92, 0x66, 0xed, 0xd8, 0x4b, 0x47, 0xa9, 0xbb, 0x34, 0x6a, 0xac, 0x7e, 0xd0, 0x5d,
-	0x6d, 0x33, 0xdc, 0xb8, 0x72, 0xe5, 0xc0, 0x85, 0xbf, 0xc0, 0x5f, 0xe0, 0x97, 0xc0, 0x9d, 0xe0,
-	0xc0, 0x8d, 0x08, 0x8e, 0x44, 0x3d, 0xfa, 0xa1, 0x79, 0x38, 0xec, 0xe0, 0xb0, 0x17, 0x45, 0x67,
-	0xe5, 0x97, 0xf5, 0xf8, 0x2a, 0xf3, 0xab, 0x14, 0x3c, 0x3b, 0xf3, 0xd8, 0x3a, 0x5d, 0x0e, 0x9c,
-	0xd0, 0x1f, 0x6e, 0x52, 0xc7, 0x13, 0x3f, 0x0f, 0xcf, 0xc2, 0xa1, 0xeb, 0x0f, 0x49, 0xe4, 0x0d,
-	0x13, 0x1a, 0xbf, 0xf6, 0x1c, 0x3a, 0x7c, 0xfd, 0x68, 0x78, 0x16, 0x93, 0x68, 0x6d, 0xbb, 0x84,
-	0x91, 0x41, 0x14, 0x87, 0x2c, 0x44, 0xbb, 0xae, 0xaf, 0xdf, 0x39, 0x0b, 0xc3, 0xb3, 0x0d, 0x1d,
-	0x8a, 0x91, 0x65, 0xba, 0x1a, 0xba, 0x69, 0x4c, 0x98, 0x17, 0x06, 0x12, 0xa3, 0xdf, 0xbd, 0xe8,
-	0x67, 0x9e, 0x4f, 0x13, 0x46, 0xfc, 0x48, 0x01, 0x9e, 0xbc, 0xfb, 0x06, 0xd8, 0x79, 0x44, 0x13,
-	0x19, 0xd6, 0xff, 0x67, 0x05, 0x3a, 0xa3, 0x65, 0x10, 0xc6, 0x3e, 0xd9, 0x1c, 0x79, 0x81, 0x97,
-	0xac, 0xd1, 0x23, 0x68, 0x24, 0x8c, 0xb0, 0x34, 0xe9, 0x55, 0xee, 0x55, 0xee, 0x77, 0x1e, 0xdf,
-	0x1a, 0xb8, 0xfe, 0x60, 0x1b, 0x33, 0x98, 0x0b, 0x00, 0x56, 0x40, 0x74, 0x13, 0x1a, 0x31, 0x25,
-	0x49, 0x18, 0xf4, 0x76, 0xef, 0x55, 0xee, 0xb7, 0xb0, 0xb2, 0xfa, 0x7f, 0xac, 0x40, 0x43, 0x42,
-	0xd1, 0x1e, 0x34, 0xad, 0xd9, 0x37, 0xa3, 0x89, 0x65, 0x74, 0x77, 0x10, 0x40, 0xe3, 0x68, 0x64,
-	0x4d, 0x4c, 0xa3, 0x5b, 0xe1, 0x8e, 0x43, 0x3c, 0x9a, 0x8f, 0x4d, 0xa3, 0xbb, 0xcb, 0x0d, 0xf3,
-	0xe5, 0x89, 0x85, 0x4d, 0xa3, 0x5b, 0x45, 0x6d, 0x68, 0x2d, 0xac, 0xa9, 0x69, 0xd8, 0xc7, 0xa7,
-	0x8b, 0x6e, 0x8d, 0x9b, 0x87, 0xa3, 0xd9, 0xa1, 0x39, 0xe1, 0x71, 0x75, 0xb4, 0x0f, 0x1a, 0x36,
-	0xbf, 0x36, 0x0f, 0x17, 0xa6, 0xd1, 0x6d, 0xf0, 0xc0, 0xa9, 0x35, 0x9f, 0x5b, 0xb3, 0xaf, 0xba,
-	0x4d, 0x74, 0x03, 0xba, 0xd8, 0x9c, 0x9f, 0x4e, 0x16, 0xf6, 0x74, 0x34, 0x39, 0x3a, 0xc6, 0x53,
-	0xd3, 0xe8, 0x6a, 0xfd, 0x7f, 0x69, 0x50, 0xff, 0x75, 0x4a, 0x13, 0x86, 0x3e, 0x86, 0x5d, 0xcf,
-	0x15, 0xa7, 0xdb, 0x7b, 0xbc, 0xcf, 0x4f, 0x27, 0x86, 0x07, 0x96, 0x81, 0x77, 0x3d, 0x17, 0x75,
-	0xa1, 0x6a, 0xcc, 0x4c, 0x71, 0x12, 0x0d, 0xf3, 0x4f, 0xd4, 0x87, 0x1a, 0xbf, 0xae, 0x5e, 0x55,
-	0x44, 0x74, 0x8a, 0x08, 0x83, 0x30, 0x82, 0x85, 0x0f, 0x7d, 0x06, 0x1a, 0x61, 0x8c, 0xfa, 0x11,
-	0x4b, 0x7a, 0xb5, 0x7b, 0xd5, 0xfb, 0x7b, 0x8f, 0x3f, 0x2a, 0x70, 0x23, 0xe5, 0x31, 0x03, 0x16,
-	0x9f, 0xe3, 0x1c, 0x88, 0x7a, 0xd0, 0x8c, 0x48, 0xcc, 0x3c, 0xb2, 0xe9, 0x75, 0xc5, 0x72, 0x99,
-	0xa9, 0xdf, 0x80, 0x5d, 0xcb, 0x40, 0x9d, 0x7c, 0xa3, 0x2d, 0xbe, 0x35, 0xfd, 0x1f, 0x35, 0xa8,
-	0x19, 0x34, 0x71, 0xd0, 0x53, 0xf8, 0xc8, 0xf5, 0x12, 0x16, 0x7b, 0xcb, 0x94, 0x85, 0xb1, 0xed,
-	0x84, 0xc1, 0xca, 0x3b, 0xb3, 0x03, 0xe2, 0x53, 0x85, 0xfe, 0xb0, 0xe4, 0x3e, 0x14, 0xde, 0x19,
-	0xf1, 0x29, 0xba, 0x03, 0x10, 0x91, 0x98, 0xf8, 0x94, 0xd1, 0x38, 0x51, 0x97, 0x55, 0x1a, 0x41,
-	0x4f, 0xe0, 0x66, 0x79, 0xde, 0x12, 0xb6, 0x7a, 0x69, 0xda, 0x93, 0x22, 0xec, 0xc7, 0x50, 0xf3,
-	0x29, 0x23, 0xbd, 0x9a, 0x20, 0xe8, 0x83, 0x12, 0x41, 0x34, 0x71, 0x06, 0x53, 0xca, 0x59, 0xe2,
-	0x00, 0xfd, 0x2f, 0x55, 0xa8, 0x71, 0x13, 0x7d, 0x02, 0x40, 0x12, 0x9b, 0x38, 0x4e, 0x98, 0x06,
-	0x4c, 0xed, 0xb9, 0x45, 0x92, 0x91, 0x1c, 0x40, 0x43, 0xa8, 0xc7, 0x94, 0xc5, 0xe7, 0x62, 0x8b,
-	0x7b, 0x32, 0x05, 0x2f, 0xcc, 0x38, 0xc0, 0x1c, 0x80, 0x25, 0x0e, 0x7d, 0x0e, 0x1a, 0xaf, 0x88,
-	0x30, 0x65, 0x89, 0xba, 0xa6, 0x8f, 0xaf, 0x8a, 0x59, 0x28, 0x0c, 0xce, 0xd1, 0x7a, 0x04, 0x75,
-	0x31, 0x13, 0x4f, 0xe2, 0x15, 0xf1, 0x36, 0x54, 0x12, 0xde, 0xc6, 0xca, 0xe2, 0x97, 0xe4, 0xc4,
-	0x24, 0x59, 0x53, 0x57, 0xec, 0xa6, 0x8d, 0x33, 0x93, 0x7b, 0xe8, 0xef, 0x22, 0x2f, 0xa6, 0xae,
-	0x58, 0xb3, 0x8d, 0x33, 0x13, 0xdd, 0x86, 0x16, 0x5f, 0xc0, 0xb5, 0xc3, 0x94, 0x09, 0x56, 0xda,
-	0x72, 0x45, 0xf7, 0x38, 0x65, 0xfa, 0x9f, 0x2b, 0xa0, 0x65, 0x1b, 0xe1, 0x27, 0x4d, 0x18, 0x89,
-	0x99, 0x4a, 0xc7, 0x5b, 0x03, 0x59, 0xe8, 0x83, 0xac, 0xd0, 0x07, 0x86, 0x12, 0x02, 0x2c, 0x71,
-	0xe8, 0x27, 0x50, 0x8d, 0xd3, 0x20, 0x27, 0xe6, 0x5a, 0x38, 0x47, 0xa1, 0x87, 0x50, 0x4b, 0x58,
-	0x18, 0x29, 0x4a, 0xde, 0x82, 0x16, 0x30, 0x7d, 0x0d, 0xfb, 0x0b, 0xea, 0x47, 0x1b, 0xc2, 0xe8,
-	0x3c, 0xa2, 0x8e, 0xc8, 0xcf, 0x38, 0xfc, 0x0d, 0x75, 0xb2, 0x2b, 0xca, 0x4c, 0x5e, 0x24, 0x31,
-	0x5d, 0xa9, 0x0c, 0xe2, 0x9f, 0x1c, 0xfb, 0x9a, 0xc6, 0x89, 0x17, 0x06, 0x2a, 0x57, 0x32, 0x13,
-	0x21, 0xa8, 0x89, 0xcc, 0xac, 0x89, 0x61, 0xf1, 0xad, 0xff, 0xa9, 0x02, 0x35, 0x5e, 0x3d, 0xe8,
-	0x67, 0x9c, 0x5d, 0x4a, 0x18, 0xcd, 0x0a, 0x52, 0xbf, 0xb4, 0xc9, 0x45, 0x26, 0x75, 0x38, 0x83,
-	0x8a, 0x8a, 0xa4, 0x89, 0xa3, 0x58, 0xe8, 0x6c, 0x5f, 0x35, 0x16, 0x3e, 0xf4, 0x08, 0xb4, 0x65,
-	0xea, 0x6d, 0x98, 0xbd, 0x3c, 0xef, 0x55, 0x45, 0x45, 0xde, 0x2c, 0x70, 0xe5, 0x63, 0xe2, 0xa6,
-	0xc0, 0x3d, 0x3f, 0xd7, 0xc7, 0xd0, 0xde, 0x2a, 0x55, 0x7e, 0xcc, 0x57, 0xf4, 0x5c, 0x25, 0x04,
-	0xff, 0x44, 0xdf, 0x87, 0xfa, 0x6b, 0xb2, 0x49, 0xa9, 0x5a, 0x7a, 0x4f, 0x88, 0xa3, 0x8c, 0xc1,
-	0xd2, 0xf3, 0x6c, 0xf7, 0xf3, 0x4a, 0x9f, 0x01, 0x7c, 0x9d, 0x84, 0x01, 0xa6, 0x49, 0xba, 0x61,
-	0x3c, 0xb5, 0xc2, 0x65, 0x89, 0x46, 0x65, 0x71, 0x66, 0x12, 0xef, 0xf7, 0x54, 0xe5, 0x95, 0xf8,
-	0x46, 0xcf, 0x00, 0x44, 0x16, 0x89, 0x7b, 0x51, 0x17, 0xf7, 0x36, 0x4e, 0x4a, 0xe8, 0xbe, 0x07,
-	0x0d, 0xb5, 0x62, 0x26, 0x59, 0x95, 0x82, 0xa0, 0x62, 0x3f, 0x4a, 0xb2, 0xbe, 0x80, 0x03, 0xa2,
-	0x64, 0xdd, 0x5e, 0x09, 0x5d, 0x57, 0x87, 0x42, 0x97, 0x15, 0x1f, 0x77, 0xc8, 0x96, 0xdd, 0xff,
-	0x37, 0x40, 0x53, 0x9d, 0x1b, 0xdd, 0x29, 0xe9, 0x69, 0xa7, 0x44, 0xc8, 0xf5, 0x8a, 0xfa, 0xc3,
-	0x2d, 0x45, 0xed, 0x96, 0x63, 0x4a, 0x9a, 0xfa, 0x05, 0xa7, 0x82, 0x3a, 0x29, 0x3f, 0x5b, 0xa6,
-	0xaa, 0xb7, 0xcb, 0x58, 0x33, 0xf7, 0x4a, 0x65, 0x2d, 0xc1, 0xd1, 0x03, 0xd0, 0x56, 0x6f, 0x5c,
-	0xdb, 0xa5, 0x51, 0xd2, 0xab, 0x8b, 0x65, 0x0e, 0x4a, 0xa1, 0x13, 0x2f, 0x61, 0xb8, 0xb9, 0x7a,
-	0xe3, 0x1a, 0x34, 0x4a, 0xd0, 0x4f, 0xa1, 0xb5, 0x24, 0xce, 0x2b, 0x09, 0x6e, 0x5c, 0x0d, 0xd6,
-	0x38, 0x42, 0xa0, 0x1f, 0x6e, 0xab, 0xb6, 0x12, 0xbc, 0x6c, 0x4f, 0x27, 0xd2, 0x55, 0x48, 0xf9,
-	0x03, 0x21, 0xe5, 0x37, 0xa0, 0xfe, 0x5b, 0x9e, 0x79, 0x2a, 0x03, 0xa4, 0xa1, 0x04, 0x5e, 0x5e,
-	0x3f, 0x17, 0xf8, 0xff, 0xd4, 0xfe, 0xaf, 0xb2, 0x78, 0x0a, 0x9a, 0x1f, 0xba, 0xde, 0xca, 0x53,
-	0x5a, 0xf5, 0xf6, 0xb0, 0x1c, 0x8b, 0x3e, 0x85, 0x4e, 0x90, 0xfa, 0x76, 0x89, 0x6c, 0xa9, 0x67,
-	0xed, 0x20, 0xf5, 0x0b, 0x8e, 0xd1, 0x97, 0x00, 0x89, 0xb3, 0xa6, 0x6e, 0xba, 0xf1, 0x82, 0x33,
-	0x45, 0xea, 0xed, 0x8b, 0x77, 0x37, 0x98, 0xe7, 0x90, 0xf1, 0x0e, 0x2e, 0x05, 0xa0, 0x67, 0xd0,
-	0x52, 0x2b, 0x04, 0x67, 0x8a, 0x65, 0xfd, 0x52, 0xb4, 0x99, 0x21, 0xc6, 0x3b, 0xb8, 0x80, 0x73,
-	0x3e, 0xde, 0x10, 0x4f, 0x44, 0x36, 0x45, 0x64, 0xef, 0x52, 0xe4, 0x0b, 0xe9, 0x1f, 0xef, 0xe0,
-	0x0c, 0x8a, 0x7e, 0x0e, 0x9a, 0x4c, 0x6c, 0xea, 0xf6, 0xb4, 0xe2, 0x25, 0xd9, 0x0a, 0x3b, 0x52,
-	0x80, 0xf1, 0x0e, 0xce, 0xc1, 0xe8, 0xcb, 0xcb, 0xa5, 0xd1, 0xba, 0xae, 0x34, 0xc6, 0x3b, 0x17,
-	0x8b, 0x43, 0xdf, 0x07, 0x28, 0x58, 0xd0, 0x9f, 0x40, 0x2b, 0x3f, 0x15, 0xba, 0x0f, 0x5d, 0x27,
-	0x8d, 0x0b, 0xaa, 0x6d, 0x2f, 0x7b, 0x6f, 0x3a, 0x4e, 0x1a, 0xe7, 0x64, 0x5b, 0xae, 0xfe, 0x00,
-	0x9a, 0xea, 0x48, 0xe8, 0x2e, 0xec, 0xf1, 0xfb, 0xc9, 0x18, 0x90, 0x78, 0x08, 0x52, 0x5f, 0x01,
-	0xf4, 0x01, 0x68, 0xd9, 0x39, 0xde, 0xa5, 0xf4, 0x9f, 0x77, 0x60, 0x5f, 0x35, 0x21, 0x36, 0xef,
-	0x06, 0xf5, 0x09, 0x1c, 0x5c, 0xa8, 0xa5, 0x2b, 0xa4, 0xef, 0x07, 0xdb, 0xd2, 0xd7, 0xe6, 0x33,
-	0xe7, 0x51, 0x25, 0xf1, 0xd3, 0xff, 0x5b, 0x81, 0xa6, 0x2a, 0x03, 0x2e, 0x71, 0xf9, 0x6e, 0x34,
-	0x55, 0xd7, 0x77, 0xb6, 0xea, 0x5a, 0xca, 0x42, 0xb9, 0x74, 0x6f, 0x95, 0x4a, 0xb7, 0x2a, 0xfb,
-	0xa2, 0xac, 0x52, 0x6f, 0x97, 0x2b, 0xb5, 0x26, 0x7c, 0x45, 0x61, 0x3e, 0xe6, 0x6d, 0x28, 0x3f,
-	0xa5, 0xc8, 0xcd, 0xce, 0x76, 0x76, 0xa9, 0x0d, 0x0d, 0x14, 0x0f, 0x0a, 0xd9, 0x9f, 0xe6, 0x92,
-	0x09, 0xd0, 0x98, 0x1c, 0x8f, 0x0c, 0x93, 0x37, 0xa8, 0x1d, 0x80, 0xd9, 0xf1, 0xc2, 0x56, 0x76,
-	0x05, 0x21, 0xe8, 0x70, 0x7b, 0x74, 0xba, 0x18, 0x1f, 0x63, 0xeb, 0x5b, 0xd1, 0xab, 0x7e, 0x00,
-	0x07, 0xc6, 0x68, 0x31, 0xb2, 0xe7, 0xd6, 0xb7, 0xa6, 0x3d, 0xb1, 0xa6, 0xd6, 0xa2, 0x5b, 0xed,
-	0xbf, 0x84, 0x3a, 0x6f, 0x78, 0x29, 0x9f, 0x61, 0x7e, 0x38, 0x36, 0x8d, 0xd3, 0x09, 0xef, 0x49,
-	0x77, 0x78, 0xf7, 0x6a, 0xbe, 0x34, 0x0f, 0x4f, 0x17, 0xdc, 0x14, 0x5d, 0xef, 0x8b, 0x91, 0x25,
-	0x8c, 0x5d, 0xde, 0xca, 0x1e, 0x59, 0x33, 0x4b, 0xf4, 0xc0, 0x55, 0xf4, 0x21, 0x7c, 0x6f, 0xf4,
-	0x7c, 0x76, 0x8c, 0xa7, 0xa3, 0x89, 0x9d, 0x0f, 0xd7, 0xfa, 0x7f, 0xd5, 0xf2, 0x34, 0x0a, 0x03,
-	0x74, 0xaf, 0x24, 0xb9, 0xdd, 0xad, 0x8b, 0xc8, 0x44, 0xf7, 0x47, 0x8a, 0xf8, 0x92, 0xa4, 0x17,
-	0x98, 0x92, 0xc8, 0x5e, 0xdf, 0x83, 0xfe, 0x12, 0x6a, 0xa3, 0x94, 0xad, 0xdf, 0x61, 0xad, 0x1b,
-	0x50, 0x67, 0xe1, 0x2b, 0x2a, 0xbb, 0x92, 0x7d, 0x2c, 0x0d, 0xdd, 0x78, 0x8b, 0xf0, 0xf5, 0xa0,
-	0xa9, 0x12, 0x30, 0x6b, 0xaa, 0x94, 0xa9, 0x24, 0xb1, 0x9a, 0x4b, 0xe2, 0xdf, 0xeb, 0xdf, 0x89,
-	0x24, 0x4e, 0xa1, 0x5b, 0xee, 0x84, 0xbd, 0x60, 0x15, 0xaa, 0xd7, 0xaa, 0x7f, 0x99, 0xca, 0x81,
-	0x51, 0x40, 0xad, 0x60, 0x15, 0xe2, 0x03, 0x77, 0x7b, 0x00, 0xfd, 0x6a, 0x4b, 0x3a, 0x65, 0x9f,
-	0xfc, 0xc9, 0x15, 0x13, 0x5d, 0x2b, 0x9e, 0x4f, 0xa1, 0x19, 0xa7, 0x41, 0x50, 0x08, 0xaf, 0x7e,
-	0x45, 0x34, 0x96, 0x08, 0x2e, 0x81, 0x0a, 0x8c, 0x7e, 0x01, 0x1a, 0x6f, 0xed, 0xa2, 0x42, 0x73,
-	0x6f, 0x5f, 0xb5, 0xac, 0x82, 0x70, 0x11, 0xcc, 0xe0, 0x3c, 0x34, 0x57, 0xcf, 0xe6, 0xb5, 0xa1,
-	0xef, 0xaa, 0x9f, 0xda, 0x7b, 0xe8, 0xe7, 0x1f, 0x2a, 0x70, 0x70, 0x81, 0x52, 0xae, 0x81, 0x97,
-	0xff, 0xe6, 0x80, 0x53, 0xfc, 0xb7, 0xf9, 0x14, 0x3a, 0x0a, 0x90, 0xf5, 0xa1, 0xb2, 0x3b, 0x6d,
-	0xcb, 0xd1, 0x6f, 0x54, 0x37, 0x9a, 0xe7, 0xaa, 0xec, 0x52, 0xa5, 0xc1, 0xd5, 0x2e, 0x8d, 0x37,
-	0xaa, 0x45, 0xe5, 0x9f, 0x17, 0x34, 0xbc, 0x05, 0x4d, 0x45, 0xae, 0x0e, 0xa0, 0x65, 0x74, 0xbd,
-	0xb7, 0xee, 0x76, 0xa1, 0x53, 0x28, 0x3f, 0x57, 0xde, 0xfe, 0x8b, 0xeb, 0x04, 0x63, 0x0f, 0x9a,
-	0xf8, 0x74, 0x36, 0x93, 0x72, 0xb1, 0x0f, 0xda, 0x7c, 0x71, 0x7c, 0x72, 0xf2, 0x1e, 0x7a, 0xf1,
-	0xb7, 0x0a, 0xb4, 0xbe, 0x8a, 0x49, 0xb4, 0x16, 0xc5, 0xf3, 0x08, 0x1a, 0xa2, 0xf0, 0xf8, 0x9f,
-	0xfa, 0x6a, 0xf6, 0x0e, 0xe6, 0x6e, 0xd9, 0x14, 0xab, 0x26, 0x4a, 0x01, 0xf9, 0x5f, 0xb4, 0x35,
-	0x71, 0x6d, 0x1a, 0xc7, 0x61, 0x9c, 0xa9, 0x74, 0x6b, 0x4d, 0x5c, 0x53, 0x0c, 0x70, 0x91, 0xe6,
-	0x6e, 0x3f, 0x8c, 0x69, 0x26, 0xd2, 0x6b, 0xe2, 0x4e, 0xc3, 0x98, 0xea, 0x06, 0xec, 0x95, 0x26,
-	0x2c, 0xbf, 0x24, 0x2d, 0xf9, 0x92, 0xdc, 0xdd, 0x7e, 0x49, 0x5a, 0x79, 0x5f, 0x5e, 0x7a, 0x45,
-	0x96, 0x0d, 0x51, 0x9f, 0x9f, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xf3, 0x5c, 0x9a, 0x02, 0x5b,
-	0x11, 0x00, 0x00,
+	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/graph_data.proto", fileDescriptor4)
+}
+
+var fileDescriptor4 = []byte{
+	// 1843 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x73, 0xdb, 0xc6,
+	0x15, 0x17, 0xbf, 0x81, 0x27, 0x89, 0x42, 0x36, 0x8e, 0x03, 0xc3, 0x89, 0xed, 0xb0, 0x4d, 0xeb,
+	0x71, 0x6b, 0x6a, 0xac, 0xd4, 0x6e, 0xea, 0x4c, 0xda, 0xa1, 0x05, 0x28, 0x42, 0x86, 0xa4, 0x54,
+	0x90, 0x8a, 0x3d, 0xb9, 0x60, 0x56, 0xc0, 0x52, 0x44, 0x4d, 0x00, 0x2c, 0xb0, 0xb0, 0xad, 0xde,
+	0x7a, 0xed, 0xb5, 0x87, 0xfe, 0x0d, 0x3d, 0xf5, 0x3f, 0xe8, 0x9f, 0xd2, 0x73, 0xa7, 0x87, 0xde,
+	0x3a, 0xed, 0xa1, 0x87, 0xce, 0x7e, 0xe0, 0x83, 0xa2, 0x64, 0x2b, 0xd3, 0x43, 0x2e, 0x1c, 0xbc,
+	0x7d, 0xbf, 0xb7, 0x1f, 0x6f, 0xdf, 0xfb, 0xbd, 0xb7, 0x84, 0xa7, 0x67, 0x01, 0x9d, 0x67, 0xa7,
+	0x7d, 0x2f, 0x0e, 0x77, 0x17, 0x99, 0x17, 0xf0, 0x9f, 0x87, 0x67, 0xf1, 0xae, 0x1f, 0xee, 0xe2,
+	0x65, 0xb0, 0x9b, 0x92, 0xe4, 0x55, 0xe0, 0x91, 0xdd, 0x57, 0x8f, 0x76, 0xcf, 0x12, 0xbc, 0x9c,
+	0xbb, 0x3e, 0xa6, 0xb8, 0xbf, 0x4c, 0x62, 0x1a, 0xa3, 0xba, 0x1f, 0x1a, 0x77, 0xce, 0xe2, 0xf8,
+	0x6c, 0x41, 0x76, 0xf9, 0xc8, 0x69, 0x36, 0xdb, 0xf5, 0xb3, 0x04, 0xd3, 0x20, 0x8e, 0x04, 0xc6,
+	0xb8, 0x7b, 0x51, 0x4f, 0x83, 0x90, 0xa4, 0x14, 0x87, 0x4b, 0x09, 0x78, 0x7c, 0xfd, 0x0d, 0xd0,
+	0xf3, 0x25, 0x49, 0x85, 0x59, 0xef, 0xef, 0x35, 0xe8, 0x0e, 0x4e, 0xa3, 0x38, 0x09, 0xf1, 0xe2,
+	0x20, 0x88, 0x82, 0x74, 0x8e, 0x1e, 0x41, 0x3b, 0xa5, 0x98, 0x66, 0xa9, 0x5e, 0xbb, 0x57, 0xbb,
+	0xdf, 0xdd, 0xbb, 0xd5, 0xf7, 0xc3, 0xfe, 0x2a, 0xa6, 0x3f, 0xe1, 0x00, 0x47, 0x02, 0xd1, 0x4d,
+	0x68, 0x27, 0x04, 0xa7, 0x71, 0xa4, 0xd7, 0xef, 0xd5, 0xee, 0xab, 0x8e, 0x94, 0x7a, 0x7f, 0xa8,
+	0x41, 0x5b, 0x40, 0xd1, 0x26, 0x74, 0xec, 0xf1, 0x37, 0x83, 0xa1, 0x6d, 0x6a, 0x1b, 0x08, 0xa0,
+	0x7d, 0x30, 0xb0, 0x87, 0x96, 0xa9, 0xd5, 0x98, 0x62, 0xdf, 0x19, 0x4c, 0x0e, 0x2d, 0x53, 0xab,
+	0x33, 0xc1, 0x7a, 0x71, 0x6c, 0x3b, 0x96, 0xa9, 0x35, 0xd0, 0x36, 0xa8, 0x53, 0x7b, 0x64, 0x99,
+	0xee, 0xd1, 0xc9, 0x54, 0x6b, 0x32, 0x71, 0x7f, 0x30, 0xde, 0xb7, 0x86, 0xcc, 0xae, 0x85, 0xb6,
+	0x40, 0x71, 0xac, 0xaf, 0xad, 0xfd, 0xa9, 0x65, 0x6a, 0x6d, 0x66, 0x38, 0xb2, 0x27, 0x13, 0x7b,
+	0xfc, 0x95, 0xd6, 0x41, 0x37, 0x40, 0x73, 0xac, 0xc9, 0xc9, 0x70, 0xea, 0x8e, 0x06, 0xc3, 0x83,
+	0x23, 0x67, 0x64, 0x99, 0x9a, 0xd2, 0xfb, 0xb7, 0x0a, 0xad, 0x5f, 0x67, 0x24, 0xa5, 0xe8, 0x23,
+	0xa8, 0x07, 0x3e, 0x3f, 0xdd, 0xe6, 0xde, 0x16, 0x3b, 0x1d, 0x1f, 0xee, 0xdb, 0xa6, 0x53, 0x0f,
+	0x7c, 0xa4, 0x41, 0xc3, 0x1c, 0x5b, 0xfc, 0x24, 0x8a, 0xc3, 0x3e, 0x51, 0x0f, 0x9a, 0xec, 0xba,
+	0xf4, 0x06, 0xb7, 0xe8, 0x96, 0x16, 0x26, 0xa6, 0xd8, 0xe1, 0x3a, 0xf4, 0x19, 0x28, 0x98, 0x52,
+	0x12, 0x2e, 0x69, 0xaa, 0x37, 0xef, 0x35, 0xee, 0x6f, 0xee, 0x7d, 0x58, 0xe2, 0x06, 0x52, 0x63,
+	0x45, 0x34, 0x39, 0x77, 0x0a, 0x20, 0xd2, 0xa1, 0xb3, 0xc4, 0x09, 0x0d, 0xf0, 0x42, 0xd7, 0xf8,
+	0x72, 0xb9, 0x68, 0xdc, 0x80, 0xba, 0x6d, 0xa2, 0x6e, 0xb1, 0x51, 0x95, 0x6d, 0xcd, 0xf8, 0x47,
+	0x0b, 0x9a, 0x26, 0x49, 0x3d, 0xf4, 0x04, 0x3e, 0xf4, 0x83, 0x94, 0x26, 0xc1, 0x69, 0x46, 0xe3,
+	0xc4, 0xf5, 0xe2, 0x68, 0x16, 0x9c, 0xb9, 0x11, 0x0e, 0x89, 0x44, 0x7f, 0x50, 0x51, 0xef, 0x73,
+	0xed, 0x18, 0x87, 0x04, 0xdd, 0x01, 0x58, 0xe2, 0x04, 0x87, 0x84, 0x92, 0x24, 0x95, 0x97, 0x55,
+	0x19, 0x41, 0x8f, 0xe1, 0x66, 0x75, 0xde, 0x0a, 0xb6, 0xb1, 0x36, 0xed, 0x71, 0x69, 0xf6, 0x63,
+	0x68, 0x86, 0x84, 0x62, 0xbd, 0xc9, 0x1d, 0xf4, 0x7e, 0xc5, 0x41, 0x24, 0xf5, 0xfa, 0x23, 0xc2,
+	0xbc, 0xc4, 0x00, 0xc6, 0x9f, 0x9b, 0xd0, 0x64, 0x22, 0xfa, 0x18, 0x00, 0xa7, 0x2e, 0xf6, 0xbc,
+	0x38, 0x8b, 0xa8, 0xdc, 0xb3, 0x8a, 0xd3, 0x81, 0x18, 0x40, 0xbb, 0xd0, 0x4a, 0x08, 0x4d, 0xce,
+	0xf9, 0x16, 0x37, 0xf7, 0x6e, 0x5d, 0x32, 0x63, 0xdf, 0x61, 0x00, 0x47, 0xe0, 0xd0, 0xe7, 0xa0,
+	0xb0, 0x8c, 0x88, 0x33, 0x9a, 0xca, 0x6b, 0xfa, 0xe8, 0x32, 0x9b, 0xa9, 0xc4, 0x38, 0x05, 0xda,
+	0xf8, 0x6f, 0x0d, 0x5a, 0x7c, 0x2a, 0x16, 0xc5, 0x33, 0x1c, 0x2c, 0x88, 0xf0, 0xf8, 0xb6, 0x23,
+	0x25, 0x76, 0x4b, 0x5e, 0x82, 0xd3, 0x39, 0xf1, 0xf9, 0x76, 0xb6, 0x9d, 0x5c, 0x64, 0x1a, 0xf2,
+	0x66, 0x19, 0x24, 0xc4, 0xe7, 0x8b, 0x6e, 0x3b, 0xb9, 0x88, 0x6e, 0x83, 0xca, 0x56, 0xf0, 0xdd,
+	0x38, 0xa3, 0xdc, 0x2d, 0xdb, 0x62, 0x49, 0xff, 0x28, 0xe3, 0xa7, 0xf3, 0xc9, 0x02, 0x9f, 0xeb,
+	0x9a, 0x3c, 0x9d, 0x48, 0xee, 0x7e, 0x9e, 0xdc, 0x7d, 0x53, 0x26, 0xbf, 0x23, 0x70, 0xe8, 0x09,
+	0xa8, 0x21, 0x7e, 0xe3, 0x0a, 0xa3, 0xf7, 0xde, 0x65, 0xa4, 0x84, 0xf8, 0x8d, 0xc9, 0xed, 0x3e,
+	0x81, 0x2d, 0x91, 0x89, 0x6e, 0x42, 0xce, 0xc8, 0x1b, 0x1d, 0x71, 0x3f, 0x6f, 0x8a, 0x31, 0x87,
+	0x0d, 0x19, 0x7f, 0xaa, 0x81, 0x92, 0x7b, 0x85, 0x6d, 0x2c, 0xa5, 0x38, 0xa1, 0x7a, 0xed, 0x5d,
+	0x6b, 0x08, 0x1c, 0xfa, 0x09, 0x34, 0x92, 0x2c, 0xd2, 0xeb, 0xef, 0x82, 0x33, 0x14, 0x7a, 0x08,
+	0xcd, 0x94, 0xc6, 0x4b, 0xbd, 0xf1, 0x2e, 0x34, 0x87, 0x19, 0x73, 0xd8, 0x9a, 0x92, 0x70, 0xb9,
+	0xc0, 0x94, 0x4c, 0x96, 0xc4, 0xe3, 0xc9, 0x92, 0xc4, 0xbf, 0x21, 0x5e, 0x1e, 0x2f, 0xb9, 0xc8,
+	0x32, 0x36, 0x21, 0x33, 0x19, 0xce, 0xec, 0x93, 0x61, 0x5f, 0x91, 0x24, 0x0d, 0xe2, 0x48, 0x06,
+	0x6e, 0x2e, 0x22, 0x04, 0x4d, 0x9e, 0x26, 0x4d, 0x3e, 0xcc, 0xbf, 0x8d, 0x3f, 0xd6, 0xa0, 0xc9,
+	0x52, 0x19, 0xfd, 0x8c, 0xdd, 0x34, 0xc1, 0x94, 0xe4, 0xec, 0x60, 0xac, 0x6d, 0x72, 0x9a, 0xf3,
+	0xae, 0x93, 0x43, 0x39, 0x3d, 0x90, 0xd4, 0xd3, 0xeb, 0x6b, 0xf4, 0x40, 0x52, 0xcf, 0xe1, 0x3a,
+	0xf4, 0x08, 0x94, 0xd3, 0x2c, 0x58, 0x50, 0xf7, 0xf4, 0x5c, 0x6f, 0x70, 0x7a, 0xb8, 0x59, 0xe2,
+	0xaa, 0xc7, 0x74, 0x3a, 0x1c, 0xf7, 0xec, 0xdc, 0x38, 0x84, 0xed, 0x15, 0xde, 0x60, 0xc7, 0x7c,
+	0x49, 0xce, 0x65, 0x70, 0xb2, 0x4f, 0xf4, 0x09, 0xb4, 0x5e, 0xe1, 0x45, 0x46, 0xe4, 0xd2, 0x9b,
+	0x9c, 0xa9, 0x85, 0x8d, 0x23, 0x34, 0x4f, 0xeb, 0x9f, 0xd7, 0x7a, 0x14, 0xe0, 0x6b, 0x7e, 0xe1,
+	0x69, 0xb6, 0xa0, 0x2c, 0xcc, 0xe3, 0xd3, 0x8a, 0x1b, 0xa5, 0xc4, 0x3c, 0x93, 0x06, 0xbf, 0x23,
+	0x32, 0xc6, 0xf9, 0x37, 0x7a, 0x0a, 0xc0, 0x23, 0x1a, 0xd3, 0xdc, 0x95, 0x6f, 0xf7, 0x49, 0x05,
+	0xdd, 0x0b, 0xa0, 0x2d, 0x57, 0xcc, 0xf9, 0xb3, 0x56, 0x3a, 0xa8, 0xdc, 0x8f, 0xe4, 0xcf, 0x2f,
+	0x60, 0x07, 0xcb, 0x1a, 0xe3, 0xce, 0x78, 0x91, 0x91, 0x87, 0x42, 0xeb, 0xe5, 0xc7, 0xe9, 0xe2,
+	0x15, 0xb9, 0xf7, 0x4f, 0x80, 0x8e, 0x3c, 0x37, 0xba, 0x53, 0x21, 0xf7, 0x6e, 0xc5, 0x21, 0x57,
+	0xd3, 0xfb, 0x0f, 0x57, 0xe8, 0x5d, 0xab, 0xda, 0x54, 0x08, 0xfe, 0x0b, 0xe6, 0x0a, 0xe2, 0x65,
+	0xec, 0x6c, 0x39, 0xc5, 0xdf, 0xae, 0x62, 0xad, 0x42, 0x2b, 0x68, 0xbe, 0x02, 0x47, 0x0f, 0x40,
+	0x99, 0xbd, 0xf6, 0x5d, 0x9f, 0x2c, 0x53, 0xbd, 0xc5, 0x97, 0xd9, 0xa9, 0x98, 0x0e, 0x83, 0x94,
+	0x3a, 0x9d, 0xd9, 0x6b, 0xdf, 0x24, 0xcb, 0x14, 0xfd, 0x14, 0xd4, 0x53, 0xec, 0xbd, 0x14, 0xe0,
+	0xf6, 0xe5, 0x60, 0x85, 0x21, 0x38, 0xfa, 0xe1, 0x6a, 0x09, 0x91, 0xec, 0x9b, 0xef, 0xe9, 0x58,
+	0xa8, 0xca, 0xba, 0xf2, 0x80, 0xd7, 0x95, 0x1b, 0xd0, 0xfa, 0x2d, 0x8b, 0x3c, 0x19, 0x01, 0x42,
+	0x90, 0xd5, 0x46, 0x5c, 0x3f, 0xab, 0x36, 0xff, 0x6a, 0xfe, 0x5f, 0x69, 0xf1, 0x04, 0x94, 0x30,
+	0xf6, 0x83, 0x59, 0x20, 0x79, 0xf3, 0xed, 0x66, 0x05, 0x16, 0x7d, 0x0a, 0xdd, 0x28, 0x0b, 0xdd,
+	0x8a, 0xb3, 0x05, 0xb7, 0x6e, 0x47, 0x59, 0x58, 0xfa, 0x18, 0x7d, 0x09, 0x90, 0x7a, 0x73, 0xe2,
+	0x67, 0x8b, 0x20, 0x3a, 0x93, 0x4e, 0xbd, 0x7d, 0xf1, 0xee, 0xfa, 0x93, 0x02, 0x72, 0xb8, 0xe1,
+	0x54, 0x0c, 0xd0, 0x53, 0x50, 0xe5, 0x0a, 0xd1, 0x99, 0xf4, 0xb2, 0xb1, 0x66, 0x6d, 0xe5, 0x88,
+	0xc3, 0x0d, 0xa7, 0x84, 0x33, 0x7f, 0xbc, 0xc6, 0x01, 0xb7, 0xec, 0x70, 0x4b, 0x7d, 0xcd, 0xf2,
+	0xb9, 0xd0, 0x1f, 0x6e, 0x38, 0x39, 0x14, 0xfd, 0x1c, 0x14, 0x11, 0xd8, 0xc4, 0xd7, 0x95, 0xb2,
+	0xac, 0xad, 0x98, 0x1d, 0x48, 0xc0, 0xe1, 0x86, 0x53, 0x80, 0xd1, 0x97, 0xeb, 0xa9, 0xa1, 0x5e,
+	0x95, 0x1a, 0x87, 0x1b, 0x17, 0x93, 0xc3, 0xd8, 0x02, 0x28, 0xbd, 0x60, 0x3c, 0x06, 0xb5, 0x38,
+	0x15, 0xba, 0x0f, 0x9a, 0x97, 0x25, 0xa5, 0xab, 0xdd, 0x20, 0xaf, 0x7d, 0x5d, 0x2f, 0x4b, 0x0a,
+	0x67, 0xdb, 0xbe, 0xf1, 0x00, 0x3a, 0xf2, 0x48, 0xe8, 0x2e, 0x6c, 0xb2, 0xfb, 0xc9, 0x3d, 0x20,
+	0xf0, 0x10, 0x65, 0xa1, 0x04, 0x18, 0x7d, 0x50, 0xf2, 0x73, 0x5c, 0x27, 0xf5, 0x9f, 0x75, 0x61,
+	0x4b, 0x76, 0x44, 0x2e, 0x6b, 0x4d, 0x8d, 0x21, 0xec, 0x5c, 0xc8, 0xa5, 0x4b, 0xa8, 0xef, 0x07,
+	0xab, 0xd4, 0xb7, 0xcd, 0x66, 0x2e, 0xac, 0x2a, 0xe4, 0x67, 0xfc, 0xa7, 0x06, 0x1d, 0x99, 0x06,
+	0x8c, 0xe2, 0x8a, 0xdd, 0x28, 0x32, 0xaf, 0xef, 0xac, 0xe4, 0xb5, 0xa0, 0x85, 0xca, 0x08, 0xba,
+	0x55, 0x49, 0xdd, 0x06, 0xd7, 0x16, 0x99, 0x7a, 0xbb, 0x9a, 0xa9, 0x4d, 0xae, 0x2b, 0x13, 0x73,
+	0x8f, 0xf5, 0xc4, 0xec, 0x94, 0x3c, 0x36, 0xbb, 0xab, 0xd1, 0x25, 0x37, 0xd4, 0x97, 0x7e, 0x90,
+	0xc8, 0xde, 0xa8, 0xa0, 0x4c, 0x80, 0xf6, 0xf0, 0x68, 0x60, 0x5a, 0xac, 0x5b, 0xee, 0x02, 0x8c,
+	0x8f, 0xa6, 0xae, 0x94, 0x6b, 0x08, 0x41, 0x97, 0xc9, 0x83, 0x93, 0xe9, 0xe1, 0x91, 0x63, 0x7f,
+	0xcb, 0x1b, 0xe7, 0xf7, 0x61, 0xc7, 0x1c, 0x4c, 0x07, 0xee, 0xc4, 0xfe, 0xd6, 0x72, 0x87, 0xf6,
+	0xc8, 0x9e, 0x6a, 0x8d, 0xde, 0x0b, 0x68, 0xb1, 0xee, 0x9b, 0xb0, 0x19, 0x26, 0xfb, 0x87, 0x96,
+	0x79, 0x32, 0x64, 0x0d, 0xf2, 0x06, 0x6b, 0xa5, 0xad, 0x17, 0xd6, 0xfe, 0xc9, 0x94, 0x89, 0xbc,
+	0x05, 0x7f, 0x3e, 0xb0, 0xb9, 0x50, 0x67, 0x7d, 0xf5, 0x81, 0x3d, 0xb6, 0x79, 0x43, 0xde, 0x40,
+	0x1f, 0xc0, 0x7b, 0x83, 0x67, 0xe3, 0x23, 0x67, 0x34, 0x18, 0xba, 0xc5, 0x70, 0xb3, 0xf7, 0x17,
+	0xb5, 0x08, 0xa3, 0x38, 0x42, 0xf7, 0x2a, 0x94, 0xab, 0xad, 0x5c, 0x44, 0x4e, 0xba, 0x3f, 0x92,
+	0x8e, 0xaf, 0x50, 0x7a, 0x89, 0xa9, 0x90, 0xec, 0xd5, 0x0d, 0xf1, 0x2f, 0xa1, 0x39, 0xc8, 0xe8,
+	0xfc, 0x1a, 0x6b, 0xdd, 0x80, 0x16, 0x8d, 0x5f, 0x12, 0xd1, 0x95, 0x6c, 0x39, 0x42, 0x30, 0xcc,
+	0xb7, 0x10, 0x9f, 0x0e, 0x1d, 0x19, 0x80, 0x79, 0x83, 0x27, 0x45, 0x49, 0x89, 0x8d, 0x82, 0x12,
+	0xff, 0xd6, 0xfe, 0x5e, 0x28, 0x71, 0x04, 0x5a, 0xb5, 0x2d, 0x0f, 0xa2, 0x59, 0x2c, 0xab, 0x55,
+	0x6f, 0xdd, 0x95, 0x7d, 0xb3, 0x84, 0xda, 0xd1, 0x2c, 0x76, 0x76, 0xfc, 0xd5, 0x01, 0xf4, 0xab,
+	0x15, 0xea, 0x14, 0x4d, 0xfb, 0xc7, 0x97, 0x4c, 0x74, 0x25, 0x79, 0x3e, 0x81, 0x4e, 0x92, 0x45,
+	0x51, 0x49, 0xbc, 0xc6, 0x25, 0xd6, 0x8e, 0x40, 0x30, 0x0a, 0x94, 0x60, 0xf4, 0x0b, 0x50, 0x58,
+	0x6b, 0xb7, 0x2c, 0x39, 0xf7, 0xf6, 0x65, 0xcb, 0x4a, 0x08, 0x23, 0xc1, 0x1c, 0xce, 0x4c, 0x0b,
+	0xf6, 0xec, 0x5c, 0x69, 0x7a, 0x5d, 0xfe, 0x54, 0xae, 0xcf, 0x9f, 0xa8, 0x9f, 0xbf, 0x45, 0xd4,
+	0x92, 0xeb, 0x2f, 0x1e, 0xb5, 0xf2, 0x14, 0x31, 0x7e, 0x5f, 0x83, 0x9d, 0x0b, 0x57, 0xc0, 0x38,
+	0x73, 0xfd, 0x8d, 0x06, 0x5e, 0xf9, 0x30, 0xfb, 0x14, 0xba, 0x12, 0x90, 0xf7, 0xad, 0xa2, 0x9b,
+	0xdd, 0x16, 0xa3, 0xdf, 0x88, 0xc1, 0x32, 0xb6, 0x45, 0x57, 0x2b, 0x04, 0xc6, 0x8e, 0x59, 0xb2,
+	0x90, 0x2d, 0x2d, 0xfb, 0xbc, 0xc0, 0xf9, 0x2a, 0x74, 0xe4, 0x65, 0x18, 0x00, 0x4a, 0xee, 0xde,
+	0xef, 0xca, 0xd3, 0xc6, 0x71, 0xe5, 0xa1, 0x24, 0x9f, 0xfb, 0xb5, 0xea, 0x73, 0xbf, 0x7c, 0xd7,
+	0xd4, 0xaf, 0xf7, 0xae, 0x79, 0xa6, 0x41, 0xb7, 0xac, 0x3d, 0x8c, 0xfb, 0x7b, 0xcf, 0xaf, 0xa2,
+	0xac, 0x4d, 0xe8, 0x38, 0x27, 0xe3, 0xb1, 0x20, 0xac, 0x2d, 0x50, 0x26, 0xd3, 0xa3, 0xe3, 0xe3,
+	0xef, 0xc0, 0x58, 0x7f, 0xad, 0x81, 0xfa, 0x15, 0xfb, 0xe7, 0x85, 0xa7, 0xef, 0x23, 0x68, 0xf3,
+	0xd4, 0x67, 0xff, 0x71, 0x34, 0xf2, 0x4a, 0x5c, 0xa8, 0x45, 0x5b, 0x2e, 0xdb, 0x38, 0x09, 0x64,
+	0x2f, 0xd6, 0x39, 0xf6, 0x5d, 0x92, 0x24, 0x71, 0x92, 0xd7, 0x09, 0x75, 0x8e, 0x7d, 0x8b, 0x0f,
+	0xb0, 0x32, 0xc1, 0xd4, 0x61, 0x9c, 0x90, 0xbc, 0x4c, 0xcc, 0xb1, 0x3f, 0x8a, 0x13, 0x62, 0x98,
+	0xb0, 0x59, 0x99, 0xb0, 0x5a, 0xcb, 0x54, 0x51, 0xcb, 0xee, 0xae, 0xd6, 0x32, 0xb5, 0x78, 0x19,
+	0x54, 0xea, 0xd8, 0x69, 0x9b, 0x7b, 0xf1, 0xb3, 0xff, 0x0d, 0x00, 0x97, 0x80, 0x7c, 0xfb, 0x6a,
+	0x12, 0x00, 0x00,
 }
@@ -32,7 +32,7 @@ func (x GraphQuery_Search_Domain) String() string {
 	return proto.EnumName(GraphQuery_Search_Domain_name, int32(x))
 }
 func (GraphQuery_Search_Domain) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor4, []int{0, 1, 0}
+	return fileDescriptor5, []int{0, 1, 0}
 }
 
 // GraphQuery represents a single query into the state of DM's dependency graph.
@@ -53,7 +53,7 @@ type GraphQuery struct {
 func (m *GraphQuery) Reset()                    { *m = GraphQuery{} }
 func (m *GraphQuery) String() string            { return proto.CompactTextString(m) }
 func (*GraphQuery) ProtoMessage()               {}
-func (*GraphQuery) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0} }
+func (*GraphQuery) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0} }
 
 func (m *GraphQuery) GetAttemptList() *AttemptList {
 	if m != nil {
@@ -85,7 +85,7 @@ type GraphQuery_AttemptRange struct {
 func (m *GraphQuery_AttemptRange) Reset()                    { *m = GraphQuery_AttemptRange{} }
 func (m *GraphQuery_AttemptRange) String() string            { return proto.CompactTextString(m) }
 func (*GraphQuery_AttemptRange) ProtoMessage()               {}
-func (*GraphQuery_AttemptRange) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0, 0} }
+func (*GraphQuery_AttemptRange) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0, 0} }
 
 func (m *GraphQuery_AttemptRange) GetQuest() string {
 	if m != nil {
@@ -202,7 +202,7 @@ type GraphQuery_Search struct {
 func (m *GraphQuery_Search) Reset()                    { *m = GraphQuery_Search{} }
 func (m *GraphQuery_Search) String() string            { return proto.CompactTextString(m) }
 func (*GraphQuery_Search) ProtoMessage()               {}
-func (*GraphQuery_Search) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0, 1} }
+func (*GraphQuery_Search) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{0, 1} }
 
 func (m *GraphQuery_Search) GetDomain() GraphQuery_Search_Domain {
 	if m != nil {
@@ -247,10 +247,10 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/graph_query.proto", fileDescriptor4)
+	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/graph_query.proto", fileDescriptor5)
 }
 
-var fileDescriptor4 = []byte{
+var fileDescriptor5 = []byte{
 	// 475 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6f, 0xd3, 0x30,
 	0x14, 0xc6, 0x49, 0xd2, 0x64, 0xec, 0xa5, 0x1d, 0x9d, 0x05, 0x28, 0x0a, 0x1c, 0xaa, 0x71, 0x68,
@@ -12,1898 +12,1939 @@ func init() {
 			"dm.Deps",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 253, 13, 116, 92, 199,
-			117, 32, 8, 227, 85, 189, 110, 52, 10, 255, 15, 63, 4, 155,
-			34, 85, 4, 127, 0, 136, 96, 147, 34, 101, 217, 162, 44, 41,
-			32, 1, 138, 144, 73, 128, 110, 128, 150, 229, 76, 68, 62, 116,
-			87, 3, 79, 236, 126, 175, 245, 222, 107, 130, 144, 45, 199, 63,
-			81, 252, 57, 243, 105, 178, 241, 120, 143, 51, 137, 39, 177, 229,
-			156, 100, 162, 56, 153, 36, 147, 204, 120, 206, 120, 126, 119, 18,
-			123, 98, 103, 103, 189, 137, 147, 120, 19, 59, 217, 147, 217, 157,
-			100, 102, 188, 227, 61, 103, 51, 201, 174, 147, 205, 158, 123, 235,
-			231, 213, 107, 128, 34, 101, 39, 103, 207, 120, 133, 163, 159, 174,
-			251, 170, 110, 85, 221, 170, 186, 117, 235, 214, 173, 123, 217, 39,
-			142, 178, 67, 155, 81, 180, 217, 20, 167, 218, 113, 148, 70, 27,
-			157, 198, 169, 122, 39, 246, 211, 32, 10, 43, 8, 241, 134, 229,
-			247, 138, 254, 62, 125, 142, 149, 22, 85, 22, 111, 138, 245, 38,
-			162, 22, 133, 245, 100, 202, 225, 206, 44, 173, 234, 164, 55, 206,
-			10, 161, 31, 70, 201, 20, 225, 206, 108, 161, 42, 19, 231, 223,
-			195, 198, 106, 81, 171, 210, 133, 242, 252, 160, 70, 120, 21, 32,
-			87, 157, 119, 61, 176, 25, 164, 91, 157, 141, 74, 45, 106, 157,
-			218, 140, 154, 126, 184, 153, 181, 175, 157, 238, 180, 69, 98, 154,
-			249, 103, 142, 243, 10, 161, 79, 94, 61, 255, 42, 57, 244, 164,
-			196, 123, 85, 101, 173, 60, 45, 154, 205, 183, 133, 209, 118, 184,
-			14, 69, 158, 122, 117, 154, 21, 61, 247, 80, 207, 166, 195, 190,
-			56, 192, 156, 1, 143, 30, 234, 241, 206, 252, 203, 1, 142, 5,
-			106, 81, 147, 159, 239, 52, 26, 34, 78, 248, 73, 46, 81, 205,
-			36, 188, 238, 167, 62, 15, 194, 84, 196, 181, 45, 63, 220, 20,
-			188, 17, 197, 45, 63, 101, 252, 66, 212, 222, 137, 131, 205, 173,
-			148, 159, 57, 125, 250, 45, 170, 0, 95, 14, 107, 21, 206, 23,
-			154, 77, 142, 223, 18, 30, 139, 68, 196, 183, 68, 189, 194, 248,
-			86, 154, 182, 147, 115, 167, 78, 213, 197, 45, 209, 140, 218, 34,
-			78, 52, 37, 160, 159, 109, 213, 136, 147, 27, 178, 17, 167, 24,
-			227, 85, 81, 15, 146, 52, 14, 54, 58, 208, 85, 238, 135, 117,
-			222, 73, 4, 15, 66, 158, 68, 157, 184, 38, 16, 178, 17, 132,
-			126, 188, 131, 237, 74, 230, 249, 118, 144, 110, 241, 40, 198, 255,
-			71, 157, 148, 241, 86, 84, 15, 26, 65, 13, 137, 53, 207, 253,
-			88, 240, 182, 136, 91, 65, 154, 138, 58, 111, 199, 209, 173, 160,
-			46, 234, 60, 221, 242, 83, 158, 110, 65, 239, 154, 205, 104, 59,
-			8, 55, 57, 140, 99, 0, 133, 18, 40, 196, 120, 75, 164, 231,
-			24, 227, 240, 247, 64, 87, 195, 18, 30, 53, 116, 139, 106, 81,
-			93, 240, 86, 39, 73, 121, 44, 82, 63, 8, 17, 171, 191, 17,
-			221, 130, 79, 138, 98, 140, 135, 81, 26, 212, 196, 60, 79, 183,
-			130, 132, 55, 131, 36, 5, 12, 118, 141, 97, 189, 171, 57, 245,
-			32, 169, 53, 253, 160, 37, 226, 202, 157, 26, 17, 132, 54, 45,
-			116, 35, 218, 113, 84, 239, 212, 68, 214, 14, 150, 53, 228, 219,
-			106, 7, 227, 170, 119, 245, 168, 214, 105, 137, 48, 245, 245, 32,
-			157, 138, 98, 30, 165, 91, 34, 230, 45, 63, 21, 113, 224, 55,
-			147, 140, 212, 56, 64, 233, 150, 96, 220, 110, 189, 233, 212, 138,
-			8, 176, 36, 32, 14, 253, 150, 128, 6, 217, 115, 43, 140, 178,
-			111, 72, 247, 32, 77, 160, 71, 161, 68, 21, 197, 9, 111, 249,
-			59, 124, 67, 192, 76, 169, 243, 52, 226, 34, 172, 71, 113, 34,
-			96, 82, 180, 227, 168, 21, 165, 130, 75, 154, 164, 9, 175, 139,
-			56, 184, 37, 234, 188, 17, 71, 45, 38, 169, 144, 68, 141, 116,
-			27, 166, 137, 154, 65, 60, 105, 139, 26, 204, 32, 222, 142, 3,
-			152, 88, 49, 204, 157, 80, 206, 162, 36, 193, 182, 51, 190, 126,
-			105, 121, 141, 175, 173, 94, 92, 127, 122, 161, 186, 196, 151, 215,
-			248, 213, 234, 234, 59, 150, 23, 151, 22, 249, 249, 103, 248, 250,
-			165, 37, 126, 97, 245, 234, 51, 213, 229, 39, 47, 173, 243, 75,
-			171, 151, 23, 151, 170, 107, 124, 97, 101, 145, 95, 88, 93, 89,
-			175, 46, 159, 191, 182, 190, 90, 93, 99, 124, 122, 97, 141, 47,
-			175, 77, 227, 151, 133, 149, 103, 248, 210, 59, 175, 86, 151, 214,
-			214, 248, 106, 149, 47, 95, 185, 122, 121, 121, 105, 145, 63, 189,
-			80, 173, 46, 172, 172, 47, 47, 173, 205, 243, 229, 149, 11, 151,
-			175, 45, 46, 175, 60, 57, 207, 207, 95, 91, 231, 43, 171, 235,
-			140, 95, 94, 190, 178, 188, 190, 180, 200, 215, 87, 231, 177, 218,
-			221, 229, 248, 234, 69, 126, 101, 169, 122, 225, 210, 194, 202, 250,
-			194, 249, 229, 203, 203, 235, 207, 96, 133, 23, 151, 215, 87, 160,
-			178, 139, 171, 85, 198, 23, 248, 213, 133, 234, 250, 242, 133, 107,
-			151, 23, 170, 252, 234, 181, 234, 213, 213, 181, 37, 14, 61, 91,
-			92, 94, 187, 112, 121, 97, 249, 202, 210, 98, 133, 47, 175, 240,
-			149, 85, 190, 244, 142, 165, 149, 117, 190, 118, 105, 225, 242, 229,
-			124, 71, 25, 95, 125, 122, 101, 169, 10, 173, 183, 187, 201, 207,
-			47, 241, 203, 203, 11, 231, 47, 47, 65, 85, 216, 207, 197, 229,
-			234, 210, 133, 117, 232, 80, 246, 235, 194, 242, 226, 210, 202, 250,
-			194, 229, 121, 198, 215, 174, 46, 93, 88, 94, 184, 60, 207, 151,
-			222, 185, 116, 229, 234, 229, 133, 234, 51, 243, 10, 233, 218, 210,
-			219, 175, 45, 173, 172, 47, 47, 92, 230, 139, 11, 87, 22, 158,
-			92, 90, 227, 179, 119, 163, 202, 213, 234, 234, 133, 107, 213, 165,
-			43, 208, 234, 213, 139, 124, 237, 218, 249, 181, 245, 229, 245, 107,
-			235, 75, 252, 201, 213, 213, 69, 36, 246, 218, 82, 245, 29, 203,
-			23, 150, 214, 30, 229, 151, 87, 215, 144, 96, 215, 214, 150, 230,
-			25, 95, 92, 88, 95, 192, 170, 175, 86, 87, 47, 46, 175, 175,
-			61, 10, 191, 207, 95, 91, 91, 70, 194, 45, 175, 172, 47, 85,
-			171, 215, 174, 174, 47, 175, 174, 204, 241, 75, 171, 79, 47, 189,
-			99, 169, 202, 47, 44, 92, 91, 91, 90, 68, 10, 175, 174, 64,
-			111, 97, 174, 44, 173, 86, 159, 1, 180, 64, 7, 28, 129, 121,
-			254, 244, 165, 165, 245, 75, 75, 85, 32, 42, 82, 107, 1, 200,
-			176, 182, 94, 93, 190, 176, 110, 103, 91, 173, 242, 245, 213, 234,
-			58, 179, 250, 201, 87, 150, 158, 188, 188, 252, 228, 210, 202, 133,
-			37, 248, 188, 10, 104, 158, 94, 94, 91, 154, 227, 11, 213, 229,
-			53, 200, 176, 140, 21, 243, 167, 23, 158, 225, 171, 215, 176, 215,
-			48, 80, 215, 214, 150, 152, 252, 109, 77, 221, 121, 28, 79, 190,
-			124, 145, 47, 44, 190, 99, 25, 90, 174, 114, 95, 93, 93, 91,
-			91, 86, 211, 5, 201, 118, 225, 146, 162, 121, 133, 177, 18, 115,
-			136, 71, 121, 105, 31, 252, 42, 121, 116, 186, 231, 81, 214, 207,
-			220, 210, 191, 239, 237, 145, 137, 1, 86, 128, 4, 241, 232, 116,
-			239, 62, 54, 200, 138, 152, 234, 145, 201, 33, 214, 43, 147, 142,
-			76, 171, 204, 189, 30, 157, 46, 159, 83, 24, 143, 244, 220, 175,
-			48, 58, 50, 33, 51, 65, 181, 71, 12, 70, 7, 48, 30, 49,
-			24, 29, 196, 120, 196, 96, 116, 168, 71, 143, 148, 15, 41, 140,
-			71, 123, 22, 20, 70, 34, 19, 50, 19, 129, 84, 239, 168, 194,
-			72, 0, 35, 36, 37, 70, 130, 24, 33, 173, 50, 247, 122, 244,
-			232, 248, 119, 41, 140, 199, 122, 230, 21, 70, 42, 19, 50, 19,
-			37, 30, 61, 214, 59, 166, 48, 82, 192, 8, 73, 137, 145, 34,
-			70, 72, 171, 204, 189, 30, 61, 54, 121, 66, 97, 60, 222, 83,
-			81, 24, 93, 153, 144, 153, 92, 226, 209, 227, 189, 7, 20, 70,
-			23, 48, 66, 82, 98, 116, 17, 35, 164, 85, 230, 94, 143, 30,
-			63, 116, 82, 97, 156, 233, 153, 86, 24, 11, 50, 33, 51, 21,
-			136, 71, 103, 122, 203, 10, 99, 1, 48, 66, 82, 98, 44, 32,
-			70, 72, 171, 204, 212, 163, 51, 7, 15, 43, 140, 179, 61, 135,
-			21, 198, 162, 76, 200, 76, 69, 226, 209, 217, 222, 41, 133, 177,
-			8, 24, 33, 41, 49, 22, 17, 35, 164, 85, 230, 94, 143, 206,
-			30, 224, 236, 35, 3, 140, 184, 61, 158, 251, 174, 158, 77, 167,
-			252, 210, 0, 95, 224, 90, 50, 194, 141, 76, 36, 34, 76, 19,
-			238, 243, 36, 216, 12, 69, 125, 158, 55, 130, 219, 162, 126, 178,
-			41, 194, 205, 116, 139, 39, 109, 63, 132, 13, 33, 13, 90, 34,
-			203, 46, 234, 140, 251, 80, 166, 22, 117, 66, 220, 222, 148, 136,
-			134, 123, 91, 35, 246, 107, 217, 14, 174, 63, 164, 28, 197, 53,
-			76, 50, 144, 96, 162, 166, 220, 164, 248, 114, 202, 3, 216, 104,
-			235, 162, 45, 194, 186, 144, 8, 253, 112, 135, 215, 252, 166, 8,
-			235, 126, 140, 88, 107, 81, 88, 19, 237, 20, 118, 212, 155, 130,
-			79, 215, 253, 157, 105, 6, 219, 207, 116, 43, 10, 211, 173, 105,
-			141, 38, 22, 77, 63, 149, 123, 212, 122, 208, 18, 73, 234, 183,
-			218, 114, 79, 85, 194, 72, 61, 0, 73, 72, 132, 53, 193, 55,
-			68, 186, 45, 68, 200, 120, 186, 109, 231, 190, 229, 55, 59, 34,
-			1, 100, 126, 70, 42, 104, 66, 144, 242, 154, 31, 194, 54, 232,
-			215, 97, 215, 141, 98, 158, 116, 54, 82, 232, 46, 80, 4, 246,
-			59, 238, 103, 136, 42, 188, 138, 194, 29, 32, 106, 183, 227, 232,
-			118, 0, 59, 119, 115, 135, 159, 56, 249, 224, 233, 249, 211, 167,
-			79, 243, 29, 225, 199, 9, 236, 116, 75, 183, 253, 86, 187, 41,
-			248, 131, 231, 248, 133, 168, 213, 238, 164, 34, 171, 25, 209, 230,
-			90, 136, 82, 73, 59, 17, 157, 122, 132, 162, 81, 69, 137, 80,
-			89, 23, 146, 212, 143, 83, 254, 24, 175, 84, 42, 143, 118, 127,
-			19, 97, 61, 247, 197, 84, 164, 165, 95, 253, 85, 126, 54, 162,
-			187, 30, 201, 199, 0, 131, 73, 157, 148, 117, 233, 244, 163, 93,
-			133, 112, 204, 85, 17, 249, 91, 23, 192, 148, 174, 36, 104, 240,
-			217, 93, 21, 189, 149, 159, 230, 199, 143, 119, 227, 122, 156, 159,
-			158, 227, 239, 150, 197, 246, 104, 221, 137, 199, 248, 131, 143, 238,
-			250, 170, 170, 126, 140, 63, 120, 90, 255, 169, 76, 47, 114, 209,
-			4, 233, 215, 106, 64, 98, 144, 61, 190, 103, 11, 222, 250, 218,
-			45, 56, 249, 26, 45, 56, 177, 87, 11, 172, 241, 63, 147, 141,
-			127, 54, 96, 56, 1, 178, 228, 137, 108, 196, 94, 255, 52, 184,
-			227, 96, 223, 121, 146, 200, 79, 246, 152, 63, 150, 31, 115, 126,
-			98, 23, 17, 30, 205, 10, 233, 25, 96, 141, 186, 93, 96, 215,
-			52, 200, 202, 228, 233, 156, 155, 116, 54, 137, 179, 2, 123, 82,
-			55, 27, 223, 44, 227, 227, 118, 198, 59, 212, 113, 98, 239, 58,
-			246, 156, 67, 214, 8, 158, 189, 211, 10, 174, 251, 169, 0, 46,
-			90, 129, 255, 212, 69, 19, 79, 128, 252, 234, 78, 186, 37, 133,
-			93, 248, 75, 129, 232, 187, 51, 206, 214, 253, 157, 228, 177, 179,
-			243, 188, 21, 132, 157, 84, 36, 143, 61, 120, 122, 46, 191, 206,
-			248, 99, 166, 182, 217, 174, 79, 149, 139, 113, 212, 90, 55, 168,
-			210, 250, 28, 195, 63, 234, 194, 118, 241, 174, 210, 8, 107, 51,
-			215, 69, 185, 225, 89, 50, 94, 174, 241, 53, 220, 7, 12, 227,
-			6, 230, 191, 37, 114, 27, 65, 133, 95, 129, 99, 208, 134, 144,
-			61, 59, 121, 246, 193, 55, 205, 191, 233, 205, 15, 3, 75, 131,
-			127, 25, 48, 223, 19, 93, 64, 30, 132, 181, 102, 39, 9, 110,
-			193, 52, 29, 100, 5, 168, 209, 245, 220, 103, 201, 187, 60, 216,
-			175, 32, 89, 128, 22, 244, 234, 148, 227, 209, 103, 75, 195, 58,
-			69, 61, 250, 172, 55, 198, 62, 72, 177, 173, 142, 71, 27, 196,
-			43, 255, 9, 209, 141, 205, 109, 57, 190, 106, 123, 126, 207, 177,
-			182, 28, 187, 79, 44, 235, 148, 166, 96, 194, 155, 34, 73, 96,
-			199, 8, 121, 20, 10, 131, 45, 206, 237, 128, 242, 204, 229, 243,
-			211, 140, 223, 80, 180, 186, 193, 27, 129, 104, 214, 113, 175, 240,
-			121, 59, 74, 130, 52, 184, 133, 103, 164, 80, 108, 250, 248, 251,
-			6, 54, 72, 101, 172, 240, 139, 81, 108, 6, 42, 193, 166, 88,
-			21, 70, 49, 111, 69, 177, 152, 231, 62, 15, 163, 240, 228, 11,
-			34, 142, 228, 222, 4, 71, 81, 236, 64, 14, 155, 60, 155, 110,
-			8, 102, 186, 7, 39, 61, 216, 213, 97, 175, 198, 236, 249, 118,
-			118, 15, 227, 35, 143, 60, 50, 175, 254, 149, 67, 104, 1, 246,
-			24, 62, 199, 245, 220, 6, 121, 118, 92, 13, 145, 83, 128, 65,
-			209, 195, 231, 192, 16, 149, 6, 117, 138, 122, 180, 49, 50, 186,
-			81, 68, 125, 196, 89, 246, 59, 199, 217, 253, 221, 42, 162, 212,
-			108, 155, 119, 208, 17, 61, 202, 250, 12, 127, 122, 221, 74, 162,
-			247, 238, 173, 36, 26, 50, 24, 181, 150, 232, 196, 221, 181, 68,
-			166, 165, 175, 67, 77, 244, 181, 163, 82, 77, 116, 243, 13, 53,
-			209, 27, 106, 162, 55, 212, 68, 111, 168, 137, 222, 80, 19, 189,
-			161, 38, 250, 255, 176, 154, 232, 188, 173, 38, 58, 255, 173, 171,
-			137, 22, 254, 26, 213, 68, 167, 108, 53, 209, 169, 111, 93, 77,
-			84, 249, 175, 66, 77, 244, 153, 97, 169, 38, 186, 222, 115, 211,
-			41, 255, 204, 48, 95, 176, 78, 131, 57, 61, 81, 59, 10, 194,
-			20, 247, 159, 160, 37, 246, 82, 219, 32, 252, 133, 40, 20, 168,
-			159, 209, 74, 156, 249, 156, 240, 236, 39, 247, 162, 50, 98, 119,
-			144, 223, 131, 144, 95, 91, 191, 192, 151, 218, 81, 109, 75, 9,
-			239, 82, 251, 35, 66, 144, 1, 64, 82, 129, 253, 18, 119, 186,
-			171, 113, 212, 20, 237, 52, 168, 241, 39, 99, 177, 25, 197, 129,
-			31, 242, 11, 90, 177, 180, 189, 21, 212, 182, 184, 184, 157, 10,
-			168, 16, 246, 182, 44, 147, 110, 56, 227, 27, 126, 237, 230, 182,
-			31, 67, 142, 8, 117, 54, 32, 165, 119, 87, 233, 39, 73, 167,
-			5, 181, 250, 205, 166, 62, 168, 161, 200, 243, 240, 105, 102, 186,
-			212, 140, 194, 205, 121, 30, 84, 68, 133, 55, 133, 223, 206, 186,
-			26, 11, 62, 157, 180, 132, 31, 139, 250, 52, 79, 34, 41, 20,
-			133, 145, 157, 139, 241, 212, 223, 104, 162, 58, 41, 20, 2, 170,
-			132, 99, 0, 138, 135, 109, 144, 119, 164, 50, 205, 168, 156, 228,
-			182, 122, 250, 244, 233, 7, 79, 226, 63, 235, 167, 79, 159, 195,
-			127, 222, 5, 189, 120, 228, 145, 71, 30, 57, 249, 224, 153, 147,
-			103, 31, 92, 63, 115, 246, 220, 155, 30, 57, 247, 166, 71, 42,
-			143, 232, 191, 119, 85, 24, 63, 191, 3, 4, 79, 227, 160, 150,
-			34, 41, 85, 147, 98, 64, 63, 207, 183, 5, 23, 97, 210, 137,
-			133, 132, 110, 11, 84, 140, 213, 162, 240, 150, 136, 83, 158, 70,
-			76, 141, 106, 212, 226, 188, 122, 241, 2, 63, 123, 246, 236, 35,
-			120, 176, 229, 128, 50, 220, 76, 42, 140, 175, 9, 193, 191, 91,
-			203, 165, 219, 219, 219, 149, 64, 164, 141, 74, 20, 111, 158, 138,
-			27, 53, 248, 23, 10, 85, 210, 219, 233, 247, 204, 222, 75, 174,
-			185, 59, 232, 209, 186, 244, 40, 87, 87, 215, 150, 223, 201, 111,
-			192, 164, 153, 157, 187, 177, 91, 101, 98, 132, 123, 117, 182, 207,
-			142, 37, 137, 72, 175, 171, 241, 154, 197, 226, 43, 215, 46, 95,
-			158, 155, 219, 51, 31, 78, 219, 217, 211, 115, 143, 222, 147, 110,
-			71, 181, 105, 83, 164, 128, 37, 106, 212, 253, 29, 171, 109, 73,
-			26, 119, 106, 41, 86, 112, 203, 111, 242, 244, 150, 170, 49, 151,
-			253, 120, 122, 107, 158, 99, 131, 30, 253, 86, 187, 116, 171, 146,
-			222, 130, 212, 107, 245, 72, 102, 234, 36, 162, 198, 31, 64, 61,
-			72, 174, 135, 103, 239, 216, 195, 167, 131, 240, 236, 25, 126, 227,
-			73, 145, 174, 237, 36, 169, 64, 125, 196, 66, 114, 49, 104, 138,
-			245, 252, 64, 92, 92, 190, 188, 180, 190, 124, 101, 137, 55, 82,
-			213, 140, 59, 149, 57, 222, 72, 117, 75, 175, 45, 175, 172, 63,
-			252, 16, 79, 131, 218, 205, 132, 63, 198, 103, 103, 103, 37, 100,
-			174, 145, 86, 234, 219, 151, 130, 205, 173, 69, 63, 197, 82, 115,
-			252, 173, 111, 229, 103, 207, 204, 241, 247, 112, 252, 118, 57, 218,
-			214, 159, 52, 221, 78, 157, 226, 11, 208, 222, 122, 180, 157, 32,
-			74, 88, 76, 15, 158, 62, 109, 177, 162, 164, 98, 50, 8, 100,
-			65, 15, 62, 188, 123, 149, 25, 108, 80, 252, 193, 135, 31, 122,
-			232, 161, 55, 159, 125, 248, 244, 105, 179, 228, 55, 68, 35, 138,
-			5, 191, 22, 6, 183, 53, 150, 71, 222, 124, 186, 27, 75, 229,
-			91, 27, 204, 89, 217, 127, 62, 59, 43, 137, 114, 202, 40, 173,
-			230, 248, 73, 187, 57, 119, 153, 193, 128, 7, 200, 165, 241, 28,
-			179, 240, 224, 4, 152, 203, 77, 128, 135, 238, 56, 1, 158, 242,
-			111, 249, 252, 134, 28, 200, 74, 173, 19, 199, 34, 76, 33, 203,
-			149, 160, 217, 12, 18, 107, 2, 0, 135, 228, 45, 132, 242, 199,
-			248, 157, 11, 188, 198, 52, 231, 143, 89, 58, 248, 80, 108, 159,
-			239, 4, 205, 186, 136, 103, 231, 160, 99, 107, 138, 66, 170, 10,
-			73, 152, 57, 173, 231, 227, 28, 242, 172, 200, 190, 7, 97, 10,
-			61, 87, 57, 101, 215, 85, 183, 145, 2, 115, 149, 13, 192, 140,
-			109, 201, 104, 240, 166, 59, 210, 64, 245, 66, 239, 155, 221, 186,
-			191, 189, 154, 175, 149, 121, 217, 216, 60, 41, 210, 11, 25, 53,
-			102, 115, 202, 188, 235, 165, 81, 246, 113, 71, 107, 243, 234, 100,
-			188, 252, 183, 28, 94, 205, 246, 110, 75, 165, 7, 219, 39, 182,
-			35, 9, 194, 154, 61, 11, 217, 222, 211, 48, 175, 42, 186, 195,
-			174, 194, 246, 218, 86, 222, 117, 7, 205, 95, 157, 92, 31, 179,
-			52, 127, 245, 156, 230, 175, 158, 211, 252, 213, 189, 49, 246, 239,
-			28, 173, 249, 123, 142, 120, 229, 223, 114, 248, 74, 20, 158, 52,
-			106, 181, 215, 167, 255, 171, 240, 21, 85, 80, 239, 204, 250, 206,
-			7, 15, 200, 25, 50, 60, 198, 39, 105, 208, 108, 242, 45, 255,
-			150, 64, 53, 156, 169, 83, 234, 131, 101, 65, 38, 247, 65, 121,
-			29, 214, 136, 98, 144, 22, 180, 136, 212, 77, 59, 181, 253, 102,
-			170, 182, 189, 85, 107, 207, 145, 186, 173, 90, 123, 46, 167, 90,
-			123, 46, 167, 90, 123, 206, 82, 173, 253, 225, 4, 59, 208, 173,
-			90, 19, 173, 118, 186, 115, 39, 181, 90, 47, 43, 44, 193, 247,
-			243, 183, 246, 86, 145, 49, 252, 170, 213, 99, 51, 119, 87, 143,
-			97, 109, 175, 67, 53, 246, 211, 227, 82, 53, 118, 118, 228, 13,
-			213, 216, 27, 170, 177, 55, 84, 99, 111, 168, 198, 222, 80, 141,
-			189, 161, 26, 251, 175, 69, 53, 246, 184, 173, 26, 123, 60, 167,
-			26, 27, 205, 171, 198, 70, 187, 84, 99, 90, 145, 229, 244, 122,
-			244, 200, 248, 99, 70, 53, 54, 111, 171, 198, 230, 115, 170, 177,
-			177, 188, 106, 108, 172, 75, 53, 54, 102, 171, 198, 140, 34, 235,
-			88, 207, 9, 91, 53, 118, 34, 167, 26, 59, 144, 87, 141, 29,
-			232, 82, 141, 29, 176, 85, 99, 135, 30, 48, 170, 177, 105, 91,
-			53, 54, 157, 83, 141, 149, 243, 170, 177, 114, 151, 106, 76, 43,
-			178, 92, 234, 209, 227, 70, 145, 53, 99, 20, 89, 5, 153, 176,
-			85, 99, 83, 121, 213, 216, 84, 151, 106, 76, 43, 178, 10, 189,
-			30, 157, 57, 192, 141, 106, 236, 126, 91, 53, 118, 127, 78, 53,
-			182, 47, 175, 26, 219, 215, 165, 26, 211, 99, 93, 164, 30, 157,
-			45, 31, 98, 223, 36, 168, 26, 163, 103, 123, 70, 202, 255, 153,
-			240, 5, 190, 41, 66, 17, 7, 53, 142, 162, 14, 111, 137, 36,
-			241, 55, 149, 42, 100, 39, 234, 160, 46, 36, 22, 39, 65, 38,
-			72, 35, 238, 223, 138, 130, 58, 175, 139, 70, 16, 226, 78, 213,
-			105, 55, 97, 223, 23, 117, 150, 47, 143, 59, 229, 78, 212, 137,
-			249, 194, 213, 229, 164, 194, 23, 120, 186, 211, 14, 106, 126, 147,
-			11, 117, 180, 8, 80, 1, 133, 162, 70, 170, 239, 116, 99, 241,
-			124, 71, 36, 41, 170, 218, 100, 58, 105, 71, 33, 212, 188, 211,
-			22, 82, 35, 7, 248, 64, 78, 216, 138, 212, 141, 115, 16, 38,
-			169, 31, 214, 132, 22, 28, 64, 20, 10, 106, 130, 95, 140, 162,
-			204, 22, 34, 110, 215, 248, 121, 63, 158, 237, 146, 7, 43, 40,
-			14, 206, 129, 24, 209, 137, 195, 132, 223, 225, 187, 101, 25, 177,
-			190, 37, 248, 83, 107, 171, 43, 153, 254, 79, 89, 69, 68, 49,
-			191, 129, 185, 111, 160, 30, 13, 105, 129, 25, 163, 141, 231, 68,
-			45, 229, 55, 222, 253, 34, 28, 11, 245, 233, 230, 108, 105, 208,
-			72, 185, 127, 228, 177, 55, 89, 146, 104, 179, 83, 11, 240, 63,
-			39, 55, 163, 83, 245, 214, 41, 191, 29, 156, 82, 157, 58, 117,
-			235, 193, 83, 40, 151, 42, 249, 151, 212, 91, 229, 187, 221, 61,
-			151, 95, 75, 130, 158, 126, 130, 121, 87, 58, 205, 52, 184, 26,
-			131, 176, 152, 238, 188, 3, 78, 0, 222, 28, 43, 202, 163, 192,
-			148, 195, 233, 108, 255, 153, 209, 74, 189, 85, 201, 101, 169, 170,
-			12, 211, 255, 189, 195, 6, 243, 133, 61, 70, 147, 52, 198, 155,
-			236, 190, 75, 61, 85, 72, 0, 172, 238, 167, 120, 139, 61, 0,
-			176, 186, 159, 2, 44, 236, 180, 166, 40, 119, 102, 41, 192, 194,
-			78, 11, 96, 27, 65, 56, 85, 224, 206, 108, 9, 96, 27, 65,
-			232, 157, 102, 46, 116, 105, 170, 200, 157, 217, 254, 51, 229, 110,
-			153, 190, 98, 206, 151, 151, 122, 170, 152, 211, 155, 103, 110, 216,
-			105, 54, 167, 122, 177, 196, 228, 174, 18, 56, 78, 144, 27, 114,
-			157, 239, 101, 5, 236, 203, 83, 110, 201, 29, 41, 76, 127, 202,
-			97, 253, 11, 105, 10, 68, 186, 28, 36, 169, 55, 195, 72, 26,
-			77, 17, 164, 195, 62, 160, 131, 245, 177, 178, 30, 45, 133, 105,
-			188, 83, 37, 105, 84, 62, 196, 220, 149, 78, 43, 241, 38, 161,
-			246, 150, 36, 221, 224, 121, 50, 226, 84, 49, 93, 126, 27, 235,
-			85, 217, 189, 17, 70, 111, 138, 29, 73, 162, 42, 252, 244, 30,
-			80, 141, 64, 18, 245, 159, 25, 239, 174, 8, 48, 87, 101, 150,
-			115, 228, 45, 206, 83, 159, 30, 130, 115, 134, 219, 115, 214, 97,
-			191, 236, 224, 57, 195, 237, 241, 206, 188, 234, 228, 142, 12, 15,
-			62, 140, 243, 245, 242, 181, 11, 203, 124, 161, 147, 110, 69, 49,
-			172, 196, 61, 207, 13, 215, 18, 33, 45, 45, 80, 58, 203, 164,
-			236, 32, 225, 155, 209, 45, 17, 135, 162, 206, 59, 97, 93, 9,
-			141, 11, 109, 191, 6, 136, 131, 154, 8, 19, 49, 207, 223, 33,
-			98, 16, 210, 248, 153, 202, 105, 125, 120, 148, 166, 133, 141, 168,
-			19, 214, 181, 12, 123, 121, 249, 194, 210, 202, 218, 18, 111, 4,
-			77, 56, 29, 246, 49, 66, 123, 60, 90, 236, 157, 197, 159, 142,
-			71, 123, 123, 143, 170, 109, 174, 175, 4, 75, 5, 85, 248, 253,
-			61, 131, 142, 89, 54, 253, 165, 50, 48, 66, 169, 19, 24, 32,
-			71, 245, 185, 218, 133, 20, 211, 169, 162, 71, 7, 250, 167, 172,
-			19, 248, 192, 254, 251, 173, 19, 248, 192, 244, 17, 68, 238, 120,
-			238, 112, 207, 180, 68, 14, 231, 208, 225, 210, 4, 251, 8, 129,
-			68, 159, 71, 199, 251, 7, 203, 47, 17, 77, 34, 254, 16, 174,
-			240, 70, 51, 242, 211, 164, 194, 215, 129, 76, 65, 194, 35, 117,
-			198, 217, 16, 53, 31, 56, 153, 120, 190, 227, 55, 131, 116, 231,
-			100, 45, 106, 181, 253, 56, 72, 162, 16, 69, 92, 166, 74, 162,
-			186, 59, 74, 163, 56, 136, 58, 73, 115, 7, 205, 55, 131, 90,
-			167, 153, 86, 248, 178, 164, 81, 16, 6, 105, 224, 55, 121, 0,
-			60, 50, 19, 248, 163, 6, 95, 188, 50, 207, 120, 219, 223, 105,
-			70, 190, 186, 103, 136, 69, 210, 105, 166, 250, 156, 47, 43, 216,
-			134, 227, 253, 6, 112, 78, 224, 58, 162, 94, 225, 151, 162, 182,
-			104, 116, 154, 205, 29, 192, 191, 51, 19, 195, 193, 31, 78, 71,
-			168, 117, 159, 71, 68, 219, 112, 190, 8, 103, 82, 4, 2, 87,
-			78, 58, 237, 118, 20, 227, 105, 173, 85, 97, 72, 113, 167, 175,
-			7, 136, 50, 128, 116, 116, 250, 128, 170, 86, 138, 232, 148, 235,
-			58, 165, 30, 207, 157, 36, 135, 169, 252, 86, 130, 156, 147, 165,
-			65, 137, 4, 134, 109, 159, 43, 85, 37, 14, 170, 74, 246, 185,
-			76, 167, 28, 143, 238, 235, 31, 210, 41, 234, 209, 125, 163, 158,
-			42, 230, 120, 116, 202, 20, 115, 10, 144, 234, 211, 41, 248, 102,
-			138, 57, 212, 163, 83, 166, 24, 241, 232, 126, 83, 140, 20, 32,
-			165, 139, 1, 202, 253, 166, 24, 161, 30, 221, 111, 138, 81, 143,
-			150, 77, 49, 90, 128, 84, 73, 167, 28, 143, 150, 77, 49, 10,
-			57, 71, 61, 246, 17, 7, 203, 185, 30, 61, 228, 206, 148, 191,
-			207, 65, 157, 23, 79, 182, 162, 78, 179, 206, 163, 176, 153, 157,
-			149, 244, 201, 76, 90, 16, 158, 18, 97, 157, 231, 184, 39, 236,
-			155, 140, 63, 25, 251, 237, 173, 183, 119, 68, 188, 179, 38, 252,
-			184, 182, 85, 225, 203, 13, 125, 70, 18, 184, 158, 124, 88, 69,
-			41, 158, 254, 218, 243, 176, 131, 234, 129, 15, 54, 195, 40, 134,
-			37, 173, 154, 232, 22, 161, 81, 7, 117, 202, 241, 232, 161, 67,
-			211, 58, 69, 61, 122, 232, 216, 113, 213, 235, 130, 71, 185, 123,
-			68, 125, 42, 20, 33, 181, 95, 167, 28, 143, 242, 242, 33, 157,
-			162, 30, 229, 135, 167, 217, 47, 57, 140, 184, 196, 115, 103, 122,
-			206, 58, 229, 159, 114, 184, 197, 175, 96, 162, 55, 163, 77, 216,
-			240, 155, 59, 220, 199, 99, 47, 200, 11, 81, 131, 119, 194, 224,
-			249, 142, 224, 190, 204, 156, 204, 171, 107, 171, 45, 101, 130, 221,
-			106, 251, 181, 148, 117, 111, 175, 138, 131, 212, 162, 86, 43, 10,
-			121, 82, 19, 161, 31, 7, 17, 160, 211, 152, 91, 176, 139, 129,
-			92, 161, 17, 43, 195, 49, 38, 45, 199, 80, 174, 152, 77, 230,
-			212, 22, 12, 163, 63, 83, 26, 195, 9, 11, 92, 200, 157, 37,
-			15, 200, 9, 75, 40, 138, 77, 108, 8, 164, 42, 72, 193, 148,
-			157, 115, 79, 128, 84, 37, 147, 46, 164, 7, 178, 116, 193, 163,
-			115, 131, 99, 89, 218, 241, 232, 220, 248, 84, 150, 166, 30, 157,
-			59, 112, 95, 150, 46, 121, 116, 238, 224, 3, 108, 148, 49, 149,
-			70, 9, 126, 238, 208, 28, 243, 88, 127, 6, 34, 0, 59, 202,
-			198, 216, 128, 5, 235, 145, 192, 113, 54, 104, 3, 29, 9, 205,
-			21, 135, 90, 143, 205, 177, 47, 192, 204, 68, 9, 251, 12, 57,
-			80, 254, 167, 14, 95, 143, 164, 45, 120, 203, 111, 3, 133, 144,
-			46, 201, 201, 52, 58, 105, 232, 150, 70, 92, 222, 130, 242, 40,
-			172, 240, 181, 136, 7, 13, 20, 4, 183, 253, 48, 205, 62, 50,
-			30, 41, 189, 139, 44, 199, 167, 27, 81, 244, 158, 7, 167, 231,
-			229, 143, 51, 211, 200, 89, 166, 55, 252, 24, 129, 184, 177, 108,
-			227, 122, 104, 70, 209, 77, 180, 123, 63, 7, 98, 149, 18, 209,
-			160, 208, 244, 57, 254, 221, 15, 206, 243, 51, 223, 51, 175, 96,
-			27, 126, 140, 48, 9, 120, 81, 42, 20, 9, 42, 92, 207, 152,
-			241, 66, 118, 127, 134, 140, 233, 148, 227, 209, 51, 227, 147, 58,
-			69, 61, 122, 102, 127, 217, 136, 90, 159, 254, 39, 14, 59, 119,
-			239, 178, 214, 38, 172, 195, 235, 117, 63, 245, 45, 129, 235, 46,
-			239, 65, 239, 46, 144, 125, 107, 194, 222, 244, 31, 57, 108, 104,
-			97, 35, 140, 226, 150, 223, 188, 24, 132, 65, 178, 229, 61, 200,
-			138, 73, 234, 167, 29, 105, 55, 56, 116, 102, 63, 138, 13, 185,
-			60, 149, 53, 204, 80, 85, 25, 189, 73, 86, 140, 133, 159, 68,
-			33, 74, 26, 125, 85, 149, 154, 254, 1, 135, 21, 101, 86, 175,
-			159, 245, 46, 175, 188, 99, 225, 242, 242, 226, 72, 143, 199, 88,
-			241, 226, 194, 242, 229, 165, 197, 17, 7, 62, 92, 168, 46, 172,
-			93, 90, 90, 28, 33, 144, 88, 122, 231, 213, 229, 234, 210, 226,
-			8, 245, 6, 89, 223, 250, 242, 149, 165, 197, 235, 171, 215, 214,
-			71, 92, 72, 94, 88, 88, 185, 176, 116, 25, 202, 21, 188, 1,
-			86, 170, 46, 61, 181, 116, 97, 125, 105, 113, 164, 8, 5, 175,
-			44, 175, 193, 161, 121, 164, 215, 27, 103, 35, 213, 165, 181, 107,
-			151, 215, 175, 95, 89, 184, 124, 113, 181, 122, 101, 105, 113, 164,
-			52, 253, 31, 74, 172, 240, 118, 152, 153, 222, 125, 140, 4, 117,
-			236, 93, 255, 153, 1, 232, 29, 130, 43, 203, 139, 85, 18, 212,
-			65, 142, 90, 92, 89, 194, 158, 148, 170, 240, 211, 155, 102, 46,
-			12, 23, 74, 149, 253, 103, 134, 178, 18, 139, 126, 234, 87, 241,
-			155, 119, 150, 149, 244, 100, 159, 114, 51, 185, 78, 230, 83, 76,
-			44, 145, 114, 157, 201, 232, 77, 177, 222, 182, 31, 195, 222, 60,
-			53, 130, 213, 233, 100, 121, 156, 145, 229, 69, 111, 200, 52, 180,
-			15, 154, 86, 254, 170, 203, 220, 69, 145, 212, 188, 135, 217, 62,
-			163, 132, 139, 226, 235, 181, 40, 108, 4, 155, 215, 67, 191, 37,
-			84, 238, 9, 235, 243, 5, 252, 186, 226, 183, 132, 119, 136, 177,
-			182, 31, 251, 45, 145, 138, 56, 81, 131, 101, 65, 188, 55, 177,
-			73, 27, 175, 149, 151, 238, 66, 123, 53, 43, 54, 195, 220, 150,
-			72, 253, 41, 23, 9, 52, 102, 17, 72, 36, 181, 202, 21, 1,
-			84, 130, 12, 229, 143, 83, 230, 66, 210, 59, 200, 152, 159, 92,
-			247, 107, 120, 31, 160, 218, 220, 231, 39, 11, 18, 224, 157, 98,
-			133, 88, 164, 241, 142, 146, 92, 247, 239, 129, 177, 82, 133, 12,
-			85, 153, 207, 123, 11, 43, 225, 85, 111, 39, 77, 212, 48, 221,
-			183, 87, 153, 117, 149, 167, 106, 114, 151, 219, 172, 128, 152, 96,
-			18, 55, 252, 160, 41, 36, 193, 7, 171, 42, 5, 131, 84, 139,
-			253, 100, 75, 212, 177, 53, 131, 85, 157, 132, 47, 226, 118, 59,
-			136, 69, 29, 235, 28, 172, 234, 164, 119, 128, 245, 161, 109, 250,
-			245, 168, 147, 34, 85, 6, 101, 141, 245, 213, 78, 90, 254, 152,
-			195, 74, 186, 33, 208, 83, 220, 181, 213, 116, 220, 191, 235, 92,
-			161, 109, 174, 171, 50, 159, 119, 130, 209, 184, 19, 26, 194, 220,
-			49, 59, 228, 242, 78, 50, 55, 73, 163, 182, 34, 201, 107, 228,
-			198, 108, 229, 45, 54, 176, 46, 90, 237, 166, 159, 138, 181, 182,
-			168, 225, 252, 140, 35, 16, 250, 212, 16, 233, 36, 44, 146, 88,
-			52, 212, 12, 130, 159, 144, 247, 150, 148, 216, 213, 92, 209, 73,
-			207, 99, 46, 206, 76, 23, 193, 248, 187, 252, 183, 29, 230, 194,
-			234, 241, 30, 2, 234, 10, 56, 236, 43, 10, 188, 198, 89, 172,
-			170, 179, 226, 138, 20, 73, 77, 81, 97, 40, 63, 212, 85, 252,
-			230, 61, 200, 74, 27, 157, 160, 153, 94, 223, 216, 153, 162, 184,
-			34, 39, 179, 124, 118, 55, 171, 189, 152, 239, 252, 78, 249, 18,
-			27, 204, 45, 85, 251, 76, 53, 40, 207, 84, 135, 243, 103, 170,
-			126, 235, 76, 101, 29, 165, 166, 83, 198, 158, 74, 162, 176, 138,
-			210, 52, 76, 45, 121, 98, 87, 100, 84, 41, 160, 76, 18, 188,
-			32, 212, 188, 194, 223, 222, 57, 198, 112, 22, 225, 184, 168, 129,
-			123, 45, 154, 88, 185, 167, 3, 86, 84, 53, 106, 150, 229, 100,
-			4, 202, 218, 163, 88, 214, 163, 108, 216, 87, 108, 253, 122, 3,
-			249, 186, 234, 148, 183, 155, 227, 87, 135, 252, 92, 122, 250, 127,
-			99, 172, 87, 245, 219, 59, 100, 241, 211, 33, 139, 32, 119, 230,
-			168, 71, 115, 28, 117, 196, 46, 99, 241, 212, 71, 129, 20, 162,
-			38, 111, 73, 20, 87, 61, 96, 231, 93, 50, 95, 37, 103, 181,
-			178, 123, 15, 176, 82, 99, 187, 126, 189, 46, 218, 9, 30, 253,
-			251, 207, 12, 119, 157, 127, 171, 189, 141, 237, 250, 162, 104, 39,
-			222, 60, 235, 219, 240, 107, 55, 101, 230, 226, 222, 153, 75, 144,
-			3, 115, 159, 204, 115, 109, 197, 240, 116, 155, 174, 202, 79, 25,
-			43, 127, 0, 89, 249, 56, 43, 160, 88, 164, 102, 128, 76, 40,
-			6, 47, 135, 31, 24, 252, 159, 184, 223, 214, 178, 120, 152, 149,
-			228, 173, 153, 226, 85, 175, 93, 204, 228, 245, 142, 177, 161, 176,
-			211, 186, 110, 17, 91, 242, 179, 193, 176, 211, 202, 104, 236, 61,
-			198, 88, 82, 219, 18, 245, 78, 51, 8, 55, 21, 81, 15, 116,
-			143, 93, 101, 205, 100, 185, 212, 83, 181, 10, 120, 231, 88, 159,
-			170, 33, 220, 52, 138, 151, 238, 210, 75, 58, 199, 165, 158, 106,
-			150, 29, 232, 177, 237, 7, 88, 82, 42, 96, 166, 118, 149, 124,
-			90, 126, 191, 212, 83, 213, 89, 189, 55, 179, 146, 156, 216, 162,
-			62, 85, 202, 118, 146, 92, 177, 139, 42, 195, 165, 158, 170, 201,
-			236, 61, 182, 123, 105, 244, 221, 105, 105, 92, 234, 233, 94, 28,
-			229, 1, 198, 50, 42, 148, 223, 196, 250, 76, 175, 188, 89, 54,
-			82, 235, 196, 25, 169, 175, 7, 122, 191, 25, 170, 117, 98, 67,
-			236, 229, 122, 249, 1, 214, 171, 186, 228, 221, 207, 250, 97, 124,
-			52, 5, 100, 126, 22, 118, 90, 42, 67, 185, 194, 74, 186, 31,
-			247, 178, 244, 207, 15, 177, 1, 37, 132, 92, 7, 105, 176, 124,
-			153, 13, 119, 173, 165, 61, 88, 223, 145, 60, 235, 27, 4, 204,
-			166, 148, 197, 252, 202, 127, 230, 176, 94, 181, 12, 128, 197, 153,
-			214, 148, 212, 186, 62, 148, 91, 215, 146, 45, 216, 75, 119, 191,
-			181, 116, 169, 148, 139, 244, 74, 61, 96, 175, 84, 23, 191, 101,
-			11, 243, 12, 136, 161, 208, 75, 156, 155, 67, 249, 217, 165, 26,
-			84, 81, 116, 80, 57, 167, 175, 24, 150, 201, 88, 241, 242, 234,
-			194, 226, 18, 8, 168, 67, 140, 173, 172, 174, 95, 87, 105, 199,
-			243, 216, 16, 164, 23, 174, 173, 95, 90, 173, 46, 191, 11, 101,
-			213, 49, 54, 188, 184, 176, 190, 112, 125, 109, 249, 93, 75, 215,
-			241, 162, 106, 132, 78, 191, 147, 21, 64, 224, 21, 128, 97, 237,
-			194, 165, 165, 197, 107, 151, 65, 38, 237, 1, 233, 117, 233, 157,
-			75, 23, 174, 173, 67, 18, 165, 222, 167, 23, 150, 49, 65, 64,
-			148, 189, 184, 188, 178, 140, 50, 48, 245, 38, 216, 232, 194, 249,
-			149, 213, 234, 149, 133, 203, 215, 13, 216, 157, 254, 217, 146, 153,
-			70, 81, 232, 113, 139, 229, 142, 228, 6, 66, 51, 221, 227, 138,
-			240, 22, 75, 207, 242, 88, 76, 246, 206, 50, 232, 227, 204, 93,
-			232, 164, 91, 247, 80, 215, 56, 43, 164, 209, 77, 33, 165, 146,
-			129, 170, 76, 148, 23, 95, 131, 241, 77, 177, 94, 53, 1, 181,
-			80, 165, 146, 138, 37, 82, 195, 18, 127, 175, 240, 255, 10, 75,
-			188, 194, 70, 108, 73, 56, 8, 27, 145, 218, 173, 166, 119, 147,
-			178, 178, 152, 101, 93, 14, 27, 81, 117, 184, 158, 7, 120, 79,
-			228, 88, 167, 148, 147, 15, 238, 129, 232, 142, 204, 243, 97, 214,
-			27, 119, 194, 48, 99, 188, 229, 61, 74, 87, 101, 14, 96, 129,
-			42, 179, 247, 8, 43, 129, 104, 215, 206, 120, 238, 129, 189, 170,
-			85, 89, 128, 9, 234, 236, 80, 212, 112, 207, 222, 59, 22, 189,
-			87, 254, 89, 122, 29, 252, 243, 253, 14, 27, 238, 34, 41, 240,
-			192, 221, 199, 28, 86, 203, 206, 54, 199, 216, 144, 202, 160, 229,
-			80, 41, 157, 14, 74, 168, 82, 39, 103, 115, 85, 74, 169, 50,
-			1, 220, 174, 19, 55, 149, 136, 10, 63, 187, 120, 120, 31, 235,
-			85, 196, 45, 51, 86, 210, 228, 122, 221, 124, 119, 132, 13, 101,
-			156, 31, 56, 239, 244, 211, 119, 98, 24, 253, 172, 183, 122, 109,
-			101, 69, 178, 139, 1, 86, 90, 91, 95, 189, 122, 245, 117, 240,
-			139, 127, 230, 176, 62, 84, 249, 225, 226, 121, 144, 21, 165, 86,
-			70, 93, 190, 224, 62, 104, 62, 75, 161, 88, 9, 81, 42, 35,
-			28, 209, 182, 252, 250, 117, 17, 199, 81, 172, 185, 116, 223, 150,
-			95, 95, 66, 0, 48, 105, 248, 220, 138, 98, 161, 153, 244, 150,
-			95, 191, 18, 197, 162, 188, 200, 250, 45, 132, 123, 92, 76, 220,
-			159, 223, 73, 250, 140, 92, 110, 223, 70, 252, 250, 15, 59, 172,
-			215, 43, 184, 61, 191, 79, 191, 3, 238, 35, 102, 178, 251, 8,
-			121, 53, 65, 60, 218, 215, 251, 184, 186, 154, 232, 207, 174, 38,
-			6, 123, 106, 217, 213, 196, 96, 105, 18, 213, 137, 61, 240, 101,
-			136, 92, 151, 234, 169, 30, 252, 54, 212, 59, 200, 254, 142, 195,
-			138, 144, 36, 61, 30, 245, 220, 145, 242, 135, 29, 121, 141, 32,
-			245, 50, 210, 105, 196, 45, 191, 25, 200, 23, 183, 74, 119, 28,
-			70, 169, 81, 29, 7, 97, 42, 66, 152, 141, 126, 179, 185, 83,
-			97, 76, 153, 236, 251, 188, 221, 244, 107, 98, 43, 106, 34, 53,
-			34, 30, 212, 33, 91, 99, 135, 159, 62, 169, 110, 19, 130, 23,
-			68, 157, 231, 87, 178, 50, 196, 238, 196, 34, 169, 48, 212, 77,
-			246, 168, 187, 18, 207, 237, 207, 210, 196, 163, 222, 208, 48, 251,
-			65, 162, 218, 238, 120, 244, 160, 59, 92, 254, 75, 213, 118, 168,
-			40, 221, 81, 186, 91, 121, 6, 87, 183, 18, 210, 126, 93, 139,
-			9, 231, 148, 179, 10, 195, 38, 120, 44, 218, 81, 156, 218, 86,
-			97, 169, 159, 220, 228, 114, 197, 9, 73, 3, 137, 112, 158, 175,
-			86, 217, 29, 202, 39, 60, 233, 212, 106, 34, 73, 248, 246, 86,
-			208, 148, 246, 87, 166, 86, 73, 82, 132, 169, 213, 201, 144, 216,
-			232, 90, 64, 159, 29, 101, 203, 154, 126, 146, 90, 5, 183, 252,
-			58, 247, 185, 84, 123, 113, 169, 20, 171, 160, 165, 92, 26, 239,
-			248, 27, 56, 89, 52, 133, 28, 164, 136, 149, 38, 30, 61, 56,
-			56, 196, 254, 163, 30, 109, 226, 209, 25, 119, 164, 252, 187, 121,
-			138, 41, 90, 161, 34, 127, 195, 175, 243, 109, 127, 167, 155, 98,
-			235, 93, 61, 78, 163, 102, 157, 119, 146, 140, 96, 207, 69, 27,
-			188, 30, 136, 58, 191, 21, 68, 77, 17, 166, 205, 29, 69, 133,
-			32, 148, 244, 202, 120, 212, 188, 166, 0, 143, 98, 174, 185, 211,
-			235, 34, 134, 210, 251, 221, 133, 26, 168, 86, 183, 230, 15, 246,
-			126, 104, 152, 253, 180, 158, 63, 212, 163, 103, 220, 145, 242, 223,
-			33, 28, 196, 97, 188, 108, 138, 164, 149, 180, 126, 5, 14, 189,
-			74, 35, 229, 96, 225, 46, 147, 168, 6, 107, 36, 156, 73, 149,
-			107, 8, 93, 92, 153, 170, 162, 33, 207, 226, 21, 69, 107, 124,
-			4, 190, 41, 82, 238, 235, 21, 215, 105, 227, 27, 14, 233, 145,
-			164, 11, 179, 126, 16, 52, 43, 42, 155, 21, 125, 95, 147, 10,
-			190, 237, 39, 204, 162, 171, 108, 181, 234, 194, 220, 189, 145, 50,
-			228, 74, 109, 122, 23, 90, 2, 3, 58, 99, 209, 18, 184, 208,
-			153, 161, 97, 246, 143, 53, 45, 93, 143, 62, 230, 122, 229, 159,
-			38, 56, 85, 160, 227, 72, 6, 88, 49, 27, 157, 20, 154, 117,
-			211, 180, 237, 110, 148, 84, 37, 53, 9, 37, 134, 32, 205, 40,
-			92, 139, 90, 237, 166, 72, 213, 228, 218, 69, 221, 111, 133, 184,
-			108, 47, 234, 154, 137, 250, 45, 81, 214, 232, 160, 239, 66, 91,
-			215, 1, 218, 13, 102, 105, 226, 209, 199, 70, 70, 217, 223, 212,
-			180, 45, 120, 244, 162, 235, 149, 191, 233, 24, 218, 66, 219, 106,
-			126, 88, 19, 77, 232, 238, 198, 14, 84, 39, 110, 167, 34, 14,
-			253, 166, 94, 213, 179, 91, 157, 150, 31, 206, 115, 191, 147, 70,
-			45, 116, 220, 147, 160, 201, 255, 220, 221, 200, 15, 34, 107, 140,
-			239, 173, 22, 175, 228, 23, 56, 84, 219, 142, 5, 244, 60, 184,
-			37, 154, 59, 44, 107, 196, 189, 174, 92, 173, 136, 87, 52, 153,
-			151, 198, 53, 65, 162, 11, 179, 174, 190, 221, 10, 124, 190, 120,
-			197, 162, 86, 193, 1, 106, 100, 212, 42, 16, 143, 94, 28, 25,
-			101, 255, 131, 230, 113, 69, 143, 174, 184, 163, 229, 127, 145, 167,
-			86, 59, 22, 183, 228, 251, 56, 156, 5, 74, 202, 5, 210, 117,
-			119, 127, 246, 249, 78, 148, 250, 243, 150, 185, 103, 50, 207, 184,
-			72, 107, 149, 185, 187, 110, 35, 13, 109, 130, 26, 119, 66, 217,
-			173, 231, 162, 141, 123, 36, 141, 190, 148, 200, 102, 139, 238, 98,
-			209, 129, 46, 13, 100, 105, 226, 209, 149, 225, 17, 246, 15, 117,
-			151, 123, 61, 250, 14, 119, 164, 252, 147, 89, 151, 131, 132, 119,
-			194, 88, 212, 162, 205, 16, 246, 219, 187, 13, 121, 61, 18, 9,
-			44, 172, 155, 97, 180, 205, 253, 141, 8, 86, 172, 106, 61, 142,
-			16, 236, 169, 141, 40, 222, 140, 210, 84, 132, 76, 229, 8, 210,
-			123, 236, 153, 186, 96, 217, 221, 177, 94, 7, 26, 158, 113, 149,
-			94, 226, 209, 119, 12, 13, 179, 31, 208, 51, 191, 228, 209, 103,
-			221, 253, 229, 255, 203, 217, 181, 1, 197, 126, 152, 231, 15, 210,
-			106, 74, 212, 249, 166, 31, 111, 248, 155, 226, 238, 123, 190, 202,
-			239, 243, 48, 106, 5, 40, 199, 232, 13, 188, 209, 105, 42, 201,
-			1, 113, 203, 13, 12, 228, 93, 14, 251, 188, 229, 230, 68, 102,
-			130, 9, 6, 212, 195, 55, 132, 105, 4, 50, 146, 148, 110, 52,
-			233, 239, 105, 244, 243, 247, 78, 187, 137, 85, 114, 128, 24, 227,
-			89, 154, 120, 244, 217, 125, 83, 153, 5, 138, 79, 198, 173, 71,
-			32, 190, 17, 250, 240, 78, 210, 39, 37, 203, 4, 197, 239, 179,
-			31, 129, 248, 222, 152, 194, 226, 120, 116, 195, 96, 113, 92, 207,
-			221, 32, 190, 253, 84, 98, 195, 96, 1, 81, 99, 195, 96, 113,
-			168, 71, 55, 16, 11, 112, 179, 130, 232, 249, 35, 39, 179, 100,
-			17, 248, 162, 194, 117, 29, 218, 227, 185, 13, 178, 165, 108, 49,
-			240, 106, 187, 193, 6, 240, 106, 219, 145, 87, 219, 155, 174, 199,
-			134, 89, 175, 76, 186, 158, 187, 233, 54, 134, 176, 195, 142, 186,
-			219, 222, 84, 210, 141, 163, 238, 182, 55, 251, 7, 179, 52, 245,
-			232, 230, 200, 104, 102, 219, 17, 144, 1, 236, 138, 131, 184, 2,
-			83, 51, 18, 36, 32, 174, 101, 234, 17, 20, 122, 45, 83, 143,
-			128, 245, 179, 239, 213, 166, 30, 45, 50, 92, 142, 249, 226, 202,
-			18, 44, 170, 68, 224, 173, 115, 26, 119, 208, 241, 17, 46, 18,
-			60, 128, 224, 18, 66, 9, 89, 220, 14, 146, 180, 194, 87, 162,
-			80, 232, 105, 98, 140, 233, 153, 116, 15, 35, 95, 165, 74, 249,
-			90, 99, 201, 35, 87, 239, 85, 28, 28, 132, 22, 9, 6, 44,
-			107, 147, 22, 41, 90, 214, 38, 173, 94, 102, 89, 155, 180, 6,
-			135, 216, 127, 67, 145, 216, 142, 87, 136, 200, 255, 232, 208, 233,
-			247, 81, 190, 190, 186, 184, 58, 27, 248, 97, 216, 169, 213, 130,
-			185, 115, 242, 201, 141, 207, 103, 18, 180, 236, 185, 30, 212, 69,
-			171, 29, 129, 64, 63, 35, 239, 224, 121, 11, 142, 55, 104, 11,
-			129, 247, 226, 15, 240, 64, 206, 112, 232, 135, 168, 159, 18, 183,
-			219, 104, 208, 3, 205, 173, 249, 205, 38, 62, 78, 206, 108, 33,
-			19, 62, 187, 80, 75, 131, 91, 126, 42, 204, 60, 199, 91, 243,
-			37, 124, 217, 106, 142, 143, 200, 91, 158, 246, 155, 55, 17, 50,
-			39, 107, 66, 11, 21, 245, 120, 64, 189, 70, 217, 240, 107, 55,
-			121, 186, 21, 71, 157, 205, 173, 238, 133, 124, 210, 88, 235, 207,
-			224, 190, 61, 3, 88, 164, 19, 158, 236, 233, 24, 218, 95, 116,
-			18, 237, 222, 199, 220, 254, 111, 8, 213, 61, 73, 8, 158, 66,
-			223, 241, 73, 69, 32, 148, 112, 27, 119, 194, 83, 81, 39, 109,
-			163, 16, 3, 251, 236, 242, 218, 234, 229, 133, 117, 185, 179, 47,
-			46, 87, 101, 121, 40, 8, 251, 201, 41, 238, 215, 79, 110, 69,
-			53, 190, 225, 195, 177, 166, 22, 7, 237, 52, 81, 3, 68, 97,
-			184, 34, 54, 196, 190, 233, 224, 140, 199, 57, 250, 94, 119, 166,
-			252, 31, 149, 56, 46, 95, 56, 248, 217, 251, 131, 188, 120, 25,
-			54, 130, 77, 237, 148, 106, 54, 138, 185, 223, 12, 252, 100, 78,
-			157, 42, 152, 54, 53, 157, 73, 236, 98, 73, 165, 214, 216, 148,
-			103, 74, 89, 135, 182, 204, 209, 27, 21, 218, 63, 116, 218, 93,
-			239, 51, 162, 120, 38, 233, 182, 250, 82, 158, 2, 67, 81, 83,
-			70, 48, 242, 93, 16, 252, 222, 222, 18, 161, 97, 114, 64, 99,
-			102, 175, 12, 152, 235, 75, 234, 40, 85, 97, 106, 125, 203, 53,
-			249, 94, 55, 26, 81, 235, 87, 26, 96, 189, 215, 172, 111, 185,
-			46, 223, 219, 63, 157, 165, 169, 71, 223, 123, 236, 56, 251, 53,
-			170, 200, 231, 120, 238, 135, 29, 183, 92, 254, 199, 148, 47, 228,
-			204, 91, 165, 17, 79, 45, 138, 165, 201, 174, 124, 93, 46, 13,
-			218, 96, 28, 179, 219, 105, 91, 208, 175, 160, 61, 109, 34, 12,
-			137, 218, 126, 146, 232, 99, 209, 158, 19, 110, 219, 223, 209, 136,
-			161, 188, 54, 196, 99, 112, 252, 140, 133, 192, 55, 57, 86, 179,
-			164, 121, 27, 62, 210, 105, 251, 113, 34, 240, 165, 126, 167, 182,
-			37, 247, 47, 124, 62, 179, 29, 36, 66, 111, 198, 140, 135, 2,
-			54, 35, 63, 14, 154, 59, 122, 197, 162, 74, 34, 62, 41, 128,
-			248, 53, 16, 233, 106, 91, 162, 229, 87, 148, 45, 112, 78, 138,
-			203, 143, 94, 16, 130, 52, 135, 93, 3, 38, 133, 6, 212, 104,
-			195, 132, 231, 120, 109, 159, 148, 8, 60, 153, 180, 252, 155, 2,
-			151, 116, 166, 71, 228, 117, 81, 11, 80, 24, 218, 171, 178, 43,
-			11, 207, 240, 218, 86, 20, 73, 179, 108, 100, 108, 248, 34, 61,
-			106, 9, 213, 68, 77, 233, 68, 88, 228, 175, 48, 54, 162, 6,
-			215, 113, 189, 194, 135, 29, 247, 189, 51, 102, 126, 56, 5, 28,
-			222, 108, 194, 56, 56, 222, 253, 19, 25, 128, 2, 96, 106, 63,
-			251, 45, 162, 102, 4, 241, 220, 143, 56, 238, 241, 242, 175, 144,
-			123, 157, 17, 123, 14, 108, 214, 66, 150, 159, 33, 187, 123, 142,
-			198, 231, 66, 154, 64, 234, 126, 75, 243, 241, 238, 126, 43, 235,
-			70, 24, 129, 14, 210, 121, 75, 232, 183, 145, 91, 34, 22, 176,
-			140, 21, 229, 13, 169, 149, 160, 181, 21, 109, 103, 199, 201, 4,
-			56, 146, 246, 144, 0, 11, 55, 218, 173, 143, 216, 222, 18, 250,
-			73, 85, 98, 42, 193, 135, 112, 114, 74, 71, 161, 53, 111, 101,
-			187, 130, 6, 79, 34, 124, 224, 181, 13, 92, 15, 230, 110, 133,
-			177, 81, 69, 105, 226, 122, 197, 143, 56, 238, 135, 157, 178, 33,
-			62, 41, 32, 173, 179, 225, 129, 229, 248, 17, 167, 255, 112, 6,
-			160, 0, 56, 122, 140, 141, 200, 209, 161, 61, 94, 241, 163, 142,
-			251, 69, 167, 160, 179, 128, 8, 224, 126, 212, 25, 24, 97, 63,
-			239, 176, 146, 132, 144, 30, 207, 253, 97, 167, 120, 95, 249, 239,
-			230, 184, 34, 52, 182, 147, 136, 88, 219, 35, 113, 101, 2, 130,
-			244, 133, 29, 200, 176, 159, 72, 73, 222, 184, 145, 85, 248, 51,
-			81, 135, 201, 69, 135, 75, 40, 147, 234, 181, 193, 63, 102, 214,
-			216, 144, 147, 225, 21, 128, 242, 114, 161, 121, 24, 190, 113, 203,
-			36, 185, 10, 99, 99, 172, 79, 183, 216, 245, 138, 63, 236, 20,
-			63, 234, 120, 108, 52, 3, 22, 176, 31, 3, 54, 200, 1, 208,
-			224, 62, 27, 68, 1, 84, 62, 192, 254, 194, 16, 0, 200, 244,
-			227, 78, 241, 239, 59, 189, 229, 63, 114, 228, 129, 209, 88, 91,
-			74, 58, 132, 157, 214, 134, 136, 181, 119, 189, 68, 114, 168, 24,
-			39, 137, 159, 194, 50, 87, 250, 186, 88, 156, 84, 124, 151, 225,
-			91, 5, 101, 27, 87, 239, 8, 61, 252, 230, 141, 158, 214, 163,
-			91, 10, 179, 149, 213, 245, 37, 169, 248, 193, 251, 14, 158, 250,
-			155, 170, 230, 196, 90, 204, 87, 174, 173, 173, 163, 219, 214, 166,
-			116, 23, 152, 61, 246, 19, 97, 167, 37, 212, 118, 165, 166, 96,
-			212, 224, 123, 218, 131, 29, 102, 25, 73, 112, 78, 252, 184, 51,
-			52, 198, 118, 24, 211, 32, 152, 20, 175, 56, 165, 114, 89, 96,
-			131, 238, 76, 128, 136, 163, 89, 143, 37, 249, 27, 139, 78, 224,
-			159, 33, 227, 187, 84, 222, 90, 73, 16, 53, 148, 146, 173, 194,
-			216, 4, 235, 207, 170, 118, 189, 226, 43, 78, 233, 199, 157, 9,
-			54, 102, 131, 11, 216, 164, 161, 60, 208, 1, 224, 112, 87, 78,
-			10, 192, 169, 253, 236, 5, 171, 67, 142, 231, 254, 164, 83, 58,
-			80, 110, 252, 181, 118, 72, 41, 202, 186, 122, 228, 184, 94, 241,
-			39, 157, 210, 43, 78, 57, 215, 78, 96, 184, 63, 217, 221, 35,
-			7, 27, 58, 60, 153, 7, 82, 0, 238, 47, 231, 122, 68, 60,
-			247, 213, 191, 254, 30, 41, 117, 85, 87, 143, 128, 63, 189, 234,
-			148, 126, 210, 57, 144, 107, 39, 240, 168, 87, 187, 123, 4, 164,
-			127, 181, 187, 71, 192, 171, 94, 133, 30, 189, 199, 234, 17, 245,
-			220, 159, 115, 74, 7, 203, 91, 127, 173, 61, 50, 106, 162, 174,
-			62, 81, 215, 43, 254, 156, 83, 122, 181, 171, 79, 180, 128, 173,
-			202, 247, 137, 58, 0, 28, 158, 202, 3, 177, 253, 7, 238, 99,
-			129, 225, 173, 142, 231, 254, 3, 167, 56, 89, 126, 90, 178, 86,
-			191, 209, 16, 181, 52, 193, 13, 70, 75, 7, 178, 43, 122, 187,
-			81, 38, 254, 208, 217, 91, 62, 62, 20, 224, 226, 118, 77, 180,
-			165, 230, 159, 241, 90, 16, 215, 58, 45, 249, 230, 41, 207, 20,
-			97, 146, 253, 3, 100, 99, 22, 187, 115, 138, 216, 128, 126, 27,
-			132, 109, 26, 24, 181, 65, 20, 64, 227, 19, 236, 255, 116, 13,
-			83, 116, 188, 226, 175, 56, 197, 127, 227, 244, 150, 255, 157, 203,
-			215, 131, 150, 20, 73, 64, 192, 222, 80, 92, 209, 111, 105, 143,
-			217, 168, 44, 69, 94, 104, 141, 136, 145, 252, 223, 46, 31, 117,
-			41, 30, 9, 67, 52, 175, 173, 135, 179, 71, 207, 128, 162, 25,
-			132, 242, 120, 176, 116, 75, 132, 233, 57, 110, 46, 224, 120, 34,
-			148, 241, 113, 182, 231, 74, 35, 225, 103, 249, 52, 42, 47, 167,
-			249, 173, 59, 21, 172, 39, 124, 215, 217, 200, 20, 142, 59, 225,
-			107, 23, 221, 242, 155, 184, 51, 85, 175, 94, 224, 179, 234, 225,
-			244, 46, 116, 92, 55, 167, 235, 184, 53, 103, 53, 50, 106, 231,
-			43, 178, 197, 135, 205, 224, 150, 72, 172, 170, 149, 150, 67, 158,
-			194, 34, 190, 120, 133, 49, 190, 44, 79, 182, 144, 85, 42, 80,
-			81, 80, 9, 82, 227, 125, 5, 55, 42, 113, 59, 229, 168, 119,
-			211, 119, 31, 154, 174, 243, 128, 69, 78, 184, 150, 31, 223, 236,
-			186, 43, 241, 147, 108, 85, 204, 155, 39, 233, 232, 233, 187, 29,
-			7, 32, 89, 202, 89, 218, 142, 154, 65, 109, 135, 25, 137, 221,
-			111, 183, 155, 129, 84, 185, 44, 55, 184, 223, 213, 58, 212, 137,
-			53, 131, 86, 128, 90, 233, 166, 128, 197, 137, 146, 147, 62, 84,
-			117, 66, 56, 129, 71, 49, 63, 93, 177, 55, 36, 152, 161, 191,
-			226, 12, 77, 50, 207, 240, 6, 56, 186, 185, 191, 234, 148, 78,
-			88, 43, 22, 79, 52, 197, 95, 117, 74, 191, 226, 216, 235, 16,
-			181, 13, 144, 151, 231, 129, 14, 0, 15, 31, 207, 3, 41, 0,
-			231, 30, 200, 213, 228, 120, 238, 231, 156, 210, 92, 174, 38, 88,
-			92, 159, 115, 74, 191, 234, 156, 200, 149, 135, 229, 245, 185, 238,
-			154, 28, 68, 112, 248, 104, 30, 72, 1, 56, 51, 155, 171, 137,
-			120, 238, 231, 157, 210, 3, 185, 154, 128, 179, 126, 222, 41, 125,
-			206, 153, 203, 149, 39, 69, 204, 155, 175, 9, 154, 250, 121, 231,
-			240, 177, 60, 144, 2, 112, 118, 142, 141, 24, 46, 68, 60, 247,
-			11, 78, 241, 62, 139, 89, 64, 45, 95, 192, 229, 109, 177, 1,
-			168, 227, 11, 78, 113, 200, 6, 57, 0, 26, 182, 37, 40, 192,
-			255, 5, 144, 160, 126, 78, 159, 170, 169, 231, 126, 201, 113, 61,
-			35, 64, 6, 9, 111, 137, 212, 71, 77, 130, 228, 203, 90, 221,
-			41, 249, 159, 92, 250, 157, 176, 38, 153, 90, 144, 238, 104, 189,
-			141, 226, 126, 243, 12, 79, 104, 168, 225, 81, 69, 20, 199, 68,
-			55, 25, 126, 77, 63, 123, 218, 67, 14, 15, 173, 51, 148, 173,
-			144, 180, 132, 107, 96, 244, 95, 202, 201, 197, 132, 22, 177, 15,
-			165, 12, 224, 0, 160, 111, 48, 3, 96, 47, 71, 70, 217, 16,
-			42, 123, 136, 87, 252, 77, 135, 124, 197, 161, 82, 109, 68, 129,
-			80, 191, 233, 176, 73, 54, 132, 68, 33, 48, 95, 191, 236, 184,
-			251, 84, 165, 248, 108, 161, 248, 101, 199, 253, 77, 103, 74, 161,
-			36, 40, 209, 124, 57, 147, 232, 241, 245, 130, 251, 101, 167, 223,
-			203, 0, 20, 0, 19, 25, 86, 199, 115, 127, 203, 113, 199, 12,
-			86, 152, 151, 191, 229, 184, 95, 118, 246, 153, 50, 32, 85, 252,
-			150, 141, 213, 193, 66, 253, 67, 25, 128, 2, 96, 212, 51, 88,
-			137, 231, 254, 182, 221, 86, 152, 29, 191, 237, 184, 191, 229, 140,
-			153, 50, 176, 179, 255, 182, 141, 21, 154, 242, 219, 118, 91, 97,
-			26, 252, 182, 221, 86, 234, 185, 191, 227, 184, 227, 6, 43, 144,
-			253, 119, 28, 247, 183, 173, 182, 194, 222, 250, 59, 54, 86, 32,
-			251, 239, 56, 253, 195, 25, 0, 177, 120, 99, 138, 236, 212, 43,
-			254, 174, 67, 126, 223, 144, 29, 242, 255, 174, 195, 134, 84, 165,
-			20, 200, 254, 123, 142, 251, 128, 170, 148, 34, 217, 127, 207, 113,
-			127, 215, 25, 81, 40, 41, 178, 135, 223, 115, 220, 131, 25, 192,
-			1, 192, 161, 99, 25, 128, 2, 96, 118, 206, 96, 117, 60, 247,
-			171, 142, 123, 192, 96, 5, 178, 127, 213, 113, 127, 207, 121, 192,
-			148, 1, 86, 240, 85, 199, 29, 205, 0, 88, 200, 155, 204, 0,
-			20, 0, 251, 203, 6, 43, 241, 220, 175, 57, 238, 105, 147, 129,
-			184, 8, 24, 200, 0, 69, 0, 12, 78, 103, 0, 7, 0, 71,
-			78, 100, 0, 10, 128, 202, 41, 169, 239, 5, 140, 127, 224, 16,
-			232, 42, 62, 107, 115, 189, 226, 31, 88, 212, 66, 124, 127, 224,
-			144, 162, 78, 58, 144, 236, 237, 215, 73, 10, 201, 161, 97, 246,
-			102, 245, 12, 206, 253, 67, 135, 28, 45, 207, 241, 155, 98, 7,
-			223, 107, 111, 9, 126, 35, 168, 107, 23, 221, 106, 201, 102, 22,
-			196, 76, 85, 11, 227, 252, 135, 14, 249, 3, 103, 68, 33, 134,
-			197, 245, 135, 14, 153, 212, 73, 7, 146, 251, 238, 215, 73, 172,
-			104, 250, 8, 107, 170, 87, 116, 238, 191, 119, 200, 120, 249, 89,
-			174, 236, 0, 177, 106, 169, 254, 109, 216, 207, 196, 185, 159, 220,
-			84, 142, 3, 81, 194, 144, 250, 77, 224, 27, 249, 219, 1, 102,
-			110, 47, 155, 59, 188, 1, 27, 23, 222, 163, 200, 182, 186, 174,
-			87, 252, 247, 14, 249, 67, 231, 168, 106, 141, 91, 192, 250, 53,
-			137, 92, 7, 146, 189, 67, 58, 73, 33, 57, 58, 198, 126, 77,
-			62, 126, 43, 126, 221, 233, 249, 115, 199, 41, 255, 19, 135, 103,
-			22, 68, 121, 23, 147, 141, 88, 136, 147, 221, 154, 41, 84, 39,
-			72, 187, 137, 150, 127, 59, 104, 117, 90, 60, 9, 94, 16, 60,
-			106, 48, 126, 230, 77, 15, 191, 237, 188, 117, 159, 193, 103, 195,
-			136, 139, 219, 105, 140, 204, 52, 21, 73, 219, 175, 137, 185, 138,
-			165, 93, 82, 57, 121, 16, 214, 162, 22, 178, 61, 168, 106, 21,
-			107, 50, 226, 65, 44, 106, 126, 179, 214, 105, 154, 131, 118, 16,
-			99, 157, 248, 192, 19, 159, 196, 185, 95, 119, 74, 30, 251, 255,
-			235, 39, 99, 238, 55, 96, 24, 222, 203, 159, 236, 248, 177, 31,
-			166, 234, 109, 40, 108, 250, 93, 47, 219, 43, 149, 202, 139, 55,
-			244, 155, 125, 249, 244, 93, 122, 70, 228, 179, 150, 174, 189, 237,
-			199, 41, 147, 46, 173, 244, 192, 42, 49, 7, 239, 7, 241, 46,
-			216, 207, 116, 211, 32, 112, 205, 169, 97, 146, 204, 243, 27, 14,
-			249, 186, 51, 174, 31, 129, 21, 176, 125, 37, 157, 116, 32, 217,
-			55, 172, 147, 20, 146, 222, 24, 251, 58, 193, 206, 56, 158, 251,
-			167, 14, 241, 202, 95, 147, 151, 229, 42, 30, 76, 212, 64, 101,
-			119, 69, 138, 86, 184, 105, 73, 231, 7, 248, 76, 55, 60, 25,
-			6, 77, 253, 114, 205, 120, 205, 242, 149, 215, 122, 144, 177, 24,
-			15, 26, 154, 10, 230, 209, 191, 188, 212, 70, 29, 9, 111, 231,
-			250, 57, 151, 61, 28, 238, 36, 162, 209, 105, 98, 174, 231, 59,
-			34, 222, 97, 217, 99, 222, 45, 17, 11, 124, 111, 167, 100, 205,
-			168, 141, 130, 111, 24, 165, 28, 207, 5, 184, 227, 193, 198, 58,
-			139, 174, 182, 234, 162, 242, 64, 5, 211, 143, 61, 198, 120, 195,
-			111, 38, 98, 110, 126, 151, 255, 4, 56, 65, 8, 229, 20, 20,
-			231, 25, 10, 101, 124, 54, 137, 140, 143, 135, 68, 8, 220, 99,
-			55, 2, 229, 107, 84, 238, 222, 70, 100, 231, 27, 66, 132, 242,
-			238, 68, 23, 87, 203, 76, 34, 23, 245, 108, 180, 128, 59, 254,
-			169, 67, 190, 97, 70, 11, 182, 164, 63, 205, 70, 203, 193, 241,
-			232, 27, 212, 73, 10, 201, 145, 81, 246, 3, 114, 234, 17, 207,
-			253, 166, 67, 78, 148, 223, 131, 131, 149, 249, 146, 139, 26, 114,
-			127, 199, 33, 201, 150, 219, 76, 146, 41, 85, 229, 171, 30, 28,
-			81, 245, 56, 123, 158, 7, 217, 217, 3, 38, 111, 146, 116, 90,
-			182, 225, 145, 41, 27, 138, 91, 34, 214, 24, 116, 87, 128, 133,
-			126, 211, 33, 127, 234, 120, 170, 177, 192, 66, 191, 233, 144, 3,
-			58, 233, 64, 242, 190, 227, 58, 73, 33, 57, 247, 0, 187, 196,
-			128, 87, 20, 255, 210, 233, 249, 0, 113, 202, 231, 184, 226, 12,
-			91, 81, 179, 158, 232, 161, 69, 10, 175, 86, 51, 85, 144, 180,
-			191, 180, 21, 253, 106, 113, 2, 199, 252, 75, 56, 130, 2, 151,
-			199, 61, 238, 125, 132, 76, 98, 19, 229, 14, 247, 62, 66, 254,
-			82, 177, 91, 185, 191, 189, 143, 168, 171, 56, 185, 187, 189, 143,
-			12, 142, 234, 36, 133, 228, 248, 132, 194, 229, 120, 238, 251, 9,
-			57, 166, 112, 193, 200, 189, 159, 144, 247, 41, 94, 45, 119, 181,
-			247, 19, 162, 81, 59, 152, 125, 148, 235, 36, 133, 228, 145, 163,
-			108, 128, 33, 39, 253, 62, 210, 243, 95, 136, 131, 77, 6, 198,
-			249, 125, 164, 52, 140, 251, 182, 75, 123, 188, 226, 75, 132, 252,
-			255, 136, 220, 137, 92, 212, 57, 189, 68, 216, 0, 238, 133, 174,
-			212, 55, 125, 63, 113, 39, 112, 135, 117, 149, 18, 232, 251, 137,
-			251, 18, 145, 98, 139, 171, 20, 64, 223, 79, 148, 176, 224, 42,
-			229, 207, 247, 147, 254, 145, 12, 64, 1, 48, 54, 110, 176, 58,
-			158, 251, 33, 226, 122, 6, 43, 244, 239, 67, 196, 253, 126, 50,
-			97, 202, 192, 220, 252, 144, 141, 213, 193, 66, 253, 131, 25, 128,
-			2, 96, 100, 20, 41, 230, 66, 75, 63, 12, 212, 5, 138, 185,
-			216, 206, 15, 91, 61, 67, 234, 127, 152, 16, 87, 39, 29, 72,
-			22, 122, 117, 146, 66, 146, 245, 179, 15, 56, 136, 204, 241, 220,
-			151, 9, 25, 46, 167, 175, 117, 223, 105, 244, 136, 127, 213, 55,
-			158, 178, 11, 64, 148, 151, 9, 249, 176, 154, 49, 46, 146, 228,
-			101, 162, 246, 64, 23, 9, 242, 50, 233, 101, 58, 73, 33, 57,
-			56, 36, 71, 214, 237, 241, 138, 63, 72, 200, 143, 233, 254, 187,
-			208, 225, 31, 36, 189, 3, 236, 45, 48, 6, 120, 81, 238, 254,
-			16, 113, 199, 202, 179, 184, 156, 117, 95, 128, 171, 202, 39, 17,
-			106, 87, 233, 186, 176, 114, 229, 197, 57, 20, 29, 202, 0, 4,
-			0, 163, 30, 123, 90, 225, 118, 60, 247, 163, 196, 245, 202, 79,
-			118, 227, 86, 254, 41, 155, 59, 166, 22, 116, 48, 156, 154, 47,
-			214, 225, 55, 141, 212, 202, 179, 170, 118, 36, 230, 193, 12, 64,
-			0, 48, 50, 202, 86, 85, 213, 196, 115, 63, 70, 220, 145, 242,
-			19, 119, 234, 86, 3, 47, 43, 180, 63, 233, 5, 235, 125, 53,
-			58, 104, 72, 162, 230, 173, 92, 111, 161, 51, 31, 35, 110, 127,
-			6, 192, 42, 134, 134, 217, 67, 170, 74, 234, 185, 63, 66, 220,
-			209, 242, 209, 238, 42, 131, 16, 187, 214, 8, 66, 191, 105, 236,
-			250, 52, 26, 96, 30, 63, 66, 148, 84, 233, 74, 83, 50, 247,
-			71, 200, 240, 8, 123, 171, 194, 235, 122, 238, 223, 37, 110, 185,
-			60, 191, 7, 94, 63, 204, 241, 167, 61, 240, 195, 74, 255, 187,
-			176, 116, 13, 128, 0, 96, 106, 191, 90, 250, 142, 87, 252, 4,
-			33, 191, 106, 150, 62, 144, 246, 19, 68, 137, 236, 174, 60, 217,
-			127, 146, 40, 145, 221, 85, 167, 250, 79, 18, 247, 19, 68, 47,
-			108, 121, 162, 255, 36, 81, 34, 187, 171, 78, 243, 159, 36, 74,
-			100, 119, 213, 73, 254, 147, 68, 137, 236, 174, 60, 197, 191, 66,
-			220, 19, 6, 43, 204, 242, 87, 136, 251, 73, 242, 128, 41, 3,
-			204, 237, 21, 27, 171, 131, 133, 14, 29, 207, 0, 20, 0, 115,
-			15, 24, 172, 196, 115, 63, 69, 220, 67, 6, 43, 236, 15, 159,
-			34, 238, 43, 228, 132, 41, 3, 39, 165, 79, 101, 12, 69, 138,
-			217, 159, 34, 253, 251, 51, 0, 5, 192, 125, 7, 153, 144, 88,
-			105, 143, 231, 254, 20, 113, 247, 151, 175, 41, 45, 97, 54, 2,
-			177, 240, 235, 59, 93, 43, 68, 10, 185, 91, 114, 247, 197, 109,
-			89, 171, 199, 186, 239, 148, 249, 142, 72, 43, 166, 33, 200, 116,
-			127, 138, 12, 76, 178, 239, 85, 245, 58, 94, 241, 103, 136, 251,
-			179, 164, 80, 110, 229, 107, 150, 162, 105, 51, 184, 101, 43, 137,
-			102, 51, 239, 21, 198, 23, 197, 242, 226, 92, 133, 95, 216, 18,
-			104, 46, 32, 172, 243, 182, 178, 233, 107, 168, 192, 63, 93, 155,
-			154, 110, 17, 16, 252, 103, 200, 192, 4, 234, 42, 16, 0, 179,
-			225, 211, 164, 56, 141, 186, 10, 5, 113, 189, 226, 167, 73, 241,
-			103, 136, 212, 66, 40, 96, 1, 243, 13, 216, 32, 7, 64, 131,
-			7, 109, 16, 5, 16, 63, 204, 222, 174, 122, 76, 188, 226, 207,
-			19, 247, 23, 73, 1, 215, 109, 214, 227, 153, 164, 219, 74, 8,
-			31, 87, 40, 91, 191, 122, 93, 106, 63, 229, 98, 174, 5, 168,
-			121, 213, 125, 128, 225, 253, 121, 50, 48, 102, 250, 128, 194, 243,
-			47, 144, 226, 65, 211, 7, 41, 192, 254, 2, 41, 254, 60, 153,
-			48, 173, 147, 66, 236, 47, 216, 125, 144, 130, 236, 47, 144, 193,
-			41, 27, 68, 1, 116, 224, 62, 118, 82, 245, 129, 122, 197, 95,
-			38, 238, 63, 37, 133, 242, 129, 93, 243, 69, 31, 117, 172, 246,
-			193, 250, 255, 101, 50, 48, 206, 222, 71, 84, 3, 81, 130, 248,
-			44, 41, 150, 203, 95, 151, 118, 94, 74, 16, 239, 58, 209, 241,
-			245, 136, 71, 27, 198, 195, 102, 206, 96, 84, 95, 220, 202, 146,
-			217, 165, 45, 18, 209, 40, 50, 231, 229, 245, 170, 116, 174, 30,
-			113, 37, 177, 114, 63, 229, 77, 1, 25, 97, 255, 178, 12, 182,
-			67, 38, 125, 134, 161, 76, 92, 97, 140, 175, 134, 205, 29, 216,
-			189, 110, 104, 89, 87, 245, 84, 75, 188, 184, 143, 221, 152, 151,
-			242, 121, 78, 226, 85, 5, 164, 24, 91, 81, 135, 46, 102, 6,
-			68, 74, 77, 159, 37, 197, 95, 38, 147, 134, 212, 82, 114, 250,
-			44, 41, 142, 216, 32, 7, 64, 163, 19, 54, 136, 2, 104, 106,
-			63, 94, 179, 186, 212, 41, 245, 120, 197, 127, 78, 220, 95, 33,
-			5, 77, 243, 18, 148, 250, 231, 68, 105, 131, 92, 169, 34, 251,
-			23, 164, 200, 13, 11, 128, 35, 241, 191, 128, 154, 12, 192, 1,
-			192, 232, 129, 12, 128, 69, 14, 221, 111, 80, 184, 158, 251, 47,
-			73, 241, 144, 201, 224, 22, 17, 48, 156, 1, 28, 0, 140, 100,
-			124, 6, 78, 171, 255, 18, 248, 140, 70, 81, 240, 220, 127, 69,
-			138, 101, 147, 161, 80, 68, 192, 96, 6, 112, 0, 48, 52, 145,
-			1, 40, 0, 144, 151, 75, 20, 69, 207, 253, 239, 72, 241, 62,
-			147, 161, 40, 1, 67, 25, 192, 1, 192, 240, 190, 12, 64, 1,
-			80, 62, 96, 80, 244, 122, 238, 191, 38, 197, 57, 147, 161, 183,
-			136, 128, 241, 12, 224, 0, 96, 226, 104, 6, 160, 0, 152, 153,
-			85, 18, 24, 241, 220, 207, 17, 165, 229, 112, 145, 5, 127, 206,
-			218, 96, 80, 68, 255, 92, 38, 190, 160, 62, 151, 40, 45, 135,
-			139, 204, 247, 115, 176, 171, 62, 130, 184, 168, 231, 254, 26, 33,
-			179, 229, 19, 175, 173, 229, 176, 31, 210, 105, 185, 137, 186, 94,
-			241, 215, 8, 249, 156, 146, 142, 93, 28, 212, 95, 35, 100, 74,
-			39, 29, 72, 238, 63, 162, 147, 88, 213, 241, 25, 213, 9, 215,
-			115, 191, 72, 200, 33, 133, 11, 164, 231, 47, 18, 242, 107, 100,
-			86, 229, 134, 209, 253, 34, 49, 168, 97, 108, 191, 72, 70, 247,
-			235, 36, 133, 228, 125, 7, 21, 174, 130, 231, 254, 58, 33, 247,
-			43, 92, 5, 215, 43, 254, 58, 33, 95, 36, 135, 84, 110, 24,
-			230, 95, 207, 112, 193, 32, 255, 58, 25, 45, 235, 36, 133, 228,
-			193, 67, 106, 187, 166, 94, 241, 223, 18, 242, 191, 155, 237, 26,
-			122, 241, 111, 9, 27, 101, 239, 192, 225, 67, 222, 241, 37, 144,
-			185, 46, 74, 3, 205, 110, 237, 140, 226, 30, 248, 205, 186, 242,
-			145, 27, 85, 35, 104, 54, 245, 6, 38, 143, 143, 76, 109, 165,
-			114, 69, 126, 137, 184, 255, 150, 140, 169, 113, 167, 200, 32, 191,
-			68, 148, 86, 214, 85, 171, 241, 75, 164, 111, 48, 3, 80, 0,
-			140, 140, 178, 150, 106, 157, 227, 185, 95, 38, 238, 84, 249, 123,
-			236, 171, 168, 238, 54, 218, 247, 134, 34, 54, 26, 37, 221, 50,
-			16, 177, 253, 102, 19, 181, 49, 233, 150, 104, 73, 59, 120, 216,
-			130, 225, 8, 142, 66, 155, 110, 52, 72, 21, 95, 38, 238, 151,
-			136, 103, 154, 4, 210, 243, 151, 237, 70, 59, 216, 166, 190, 172,
-			91, 32, 85, 124, 153, 76, 238, 99, 53, 213, 104, 226, 185, 95,
-			33, 238, 100, 121, 141, 95, 148, 175, 103, 115, 45, 214, 176, 215,
-			110, 106, 190, 165, 108, 119, 83, 97, 157, 124, 133, 184, 95, 38,
-			83, 166, 33, 48, 113, 190, 98, 55, 21, 200, 247, 21, 210, 55,
-			154, 1, 40, 0, 198, 39, 148, 168, 66, 33, 253, 85, 226, 238,
-			43, 95, 227, 231, 213, 99, 222, 92, 91, 13, 240, 219, 108, 44,
-			172, 173, 175, 18, 247, 43, 100, 210, 52, 133, 22, 176, 234, 172,
-			177, 48, 51, 191, 74, 250, 50, 202, 83, 108, 220, 196, 164, 98,
-			204, 20, 206, 38, 191, 79, 220, 175, 27, 198, 140, 79, 181, 220,
-			223, 39, 125, 195, 236, 18, 238, 133, 84, 158, 79, 254, 103, 82,
-			28, 45, 63, 204, 229, 35, 98, 52, 228, 50, 214, 136, 121, 163,
-			227, 121, 16, 137, 27, 126, 45, 157, 183, 155, 220, 167, 49, 57,
-			136, 106, 192, 6, 17, 0, 13, 143, 176, 15, 57, 166, 66, 199,
-			115, 255, 23, 82, 156, 40, 119, 120, 246, 114, 89, 159, 205, 130,
-			156, 153, 115, 246, 186, 2, 117, 63, 218, 207, 92, 138, 74, 34,
-			124, 140, 226, 243, 52, 246, 195, 36, 0, 1, 16, 223, 255, 101,
-			186, 31, 169, 36, 141, 253, 144, 71, 29, 115, 75, 155, 107, 175,
-			35, 91, 50, 98, 131, 8, 128, 198, 198, 217, 75, 89, 123, 137,
-			231, 254, 49, 41, 238, 47, 39, 60, 255, 178, 186, 171, 205, 184,
-			107, 163, 233, 191, 223, 73, 183, 68, 152, 74, 207, 160, 42, 148,
-			112, 200, 173, 203, 215, 237, 173, 40, 17, 185, 99, 45, 136, 178,
-			198, 237, 148, 60, 173, 70, 97, 190, 181, 64, 183, 63, 134, 109,
-			194, 2, 97, 211, 246, 77, 177, 170, 105, 44, 245, 220, 255, 4,
-			146, 205, 119, 241, 174, 39, 223, 93, 173, 109, 249, 183, 209, 179,
-			211, 117, 75, 31, 6, 109, 143, 133, 95, 219, 234, 26, 87, 152,
-			104, 255, 137, 20, 39, 108, 16, 1, 208, 212, 126, 246, 49, 71,
-			45, 12, 215, 115, 191, 1, 107, 248, 251, 29, 61, 122, 89, 133,
-			218, 237, 136, 86, 242, 117, 173, 13, 115, 223, 106, 61, 244, 71,
-			179, 70, 253, 98, 85, 46, 31, 144, 177, 208, 192, 79, 250, 108,
-			178, 39, 138, 165, 245, 222, 181, 148, 96, 107, 249, 70, 110, 21,
-			224, 230, 242, 141, 236, 136, 66, 113, 123, 249, 6, 233, 207, 214,
-			61, 108, 48, 223, 128, 117, 255, 187, 82, 79, 81, 244, 220, 63,
-			33, 100, 95, 249, 139, 142, 209, 222, 90, 86, 110, 119, 81, 207,
-			91, 91, 194, 188, 197, 123, 209, 207, 43, 48, 139, 189, 180, 246,
-			187, 148, 246, 232, 140, 144, 47, 55, 236, 107, 1, 173, 227, 99,
-			60, 72, 121, 75, 248, 97, 98, 130, 13, 105, 221, 43, 74, 138,
-			168, 47, 81, 207, 1, 51, 125, 138, 81, 128, 20, 93, 175, 248,
-			39, 214, 158, 135, 66, 205, 159, 16, 210, 167, 147, 14, 36, 217,
-			168, 78, 82, 72, 162, 147, 49, 226, 22, 188, 226, 159, 145, 158,
-			127, 74, 165, 214, 11, 182, 214, 63, 35, 165, 81, 246, 50, 144,
-			173, 64, 123, 188, 226, 55, 9, 249, 191, 9, 45, 191, 152, 117,
-			252, 250, 66, 39, 221, 146, 111, 55, 211, 78, 187, 41, 178, 183,
-			13, 72, 56, 56, 106, 100, 222, 37, 22, 179, 217, 161, 172, 14,
-			234, 246, 41, 107, 61, 186, 41, 66, 62, 155, 8, 121, 179, 190,
-			219, 50, 33, 110, 215, 230, 148, 109, 123, 1, 15, 128, 223, 212,
-			71, 239, 130, 212, 186, 253, 57, 81, 247, 90, 5, 165, 117, 251,
-			115, 226, 126, 83, 29, 189, 17, 84, 196, 60, 99, 25, 192, 1,
-			192, 248, 100, 6, 160, 0, 80, 247, 90, 5, 169, 117, 251, 11,
-			162, 46, 254, 10, 74, 235, 246, 23, 196, 253, 115, 114, 192, 148,
-			129, 77, 242, 47, 136, 219, 151, 1, 176, 16, 27, 206, 0, 20,
-			0, 234, 226, 175, 0, 71, 213, 247, 81, 242, 125, 148, 170, 174,
-			64, 254, 247, 81, 165, 64, 44, 200, 115, 227, 251, 169, 82, 32,
-			22, 212, 169, 241, 253, 212, 125, 31, 29, 82, 40, 229, 153, 241,
-			253, 84, 77, 251, 130, 58, 49, 190, 159, 246, 143, 100, 0, 10,
-			0, 165, 64, 44, 72, 45, 194, 7, 168, 186, 25, 45, 40, 45,
-			194, 7, 168, 251, 126, 58, 97, 202, 64, 87, 62, 96, 99, 117,
-			176, 144, 186, 25, 45, 40, 45, 194, 7, 168, 186, 25, 45, 72,
-			45, 194, 7, 169, 82, 75, 22, 148, 22, 225, 131, 212, 253, 0,
-			221, 103, 202, 192, 214, 252, 65, 27, 43, 52, 229, 131, 84, 169,
-			37, 11, 74, 139, 240, 65, 170, 212, 146, 5, 232, 255, 75, 84,
-			169, 37, 11, 216, 251, 151, 44, 122, 225, 48, 190, 68, 149, 90,
-			18, 221, 77, 187, 47, 81, 165, 150, 44, 96, 191, 95, 162, 172,
-			95, 18, 27, 246, 205, 15, 81, 242, 195, 186, 48, 238, 154, 31,
-			162, 189, 3, 236, 89, 232, 128, 220, 51, 127, 128, 186, 99, 229,
-			21, 60, 62, 10, 235, 105, 77, 34, 5, 61, 191, 86, 19, 237,
-			84, 30, 159, 187, 14, 143, 234, 117, 161, 212, 100, 170, 103, 97,
-			204, 210, 84, 168, 39, 51, 80, 193, 80, 6, 32, 0, 24, 245,
-			216, 5, 213, 2, 199, 115, 95, 166, 238, 72, 249, 108, 87, 11,
-			164, 185, 46, 62, 53, 155, 69, 45, 134, 94, 53, 210, 96, 96,
-			241, 202, 156, 85, 141, 35, 177, 244, 103, 0, 2, 128, 161, 97,
-			214, 84, 213, 16, 207, 253, 33, 234, 142, 150, 255, 198, 157, 58,
-			138, 239, 114, 241, 197, 106, 212, 134, 206, 46, 94, 81, 220, 76,
-			160, 216, 11, 28, 109, 75, 248, 113, 22, 255, 64, 220, 65, 65,
-			83, 80, 91, 220, 15, 81, 165, 170, 43, 168, 13, 238, 135, 232,
-			240, 8, 123, 88, 181, 135, 122, 238, 71, 161, 61, 199, 119, 119,
-			251, 142, 74, 192, 130, 218, 194, 62, 106, 99, 134, 13, 236, 163,
-			128, 249, 49, 133, 217, 245, 220, 143, 81, 183, 92, 62, 185, 39,
-			230, 59, 168, 1, 179, 10, 96, 7, 249, 24, 85, 90, 192, 130,
-			210, 2, 126, 140, 42, 45, 96, 129, 18, 175, 248, 35, 148, 252,
-			35, 179, 126, 161, 171, 63, 66, 13, 43, 66, 157, 201, 143, 82,
-			165, 5, 44, 40, 141, 201, 143, 82, 247, 71, 168, 94, 157, 232,
-			234, 17, 242, 28, 204, 0, 14, 0, 148, 22, 176, 160, 116, 37,
-			63, 74, 149, 22, 176, 32, 229, 217, 143, 83, 165, 5, 44, 40,
-			123, 137, 143, 83, 247, 71, 233, 3, 166, 140, 83, 196, 60, 25,
-			86, 7, 11, 41, 45, 96, 65, 217, 75, 124, 156, 206, 61, 128,
-			114, 101, 129, 18, 96, 235, 63, 70, 221, 79, 209, 130, 206, 130,
-			156, 245, 199, 232, 192, 1, 84, 2, 33, 0, 58, 244, 227, 84,
-			41, 129, 20, 196, 245, 138, 63, 78, 139, 63, 70, 165, 138, 74,
-			1, 11, 152, 111, 192, 6, 57, 0, 82, 74, 32, 5, 162, 0,
-			58, 112, 159, 133, 223, 241, 220, 79, 208, 34, 183, 240, 67, 231,
-			62, 65, 139, 63, 158, 195, 15, 236, 233, 19, 121, 252, 168, 129,
-			165, 131, 7, 108, 16, 5, 208, 161, 251, 45, 252, 196, 115, 63,
-			73, 139, 251, 44, 252, 192, 166, 62, 73, 139, 159, 160, 220, 42,
-			9, 140, 234, 147, 121, 252, 208, 180, 79, 210, 65, 207, 6, 81,
-			0, 161, 104, 174, 241, 83, 207, 125, 133, 22, 39, 44, 252, 32,
-			244, 191, 66, 139, 159, 164, 251, 172, 146, 32, 246, 191, 146, 199,
-			15, 147, 249, 21, 58, 56, 98, 131, 16, 155, 97, 220, 184, 112,
-			126, 130, 186, 115, 102, 224, 161, 237, 63, 145, 27, 51, 212, 19,
-			252, 4, 53, 59, 155, 188, 204, 251, 9, 58, 126, 52, 3, 80,
-			0, 204, 204, 106, 172, 80, 241, 79, 82, 119, 191, 25, 118, 52,
-			214, 165, 3, 147, 38, 3, 241, 220, 191, 71, 221, 73, 147, 1,
-			80, 254, 61, 58, 48, 102, 50, 80, 207, 125, 21, 54, 20, 157,
-			1, 80, 190, 74, 7, 198, 205, 220, 114, 189, 226, 167, 169, 251,
-			115, 214, 220, 130, 165, 245, 105, 153, 69, 210, 14, 239, 160, 126,
-			150, 22, 203, 134, 118, 242, 30, 234, 103, 105, 241, 211, 116, 210,
-			80, 69, 222, 69, 253, 44, 45, 142, 216, 32, 7, 64, 74, 159,
-			165, 64, 20, 64, 74, 159, 85, 160, 164, 212, 227, 21, 127, 158,
-			186, 255, 48, 107, 2, 234, 179, 126, 158, 178, 41, 67, 94, 234,
-			185, 191, 64, 149, 62, 11, 211, 69, 4, 100, 107, 21, 250, 245,
-			11, 116, 244, 64, 6, 192, 34, 74, 159, 85, 144, 198, 46, 191,
-			72, 149, 50, 10, 211, 69, 4, 12, 102, 0, 7, 0, 67, 19,
-			25, 128, 2, 64, 41, 163, 10, 242, 248, 250, 15, 168, 82, 70,
-			97, 186, 136, 128, 161, 12, 224, 0, 96, 120, 95, 6, 160, 0,
-			80, 202, 168, 130, 180, 176, 249, 37, 27, 69, 81, 2, 50, 20,
-			32, 252, 253, 146, 141, 2, 196, 191, 95, 178, 81, 244, 122, 238,
-			47, 83, 165, 207, 194, 116, 17, 1, 227, 25, 192, 1, 192, 68,
-			54, 175, 122, 41, 0, 148, 62, 171, 0, 115, 228, 51, 84, 233,
-			179, 10, 184, 138, 63, 99, 177, 74, 100, 80, 159, 161, 74, 159,
-			85, 192, 213, 251, 25, 170, 244, 89, 5, 92, 185, 159, 129, 45,
-			43, 66, 92, 196, 115, 63, 75, 201, 120, 217, 127, 29, 230, 51,
-			153, 72, 126, 111, 246, 51, 202, 47, 184, 108, 46, 44, 172, 207,
-			82, 242, 25, 58, 162, 26, 4, 163, 242, 217, 172, 185, 208, 187,
-			207, 82, 101, 65, 83, 192, 153, 243, 89, 58, 58, 198, 254, 3,
-			101, 48, 228, 197, 207, 211, 158, 223, 167, 78, 249, 119, 41, 55,
-			102, 179, 217, 27, 31, 163, 44, 224, 139, 87, 56, 186, 227, 149,
-			2, 61, 74, 249, 42, 8, 145, 121, 87, 140, 7, 77, 124, 186,
-			206, 47, 152, 235, 63, 124, 220, 152, 89, 149, 232, 80, 15, 214,
-			139, 72, 148, 70, 148, 57, 114, 39, 237, 196, 66, 5, 86, 90,
-			184, 186, 44, 45, 63, 24, 23, 183, 219, 77, 109, 98, 43, 91,
-			17, 132, 42, 215, 182, 191, 147, 152, 19, 220, 206, 140, 180, 231,
-			238, 196, 234, 164, 129, 206, 167, 177, 197, 198, 225, 138, 121, 211,
-			36, 146, 96, 51, 52, 94, 110, 44, 219, 19, 244, 244, 82, 151,
-			231, 126, 124, 255, 201, 107, 205, 0, 77, 20, 210, 136, 11, 63,
-			9, 154, 59, 32, 95, 117, 90, 157, 38, 108, 193, 198, 78, 60,
-			163, 31, 82, 162, 30, 52, 26, 2, 175, 58, 165, 7, 29, 220,
-			199, 163, 88, 185, 137, 105, 249, 65, 136, 170, 125, 63, 228, 65,
-			120, 178, 37, 90, 81, 140, 238, 5, 106, 91, 66, 91, 198, 72,
-			50, 103, 135, 39, 144, 109, 164, 129, 10, 126, 168, 43, 245, 95,
-			61, 72, 106, 209, 45, 17, 139, 58, 247, 107, 113, 148, 36, 44,
-			115, 199, 93, 189, 122, 33, 81, 70, 12, 176, 144, 62, 79, 75,
-			163, 104, 231, 85, 4, 22, 246, 5, 74, 120, 249, 89, 105, 189,
-			149, 104, 221, 43, 52, 140, 139, 80, 90, 27, 7, 214, 123, 163,
-			140, 248, 168, 211, 103, 175, 173, 176, 69, 164, 90, 87, 91, 68,
-			246, 248, 5, 74, 62, 79, 199, 112, 30, 22, 145, 53, 126, 129,
-			170, 167, 215, 24, 44, 197, 253, 2, 157, 56, 160, 147, 20, 146,
-			135, 238, 103, 255, 144, 96, 99, 29, 207, 253, 13, 74, 38, 203,
-			63, 69, 248, 37, 237, 87, 105, 207, 219, 122, 53, 218, 118, 120,
-			96, 36, 152, 244, 212, 163, 79, 206, 129, 216, 102, 93, 167, 62,
-			81, 151, 37, 213, 91, 33, 180, 115, 69, 223, 18, 88, 87, 69,
-			93, 211, 168, 183, 194, 122, 226, 183, 133, 60, 15, 42, 157, 143,
-			156, 38, 248, 185, 182, 165, 158, 6, 202, 11, 152, 150, 223, 108,
-			10, 121, 177, 18, 224, 68, 208, 207, 145, 228, 235, 250, 204, 91,
-			186, 36, 48, 14, 47, 28, 236, 241, 229, 150, 244, 31, 178, 220,
-			216, 221, 245, 75, 210, 141, 148, 188, 127, 241, 155, 9, 94, 83,
-			38, 66, 159, 172, 139, 200, 203, 126, 131, 146, 47, 80, 174, 8,
-			11, 210, 200, 111, 104, 230, 80, 68, 94, 246, 27, 180, 119, 84,
-			39, 41, 36, 199, 39, 216, 63, 163, 72, 118, 226, 185, 95, 3,
-			102, 246, 247, 169, 169, 106, 111, 162, 107, 202, 232, 75, 187, 100,
-			43, 138, 205, 125, 86, 163, 211, 108, 230, 237, 172, 16, 133, 121,
-			149, 21, 132, 155, 73, 230, 145, 252, 1, 222, 242, 111, 103, 23,
-			73, 153, 122, 40, 251, 136, 207, 29, 148, 9, 21, 154, 123, 25,
-			254, 82, 21, 207, 87, 174, 248, 183, 101, 120, 214, 13, 1, 167,
-			144, 45, 93, 50, 140, 194, 147, 169, 136, 209, 243, 129, 26, 86,
-			12, 184, 220, 129, 161, 134, 225, 239, 196, 250, 9, 156, 238, 206,
-			108, 70, 113, 67, 98, 249, 164, 96, 67, 43, 93, 20, 163, 169,
-			249, 137, 64, 63, 35, 43, 81, 42, 180, 230, 82, 154, 124, 100,
-			124, 32, 221, 82, 62, 28, 244, 214, 176, 17, 69, 77, 225, 75,
-			119, 67, 120, 217, 220, 192, 23, 152, 250, 118, 15, 61, 52, 192,
-			217, 38, 72, 59, 190, 126, 102, 35, 66, 88, 91, 56, 141, 234,
-			145, 62, 195, 89, 10, 27, 125, 193, 11, 227, 9, 123, 195, 215,
-			40, 249, 13, 58, 169, 6, 24, 246, 134, 175, 101, 195, 143, 230,
-			172, 180, 119, 88, 39, 41, 36, 189, 49, 227, 175, 253, 195, 163,
-			236, 252, 189, 123, 75, 215, 71, 189, 204, 101, 167, 229, 182, 253,
-			219, 112, 251, 62, 189, 201, 198, 119, 41, 86, 170, 226, 121, 239,
-			56, 115, 253, 78, 186, 165, 252, 200, 117, 57, 110, 92, 232, 164,
-			91, 85, 252, 238, 205, 176, 97, 203, 151, 156, 229, 126, 49, 115,
-			49, 135, 122, 156, 167, 126, 104, 88, 134, 117, 153, 255, 14, 112,
-			163, 118, 65, 57, 76, 43, 149, 24, 251, 227, 146, 244, 152, 118,
-			184, 103, 222, 41, 255, 94, 105, 183, 154, 170, 42, 158, 151, 91,
-			29, 70, 129, 208, 155, 247, 73, 125, 146, 207, 217, 234, 232, 97,
-			134, 211, 174, 104, 54, 42, 140, 47, 70, 114, 225, 4, 137, 70,
-			178, 120, 5, 114, 162, 115, 21, 163, 197, 95, 202, 29, 222, 141,
-			179, 36, 216, 186, 145, 117, 137, 16, 4, 158, 132, 117, 101, 198,
-			26, 209, 201, 152, 37, 22, 0, 183, 200, 86, 253, 60, 95, 168,
-			215, 165, 34, 211, 15, 235, 90, 109, 155, 105, 25, 247, 80, 203,
-			181, 84, 24, 85, 88, 99, 118, 92, 144, 110, 197, 159, 42, 41,
-			27, 114, 83, 132, 204, 60, 57, 205, 162, 61, 74, 155, 72, 187,
-			205, 219, 89, 7, 247, 124, 39, 81, 201, 222, 19, 229, 169, 34,
-			29, 206, 161, 254, 70, 169, 77, 230, 187, 81, 171, 151, 63, 45,
-			63, 190, 137, 145, 226, 25, 159, 81, 153, 131, 112, 115, 70, 73,
-			66, 218, 102, 86, 201, 66, 126, 200, 87, 223, 134, 83, 175, 194,
-			23, 20, 103, 194, 205, 125, 94, 94, 248, 203, 141, 139, 225, 206,
-			165, 31, 202, 47, 229, 150, 134, 236, 134, 31, 238, 160, 68, 33,
-			137, 160, 30, 251, 101, 57, 81, 175, 106, 60, 81, 32, 75, 171,
-			94, 189, 128, 88, 101, 67, 20, 131, 95, 125, 27, 151, 90, 142,
-			156, 179, 56, 244, 196, 163, 187, 93, 145, 57, 151, 165, 79, 190,
-			133, 120, 179, 133, 241, 55, 85, 57, 205, 159, 129, 206, 45, 191,
-			217, 136, 226, 150, 168, 87, 228, 211, 97, 152, 138, 230, 213, 255,
-			150, 104, 182, 21, 170, 171, 230, 29, 244, 162, 8, 3, 81, 87,
-			168, 178, 7, 193, 93, 35, 141, 200, 131, 16, 95, 208, 215, 82,
-			21, 188, 243, 53, 107, 88, 104, 162, 181, 208, 146, 148, 215, 36,
-			250, 61, 177, 42, 156, 82, 244, 197, 27, 5, 57, 181, 165, 31,
-			43, 89, 147, 47, 145, 89, 250, 51, 28, 181, 140, 191, 33, 122,
-			37, 113, 168, 123, 222, 154, 240, 129, 225, 180, 213, 35, 228, 228,
-			46, 141, 102, 200, 181, 84, 213, 114, 151, 203, 236, 116, 99, 145,
-			198, 129, 61, 125, 197, 109, 191, 150, 74, 129, 122, 47, 246, 161,
-			44, 61, 178, 16, 107, 135, 75, 247, 73, 131, 98, 244, 115, 115,
-			148, 28, 44, 191, 200, 181, 226, 61, 55, 191, 164, 58, 190, 22,
-			161, 56, 172, 119, 96, 107, 221, 225, 252, 155, 231, 126, 22, 96,
-			149, 237, 225, 203, 224, 78, 203, 80, 121, 183, 205, 133, 139, 62,
-			74, 14, 31, 178, 60, 5, 29, 37, 99, 150, 167, 160, 163, 227,
-			83, 150, 167, 160, 163, 7, 238, 99, 255, 138, 104, 87, 65, 39,
-			200, 125, 229, 95, 34, 221, 75, 195, 34, 154, 31, 214, 163, 86,
-			115, 71, 6, 245, 243, 45, 253, 107, 203, 175, 109, 5, 161, 48,
-			186, 81, 249, 96, 60, 179, 211, 137, 226, 189, 60, 115, 233, 167,
-			255, 73, 167, 166, 132, 66, 144, 183, 182, 35, 30, 251, 181, 28,
-			95, 78, 24, 223, 136, 96, 129, 170, 43, 189, 52, 50, 131, 100,
-			197, 60, 130, 177, 187, 51, 131, 83, 116, 206, 155, 145, 233, 197,
-			223, 108, 242, 217, 118, 28, 109, 248, 27, 205, 29, 144, 172, 44,
-			169, 70, 153, 30, 181, 120, 18, 201, 54, 226, 145, 47, 10, 133,
-			58, 188, 109, 7, 97, 46, 24, 245, 9, 114, 244, 160, 229, 97,
-			233, 68, 46, 24, 245, 137, 210, 62, 203, 195, 210, 137, 242, 1,
-			35, 139, 188, 84, 97, 75, 119, 146, 33, 100, 96, 162, 83, 48,
-			3, 79, 165, 226, 118, 122, 42, 85, 65, 11, 176, 172, 73, 41,
-			113, 100, 48, 247, 245, 181, 3, 244, 253, 31, 14, 43, 152, 184,
-			122, 129, 10, 192, 129, 241, 242, 130, 48, 245, 198, 153, 219, 1,
-			32, 72, 19, 238, 165, 158, 42, 166, 188, 73, 86, 192, 112, 96,
-			232, 236, 213, 185, 212, 83, 149, 73, 200, 13, 2, 159, 116, 198,
-			13, 185, 33, 165, 227, 245, 21, 236, 120, 125, 147, 172, 176, 177,
-			147, 10, 233, 97, 127, 0, 48, 96, 210, 155, 50, 209, 17, 122,
-			85, 118, 29, 31, 97, 146, 21, 252, 56, 246, 119, 208, 121, 48,
-			124, 144, 73, 19, 139, 175, 239, 117, 197, 226, 155, 126, 181, 192,
-			138, 107, 232, 206, 195, 171, 100, 253, 238, 63, 83, 174, 228, 104,
-			87, 145, 121, 42, 11, 105, 212, 210, 52, 57, 109, 209, 228, 110,
-			5, 36, 189, 206, 216, 244, 186, 91, 17, 69, 203, 211, 22, 45,
-			239, 90, 11, 210, 249, 76, 70, 231, 254, 51, 135, 246, 46, 176,
-			6, 251, 74, 88, 19, 122, 28, 30, 182, 199, 225, 94, 74, 169,
-			113, 58, 197, 92, 17, 118, 90, 202, 23, 244, 254, 59, 21, 75,
-			161, 113, 144, 209, 123, 200, 12, 108, 233, 181, 58, 244, 212, 218,
-			234, 138, 53, 232, 103, 244, 160, 247, 221, 67, 33, 153, 181, 220,
-			98, 116, 77, 164, 222, 155, 88, 1, 207, 248, 202, 201, 241, 253,
-			119, 108, 98, 69, 186, 58, 150, 185, 203, 167, 88, 193, 120, 42,
-			174, 71, 53, 237, 169, 184, 30, 213, 242, 158, 205, 181, 183, 232,
-			242, 49, 230, 66, 253, 222, 65, 198, 90, 254, 237, 235, 242, 229,
-			143, 114, 149, 223, 215, 242, 111, 95, 70, 64, 121, 142, 149, 52,
-			21, 239, 150, 181, 200, 92, 24, 215, 243, 37, 86, 148, 14, 103,
-			166, 127, 208, 101, 238, 197, 160, 41, 188, 199, 88, 73, 119, 67,
-			245, 235, 112, 87, 191, 32, 155, 137, 104, 162, 98, 12, 233, 28,
-			229, 255, 68, 88, 73, 127, 219, 163, 131, 30, 76, 185, 250, 142,
-			234, 31, 254, 246, 30, 99, 5, 116, 157, 163, 194, 166, 204, 188,
-			86, 117, 21, 12, 3, 164, 200, 137, 165, 202, 63, 236, 176, 62,
-			19, 28, 104, 143, 42, 43, 172, 183, 46, 26, 126, 167, 153, 154,
-			192, 148, 249, 10, 100, 48, 80, 157, 201, 43, 179, 18, 172, 99,
-			144, 163, 149, 167, 105, 147, 246, 78, 106, 122, 169, 53, 51, 177,
-			231, 144, 87, 85, 166, 242, 13, 198, 178, 246, 238, 225, 152, 250,
-			173, 121, 199, 212, 199, 239, 222, 115, 232, 163, 29, 251, 224, 25,
-			54, 152, 27, 137, 61, 42, 57, 147, 175, 228, 190, 215, 170, 196,
-			142, 41, 243, 207, 29, 214, 183, 166, 108, 164, 99, 239, 8, 51,
-			28, 223, 246, 136, 62, 160, 129, 232, 19, 253, 173, 172, 136, 99,
-			146, 168, 88, 163, 71, 187, 201, 163, 209, 201, 206, 104, 15, 224,
-			178, 76, 121, 149, 245, 91, 224, 123, 9, 48, 186, 215, 56, 90,
-			46, 189, 127, 144, 203, 147, 232, 243, 223, 1, 39, 209, 163, 230,
-			36, 58, 193, 26, 242, 32, 58, 208, 115, 192, 41, 191, 139, 99,
-			183, 51, 109, 115, 102, 214, 44, 31, 249, 153, 87, 124, 114, 242,
-			40, 191, 77, 137, 116, 229, 230, 167, 92, 15, 61, 42, 136, 253,
-			80, 250, 56, 146, 70, 86, 90, 10, 29, 80, 174, 24, 123, 74,
-			61, 158, 59, 72, 202, 202, 67, 100, 73, 186, 12, 31, 204, 124,
-			73, 14, 185, 19, 90, 236, 43, 64, 170, 207, 18, 9, 135, 84,
-			196, 73, 41, 18, 14, 141, 141, 103, 206, 35, 135, 77, 49, 144,
-			101, 134, 93, 102, 201, 50, 195, 253, 182, 183, 200, 97, 83, 140,
-			120, 116, 196, 20, 35, 5, 72, 233, 98, 128, 114, 164, 127, 68,
-			167, 168, 71, 71, 76, 49, 234, 209, 81, 83, 140, 22, 32, 165,
-			125, 83, 82, 199, 163, 163, 166, 54, 10, 57, 77, 49, 215, 163,
-			99, 166, 152, 91, 128, 148, 174, 205, 117, 60, 58, 102, 250, 230,
-			82, 143, 142, 153, 98, 5, 143, 142, 155, 98, 5, 76, 105, 146,
-			20, 48, 248, 168, 110, 100, 129, 122, 116, 220, 20, 43, 122, 116,
-			210, 157, 84, 159, 138, 5, 72, 233, 218, 138, 142, 71, 39, 251,
-			71, 117, 138, 122, 116, 114, 124, 66, 21, 235, 245, 232, 62, 83,
-			172, 215, 138, 75, 42, 93, 166, 238, 51, 181, 245, 82, 143, 238,
-			51, 197, 74, 30, 221, 175, 98, 102, 246, 144, 82, 17, 82, 251,
-			117, 202, 241, 232, 254, 178, 22, 243, 75, 212, 163, 251, 15, 79,
-			235, 184, 179, 7, 123, 46, 102, 222, 58, 15, 170, 119, 125, 248,
-			12, 228, 16, 57, 110, 123, 235, 60, 196, 6, 213, 107, 241, 30,
-			248, 122, 191, 123, 164, 160, 189, 111, 226, 247, 251, 7, 70, 217,
-			83, 232, 230, 65, 250, 108, 162, 135, 139, 19, 229, 71, 248, 21,
-			63, 190, 89, 143, 182, 195, 147, 242, 205, 5, 42, 151, 163, 90,
-			167, 149, 139, 87, 45, 215, 162, 244, 153, 134, 187, 171, 241, 193,
-			161, 156, 48, 185, 135, 139, 247, 143, 177, 145, 12, 84, 0, 252,
-			3, 54, 4, 206, 89, 131, 35, 54, 132, 122, 244, 240, 216, 56,
-			27, 54, 141, 114, 60, 58, 93, 220, 103, 97, 6, 25, 124, 186,
-			120, 120, 194, 42, 5, 115, 119, 58, 135, 25, 8, 51, 61, 232,
-			217, 16, 234, 209, 233, 137, 73, 118, 41, 115, 94, 122, 204, 61,
-			88, 126, 68, 221, 21, 52, 241, 208, 43, 117, 218, 73, 18, 200,
-			171, 171, 155, 34, 76, 44, 101, 104, 34, 36, 227, 216, 242, 111,
-			105, 119, 208, 202, 155, 24, 61, 166, 188, 253, 58, 210, 226, 137,
-			30, 27, 244, 114, 110, 79, 143, 141, 77, 229, 220, 158, 30, 59,
-			112, 159, 26, 52, 199, 115, 103, 73, 133, 90, 30, 39, 103, 217,
-			16, 251, 158, 204, 225, 228, 9, 183, 92, 94, 1, 94, 152, 198,
-			65, 45, 213, 151, 28, 242, 209, 119, 230, 66, 7, 5, 55, 165,
-			120, 87, 124, 201, 12, 146, 188, 216, 104, 249, 59, 140, 167, 254,
-			77, 209, 229, 210, 241, 132, 59, 155, 119, 233, 120, 162, 203, 165,
-			227, 137, 254, 137, 156, 75, 199, 19, 83, 251, 85, 219, 137, 231,
-			158, 38, 15, 233, 182, 195, 80, 157, 102, 158, 106, 59, 70, 13,
-			61, 251, 215, 214, 118, 25, 199, 243, 172, 123, 122, 92, 181, 13,
-			159, 195, 208, 179, 166, 237, 50, 150, 231, 89, 211, 118, 25, 205,
-			243, 236, 212, 126, 233, 110, 22, 152, 204, 195, 100, 84, 53, 29,
-			24, 208, 195, 108, 40, 139, 64, 252, 22, 178, 100, 71, 32, 126,
-			75, 105, 40, 243, 82, 251, 136, 59, 106, 185, 165, 125, 196, 4,
-			247, 133, 140, 143, 244, 13, 88, 110, 105, 31, 25, 30, 201, 34,
-			16, 159, 115, 61, 237, 247, 181, 8, 169, 146, 229, 19, 246, 92,
-			223, 160, 229, 19, 246, 156, 241, 137, 75, 60, 250, 104, 22, 129,
-			184, 8, 169, 146, 21, 129, 248, 209, 62, 59, 2, 241, 163, 118,
-			4, 226, 183, 154, 218, 104, 17, 82, 118, 4, 226, 183, 154, 218,
-			128, 10, 111, 53, 181, 185, 30, 125, 92, 241, 76, 25, 249, 247,
-			113, 119, 192, 138, 252, 251, 248, 224, 136, 21, 249, 247, 113, 197,
-			51, 49, 242, 239, 19, 238, 62, 43, 242, 239, 19, 166, 24, 176,
-			218, 39, 6, 61, 43, 242, 239, 19, 19, 147, 108, 6, 139, 21,
-			61, 186, 224, 142, 78, 151, 121, 59, 106, 227, 77, 164, 82, 214,
-			224, 4, 184, 190, 150, 198, 218, 77, 110, 17, 115, 106, 119, 191,
-			192, 134, 23, 74, 186, 2, 96, 195, 11, 134, 202, 189, 30, 189,
-			224, 142, 171, 79, 189, 69, 72, 233, 126, 3, 27, 190, 160, 220,
-			31, 227, 131, 16, 122, 65, 57, 81, 118, 128, 13, 47, 26, 42,
-			3, 27, 94, 52, 197, 128, 13, 47, 26, 42, 3, 27, 94, 28,
-			245, 216, 121, 25, 185, 120, 185, 167, 233, 148, 31, 230, 32, 198,
-			117, 249, 108, 0, 8, 94, 27, 129, 204, 162, 118, 121, 41, 39,
-			4, 218, 153, 140, 142, 35, 188, 92, 26, 96, 103, 117, 28, 225,
-			183, 145, 231, 104, 249, 152, 17, 12, 108, 209, 34, 8, 55, 209,
-			223, 173, 18, 73, 153, 21, 110, 248, 109, 204, 67, 182, 166, 194,
-			13, 95, 113, 199, 190, 29, 30, 62, 156, 69, 42, 118, 175, 184,
-			111, 27, 207, 133, 42, 190, 162, 214, 151, 14, 85, 124, 165, 127,
-			40, 23, 170, 248, 202, 168, 199, 254, 210, 81, 109, 113, 60, 186,
-			230, 142, 151, 255, 179, 195, 225, 176, 145, 187, 160, 149, 30, 32,
-			164, 151, 94, 211, 16, 211, 57, 190, 156, 90, 30, 32, 229, 243,
-			228, 184, 133, 87, 158, 242, 69, 242, 115, 9, 62, 14, 139, 234,
-			157, 166, 212, 164, 37, 157, 141, 36, 13, 82, 169, 55, 179, 252,
-			149, 242, 101, 125, 103, 173, 107, 97, 25, 117, 229, 179, 72, 196,
-			56, 47, 155, 152, 247, 245, 42, 235, 130, 166, 206, 115, 191, 145,
-			10, 188, 71, 102, 185, 186, 18, 235, 113, 74, 230, 204, 74, 147,
-			16, 182, 170, 53, 247, 74, 22, 221, 25, 54, 170, 53, 139, 132,
-			176, 246, 215, 250, 135, 179, 52, 245, 232, 154, 39, 173, 125, 136,
-			220, 179, 175, 185, 126, 65, 127, 199, 209, 190, 54, 48, 129, 123,
-			54, 209, 123, 246, 211, 223, 254, 158, 77, 204, 158, 253, 116, 241,
-			218, 62, 220, 53, 137, 217, 179, 159, 86, 59, 43, 49, 123, 246,
-			211, 106, 207, 38, 102, 207, 126, 90, 237, 217, 68, 239, 217, 207,
-			20, 167, 44, 204, 64, 136, 103, 138, 79, 79, 88, 165, 128, 9,
-			62, 83, 236, 183, 33, 80, 108, 96, 204, 134, 80, 143, 62, 51,
-			185, 143, 253, 107, 199, 160, 38, 30, 125, 182, 56, 85, 254, 69,
-			135, 235, 147, 33, 15, 194, 58, 62, 21, 72, 120, 208, 224, 51,
-			0, 158, 145, 247, 229, 114, 252, 110, 229, 55, 20, 51, 57, 164,
-			59, 13, 166, 143, 2, 218, 191, 114, 93, 70, 4, 239, 4, 201,
-			150, 116, 42, 50, 61, 45, 237, 33, 194, 40, 61, 153, 116, 212,
-			24, 243, 229, 6, 87, 167, 86, 180, 180, 71, 166, 245, 110, 168,
-			251, 28, 127, 247, 139, 47, 42, 191, 31, 250, 150, 70, 61, 151,
-			207, 232, 65, 92, 207, 125, 182, 248, 204, 148, 213, 87, 96, 163,
-			207, 22, 153, 13, 113, 60, 250, 108, 191, 77, 15, 96, 236, 207,
-			78, 238, 179, 40, 77, 61, 122, 35, 71, 105, 234, 122, 238, 141,
-			226, 179, 54, 102, 216, 0, 110, 228, 198, 16, 54, 129, 27, 131,
-			54, 102, 216, 8, 110, 76, 238, 99, 159, 38, 106, 237, 18, 143,
-			6, 238, 177, 242, 199, 137, 36, 152, 36, 168, 21, 135, 221, 50,
-			173, 49, 20, 69, 111, 51, 27, 65, 106, 2, 231, 152, 149, 6,
-			139, 75, 186, 107, 70, 171, 11, 164, 75, 203, 79, 107, 91, 234,
-			186, 122, 83, 220, 230, 167, 254, 198, 209, 119, 127, 247, 179, 47,
-			126, 207, 137, 23, 79, 97, 220, 240, 233, 163, 239, 110, 68, 209,
-			139, 211, 198, 243, 55, 143, 110, 42, 239, 254, 24, 238, 123, 158,
-			79, 31, 149, 255, 143, 98, 200, 108, 114, 134, 17, 94, 154, 201,
-			163, 109, 118, 65, 130, 118, 20, 122, 68, 20, 179, 134, 134, 158,
-			135, 133, 111, 61, 41, 173, 9, 99, 232, 146, 189, 75, 221, 139,
-			103, 88, 11, 29, 198, 51, 176, 22, 42, 238, 213, 129, 91, 206,
-			210, 142, 71, 131, 3, 60, 75, 83, 143, 6, 71, 142, 226, 6,
-			132, 146, 210, 77, 114, 204, 138, 79, 126, 147, 60, 103, 199, 39,
-			191, 73, 246, 89, 241, 201, 111, 78, 113, 43, 62, 249, 205, 35,
-			71, 241, 88, 64, 61, 55, 132, 227, 54, 147, 254, 62, 104, 88,
-			146, 219, 58, 5, 236, 145, 114, 56, 130, 175, 228, 220, 136, 132,
-			114, 183, 195, 23, 114, 52, 82, 49, 2, 240, 121, 28, 141, 250,
-			38, 117, 138, 122, 52, 218, 95, 86, 88, 28, 143, 182, 137, 113,
-			220, 225, 122, 110, 155, 68, 7, 84, 78, 88, 203, 109, 50, 174,
-			83, 144, 117, 194, 124, 163, 30, 109, 31, 186, 223, 232, 193, 255,
-			215, 85, 182, 112, 239, 119, 233, 2, 29, 34, 94, 223, 51, 146,
-			250, 95, 141, 58, 253, 219, 185, 217, 255, 86, 99, 177, 191, 223,
-			97, 19, 122, 70, 45, 135, 73, 234, 135, 105, 128, 76, 250, 117,
-			197, 97, 126, 152, 245, 233, 135, 244, 177, 82, 138, 77, 221, 73,
-			235, 83, 205, 178, 62, 229, 150, 232, 136, 59, 253, 179, 5, 230,
-			117, 185, 155, 172, 138, 231, 189, 163, 89, 16, 71, 186, 71, 192,
-			101, 21, 212, 241, 17, 54, 136, 63, 174, 103, 161, 29, 41, 170,
-			133, 242, 161, 116, 43, 43, 157, 86, 82, 29, 192, 172, 58, 132,
-			240, 119, 177, 33, 163, 205, 146, 53, 209, 44, 78, 221, 158, 68,
-			169, 26, 245, 151, 12, 234, 254, 4, 27, 49, 24, 116, 253, 238,
-			107, 212, 63, 172, 115, 235, 38, 156, 97, 3, 177, 191, 125, 221,
-			68, 113, 191, 67, 208, 224, 254, 216, 223, 214, 110, 55, 188, 55,
-			179, 193, 70, 100, 69, 115, 85, 42, 245, 189, 140, 54, 6, 26,
-			81, 22, 222, 213, 59, 195, 10, 104, 234, 163, 148, 233, 24, 172,
-			124, 55, 221, 43, 151, 33, 79, 85, 102, 245, 222, 194, 122, 213,
-			3, 116, 165, 79, 63, 116, 135, 82, 203, 50, 87, 85, 103, 47,
-			191, 153, 21, 16, 147, 55, 205, 6, 115, 79, 212, 84, 156, 205,
-			254, 150, 127, 27, 10, 175, 5, 47, 136, 167, 220, 146, 51, 66,
-			158, 114, 75, 100, 132, 150, 127, 204, 97, 189, 10, 155, 247, 93,
-			89, 200, 78, 87, 105, 71, 95, 179, 250, 202, 42, 186, 151, 77,
-			76, 104, 207, 242, 35, 172, 87, 193, 188, 73, 19, 170, 85, 106,
-			116, 85, 202, 174, 252, 41, 183, 228, 142, 20, 158, 114, 75, 133,
-			145, 162, 129, 211, 17, 215, 64, 138, 35, 189, 211, 223, 116, 118,
-			207, 215, 164, 237, 149, 89, 73, 191, 21, 81, 145, 103, 77, 218,
-			155, 99, 125, 114, 150, 6, 117, 173, 22, 29, 200, 230, 243, 242,
-			98, 181, 132, 159, 151, 235, 137, 119, 138, 25, 117, 42, 230, 166,
-			123, 228, 238, 215, 57, 160, 192, 49, 107, 26, 227, 229, 51, 78,
-			193, 190, 108, 174, 162, 221, 149, 119, 44, 23, 168, 86, 133, 210,
-			205, 58, 160, 62, 122, 247, 179, 126, 121, 11, 123, 125, 203, 111,
-			166, 56, 183, 74, 85, 38, 65, 151, 252, 102, 250, 212, 223, 91,
-			146, 17, 18, 63, 227, 124, 7, 40, 84, 87, 178, 8, 137, 23,
-			116, 132, 196, 146, 137, 144, 200, 178, 8, 137, 3, 61, 247, 57,
-			150, 42, 244, 32, 187, 98, 212, 157, 100, 162, 252, 93, 92, 241,
-			70, 125, 178, 64, 230, 43, 195, 110, 152, 79, 202, 127, 168, 58,
-			71, 161, 71, 219, 236, 8, 149, 93, 169, 15, 145, 129, 251, 109,
-			253, 105, 46, 248, 206, 80, 223, 72, 151, 254, 244, 207, 28, 173,
-			64, 29, 39, 163, 229, 255, 224, 240, 88, 52, 116, 43, 54, 131,
-			20, 147, 90, 58, 82, 13, 145, 38, 175, 216, 142, 250, 174, 227,
-			78, 195, 122, 138, 152, 89, 191, 104, 83, 150, 61, 78, 144, 89,
-			104, 52, 133, 255, 228, 118, 80, 23, 249, 152, 35, 12, 245, 205,
-			97, 100, 252, 148, 89, 225, 72, 154, 145, 124, 92, 27, 133, 218,
-			174, 180, 214, 105, 250, 24, 17, 139, 207, 54, 131, 155, 130, 241,
-			153, 88, 52, 146, 83, 91, 194, 175, 39, 167, 90, 126, 146, 138,
-			120, 102, 46, 119, 17, 62, 78, 134, 108, 229, 241, 120, 46, 212,
-			208, 184, 82, 132, 72, 33, 126, 124, 120, 132, 157, 130, 113, 236,
-			243, 232, 190, 254, 193, 242, 17, 61, 21, 249, 89, 20, 177, 236,
-			145, 83, 49, 93, 153, 212, 145, 246, 245, 64, 9, 133, 171, 175,
-			199, 201, 165, 136, 76, 125, 200, 209, 122, 233, 3, 100, 182, 252,
-			130, 113, 47, 19, 119, 185, 225, 247, 107, 105, 199, 111, 102, 228,
-			12, 253, 150, 64, 195, 140, 109, 209, 108, 194, 255, 49, 130, 134,
-			117, 186, 99, 118, 228, 17, 57, 147, 164, 54, 80, 99, 104, 225,
-			194, 139, 197, 243, 29, 233, 244, 77, 18, 7, 164, 193, 3, 100,
-			223, 160, 86, 131, 23, 161, 97, 251, 45, 21, 249, 129, 242, 17,
-			75, 69, 126, 224, 248, 12, 251, 97, 165, 217, 125, 115, 207, 139,
-			78, 249, 111, 178, 110, 71, 208, 150, 125, 219, 78, 212, 65, 155,
-			178, 36, 17, 42, 104, 33, 26, 88, 11, 144, 91, 163, 70, 230,
-			39, 10, 237, 173, 103, 18, 101, 146, 171, 237, 212, 108, 20, 117,
-			81, 107, 250, 177, 200, 249, 165, 145, 243, 42, 10, 179, 215, 213,
-			24, 67, 7, 141, 108, 64, 168, 126, 70, 185, 251, 211, 101, 77,
-			117, 24, 210, 110, 135, 215, 162, 214, 70, 16, 250, 42, 228, 150,
-			178, 151, 186, 138, 34, 56, 28, 28, 124, 21, 173, 72, 58, 2,
-			111, 203, 211, 122, 179, 163, 207, 22, 86, 7, 186, 34, 23, 248,
-			169, 180, 5, 146, 49, 34, 118, 163, 53, 35, 18, 216, 146, 3,
-			159, 85, 87, 48, 106, 141, 168, 86, 215, 37, 26, 137, 81, 151,
-			156, 187, 215, 134, 220, 177, 13, 177, 191, 141, 42, 226, 168, 193,
-			245, 182, 195, 79, 62, 110, 108, 90, 108, 60, 50, 240, 174, 68,
-			183, 19, 117, 242, 38, 83, 153, 217, 225, 226, 21, 229, 73, 201,
-			94, 248, 202, 252, 46, 52, 54, 198, 243, 198, 149, 103, 10, 243,
-			24, 70, 119, 91, 27, 45, 46, 47, 38, 250, 180, 166, 207, 174,
-			202, 94, 254, 148, 30, 58, 198, 125, 245, 170, 32, 82, 214, 70,
-			88, 97, 54, 39, 230, 101, 196, 40, 245, 204, 32, 221, 138, 234,
-			42, 82, 168, 54, 6, 242, 165, 119, 78, 172, 40, 180, 204, 130,
-			96, 82, 52, 130, 102, 83, 27, 69, 229, 4, 40, 101, 104, 135,
-			219, 147, 150, 196, 112, 69, 214, 1, 159, 246, 20, 95, 199, 216,
-			117, 183, 76, 140, 159, 90, 212, 234, 158, 176, 234, 132, 166, 181,
-			0, 245, 172, 126, 29, 116, 37, 10, 155, 59, 44, 211, 235, 203,
-			57, 177, 219, 74, 84, 216, 54, 155, 138, 18, 187, 170, 130, 211,
-			109, 180, 29, 178, 204, 203, 209, 114, 104, 49, 208, 121, 30, 152,
-			183, 169, 214, 69, 194, 158, 232, 164, 255, 133, 48, 10, 79, 102,
-			53, 119, 18, 17, 43, 163, 112, 159, 99, 60, 200, 185, 121, 30,
-			66, 189, 9, 96, 206, 247, 33, 204, 53, 153, 153, 209, 179, 2,
-			171, 108, 136, 45, 191, 137, 123, 145, 109, 167, 103, 8, 148, 217,
-			113, 154, 66, 232, 92, 64, 154, 158, 43, 219, 51, 57, 30, 246,
-			124, 192, 184, 56, 144, 79, 79, 89, 29, 210, 74, 59, 28, 144,
-			175, 59, 149, 22, 68, 91, 59, 2, 223, 64, 75, 89, 229, 147,
-			84, 187, 115, 178, 188, 190, 40, 15, 7, 140, 215, 131, 88, 212,
-			82, 12, 84, 172, 239, 185, 222, 92, 42, 75, 231, 178, 168, 132,
-			63, 71, 142, 148, 191, 87, 197, 122, 9, 236, 101, 155, 103, 48,
-			40, 1, 233, 229, 33, 15, 147, 198, 162, 151, 89, 225, 133, 160,
-			187, 234, 29, 137, 114, 142, 40, 233, 178, 195, 235, 81, 56, 147,
-			206, 155, 215, 57, 27, 194, 50, 201, 27, 208, 177, 234, 232, 57,
-			194, 172, 59, 129, 115, 253, 83, 214, 157, 192, 185, 253, 135, 172,
-			59, 129, 115, 135, 167, 217, 171, 142, 190, 20, 120, 130, 60, 88,
-			254, 184, 35, 171, 214, 12, 71, 113, 105, 28, 149, 122, 61, 208,
-			225, 11, 179, 17, 194, 141, 72, 114, 238, 56, 184, 37, 236, 183,
-			193, 178, 247, 27, 81, 29, 38, 152, 21, 44, 38, 186, 37, 42,
-			140, 47, 249, 181, 45, 117, 223, 37, 181, 33, 126, 59, 225, 15,
-			158, 123, 208, 178, 146, 124, 190, 19, 220, 242, 155, 34, 52, 108,
-			78, 223, 75, 184, 208, 88, 102, 221, 96, 60, 209, 127, 200, 186,
-			193, 120, 226, 254, 121, 235, 6, 227, 137, 83, 167, 217, 251, 28,
-			125, 133, 113, 129, 60, 84, 78, 140, 10, 69, 197, 231, 217, 187,
-			147, 138, 49, 222, 169, 139, 26, 71, 50, 47, 39, 165, 148, 166,
-			164, 16, 35, 226, 147, 90, 214, 218, 240, 147, 32, 49, 77, 39,
-			46, 52, 129, 89, 183, 40, 23, 250, 185, 117, 139, 114, 225, 240,
-			41, 235, 22, 229, 194, 153, 179, 236, 43, 142, 190, 70, 185, 68,
-			30, 42, 127, 193, 49, 245, 126, 187, 67, 100, 218, 175, 124, 249,
-			106, 117, 148, 120, 30, 4, 147, 32, 212, 174, 133, 211, 136, 231,
-			15, 201, 223, 210, 224, 225, 178, 236, 66, 163, 47, 126, 92, 232,
-			27, 179, 174, 136, 46, 153, 225, 4, 201, 252, 210, 253, 167, 172,
-			43, 162, 75, 103, 206, 178, 143, 19, 125, 71, 180, 66, 166, 203,
-			127, 139, 240, 106, 118, 58, 182, 86, 160, 188, 197, 67, 225, 196,
-			242, 128, 147, 197, 233, 48, 36, 146, 135, 27, 166, 151, 219, 186,
-			153, 188, 65, 61, 243, 180, 129, 40, 53, 145, 20, 179, 57, 9,
-			251, 99, 40, 227, 90, 204, 243, 149, 213, 117, 166, 57, 137, 57,
-			120, 72, 68, 242, 234, 80, 206, 253, 110, 215, 201, 104, 109, 11,
-			59, 165, 218, 108, 97, 167, 212, 254, 154, 228, 106, 204, 92, 14,
-			89, 162, 163, 181, 15, 71, 141, 172, 42, 19, 252, 209, 117, 61,
-			119, 133, 92, 122, 200, 186, 67, 91, 33, 35, 214, 29, 218, 202,
-			232, 65, 235, 14, 109, 133, 31, 102, 175, 82, 125, 137, 246, 12,
-			57, 94, 254, 56, 229, 23, 45, 237, 1, 18, 54, 180, 29, 226,
-			200, 251, 141, 32, 230, 179, 153, 1, 242, 242, 226, 188, 180, 50,
-			158, 51, 12, 93, 249, 204, 214, 12, 0, 138, 195, 254, 129, 108,
-			80, 10, 222, 202, 207, 78, 20, 7, 47, 216, 247, 3, 138, 189,
-			201, 30, 50, 148, 233, 110, 200, 69, 121, 195, 144, 89, 9, 147,
-			240, 73, 15, 231, 13, 107, 255, 213, 38, 254, 32, 11, 193, 30,
-			183, 231, 118, 189, 123, 15, 178, 252, 177, 200, 246, 230, 220, 16,
-			73, 17, 59, 145, 219, 24, 198, 21, 219, 136, 210, 46, 194, 204,
-			51, 211, 48, 217, 228, 83, 102, 182, 101, 175, 29, 204, 251, 131,
-			58, 198, 141, 14, 235, 126, 19, 196, 93, 185, 231, 130, 160, 171,
-			45, 205, 89, 206, 161, 144, 12, 195, 44, 71, 185, 224, 122, 238,
-			51, 100, 101, 218, 186, 242, 124, 134, 140, 89, 87, 158, 207, 140,
-			31, 182, 174, 60, 159, 57, 122, 44, 51, 208, 248, 238, 124, 56,
-			213, 239, 102, 195, 236, 163, 142, 76, 246, 121, 244, 217, 65, 175,
-			252, 146, 163, 148, 204, 181, 168, 213, 246, 83, 220, 238, 113, 137,
-			231, 94, 137, 161, 150, 103, 94, 219, 110, 111, 11, 14, 139, 60,
-			150, 134, 68, 117, 117, 209, 133, 121, 244, 203, 208, 10, 191, 20,
-			109, 139, 91, 34, 158, 151, 218, 0, 124, 175, 176, 45, 248, 234,
-			202, 229, 103, 240, 164, 153, 83, 23, 97, 95, 209, 80, 2, 14,
-			96, 207, 14, 154, 160, 174, 120, 4, 203, 165, 137, 76, 235, 236,
-			240, 25, 163, 92, 200, 207, 78, 119, 154, 200, 244, 203, 212, 14,
-			34, 123, 176, 252, 23, 132, 95, 201, 84, 83, 48, 119, 238, 100,
-			45, 48, 3, 153, 102, 248, 108, 16, 74, 195, 129, 57, 91, 1,
-			193, 204, 115, 222, 121, 30, 52, 120, 151, 223, 199, 235, 57, 207,
-			74, 153, 227, 118, 249, 22, 47, 72, 248, 86, 32, 183, 122, 21,
-			2, 223, 14, 96, 51, 163, 94, 95, 206, 168, 11, 37, 123, 150,
-			155, 163, 129, 142, 27, 137, 17, 212, 183, 4, 108, 68, 130, 153,
-			144, 134, 56, 84, 73, 170, 10, 118, 51, 173, 44, 144, 219, 174,
-			102, 157, 158, 151, 103, 0, 188, 110, 146, 208, 168, 193, 31, 124,
-			248, 202, 249, 92, 120, 208, 174, 14, 49, 227, 183, 253, 236, 233,
-			43, 231, 231, 205, 250, 199, 215, 17, 245, 78, 77, 222, 119, 193,
-			55, 99, 85, 161, 131, 248, 62, 235, 221, 37, 136, 111, 222, 154,
-			101, 243, 192, 125, 234, 42, 29, 227, 244, 122, 106, 157, 20, 243,
-			65, 124, 139, 248, 209, 190, 199, 15, 74, 131, 214, 61, 126, 160,
-			124, 209, 160, 77, 204, 77, 242, 110, 219, 38, 230, 38, 27, 101,
-			31, 145, 235, 196, 233, 243, 104, 56, 56, 85, 254, 224, 61, 173,
-			19, 165, 162, 188, 203, 74, 81, 185, 238, 121, 173, 248, 230, 109,
-			150, 156, 246, 14, 172, 146, 208, 172, 10, 7, 87, 73, 46, 77,
-			100, 90, 103, 135, 207, 102, 85, 56, 184, 74, 114, 105, 34, 211,
-			58, 59, 36, 199, 198, 205, 103, 144, 90, 114, 105, 245, 93, 103,
-			167, 30, 13, 39, 247, 153, 207, 120, 29, 100, 167, 137, 78, 235,
-			48, 143, 110, 219, 189, 173, 45, 195, 36, 99, 106, 15, 140, 177,
-			159, 112, 52, 160, 207, 163, 233, 112, 185, 252, 183, 95, 15, 201,
-			181, 86, 120, 23, 233, 153, 77, 123, 222, 149, 251, 94, 135, 128,
-			101, 235, 101, 88, 199, 41, 130, 65, 72, 135, 181, 57, 153, 102,
-			86, 93, 16, 34, 33, 89, 33, 200, 226, 141, 89, 89, 156, 221,
-			16, 34, 33, 89, 33, 0, 160, 55, 18, 157, 5, 134, 164, 11,
-			162, 242, 100, 133, 168, 71, 83, 116, 147, 161, 179, 192, 192, 116,
-			65, 136, 132, 60, 101, 69, 215, 164, 219, 197, 201, 242, 35, 124,
-			57, 148, 62, 0, 18, 229, 109, 28, 4, 95, 21, 162, 163, 235,
-			48, 165, 130, 5, 232, 151, 108, 163, 118, 220, 75, 119, 187, 152,
-			150, 179, 10, 113, 121, 111, 171, 107, 103, 19, 244, 146, 110, 247,
-			143, 218, 16, 234, 209, 237, 241, 9, 61, 189, 160, 73, 47, 184,
-			83, 57, 35, 178, 23, 172, 249, 131, 71, 160, 23, 84, 148, 120,
-			109, 68, 246, 194, 192, 88, 206, 136, 236, 133, 201, 125, 153, 209,
-			206, 123, 76, 108, 151, 94, 215, 115, 223, 99, 150, 62, 218, 240,
-			188, 135, 244, 89, 54, 60, 239, 97, 163, 150, 13, 207, 123, 208,
-			148, 146, 184, 196, 43, 124, 47, 234, 198, 181, 85, 205, 247, 150,
-			202, 236, 143, 11, 58, 18, 201, 203, 14, 25, 47, 255, 79, 133,
-			204, 171, 148, 246, 105, 129, 98, 160, 223, 108, 242, 80, 108, 219,
-			254, 33, 182, 253, 132, 63, 23, 117, 226, 208, 111, 194, 97, 214,
-			4, 153, 111, 238, 104, 171, 1, 203, 91, 156, 150, 143, 230, 45,
-			113, 104, 158, 223, 200, 75, 222, 57, 136, 202, 118, 3, 15, 209,
-			12, 27, 176, 45, 154, 205, 147, 242, 33, 160, 20, 98, 98, 161,
-			3, 81, 101, 129, 83, 234, 117, 96, 242, 235, 112, 44, 69, 78,
-			62, 131, 161, 218, 58, 126, 179, 185, 51, 163, 54, 63, 191, 142,
-			54, 17, 183, 2, 223, 126, 111, 58, 155, 127, 112, 58, 167, 252,
-			116, 40, 41, 107, 7, 106, 203, 142, 184, 91, 254, 45, 21, 237,
-			118, 121, 17, 31, 117, 55, 101, 232, 112, 45, 148, 107, 189, 147,
-			218, 170, 44, 25, 16, 31, 128, 199, 56, 31, 109, 13, 23, 30,
-			161, 165, 160, 111, 84, 7, 0, 49, 66, 89, 16, 50, 139, 138,
-			41, 250, 30, 208, 39, 65, 181, 99, 193, 73, 64, 182, 225, 134,
-			92, 248, 55, 178, 115, 207, 226, 202, 18, 111, 52, 253, 77, 229,
-			58, 0, 27, 213, 69, 125, 12, 218, 175, 30, 9, 206, 42, 175,
-			4, 230, 108, 52, 207, 55, 252, 186, 178, 23, 152, 231, 34, 173,
-			85, 230, 212, 59, 50, 97, 61, 158, 71, 247, 173, 82, 194, 133,
-			86, 228, 111, 127, 110, 224, 222, 107, 25, 69, 232, 207, 137, 52,
-			39, 170, 179, 220, 44, 154, 183, 142, 41, 112, 222, 145, 2, 4,
-			116, 59, 6, 81, 57, 22, 245, 221, 61, 216, 54, 114, 3, 179,
-			62, 6, 245, 228, 6, 218, 162, 42, 211, 129, 194, 203, 14, 249,
-			222, 251, 172, 40, 55, 47, 235, 96, 68, 210, 221, 213, 203, 78,
-			175, 29, 229, 230, 101, 199, 27, 99, 159, 119, 116, 148, 155, 143,
-			56, 228, 88, 249, 31, 59, 70, 127, 153, 117, 189, 203, 72, 207,
-			28, 147, 42, 90, 195, 104, 132, 110, 125, 44, 103, 124, 99, 199,
-			58, 135, 162, 138, 46, 80, 241, 108, 84, 168, 223, 229, 52, 209,
-			39, 222, 32, 225, 155, 185, 64, 65, 104, 30, 34, 167, 97, 22,
-			111, 231, 121, 121, 50, 147, 94, 27, 98, 33, 149, 80, 97, 164,
-			189, 92, 48, 29, 17, 198, 197, 174, 152, 100, 17, 3, 29, 79,
-			90, 225, 99, 62, 226, 236, 227, 86, 248, 152, 143, 56, 71, 142,
-			178, 63, 48, 225, 99, 62, 230, 144, 217, 242, 151, 28, 110, 83,
-			249, 117, 81, 34, 27, 125, 155, 24, 249, 17, 253, 171, 163, 74,
-			30, 239, 221, 201, 131, 190, 218, 50, 242, 144, 34, 36, 13, 121,
-			48, 142, 132, 179, 239, 136, 21, 146, 230, 99, 206, 241, 25, 246,
-			67, 146, 60, 212, 115, 127, 20, 102, 201, 7, 44, 242, 72, 207,
-			177, 129, 137, 76, 35, 67, 25, 237, 106, 134, 81, 210, 203, 246,
-			232, 56, 67, 236, 142, 93, 193, 16, 37, 39, 237, 144, 80, 210,
-			95, 104, 87, 127, 168, 139, 109, 50, 201, 2, 36, 251, 71, 117,
-			210, 129, 164, 167, 135, 155, 98, 7, 142, 28, 101, 63, 81, 100,
-			210, 251, 183, 251, 115, 14, 41, 151, 255, 219, 162, 118, 238, 33,
-			131, 236, 228, 221, 197, 240, 182, 136, 205, 251, 222, 200, 62, 154,
-			206, 219, 79, 127, 253, 112, 71, 251, 64, 145, 241, 11, 164, 58,
-			68, 105, 73, 229, 69, 13, 62, 253, 72, 85, 100, 34, 205, 46,
-			106, 126, 179, 89, 225, 11, 225, 14, 238, 68, 80, 163, 186, 87,
-			69, 127, 184, 117, 229, 205, 39, 107, 147, 196, 62, 171, 55, 180,
-			199, 30, 131, 237, 108, 78, 121, 249, 104, 183, 133, 31, 227, 236,
-			100, 252, 198, 226, 202, 146, 252, 10, 108, 66, 41, 76, 229, 30,
-			39, 61, 130, 108, 251, 59, 73, 222, 13, 18, 170, 150, 119, 180,
-			58, 84, 170, 178, 164, 150, 70, 133, 233, 52, 149, 98, 188, 168,
-			121, 19, 3, 42, 8, 147, 182, 188, 234, 12, 18, 121, 193, 36,
-			253, 149, 192, 62, 187, 163, 238, 130, 20, 86, 140, 5, 17, 117,
-			100, 83, 230, 120, 11, 14, 241, 81, 152, 189, 55, 182, 54, 21,
-			169, 198, 81, 27, 9, 156, 208, 229, 109, 137, 220, 78, 178, 200,
-			90, 93, 29, 149, 129, 13, 154, 73, 212, 109, 223, 39, 67, 229,
-			239, 142, 200, 192, 125, 73, 212, 166, 72, 18, 166, 204, 109, 79,
-			26, 95, 87, 24, 106, 82, 117, 193, 104, 189, 50, 215, 59, 179,
-			24, 22, 74, 223, 115, 236, 161, 86, 86, 94, 144, 230, 148, 102,
-			1, 254, 212, 180, 225, 50, 148, 154, 14, 43, 35, 29, 47, 2,
-			153, 181, 96, 156, 83, 141, 227, 124, 128, 78, 170, 13, 10, 254,
-			76, 236, 175, 93, 12, 202, 138, 184, 145, 121, 41, 206, 104, 29,
-			228, 116, 44, 18, 89, 206, 102, 52, 11, 201, 229, 98, 108, 99,
-			242, 163, 142, 182, 82, 115, 139, 184, 110, 134, 116, 82, 198, 51,
-			158, 208, 73, 140, 100, 60, 181, 159, 253, 34, 197, 69, 86, 240,
-			220, 127, 228, 144, 125, 229, 79, 81, 140, 233, 118, 35, 119, 19,
-			116, 67, 250, 155, 208, 49, 65, 230, 248, 154, 177, 123, 176, 108,
-			59, 45, 111, 218, 170, 185, 184, 52, 44, 63, 161, 139, 87, 50,
-			87, 200, 86, 24, 94, 237, 67, 190, 153, 242, 217, 44, 102, 178,
-			186, 115, 17, 146, 140, 90, 175, 187, 173, 244, 100, 242, 162, 41,
-			225, 157, 112, 163, 25, 213, 110, 138, 250, 92, 197, 214, 171, 153,
-			1, 8, 243, 149, 205, 36, 12, 85, 82, 202, 173, 174, 158, 129,
-			177, 184, 21, 221, 84, 114, 157, 242, 97, 192, 155, 81, 184, 41,
-			98, 190, 29, 197, 55, 113, 197, 53, 58, 90, 90, 67, 94, 144,
-			104, 57, 102, 15, 74, 89, 98, 83, 78, 71, 102, 142, 84, 65,
-			194, 213, 202, 212, 110, 141, 89, 70, 190, 140, 50, 232, 231, 41,
-			10, 211, 32, 236, 24, 48, 250, 106, 146, 67, 94, 112, 189, 226,
-			63, 114, 200, 207, 57, 101, 53, 168, 5, 57, 138, 90, 154, 40,
-			56, 144, 236, 213, 129, 205, 10, 20, 146, 19, 147, 198, 208, 239,
-			167, 139, 236, 241, 123, 55, 143, 147, 103, 26, 45, 25, 255, 21,
-			57, 222, 121, 150, 141, 228, 124, 169, 188, 30, 167, 59, 211, 204,
-			5, 76, 234, 193, 35, 218, 193, 101, 49, 227, 170, 248, 237, 169,
-			127, 230, 202, 87, 142, 222, 119, 128, 81, 142, 237, 111, 231, 130,
-			52, 191, 233, 239, 241, 156, 242, 155, 121, 55, 9, 51, 229, 156,
-			244, 228, 154, 69, 127, 241, 67, 203, 55, 182, 182, 219, 233, 47,
-			77, 177, 125, 218, 110, 103, 144, 28, 44, 51, 173, 205, 173, 219,
-			22, 56, 131, 164, 191, 108, 57, 181, 24, 204, 57, 181, 24, 204,
-			57, 181, 24, 60, 96, 92, 115, 56, 30, 29, 37, 147, 229, 23,
-			237, 48, 52, 184, 187, 25, 123, 239, 44, 26, 13, 108, 43, 192,
-			7, 245, 150, 130, 71, 25, 29, 212, 18, 150, 103, 3, 149, 19,
-			198, 9, 155, 201, 149, 197, 210, 148, 12, 70, 249, 79, 83, 218,
-			234, 156, 77, 204, 40, 25, 52, 206, 33, 138, 208, 182, 1, 203,
-			38, 102, 116, 112, 212, 178, 137, 25, 29, 159, 48, 107, 229, 223,
-			157, 100, 143, 190, 222, 121, 46, 163, 220, 100, 11, 229, 91, 180,
-			69, 253, 129, 34, 99, 120, 40, 124, 59, 224, 243, 206, 176, 1,
-			173, 44, 133, 35, 151, 90, 38, 187, 109, 35, 253, 44, 225, 125,
-			23, 27, 52, 10, 86, 63, 220, 20, 202, 214, 238, 128, 177, 117,
-			67, 212, 102, 35, 131, 44, 85, 93, 11, 166, 240, 113, 183, 240,
-			227, 218, 150, 50, 188, 155, 232, 42, 186, 134, 31, 171, 42, 83,
-			249, 41, 54, 96, 35, 243, 198, 51, 163, 85, 124, 187, 47, 141,
-			84, 71, 24, 109, 70, 219, 184, 128, 7, 171, 240, 211, 243, 152,
-			187, 21, 108, 110, 41, 91, 72, 252, 93, 254, 1, 151, 21, 37,
-			122, 239, 33, 86, 172, 71, 45, 63, 8, 17, 207, 144, 180, 213,
-			220, 213, 138, 202, 34, 230, 169, 170, 188, 222, 12, 43, 160, 175,
-			36, 229, 254, 97, 20, 10, 93, 141, 35, 16, 24, 119, 212, 187,
-			104, 252, 238, 29, 97, 84, 132, 117, 101, 82, 185, 71, 54, 248,
-			234, 173, 178, 33, 212, 58, 223, 190, 222, 8, 154, 169, 136, 147,
-			169, 2, 82, 100, 118, 239, 182, 44, 96, 222, 139, 50, 171, 124,
-			211, 61, 232, 219, 48, 239, 50, 27, 68, 215, 55, 6, 95, 81,
-			61, 245, 223, 19, 223, 18, 100, 205, 161, 27, 16, 22, 168, 252,
-			78, 230, 237, 174, 114, 143, 247, 226, 243, 249, 247, 226, 147, 80,
-			219, 149, 78, 51, 13, 186, 40, 147, 61, 167, 175, 178, 209, 93,
-			149, 239, 129, 120, 38, 143, 120, 47, 106, 103, 239, 232, 57, 43,
-			202, 193, 242, 250, 88, 225, 237, 215, 150, 214, 214, 71, 122, 188,
-			126, 214, 187, 176, 190, 190, 116, 229, 234, 250, 136, 35, 237, 79,
-			171, 189, 73, 20, 167, 215, 55, 118, 158, 250, 205, 227, 210, 204,
-			242, 165, 239, 4, 51, 203, 199, 45, 142, 254, 97, 7, 89, 122,
-			97, 0, 186, 86, 126, 15, 207, 70, 62, 255, 114, 77, 61, 51,
-			147, 177, 56, 140, 155, 71, 121, 218, 136, 26, 104, 81, 150, 73,
-			205, 59, 242, 48, 82, 97, 124, 57, 157, 129, 210, 154, 175, 103,
-			102, 115, 200, 135, 115, 218, 97, 251, 109, 187, 199, 254, 141, 241,
-			176, 52, 66, 166, 203, 255, 196, 225, 22, 147, 233, 50, 87, 147,
-			6, 30, 161, 224, 58, 36, 156, 121, 109, 111, 110, 249, 252, 196,
-			186, 73, 97, 214, 21, 109, 188, 35, 245, 51, 74, 31, 161, 68,
-			239, 196, 182, 124, 81, 246, 89, 252, 52, 30, 75, 117, 60, 94,
-			25, 254, 85, 42, 251, 102, 124, 219, 84, 201, 108, 48, 136, 114,
-			38, 103, 77, 58, 66, 6, 198, 173, 189, 108, 132, 140, 88, 123,
-			217, 200, 232, 65, 107, 47, 27, 225, 135, 229, 3, 127, 218, 227,
-			185, 30, 153, 84, 15, 252, 81, 7, 239, 177, 73, 212, 185, 170,
-			119, 94, 99, 202, 59, 186, 126, 170, 53, 230, 122, 242, 26, 70,
-			63, 212, 26, 83, 215, 52, 250, 153, 214, 88, 255, 72, 150, 86,
-			175, 227, 53, 62, 199, 163, 227, 202, 117, 181, 126, 160, 53, 238,
-			142, 77, 152, 252, 104, 209, 105, 225, 67, 155, 78, 245, 216, 79,
-			63, 205, 26, 31, 245, 12, 62, 226, 209, 9, 119, 220, 224, 131,
-			99, 245, 132, 59, 62, 102, 242, 147, 2, 100, 200, 240, 65, 3,
-			38, 212, 203, 55, 253, 180, 105, 194, 27, 99, 223, 103, 156, 85,
-			29, 32, 15, 148, 255, 139, 195, 115, 59, 203, 94, 83, 194, 231,
-			242, 147, 109, 127, 17, 132, 185, 185, 140, 246, 19, 205, 104, 219,
-			152, 18, 60, 142, 87, 106, 97, 157, 195, 22, 96, 65, 155, 209,
-			182, 114, 149, 138, 40, 131, 132, 127, 119, 51, 218, 158, 199, 108,
-			115, 21, 126, 9, 115, 251, 59, 12, 114, 3, 12, 215, 173, 114,
-			138, 249, 255, 144, 247, 44, 177, 113, 36, 215, 177, 187, 106, 70,
-			100, 145, 20, 169, 34, 57, 228, 182, 68, 169, 50, 241, 102, 164,
-			221, 225, 136, 164, 149, 181, 197, 141, 22, 166, 66, 105, 165, 213,
-			42, 226, 146, 202, 70, 14, 37, 104, 155, 211, 77, 77, 155, 195,
-			233, 81, 247, 80, 220, 129, 163, 40, 112, 180, 14, 140, 133, 1,
-			39, 6, 98, 4, 65, 14, 57, 230, 16, 192, 199, 28, 23, 73,
-			128, 228, 236, 28, 124, 202, 33, 167, 0, 185, 248, 144, 99, 16,
-			32, 168, 247, 234, 215, 67, 82, 203, 221, 141, 115, 112, 110, 83,
-			53, 213, 245, 121, 253, 250, 213, 251, 63, 104, 203, 253, 224, 38,
-			112, 126, 88, 20, 217, 133, 118, 122, 224, 14, 151, 77, 41, 197,
-			104, 244, 187, 168, 221, 13, 22, 47, 53, 76, 22, 6, 42, 193,
-			192, 28, 134, 226, 236, 232, 172, 195, 80, 156, 157, 123, 221, 97,
-			40, 206, 94, 188, 196, 126, 50, 2, 184, 228, 241, 210, 13, 255,
-			143, 61, 18, 252, 201, 136, 88, 21, 72, 219, 7, 96, 135, 159,
-			184, 182, 32, 96, 69, 157, 46, 18, 82, 41, 13, 99, 116, 150,
-			85, 104, 50, 43, 118, 168, 139, 164, 33, 20, 169, 134, 192, 108,
-			19, 165, 137, 37, 186, 163, 180, 183, 16, 197, 32, 112, 2, 57,
-			232, 181, 26, 226, 102, 10, 166, 159, 112, 175, 219, 142, 235, 34,
-			15, 251, 74, 195, 17, 131, 114, 182, 232, 38, 162, 246, 181, 194,
-			164, 84, 10, 18, 244, 197, 36, 186, 22, 197, 97, 180, 29, 199,
-			59, 151, 86, 148, 8, 173, 28, 212, 174, 137, 223, 50, 101, 158,
-			223, 17, 226, 215, 37, 57, 87, 226, 172, 241, 3, 107, 56, 137,
-			201, 30, 163, 171, 49, 100, 83, 17, 215, 48, 156, 236, 208, 248,
-			239, 228, 105, 231, 113, 55, 236, 67, 233, 164, 107, 226, 187, 56,
-			64, 136, 234, 110, 220, 175, 174, 136, 42, 102, 154, 170, 155, 110,
-			200, 89, 92, 93, 17, 91, 213, 60, 221, 139, 171, 117, 177, 180,
-			88, 87, 163, 242, 106, 29, 100, 198, 71, 118, 116, 190, 191, 45,
-			199, 126, 183, 186, 151, 63, 169, 174, 136, 165, 165, 231, 117, 97,
-			26, 203, 207, 245, 200, 231, 0, 0, 69, 20, 93, 16, 252, 193,
-			210, 201, 129, 80, 168, 11, 116, 205, 56, 233, 225, 159, 218, 152,
-			212, 128, 202, 213, 232, 249, 81, 152, 106, 96, 24, 128, 69, 113,
-			218, 46, 84, 178, 188, 221, 147, 96, 233, 203, 211, 154, 222, 116,
-			183, 186, 130, 226, 178, 61, 207, 125, 237, 123, 131, 245, 187, 16,
-			17, 47, 38, 29, 209, 205, 227, 253, 40, 93, 0, 190, 20, 79,
-			135, 44, 214, 138, 6, 128, 236, 42, 114, 71, 43, 122, 7, 85,
-			247, 144, 240, 22, 244, 49, 171, 26, 150, 213, 175, 33, 41, 56,
-			230, 29, 55, 204, 11, 68, 241, 126, 105, 241, 164, 15, 230, 251,
-			219, 13, 124, 115, 91, 75, 75, 133, 7, 143, 130, 90, 3, 96,
-			178, 101, 241, 65, 194, 228, 94, 134, 168, 126, 196, 129, 11, 220,
-			155, 61, 239, 73, 14, 179, 181, 244, 8, 54, 245, 69, 78, 178,
-			181, 248, 200, 30, 198, 110, 240, 253, 164, 23, 103, 97, 91, 212,
-			26, 152, 163, 179, 182, 85, 19, 205, 86, 152, 133, 77, 32, 1,
-			138, 198, 197, 121, 51, 236, 106, 205, 120, 40, 182, 195, 230, 110,
-			222, 14, 161, 50, 50, 94, 107, 146, 106, 221, 96, 147, 96, 122,
-			30, 34, 158, 148, 50, 111, 210, 219, 37, 117, 13, 120, 192, 23,
-			220, 28, 153, 0, 163, 41, 180, 229, 197, 247, 110, 25, 45, 184,
-			170, 195, 147, 61, 163, 110, 143, 207, 201, 187, 167, 221, 135, 60,
-			78, 110, 149, 185, 51, 196, 131, 158, 113, 183, 199, 231, 228, 214,
-			228, 25, 246, 207, 62, 110, 69, 46, 180, 78, 43, 193, 223, 249,
-			2, 121, 70, 71, 247, 132, 58, 208, 102, 59, 204, 65, 195, 175,
-			137, 166, 45, 54, 170, 98, 191, 115, 209, 75, 241, 254, 192, 26,
-			218, 76, 132, 207, 194, 164, 173, 75, 82, 169, 161, 89, 108, 60,
-			23, 181, 182, 83, 202, 155, 70, 44, 237, 28, 86, 34, 50, 39,
-			57, 187, 54, 144, 51, 38, 86, 149, 239, 33, 36, 90, 254, 104,
-			85, 91, 21, 209, 103, 28, 216, 35, 40, 183, 34, 103, 253, 154,
-			202, 56, 10, 116, 62, 223, 223, 182, 69, 190, 25, 166, 245, 86,
-			161, 212, 131, 185, 50, 15, 52, 153, 14, 5, 10, 95, 120, 183,
-			42, 61, 50, 2, 234, 32, 205, 118, 117, 69, 219, 33, 101, 16,
-			94, 119, 222, 42, 48, 65, 235, 230, 242, 71, 131, 240, 250, 232,
-			25, 219, 38, 156, 172, 79, 207, 176, 127, 243, 16, 73, 24, 39,
-			191, 59, 62, 27, 252, 204, 19, 203, 40, 165, 35, 63, 173, 42,
-			152, 1, 171, 14, 215, 9, 56, 5, 196, 207, 226, 172, 111, 221,
-			182, 96, 128, 4, 108, 85, 17, 198, 170, 10, 26, 78, 76, 226,
-			115, 215, 49, 91, 185, 34, 24, 13, 218, 229, 52, 211, 122, 4,
-			245, 81, 228, 197, 236, 254, 204, 228, 137, 4, 203, 169, 100, 200,
-			227, 118, 220, 148, 220, 42, 164, 92, 239, 197, 176, 131, 5, 8,
-			32, 54, 254, 110, 123, 121, 220, 126, 22, 231, 202, 97, 68, 30,
-			113, 72, 158, 177, 162, 63, 139, 17, 78, 62, 28, 159, 208, 127,
-			142, 12, 201, 166, 102, 182, 208, 155, 164, 208, 246, 177, 253, 115,
-			79, 225, 174, 199, 201, 22, 189, 16, 252, 147, 39, 54, 165, 168,
-			9, 95, 232, 13, 101, 69, 78, 187, 136, 34, 38, 13, 11, 38,
-			108, 87, 103, 74, 178, 188, 135, 32, 83, 151, 127, 95, 93, 212,
-			29, 201, 247, 244, 90, 113, 30, 195, 44, 223, 217, 7, 47, 188,
-			195, 51, 212, 20, 152, 107, 194, 92, 25, 112, 104, 52, 5, 57,
-			14, 149, 194, 86, 114, 171, 43, 25, 2, 110, 122, 156, 6, 121,
-			20, 252, 242, 28, 84, 146, 124, 233, 22, 253, 112, 194, 160, 138,
-			100, 130, 182, 232, 180, 109, 203, 163, 207, 4, 182, 77, 56, 217,
-			154, 63, 175, 33, 41, 249, 210, 135, 170, 54, 207, 16, 86, 202,
-			162, 15, 233, 214, 5, 51, 222, 47, 203, 1, 118, 62, 9, 202,
-			135, 51, 115, 182, 77, 56, 121, 120, 246, 28, 251, 119, 170, 38,
-			36, 156, 116, 233, 74, 240, 115, 42, 10, 130, 240, 0, 119, 133,
-			228, 90, 30, 174, 134, 23, 86, 178, 23, 246, 226, 154, 162, 11,
-			13, 245, 44, 116, 22, 10, 238, 75, 80, 72, 138, 12, 44, 147,
-			54, 249, 0, 15, 81, 23, 218, 100, 18, 118, 36, 17, 201, 66,
-			140, 85, 2, 58, 158, 15, 114, 87, 201, 14, 82, 39, 173, 206,
-			23, 237, 20, 84, 208, 152, 166, 94, 18, 117, 125, 143, 0, 179,
-			34, 239, 75, 201, 76, 87, 37, 19, 146, 238, 64, 91, 210, 164,
-			106, 93, 84, 195, 142, 252, 132, 170, 121, 111, 127, 103, 167, 250,
-			232, 185, 189, 18, 238, 107, 253, 183, 227, 221, 107, 143, 165, 217,
-			68, 229, 246, 6, 252, 36, 220, 233, 184, 162, 184, 166, 151, 124,
-			100, 58, 27, 233, 14, 246, 195, 210, 71, 244, 203, 173, 28, 209,
-			173, 182, 118, 220, 31, 234, 12, 159, 247, 127, 93, 232, 117, 217,
-			160, 183, 48, 40, 232, 225, 56, 96, 53, 82, 48, 205, 77, 49,
-			139, 88, 229, 24, 73, 119, 156, 128, 49, 134, 148, 18, 197, 214,
-			76, 190, 43, 248, 153, 64, 109, 20, 240, 97, 6, 73, 83, 87,
-			175, 132, 156, 29, 153, 131, 250, 132, 114, 218, 165, 15, 231, 13,
-			42, 146, 178, 68, 189, 223, 176, 109, 143, 147, 110, 237, 55, 109,
-			91, 162, 230, 55, 175, 178, 63, 210, 168, 74, 57, 253, 158, 71,
-			175, 4, 191, 32, 194, 85, 173, 28, 143, 170, 64, 247, 45, 146,
-			194, 67, 3, 216, 201, 142, 69, 79, 244, 64, 2, 44, 56, 10,
-			57, 53, 110, 106, 212, 100, 255, 215, 184, 25, 171, 211, 188, 2,
-			43, 183, 22, 31, 73, 196, 192, 229, 108, 239, 210, 163, 70, 186,
-			163, 255, 131, 165, 7, 255, 91, 194, 255, 228, 118, 6, 255, 90,
-			198, 191, 112, 139, 191, 92, 212, 66, 20, 146, 151, 204, 164, 66,
-			9, 74, 121, 233, 123, 30, 237, 174, 24, 172, 162, 101, 64, 138,
-			95, 179, 29, 158, 236, 168, 94, 182, 29, 68, 118, 44, 127, 29,
-			213, 18, 190, 207, 233, 75, 207, 159, 119, 66, 27, 95, 106, 83,
-			59, 132, 54, 210, 151, 218, 212, 142, 133, 187, 94, 122, 124, 78,
-			55, 137, 108, 158, 61, 103, 212, 220, 127, 127, 141, 125, 1, 115,
-			206, 65, 216, 222, 197, 204, 15, 142, 150, 251, 252, 96, 182, 227,
-			72, 133, 180, 170, 140, 12, 23, 6, 255, 55, 183, 210, 255, 66,
-			186, 135, 175, 162, 163, 255, 178, 250, 249, 191, 24, 102, 99, 174,
-			2, 237, 196, 6, 44, 204, 228, 144, 245, 93, 11, 150, 85, 251,
-			109, 224, 159, 252, 18, 163, 123, 105, 20, 43, 229, 53, 232, 221,
-			139, 213, 72, 210, 40, 222, 128, 33, 188, 174, 51, 25, 80, 171,
-			211, 61, 236, 147, 174, 115, 24, 44, 219, 28, 6, 37, 149, 155,
-			98, 112, 252, 96, 246, 2, 249, 76, 252, 49, 62, 83, 62, 230,
-			153, 27, 31, 171, 103, 212, 192, 224, 83, 143, 81, 185, 73, 72,
-			63, 187, 147, 171, 36, 0, 242, 39, 191, 198, 70, 48, 142, 44,
-			73, 49, 173, 239, 233, 229, 11, 71, 30, 176, 177, 166, 135, 109,
-			216, 39, 170, 203, 108, 196, 244, 243, 49, 54, 124, 243, 222, 198,
-			239, 173, 110, 172, 109, 78, 14, 241, 113, 54, 114, 125, 245, 183,
-			239, 96, 211, 227, 195, 140, 94, 191, 119, 255, 214, 164, 31, 252,
-			161, 206, 191, 112, 150, 141, 128, 67, 125, 220, 85, 175, 138, 108,
-			12, 239, 133, 31, 175, 201, 54, 191, 194, 228, 239, 199, 18, 53,
-			213, 219, 121, 237, 80, 102, 235, 53, 133, 215, 27, 167, 246, 176,
-			32, 204, 73, 82, 58, 4, 127, 73, 108, 26, 135, 183, 92, 171,
-			200, 232, 178, 56, 14, 254, 38, 125, 131, 178, 155, 172, 216, 244,
-			15, 254, 9, 159, 212, 15, 240, 119, 216, 136, 77, 145, 65, 78,
-			248, 180, 125, 132, 191, 206, 78, 119, 246, 247, 172, 197, 59, 7,
-			100, 27, 223, 24, 239, 236, 239, 217, 108, 241, 252, 53, 54, 188,
-			115, 16, 73, 232, 98, 246, 142, 225, 141, 83, 59, 88, 229, 92,
-			2, 94, 138, 157, 248, 31, 38, 82, 24, 222, 86, 85, 197, 131,
-			231, 54, 47, 197, 36, 35, 73, 100, 208, 37, 137, 114, 206, 29,
-			139, 239, 48, 90, 120, 143, 203, 94, 1, 105, 39, 84, 61, 75,
-			204, 135, 190, 97, 218, 124, 78, 98, 113, 55, 201, 226, 72, 239,
-			77, 53, 131, 223, 97, 167, 20, 254, 202, 137, 209, 93, 14, 18,
-			173, 140, 108, 168, 22, 127, 147, 13, 155, 188, 36, 254, 209, 182,
-			55, 51, 224, 189, 255, 88, 70, 115, 197, 143, 127, 21, 204, 21,
-			53, 155, 21, 226, 162, 206, 10, 49, 162, 18, 68, 16, 78, 216,
-			169, 53, 248, 73, 57, 25, 53, 166, 141, 241, 97, 198, 126, 230,
-			163, 105, 163, 34, 193, 16, 252, 163, 95, 48, 55, 12, 240, 58,
-			242, 74, 177, 33, 238, 218, 144, 240, 129, 246, 85, 205, 210, 253,
-			39, 45, 116, 200, 49, 62, 33, 53, 144, 225, 14, 194, 44, 42,
-			184, 11, 53, 152, 170, 195, 214, 10, 59, 81, 59, 206, 84, 132,
-			169, 228, 133, 32, 6, 215, 122, 130, 170, 162, 89, 117, 235, 98,
-			1, 2, 161, 4, 135, 188, 209, 219, 237, 184, 221, 96, 200, 33,
-			133, 77, 91, 2, 65, 71, 137, 75, 185, 12, 131, 53, 148, 178,
-			0, 232, 182, 141, 230, 120, 186, 31, 239, 199, 34, 132, 163, 49,
-			91, 60, 195, 198, 164, 116, 210, 40, 174, 131, 211, 174, 36, 101,
-			202, 69, 41, 85, 222, 246, 121, 220, 19, 23, 13, 161, 82, 206,
-			175, 142, 141, 166, 50, 60, 205, 214, 180, 137, 102, 206, 159, 15,
-			190, 33, 238, 41, 121, 178, 33, 54, 227, 248, 24, 47, 165, 29,
-			148, 162, 141, 176, 88, 176, 142, 204, 249, 149, 138, 99, 29, 153,
-			43, 88, 250, 231, 10, 150, 254, 185, 179, 231, 216, 95, 19, 109,
-			17, 16, 254, 108, 240, 99, 34, 208, 108, 101, 83, 59, 20, 98,
-			127, 51, 212, 194, 96, 249, 26, 199, 97, 174, 151, 133, 207, 226,
-			44, 15, 219, 66, 213, 30, 97, 78, 23, 0, 50, 109, 54, 247,
-			51, 8, 139, 19, 251, 157, 98, 132, 31, 88, 201, 192, 201, 170,
-			33, 54, 226, 104, 191, 19, 133, 157, 158, 83, 231, 202, 86, 182,
-			233, 164, 18, 233, 33, 68, 196, 232, 102, 68, 43, 14, 159, 245,
-			65, 75, 242, 54, 106, 43, 212, 158, 228, 155, 81, 14, 97, 144,
-			146, 44, 109, 198, 121, 14, 1, 236, 97, 19, 107, 15, 54, 225,
-			205, 61, 9, 179, 168, 29, 163, 238, 169, 5, 6, 133, 78, 31,
-			165, 109, 137, 5, 232, 238, 151, 155, 24, 236, 129, 253, 218, 48,
-			10, 181, 241, 102, 223, 9, 147, 4, 112, 193, 206, 113, 215, 96,
-			237, 147, 212, 32, 131, 136, 114, 172, 162, 2, 101, 114, 34, 168,
-			211, 39, 63, 3, 248, 102, 146, 14, 230, 25, 196, 8, 62, 235,
-			0, 33, 252, 57, 215, 1, 66, 20, 28, 32, 132, 74, 5, 138,
-			246, 10, 49, 83, 177, 166, 175, 170, 191, 228, 154, 190, 170, 236,
-			52, 251, 91, 223, 218, 190, 106, 244, 76, 240, 87, 190, 88, 187,
-			185, 137, 222, 39, 7, 173, 184, 135, 214, 151, 68, 69, 159, 194,
-			205, 186, 128, 250, 140, 139, 73, 222, 186, 36, 32, 204, 255, 122,
-			22, 135, 209, 192, 31, 160, 234, 100, 98, 51, 233, 52, 99, 101,
-			139, 9, 35, 231, 211, 129, 192, 220, 118, 47, 89, 232, 181, 228,
-			211, 186, 136, 145, 210, 127, 33, 63, 158, 228, 98, 27, 236, 55,
-			241, 206, 78, 154, 169, 144, 47, 116, 169, 108, 162, 71, 62, 4,
-			135, 165, 123, 177, 104, 245, 183, 179, 36, 18, 219, 113, 239, 32,
-			142, 59, 112, 6, 9, 206, 235, 55, 55, 85, 88, 106, 30, 247,
-			122, 160, 228, 107, 166, 157, 94, 150, 182, 209, 46, 185, 157, 132,
-			185, 48, 220, 136, 198, 70, 53, 153, 220, 49, 136, 90, 237, 39,
-			105, 150, 244, 90, 123, 70, 112, 196, 111, 172, 70, 171, 147, 5,
-			219, 96, 141, 14, 23, 108, 131, 181, 145, 177, 130, 109, 176, 54,
-			49, 201, 154, 8, 111, 58, 196, 233, 155, 244, 114, 41, 216, 20,
-			134, 255, 25, 116, 196, 43, 236, 171, 224, 70, 57, 232, 167, 103,
-			2, 125, 37, 129, 106, 24, 3, 32, 80, 151, 55, 71, 184, 82,
-			218, 14, 33, 133, 169, 151, 167, 148, 138, 118, 72, 209, 131, 122,
-			249, 180, 219, 227, 115, 82, 63, 227, 62, 228, 113, 178, 80, 158,
-			118, 134, 120, 208, 51, 225, 246, 248, 156, 44, 168, 232, 29, 236,
-			241, 57, 105, 20, 134, 200, 105, 26, 42, 10, 70, 245, 200, 49,
-			42, 96, 75, 217, 75, 23, 233, 185, 130, 189, 116, 145, 94, 46,
-			89, 123, 104, 89, 14, 24, 47, 216, 75, 23, 79, 207, 22, 236,
-			165, 139, 193, 89, 155, 66, 125, 217, 159, 116, 100, 170, 101, 131,
-			254, 160, 145, 90, 246, 203, 78, 186, 152, 229, 83, 163, 78, 186,
-			152, 229, 211, 19, 234, 179, 241, 56, 189, 226, 191, 79, 28, 213,
-			250, 21, 54, 193, 62, 243, 172, 66, 251, 42, 157, 11, 126, 234,
-			65, 100, 165, 252, 60, 172, 231, 150, 50, 118, 187, 54, 83, 40,
-			137, 8, 20, 49, 126, 91, 44, 106, 155, 119, 178, 183, 23, 71,
-			73, 216, 139, 107, 76, 92, 236, 164, 133, 119, 125, 169, 46, 22,
-			150, 244, 192, 78, 138, 254, 165, 53, 52, 124, 190, 179, 168, 131,
-			194, 247, 18, 172, 97, 6, 190, 205, 79, 194, 94, 242, 76, 169,
-			12, 228, 7, 148, 68, 113, 46, 39, 193, 56, 103, 240, 235, 30,
-			80, 36, 95, 165, 87, 28, 69, 113, 73, 158, 105, 164, 160, 72,
-			190, 202, 166, 10, 138, 228, 171, 149, 89, 246, 47, 190, 85, 140,
-			174, 210, 55, 130, 127, 128, 232, 82, 201, 69, 191, 42, 178, 20,
-			28, 178, 13, 254, 34, 29, 85, 84, 89, 74, 217, 10, 143, 33,
-			13, 181, 88, 237, 118, 219, 154, 242, 107, 142, 41, 138, 195, 168,
-			157, 116, 128, 93, 170, 109, 131, 215, 47, 16, 135, 90, 93, 89,
-			16, 116, 208, 80, 172, 203, 73, 238, 133, 125, 180, 158, 134, 98,
-			59, 233, 105, 167, 80, 101, 35, 78, 114, 216, 17, 170, 252, 116,
-			224, 168, 83, 33, 73, 21, 71, 83, 117, 171, 140, 98, 225, 136,
-			122, 141, 79, 178, 110, 211, 204, 213, 146, 36, 45, 211, 91, 82,
-			17, 225, 61, 17, 138, 86, 152, 69, 204, 30, 66, 71, 106, 235,
-			184, 132, 162, 82, 118, 149, 94, 157, 43, 40, 101, 87, 233, 185,
-			130, 82, 118, 117, 254, 245, 130, 82, 118, 245, 226, 37, 246, 25,
-			177, 90, 217, 59, 116, 62, 248, 41, 249, 101, 6, 253, 98, 16,
-			75, 4, 69, 122, 7, 3, 129, 177, 23, 245, 253, 236, 184, 48,
-			97, 136, 193, 122, 117, 156, 48, 94, 185, 255, 207, 226, 132, 181,
-			34, 253, 14, 93, 125, 195, 42, 202, 75, 242, 157, 178, 130, 34,
-			253, 206, 104, 81, 145, 126, 71, 197, 9, 67, 222, 218, 187, 42,
-			78, 24, 115, 214, 222, 53, 132, 12, 244, 156, 119, 77, 85, 45,
-			41, 17, 220, 29, 30, 119, 106, 67, 220, 133, 192, 88, 73, 0,
-			125, 94, 186, 231, 127, 223, 211, 20, 80, 46, 121, 143, 157, 81,
-			198, 69, 72, 168, 189, 78, 83, 77, 163, 49, 87, 246, 250, 216,
-			20, 251, 133, 7, 55, 129, 74, 151, 253, 160, 60, 21, 252, 171,
-			39, 110, 38, 109, 117, 241, 214, 146, 168, 102, 203, 0, 58, 110,
-			223, 214, 169, 90, 195, 199, 186, 128, 22, 10, 167, 198, 89, 92,
-			203, 69, 220, 1, 113, 194, 225, 148, 224, 130, 143, 63, 86, 252,
-			1, 68, 72, 100, 9, 102, 184, 206, 99, 145, 68, 57, 211, 230,
-			16, 93, 24, 47, 137, 192, 209, 28, 39, 50, 242, 148, 148, 57,
-			224, 121, 147, 31, 91, 241, 108, 74, 86, 96, 40, 97, 72, 116,
-			235, 167, 251, 42, 4, 84, 29, 152, 114, 250, 160, 188, 62, 163,
-			174, 58, 157, 215, 251, 129, 185, 252, 116, 102, 239, 7, 163, 167,
-			221, 30, 194, 201, 3, 200, 124, 174, 33, 231, 113, 178, 85, 158,
-			14, 150, 156, 184, 84, 247, 214, 31, 8, 73, 93, 51, 14, 181,
-			238, 102, 192, 186, 83, 126, 48, 229, 44, 228, 149, 228, 188, 238,
-			102, 192, 194, 51, 58, 225, 246, 16, 78, 182, 248, 20, 251, 27,
-			98, 118, 227, 115, 210, 42, 87, 130, 159, 144, 47, 31, 38, 171,
-			51, 33, 169, 19, 160, 19, 152, 155, 99, 169, 238, 92, 11, 237,
-			244, 73, 210, 84, 114, 67, 167, 221, 119, 231, 53, 69, 130, 85,
-			109, 97, 39, 58, 192, 196, 25, 224, 45, 10, 54, 52, 73, 73,
-			32, 82, 243, 32, 145, 56, 96, 112, 139, 137, 237, 118, 216, 217,
-			61, 188, 177, 90, 46, 84, 237, 110, 137, 58, 174, 207, 179, 73,
-			148, 188, 135, 197, 198, 195, 14, 83, 247, 111, 65, 111, 164, 203,
-			115, 215, 213, 172, 123, 42, 120, 169, 16, 71, 162, 49, 75, 74,
-			194, 170, 220, 111, 99, 195, 22, 63, 238, 165, 98, 109, 245, 254,
-			234, 227, 205, 219, 191, 127, 227, 241, 251, 183, 239, 222, 190, 47,
-			55, 122, 11, 74, 128, 202, 91, 79, 210, 49, 137, 126, 40, 90,
-			23, 48, 80, 82, 142, 86, 121, 107, 218, 121, 161, 146, 118, 180,
-			10, 47, 93, 162, 87, 75, 5, 33, 171, 30, 194, 73, 107, 122,
-			134, 125, 219, 188, 115, 194, 201, 110, 121, 46, 184, 37, 225, 227,
-			212, 116, 174, 91, 167, 38, 172, 249, 41, 180, 102, 70, 168, 242,
-			201, 133, 66, 164, 154, 188, 22, 246, 40, 41, 210, 110, 185, 85,
-			113, 214, 39, 37, 185, 156, 187, 71, 73, 153, 118, 71, 93, 228,
-			149, 212, 105, 183, 50, 203, 214, 204, 30, 41, 39, 157, 242, 108,
-			240, 245, 67, 123, 84, 42, 33, 103, 175, 175, 216, 14, 165, 156,
-			118, 202, 187, 115, 206, 82, 180, 36, 103, 118, 183, 67, 61, 78,
-			58, 163, 220, 237, 33, 156, 116, 102, 180, 15, 33, 164, 153, 238,
-			210, 138, 34, 225, 152, 104, 186, 235, 208, 71, 144, 198, 187, 42,
-			110, 123, 72, 21, 208, 232, 142, 157, 177, 109, 194, 73, 87, 197,
-			129, 203, 182, 199, 201, 83, 21, 7, 14, 77, 202, 233, 83, 218,
-			173, 152, 241, 146, 45, 120, 234, 204, 39, 191, 228, 167, 99, 83,
-			182, 77, 56, 121, 90, 153, 53, 243, 249, 156, 100, 52, 48, 243,
-			73, 68, 201, 232, 211, 57, 51, 94, 114, 198, 153, 51, 159, 220,
-			64, 54, 54, 99, 219, 132, 147, 108, 238, 53, 246, 231, 190, 154,
-			144, 112, 210, 167, 231, 131, 239, 59, 37, 47, 77, 157, 120, 203,
-			1, 59, 255, 57, 20, 162, 27, 103, 78, 142, 183, 29, 149, 40,
-			2, 47, 217, 195, 145, 63, 78, 72, 157, 189, 22, 116, 73, 235,
-			24, 242, 152, 21, 246, 160, 239, 116, 201, 98, 128, 62, 10, 28,
-			17, 147, 8, 182, 208, 78, 15, 228, 79, 200, 130, 174, 100, 67,
-			19, 83, 247, 209, 210, 71, 76, 125, 232, 138, 255, 3, 157, 218,
-			234, 230, 253, 66, 100, 79, 20, 31, 142, 128, 96, 6, 178, 18,
-			189, 251, 52, 11, 12, 228, 36, 114, 247, 205, 229, 13, 49, 147,
-			164, 63, 250, 154, 109, 75, 72, 158, 155, 103, 159, 106, 200, 82,
-			78, 94, 208, 74, 240, 95, 158, 184, 137, 122, 87, 72, 188, 136,
-			116, 215, 232, 223, 92, 112, 30, 210, 161, 245, 221, 139, 145, 217,
-			124, 166, 138, 189, 65, 118, 22, 104, 107, 179, 21, 118, 158, 168,
-			20, 158, 232, 170, 140, 169, 46, 221, 139, 85, 43, 63, 240, 233,
-			183, 53, 237, 85, 126, 204, 57, 8, 159, 18, 140, 122, 27, 97,
-			129, 16, 235, 188, 118, 232, 143, 8, 10, 25, 100, 191, 80, 39,
-			20, 63, 139, 59, 56, 163, 195, 7, 56, 224, 148, 159, 231, 11,
-			218, 63, 111, 192, 37, 63, 206, 23, 70, 224, 134, 232, 56, 242,
-			98, 196, 126, 72, 242, 195, 124, 49, 61, 195, 94, 106, 112, 150,
-			56, 253, 196, 163, 179, 193, 127, 122, 226, 186, 210, 85, 127, 30,
-			64, 145, 109, 108, 238, 202, 227, 184, 62, 217, 172, 160, 154, 249,
-			82, 96, 212, 80, 100, 199, 130, 81, 47, 252, 121, 112, 68, 48,
-			178, 207, 129, 227, 164, 2, 75, 137, 242, 210, 39, 30, 125, 97,
-			73, 84, 9, 1, 51, 108, 59, 60, 217, 1, 106, 3, 213, 65,
-			100, 199, 76, 133, 125, 226, 169, 114, 97, 244, 7, 158, 95, 9,
-			14, 76, 78, 151, 129, 188, 169, 81, 228, 170, 230, 220, 195, 155,
-			184, 94, 148, 37, 92, 159, 43, 148, 149, 228, 23, 216, 239, 170,
-			186, 246, 82, 44, 222, 223, 91, 232, 165, 11, 237, 48, 123, 130,
-			17, 150, 145, 10, 158, 3, 138, 93, 254, 129, 7, 188, 41, 242,
-			184, 180, 12, 27, 27, 209, 77, 79, 54, 153, 54, 156, 82, 34,
-			155, 211, 146, 148, 73, 190, 150, 240, 242, 167, 158, 255, 167, 250,
-			97, 34, 37, 253, 79, 229, 232, 117, 192, 23, 130, 57, 45, 232,
-			133, 224, 91, 98, 13, 107, 232, 107, 172, 192, 192, 37, 253, 45,
-			89, 227, 183, 10, 205, 183, 239, 87, 103, 112, 64, 56, 98, 93,
-			128, 31, 122, 116, 204, 118, 64, 238, 128, 241, 41, 219, 1, 217,
-			3, 166, 3, 219, 1, 249, 3, 230, 207, 179, 13, 181, 43, 143,
-			211, 31, 121, 84, 4, 215, 79, 186, 43, 215, 95, 123, 96, 95,
-			103, 212, 34, 30, 229, 229, 31, 121, 244, 135, 222, 5, 179, 174,
-			87, 134, 117, 184, 237, 128, 133, 167, 206, 218, 14, 34, 59, 206,
-			95, 80, 38, 236, 18, 100, 3, 168, 168, 87, 83, 162, 188, 252,
-			103, 14, 116, 253, 18, 164, 7, 48, 175, 166, 4, 233, 1, 204,
-			171, 41, 65, 122, 0, 55, 86, 107, 148, 125, 227, 228, 166, 92,
-			245, 211, 177, 96, 191, 170, 94, 239, 87, 178, 78, 127, 245, 178,
-			10, 193, 245, 147, 79, 161, 11, 123, 91, 91, 157, 154, 227, 43,
-			6, 125, 126, 17, 16, 12, 122, 8, 44, 255, 183, 199, 40, 88,
-			1, 87, 217, 196, 64, 10, 101, 94, 57, 58, 137, 125, 112, 100,
-			127, 222, 229, 55, 216, 153, 67, 133, 194, 57, 24, 164, 143, 170,
-			31, 30, 28, 83, 155, 152, 95, 99, 227, 133, 0, 74, 14, 69,
-			10, 6, 99, 42, 143, 125, 188, 206, 70, 12, 249, 231, 147, 131,
-			166, 212, 160, 152, 79, 254, 189, 207, 202, 24, 144, 122, 254, 87,
-			192, 30, 248, 53, 109, 15, 28, 182, 89, 226, 217, 169, 247, 180,
-			61, 112, 244, 212, 29, 109, 15, 28, 59, 245, 46, 252, 44, 113,
-			50, 110, 226, 88, 39, 134, 25, 187, 196, 252, 242, 16, 167, 124,
-			232, 188, 23, 204, 99, 240, 210, 154, 229, 63, 54, 81, 102, 94,
-			93, 191, 141, 6, 175, 50, 196, 220, 12, 143, 177, 15, 24, 45,
-			131, 106, 96, 218, 191, 27, 172, 189, 250, 2, 1, 210, 166, 110,
-			14, 29, 195, 127, 56, 64, 138, 141, 177, 82, 25, 229, 234, 233,
-			242, 140, 110, 249, 156, 76, 87, 222, 212, 45, 194, 201, 244, 91,
-			119, 216, 93, 88, 220, 227, 100, 214, 255, 32, 248, 150, 132, 180,
-			188, 111, 208, 252, 103, 243, 66, 162, 126, 0, 56, 66, 253, 33,
-			58, 110, 166, 40, 249, 172, 221, 53, 11, 75, 206, 123, 182, 60,
-			171, 91, 62, 39, 179, 115, 151, 117, 139, 112, 50, 187, 114, 143,
-			125, 8, 11, 251, 156, 4, 254, 237, 224, 246, 9, 22, 214, 38,
-			1, 229, 31, 108, 98, 127, 225, 106, 215, 142, 239, 122, 7, 242,
-			68, 65, 121, 74, 183, 228, 50, 211, 53, 221, 34, 156, 4, 203,
-			239, 178, 111, 195, 14, 8, 39, 243, 254, 55, 131, 247, 69, 182,
-			223, 201, 173, 245, 20, 130, 231, 195, 246, 110, 46, 194, 118, 170,
-			172, 152, 131, 128, 182, 119, 76, 209, 8, 166, 55, 33, 177, 105,
-			190, 60, 161, 91, 62, 39, 243, 147, 23, 116, 75, 174, 251, 198,
-			91, 154, 204, 255, 79, 0, 0, 0, 255, 255, 136, 16, 167, 110,
-			187, 29, 1, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 13, 116, 28, 201,
+			121, 24, 136, 238, 234, 25, 12, 10, 255, 69, 16, 4, 155, 75,
+			110, 237, 44, 185, 0, 150, 131, 1, 8, 238, 174, 76, 174, 184,
+			107, 144, 224, 138, 88, 241, 103, 5, 128, 90, 175, 214, 187, 100,
+			99, 166, 7, 104, 113, 166, 123, 182, 187, 135, 32, 118, 69, 201,
+			145, 229, 248, 44, 159, 236, 88, 178, 45, 235, 236, 156, 46, 113,
+			158, 243, 164, 231, 191, 243, 191, 243, 99, 59, 150, 19, 251, 18,
+			63, 221, 197, 241, 217, 82, 44, 43, 151, 231, 188, 88, 182, 206,
+			209, 37, 186, 156, 147, 248, 98, 223, 251, 190, 250, 170, 186, 103,
+			0, 144, 92, 201, 186, 188, 167, 103, 62, 238, 114, 170, 186, 126,
+			191, 170, 250, 234, 251, 47, 254, 215, 56, 63, 178, 25, 69, 155,
+			77, 127, 190, 29, 71, 105, 180, 209, 105, 204, 251, 173, 118, 186,
+			83, 197, 164, 24, 85, 31, 171, 250, 99, 185, 159, 23, 46, 194,
+			247, 243, 119, 249, 129, 90, 212, 170, 246, 124, 63, 207, 241, 235,
+			11, 144, 124, 193, 122, 151, 254, 188, 25, 53, 189, 112, 179, 26,
+			197, 155, 89, 55, 233, 78, 219, 79, 230, 111, 133, 209, 118, 168,
+			186, 108, 111, 252, 39, 203, 250, 219, 54, 123, 219, 11, 231, 63,
+			105, 31, 123, 155, 170, 249, 2, 21, 175, 190, 232, 55, 155, 111,
+			135, 194, 235, 80, 239, 249, 95, 41, 241, 162, 112, 70, 250, 202,
+			99, 252, 211, 14, 183, 134, 4, 27, 233, 19, 139, 159, 114, 228,
+			133, 168, 189, 19, 7, 155, 91, 169, 92, 92, 88, 92, 152, 91,
+			92, 88, 124, 66, 158, 239, 52, 228, 186, 95, 219, 10, 163, 102,
+			180, 25, 248, 73, 69, 174, 132, 181, 42, 231, 242, 114, 80, 243,
+			195, 196, 175, 203, 78, 88, 247, 99, 153, 110, 249, 114, 169, 237,
+			213, 182, 124, 253, 165, 34, 223, 233, 199, 73, 16, 133, 114, 177,
+			186, 32, 103, 160, 64, 153, 62, 149, 103, 159, 230, 114, 39, 234,
+			200, 150, 183, 35, 195, 40, 149, 157, 196, 151, 233, 86, 144, 200,
+			70, 208, 244, 165, 127, 167, 230, 183, 83, 25, 132, 178, 22, 181,
+			218, 205, 192, 11, 107, 190, 220, 14, 210, 45, 153, 102, 205, 87,
+			185, 124, 137, 90, 136, 54, 82, 47, 8, 165, 39, 107, 81, 123,
+			71, 70, 141, 124, 49, 233, 165, 156, 75, 252, 179, 149, 166, 237,
+			179, 243, 243, 219, 219, 219, 85, 15, 71, 138, 64, 109, 170, 114,
+			201, 252, 229, 149, 11, 23, 175, 174, 93, 156, 91, 172, 46, 112,
+			46, 175, 135, 77, 63, 73, 100, 236, 191, 214, 9, 98, 191, 46,
+			55, 118, 164, 215, 110, 55, 131, 154, 183, 209, 244, 101, 211, 219,
+			150, 81, 44, 189, 205, 216, 247, 235, 50, 141, 96, 172, 219, 113,
+			144, 6, 225, 102, 69, 38, 81, 35, 221, 246, 98, 159, 203, 122,
+			144, 164, 113, 176, 209, 73, 187, 192, 164, 71, 22, 36, 93, 5,
+			162, 80, 122, 161, 44, 47, 173, 201, 149, 181, 178, 60, 191, 180,
+			182, 178, 86, 225, 242, 197, 149, 245, 75, 215, 174, 175, 203, 23,
+			151, 86, 87, 151, 174, 174, 175, 92, 92, 147, 215, 86, 229, 133,
+			107, 87, 151, 87, 214, 87, 174, 93, 93, 147, 215, 158, 147, 75,
+			87, 95, 146, 111, 95, 185, 186, 92, 145, 126, 144, 110, 249, 177,
+			244, 239, 180, 99, 24, 125, 20, 203, 0, 0, 232, 215, 171, 92,
+			174, 249, 126, 87, 247, 141, 72, 173, 90, 210, 246, 107, 65, 35,
+			168, 73, 216, 103, 29, 111, 211, 151, 155, 209, 109, 63, 14, 131,
+			112, 83, 182, 253, 184, 21, 36, 176, 136, 137, 244, 194, 58, 151,
+			205, 160, 21, 164, 94, 138, 25, 187, 102, 84, 229, 188, 196, 45,
+			91, 176, 177, 190, 41, 248, 85, 18, 76, 244, 93, 228, 3, 220,
+			46, 13, 170, 159, 42, 243, 64, 95, 5, 51, 45, 245, 83, 101,
+			78, 244, 157, 196, 76, 250, 169, 50, 15, 246, 149, 49, 147, 171,
+			159, 42, 115, 178, 239, 17, 204, 60, 174, 126, 170, 204, 67, 125,
+			79, 99, 230, 9, 245, 83, 101, 78, 245, 61, 140, 153, 15, 171,
+			159, 127, 223, 230, 182, 211, 39, 88, 185, 111, 204, 253, 9, 91,
+			46, 201, 77, 63, 244, 227, 160, 38, 241, 12, 201, 150, 159, 36,
+			48, 253, 116, 203, 75, 113, 119, 214, 188, 80, 198, 254, 28, 110,
+			206, 72, 122, 183, 163, 160, 46, 235, 126, 35, 64, 208, 212, 59,
+			184, 27, 82, 191, 206, 187, 235, 39, 176, 25, 118, 162, 78, 44,
+			151, 94, 88, 73, 170, 114, 73, 166, 59, 237, 160, 230, 53, 165,
+			127, 199, 107, 181, 155, 184, 240, 105, 132, 123, 62, 72, 165, 151,
+			224, 42, 192, 70, 243, 147, 148, 75, 90, 149, 216, 79, 218, 17,
+			44, 19, 156, 117, 216, 211, 94, 8, 237, 201, 150, 159, 110, 69,
+			245, 170, 124, 14, 214, 54, 76, 82, 56, 27, 103, 105, 135, 39,
+			126, 124, 59, 168, 249, 242, 185, 40, 146, 111, 208, 166, 151, 113,
+			187, 38, 207, 123, 241, 76, 15, 182, 169, 34, 178, 153, 149, 177,
+			159, 118, 226, 48, 145, 251, 124, 127, 90, 53, 115, 151, 195, 31,
+			230, 244, 89, 130, 149, 75, 195, 27, 69, 44, 118, 154, 127, 246,
+			40, 63, 214, 139, 3, 235, 157, 24, 55, 200, 126, 104, 240, 44,
+			47, 45, 83, 17, 49, 197, 251, 19, 191, 22, 133, 245, 100, 202,
+			146, 214, 12, 91, 213, 73, 49, 193, 11, 161, 23, 70, 201, 148,
+			45, 173, 153, 194, 170, 74, 156, 255, 86, 107, 111, 212, 57, 172,
+			91, 212, 216, 243, 212, 3, 98, 79, 61, 216, 55, 133, 64, 127,
+			239, 136, 66, 160, 27, 214, 95, 33, 208, 191, 66, 160, 95, 107,
+			4, 106, 80, 24, 252, 212, 8, 244, 146, 198, 170, 240, 83, 35,
+			80, 131, 85, 39, 12, 86, 61, 216, 87, 213, 88, 21, 126, 106,
+			4, 106, 176, 234, 164, 193, 170, 135, 50, 172, 122, 200, 96, 213,
+			169, 12, 171, 194, 207, 191, 57, 134, 8, 212, 185, 222, 183, 97,
+			185, 127, 99, 76, 46, 73, 125, 238, 100, 236, 3, 196, 252, 48,
+			77, 164, 39, 147, 96, 51, 244, 235, 21, 217, 8, 238, 248, 245,
+			185, 166, 31, 110, 166, 91, 50, 105, 123, 33, 238, 173, 160, 229,
+			103, 197, 1, 125, 122, 80, 167, 22, 117, 194, 20, 190, 19, 6,
+			0, 112, 201, 70, 236, 213, 160, 249, 164, 235, 67, 42, 17, 27,
+			96, 57, 46, 99, 63, 137, 154, 29, 40, 85, 149, 43, 41, 32,
+			215, 32, 172, 251, 109, 63, 172, 251, 170, 65, 47, 220, 145, 53,
+			175, 233, 135, 117, 47, 198, 86, 107, 81, 8, 4, 70, 34, 155,
+			193, 45, 95, 150, 235, 222, 78, 25, 241, 110, 185, 21, 133, 233,
+			86, 89, 55, 19, 251, 77, 15, 54, 94, 26, 201, 245, 160, 229,
+			39, 169, 215, 106, 3, 110, 199, 251, 1, 86, 173, 30, 52, 26,
+			126, 236, 195, 225, 218, 240, 211, 109, 223, 15, 185, 76, 183, 243,
+			165, 111, 123, 205, 142, 159, 64, 99, 94, 6, 42, 24, 66, 144,
+			226, 237, 178, 225, 75, 175, 94, 135, 189, 27, 203, 164, 179, 145,
+			194, 116, 1, 34, 141, 56, 106, 73, 47, 107, 168, 42, 87, 189,
+			112, 19, 119, 188, 215, 110, 199, 209, 157, 160, 229, 165, 126, 115,
+			71, 158, 156, 59, 181, 80, 89, 88, 88, 144, 59, 190, 23, 39,
+			128, 86, 30, 149, 23, 213, 53, 147, 112, 174, 127, 202, 83, 103,
+			229, 133, 168, 213, 238, 164, 126, 54, 12, 236, 163, 107, 184, 0,
+			57, 217, 78, 252, 78, 61, 146, 181, 168, 14, 219, 17, 142, 125,
+			86, 64, 38, 169, 23, 167, 242, 156, 172, 86, 171, 79, 247, 126,
+			243, 195, 122, 215, 23, 211, 145, 198, 177, 250, 171, 170, 168, 115,
+			171, 122, 89, 207, 73, 63, 172, 155, 212, 156, 234, 75, 167, 159,
+			238, 169, 132, 27, 128, 170, 168, 223, 186, 2, 166, 116, 39, 65,
+			67, 206, 236, 234, 232, 173, 114, 65, 62, 246, 88, 111, 91, 207,
+			200, 133, 217, 236, 214, 220, 85, 233, 228, 57, 121, 234, 233, 93,
+			95, 169, 235, 115, 242, 212, 130, 254, 67, 133, 238, 74, 191, 153,
+			248, 123, 15, 224, 153, 61, 7, 240, 214, 123, 15, 96, 238, 30,
+			3, 56, 185, 215, 0, 114, 203, 191, 152, 45, 127, 182, 94, 184,
+			254, 89, 242, 100, 182, 51, 222, 252, 46, 216, 119, 173, 247, 223,
+			35, 170, 98, 126, 201, 207, 117, 47, 185, 60, 153, 77, 147, 178,
+			168, 189, 108, 209, 117, 21, 2, 67, 86, 97, 215, 46, 200, 234,
+			116, 195, 185, 107, 207, 229, 65, 156, 85, 56, 121, 239, 229, 205,
+			10, 62, 147, 47, 184, 79, 31, 39, 247, 238, 99, 238, 62, 43,
+			120, 122, 191, 3, 92, 247, 82, 63, 13, 90, 126, 21, 254, 87,
+			247, 155, 169, 7, 135, 248, 133, 157, 116, 43, 10, 245, 202, 165,
+			112, 48, 119, 23, 156, 169, 123, 59, 201, 185, 211, 21, 217, 10,
+			194, 78, 234, 39, 231, 78, 45, 204, 118, 31, 51, 121, 206, 244,
+			54, 211, 243, 169, 250, 92, 28, 181, 214, 77, 83, 105, 125, 22,
+			113, 207, 243, 107, 215, 174, 202, 43, 94, 187, 29, 132, 155, 156,
+			203, 149, 80, 229, 52, 162, 184, 229, 165, 21, 188, 46, 205, 248,
+			145, 178, 13, 18, 233, 135, 176, 205, 234, 64, 9, 123, 18, 238,
+			251, 112, 83, 198, 30, 50, 50, 233, 150, 7, 248, 146, 203, 104,
+			227, 221, 126, 45, 173, 200, 237, 45, 63, 6, 10, 221, 215, 5,
+			125, 88, 56, 68, 202, 190, 76, 58, 141, 70, 112, 71, 150, 147,
+			178, 156, 9, 194, 122, 80, 243, 128, 158, 208, 247, 198, 44, 224,
+			126, 14, 40, 180, 29, 251, 53, 31, 122, 220, 216, 193, 122, 97,
+			167, 181, 225, 199, 185, 43, 166, 162, 184, 205, 236, 150, 73, 52,
+			69, 128, 195, 228, 230, 94, 242, 154, 186, 138, 162, 200, 137, 194,
+			175, 200, 211, 58, 95, 181, 180, 208, 213, 86, 178, 21, 117, 154,
+			117, 185, 225, 115, 51, 247, 160, 11, 80, 0, 138, 242, 233, 164,
+			12, 243, 5, 134, 56, 107, 13, 238, 142, 83, 185, 198, 168, 45,
+			46, 55, 252, 220, 16, 247, 106, 173, 170, 119, 215, 41, 104, 23,
+			218, 233, 105, 149, 203, 86, 80, 139, 187, 219, 125, 208, 102, 79,
+			37, 229, 106, 142, 69, 184, 94, 26, 227, 159, 179, 184, 227, 244,
+			217, 125, 130, 189, 100, 79, 184, 159, 182, 228, 26, 18, 5, 166,
+			79, 162, 50, 243, 84, 65, 85, 94, 233, 36, 41, 244, 137, 184,
+			105, 238, 244, 169, 39, 43, 79, 190, 229, 41, 184, 223, 224, 63,
+			14, 116, 226, 201, 158, 76, 25, 132, 181, 102, 39, 9, 110, 251,
+			85, 121, 53, 74, 253, 179, 208, 106, 226, 203, 141, 168, 131, 51,
+			139, 125, 164, 129, 145, 64, 132, 86, 207, 114, 249, 212, 2, 12,
+			98, 190, 21, 132, 242, 113, 72, 180, 130, 112, 126, 43, 150, 143,
+			203, 197, 39, 228, 86, 60, 95, 247, 118, 228, 227, 242, 244, 83,
+			79, 86, 23, 159, 148, 112, 68, 230, 225, 110, 149, 143, 171, 3,
+			170, 46, 90, 206, 135, 120, 1, 102, 87, 128, 233, 245, 235, 148,
+			37, 216, 75, 165, 81, 157, 98, 130, 189, 36, 14, 240, 111, 101,
+			8, 8, 75, 48, 207, 22, 238, 127, 180, 53, 32, 186, 104, 27,
+			143, 224, 210, 77, 220, 228, 104, 27, 77, 149, 3, 188, 120, 6,
+			48, 125, 152, 18, 137, 226, 10, 60, 47, 81, 232, 155, 214, 226,
+			46, 82, 75, 109, 70, 79, 46, 112, 121, 147, 214, 225, 166, 108,
+			4, 126, 179, 14, 103, 67, 122, 178, 29, 37, 65, 26, 220, 246,
+			129, 30, 9, 253, 77, 15, 127, 223, 196, 1, 81, 65, 181, 207,
+			53, 22, 72, 112, 40, 185, 14, 163, 88, 182, 162, 216, 175, 72,
+			79, 134, 81, 56, 247, 186, 31, 71, 138, 8, 50, 212, 114, 87,
+			107, 178, 165, 22, 156, 155, 233, 121, 45, 31, 201, 71, 205, 23,
+			247, 140, 179, 119, 139, 156, 57, 115, 166, 66, 255, 169, 237, 145,
+			203, 200, 109, 13, 189, 94, 86, 1, 86, 65, 175, 151, 5, 107,
+			82, 26, 214, 41, 38, 152, 55, 54, 110, 120, 219, 95, 156, 229,
+			15, 247, 242, 182, 169, 190, 201, 246, 99, 110, 159, 230, 3, 230,
+			182, 123, 211, 220, 237, 183, 237, 195, 221, 142, 152, 38, 53, 123,
+			187, 72, 69, 238, 39, 28, 52, 227, 125, 83, 252, 237, 207, 79,
+			43, 254, 246, 125, 127, 197, 223, 254, 21, 127, 251, 223, 134, 191,
+			93, 201, 248, 219, 149, 123, 243, 183, 243, 25, 127, 59, 255, 213,
+			242, 183, 159, 57, 170, 248, 219, 180, 239, 125, 150, 251, 207, 142,
+			202, 165, 28, 233, 106, 208, 40, 80, 41, 237, 40, 8, 81, 190,
+			2, 7, 108, 47, 126, 19, 243, 95, 7, 196, 24, 197, 178, 25,
+			213, 188, 38, 55, 60, 104, 165, 155, 226, 121, 51, 140, 47, 223,
+			251, 114, 168, 202, 245, 45, 159, 26, 210, 140, 43, 96, 238, 52,
+			130, 29, 227, 183, 163, 218, 22, 92, 44, 215, 215, 47, 200, 86,
+			80, 15, 81, 66, 21, 133, 92, 62, 239, 133, 29, 47, 222, 145,
+			167, 42, 242, 212, 153, 183, 44, 84, 52, 33, 213, 142, 163, 166,
+			223, 78, 131, 154, 124, 91, 236, 111, 70, 113, 224, 133, 25, 7,
+			189, 189, 21, 212, 182, 164, 127, 39, 69, 210, 43, 221, 242, 249,
+			94, 165, 54, 188, 218, 173, 109, 47, 134, 18, 17, 222, 153, 50,
+			10, 125, 32, 72, 151, 154, 77, 77, 112, 74, 47, 246, 233, 54,
+			198, 137, 55, 163, 112, 179, 42, 47, 251, 94, 59, 155, 114, 236,
+			203, 114, 210, 242, 189, 216, 175, 151, 101, 18, 41, 230, 59, 140,
+			100, 211, 247, 218, 156, 138, 201, 20, 101, 74, 65, 34, 67, 31,
+			233, 58, 216, 185, 65, 152, 250, 113, 59, 246, 149, 176, 165, 34,
+			59, 9, 236, 87, 79, 190, 188, 248, 196, 220, 22, 72, 103, 155,
+			65, 232, 123, 49, 151, 216, 250, 43, 51, 32, 220, 74, 206, 206,
+			207, 215, 253, 219, 126, 51, 106, 251, 113, 162, 241, 112, 45, 106,
+			205, 195, 122, 206, 99, 201, 89, 152, 4, 128, 59, 214, 28, 57,
+			222, 68, 11, 11, 11, 167, 230, 240, 239, 250, 194, 194, 89, 252,
+			251, 46, 152, 250, 153, 51, 103, 206, 204, 157, 90, 156, 59, 125,
+			106, 125, 241, 244, 217, 39, 207, 156, 125, 242, 76, 245, 140, 254,
+			243, 174, 170, 60, 191, 131, 18, 140, 52, 14, 106, 72, 172, 166,
+			52, 69, 108, 189, 34, 183, 125, 233, 135, 73, 39, 38, 169, 244,
+			182, 143, 98, 131, 90, 20, 222, 246, 227, 20, 218, 135, 123, 27,
+			7, 240, 242, 234, 115, 23, 184, 60, 125, 250, 244, 153, 108, 46,
+			128, 201, 2, 63, 109, 32, 30, 139, 27, 181, 249, 184, 81, 131,
+			18, 213, 244, 78, 58, 139, 28, 1, 145, 209, 15, 36, 57, 200,
+			206, 2, 118, 248, 194, 181, 181, 149, 111, 146, 55, 1, 50, 51,
+			179, 55, 53, 175, 145, 21, 50, 119, 15, 177, 51, 38, 93, 77,
+			252, 244, 6, 45, 240, 12, 228, 206, 92, 189, 126, 249, 242, 236,
+			236, 158, 229, 112, 191, 207, 44, 204, 62, 253, 64, 236, 44, 141,
+			105, 211, 79, 161, 149, 168, 81, 247, 118, 114, 99, 75, 210, 184,
+			83, 75, 37, 124, 186, 237, 53, 101, 122, 155, 122, 236, 42, 254,
+			88, 122, 187, 34, 113, 64, 79, 127, 165, 83, 186, 93, 77, 111,
+			195, 4, 239, 53, 35, 85, 168, 147, 248, 53, 162, 44, 103, 159,
+			222, 155, 221, 235, 153, 225, 139, 65, 120, 122, 81, 222, 124, 155,
+			159, 174, 237, 36, 169, 143, 44, 216, 82, 242, 92, 208, 244, 215,
+			187, 23, 226, 185, 149, 203, 23, 215, 87, 174, 92, 148, 141, 148,
+			134, 177, 95, 157, 199, 26, 169, 30, 233, 245, 149, 171, 235, 79,
+			61, 33, 211, 160, 118, 11, 152, 235, 153, 153, 25, 149, 51, 219,
+			72, 171, 245, 237, 75, 193, 230, 214, 178, 151, 98, 173, 89, 249,
+			214, 183, 202, 211, 139, 179, 242, 61, 18, 191, 93, 142, 182, 245,
+			39, 13, 183, 249, 121, 185, 36, 95, 12, 194, 122, 180, 157, 96,
+			147, 112, 88, 78, 45, 116, 241, 66, 85, 83, 64, 97, 169, 83,
+			79, 237, 62, 70, 166, 53, 168, 126, 234, 169, 39, 158, 120, 226,
+			45, 167, 159, 90, 200, 208, 198, 134, 223, 136, 98, 95, 94, 15,
+			131, 59, 132, 235, 0, 153, 245, 182, 82, 253, 202, 22, 115, 70,
+			205, 95, 206, 204, 192, 12, 18, 57, 111, 248, 244, 89, 57, 151,
+			31, 206, 125, 118, 48, 180, 115, 122, 49, 107, 231, 68, 174, 29,
+			220, 0, 179, 93, 27, 224, 137, 125, 55, 192, 243, 222, 109, 79,
+			222, 84, 139, 95, 173, 117, 226, 216, 15, 83, 40, 114, 37, 104,
+			54, 131, 36, 183, 1, 0, 155, 202, 22, 230, 202, 115, 114, 255,
+			10, 247, 216, 230, 242, 92, 150, 91, 13, 253, 237, 243, 157, 160,
+			89, 247, 227, 153, 89, 152, 216, 26, 65, 136, 186, 80, 128, 33,
+			41, 1, 252, 133, 50, 87, 113, 175, 207, 4, 97, 10, 51, 167,
+			146, 106, 234, 52, 109, 0, 193, 236, 108, 117, 3, 90, 158, 233,
+			2, 193, 147, 247, 1, 193, 10, 106, 191, 210, 106, 24, 109, 231,
+			102, 77, 185, 50, 140, 182, 229, 57, 217, 85, 230, 158, 19, 205,
+			198, 125, 255, 25, 135, 209, 118, 117, 211, 79, 47, 194, 94, 83,
+			121, 51, 179, 185, 137, 119, 79, 158, 10, 67, 98, 102, 239, 137,
+			62, 181, 239, 68, 105, 181, 52, 149, 209, 43, 214, 217, 107, 153,
+			102, 102, 123, 62, 86, 223, 230, 167, 23, 178, 85, 159, 121, 96,
+			57, 77, 54, 150, 123, 9, 106, 20, 197, 192, 241, 250, 121, 83,
+			183, 143, 18, 34, 0, 229, 226, 1, 209, 130, 178, 33, 174, 69,
+			11, 65, 34, 203, 111, 0, 213, 112, 119, 238, 13, 20, 202, 223,
+			157, 123, 163, 238, 237, 220, 93, 127, 3, 174, 238, 187, 103, 223,
+			104, 5, 225, 221, 179, 111, 36, 126, 237, 238, 203, 213, 55, 128,
+			147, 6, 124, 123, 247, 149, 119, 149, 57, 137, 136, 84, 109, 24,
+			181, 215, 220, 246, 118, 242, 2, 28, 69, 9, 52, 128, 6, 168,
+			7, 155, 65, 154, 144, 148, 133, 122, 170, 72, 236, 170, 194, 165,
+			234, 172, 34, 177, 55, 37, 51, 193, 46, 145, 42, 1, 214, 118,
+			174, 173, 164, 247, 112, 105, 111, 71, 186, 53, 223, 171, 109, 193,
+			188, 124, 67, 197, 1, 245, 71, 8, 165, 66, 244, 83, 205, 11,
+			229, 102, 36, 59, 109, 184, 196, 207, 232, 170, 51, 65, 213, 175,
+			82, 230, 169, 189, 105, 189, 217, 10, 199, 254, 163, 182, 106, 89,
+			245, 84, 126, 87, 89, 139, 191, 72, 242, 229, 43, 254, 25, 246,
+			1, 210, 161, 51, 229, 235, 235, 23, 202, 179, 79, 119, 229, 162,
+			56, 76, 179, 48, 160, 170, 70, 158, 243, 180, 18, 243, 36, 126,
+			28, 120, 205, 224, 117, 63, 214, 210, 32, 2, 37, 48, 99, 215,
+			215, 47, 200, 25, 47, 49, 189, 1, 3, 196, 101, 249, 93, 229,
+			89, 88, 128, 80, 182, 227, 32, 84, 4, 205, 238, 173, 68, 178,
+			134, 92, 87, 109, 47, 78, 178, 110, 64, 30, 128, 20, 29, 208,
+			55, 53, 100, 245, 54, 162, 116, 11, 251, 132, 186, 17, 242, 48,
+			122, 14, 201, 174, 113, 0, 209, 27, 53, 26, 137, 159, 34, 177,
+			214, 37, 152, 43, 47, 46, 156, 122, 203, 220, 194, 169, 185, 83,
+			79, 174, 47, 156, 58, 123, 122, 225, 236, 169, 39, 171, 11, 167,
+			222, 85, 166, 221, 157, 72, 76, 155, 203, 165, 237, 129, 82, 30,
+			75, 98, 255, 81, 152, 81, 205, 79, 86, 36, 180, 86, 165, 3,
+			228, 221, 246, 214, 106, 113, 208, 78, 43, 64, 235, 118, 17, 106,
+			158, 132, 203, 145, 164, 152, 176, 226, 138, 151, 85, 155, 93, 237,
+			71, 220, 254, 128, 173, 234, 94, 92, 231, 242, 229, 52, 90, 89,
+			187, 182, 134, 210, 208, 153, 217, 61, 200, 211, 106, 43, 122, 61,
+			104, 54, 61, 164, 237, 252, 112, 238, 250, 218, 124, 61, 170, 37,
+			243, 47, 250, 27, 243, 217, 80, 230, 87, 125, 82, 81, 205, 191,
+			173, 25, 109, 120, 205, 27, 215, 112, 12, 201, 60, 12, 104, 62,
+			215, 201, 44, 55, 182, 5, 43, 26, 211, 128, 24, 71, 15, 73,
+			222, 52, 18, 100, 253, 227, 166, 158, 16, 169, 178, 104, 182, 160,
+			192, 218, 107, 138, 92, 190, 124, 51, 73, 227, 6, 86, 205, 205,
+			40, 170, 37, 213, 182, 194, 108, 48, 151, 197, 249, 102, 176, 17,
+			123, 241, 14, 18, 221, 213, 173, 180, 213, 124, 20, 127, 233, 186,
+			179, 200, 234, 115, 179, 145, 117, 39, 192, 167, 202, 233, 19, 47,
+			205, 157, 104, 205, 157, 168, 175, 159, 184, 116, 246, 196, 149, 179,
+			39, 214, 170, 39, 26, 239, 154, 174, 202, 203, 193, 45, 127, 59,
+			0, 169, 3, 136, 49, 189, 219, 94, 182, 74, 157, 196, 87, 173,
+			61, 31, 213, 149, 210, 109, 58, 145, 47, 223, 92, 89, 187, 166,
+			73, 154, 231, 176, 135, 106, 157, 146, 51, 179, 55, 95, 153, 225,
+			90, 94, 240, 238, 168, 238, 205, 193, 192, 170, 73, 212, 137, 107,
+			64, 141, 108, 250, 213, 208, 79, 231, 189, 118, 128, 107, 2, 211,
+			130, 82, 56, 163, 121, 53, 220, 249, 221, 205, 227, 84, 179, 62,
+			184, 156, 133, 173, 98, 132, 23, 170, 94, 234, 199, 178, 230, 181,
+			241, 124, 68, 13, 101, 194, 162, 228, 220, 6, 229, 147, 64, 220,
+			128, 63, 47, 154, 77, 75, 227, 252, 135, 140, 104, 246, 142, 61,
+			225, 126, 151, 37, 87, 51, 222, 86, 239, 251, 168, 129, 219, 29,
+			218, 148, 73, 16, 214, 242, 244, 21, 223, 155, 192, 234, 22, 205,
+			237, 195, 16, 241, 189, 56, 162, 119, 237, 33, 170, 235, 43, 8,
+			118, 167, 75, 180, 122, 167, 75, 180, 122, 71, 28, 224, 255, 198,
+			210, 162, 213, 247, 218, 194, 253, 29, 75, 94, 141, 194, 57, 35,
+			183, 124, 115, 2, 214, 170, 188, 74, 21, 13, 91, 73, 218, 91,
+			216, 109, 57, 134, 28, 69, 150, 73, 26, 52, 155, 114, 203, 187,
+			237, 203, 48, 223, 39, 162, 108, 82, 251, 194, 158, 242, 82, 98,
+			203, 27, 81, 12, 236, 176, 150, 25, 244, 2, 139, 88, 197, 76,
+			150, 185, 183, 236, 242, 189, 93, 178, 203, 247, 118, 201, 46, 223,
+			155, 147, 93, 126, 64, 240, 39, 55, 131, 116, 171, 179, 129, 220,
+			107, 179, 83, 11, 240, 127, 115, 155, 209, 124, 189, 5, 251, 114,
+			158, 140, 133, 230, 111, 159, 82, 6, 49, 36, 209, 180, 235, 45,
+			247, 126, 98, 79, 247, 94, 118, 143, 229, 103, 185, 184, 210, 105,
+			166, 193, 11, 49, 112, 209, 233, 206, 59, 1, 24, 98, 150, 23,
+			21, 84, 166, 44, 201, 102, 6, 23, 199, 171, 245, 86, 181, 171,
+			200, 42, 21, 40, 127, 218, 226, 195, 221, 149, 5, 103, 73, 26,
+			163, 137, 208, 192, 165, 190, 85, 72, 8, 193, 89, 221, 75, 209,
+			60, 104, 8, 242, 234, 94, 10, 121, 97, 167, 53, 197, 192, 148,
+			8, 242, 194, 78, 11, 242, 54, 130, 112, 170, 32, 173, 153, 18,
+			228, 109, 4, 161, 88, 224, 14, 44, 194, 84, 81, 90, 51, 131,
+			139, 110, 175, 192, 181, 106, 238, 171, 75, 125, 171, 88, 82, 84,
+			184, 19, 118, 154, 205, 169, 126, 172, 49, 185, 171, 6, 90, 83,
+			65, 105, 40, 117, 190, 159, 23, 112, 46, 207, 59, 37, 103, 172,
+			80, 254, 97, 139, 15, 46, 165, 41, 88, 137, 93, 14, 146, 84,
+			76, 115, 59, 141, 166, 108, 132, 195, 33, 128, 67, 238, 99, 117,
+			61, 186, 24, 166, 241, 206, 170, 157, 70, 238, 49, 238, 92, 237,
+			180, 18, 49, 9, 189, 183, 64, 138, 204, 102, 134, 207, 219, 99,
+			22, 244, 211, 74, 220, 183, 243, 126, 42, 46, 198, 56, 187, 229,
+			239, 40, 16, 173, 194, 79, 241, 56, 13, 2, 65, 52, 184, 56,
+			209, 219, 17, 180, 188, 170, 138, 156, 181, 191, 193, 122, 254, 55,
+			134, 65, 234, 235, 244, 157, 182, 248, 207, 90, 40, 245, 117, 250,
+			196, 226, 39, 173, 46, 169, 239, 169, 167, 144, 220, 184, 124, 253,
+			194, 138, 92, 234, 164, 91, 81, 12, 22, 110, 205, 166, 68, 177,
+			48, 136, 158, 96, 95, 161, 185, 204, 245, 196, 7, 225, 21, 222,
+			116, 10, 49, 162, 81, 1, 80, 28, 74, 126, 248, 160, 162, 97,
+			125, 142, 212, 37, 211, 0, 197, 142, 22, 84, 145, 72, 22, 165,
+			194, 85, 14, 162, 60, 214, 39, 88, 177, 111, 22, 127, 90, 130,
+			245, 247, 157, 32, 145, 227, 64, 223, 32, 231, 74, 188, 55, 216,
+			55, 108, 25, 132, 56, 88, 114, 249, 160, 198, 135, 67, 246, 113,
+			58, 81, 125, 14, 164, 12, 50, 42, 10, 54, 52, 56, 165, 83,
+			150, 96, 67, 135, 31, 214, 41, 38, 216, 80, 249, 81, 108, 220,
+			18, 206, 104, 95, 89, 53, 110, 89, 130, 141, 150, 14, 242, 15,
+			219, 156, 57, 214, 128, 96, 19, 246, 176, 251, 109, 182, 6, 145,
+			124, 2, 176, 179, 108, 52, 35, 47, 77, 128, 136, 3, 195, 149,
+			68, 70, 173, 32, 69, 106, 202, 175, 121, 64, 103, 249, 175, 117,
+			188, 102, 144, 238, 204, 129, 164, 219, 139, 131, 36, 10, 233, 250,
+			83, 53, 161, 78, 24, 165, 81, 28, 68, 157, 164, 185, 131, 54,
+			41, 65, 173, 211, 76, 171, 64, 212, 3, 140, 130, 48, 72, 3,
+			175, 137, 134, 76, 126, 203, 15, 149, 64, 12, 150, 102, 249, 74,
+			133, 203, 182, 183, 211, 140, 60, 18, 59, 198, 126, 210, 105, 166,
+			26, 229, 169, 14, 182, 1, 211, 109, 128, 30, 9, 8, 7, 32,
+			19, 47, 69, 109, 191, 209, 105, 54, 81, 123, 186, 51, 29, 3,
+			14, 76, 57, 9, 223, 20, 157, 188, 237, 203, 122, 20, 78, 167,
+			152, 9, 200, 45, 233, 180, 219, 17, 136, 172, 182, 252, 86, 149,
+			35, 196, 173, 129, 62, 193, 38, 6, 135, 16, 142, 214, 64, 159,
+			213, 149, 178, 117, 202, 113, 172, 82, 159, 112, 38, 237, 71, 152,
+			42, 89, 130, 146, 147, 165, 97, 213, 8, 44, 219, 33, 231, 128,
+			250, 132, 10, 185, 67, 14, 215, 41, 75, 176, 67, 131, 35, 58,
+			197, 4, 59, 52, 46, 168, 154, 37, 216, 148, 169, 6, 184, 117,
+			202, 25, 208, 41, 248, 102, 170, 89, 76, 176, 41, 83, 205, 22,
+			236, 176, 169, 102, 23, 32, 165, 171, 217, 150, 96, 135, 77, 53,
+			155, 9, 118, 216, 84, 99, 130, 185, 166, 26, 43, 64, 170, 164,
+			83, 150, 96, 174, 169, 198, 160, 228, 184, 224, 31, 134, 171, 205,
+			178, 29, 193, 142, 57, 211, 238, 7, 44, 36, 75, 52, 129, 28,
+			133, 205, 29, 56, 11, 157, 68, 235, 244, 96, 161, 209, 20, 98,
+			30, 204, 112, 186, 176, 39, 80, 3, 32, 162, 245, 218, 91, 239,
+			232, 248, 241, 206, 154, 239, 197, 192, 160, 172, 52, 180, 100, 95,
+			233, 159, 61, 56, 69, 64, 93, 180, 188, 118, 69, 6, 169, 89,
+			248, 96, 51, 140, 98, 56, 210, 52, 68, 167, 8, 131, 58, 170,
+			83, 150, 96, 199, 142, 149, 117, 138, 9, 118, 236, 196, 99, 52,
+			235, 130, 96, 210, 121, 148, 62, 21, 138, 144, 58, 172, 83, 150,
+			96, 210, 61, 166, 83, 76, 48, 249, 72, 153, 255, 140, 197, 109,
+			199, 22, 206, 116, 223, 105, 203, 253, 187, 150, 204, 225, 43, 216,
+			232, 160, 97, 170, 121, 176, 241, 60, 217, 12, 18, 164, 122, 162,
+			134, 236, 132, 193, 107, 29, 48, 84, 196, 194, 134, 197, 218, 34,
+			241, 122, 171, 237, 213, 82, 158, 233, 65, 141, 145, 11, 0, 173,
+			22, 181, 90, 81, 40, 147, 154, 31, 122, 113, 16, 193, 177, 208,
+			45, 183, 224, 22, 107, 55, 179, 134, 73, 115, 196, 149, 150, 18,
+			237, 117, 103, 18, 96, 49, 224, 184, 195, 234, 79, 151, 96, 137,
+			29, 199, 102, 125, 194, 153, 177, 31, 87, 27, 214, 102, 176, 97,
+			103, 248, 8, 31, 230, 69, 248, 6, 91, 118, 214, 57, 201, 71,
+			120, 191, 74, 58, 144, 30, 202, 210, 5, 193, 102, 135, 15, 100,
+			105, 75, 176, 217, 137, 169, 44, 205, 4, 155, 61, 242, 80, 150,
+			46, 9, 54, 123, 244, 113, 62, 202, 75, 148, 182, 5, 155, 61,
+			54, 203, 255, 25, 108, 34, 27, 186, 91, 180, 143, 184, 255, 208,
+			146, 235, 17, 128, 209, 147, 45, 175, 13, 147, 193, 41, 36, 115,
+			105, 52, 103, 166, 152, 70, 82, 25, 207, 73, 32, 137, 214, 34,
+			48, 113, 1, 69, 220, 54, 8, 85, 204, 71, 14, 198, 150, 233,
+			150, 1, 141, 44, 55, 162, 232, 61, 167, 202, 21, 245, 99, 177,
+			140, 72, 160, 188, 225, 197, 152, 137, 119, 192, 54, 26, 20, 52,
+			163, 232, 22, 218, 221, 157, 5, 57, 5, 153, 200, 64, 165, 242,
+			89, 249, 242, 169, 138, 92, 124, 165, 66, 121, 27, 94, 140, 121,
+			42, 227, 174, 58, 211, 182, 221, 87, 132, 217, 28, 208, 41, 75,
+			176, 197, 137, 73, 157, 98, 130, 45, 30, 118, 13, 25, 244, 215,
+			127, 201, 226, 103, 31, 156, 14, 218, 140, 189, 246, 214, 141, 186,
+			151, 122, 57, 98, 232, 62, 246, 205, 247, 39, 150, 190, 50, 66,
+			172, 252, 5, 139, 143, 44, 109, 132, 64, 192, 55, 159, 11, 194,
+			32, 217, 18, 167, 120, 49, 73, 189, 180, 3, 132, 128, 53, 51,
+			178, 120, 24, 175, 244, 174, 50, 213, 53, 44, 176, 74, 5, 197,
+			36, 47, 198, 190, 151, 68, 33, 82, 1, 3, 171, 148, 42, 127,
+			208, 226, 69, 85, 84, 12, 242, 254, 149, 171, 239, 92, 186, 188,
+			178, 60, 214, 39, 56, 47, 62, 183, 180, 114, 249, 226, 242, 152,
+			5, 31, 46, 172, 46, 173, 93, 186, 184, 60, 102, 67, 226, 226,
+			55, 189, 176, 178, 122, 113, 121, 140, 137, 97, 62, 0, 162, 229,
+			229, 27, 215, 174, 175, 143, 57, 144, 188, 176, 116, 245, 194, 197,
+			203, 80, 175, 32, 134, 120, 105, 245, 226, 243, 23, 47, 172, 95,
+			92, 30, 43, 66, 197, 43, 43, 107, 107, 43, 87, 223, 54, 214,
+			47, 38, 248, 216, 234, 197, 181, 235, 151, 215, 111, 92, 89, 186,
+			252, 220, 181, 213, 43, 23, 151, 199, 74, 229, 63, 29, 224, 133,
+			119, 192, 86, 20, 15, 113, 59, 168, 227, 236, 6, 23, 135, 96,
+			118, 152, 93, 93, 89, 94, 181, 131, 58, 208, 56, 203, 87, 47,
+			226, 76, 74, 171, 108, 249, 234, 69, 81, 230, 14, 44, 23, 82,
+			124, 131, 139, 35, 89, 141, 101, 47, 245, 86, 241, 155, 56, 205,
+			75, 122, 119, 79, 57, 25, 205, 165, 202, 17, 130, 73, 144, 136,
+			90, 53, 5, 65, 117, 223, 246, 98, 184, 55, 167, 198, 176, 59,
+			157, 116, 39, 184, 189, 178, 44, 70, 204, 64, 7, 96, 104, 238,
+			31, 23, 184, 179, 236, 39, 53, 241, 20, 63, 100, 116, 182, 81,
+			124, 163, 22, 133, 141, 96, 243, 70, 232, 181, 124, 42, 125, 48,
+			247, 249, 2, 126, 189, 234, 181, 124, 113, 140, 243, 182, 23, 123,
+			45, 63, 245, 227, 132, 22, 43, 151, 35, 158, 228, 147, 249, 118,
+			115, 101, 217, 174, 102, 95, 200, 170, 77, 115, 167, 229, 167, 222,
+			148, 131, 0, 58, 144, 3, 144, 159, 212, 170, 87, 124, 128, 18,
+			20, 112, 63, 225, 112, 7, 146, 226, 40, 231, 94, 114, 195, 171,
+			33, 219, 66, 99, 30, 240, 146, 37, 149, 33, 230, 121, 33, 246,
+			211, 120, 135, 168, 202, 195, 123, 180, 88, 93, 133, 2, 171, 170,
+			156, 248, 6, 94, 130, 19, 17, 117, 210, 132, 150, 233, 161, 189,
+			234, 172, 83, 153, 85, 83, 218, 253, 127, 45, 94, 192, 166, 96,
+			23, 55, 188, 160, 233, 171, 173, 49, 188, 74, 41, 49, 197, 251,
+			107, 177, 151, 108, 249, 117, 28, 206, 240, 170, 78, 194, 23, 255,
+			78, 27, 164, 90, 216, 233, 240, 170, 78, 138, 35, 124, 0, 122,
+			168, 223, 136, 58, 41, 130, 101, 88, 117, 89, 191, 214, 193, 217,
+			213, 253, 166, 183, 131, 139, 62, 184, 120, 120, 23, 157, 175, 237,
+			109, 86, 85, 57, 241, 20, 31, 104, 121, 119, 110, 96, 98, 106,
+			252, 126, 149, 74, 45, 239, 206, 50, 20, 21, 143, 240, 33, 117,
+			18, 111, 196, 254, 166, 127, 103, 74, 32, 156, 7, 85, 222, 42,
+			100, 185, 31, 181, 120, 73, 67, 5, 6, 134, 215, 251, 148, 117,
+			191, 62, 84, 57, 113, 146, 179, 184, 19, 78, 217, 247, 43, 14,
+			165, 196, 28, 119, 146, 52, 106, 79, 177, 251, 149, 198, 98, 238,
+			22, 31, 90, 247, 91, 109, 176, 142, 94, 107, 251, 53, 0, 118,
+			59, 142, 128, 58, 164, 253, 162, 147, 112, 98, 99, 191, 65, 219,
+			153, 197, 126, 3, 202, 222, 86, 164, 61, 246, 54, 176, 170, 147,
+			66, 112, 7, 143, 137, 131, 199, 4, 127, 187, 31, 177, 184, 3,
+			71, 89, 60, 1, 43, 237, 131, 124, 111, 202, 186, 31, 211, 182,
+			170, 139, 34, 122, 240, 147, 218, 148, 189, 11, 61, 248, 73, 109,
+			213, 169, 195, 129, 61, 197, 75, 160, 124, 72, 111, 108, 236, 76,
+			49, 68, 15, 147, 89, 185, 252, 52, 87, 251, 177, 220, 249, 29,
+			247, 18, 31, 238, 194, 27, 121, 230, 107, 88, 49, 95, 143, 116,
+			51, 95, 131, 57, 230, 43, 199, 115, 149, 83, 206, 159, 199, 5,
+			7, 178, 27, 182, 121, 180, 145, 3, 35, 165, 0, 50, 73, 240,
+			186, 79, 123, 28, 127, 139, 179, 156, 227, 142, 198, 117, 153, 98,
+			247, 133, 73, 174, 116, 57, 224, 69, 234, 81, 227, 79, 43, 195,
+			159, 217, 120, 8, 127, 62, 205, 71, 61, 186, 99, 110, 52, 240,
+			146, 161, 73, 137, 221, 215, 207, 234, 136, 46, 170, 210, 229, 127,
+			199, 121, 63, 205, 91, 28, 203, 33, 247, 145, 28, 64, 246, 71,
+			239, 199, 187, 208, 251, 88, 190, 78, 14, 193, 63, 13, 160, 240,
+			107, 40, 29, 215, 40, 254, 72, 190, 236, 69, 243, 21, 151, 107,
+			53, 87, 92, 60, 206, 75, 141, 237, 250, 141, 186, 223, 78, 80,
+			70, 48, 184, 56, 154, 171, 10, 236, 250, 106, 127, 99, 187, 190,
+			236, 183, 19, 81, 225, 3, 96, 214, 160, 10, 23, 247, 46, 92,
+			130, 18, 88, 122, 174, 251, 10, 33, 236, 75, 101, 171, 47, 168,
+			235, 36, 187, 87, 30, 199, 123, 101, 130, 23, 144, 40, 163, 29,
+			160, 18, 116, 219, 0, 212, 135, 241, 182, 249, 143, 206, 87, 117,
+			44, 158, 226, 165, 86, 84, 71, 194, 127, 202, 190, 111, 53, 83,
+			86, 156, 224, 35, 97, 167, 117, 35, 7, 108, 216, 119, 195, 171,
+			195, 97, 167, 149, 193, 88, 156, 227, 60, 169, 109, 249, 245, 78,
+			51, 8, 55, 9, 168, 93, 235, 1, 67, 175, 174, 153, 34, 151,
+			250, 86, 115, 21, 196, 89, 62, 64, 61, 132, 155, 70, 66, 211,
+			91, 155, 186, 11, 55, 47, 245, 173, 102, 197, 1, 30, 219, 30,
+			90, 89, 145, 164, 102, 106, 87, 191, 47, 170, 239, 151, 250, 86,
+			117, 81, 241, 22, 94, 82, 27, 219, 175, 79, 149, 8, 5, 246,
+			86, 123, 142, 10, 92, 234, 91, 53, 133, 197, 185, 221, 71, 99,
+			96, 191, 163, 113, 169, 175, 247, 112, 184, 67, 156, 103, 80, 112,
+			159, 228, 3, 102, 86, 98, 134, 143, 213, 58, 113, 6, 234, 27,
+			116, 114, 134, 87, 71, 106, 157, 152, 202, 69, 225, 74, 221, 125,
+			156, 247, 211, 148, 196, 195, 124, 16, 214, 71, 67, 0, 118, 196,
+			240, 42, 15, 59, 45, 42, 224, 86, 121, 73, 207, 227, 65, 142,
+			254, 249, 17, 62, 68, 20, 209, 13, 144, 17, 186, 151, 249, 168,
+			233, 122, 95, 212, 247, 104, 55, 234, 27, 134, 150, 77, 173, 28,
+			242, 115, 255, 147, 197, 251, 233, 24, 8, 145, 27, 77, 137, 206,
+			245, 177, 174, 115, 13, 173, 149, 186, 142, 238, 225, 220, 209, 133,
+			141, 88, 202, 78, 234, 145, 252, 73, 133, 11, 165, 148, 59, 152,
+			139, 64, 19, 195, 44, 113, 111, 142, 44, 186, 123, 156, 203, 42,
+			193, 129, 74, 150, 175, 24, 148, 201, 121, 241, 242, 181, 165, 229,
+			139, 64, 45, 143, 112, 126, 245, 218, 250, 13, 74, 91, 66, 240,
+			17, 72, 47, 93, 95, 191, 116, 109, 117, 229, 93, 72, 56, 31,
+			224, 163, 203, 75, 235, 75, 55, 214, 86, 222, 117, 241, 198, 229,
+			149, 43, 43, 235, 99, 172, 252, 77, 188, 0, 212, 183, 47, 70,
+			56, 95, 187, 112, 233, 226, 242, 245, 203, 64, 32, 247, 1, 41,
+			125, 241, 155, 46, 94, 184, 190, 14, 73, 36, 193, 95, 92, 90,
+			193, 132, 13, 116, 245, 115, 43, 87, 87, 144, 32, 103, 226, 32,
+			31, 95, 58, 127, 245, 218, 234, 149, 165, 203, 55, 76, 182, 83,
+			254, 137, 1, 179, 141, 162, 80, 200, 28, 202, 29, 235, 90, 8,
+			141, 116, 31, 35, 192, 231, 80, 122, 86, 38, 135, 100, 247, 39,
+			136, 159, 225, 14, 72, 2, 31, 160, 175, 9, 94, 72, 163, 91,
+			190, 162, 74, 134, 86, 85, 194, 93, 190, 7, 226, 155, 226, 253,
+			180, 1, 53, 129, 71, 73, 66, 137, 204, 160, 196, 207, 23, 255,
+			155, 160, 196, 43, 124, 44, 79, 150, 7, 97, 35, 162, 219, 170,
+			188, 27, 148, 213, 229, 172, 232, 74, 216, 136, 86, 71, 115, 117,
+			33, 67, 60, 219, 133, 58, 29, 68, 157, 71, 247, 104, 104, 95,
+			228, 249, 20, 239, 143, 59, 97, 152, 33, 94, 119, 143, 218, 171,
+			170, 4, 160, 64, 42, 44, 206, 240, 18, 144, 118, 237, 12, 231,
+			30, 217, 171, 91, 42, 2, 72, 80, 23, 23, 103, 114, 216, 179,
+			127, 223, 170, 15, 138, 63, 75, 15, 142, 63, 69, 85, 243, 34,
+			3, 25, 174, 239, 233, 54, 207, 138, 184, 127, 205, 226, 163, 61,
+			75, 0, 56, 115, 55, 143, 198, 107, 25, 99, 118, 130, 143, 80,
+			1, 34, 84, 137, 154, 29, 86, 185, 36, 167, 206, 246, 54, 172,
+			254, 0, 237, 109, 192, 142, 157, 184, 137, 108, 198, 192, 42, 235,
+			196, 205, 30, 156, 63, 192, 251, 105, 49, 92, 206, 75, 107, 4,
+			210, 55, 139, 167, 221, 23, 114, 140, 18, 177, 251, 68, 65, 170,
+			84, 198, 215, 220, 151, 31, 80, 124, 205, 249, 49, 62, 98, 48,
+			45, 226, 254, 242, 139, 251, 161, 172, 65, 222, 191, 122, 253, 234,
+			85, 72, 88, 98, 136, 151, 214, 214, 175, 189, 240, 194, 155, 192,
+			88, 191, 100, 241, 1, 148, 78, 194, 130, 129, 140, 3, 241, 128,
+			214, 19, 33, 131, 105, 62, 43, 86, 147, 184, 117, 42, 8, 28,
+			235, 150, 87, 191, 225, 199, 113, 68, 172, 115, 105, 117, 96, 203,
+			171, 95, 196, 12, 113, 152, 151, 224, 51, 184, 37, 232, 107, 98,
+			203, 171, 95, 137, 98, 223, 93, 230, 131, 185, 6, 247, 208, 161,
+			60, 220, 125, 151, 13, 24, 206, 32, 119, 143, 61, 255, 3, 31,
+			179, 120, 191, 40, 56, 125, 127, 193, 190, 14, 84, 39, 51, 153,
+			234, 68, 105, 81, 80, 119, 242, 44, 105, 81, 6, 51, 45, 202,
+			112, 95, 45, 211, 162, 12, 151, 64, 6, 231, 56, 125, 240, 101,
+			196, 190, 161, 36, 159, 125, 248, 109, 164, 127, 152, 127, 204, 226,
+			69, 248, 8, 178, 72, 225, 140, 185, 223, 97, 41, 141, 135, 18,
+			83, 193, 196, 130, 240, 182, 215, 12, 148, 35, 10, 137, 185, 193,
+			240, 95, 75, 185, 193, 156, 55, 132, 29, 10, 226, 95, 180, 184,
+			72, 161, 150, 39, 219, 77, 175, 230, 111, 69, 77, 244, 49, 136,
+			100, 80, 247, 195, 52, 104, 236, 200, 133, 57, 82, 124, 4, 175,
+			251, 117, 217, 141, 75, 192, 120, 169, 83, 75, 59, 177, 159, 84,
+			57, 138, 81, 251, 72, 173, 35, 156, 193, 44, 109, 11, 38, 70,
+			70, 249, 119, 219, 52, 118, 75, 176, 163, 206, 168, 251, 23, 52,
+			118, 232, 40, 221, 33, 49, 179, 146, 72, 128, 114, 7, 116, 47,
+			104, 202, 73, 199, 39, 65, 151, 164, 204, 146, 63, 138, 65, 20,
+			29, 197, 160, 229, 49, 174, 196, 169, 151, 220, 146, 234, 196, 129,
+			93, 56, 24, 245, 98, 131, 21, 121, 109, 149, 239, 83, 63, 145,
+			73, 167, 86, 3, 183, 31, 101, 191, 4, 165, 76, 175, 0, 28,
+			90, 105, 58, 157, 28, 124, 37, 83, 88, 107, 45, 86, 167, 145,
+			53, 189, 36, 205, 85, 220, 242, 192, 58, 71, 73, 1, 37, 28,
+			249, 14, 0, 73, 34, 130, 1, 171, 131, 28, 196, 64, 197, 117,
+			212, 201, 165, 109, 193, 142, 14, 143, 240, 47, 234, 213, 182, 5,
+			155, 118, 198, 220, 223, 235, 134, 24, 193, 10, 141, 26, 54, 188,
+			186, 220, 246, 118, 122, 33, 182, 222, 3, 177, 52, 106, 130, 225,
+			86, 6, 176, 119, 71, 27, 178, 30, 248, 117, 121, 59, 136, 154,
+			126, 152, 54, 119, 8, 10, 160, 236, 128, 2, 25, 142, 170, 104,
+			8, 128, 187, 131, 198, 78, 111, 10, 24, 36, 6, 189, 15, 52,
+			80, 3, 144, 219, 63, 56, 251, 145, 81, 254, 9, 189, 127, 64,
+			58, 237, 140, 185, 31, 179, 37, 16, 228, 176, 252, 81, 164, 204,
+			52, 181, 171, 5, 204, 10, 148, 101, 32, 194, 185, 223, 38, 170,
+			193, 25, 1, 21, 27, 10, 124, 76, 117, 88, 244, 160, 229, 195,
+			198, 145, 203, 87, 8, 214, 104, 144, 179, 233, 167, 96, 6, 129,
+			115, 144, 157, 54, 24, 154, 144, 19, 120, 79, 203, 212, 132, 156,
+			241, 171, 155, 85, 114, 182, 132, 194, 219, 224, 250, 152, 193, 21,
+			245, 151, 122, 10, 179, 15, 6, 202, 80, 146, 20, 249, 62, 176,
+			4, 4, 180, 152, 131, 37, 179, 5, 91, 28, 25, 229, 191, 168,
+			97, 233, 8, 118, 206, 17, 238, 39, 108, 185, 78, 19, 71, 48,
+			64, 212, 129, 141, 14, 104, 42, 162, 91, 6, 188, 247, 131, 36,
+			213, 212, 32, 84, 45, 4, 105, 6, 97, 208, 189, 54, 253, 148,
+			54, 215, 46, 232, 126, 37, 192, 229, 123, 65, 215, 108, 212, 175,
+			8, 178, 70, 36, 127, 31, 216, 58, 22, 192, 110, 56, 75, 219,
+			130, 157, 27, 27, 231, 223, 169, 97, 91, 16, 236, 57, 71, 184,
+			127, 102, 25, 216, 110, 123, 137, 172, 129, 151, 85, 179, 105, 44,
+			236, 192, 3, 36, 14, 33, 172, 141, 58, 213, 51, 91, 157, 150,
+			23, 86, 164, 215, 73, 35, 136, 78, 80, 151, 9, 218, 28, 207,
+			222, 15, 252, 64, 52, 199, 45, 208, 184, 45, 95, 233, 62, 224,
+			208, 109, 59, 246, 97, 230, 193, 109, 191, 185, 195, 179, 65, 60,
+			24, 80, 164, 209, 75, 16, 76, 42, 128, 3, 240, 94, 165, 202,
+			188, 103, 110, 183, 3, 79, 46, 95, 201, 65, 171, 96, 1, 52,
+			50, 104, 21, 108, 193, 158, 27, 27, 231, 255, 155, 198, 113, 69,
+			193, 174, 58, 227, 238, 175, 116, 67, 171, 29, 251, 183, 149, 207,
+			37, 238, 2, 162, 179, 181, 199, 113, 126, 250, 51, 175, 117, 162,
+			212, 171, 228, 93, 169, 42, 92, 250, 105, 173, 58, 123, 31, 192,
+			197, 126, 3, 53, 192, 105, 36, 227, 14, 89, 124, 189, 59, 218,
+			120, 64, 208, 104, 29, 77, 182, 91, 244, 20, 139, 22, 76, 105,
+			40, 75, 219, 130, 93, 29, 29, 227, 63, 167, 167, 220, 47, 216,
+			59, 157, 49, 247, 71, 178, 41, 7, 137, 236, 132, 177, 95, 139,
+			54, 67, 184, 111, 239, 183, 228, 245, 200, 79, 224, 96, 129, 151,
+			162, 244, 54, 162, 78, 106, 70, 143, 43, 4, 119, 42, 152, 209,
+			69, 105, 10, 65, 53, 84, 137, 32, 125, 192, 153, 145, 190, 105,
+			247, 196, 250, 45, 24, 120, 134, 85, 250, 109, 193, 222, 57, 50,
+			202, 63, 168, 119, 126, 73, 176, 87, 157, 195, 238, 127, 177, 118,
+			93, 64, 177, 23, 118, 227, 7, 21, 56, 201, 175, 203, 77, 47,
+			222, 240, 54, 253, 251, 77, 217, 148, 7, 207, 216, 86, 128, 116,
+			140, 190, 192, 27, 157, 38, 81, 14, 136, 123, 56, 86, 6, 114,
+			88, 194, 61, 79, 214, 26, 20, 21, 170, 211, 76, 97, 203, 2,
+			244, 180, 141, 236, 6, 24, 96, 0, 117, 163, 65, 255, 64, 171,
+			223, 173, 134, 219, 13, 44, 112, 180, 123, 213, 153, 200, 210, 182,
+			96, 175, 30, 154, 202, 140, 101, 60, 123, 66, 155, 192, 20, 33,
+			85, 210, 41, 112, 171, 29, 200, 219, 234, 121, 226, 0, 85, 179,
+			4, 219, 48, 213, 192, 234, 98, 195, 84, 3, 98, 98, 195, 84,
+			3, 171, 139, 13, 172, 6, 22, 6, 5, 191, 239, 191, 88, 153,
+			89, 141, 143, 134, 111, 142, 99, 129, 158, 189, 97, 111, 145, 97,
+			8, 234, 217, 27, 124, 8, 245, 236, 150, 210, 179, 111, 58, 2,
+			103, 128, 201, 2, 164, 121, 150, 182, 4, 219, 28, 28, 206, 210,
+			76, 176, 205, 177, 113, 50, 94, 232, 19, 44, 176, 201, 28, 5,
+			103, 24, 216, 142, 78, 89, 130, 5, 133, 126, 157, 98, 130, 5,
+			124, 144, 191, 79, 219, 149, 180, 236, 81, 55, 150, 203, 87, 47,
+			2, 17, 150, 248, 112, 27, 201, 52, 238, 96, 52, 16, 220, 230,
+			239, 232, 248, 73, 138, 135, 0, 44, 103, 164, 127, 7, 236, 177,
+			192, 120, 145, 200, 126, 32, 213, 155, 205, 104, 27, 130, 40, 40,
+			183, 235, 4, 109, 190, 21, 133, 172, 91, 233, 110, 220, 152, 103,
+			0, 84, 91, 118, 81, 167, 44, 193, 90, 253, 230, 27, 19, 172,
+			53, 60, 194, 255, 6, 248, 164, 91, 204, 18, 133, 200, 254, 55,
+			22, 43, 127, 11, 147, 235, 215, 150, 175, 205, 4, 94, 24, 118,
+			106, 181, 96, 246, 172, 178, 109, 244, 228, 116, 130, 118, 67, 55,
+			130, 186, 223, 106, 71, 64, 131, 79, 75, 228, 187, 100, 11, 140,
+			185, 208, 25, 16, 85, 249, 143, 203, 64, 109, 74, 24, 184, 95,
+			159, 247, 239, 180, 209, 92, 8, 198, 7, 54, 27, 232, 22, 153,
+			69, 48, 75, 228, 204, 82, 45, 13, 110, 123, 169, 111, 182, 38,
+			42, 250, 47, 162, 187, 155, 225, 248, 16, 29, 188, 232, 53, 111,
+			97, 14, 58, 55, 60, 174, 236, 95, 218, 113, 116, 59, 168, 251,
+			9, 248, 177, 121, 18, 164, 123, 50, 221, 138, 163, 206, 230, 86,
+			239, 217, 155, 51, 30, 172, 211, 112, 65, 251, 211, 208, 10, 130,
+			181, 154, 185, 97, 36, 0, 250, 142, 113, 123, 53, 6, 11, 27,
+			62, 77, 79, 1, 66, 166, 48, 247, 141, 0, 172, 69, 124, 162,
+			71, 227, 78, 56, 31, 117, 210, 118, 135, 60, 242, 228, 202, 218,
+			181, 203, 75, 235, 234, 50, 94, 94, 89, 85, 245, 161, 34, 92,
+			1, 243, 210, 171, 207, 109, 69, 53, 185, 225, 1, 39, 130, 22,
+			227, 20, 80, 192, 98, 176, 187, 35, 62, 194, 255, 12, 80, 173,
+			197, 44, 216, 194, 239, 117, 166, 221, 47, 18, 5, 13, 90, 49,
+			224, 122, 204, 140, 242, 40, 70, 73, 36, 136, 147, 151, 51, 224,
+			127, 220, 12, 188, 100, 150, 24, 1, 176, 107, 69, 83, 131, 233,
+			156, 95, 49, 112, 161, 181, 198, 166, 98, 3, 85, 31, 218, 238,
+			71, 223, 45, 104, 178, 1, 62, 9, 91, 121, 143, 229, 40, 158,
+			78, 122, 109, 202, 40, 184, 82, 232, 163, 169, 44, 93, 236, 234,
+			19, 58, 5, 104, 188, 4, 148, 35, 207, 31, 5, 216, 220, 23,
+			137, 251, 33, 20, 132, 147, 47, 192, 236, 115, 105, 48, 122, 29,
+			44, 103, 105, 48, 123, 61, 241, 24, 255, 167, 140, 160, 101, 9,
+			231, 59, 44, 199, 117, 127, 145, 201, 37, 229, 196, 64, 22, 234,
+			228, 116, 17, 197, 42, 174, 158, 114, 75, 133, 109, 18, 132, 176,
+			108, 153, 58, 221, 120, 61, 227, 69, 10, 215, 64, 226, 27, 136,
+			180, 61, 29, 7, 195, 219, 123, 127, 109, 123, 59, 186, 97, 168,
+			175, 173, 250, 56, 48, 136, 177, 15, 150, 225, 173, 252, 176, 148,
+			173, 28, 26, 20, 163, 7, 132, 244, 144, 167, 219, 194, 91, 64,
+			185, 57, 128, 201, 186, 190, 46, 193, 196, 14, 174, 11, 47, 14,
+			154, 59, 250, 128, 194, 154, 250, 241, 156, 15, 176, 174, 249, 117,
+			9, 194, 192, 150, 167, 157, 84, 115, 163, 236, 93, 172, 32, 4,
+			254, 0, 167, 150, 121, 216, 131, 160, 1, 57, 109, 109, 236, 132,
+			166, 241, 145, 108, 121, 183, 124, 60, 193, 153, 172, 81, 214, 253,
+			90, 0, 130, 134, 100, 175, 206, 174, 44, 189, 36, 107, 91, 81,
+			4, 113, 233, 34, 48, 136, 14, 144, 223, 72, 34, 176, 39, 199,
+			33, 106, 72, 39, 126, 14, 252, 85, 206, 71, 105, 113, 173, 2,
+			46, 102, 46, 3, 87, 119, 240, 96, 150, 193, 32, 99, 234, 48,
+			255, 29, 155, 214, 223, 22, 206, 135, 45, 231, 49, 247, 31, 219,
+			15, 186, 254, 123, 46, 99, 54, 30, 222, 189, 31, 118, 207, 19,
+			227, 65, 130, 43, 179, 178, 23, 199, 89, 2, 90, 216, 61, 75,
+			50, 140, 4, 120, 27, 135, 3, 178, 48, 7, 143, 165, 32, 52,
+			112, 54, 128, 37, 194, 103, 43, 218, 214, 67, 128, 27, 37, 238,
+			152, 72, 103, 112, 42, 163, 221, 242, 129, 237, 45, 159, 194, 231,
+			248, 137, 233, 196, 139, 205, 6, 142, 194, 220, 46, 85, 227, 10,
+			26, 50, 137, 192, 236, 92, 110, 3, 74, 131, 157, 154, 91, 11,
+			187, 128, 128, 205, 101, 88, 144, 49, 248, 72, 150, 193, 32, 227,
+			248, 9, 62, 166, 150, 130, 245, 137, 226, 247, 90, 206, 231, 173,
+			130, 46, 2, 183, 179, 243, 189, 214, 208, 24, 255, 73, 139, 151,
+			84, 142, 221, 39, 156, 31, 176, 138, 15, 185, 127, 179, 11, 191,
+			193, 200, 58, 137, 31, 107, 219, 40, 73, 230, 40, 8, 76, 184,
+			75, 12, 34, 137, 136, 236, 197, 43, 169, 42, 95, 138, 58, 92,
+			197, 20, 193, 211, 145, 145, 212, 58, 224, 38, 22, 214, 173, 33,
+			78, 66, 101, 129, 118, 84, 34, 108, 20, 214, 231, 1, 65, 233,
+			94, 170, 156, 143, 243, 1, 61, 226, 2, 14, 121, 40, 159, 101,
+			65, 214, 240, 161, 124, 22, 131, 44, 247, 8, 255, 175, 102, 174,
+			0, 145, 143, 91, 197, 127, 100, 245, 187, 95, 0, 151, 139, 52,
+			222, 49, 6, 152, 73, 79, 28, 34, 224, 16, 81, 126, 227, 201,
+			24, 23, 223, 75, 225, 176, 146, 92, 44, 246, 231, 8, 89, 114,
+			96, 196, 104, 152, 178, 222, 241, 245, 178, 210, 221, 88, 151, 90,
+			98, 78, 148, 35, 236, 224, 171, 215, 214, 47, 42, 1, 11, 106,
+			54, 100, 234, 109, 82, 207, 6, 249, 37, 190, 188, 114, 125, 109,
+			29, 80, 158, 215, 84, 209, 106, 180, 149, 41, 132, 44, 234, 180,
+			124, 186, 99, 104, 107, 69, 13, 185, 167, 25, 218, 35, 57, 192,
+			225, 242, 127, 220, 26, 57, 192, 119, 56, 215, 89, 176, 254, 127,
+			219, 42, 185, 174, 47, 215, 239, 9, 128, 72, 198, 192, 201, 102,
+			100, 108, 66, 71, 26, 215, 25, 66, 226, 236, 18, 45, 107, 102,
+			60, 106, 144, 48, 171, 202, 249, 1, 62, 152, 117, 93, 192, 190,
+			71, 186, 51, 45, 200, 28, 61, 216, 157, 201, 32, 115, 234, 48,
+			127, 61, 55, 114, 75, 56, 63, 98, 149, 142, 184, 141, 175, 233,
+			200, 73, 242, 212, 51, 116, 64, 141, 63, 210, 59, 116, 11, 71,
+			52, 58, 217, 157, 201, 32, 243, 176, 219, 53, 116, 91, 56, 159,
+			252, 218, 15, 157, 4, 61, 61, 67, 7, 76, 242, 201, 222, 161,
+			219, 22, 100, 246, 12, 221, 102, 144, 121, 216, 229, 239, 201, 13,
+			157, 9, 231, 199, 173, 210, 81, 119, 235, 107, 58, 116, 35, 73,
+			233, 25, 60, 43, 96, 247, 221, 131, 103, 22, 100, 142, 78, 117,
+			103, 226, 64, 143, 60, 196, 127, 203, 206, 141, 222, 17, 206, 207,
+			91, 165, 138, 251, 79, 1, 219, 249, 210, 107, 233, 208, 31, 0,
+			121, 128, 247, 182, 23, 164, 218, 159, 29, 48, 66, 35, 136, 147,
+			20, 167, 2, 162, 78, 51, 25, 168, 130, 224, 39, 165, 181, 188,
+			232, 225, 237, 22, 38, 88, 224, 182, 79, 179, 175, 71, 157, 141,
+			38, 226, 211, 32, 145, 168, 216, 169, 144, 115, 169, 49, 90, 3,
+			140, 176, 2, 38, 212, 192, 167, 68, 177, 92, 168, 236, 238, 78,
+			49, 31, 116, 243, 3, 253, 211, 106, 249, 245, 0, 3, 80, 86,
+			249, 226, 107, 56, 23, 226, 82, 54, 252, 38, 112, 244, 177, 15,
+			172, 0, 52, 180, 3, 82, 52, 8, 197, 145, 64, 92, 87, 153,
+			110, 249, 65, 220, 133, 114, 80, 248, 198, 229, 182, 223, 108, 162,
+			179, 37, 20, 217, 27, 155, 16, 182, 233, 89, 19, 167, 136, 64,
+			149, 221, 153, 22, 100, 62, 242, 88, 119, 38, 131, 204, 217, 147,
+			252, 110, 110, 73, 10, 194, 249, 251, 86, 105, 193, 13, 112, 22,
+			45, 239, 78, 208, 234, 180, 238, 179, 50, 222, 110, 16, 129, 57,
+			61, 239, 130, 34, 193, 188, 225, 161, 67, 5, 250, 243, 130, 59,
+			113, 207, 232, 11, 69, 236, 191, 123, 244, 5, 11, 50, 31, 121,
+			188, 59, 147, 65, 230, 220, 60, 255, 71, 86, 110, 248, 69, 225,
+			252, 138, 85, 122, 196, 253, 81, 11, 237, 249, 125, 244, 61, 5,
+			251, 248, 110, 188, 15, 187, 31, 72, 49, 165, 14, 148, 45, 47,
+			173, 109, 233, 125, 49, 179, 122, 113, 17, 226, 64, 111, 118, 154,
+			16, 14, 133, 252, 164, 97, 151, 193, 170, 211, 76, 171, 242, 26,
+			82, 20, 187, 218, 85, 186, 92, 181, 104, 116, 15, 129, 148, 56,
+			104, 128, 7, 1, 136, 99, 64, 50, 5, 210, 155, 168, 65, 109,
+			37, 61, 32, 40, 22, 112, 14, 221, 135, 170, 104, 65, 230, 232,
+			145, 238, 76, 6, 153, 199, 36, 15, 12, 253, 96, 9, 231, 31,
+			91, 197, 73, 247, 69, 69, 62, 120, 141, 6, 56, 183, 34, 197,
+			164, 137, 91, 232, 117, 199, 208, 79, 228, 238, 2, 168, 226, 54,
+			24, 252, 119, 18, 138, 38, 69, 110, 218, 181, 32, 174, 117, 90,
+			224, 244, 90, 243, 187, 47, 126, 171, 136, 125, 13, 230, 179, 176,
+			251, 161, 241, 124, 22, 131, 172, 137, 131, 252, 63, 59, 230, 226,
+			183, 68, 241, 119, 173, 226, 239, 89, 253, 238, 191, 113, 192, 143,
+			3, 168, 13, 176, 61, 140, 131, 13, 34, 118, 122, 247, 27, 144,
+			96, 185, 227, 103, 88, 82, 164, 79, 184, 166, 3, 0, 151, 85,
+			180, 37, 190, 145, 24, 224, 150, 133, 224, 54, 200, 183, 94, 4,
+			41, 228, 89, 82, 45, 193, 162, 130, 155, 39, 160, 154, 28, 189,
+			8, 229, 164, 124, 85, 150, 241, 44, 150, 229, 237, 253, 42, 214,
+			19, 185, 139, 105, 55, 149, 227, 78, 120, 239, 170, 91, 94, 19,
+			204, 173, 228, 234, 11, 23, 228, 12, 5, 162, 218, 213, 156, 212,
+			195, 233, 145, 3, 80, 88, 3, 28, 100, 212, 238, 238, 40, 79,
+			250, 110, 6, 183, 253, 36, 215, 53, 73, 204, 148, 120, 32, 146,
+			203, 87, 20, 190, 131, 237, 0, 69, 149, 48, 30, 246, 231, 22,
+			248, 223, 231, 80, 111, 232, 223, 73, 37, 202, 112, 181, 30, 77,
+			195, 181, 2, 173, 168, 189, 213, 242, 226, 91, 61, 122, 55, 47,
+			201, 174, 15, 69, 90, 195, 119, 140, 218, 219, 142, 1, 105, 210,
+			134, 108, 71, 205, 160, 182, 67, 237, 0, 161, 213, 166, 56, 92,
+			56, 62, 175, 103, 116, 64, 235, 135, 24, 59, 26, 52, 28, 77,
+			31, 46, 96, 104, 87, 59, 35, 228, 80, 79, 126, 211, 130, 60,
+			193, 249, 93, 107, 100, 146, 11, 131, 52, 64, 166, 224, 124, 198,
+			42, 157, 204, 157, 45, 20, 112, 57, 159, 233, 70, 68, 200, 111,
+			59, 159, 233, 70, 163, 40, 238, 114, 62, 99, 205, 62, 222, 213,
+			164, 37, 156, 207, 90, 165, 217, 174, 130, 112, 96, 62, 219, 219,
+			36, 12, 232, 179, 214, 35, 199, 187, 51, 25, 100, 78, 207, 116,
+			53, 105, 11, 231, 95, 90, 165, 60, 18, 180, 108, 187, 136, 153,
+			221, 77, 2, 245, 240, 47, 173, 71, 78, 116, 103, 50, 200, 156,
+			153, 229, 99, 6, 87, 216, 194, 249, 125, 171, 248, 80, 238, 176,
+			66, 115, 191, 111, 21, 71, 242, 89, 22, 100, 141, 230, 105, 121,
+			32, 68, 126, 223, 114, 143, 240, 31, 215, 66, 25, 38, 156, 63,
+			176, 28, 97, 184, 150, 32, 1, 223, 121, 15, 5, 81, 138, 204,
+			208, 2, 110, 133, 144, 112, 139, 52, 58, 33, 5, 131, 0, 109,
+			9, 201, 249, 8, 29, 85, 56, 114, 252, 80, 75, 87, 33, 20,
+			6, 122, 111, 112, 61, 38, 159, 188, 61, 56, 189, 80, 223, 204,
+			40, 45, 52, 27, 49, 207, 74, 179, 34, 14, 184, 148, 101, 88,
+			144, 49, 48, 156, 101, 224, 148, 198, 198, 249, 8, 10, 6, 109,
+			81, 252, 183, 150, 253, 69, 139, 241, 97, 20, 75, 1, 136, 255,
+			173, 197, 39, 249, 8, 66, 0, 92, 138, 156, 63, 180, 156, 67,
+			84, 223, 70, 98, 250, 15, 51, 6, 209, 198, 173, 243, 135, 214,
+			160, 200, 50, 152, 112, 254, 208, 58, 152, 53, 97, 9, 231, 11,
+			150, 115, 192, 20, 0, 162, 246, 11, 249, 38, 96, 171, 124, 193,
+			26, 28, 201, 50, 24, 100, 140, 11, 211, 132, 45, 156, 63, 202,
+			143, 2, 136, 203, 63, 202, 55, 1, 227, 254, 163, 252, 40, 96,
+			45, 255, 40, 63, 10, 38, 156, 63, 182, 156, 9, 83, 0, 72,
+			188, 63, 206, 55, 1, 228, 221, 31, 91, 131, 163, 89, 6, 86,
+			17, 7, 8, 84, 76, 20, 255, 196, 178, 255, 189, 1, 21, 148,
+			255, 19, 139, 143, 80, 15, 12, 64, 245, 239, 44, 231, 113, 2,
+			21, 195, 147, 246, 239, 44, 231, 104, 150, 97, 65, 137, 99, 39,
+			178, 12, 6, 25, 51, 179, 166, 9, 75, 56, 95, 178, 156, 35,
+			166, 0, 156, 172, 47, 89, 206, 120, 150, 129, 37, 196, 100, 150,
+			193, 32, 227, 176, 107, 154, 176, 133, 243, 127, 89, 206, 130, 41,
+			96, 59, 152, 49, 148, 101, 20, 33, 99, 184, 156, 101, 88, 144,
+			241, 232, 201, 44, 131, 65, 70, 117, 94, 137, 222, 109, 91, 56,
+			255, 193, 178, 199, 212, 180, 177, 250, 127, 176, 236, 162, 78, 90,
+			240, 181, 127, 80, 39, 25, 36, 71, 70, 249, 91, 176, 42, 19,
+			206, 127, 180, 236, 227, 238, 172, 188, 229, 239, 128, 72, 3, 182,
+			245, 205, 160, 174, 163, 130, 70, 141, 60, 65, 81, 93, 89, 230,
+			212, 14, 43, 98, 205, 73, 157, 180, 32, 121, 232, 97, 157, 196,
+			118, 203, 143, 242, 38, 249, 78, 58, 255, 217, 178, 39, 220, 87,
+			37, 25, 117, 98, 79, 74, 14, 175, 213, 41, 40, 55, 144, 94,
+			114, 139, 162, 198, 225, 5, 11, 154, 23, 37, 130, 235, 86, 180,
+			112, 163, 8, 110, 238, 128, 192, 180, 169, 84, 82, 170, 115, 167,
+			32, 156, 255, 156, 1, 192, 177, 32, 217, 63, 162, 147, 12, 146,
+			227, 7, 248, 63, 85, 30, 142, 197, 191, 176, 250, 190, 203, 182,
+			220, 191, 111, 201, 204, 154, 43, 115, 81, 76, 72, 96, 56, 215,
+			43, 49, 68, 193, 15, 104, 199, 192, 135, 79, 209, 170, 73, 240,
+			58, 168, 13, 184, 92, 124, 242, 169, 183, 159, 207, 105, 130, 228,
+			76, 24, 65, 156, 190, 216, 3, 182, 45, 245, 147, 182, 87, 243,
+			103, 171, 57, 169, 31, 149, 132, 232, 11, 17, 40, 96, 57, 118,
+			69, 17, 66, 244, 101, 24, 251, 53, 175, 89, 235, 52, 141, 148,
+			36, 136, 177, 79, 244, 226, 69, 191, 71, 231, 47, 172, 146, 224,
+			255, 189, 118, 54, 116, 222, 111, 219, 19, 238, 123, 229, 219, 58,
+			94, 236, 133, 41, 57, 0, 195, 21, 215, 37, 146, 187, 249, 70,
+			181, 90, 189, 123, 83, 63, 120, 2, 43, 189, 163, 163, 10, 205,
+			228, 116, 28, 96, 103, 202, 129, 52, 242, 204, 58, 210, 165, 142,
+			154, 85, 212, 162, 123, 153, 138, 0, 200, 139, 89, 90, 21, 133,
+			151, 222, 111, 219, 37, 157, 180, 32, 57, 48, 170, 147, 12, 146,
+			226, 0, 255, 19, 27, 199, 14, 2, 70, 219, 22, 238, 231, 149,
+			85, 1, 61, 92, 16, 53, 80, 197, 0, 36, 190, 26, 20, 61,
+			244, 2, 219, 9, 131, 63, 4, 77, 237, 226, 104, 130, 66, 120,
+			20, 245, 22, 8, 8, 164, 134, 105, 210, 96, 4, 131, 19, 85,
+			218, 127, 32, 236, 60, 73, 150, 180, 68, 171, 204, 26, 177, 49,
+			88, 62, 129, 106, 16, 74, 189, 6, 174, 187, 156, 138, 36, 20,
+			228, 8, 28, 51, 137, 144, 138, 218, 168, 124, 0, 73, 46, 184,
+			115, 227, 246, 134, 81, 67, 36, 236, 90, 179, 83, 247, 171, 143,
+			67, 248, 18, 79, 158, 59, 199, 101, 195, 107, 38, 254, 108, 101,
+			215, 91, 51, 64, 9, 251, 117, 18, 95, 194, 198, 64, 138, 67,
+			206, 36, 145, 121, 15, 39, 241, 125, 164, 174, 55, 2, 10, 89,
+			131, 141, 110, 27, 122, 84, 110, 248, 126, 8, 19, 134, 1, 168,
+			234, 116, 136, 84, 227, 126, 61, 91, 28, 192, 248, 223, 145, 45,
+			14, 224, 251, 239, 176, 7, 134, 117, 18, 164, 187, 246, 216, 56,
+			255, 160, 218, 88, 182, 112, 62, 100, 219, 39, 221, 247, 224, 218,
+			24, 47, 75, 216, 25, 120, 11, 226, 210, 100, 135, 105, 58, 201,
+			68, 217, 232, 251, 226, 227, 2, 146, 127, 61, 56, 56, 27, 58,
+			26, 182, 102, 146, 116, 90, 121, 131, 44, 83, 55, 244, 111, 171,
+			128, 169, 208, 130, 30, 57, 96, 187, 15, 217, 246, 17, 157, 180,
+			32, 249, 208, 99, 58, 201, 32, 57, 251, 56, 191, 196, 129, 233,
+			44, 126, 143, 221, 247, 189, 182, 229, 158, 149, 116, 204, 193, 90,
+			45, 209, 11, 135, 240, 187, 182, 154, 113, 108, 196, 81, 229, 148,
+			39, 116, 210, 224, 102, 249, 30, 187, 52, 130, 248, 23, 239, 149,
+			15, 219, 132, 10, 213, 173, 242, 97, 219, 30, 210, 73, 144, 207,
+			218, 195, 227, 58, 9, 210, 89, 123, 226, 32, 85, 181, 132, 243,
+			17, 219, 62, 65, 31, 225, 54, 249, 136, 77, 152, 92, 221, 37,
+			31, 177, 199, 165, 78, 50, 248, 250, 40, 196, 69, 176, 29, 71,
+			20, 191, 223, 238, 251, 239, 152, 133, 3, 2, 28, 247, 253, 118,
+			105, 20, 111, 66, 7, 228, 155, 31, 181, 237, 255, 193, 86, 55,
+			161, 131, 2, 191, 143, 218, 124, 8, 239, 32, 135, 132, 189, 182,
+			115, 16, 47, 20, 71, 139, 82, 109, 186, 107, 29, 146, 190, 253,
+			128, 61, 56, 150, 101, 128, 24, 213, 62, 48, 97, 154, 176, 132,
+			243, 49, 219, 17, 166, 9, 216, 66, 31, 203, 55, 1, 163, 255,
+			152, 61, 56, 156, 101, 48, 200, 24, 27, 199, 169, 59, 48, 134,
+			31, 212, 96, 114, 16, 106, 63, 104, 219, 142, 78, 90, 240, 181,
+			208, 175, 147, 12, 146, 124, 144, 191, 31, 118, 32, 98, 186, 143,
+			219, 246, 168, 155, 222, 75, 183, 171, 185, 224, 191, 116, 237, 174,
+			26, 19, 76, 248, 227, 54, 93, 51, 14, 46, 214, 199, 237, 126,
+			243, 149, 193, 215, 97, 32, 62, 28, 199, 113, 250, 68, 241, 111,
+			217, 246, 79, 234, 21, 1, 107, 76, 231, 111, 217, 253, 67, 252,
+			27, 96, 69, 28, 92, 145, 31, 182, 157, 3, 238, 140, 92, 207,
+			238, 91, 152, 25, 249, 144, 16, 226, 38, 105, 115, 157, 72, 74,
+			12, 150, 97, 97, 213, 145, 44, 195, 134, 140, 113, 193, 95, 164,
+			182, 65, 28, 105, 59, 194, 125, 91, 111, 219, 20, 213, 174, 185,
+			99, 122, 1, 4, 23, 164, 230, 75, 142, 155, 74, 35, 58, 15,
+			185, 174, 97, 206, 63, 98, 59, 180, 196, 144, 97, 67, 198, 216,
+			56, 191, 70, 93, 219, 194, 249, 132, 237, 140, 185, 207, 238, 55,
+			45, 232, 48, 139, 217, 75, 5, 18, 154, 45, 198, 4, 186, 221,
+			53, 91, 88, 250, 79, 216, 206, 96, 150, 129, 93, 140, 140, 242,
+			39, 168, 75, 38, 156, 31, 181, 157, 113, 247, 120, 111, 151, 65,
+			136, 83, 107, 128, 197, 135, 49, 58, 212, 205, 192, 145, 254, 81,
+			219, 25, 50, 237, 50, 27, 50, 70, 199, 248, 91, 169, 93, 71,
+			56, 63, 97, 59, 174, 91, 217, 163, 93, 47, 236, 194, 26, 123,
+			180, 15, 39, 244, 39, 204, 145, 131, 12, 27, 50, 166, 14, 211,
+			145, 181, 68, 241, 167, 108, 251, 51, 230, 200, 2, 104, 127, 202,
+			38, 226, 213, 1, 13, 171, 112, 126, 218, 38, 226, 21, 211, 69,
+			204, 56, 154, 101, 88, 144, 65, 196, 171, 67, 236, 225, 79, 219,
+			68, 188, 66, 134, 37, 156, 159, 177, 157, 147, 166, 0, 160, 155,
+			159, 201, 55, 1, 157, 254, 140, 125, 236, 177, 44, 131, 65, 198,
+			236, 227, 166, 9, 91, 56, 63, 107, 59, 199, 76, 1, 160, 243,
+			127, 54, 59, 245, 138, 5, 252, 89, 123, 240, 112, 150, 193, 32,
+			227, 161, 163, 220, 87, 77, 176, 62, 225, 252, 162, 237, 28, 118,
+			175, 147, 160, 40, 3, 100, 236, 123, 245, 157, 158, 141, 174, 168,
+			191, 45, 117, 113, 225, 141, 166, 197, 38, 189, 74, 112, 185, 227,
+			167, 26, 224, 164, 227, 250, 69, 123, 104, 146, 191, 143, 250, 181,
+			68, 241, 31, 218, 206, 47, 219, 5, 183, 213, 221, 179, 34, 226,
+			154, 16, 52, 42, 219, 238, 51, 134, 201, 211, 154, 161, 186, 92,
+			89, 158, 173, 202, 11, 91, 126, 13, 37, 13, 57, 14, 143, 236,
+			6, 27, 20, 115, 63, 175, 110, 207, 141, 8, 160, 251, 15, 237,
+			161, 131, 200, 8, 99, 6, 44, 234, 47, 217, 197, 50, 178, 184,
+			148, 83, 192, 172, 161, 124, 150, 5, 89, 195, 71, 243, 89, 12,
+			178, 228, 35, 252, 29, 52, 57, 91, 20, 127, 213, 118, 126, 205,
+			46, 184, 207, 118, 77, 110, 58, 233, 53, 58, 66, 111, 17, 50,
+			29, 172, 215, 225, 8, 234, 227, 87, 11, 124, 205, 170, 66, 199,
+			176, 146, 191, 106, 15, 29, 48, 195, 69, 138, 242, 83, 118, 49,
+			27, 136, 162, 234, 62, 149, 31, 174, 226, 55, 63, 101, 15, 79,
+			229, 179, 24, 100, 29, 121, 136, 207, 209, 112, 153, 40, 254, 186,
+			237, 252, 175, 118, 193, 61, 210, 189, 22, 65, 98, 40, 251, 220,
+			80, 224, 112, 254, 186, 61, 52, 193, 191, 197, 166, 177, 224, 165,
+			251, 105, 187, 232, 186, 127, 2, 156, 191, 177, 199, 234, 225, 87,
+			228, 186, 137, 218, 214, 203, 175, 211, 178, 214, 168, 102, 166, 94,
+			70, 120, 25, 177, 85, 69, 41, 220, 65, 2, 6, 155, 142, 72,
+			56, 136, 225, 221, 244, 161, 32, 88, 10, 101, 192, 5, 171, 212,
+			157, 168, 67, 68, 34, 72, 144, 174, 129, 129, 76, 208, 144, 55,
+			53, 241, 71, 51, 213, 36, 32, 94, 89, 55, 225, 153, 147, 102,
+			179, 155, 4, 164, 10, 92, 145, 188, 196, 116, 112, 3, 85, 69,
+			104, 124, 218, 46, 142, 229, 179, 44, 200, 26, 63, 152, 207, 98,
+			144, 53, 117, 24, 213, 193, 14, 179, 74, 125, 162, 248, 207, 109,
+			231, 119, 237, 130, 6, 47, 68, 241, 113, 254, 185, 77, 50, 5,
+			56, 196, 76, 56, 191, 101, 23, 165, 46, 128, 204, 222, 111, 65,
+			79, 38, 195, 130, 140, 241, 35, 89, 6, 86, 57, 246, 176, 105,
+			194, 17, 206, 191, 176, 139, 25, 162, 0, 229, 192, 191, 176, 139,
+			163, 89, 134, 5, 25, 99, 25, 162, 112, 24, 100, 60, 116, 212,
+			52, 81, 16, 206, 111, 219, 69, 215, 20, 0, 9, 253, 111, 219,
+			69, 77, 80, 96, 228, 26, 231, 183, 237, 17, 77, 198, 88, 40,
+			153, 255, 109, 133, 83, 113, 159, 1, 113, 248, 191, 219, 197, 135,
+			76, 129, 162, 202, 160, 187, 18, 50, 44, 200, 24, 61, 148, 101,
+			48, 200, 112, 143, 152, 38, 250, 133, 243, 59, 118, 113, 214, 20,
+			232, 47, 98, 198, 68, 150, 97, 65, 198, 193, 227, 89, 6, 131,
+			140, 233, 25, 162, 115, 108, 225, 124, 86, 211, 116, 14, 114, 231,
+			159, 205, 168, 6, 56, 100, 159, 181, 137, 59, 119, 16, 89, 126,
+			214, 30, 25, 229, 103, 176, 42, 19, 206, 231, 108, 123, 198, 61,
+			121, 111, 238, 220, 236, 63, 195, 159, 59, 184, 100, 159, 179, 237,
+			41, 157, 180, 160, 169, 195, 143, 234, 36, 182, 252, 216, 52, 13,
+			209, 17, 206, 231, 109, 251, 24, 125, 132, 165, 250, 124, 54, 98,
+			184, 185, 62, 111, 143, 31, 214, 73, 6, 201, 135, 142, 82, 213,
+			130, 112, 254, 149, 109, 63, 76, 85, 97, 137, 254, 85, 86, 21,
+			22, 232, 95, 217, 227, 174, 78, 50, 72, 30, 61, 70, 87, 30,
+			19, 197, 127, 109, 219, 223, 202, 244, 149, 7, 167, 252, 95, 219,
+			124, 156, 191, 19, 65, 143, 71, 252, 15, 128, 110, 121, 78, 130,
+			28, 96, 151, 204, 128, 14, 57, 126, 203, 201, 225, 21, 223, 3,
+			66, 1, 125, 123, 40, 182, 71, 163, 19, 134, 72, 235, 15, 108,
+			167, 148, 101, 128, 16, 206, 38, 33, 156, 67, 135, 230, 15, 128,
+			227, 105, 209, 80, 64, 134, 102, 59, 83, 238, 43, 25, 176, 147,
+			93, 3, 202, 125, 218, 246, 99, 35, 212, 208, 195, 0, 142, 16,
+			76, 38, 64, 66, 144, 110, 249, 45, 208, 199, 41, 158, 7, 248,
+			196, 140, 202, 129, 238, 64, 136, 151, 31, 33, 220, 29, 127, 104,
+			15, 28, 200, 50, 64, 136, 103, 79, 30, 226, 53, 26, 161, 45,
+			156, 47, 218, 206, 164, 187, 38, 159, 83, 78, 242, 93, 195, 211,
+			121, 247, 30, 87, 247, 176, 248, 238, 113, 193, 117, 255, 197, 252,
+			184, 96, 255, 126, 209, 30, 24, 207, 50, 24, 100, 76, 28, 164,
+			235, 158, 193, 142, 254, 146, 237, 28, 114, 175, 203, 243, 228, 246,
+			219, 53, 48, 147, 249, 85, 142, 12, 164, 133, 95, 202, 143, 12,
+			118, 211, 151, 236, 1, 205, 160, 48, 220, 243, 95, 178, 15, 78,
+			18, 34, 4, 199, 176, 226, 191, 183, 157, 191, 48, 136, 16, 253,
+			167, 156, 127, 111, 15, 140, 242, 75, 120, 205, 48, 160, 174, 133,
+			243, 101, 187, 56, 238, 62, 37, 149, 111, 49, 189, 210, 145, 243,
+			127, 161, 155, 103, 219, 75, 48, 160, 105, 195, 3, 107, 50, 51,
+			62, 133, 136, 25, 209, 233, 95, 206, 174, 74, 70, 148, 250, 151,
+			237, 209, 49, 254, 237, 150, 233, 208, 18, 206, 159, 218, 197, 131,
+			110, 71, 102, 14, 205, 154, 5, 9, 26, 249, 30, 51, 151, 7,
+			232, 205, 196, 169, 3, 33, 4, 121, 136, 120, 50, 141, 189, 48,
+			9, 128, 98, 66, 167, 188, 76, 206, 128, 59, 83, 198, 158, 81,
+			9, 2, 27, 223, 53, 94, 216, 114, 127, 154, 93, 47, 140, 200,
+			251, 63, 5, 38, 240, 219, 178, 241, 218, 194, 249, 51, 187, 120,
+			216, 77, 100, 183, 195, 117, 207, 152, 241, 66, 132, 81, 129, 87,
+			194, 22, 56, 108, 169, 88, 197, 40, 57, 242, 194, 236, 236, 112,
+			82, 144, 210, 233, 54, 242, 123, 19, 11, 75, 49, 101, 81, 216,
+			61, 90, 216, 136, 127, 102, 23, 39, 242, 89, 56, 180, 67, 83,
+			124, 213, 12, 150, 9, 231, 207, 129, 104, 248, 70, 217, 227, 9,
+			222, 51, 90, 84, 202, 123, 169, 119, 35, 39, 123, 129, 177, 199,
+			16, 81, 186, 103, 93, 97, 163, 253, 185, 93, 212, 119, 46, 35,
+			222, 225, 207, 225, 30, 250, 168, 69, 167, 192, 17, 206, 251, 153,
+			51, 233, 254, 117, 75, 175, 94, 214, 33, 205, 116, 85, 11, 148,
+			122, 14, 130, 81, 92, 229, 252, 255, 209, 146, 81, 59, 166, 170,
+			179, 2, 228, 11, 218, 244, 145, 102, 57, 183, 81, 114, 242, 211,
+			93, 231, 6, 144, 253, 251, 153, 33, 224, 25, 42, 236, 223, 207,
+			6, 179, 19, 13, 247, 242, 251, 217, 196, 65, 254, 123, 138, 247,
+			46, 10, 231, 219, 153, 125, 200, 253, 77, 203, 72, 1, 115, 118,
+			109, 247, 145, 234, 210, 92, 1, 103, 87, 114, 248, 18, 31, 253,
+			4, 52, 176, 151, 176, 119, 151, 172, 23, 176, 20, 138, 144, 116,
+			255, 89, 180, 198, 10, 7, 109, 119, 203, 247, 66, 58, 161, 97,
+			100, 132, 122, 64, 71, 41, 25, 64, 22, 150, 151, 198, 99, 152,
+			122, 32, 16, 190, 157, 217, 3, 58, 105, 65, 146, 143, 235, 36,
+			131, 36, 6, 57, 179, 157, 130, 40, 126, 144, 245, 253, 62, 73,
+			96, 224, 170, 251, 32, 43, 141, 243, 15, 1, 148, 10, 32, 130,
+			249, 16, 179, 255, 6, 99, 238, 221, 108, 158, 55, 192, 219, 20,
+			182, 154, 39, 211, 14, 196, 179, 139, 186, 224, 4, 196, 184, 41,
+			187, 178, 156, 169, 44, 73, 53, 91, 207, 179, 28, 235, 224, 178,
+			47, 103, 18, 122, 42, 104, 183, 250, 54, 110, 215, 180, 128, 175,
+			128, 220, 208, 135, 24, 177, 147, 5, 144, 231, 8, 231, 187, 24,
+			41, 50, 48, 93, 196, 140, 3, 89, 134, 5, 25, 19, 147, 89,
+			6, 131, 12, 82, 100, 64, 134, 37, 156, 239, 102, 164, 176, 41,
+			144, 4, 232, 187, 153, 51, 144, 101, 96, 9, 62, 154, 149, 96,
+			144, 65, 10, 155, 2, 179, 68, 241, 195, 204, 254, 126, 34, 0,
+			10, 168, 34, 253, 48, 35, 49, 85, 1, 216, 28, 225, 124, 132,
+			17, 207, 92, 32, 230, 232, 35, 122, 179, 22, 136, 53, 250, 8,
+			35, 49, 85, 129, 24, 163, 143, 48, 18, 83, 65, 6, 88, 58,
+			50, 82, 76, 97, 186, 128, 25, 89, 19, 208, 233, 247, 50, 82,
+			76, 21, 136, 231, 253, 94, 70, 138, 41, 200, 176, 133, 243, 125,
+			140, 36, 93, 5, 226, 121, 191, 47, 223, 4, 224, 158, 239, 99,
+			36, 233, 42, 16, 207, 251, 125, 140, 36, 93, 5, 152, 198, 71,
+			25, 73, 186, 10, 72, 182, 127, 148, 145, 164, 171, 128, 36, 251,
+			71, 25, 73, 186, 10, 72, 174, 127, 148, 113, 240, 140, 113, 156,
+			2, 220, 81, 31, 99, 246, 39, 53, 136, 240, 134, 250, 24, 235,
+			31, 226, 175, 194, 224, 224, 10, 17, 206, 15, 49, 231, 128, 123,
+			21, 185, 160, 76, 219, 14, 76, 45, 18, 66, 42, 108, 123, 246,
+			196, 98, 142, 7, 34, 247, 58, 144, 157, 163, 233, 60, 132, 34,
+			224, 57, 54, 154, 92, 72, 160, 131, 145, 44, 195, 134, 30, 199,
+			5, 191, 64, 35, 0, 185, 23, 115, 198, 220, 211, 61, 35, 80,
+			246, 177, 208, 166, 156, 129, 209, 152, 93, 172, 244, 167, 203, 87,
+			102, 115, 221, 192, 42, 124, 156, 145, 88, 167, 64, 87, 205, 199,
+			217, 200, 40, 111, 82, 55, 32, 212, 98, 206, 184, 251, 205, 251,
+			77, 20, 29, 83, 209, 101, 51, 106, 195, 100, 151, 175, 16, 50,
+			241, 145, 44, 4, 249, 193, 150, 15, 129, 159, 225, 202, 217, 109,
+			169, 219, 61, 109, 88, 210, 31, 102, 36, 14, 42, 208, 101, 242,
+			195, 108, 116, 140, 63, 69, 227, 1, 115, 62, 24, 207, 99, 187,
+			167, 189, 175, 160, 169, 64, 130, 166, 31, 201, 183, 12, 151, 197,
+			143, 64, 203, 231, 168, 101, 71, 56, 159, 96, 142, 235, 206, 237,
+			217, 242, 62, 162, 166, 172, 3, 64, 224, 159, 48, 167, 134, 36,
+			77, 159, 96, 36, 105, 42, 48, 91, 20, 127, 148, 217, 191, 107,
+			78, 29, 76, 245, 71, 51, 212, 128, 92, 254, 143, 49, 146, 52,
+			97, 186, 40, 156, 31, 99, 36, 38, 42, 16, 135, 255, 99, 140,
+			36, 77, 5, 226, 239, 127, 140, 145, 164, 9, 50, 192, 16, 143,
+			145, 164, 9, 211, 69, 204, 200, 154, 128, 245, 254, 113, 70, 146,
+			166, 2, 105, 148, 127, 156, 205, 62, 142, 212, 89, 129, 129, 11,
+			124, 241, 39, 153, 243, 179, 172, 160, 139, 32, 14, 251, 73, 54,
+			116, 4, 5, 18, 152, 1, 67, 253, 159, 25, 9, 36, 40, 167,
+			128, 89, 67, 249, 44, 11, 178, 134, 167, 242, 89, 12, 178, 142,
+			60, 148, 107, 10, 228, 109, 172, 40, 115, 133, 0, 159, 253, 84,
+			119, 83, 48, 236, 159, 98, 195, 71, 242, 89, 12, 178, 142, 61,
+			156, 107, 202, 22, 206, 79, 179, 226, 161, 92, 83, 128, 50, 126,
+			186, 187, 41, 0, 251, 79, 179, 97, 145, 207, 98, 144, 117, 112,
+			50, 215, 20, 200, 223, 24, 209, 22, 148, 83, 192, 172, 124, 83,
+			176, 165, 126, 134, 13, 143, 229, 179, 176, 162, 193, 131, 72, 11,
+			253, 28, 115, 102, 13, 188, 129, 15, 253, 185, 12, 223, 43, 5,
+			253, 207, 177, 137, 227, 89, 6, 131, 140, 233, 25, 221, 4, 244,
+			242, 11, 204, 57, 172, 11, 32, 186, 254, 5, 54, 164, 17, 37,
+			196, 39, 112, 254, 30, 115, 244, 141, 97, 51, 104, 242, 239, 177,
+			161, 3, 166, 0, 19, 206, 63, 200, 144, 177, 205, 160, 201, 127,
+			192, 134, 38, 204, 162, 59, 162, 248, 75, 204, 249, 149, 220, 162,
+			195, 110, 254, 37, 85, 164, 164, 50, 96, 209, 127, 153, 21, 93,
+			51, 91, 165, 60, 248, 101, 86, 28, 203, 103, 89, 144, 53, 126,
+			48, 159, 197, 32, 139, 36, 33, 5, 102, 131, 36, 228, 87, 153,
+			243, 27, 89, 111, 40, 9, 249, 85, 198, 167, 80, 18, 2, 64,
+			0, 193, 21, 35, 73, 8, 166, 139, 152, 161, 47, 28, 101, 148,
+			240, 41, 70, 146, 144, 2, 25, 37, 124, 138, 145, 36, 4, 50,
+			28, 225, 252, 26, 35, 49, 6, 166, 139, 152, 161, 111, 11, 27,
+			41, 174, 95, 99, 36, 198, 192, 12, 6, 37, 72, 140, 1, 25,
+			5, 225, 252, 99, 70, 98, 12, 76, 131, 1, 28, 35, 49, 6,
+			102, 128, 249, 27, 35, 49, 6, 102, 128, 241, 27, 35, 49, 6,
+			100, 20, 133, 243, 79, 242, 77, 0, 161, 243, 79, 242, 77, 128,
+			157, 223, 63, 201, 55, 81, 100, 144, 145, 107, 162, 95, 56, 191,
+			206, 72, 18, 2, 5, 64, 18, 242, 235, 172, 168, 9, 0, 219,
+			238, 7, 49, 29, 35, 73, 8, 102, 48, 200, 152, 158, 225, 239,
+			166, 245, 45, 136, 226, 111, 50, 231, 183, 88, 193, 253, 38, 178,
+			187, 239, 182, 190, 211, 22, 130, 100, 100, 111, 236, 92, 189, 6,
+			132, 67, 70, 138, 205, 16, 56, 156, 244, 17, 57, 219, 122, 140,
+			71, 65, 157, 51, 160, 200, 126, 147, 13, 141, 243, 43, 180, 115,
+			240, 34, 254, 52, 43, 78, 185, 79, 203, 85, 69, 36, 35, 41,
+			134, 222, 210, 115, 32, 124, 70, 55, 78, 53, 162, 182, 182, 238,
+			221, 222, 130, 171, 196, 88, 95, 114, 179, 161, 10, 40, 71, 248,
+			116, 254, 40, 170, 219, 252, 211, 108, 248, 64, 62, 11, 4, 112,
+			108, 242, 16, 191, 110, 198, 1, 242, 54, 86, 156, 117, 47, 72,
+			140, 65, 9, 152, 29, 52, 185, 224, 99, 14, 125, 129, 110, 4,
+			46, 108, 165, 245, 207, 108, 138, 50, 107, 56, 3, 4, 226, 68,
+			168, 217, 34, 182, 123, 44, 159, 133, 93, 61, 124, 60, 159, 197,
+			32, 107, 122, 134, 111, 211, 186, 150, 132, 243, 59, 204, 153, 112,
+			55, 105, 73, 12, 103, 210, 232, 129, 248, 94, 0, 71, 34, 53,
+			131, 15, 12, 209, 88, 67, 235, 71, 110, 67, 127, 187, 107, 196,
+			122, 123, 148, 64, 148, 150, 17, 140, 182, 93, 2, 81, 90, 70,
+			48, 218, 118, 137, 65, 134, 56, 64, 132, 148, 37, 156, 207, 48,
+			146, 46, 169, 233, 126, 134, 145, 40, 77, 77, 245, 51, 140, 68,
+			105, 106, 154, 159, 1, 2, 34, 194, 170, 182, 112, 62, 199, 236,
+			9, 55, 51, 93, 8, 146, 251, 49, 43, 102, 204, 201, 131, 217,
+			160, 80, 68, 117, 164, 184, 241, 208, 126, 46, 27, 29, 224, 194,
+			207, 49, 178, 66, 41, 32, 98, 249, 28, 27, 63, 192, 255, 152,
+			113, 219, 41, 138, 226, 23, 24, 4, 173, 113, 127, 143, 73, 99,
+			104, 153, 121, 52, 25, 17, 8, 236, 14, 140, 141, 172, 152, 25,
+			60, 40, 240, 172, 234, 134, 159, 121, 53, 3, 121, 163, 28, 231,
+			37, 189, 54, 213, 220, 81, 166, 200, 153, 101, 134, 42, 156, 100,
+			125, 17, 41, 72, 6, 172, 29, 8, 200, 66, 143, 251, 44, 189,
+			176, 2, 22, 111, 205, 38, 26, 36, 55, 181, 81, 166, 26, 69,
+			16, 82, 41, 120, 141, 200, 176, 170, 59, 211, 16, 75, 7, 187,
+			160, 200, 57, 16, 165, 27, 71, 108, 194, 189, 24, 15, 46, 31,
+			223, 24, 142, 118, 27, 116, 128, 189, 93, 72, 111, 58, 121, 77,
+			48, 102, 175, 53, 65, 146, 129, 202, 65, 223, 75, 2, 216, 124,
+			181, 90, 167, 5, 6, 49, 62, 55, 70, 196, 25, 252, 16, 18,
+			16, 134, 31, 222, 221, 73, 41, 126, 15, 18, 81, 81, 76, 65,
+			106, 90, 94, 16, 162, 122, 192, 3, 225, 253, 92, 203, 111, 69,
+			96, 220, 81, 3, 54, 95, 155, 155, 40, 70, 50, 99, 28, 151,
+			175, 100, 175, 159, 123, 169, 90, 9, 176, 48, 15, 146, 90, 116,
+			219, 135, 23, 104, 61, 120, 189, 59, 225, 89, 220, 242, 213, 23,
+			46, 36, 100, 59, 0, 120, 246, 11, 192, 40, 130, 105, 84, 17,
+			80, 210, 23, 153, 45, 221, 87, 149, 255, 101, 162, 165, 188, 48,
+			48, 233, 67, 116, 37, 122, 226, 84, 59, 92, 101, 192, 135, 142,
+			171, 252, 222, 162, 97, 108, 84, 75, 133, 139, 120, 81, 126, 145,
+			217, 19, 58, 9, 210, 59, 118, 240, 136, 78, 130, 236, 14, 174,
+			174, 159, 3, 35, 156, 34, 108, 217, 47, 51, 123, 210, 253, 187,
+			182, 188, 164, 131, 56, 245, 104, 195, 181, 192, 4, 198, 146, 25,
+			77, 249, 117, 137, 66, 168, 32, 201, 217, 212, 220, 14, 252, 109,
+			46, 163, 174, 195, 230, 215, 21, 248, 200, 97, 10, 150, 60, 14,
+			189, 166, 18, 86, 225, 91, 12, 217, 185, 52, 251, 188, 141, 49,
+			229, 184, 150, 101, 169, 93, 129, 159, 107, 91, 228, 229, 168, 116,
+			54, 112, 35, 248, 74, 23, 19, 160, 79, 138, 246, 201, 82, 174,
+			252, 89, 20, 121, 5, 79, 92, 77, 80, 255, 163, 167, 90, 184,
+			73, 6, 192, 187, 166, 126, 73, 197, 172, 194, 67, 33, 189, 102,
+			130, 250, 202, 196, 215, 6, 104, 69, 148, 222, 126, 89, 31, 253,
+			34, 34, 166, 47, 179, 254, 113, 157, 100, 0, 214, 137, 131, 252,
+			151, 24, 66, 217, 22, 206, 159, 3, 102, 250, 9, 102, 90, 222,
+			27, 198, 26, 16, 90, 131, 151, 108, 193, 51, 14, 4, 80, 120,
+			5, 162, 219, 52, 9, 155, 48, 158, 104, 240, 180, 104, 22, 237,
+			253, 113, 176, 89, 203, 84, 77, 153, 148, 43, 251, 8, 242, 64,
+			146, 124, 41, 11, 41, 131, 61, 86, 253, 215, 170, 87, 188, 59,
+			16, 187, 111, 86, 110, 248, 192, 224, 109, 233, 154, 96, 133, 149,
+			130, 175, 95, 182, 138, 248, 156, 87, 7, 86, 22, 86, 187, 19,
+			235, 139, 76, 79, 103, 38, 3, 176, 129, 40, 52, 38, 1, 172,
+			106, 254, 36, 172, 169, 121, 137, 15, 76, 163, 188, 26, 165, 116,
+			248, 180, 129, 70, 118, 202, 241, 225, 117, 0, 151, 198, 243, 27,
+			81, 212, 4, 121, 16, 120, 218, 1, 79, 234, 55, 208, 155, 84,
+			235, 255, 240, 18, 3, 182, 49, 72, 59, 244, 90, 50, 26, 49,
+			189, 131, 118, 77, 61, 210, 236, 113, 78, 20, 165, 110, 94, 181,
+			158, 128, 232, 255, 60, 91, 109, 56, 53, 127, 206, 250, 71, 117,
+			18, 68, 142, 76, 28, 48, 145, 240, 191, 167, 194, 47, 238, 23,
+			135, 94, 61, 129, 48, 15, 71, 98, 62, 245, 239, 164, 243, 41,
+			69, 61, 198, 186, 38, 69, 49, 241, 135, 187, 190, 222, 251, 41,
+			160, 255, 219, 226, 5, 243, 130, 79, 64, 225, 196, 25, 188, 194,
+			19, 132, 169, 152, 224, 78, 7, 50, 33, 182, 154, 115, 169, 111,
+			21, 83, 98, 146, 23, 240, 101, 19, 140, 213, 102, 93, 234, 91,
+			85, 73, 40, 13, 48, 197, 88, 122, 240, 144, 15, 166, 244, 203,
+			64, 133, 252, 203, 64, 147, 188, 176, 177, 147, 250, 42, 68, 239,
+			16, 180, 128, 73, 49, 101, 194, 43, 247, 83, 113, 29, 96, 121,
+			146, 23, 188, 56, 246, 118, 48, 250, 43, 124, 80, 73, 243, 234,
+			207, 192, 155, 122, 245, 167, 252, 201, 2, 47, 66, 144, 191, 150,
+			39, 170, 217, 188, 7, 23, 221, 106, 23, 236, 48, 4, 110, 203,
+			171, 46, 165, 81, 75, 195, 100, 33, 7, 147, 251, 85, 192, 146,
+			98, 49, 15, 175, 251, 85, 33, 88, 46, 228, 96, 121, 223, 94,
+			160, 164, 88, 204, 224, 60, 184, 120, 108, 239, 10, 107, 112, 180,
+			194, 154, 175, 215, 225, 169, 252, 58, 60, 72, 45, 90, 167, 121,
+			238, 128, 143, 41, 69, 240, 61, 188, 95, 181, 20, 54, 1, 20,
+			20, 79, 152, 133, 45, 221, 107, 66, 207, 175, 93, 187, 154, 91,
+			244, 69, 189, 232, 3, 15, 80, 73, 21, 117, 91, 156, 173, 249,
+			169, 120, 146, 23, 240, 146, 164, 24, 133, 15, 239, 93, 117, 205,
+			79, 171, 24, 88, 112, 85, 149, 118, 231, 121, 193, 4, 26, 172,
+			71, 53, 29, 104, 176, 30, 213, 178, 240, 145, 118, 46, 124, 164,
+			123, 130, 59, 48, 104, 136, 112, 8, 186, 1, 101, 143, 74, 177,
+			118, 33, 238, 252, 101, 204, 112, 103, 121, 73, 67, 241, 126, 69,
+			139, 220, 129, 237, 118, 190, 196, 139, 64, 225, 183, 188, 242, 119,
+			59, 220, 129, 231, 131, 197, 57, 94, 210, 211, 160, 121, 61, 210,
+			51, 47, 40, 102, 66, 162, 211, 139, 9, 186, 132, 251, 127, 218,
+			188, 164, 191, 237, 49, 65, 1, 91, 174, 190, 67, 243, 195, 223,
+			226, 28, 47, 160, 167, 61, 197, 93, 159, 190, 87, 119, 16, 48,
+			219, 107, 17, 56, 177, 150, 251, 3, 22, 31, 48, 79, 29, 236,
+			209, 101, 149, 247, 147, 255, 29, 29, 167, 137, 158, 14, 16, 53,
+			173, 234, 66, 194, 229, 37, 56, 237, 64, 104, 83, 160, 72, 147,
+			22, 115, 26, 94, 116, 102, 14, 246, 52, 165, 118, 203, 42, 21,
+			114, 111, 114, 158, 141, 119, 143, 184, 146, 111, 237, 142, 43, 249,
+			216, 253, 103, 14, 207, 57, 228, 131, 39, 191, 196, 135, 117, 129,
+			253, 58, 89, 236, 238, 228, 161, 123, 117, 146, 107, 186, 252, 203,
+			22, 31, 88, 35, 243, 163, 88, 60, 202, 13, 198, 207, 135, 72,
+			29, 210, 153, 24, 36, 245, 173, 188, 136, 107, 146, 208, 171, 102,
+			199, 123, 250, 50, 205, 169, 201, 232, 0, 158, 170, 142, 123, 141,
+			15, 230, 178, 31, 228, 41, 179, 238, 214, 213, 58, 102, 51, 120,
+			254, 7, 143, 169, 167, 204, 94, 251, 58, 136, 199, 169, 223, 47,
+			43, 245, 77, 242, 134, 138, 188, 57, 212, 119, 196, 114, 223, 37,
+			113, 218, 25, 187, 150, 217, 22, 33, 32, 50, 219, 114, 181, 121,
+			40, 204, 3, 26, 149, 215, 193, 146, 72, 47, 61, 114, 88, 160,
+			175, 141, 232, 105, 194, 236, 177, 200, 33, 138, 179, 212, 87, 234,
+			19, 206, 176, 237, 82, 84, 79, 16, 88, 177, 97, 122, 128, 11,
+			148, 1, 108, 196, 57, 72, 113, 155, 250, 10, 130, 141, 208, 75,
+			90, 40, 4, 101, 35, 244, 182, 21, 234, 114, 216, 200, 129, 137,
+			44, 20, 212, 168, 169, 6, 65, 139, 70, 233, 221, 46, 20, 120,
+			178, 209, 193, 124, 40, 168, 81, 83, 205, 22, 108, 204, 84, 179,
+			11, 144, 210, 213, 160, 201, 177, 193, 49, 157, 98, 130, 141, 153,
+			106, 76, 176, 113, 83, 141, 21, 32, 165, 3, 79, 65, 172, 193,
+			113, 211, 27, 131, 146, 166, 154, 35, 216, 1, 83, 205, 41, 64,
+			74, 247, 6, 97, 244, 14, 152, 185, 57, 76, 176, 3, 166, 90,
+			65, 176, 9, 83, 173, 128, 41, 13, 146, 2, 62, 115, 166, 7,
+			89, 96, 130, 77, 152, 106, 69, 193, 38, 157, 73, 170, 86, 44,
+			64, 74, 247, 6, 49, 217, 38, 7, 199, 117, 138, 9, 54, 57,
+			113, 144, 170, 245, 195, 11, 104, 186, 90, 127, 238, 5, 52, 21,
+			241, 236, 144, 1, 73, 63, 188, 128, 102, 170, 149, 224, 241, 178,
+			71, 233, 83, 169, 8, 169, 195, 58, 5, 79, 153, 209, 235, 92,
+			125, 32, 15, 97, 135, 31, 41, 235, 23, 238, 142, 246, 61, 151,
+			133, 226, 58, 74, 246, 232, 24, 138, 235, 152, 253, 88, 62, 20,
+			23, 152, 246, 128, 72, 19, 189, 119, 133, 243, 176, 243, 104, 129,
+			66, 247, 244, 129, 88, 157, 61, 60, 52, 206, 159, 71, 247, 60,
+			112, 249, 237, 19, 236, 145, 226, 65, 247, 140, 188, 226, 197, 183,
+			234, 209, 118, 56, 167, 31, 60, 173, 203, 122, 84, 235, 100, 145,
+			107, 140, 59, 44, 133, 88, 193, 219, 181, 202, 249, 24, 122, 241,
+			81, 108, 7, 246, 72, 113, 40, 159, 99, 9, 246, 200, 240, 88,
+			62, 135, 9, 246, 200, 1, 144, 35, 234, 17, 88, 130, 149, 139,
+			135, 114, 69, 96, 87, 150, 187, 154, 129, 41, 151, 135, 69, 62,
+			135, 9, 86, 62, 56, 201, 47, 101, 49, 199, 78, 56, 71, 221,
+			51, 196, 70, 195, 99, 98, 9, 241, 127, 73, 18, 128, 168, 15,
+			47, 122, 99, 202, 65, 92, 87, 205, 3, 237, 217, 109, 56, 125,
+			4, 34, 124, 22, 236, 4, 133, 225, 195, 116, 81, 176, 19, 195,
+			185, 112, 102, 150, 96, 39, 14, 76, 101, 225, 204, 152, 96, 39,
+			142, 60, 68, 203, 97, 193, 11, 100, 85, 189, 28, 22, 189, 64,
+			246, 10, 121, 48, 246, 9, 118, 210, 113, 221, 171, 192, 141, 165,
+			113, 80, 163, 49, 238, 246, 127, 71, 146, 140, 152, 84, 194, 56,
+			6, 252, 202, 89, 171, 229, 237, 112, 153, 122, 183, 178, 161, 163,
+			202, 148, 157, 236, 9, 220, 116, 114, 240, 96, 150, 102, 130, 157,
+			156, 58, 76, 67, 181, 133, 179, 96, 63, 161, 135, 10, 167, 120,
+			129, 11, 26, 42, 40, 134, 216, 233, 175, 217, 80, 209, 150, 148,
+			157, 54, 67, 69, 53, 19, 59, 109, 134, 138, 90, 38, 118, 122,
+			234, 48, 158, 25, 11, 144, 195, 83, 246, 56, 141, 20, 16, 199,
+			83, 156, 246, 63, 160, 200, 111, 176, 47, 210, 44, 16, 69, 126,
+			67, 105, 36, 139, 37, 119, 198, 161, 106, 32, 14, 97, 103, 204,
+			243, 127, 80, 240, 204, 128, 137, 51, 199, 4, 59, 51, 58, 70,
+			213, 44, 193, 206, 58, 130, 62, 89, 69, 72, 233, 106, 176, 160,
+			103, 7, 134, 117, 138, 9, 118, 214, 68, 174, 179, 5, 123, 218,
+			60, 54, 104, 23, 33, 165, 171, 1, 112, 159, 30, 208, 143, 13,
+			194, 27, 133, 79, 231, 223, 40, 124, 171, 233, 141, 21, 33, 165,
+			171, 193, 76, 223, 106, 122, 3, 40, 188, 213, 244, 230, 8, 246,
+			12, 225, 58, 52, 184, 100, 207, 56, 122, 54, 128, 34, 159, 25,
+			30, 211, 41, 38, 216, 51, 132, 235, 44, 192, 220, 207, 58, 135,
+			232, 19, 188, 13, 248, 172, 169, 6, 40, 242, 217, 97, 61, 18,
+			64, 145, 207, 30, 156, 228, 211, 88, 173, 40, 216, 146, 51, 94,
+			118, 101, 59, 106, 163, 8, 142, 66, 211, 224, 122, 223, 88, 75,
+			99, 138, 164, 102, 23, 177, 164, 142, 209, 7, 232, 115, 169, 164,
+			59, 40, 50, 193, 150, 12, 148, 251, 5, 187, 224, 76, 208, 167,
+			254, 34, 164, 244, 188, 1, 125, 94, 160, 152, 132, 104, 98, 201,
+			46, 80, 40, 67, 203, 46, 9, 182, 108, 160, 12, 232, 115, 217,
+			84, 131, 208, 137, 203, 6, 202, 128, 62, 151, 199, 5, 63, 143,
+			158, 127, 206, 74, 95, 211, 114, 159, 146, 64, 76, 247, 56, 252,
+			65, 14, 74, 84, 64, 186, 66, 183, 179, 186, 223, 3, 237, 209,
+			171, 95, 26, 92, 41, 13, 241, 211, 250, 165, 193, 183, 219, 239,
+			102, 238, 9, 115, 161, 231, 73, 130, 32, 220, 108, 250, 166, 49,
+			138, 17, 168, 30, 36, 124, 59, 23, 252, 82, 246, 32, 225, 21,
+			231, 192, 87, 131, 123, 205, 91, 132, 5, 104, 42, 151, 182, 4,
+			187, 50, 56, 146, 165, 153, 96, 87, 198, 5, 255, 11, 139, 186,
+			182, 4, 91, 115, 38, 220, 47, 89, 18, 120, 130, 46, 65, 36,
+			240, 62, 146, 2, 235, 153, 126, 205, 92, 192, 19, 50, 11, 245,
+			4, 149, 96, 204, 28, 144, 150, 242, 164, 121, 119, 18, 133, 21,
+			8, 83, 216, 105, 42, 9, 92, 210, 217, 72, 210, 32, 69, 225,
+			122, 70, 24, 37, 85, 185, 162, 101, 179, 186, 23, 158, 1, 83,
+			57, 6, 96, 139, 21, 53, 196, 238, 120, 109, 248, 5, 29, 27,
+			43, 164, 48, 242, 64, 106, 157, 239, 43, 201, 89, 136, 102, 110,
+			254, 26, 34, 112, 201, 172, 229, 32, 6, 39, 123, 109, 112, 52,
+			75, 51, 193, 214, 132, 82, 103, 218, 112, 229, 8, 231, 186, 227,
+			21, 244, 119, 92, 203, 235, 67, 7, 241, 38, 181, 245, 77, 250,
+			226, 87, 127, 147, 82, 91, 5, 193, 94, 44, 14, 229, 115, 44,
+			193, 94, 28, 30, 203, 231, 48, 193, 94, 164, 155, 212, 214, 55,
+			233, 75, 197, 169, 92, 17, 64, 94, 47, 21, 7, 243, 57, 80,
+			102, 232, 64, 62, 135, 9, 246, 210, 228, 33, 254, 107, 150, 105,
+			199, 22, 236, 213, 226, 148, 251, 83, 150, 212, 156, 152, 121, 232,
+			63, 1, 1, 229, 52, 100, 79, 195, 166, 241, 104, 33, 50, 218,
+			23, 231, 101, 86, 25, 164, 186, 176, 174, 68, 122, 235, 216, 134,
+			96, 139, 17, 132, 155, 157, 32, 217, 146, 40, 111, 44, 151, 85,
+			76, 236, 48, 74, 231, 224, 205, 90, 140, 201, 0, 86, 95, 196,
+			37, 162, 13, 28, 34, 155, 55, 160, 239, 179, 242, 141, 187, 119,
+			201, 251, 179, 69, 47, 122, 147, 191, 86, 54, 49, 192, 117, 175,
+			22, 187, 114, 32, 182, 234, 96, 126, 242, 128, 125, 95, 157, 60,
+			148, 131, 33, 19, 236, 102, 23, 12, 1, 37, 223, 236, 90, 10,
+			64, 203, 55, 135, 243, 205, 0, 106, 190, 57, 121, 136, 255, 168,
+			77, 199, 203, 22, 44, 112, 78, 184, 63, 100, 195, 142, 245, 90,
+			10, 84, 185, 183, 83, 115, 90, 30, 3, 43, 8, 35, 43, 55,
+			2, 109, 176, 230, 103, 135, 1, 246, 127, 85, 169, 80, 110, 249,
+			116, 18, 48, 152, 12, 201, 86, 55, 253, 59, 114, 254, 155, 143,
+			191, 241, 242, 171, 119, 95, 57, 121, 119, 30, 31, 16, 45, 31,
+			127, 163, 17, 69, 119, 203, 38, 158, 166, 140, 110, 81, 152, 91,
+			124, 247, 179, 34, 203, 199, 213, 191, 81, 44, 203, 199, 223, 48,
+			37, 195, 8, 132, 219, 106, 220, 73, 22, 210, 12, 101, 252, 26,
+			214, 132, 62, 65, 88, 123, 30, 206, 102, 206, 67, 162, 230, 27,
+			157, 11, 167, 13, 225, 239, 121, 172, 115, 103, 17, 46, 203, 192,
+			113, 179, 52, 4, 122, 61, 34, 179, 52, 132, 122, 125, 244, 56,
+			222, 0, 72, 153, 220, 178, 79, 232, 183, 71, 139, 130, 221, 178,
+			15, 233, 148, 37, 216, 173, 41, 169, 83, 76, 176, 91, 143, 30,
+			71, 2, 154, 9, 39, 236, 123, 77, 17, 208, 176, 126, 97, 73,
+			93, 164, 96, 120, 206, 34, 251, 8, 86, 65, 107, 113, 22, 81,
+			108, 92, 52, 21, 103, 209, 192, 164, 78, 49, 193, 162, 195, 46,
+			85, 179, 4, 107, 219, 146, 170, 193, 57, 107, 83, 128, 93, 180,
+			223, 102, 237, 131, 186, 73, 224, 163, 218, 199, 30, 54, 50, 225,
+			239, 191, 202, 151, 30, 252, 109, 82, 31, 227, 177, 220, 216, 243,
+			141, 212, 191, 28, 209, 178, 251, 85, 60, 213, 250, 149, 190, 178,
+			250, 215, 44, 126, 80, 239, 137, 149, 16, 194, 254, 164, 1, 162,
+			198, 55, 245, 168, 225, 83, 124, 128, 152, 111, 63, 38, 1, 209,
+			212, 126, 18, 144, 213, 172, 232, 243, 78, 137, 141, 57, 229, 31,
+			43, 112, 209, 19, 237, 102, 213, 127, 77, 28, 207, 94, 68, 98,
+			123, 188, 94, 168, 62, 138, 51, 124, 24, 127, 220, 32, 15, 31,
+			18, 192, 236, 253, 218, 251, 16, 22, 165, 108, 241, 141, 124, 68,
+			143, 241, 6, 126, 32, 57, 28, 62, 185, 178, 39, 80, 86, 141,
+			40, 232, 29, 80, 94, 60, 203, 199, 76, 11, 186, 127, 231, 30,
+			253, 143, 234, 210, 122, 8, 139, 124, 40, 246, 182, 245, 216, 247,
+			125, 129, 111, 48, 246, 182, 41, 157, 136, 183, 240, 225, 70, 148,
+			123, 26, 109, 170, 184, 215, 211, 85, 32, 209, 89, 29, 106, 68,
+			217, 91, 105, 32, 41, 71, 205, 18, 9, 150, 241, 25, 210, 221,
+			112, 175, 94, 134, 50, 171, 170, 168, 248, 6, 222, 79, 30, 81,
+			244, 50, 209, 177, 125, 106, 173, 168, 82, 171, 186, 184, 251, 22,
+			94, 192, 150, 68, 153, 15, 119, 25, 118, 211, 131, 164, 131, 240,
+			22, 168, 151, 122, 107, 193, 235, 254, 243, 78, 201, 26, 179, 159,
+			119, 74, 246, 24, 115, 255, 71, 139, 247, 83, 107, 226, 27, 179,
+			247, 175, 28, 146, 20, 222, 179, 251, 234, 53, 180, 32, 73, 204,
+			59, 89, 238, 25, 222, 79, 121, 98, 210, 188, 123, 6, 35, 40,
+			173, 82, 42, 223, 249, 243, 78, 201, 25, 43, 60, 239, 148, 10,
+			99, 69, 147, 207, 198, 28, 147, 83, 28, 235, 47, 255, 153, 181,
+			123, 191, 38, 109, 16, 159, 106, 171, 79, 122, 198, 205, 164, 197,
+			44, 31, 192, 29, 118, 35, 168, 107, 17, 97, 247, 243, 190, 37,
+			252, 188, 82, 79, 196, 60, 55, 162, 69, 44, 205, 246, 40, 61,
+			168, 75, 64, 133, 19, 185, 109, 140, 186, 63, 124, 2, 114, 32,
+			219, 171, 168, 66, 22, 39, 186, 94, 125, 163, 119, 233, 178, 9,
+			208, 71, 120, 15, 74, 121, 245, 220, 128, 112, 85, 184, 183, 74,
+			171, 92, 101, 93, 242, 154, 233, 243, 159, 185, 160, 30, 251, 249,
+			5, 235, 235, 64, 184, 120, 45, 123, 236, 103, 89, 63, 246, 83,
+			50, 143, 253, 240, 236, 177, 159, 161, 190, 135, 178, 199, 126, 134,
+			74, 71, 249, 21, 35, 250, 179, 15, 186, 223, 40, 9, 55, 106,
+			242, 29, 48, 240, 156, 10, 71, 109, 62, 81, 96, 36, 226, 77,
+			160, 84, 15, 91, 66, 194, 67, 187, 148, 23, 30, 14, 140, 245,
+			8, 15, 255, 147, 165, 165, 135, 19, 246, 184, 251, 199, 224, 217,
+			208, 208, 221, 110, 6, 16, 116, 176, 97, 8, 26, 234, 25, 193,
+			162, 152, 162, 250, 46, 38, 34, 11, 9, 65, 17, 169, 117, 132,
+			222, 174, 33, 230, 216, 176, 236, 89, 15, 154, 217, 220, 118, 80,
+			247, 187, 131, 111, 67, 92, 214, 58, 106, 112, 211, 173, 158, 79,
+			178, 25, 41, 31, 20, 136, 21, 6, 196, 77, 26, 64, 112, 21,
+			120, 212, 167, 33, 103, 64, 87, 206, 229, 116, 236, 55, 146, 249,
+			45, 223, 171, 39, 243, 45, 47, 73, 253, 120, 122, 214, 0, 9,
+			248, 133, 9, 3, 36, 184, 226, 39, 72, 124, 160, 68, 165, 19,
+			163, 99, 124, 158, 51, 167, 111, 64, 176, 67, 246, 176, 251, 168,
+			222, 108, 242, 52, 146, 65, 249, 181, 161, 39, 205, 192, 70, 196,
+			113, 250, 6, 250, 64, 88, 72, 109, 13, 244, 89, 93, 41, 91,
+			165, 190, 221, 210, 82, 216, 35, 246, 140, 251, 186, 22, 58, 251,
+			198, 181, 149, 214, 214, 171, 165, 29, 175, 153, 193, 15, 84, 7,
+			21, 224, 151, 48, 208, 35, 24, 102, 135, 59, 93, 76, 18, 17,
+			122, 200, 145, 65, 188, 154, 26, 153, 70, 152, 22, 90, 112, 114,
+			208, 46, 67, 69, 226, 32, 41, 111, 81, 176, 35, 182, 150, 91,
+			194, 182, 56, 226, 106, 153, 38, 208, 212, 71, 30, 155, 230, 63,
+			64, 130, 203, 183, 244, 221, 181, 220, 239, 228, 189, 161, 229, 86,
+			253, 215, 192, 216, 41, 218, 78, 48, 202, 73, 26, 65, 52, 16,
+			159, 158, 212, 65, 3, 28, 208, 172, 193, 166, 210, 119, 16, 16,
+			150, 203, 87, 166, 19, 178, 225, 80, 162, 245, 238, 38, 234, 126,
+			173, 9, 86, 29, 121, 55, 103, 181, 115, 192, 119, 151, 26, 130,
+			130, 94, 136, 182, 76, 64, 233, 190, 68, 49, 86, 116, 93, 211,
+			221, 198, 14, 130, 171, 22, 181, 32, 240, 60, 217, 8, 146, 73,
+			197, 11, 72, 23, 3, 53, 239, 81, 96, 254, 188, 41, 97, 187,
+			217, 209, 4, 127, 110, 2, 38, 248, 38, 236, 7, 56, 29, 208,
+			148, 84, 213, 171, 187, 155, 53, 75, 16, 228, 137, 1, 57, 67,
+			26, 6, 58, 5, 52, 234, 186, 106, 6, 90, 145, 166, 230, 236,
+			131, 14, 100, 223, 49, 196, 222, 54, 202, 73, 163, 134, 212, 55,
+			137, 156, 123, 70, 122, 123, 180, 131, 223, 73, 16, 184, 3, 1,
+			161, 97, 36, 94, 19, 76, 45, 119, 228, 173, 48, 23, 79, 25,
+			29, 4, 186, 142, 118, 132, 11, 177, 18, 26, 43, 149, 138, 9,
+			151, 148, 194, 198, 133, 13, 178, 173, 125, 240, 86, 150, 19, 205,
+			66, 105, 86, 145, 236, 169, 230, 105, 126, 224, 13, 71, 86, 103,
+			145, 138, 52, 13, 81, 144, 242, 123, 162, 162, 30, 71, 32, 51,
+			180, 116, 43, 170, 211, 59, 86, 218, 139, 193, 75, 224, 96, 164,
+			58, 228, 171, 33, 123, 192, 217, 0, 236, 255, 180, 97, 75, 23,
+			77, 68, 175, 27, 224, 141, 67, 32, 82, 177, 181, 235, 112, 4,
+			181, 245, 107, 29, 95, 86, 185, 109, 226, 219, 215, 162, 86, 239,
+			134, 37, 182, 73, 51, 221, 245, 204, 49, 160, 42, 87, 82, 192,
+			187, 96, 227, 199, 51, 225, 54, 44, 164, 151, 133, 248, 152, 211,
+			211, 200, 134, 150, 26, 72, 236, 234, 10, 88, 206, 104, 59, 31,
+			216, 118, 37, 204, 161, 72, 8, 218, 163, 29, 71, 114, 210, 244,
+			61, 155, 131, 32, 164, 240, 210, 74, 56, 151, 245, 12, 33, 197,
+			233, 217, 37, 178, 191, 157, 173, 64, 20, 44, 104, 51, 200, 53,
+			137, 115, 8, 187, 134, 204, 117, 39, 6, 154, 17, 200, 17, 182,
+			188, 38, 222, 54, 232, 2, 135, 231, 56, 171, 69, 134, 92, 57,
+			39, 58, 242, 178, 83, 198, 75, 244, 242, 168, 90, 143, 108, 224,
+			20, 37, 30, 120, 92, 189, 101, 245, 235, 13, 218, 243, 78, 57,
+			119, 144, 208, 65, 219, 51, 129, 92, 3, 173, 194, 40, 16, 20,
+			145, 161, 121, 151, 101, 114, 245, 131, 55, 28, 98, 191, 150, 162,
+			217, 178, 86, 227, 188, 165, 228, 170, 0, 94, 224, 254, 196, 206,
+			218, 143, 186, 239, 163, 96, 232, 70, 96, 96, 142, 30, 237, 159,
+			118, 138, 68, 141, 62, 30, 138, 63, 212, 90, 13, 159, 231, 130,
+			237, 195, 116, 201, 206, 144, 130, 225, 40, 184, 64, 128, 226, 112,
+			58, 173, 24, 235, 205, 13, 159, 192, 92, 39, 252, 110, 161, 18,
+			228, 172, 109, 82, 32, 3, 31, 156, 210, 41, 144, 129, 31, 62,
+			70, 50, 112, 32, 16, 206, 62, 82, 230, 159, 180, 180, 236, 252,
+			89, 251, 148, 251, 67, 150, 154, 7, 193, 64, 99, 105, 0, 139,
+			87, 175, 7, 132, 75, 115, 43, 132, 55, 15, 96, 223, 186, 31,
+			7, 183, 201, 178, 149, 107, 111, 82, 144, 58, 68, 245, 192, 207,
+			201, 35, 240, 8, 85, 185, 138, 193, 140, 2, 52, 101, 134, 216,
+			242, 218, 137, 60, 117, 246, 148, 52, 49, 61, 224, 234, 186, 237,
+			193, 107, 117, 26, 205, 209, 208, 45, 71, 176, 103, 205, 36, 129,
+			135, 127, 118, 80, 79, 11, 86, 231, 217, 135, 43, 58, 5, 194,
+			240, 249, 5, 254, 45, 150, 150, 244, 95, 176, 159, 112, 19, 35,
+			215, 160, 0, 246, 123, 79, 146, 16, 227, 126, 83, 212, 109, 36,
+			120, 79, 107, 122, 73, 145, 41, 126, 60, 167, 233, 184, 13, 47,
+			9, 18, 51, 116, 219, 17, 236, 130, 25, 58, 200, 79, 46, 12,
+			74, 157, 2, 233, 249, 35, 243, 36, 61, 135, 219, 248, 194, 226,
+			105, 254, 89, 75, 107, 27, 46, 217, 79, 184, 255, 204, 50, 253,
+			126, 181, 75, 100, 198, 79, 1, 212, 180, 140, 200, 127, 13, 40,
+			145, 32, 212, 241, 220, 192, 50, 145, 138, 222, 208, 215, 205, 155,
+			95, 60, 160, 1, 122, 155, 161, 153, 50, 71, 176, 75, 6, 38,
+			32, 182, 187, 100, 150, 19, 136, 237, 75, 15, 107, 152, 128, 184,
+			238, 210, 226, 105, 254, 67, 182, 86, 165, 92, 181, 203, 238, 119,
+			217, 114, 53, 99, 120, 51, 145, 29, 233, 182, 144, 56, 161, 251,
+			173, 59, 242, 175, 1, 145, 226, 87, 184, 142, 61, 181, 110, 54,
+			111, 80, 207, 188, 80, 177, 73, 13, 36, 66, 54, 115, 112, 63,
+			2, 194, 67, 123, 227, 171, 215, 214, 185, 142, 8, 98, 120, 9,
+			213, 16, 94, 48, 234, 250, 216, 21, 175, 14, 45, 25, 225, 166,
+			164, 203, 214, 151, 43, 203, 58, 254, 128, 58, 141, 153, 163, 125,
+			142, 86, 204, 221, 195, 68, 192, 119, 131, 213, 129, 151, 214, 236,
+			49, 157, 130, 71, 202, 198, 143, 234, 20, 19, 236, 170, 124, 132,
+			127, 146, 105, 229, 210, 75, 246, 99, 238, 15, 49, 249, 92, 78,
+			2, 0, 67, 244, 194, 188, 43, 56, 100, 182, 189, 32, 150, 51,
+			166, 208, 141, 149, 229, 138, 114, 115, 157, 53, 24, 156, 2, 17,
+			234, 19, 159, 194, 252, 130, 84, 225, 61, 228, 227, 36, 121, 152,
+			71, 113, 240, 122, 94, 178, 78, 248, 12, 50, 96, 254, 64, 196,
+			221, 196, 73, 37, 55, 13, 92, 137, 122, 132, 79, 122, 253, 110,
+			26, 41, 55, 61, 24, 162, 76, 125, 241, 82, 219, 243, 126, 222,
+			125, 233, 228, 156, 147, 161, 68, 216, 237, 128, 15, 155, 57, 246,
+			19, 117, 111, 193, 181, 56, 191, 17, 165, 61, 128, 169, 112, 51,
+			48, 172, 149, 204, 155, 237, 101, 112, 159, 30, 92, 203, 171, 251,
+			112, 45, 133, 117, 175, 9, 244, 173, 186, 100, 195, 40, 221, 130,
+			109, 9, 165, 244, 192, 41, 92, 117, 75, 243, 51, 74, 247, 247,
+			146, 173, 21, 105, 5, 80, 11, 76, 60, 162, 83, 160, 18, 56,
+			126, 130, 244, 196, 125, 194, 121, 185, 251, 177, 175, 151, 249, 40,
+			255, 94, 11, 138, 50, 96, 119, 94, 117, 132, 251, 109, 22, 201,
+			118, 193, 104, 214, 75, 241, 66, 71, 12, 220, 101, 73, 140, 162,
+			153, 138, 14, 0, 184, 13, 241, 119, 64, 117, 131, 150, 48, 117,
+			82, 1, 97, 25, 237, 27, 80, 149, 151, 162, 109, 8, 5, 88,
+			81, 44, 188, 132, 173, 189, 237, 203, 107, 87, 47, 191, 132, 220,
+			98, 151, 140, 7, 141, 178, 65, 211, 142, 60, 213, 171, 24, 144,
+			13, 52, 227, 138, 171, 234, 74, 219, 42, 173, 139, 195, 231, 177,
+			113, 243, 217, 234, 77, 219, 42, 253, 33, 150, 127, 226, 236, 168,
+			251, 95, 109, 121, 37, 147, 39, 193, 102, 217, 79, 75, 62, 13,
+			133, 166, 229, 76, 16, 42, 133, 249, 108, 94, 106, 192, 201, 129,
+			2, 118, 13, 132, 112, 239, 142, 30, 116, 131, 76, 188, 65, 80,
+			225, 235, 91, 60, 72, 200, 94, 27, 220, 137, 2, 117, 153, 135,
+			124, 87, 208, 235, 105, 50, 200, 159, 38, 13, 77, 126, 91, 27,
+			226, 95, 191, 138, 4, 200, 1, 26, 216, 240, 224, 161, 28, 10,
+			157, 169, 212, 7, 73, 74, 21, 123, 209, 82, 246, 192, 201, 174,
+			97, 45, 84, 144, 232, 86, 250, 27, 149, 27, 53, 228, 169, 167,
+			174, 156, 55, 167, 11, 244, 65, 61, 19, 226, 38, 28, 230, 233,
+			133, 43, 231, 43, 230, 192, 163, 55, 64, 189, 83, 83, 10, 36,
+			248, 70, 10, 132, 253, 159, 152, 235, 182, 209, 216, 60, 242, 16,
+			169, 144, 65, 219, 96, 107, 61, 55, 40, 172, 3, 59, 175, 176,
+			14, 74, 90, 227, 14, 10, 235, 128, 60, 187, 225, 217, 54, 231,
+			150, 253, 134, 62, 7, 176, 69, 110, 241, 113, 254, 97, 117, 14,
+			172, 1, 193, 66, 103, 202, 253, 214, 7, 58, 7, 36, 55, 188,
+			207, 73, 160, 82, 15, 124, 22, 104, 191, 152, 83, 96, 193, 41,
+			8, 205, 174, 183, 240, 20, 116, 165, 109, 245, 93, 23, 135, 207,
+			102, 215, 91, 3, 86, 111, 218, 86, 105, 93, 28, 146, 232, 117,
+			170, 62, 219, 86, 79, 154, 190, 235, 226, 76, 176, 112, 242, 144,
+			249, 204, 172, 158, 180, 173, 211, 250, 37, 35, 167, 237, 220, 209,
+			166, 75, 10, 241, 180, 135, 14, 240, 191, 99, 233, 140, 1, 193,
+			210, 162, 235, 126, 228, 205, 128, 92, 139, 106, 119, 129, 158, 236,
+			241, 72, 82, 213, 83, 250, 65, 151, 64, 187, 99, 160, 223, 27,
+			5, 64, 135, 69, 72, 71, 181, 9, 148, 70, 70, 61, 57, 182,
+			202, 201, 42, 89, 130, 165, 226, 64, 174, 146, 181, 59, 199, 86,
+			57, 89, 37, 200, 56, 56, 153, 43, 98, 91, 187, 114, 168, 76,
+			86, 137, 9, 150, 78, 29, 206, 21, 97, 214, 174, 28, 91, 229,
+			60, 175, 43, 1, 183, 178, 93, 156, 116, 207, 72, 80, 84, 128,
+			151, 151, 126, 146, 2, 158, 185, 162, 64, 198, 61, 236, 16, 69,
+			97, 213, 15, 0, 154, 214, 81, 252, 184, 93, 236, 202, 177, 4,
+			219, 30, 28, 207, 231, 48, 193, 182, 39, 14, 234, 157, 10, 253,
+			191, 238, 232, 243, 173, 24, 148, 215, 157, 193, 46, 195, 167, 215,
+			135, 14, 100, 105, 38, 216, 235, 147, 135, 232, 252, 247, 11, 246,
+			30, 123, 146, 206, 63, 88, 158, 188, 199, 30, 200, 89, 158, 188,
+			135, 107, 147, 33, 176, 60, 121, 15, 26, 238, 129, 9, 73, 225,
+			125, 125, 191, 64, 175, 97, 2, 92, 223, 87, 114, 249, 31, 21,
+			72, 35, 233, 124, 200, 178, 39, 220, 127, 89, 144, 90, 246, 110,
+			60, 14, 145, 42, 3, 89, 3, 56, 70, 102, 190, 92, 224, 95,
+			40, 223, 29, 117, 192, 3, 10, 120, 75, 243, 34, 105, 115, 71,
+			235, 204, 115, 129, 77, 52, 245, 82, 201, 17, 43, 21, 121, 179,
+			155, 16, 238, 202, 161, 98, 55, 145, 247, 229, 192, 127, 225, 91,
+			47, 104, 220, 71, 79, 157, 123, 153, 191, 105, 22, 43, 186, 14,
+			49, 160, 64, 136, 190, 163, 208, 238, 52, 132, 82, 6, 58, 177,
+			185, 51, 77, 55, 21, 185, 206, 194, 147, 189, 57, 175, 65, 148,
+			161, 102, 71, 110, 86, 93, 39, 20, 34, 216, 223, 1, 129, 76,
+			198, 113, 130, 97, 6, 55, 194, 156, 216, 111, 52, 213, 163, 149,
+			154, 70, 214, 98, 32, 186, 87, 114, 20, 26, 122, 244, 196, 232,
+			22, 152, 23, 56, 33, 71, 171, 232, 110, 195, 201, 67, 142, 33,
+			153, 32, 88, 95, 6, 197, 20, 125, 199, 52, 99, 70, 215, 11,
+			16, 230, 88, 82, 222, 84, 167, 248, 102, 198, 134, 64, 228, 217,
+			70, 211, 219, 196, 27, 152, 6, 213, 3, 125, 124, 225, 149, 188,
+			160, 102, 200, 171, 204, 176, 42, 21, 124, 113, 93, 233, 212, 43,
+			234, 149, 225, 10, 189, 235, 149, 243, 134, 218, 240, 141, 152, 58,
+			8, 115, 29, 96, 171, 55, 241, 162, 204, 25, 14, 232, 207, 40,
+			169, 74, 252, 58, 239, 218, 69, 149, 28, 215, 0, 236, 135, 186,
+			237, 97, 218, 49, 72, 61, 192, 115, 113, 215, 12, 244, 32, 16,
+			88, 230, 99, 80, 79, 110, 146, 3, 20, 218, 237, 193, 94, 47,
+			234, 36, 132, 154, 177, 200, 1, 10, 173, 246, 156, 15, 89, 226,
+			0, 255, 13, 75, 71, 246, 254, 176, 101, 159, 112, 127, 209, 50,
+			226, 195, 108, 170, 61, 166, 100, 134, 75, 169, 106, 1, 159, 33,
+			129, 73, 125, 81, 231, 32, 125, 163, 41, 133, 36, 33, 11, 40,
+			134, 55, 61, 69, 183, 146, 38, 154, 225, 12, 18, 185, 217, 21,
+			11, 29, 77, 38, 212, 182, 163, 34, 154, 59, 175, 144, 219, 29,
+			56, 250, 1, 31, 10, 17, 220, 1, 230, 137, 153, 183, 229, 192,
+			67, 123, 182, 73, 22, 33, 57, 56, 169, 147, 56, 209, 67, 82,
+			39, 33, 238, 179, 245, 232, 113, 254, 127, 152, 24, 218, 31, 181,
+			236, 25, 247, 159, 91, 25, 239, 250, 102, 33, 161, 43, 26, 17,
+			1, 2, 195, 52, 247, 151, 12, 149, 238, 118, 239, 15, 30, 136,
+			250, 255, 209, 12, 60, 16, 112, 226, 163, 25, 120, 192, 77, 238,
+			163, 214, 161, 71, 117, 18, 194, 222, 88, 143, 77, 243, 239, 81,
+			224, 129, 120, 207, 176, 75, 222, 159, 3, 15, 110, 121, 25, 152,
+			120, 221, 112, 252, 119, 118, 15, 67, 151, 167, 241, 232, 216, 234,
+			124, 223, 169, 96, 68, 232, 185, 124, 212, 123, 21, 5, 186, 103,
+			62, 204, 193, 49, 153, 100, 1, 146, 131, 227, 58, 9, 1, 171,
+			45, 161, 151, 155, 225, 4, 30, 61, 206, 255, 78, 17, 231, 227,
+			192, 35, 100, 182, 235, 126, 95, 145, 200, 1, 10, 61, 222, 237,
+			205, 11, 210, 29, 240, 70, 166, 120, 207, 57, 70, 177, 130, 97,
+			208, 233, 147, 23, 238, 104, 159, 85, 20, 64, 146, 52, 130, 132,
+			148, 136, 241, 0, 159, 43, 202, 29, 253, 56, 9, 61, 128, 128,
+			187, 42, 151, 194, 29, 188, 121, 160, 71, 210, 84, 194, 205, 227,
+			213, 201, 217, 58, 27, 147, 106, 125, 70, 95, 96, 231, 206, 193,
+			245, 53, 75, 110, 154, 237, 182, 239, 197, 136, 14, 185, 188, 185,
+			124, 245, 162, 250, 10, 104, 129, 228, 149, 208, 190, 118, 233, 4,
+			255, 240, 110, 47, 117, 148, 236, 238, 104, 105, 164, 146, 132, 225,
+			208, 9, 193, 103, 157, 98, 140, 252, 138, 137, 123, 31, 132, 160,
+			223, 34, 131, 109, 212, 239, 40, 135, 83, 95, 110, 111, 237, 144,
+			42, 134, 90, 157, 129, 209, 65, 124, 59, 24, 202, 172, 4, 107,
+			187, 32, 10, 179, 88, 72, 185, 75, 4, 105, 16, 125, 113, 128,
+			190, 16, 26, 146, 116, 125, 100, 175, 9, 244, 76, 20, 110, 101,
+			37, 236, 237, 177, 102, 67, 7, 228, 61, 226, 235, 130, 37, 40,
+			0, 181, 233, 39, 224, 131, 138, 70, 161, 115, 180, 198, 24, 231,
+			43, 10, 105, 10, 70, 232, 68, 95, 253, 186, 156, 193, 216, 248,
+			90, 205, 176, 135, 84, 151, 156, 212, 103, 137, 207, 135, 9, 208,
+			182, 161, 215, 34, 116, 88, 111, 92, 88, 188, 60, 52, 85, 75,
+			221, 17, 69, 6, 251, 1, 38, 73, 23, 18, 52, 100, 222, 59,
+			216, 133, 160, 114, 161, 146, 179, 104, 121, 25, 172, 13, 87, 152,
+			211, 70, 117, 217, 62, 102, 207, 16, 64, 48, 186, 31, 183, 236,
+			17, 157, 132, 32, 65, 214, 232, 65, 157, 196, 151, 252, 166, 14,
+			243, 159, 2, 209, 18, 6, 88, 248, 121, 203, 62, 228, 254, 48,
+			195, 87, 42, 110, 118, 233, 93, 110, 34, 53, 165, 53, 161, 245,
+			89, 185, 102, 12, 7, 114, 134, 139, 70, 114, 103, 70, 7, 181,
+			244, 214, 3, 108, 186, 124, 197, 132, 53, 200, 169, 33, 136, 81,
+			0, 195, 4, 57, 163, 229, 238, 177, 79, 26, 14, 191, 39, 10,
+			6, 9, 169, 54, 252, 90, 212, 242, 225, 249, 168, 141, 102, 84,
+			187, 229, 215, 103, 51, 62, 151, 72, 195, 156, 132, 200, 116, 6,
+			202, 77, 144, 104, 81, 196, 55, 189, 225, 98, 255, 118, 116, 139,
+			200, 54, 122, 68, 4, 3, 136, 248, 177, 220, 142, 226, 91, 120,
+			192, 26, 29, 77, 140, 161, 110, 43, 209, 180, 211, 30, 144, 202,
+			81, 69, 121, 128, 208, 220, 149, 216, 141, 14, 162, 14, 176, 71,
+			184, 163, 27, 50, 232, 134, 31, 133, 105, 16, 118, 76, 54, 186,
+			210, 171, 53, 44, 168, 69, 211, 180, 2, 196, 104, 249, 121, 171,
+			95, 232, 36, 190, 11, 120, 112, 210, 24, 198, 125, 113, 140, 159,
+			127, 112, 115, 50, 29, 97, 44, 155, 91, 206, 50, 238, 171, 48,
+			105, 43, 111, 242, 137, 93, 241, 245, 192, 48, 236, 49, 238, 192,
+			202, 76, 89, 251, 218, 61, 225, 119, 49, 205, 71, 205, 144, 110,
+			100, 222, 165, 67, 171, 35, 38, 27, 23, 247, 249, 47, 143, 40,
+			119, 185, 202, 215, 129, 69, 203, 178, 113, 151, 27, 228, 127, 84,
+			82, 198, 43, 143, 244, 85, 44, 247, 115, 165, 221, 209, 10, 115,
+			154, 255, 189, 116, 150, 166, 28, 220, 79, 122, 153, 33, 200, 154,
+			223, 108, 84, 185, 92, 142, 160, 16, 206, 146, 26, 89, 190, 2,
+			37, 51, 21, 115, 87, 120, 94, 140, 25, 135, 47, 236, 25, 153,
+			47, 156, 40, 63, 4, 38, 38, 225, 61, 133, 177, 71, 224, 196,
+			242, 172, 77, 55, 103, 83, 145, 75, 117, 140, 175, 139, 173, 105,
+			253, 32, 157, 127, 56, 116, 187, 230, 107, 164, 253, 112, 46, 243,
+			68, 94, 111, 252, 71, 170, 169, 6, 114, 11, 158, 176, 209, 42,
+			115, 115, 98, 53, 67, 149, 27, 243, 118, 54, 193, 172, 233, 92,
+			180, 59, 66, 4, 221, 149, 0, 42, 160, 8, 160, 176, 129, 20,
+			173, 175, 210, 219, 52, 225, 127, 120, 112, 15, 208, 79, 194, 229,
+			52, 21, 14, 194, 205, 233, 12, 97, 232, 39, 31, 33, 10, 140,
+			23, 202, 107, 111, 199, 173, 87, 149, 75, 116, 117, 99, 88, 19,
+			188, 214, 99, 138, 236, 194, 193, 45, 200, 24, 252, 152, 46, 241,
+			104, 80, 44, 161, 112, 7, 94, 21, 34, 196, 77, 47, 200, 102,
+			131, 131, 211, 64, 10, 119, 125, 57, 195, 19, 135, 208, 170, 34,
+			60, 232, 110, 189, 246, 118, 169, 130, 235, 153, 154, 112, 34, 96,
+			179, 152, 105, 147, 225, 195, 74, 136, 198, 236, 75, 241, 102, 11,
+			221, 222, 168, 158, 198, 142, 0, 231, 150, 215, 84, 28, 116, 85,
+			197, 79, 210, 114, 118, 32, 34, 228, 150, 223, 108, 107, 27, 10,
+			243, 4, 246, 178, 31, 194, 115, 2, 115, 114, 189, 235, 129, 232,
+			158, 149, 70, 180, 12, 239, 65, 197, 49, 70, 126, 199, 139, 243,
+			158, 61, 44, 41, 10, 240, 34, 18, 1, 212, 252, 158, 173, 82,
+			155, 138, 31, 207, 107, 204, 195, 52, 72, 119, 186, 205, 52, 168,
+			1, 109, 175, 101, 32, 6, 34, 9, 19, 124, 133, 238, 195, 154,
+			239, 1, 194, 105, 211, 163, 212, 201, 125, 6, 205, 17, 107, 81,
+			215, 72, 108, 107, 9, 220, 134, 121, 238, 52, 219, 190, 254, 29,
+			175, 150, 170, 80, 66, 187, 142, 211, 170, 255, 26, 197, 201, 207,
+			92, 102, 31, 41, 61, 164, 30, 44, 66, 209, 252, 113, 251, 168,
+			123, 87, 234, 248, 171, 93, 251, 75, 69, 101, 205, 209, 215, 185,
+			120, 170, 38, 210, 106, 69, 230, 46, 73, 174, 201, 228, 124, 0,
+			201, 125, 142, 97, 183, 26, 93, 249, 14, 30, 39, 37, 139, 146,
+			74, 31, 159, 152, 210, 41, 38, 216, 241, 35, 15, 241, 95, 181,
+			181, 101, 221, 73, 251, 33, 247, 103, 236, 172, 73, 28, 75, 30,
+			74, 94, 88, 143, 90, 205, 29, 185, 233, 135, 0, 245, 140, 182,
+			109, 121, 181, 45, 32, 138, 53, 10, 69, 146, 196, 92, 55, 200,
+			21, 109, 236, 236, 154, 4, 221, 130, 32, 54, 32, 171, 47, 224,
+			175, 182, 35, 25, 123, 181, 46, 68, 156, 112, 185, 17, 165, 91,
+			90, 209, 9, 0, 209, 171, 146, 45, 25, 46, 214, 254, 24, 141,
+			0, 139, 35, 219, 117, 218, 155, 77, 57, 211, 142, 163, 13, 111,
+			163, 185, 3, 97, 102, 114, 33, 94, 72, 215, 214, 82, 207, 24,
+			131, 182, 34, 196, 16, 87, 62, 209, 189, 219, 65, 104, 192, 13,
+			54, 122, 39, 73, 150, 175, 220, 153, 79, 150, 14, 229, 220, 153,
+			79, 186, 71, 12, 181, 241, 157, 69, 254, 204, 131, 83, 9, 74,
+			184, 169, 165, 106, 127, 73, 148, 198, 171, 124, 172, 235, 242, 120,
+			51, 84, 70, 153, 59, 208, 18, 185, 230, 163, 149, 122, 246, 8,
+			215, 42, 126, 123, 254, 127, 114, 20, 129, 33, 190, 206, 8, 140,
+			11, 138, 190, 24, 236, 19, 150, 251, 150, 238, 251, 23, 72, 11,
+			163, 133, 83, 17, 83, 137, 5, 71, 129, 146, 38, 211, 201, 4,
+			7, 14, 228, 96, 105, 138, 31, 210, 136, 99, 216, 62, 234, 114,
+			173, 167, 173, 231, 79, 241, 112, 215, 41, 30, 238, 58, 197, 195,
+			71, 12, 242, 1, 159, 118, 123, 210, 189, 75, 23, 7, 246, 12,
+			107, 65, 226, 183, 92, 16, 106, 44, 113, 51, 9, 94, 247, 53,
+			63, 26, 36, 185, 71, 255, 116, 28, 68, 138, 93, 139, 77, 232,
+			82, 217, 91, 131, 72, 130, 232, 96, 89, 164, 120, 206, 78, 67,
+			17, 6, 67, 150, 164, 120, 26, 198, 135, 181, 39, 59, 156, 134,
+			241, 137, 131, 230, 52, 252, 102, 133, 63, 253, 102, 119, 50, 6,
+			160, 202, 29, 133, 175, 208, 23, 228, 131, 69, 206, 81, 75, 3,
+			65, 152, 118, 196, 34, 31, 162, 83, 118, 3, 4, 178, 83, 214,
+			62, 190, 9, 84, 8, 28, 21, 196, 55, 242, 97, 93, 39, 246,
+			194, 77, 159, 108, 221, 143, 24, 91, 115, 108, 218, 176, 189, 80,
+			100, 85, 247, 130, 41, 12, 52, 226, 123, 113, 109, 139, 156, 49,
+			14, 246, 84, 93, 195, 143, 171, 84, 200, 125, 158, 15, 229, 27,
+			19, 19, 153, 211, 8, 120, 167, 144, 147, 200, 24, 103, 205, 104,
+			27, 143, 232, 240, 42, 252, 20, 130, 59, 91, 193, 230, 22, 249,
+			34, 224, 111, 247, 131, 14, 47, 170, 230, 33, 162, 78, 61, 2,
+			31, 76, 156, 245, 200, 226, 67, 123, 142, 162, 186, 140, 101, 86,
+			169, 172, 152, 230, 5, 36, 255, 40, 20, 209, 56, 84, 122, 33,
+			142, 64, 188, 180, 67, 49, 58, 240, 187, 120, 148, 51, 63, 172,
+			79, 57, 251, 21, 131, 175, 226, 26, 31, 65, 5, 242, 157, 27,
+			141, 160, 9, 86, 193, 83, 5, 132, 200, 204, 222, 99, 89, 194,
+			178, 207, 169, 162, 24, 72, 100, 117, 216, 203, 231, 137, 203, 124,
+			24, 111, 115, 211, 94, 145, 194, 206, 236, 217, 222, 69, 40, 218,
+			213, 220, 144, 159, 203, 114, 191, 137, 139, 221, 93, 238, 17, 187,
+			164, 210, 29, 187, 100, 18, 70, 127, 165, 211, 76, 131, 30, 200,
+			152, 232, 37, 238, 42, 31, 223, 213, 249, 30, 13, 79, 119, 55,
+			188, 23, 180, 77, 155, 101, 201, 139, 106, 177, 196, 0, 47, 188,
+			227, 250, 197, 181, 245, 177, 62, 49, 200, 251, 151, 214, 215, 47,
+			94, 121, 97, 125, 204, 82, 254, 31, 171, 253, 73, 20, 167, 55,
+			54, 118, 158, 255, 7, 39, 148, 155, 195, 183, 125, 61, 184, 57,
+			104, 135, 134, 82, 223, 32, 255, 14, 120, 128, 181, 79, 20, 134,
+			250, 190, 205, 178, 220, 247, 200, 108, 229, 187, 189, 177, 201, 117,
+			26, 113, 75, 22, 196, 15, 69, 88, 112, 247, 128, 249, 119, 38,
+			99, 219, 81, 130, 209, 42, 151, 43, 233, 52, 212, 214, 152, 59,
+			51, 106, 71, 196, 107, 120, 55, 240, 217, 201, 80, 255, 80, 73,
+			240, 255, 197, 16, 141, 99, 118, 25, 222, 133, 165, 115, 13, 120,
+			69, 51, 169, 32, 140, 76, 35, 178, 198, 132, 231, 4, 233, 229,
+			47, 18, 56, 213, 50, 3, 48, 47, 201, 25, 69, 112, 173, 168,
+			193, 201, 160, 248, 71, 219, 138, 19, 253, 73, 214, 118, 68, 85,
+			41, 163, 108, 185, 128, 228, 154, 126, 177, 20, 12, 32, 180, 42,
+			112, 218, 203, 219, 21, 155, 27, 5, 155, 156, 54, 104, 31, 110,
+			171, 49, 178, 215, 82, 52, 231, 24, 217, 107, 41, 154, 115, 76,
+			130, 153, 143, 227, 160, 75, 179, 176, 39, 41, 186, 12, 234, 215,
+			5, 7, 145, 125, 209, 33, 119, 102, 136, 193, 2, 42, 86, 237,
+			145, 124, 128, 76, 44, 180, 63, 242, 129, 193, 177, 44, 77, 145,
+			88, 116, 117, 112, 144, 112, 14, 152, 207, 64, 154, 77, 228, 170,
+			195, 117, 52, 65, 14, 234, 218, 11, 121, 98, 92, 152, 222, 109,
+			193, 14, 58, 19, 166, 119, 48, 45, 59, 152, 171, 14, 10, 216,
+			131, 228, 173, 173, 253, 120, 15, 138, 3, 252, 3, 134, 152, 62,
+			98, 63, 238, 254, 63, 150, 6, 151, 186, 23, 246, 90, 80, 15,
+			200, 234, 77, 191, 203, 212, 49, 8, 187, 118, 34, 216, 24, 74,
+			136, 73, 170, 249, 248, 103, 96, 145, 224, 230, 5, 4, 158, 203,
+			109, 70, 219, 20, 198, 18, 155, 12, 18, 249, 114, 51, 218, 174,
+			96, 177, 217, 170, 188, 132, 165, 33, 24, 198, 134, 143, 121, 126,
+			156, 69, 48, 196, 52, 236, 57, 53, 8, 213, 62, 118, 170, 110,
+			247, 102, 180, 157, 47, 14, 201, 36, 229, 186, 184, 156, 209, 150,
+			125, 11, 57, 151, 21, 71, 176, 35, 100, 16, 169, 200, 129, 35,
+			131, 134, 28, 6, 16, 77, 157, 208, 41, 112, 217, 152, 153, 229,
+			31, 29, 0, 240, 49, 75, 20, 46, 218, 223, 106, 49, 247, 219,
+			7, 228, 146, 84, 152, 185, 7, 118, 184, 167, 141, 170, 95, 189,
+			226, 2, 102, 71, 126, 156, 130, 228, 27, 149, 74, 185, 88, 182,
+			60, 99, 125, 233, 26, 168, 74, 66, 180, 24, 196, 196, 4, 18,
+			128, 242, 158, 172, 71, 233, 92, 221, 167, 135, 247, 101, 219, 75,
+			183, 170, 96, 82, 8, 54, 26, 30, 196, 100, 172, 200, 196, 163,
+			208, 209, 24, 241, 191, 222, 99, 145, 73, 227, 58, 203, 129, 39,
+			69, 105, 249, 76, 80, 63, 87, 247, 189, 250, 134, 239, 55, 102,
+			207, 146, 184, 156, 108, 193, 207, 201, 183, 154, 119, 109, 159, 145,
+			242, 81, 64, 198, 36, 186, 54, 38, 215, 213, 28, 227, 116, 67,
+			185, 241, 96, 92, 46, 121, 78, 185, 83, 239, 42, 15, 97, 16,
+			110, 208, 59, 243, 242, 156, 124, 67, 21, 144, 178, 124, 203, 223,
+			41, 159, 149, 101, 188, 36, 202, 21, 147, 221, 130, 203, 169, 124,
+			86, 190, 92, 6, 30, 189, 92, 145, 167, 22, 42, 84, 42, 41,
+			87, 80, 96, 252, 74, 86, 58, 233, 108, 64, 217, 55, 202, 173,
+			100, 179, 124, 86, 158, 58, 117, 183, 34, 77, 98, 241, 174, 46,
+			121, 23, 1, 64, 40, 45, 15, 130, 247, 156, 122, 112, 32, 208,
+			22, 83, 111, 209, 156, 35, 122, 27, 248, 98, 248, 168, 173, 62,
+			170, 248, 84, 47, 74, 2, 186, 155, 234, 41, 134, 96, 33, 194,
+			56, 15, 149, 56, 105, 166, 0, 150, 29, 63, 201, 1, 37, 186,
+			85, 62, 139, 83, 207, 205, 103, 93, 155, 185, 170, 7, 162, 212,
+			70, 4, 187, 186, 118, 226, 119, 234, 17, 24, 76, 167, 145, 154,
+			157, 34, 144, 206, 106, 156, 14, 89, 221, 180, 205, 89, 61, 130,
+			114, 126, 146, 0, 217, 178, 158, 102, 89, 195, 178, 124, 92, 161,
+			130, 125, 214, 184, 106, 22, 16, 199, 43, 79, 45, 60, 104, 197,
+			164, 179, 81, 85, 43, 247, 242, 169, 83, 93, 21, 247, 130, 90,
+			21, 97, 242, 114, 182, 31, 96, 141, 175, 197, 103, 249, 62, 19,
+			238, 162, 189, 178, 249, 62, 200, 100, 94, 62, 245, 10, 116, 245,
+			166, 102, 242, 242, 194, 43, 217, 100, 178, 1, 94, 14, 82, 63,
+			246, 154, 114, 186, 170, 132, 134, 211, 47, 79, 203, 218, 150, 23,
+			123, 53, 88, 6, 141, 227, 252, 164, 230, 181, 181, 24, 200, 147,
+			27, 94, 237, 86, 210, 244, 146, 45, 141, 209, 224, 21, 2, 118,
+			145, 195, 165, 3, 183, 132, 5, 92, 224, 115, 206, 74, 129, 174,
+			1, 11, 94, 76, 97, 207, 13, 64, 72, 241, 146, 74, 195, 45,
+			246, 182, 226, 24, 90, 18, 81, 134, 5, 57, 131, 249, 28, 91,
+			176, 183, 141, 228, 43, 129, 177, 120, 81, 228, 138, 64, 191, 151,
+			138, 195, 249, 28, 91, 176, 75, 99, 227, 252, 55, 32, 226, 67,
+			31, 152, 24, 9, 246, 130, 51, 233, 254, 130, 45, 21, 197, 151,
+			83, 60, 41, 125, 103, 173, 233, 129, 88, 89, 191, 73, 158, 228,
+			222, 142, 164, 240, 36, 96, 213, 162, 238, 15, 100, 8, 65, 21,
+			116, 219, 11, 154, 250, 101, 36, 42, 26, 251, 218, 2, 192, 104,
+			54, 129, 195, 52, 92, 100, 184, 91, 97, 200, 179, 216, 223, 198,
+			146, 13, 4, 213, 100, 230, 15, 198, 61, 242, 38, 237, 153, 155,
+			244, 68, 42, 18, 55, 94, 147, 90, 61, 78, 34, 80, 196, 243,
+			73, 103, 35, 123, 63, 153, 227, 20, 2, 10, 18, 210, 43, 188,
+			219, 214, 104, 218, 147, 138, 117, 130, 50, 158, 214, 25, 43, 64,
+			129, 6, 43, 33, 99, 206, 62, 50, 230, 122, 193, 220, 245, 248,
+			238, 15, 123, 97, 112, 60, 75, 51, 193, 94, 152, 56, 200, 255,
+			53, 88, 94, 246, 49, 139, 11, 118, 221, 57, 228, 254, 150, 37,
+			23, 101, 144, 17, 191, 244, 72, 22, 210, 213, 120, 123, 160, 177,
+			30, 216, 18, 239, 100, 246, 209, 88, 0, 224, 88, 38, 60, 88,
+			166, 24, 25, 129, 137, 65, 157, 119, 121, 34, 19, 65, 163, 45,
+			155, 143, 98, 205, 229, 211, 25, 72, 186, 227, 170, 115, 35, 182,
+			74, 193, 164, 10, 168, 103, 31, 108, 157, 164, 7, 231, 49, 4,
+			170, 22, 70, 48, 135, 113, 112, 52, 77, 231, 183, 18, 191, 121,
+			27, 223, 103, 85, 228, 144, 197, 251, 4, 187, 62, 76, 177, 230,
+			208, 214, 244, 157, 206, 40, 209, 74, 104, 229, 249, 78, 178, 234,
+			196, 164, 213, 147, 182, 85, 250, 119, 45, 218, 170, 150, 96, 47,
+			59, 15, 187, 191, 110, 201, 53, 224, 11, 241, 64, 94, 36, 131,
+			176, 8, 205, 35, 209, 118, 4, 238, 187, 26, 197, 206, 166, 57,
+			5, 113, 2, 50, 228, 56, 213, 119, 253, 14, 221, 203, 97, 180,
+			141, 186, 3, 176, 66, 141, 125, 249, 110, 32, 137, 246, 106, 97,
+			154, 192, 60, 45, 205, 13, 129, 147, 86, 175, 178, 231, 92, 21,
+			100, 246, 126, 88, 133, 8, 126, 88, 51, 106, 70, 145, 36, 234,
+			160, 229, 118, 14, 144, 56, 47, 27, 170, 81, 57, 241, 188, 124,
+			208, 205, 210, 76, 176, 151, 143, 30, 211, 128, 179, 109, 193, 190,
+			217, 57, 170, 1, 7, 239, 102, 176, 111, 206, 85, 7, 34, 243,
+			155, 15, 78, 101, 105, 38, 216, 55, 31, 121, 136, 255, 161, 67,
+			245, 33, 178, 135, 115, 214, 253, 93, 71, 118, 241, 164, 61, 164,
+			146, 194, 189, 0, 129, 105, 117, 251, 4, 45, 47, 245, 167, 233,
+			144, 87, 169, 46, 102, 114, 202, 68, 32, 2, 44, 0, 41, 35,
+			253, 163, 133, 208, 72, 16, 192, 11, 178, 202, 214, 1, 44, 131,
+			48, 70, 46, 58, 245, 34, 42, 79, 122, 73, 165, 160, 161, 80,
+			141, 214, 195, 203, 102, 132, 202, 100, 80, 107, 169, 107, 67, 95,
+			10, 72, 121, 192, 229, 7, 172, 78, 25, 40, 138, 168, 1, 152,
+			188, 12, 8, 166, 92, 145, 101, 47, 172, 195, 63, 73, 218, 105,
+			52, 202, 175, 16, 153, 97, 46, 229, 30, 175, 152, 108, 90, 154,
+			230, 163, 8, 51, 72, 28, 226, 5, 173, 104, 29, 121, 78, 119,
+			249, 138, 201, 172, 70, 13, 149, 143, 93, 239, 145, 15, 67, 217,
+			35, 155, 134, 182, 223, 135, 138, 188, 119, 197, 108, 142, 212, 47,
+			239, 245, 178, 129, 221, 170, 166, 3, 147, 212, 48, 77, 204, 35,
+			1, 192, 30, 2, 59, 29, 53, 114, 158, 213, 28, 176, 130, 246,
+			231, 139, 97, 173, 240, 103, 144, 128, 141, 34, 40, 46, 67, 100,
+			250, 244, 243, 135, 24, 126, 49, 206, 109, 108, 112, 102, 106, 59,
+			143, 101, 105, 136, 62, 51, 253, 100, 150, 134, 157, 248, 13, 103,
+			248, 183, 232, 157, 9, 79, 16, 90, 206, 19, 238, 151, 152, 204,
+			11, 53, 246, 223, 153, 62, 148, 202, 246, 36, 86, 234, 217, 140,
+			124, 223, 221, 168, 156, 2, 0, 30, 123, 238, 69, 189, 21, 245,
+			78, 228, 255, 127, 111, 69, 159, 102, 115, 143, 77, 248, 242, 194,
+			43, 176, 15, 212, 54, 204, 114, 79, 189, 82, 141, 26, 250, 27,
+			118, 221, 251, 237, 148, 250, 6, 195, 233, 253, 180, 168, 62, 169,
+			157, 245, 181, 221, 73, 106, 199, 232, 23, 189, 251, 232, 13, 230,
+			247, 91, 206, 35, 89, 6, 188, 245, 104, 149, 231, 179, 12, 6,
+			25, 139, 167, 149, 52, 0, 30, 141, 250, 128, 101, 31, 69, 235,
+			140, 62, 124, 201, 232, 3, 218, 0, 14, 253, 253, 157, 15, 104,
+			3, 56, 116, 248, 119, 62, 96, 137, 41, 157, 100, 80, 247, 200,
+			67, 70, 156, 252, 115, 103, 249, 155, 80, 140, 108, 123, 205, 91,
+			55, 148, 36, 39, 147, 38, 31, 235, 141, 112, 95, 167, 72, 14,
+			20, 121, 232, 225, 222, 239, 230, 66, 161, 2, 95, 133, 102, 198,
+			253, 106, 100, 225, 95, 105, 76, 164, 143, 149, 248, 80, 94, 80,
+			245, 192, 170, 32, 21, 177, 40, 222, 33, 137, 228, 72, 183, 160,
+			118, 85, 125, 20, 179, 220, 105, 69, 117, 159, 132, 196, 40, 223,
+			206, 247, 86, 189, 18, 213, 253, 85, 44, 34, 42, 58, 98, 143,
+			147, 201, 78, 187, 202, 118, 197, 234, 89, 204, 98, 245, 168, 56,
+			66, 83, 187, 202, 147, 221, 153, 137, 210, 35, 22, 121, 191, 127,
+			7, 127, 78, 21, 247, 169, 115, 241, 14, 213, 161, 130, 238, 119,
+			90, 220, 129, 65, 130, 76, 182, 222, 72, 40, 216, 13, 171, 55,
+			18, 113, 142, 15, 212, 3, 80, 156, 67, 92, 34, 128, 194, 200,
+			226, 195, 187, 26, 132, 186, 213, 101, 93, 108, 53, 171, 81, 94,
+			228, 3, 38, 95, 12, 241, 210, 115, 215, 86, 95, 92, 90, 93,
+			94, 27, 235, 19, 195, 124, 224, 252, 210, 133, 183, 171, 164, 37,
+			74, 220, 57, 127, 109, 253, 210, 152, 237, 190, 87, 199, 25, 58,
+			194, 7, 48, 206, 144, 223, 166, 165, 98, 171, 37, 136, 49, 4,
+			105, 241, 4, 135, 223, 55, 96, 107, 210, 234, 28, 222, 245, 154,
+			193, 50, 237, 235, 213, 254, 150, 122, 103, 227, 65, 66, 23, 185,
+			63, 200, 178, 112, 69, 79, 229, 181, 15, 131, 139, 114, 63, 248,
+			107, 111, 22, 173, 159, 56, 155, 133, 57, 178, 31, 176, 166, 174,
+			32, 158, 225, 3, 70, 191, 60, 197, 30, 176, 118, 86, 69, 156,
+			224, 35, 97, 167, 149, 25, 111, 37, 184, 217, 134, 87, 135, 195,
+			78, 203, 236, 242, 68, 28, 230, 165, 198, 118, 29, 160, 155, 96,
+			0, 161, 210, 106, 127, 67, 189, 110, 45, 142, 240, 1, 96, 16,
+			213, 183, 34, 126, 43, 65, 6, 124, 116, 239, 102, 241, 151, 198,
+			56, 11, 234, 102, 187, 4, 245, 68, 136, 156, 238, 180, 164, 116,
+			165, 251, 69, 105, 194, 240, 74, 27, 74, 225, 134, 35, 44, 173,
+			154, 52, 196, 42, 67, 169, 135, 95, 215, 99, 163, 164, 123, 149,
+			247, 211, 254, 133, 134, 17, 218, 48, 2, 54, 51, 176, 74, 41,
+			113, 146, 151, 8, 150, 201, 148, 189, 183, 142, 203, 20, 120, 254,
+			243, 243, 74, 45, 240, 145, 175, 7, 181, 192, 76, 22, 253, 104,
+			86, 71, 63, 26, 160, 64, 72, 12, 194, 31, 93, 196, 159, 142,
+			96, 131, 70, 133, 48, 220, 55, 200, 127, 203, 86, 42, 132, 201,
+			190, 143, 88, 150, 251, 107, 118, 102, 146, 181, 59, 148, 12, 92,
+			41, 89, 220, 23, 45, 176, 39, 75, 225, 116, 43, 142, 58, 155,
+			104, 181, 16, 196, 198, 116, 115, 26, 217, 175, 109, 120, 52, 212,
+			40, 24, 2, 100, 194, 224, 230, 6, 41, 99, 88, 111, 130, 193,
+			37, 24, 227, 248, 64, 249, 0, 247, 150, 243, 199, 160, 167, 135,
+			42, 153, 37, 36, 100, 183, 0, 28, 64, 9, 54, 155, 126, 179,
+			202, 129, 54, 151, 240, 176, 180, 145, 220, 234, 208, 41, 178, 29,
+			71, 245, 78, 45, 99, 235, 161, 189, 157, 204, 1, 242, 181, 142,
+			223, 129, 48, 38, 48, 53, 158, 217, 221, 16, 207, 136, 175, 31,
+			215, 253, 10, 44, 50, 88, 76, 83, 239, 50, 34, 7, 54, 112,
+			248, 156, 49, 136, 138, 92, 80, 114, 186, 144, 201, 210, 4, 95,
+			214, 170, 144, 41, 251, 168, 251, 22, 121, 141, 88, 193, 170, 92,
+			243, 125, 169, 207, 114, 183, 237, 48, 116, 158, 231, 243, 140, 236,
+			25, 152, 248, 169, 46, 157, 249, 84, 151, 206, 124, 234, 200, 67,
+			252, 111, 49, 173, 51, 151, 246, 33, 247, 35, 12, 196, 2, 241,
+			78, 46, 162, 145, 166, 115, 26, 230, 89, 39, 120, 187, 7, 121,
+			214, 204, 110, 61, 141, 61, 136, 167, 228, 53, 37, 217, 41, 241,
+			92, 22, 242, 248, 81, 173, 214, 137, 193, 164, 199, 147, 157, 176,
+			219, 207, 61, 222, 33, 254, 61, 1, 131, 174, 122, 39, 172, 123,
+			97, 154, 123, 47, 40, 179, 130, 3, 217, 186, 122, 115, 93, 71,
+			71, 240, 154, 114, 203, 247, 110, 239, 160, 9, 238, 211, 74, 178,
+			64, 99, 10, 225, 44, 65, 223, 40, 234, 111, 199, 17, 152, 20,
+			98, 24, 23, 175, 150, 162, 249, 74, 13, 151, 106, 211, 139, 235,
+			96, 29, 14, 83, 132, 71, 1, 91, 192, 207, 193, 109, 129, 145,
+			81, 148, 213, 189, 150, 40, 240, 222, 241, 102, 174, 136, 52, 240,
+			154, 14, 90, 98, 192, 5, 67, 160, 81, 3, 192, 128, 108, 1,
+			37, 66, 196, 201, 226, 10, 77, 234, 234, 178, 30, 36, 53, 216,
+			247, 168, 213, 10, 66, 176, 112, 195, 43, 201, 172, 38, 48, 214,
+			178, 203, 148, 64, 82, 160, 104, 165, 59, 144, 7, 39, 51, 157,
+			82, 217, 62, 149, 215, 41, 149, 249, 8, 255, 49, 155, 212, 58,
+			125, 130, 77, 59, 227, 238, 223, 180, 229, 242, 115, 107, 224, 76,
+			133, 140, 19, 240, 254, 198, 240, 216, 147, 120, 119, 206, 41, 97,
+			195, 76, 144, 108, 205, 2, 165, 235, 201, 243, 96, 163, 214, 243,
+			1, 133, 149, 92, 174, 5, 97, 205, 39, 189, 136, 87, 207, 29,
+			14, 140, 71, 209, 76, 131, 185, 116, 11, 106, 107, 11, 71, 146,
+			69, 41, 250, 58, 72, 228, 6, 154, 131, 251, 141, 70, 20, 147,
+			31, 52, 66, 78, 69, 72, 104, 162, 254, 5, 184, 76, 185, 181,
+			179, 17, 7, 117, 185, 225, 167, 219, 96, 55, 9, 115, 0, 225,
+			201, 249, 231, 214, 40, 26, 67, 226, 167, 112, 240, 33, 12, 75,
+			152, 198, 81, 83, 105, 248, 54, 2, 136, 236, 161, 73, 12, 189,
+			253, 168, 49, 24, 49, 156, 86, 175, 185, 25, 197, 65, 186, 213,
+			50, 124, 159, 82, 186, 77, 59, 165, 44, 109, 9, 54, 61, 48,
+			148, 165, 153, 96, 211, 163, 99, 188, 166, 192, 11, 242, 208, 147,
+			206, 124, 193, 93, 147, 134, 160, 201, 201, 33, 81, 14, 213, 53,
+			140, 60, 162, 203, 108, 103, 181, 193, 163, 14, 103, 1, 24, 39,
+			27, 20, 162, 139, 147, 3, 130, 228, 165, 125, 112, 160, 5, 171,
+			20, 15, 144, 116, 180, 143, 206, 123, 165, 56, 146, 207, 177, 5,
+			171, 140, 231, 43, 89, 130, 205, 21, 39, 114, 69, 64, 90, 51,
+			87, 28, 205, 231, 216, 130, 205, 145, 135, 171, 170, 100, 11, 86,
+			237, 42, 2, 50, 154, 106, 145, 231, 115, 160, 204, 240, 72, 94,
+			17, 185, 224, 60, 100, 64, 6, 91, 121, 193, 25, 206, 210, 150,
+			96, 11, 35, 135, 178, 52, 19, 108, 193, 61, 66, 143, 53, 216,
+			130, 45, 26, 93, 42, 200, 135, 22, 237, 162, 78, 89, 130, 45,
+			246, 15, 234, 20, 19, 108, 113, 100, 148, 78, 129, 37, 156, 39,
+			236, 203, 250, 20, 192, 196, 158, 224, 163, 252, 87, 180, 0, 174,
+			79, 176, 51, 206, 148, 251, 147, 22, 70, 15, 128, 221, 158, 25,
+			45, 145, 22, 56, 175, 142, 4, 209, 143, 194, 104, 254, 211, 114,
+			65, 43, 131, 131, 86, 203, 175, 7, 32, 73, 226, 114, 38, 140,
+			186, 46, 173, 217, 138, 156, 59, 165, 11, 134, 145, 68, 114, 126,
+			90, 233, 20, 159, 89, 208, 161, 77, 90, 1, 68, 249, 32, 23,
+			161, 77, 47, 13, 110, 19, 71, 15, 231, 33, 168, 251, 9, 52,
+			2, 101, 67, 229, 94, 101, 246, 128, 133, 27, 243, 140, 51, 144,
+			165, 33, 156, 62, 215, 234, 94, 10, 168, 63, 121, 136, 255, 11,
+			45, 30, 183, 32, 44, 252, 227, 238, 167, 108, 73, 175, 201, 221,
+			43, 88, 2, 96, 192, 108, 59, 2, 50, 219, 209, 72, 52, 138,
+			205, 182, 4, 189, 33, 74, 207, 154, 26, 81, 107, 138, 166, 238,
+			123, 245, 38, 216, 67, 6, 137, 156, 134, 179, 77, 71, 123, 186,
+			66, 190, 71, 218, 181, 150, 26, 87, 182, 200, 208, 158, 244, 32,
+			2, 179, 246, 173, 32, 109, 43, 248, 107, 4, 45, 95, 201, 219,
+			116, 44, 4, 125, 125, 167, 145, 182, 123, 38, 147, 84, 185, 190,
+			255, 51, 117, 155, 113, 187, 102, 218, 130, 64, 131, 16, 216, 66,
+			13, 137, 162, 154, 128, 164, 124, 203, 139, 235, 60, 155, 4, 9,
+			133, 245, 177, 204, 173, 2, 236, 229, 37, 179, 183, 149, 188, 115,
+			233, 232, 137, 44, 13, 1, 247, 103, 102, 249, 175, 48, 90, 5,
+			91, 176, 183, 59, 71, 221, 159, 100, 95, 203, 176, 21, 136, 60,
+			144, 155, 174, 236, 10, 101, 161, 114, 149, 228, 156, 239, 250, 74,
+			162, 116, 144, 55, 237, 19, 233, 226, 255, 35, 239, 106, 126, 219,
+			56, 174, 56, 119, 103, 185, 145, 134, 242, 135, 134, 31, 146, 199,
+			181, 180, 9, 80, 40, 105, 237, 20, 73, 138, 164, 104, 81, 196,
+			180, 41, 215, 140, 36, 219, 145, 141, 194, 169, 15, 14, 77, 173,
+			45, 66, 52, 73, 115, 197, 184, 68, 15, 62, 57, 104, 96, 20,
+			72, 123, 104, 79, 69, 207, 69, 209, 83, 47, 61, 245, 212, 127,
+			163, 64, 207, 5, 138, 30, 122, 236, 165, 248, 205, 188, 55, 59,
+			75, 58, 178, 17, 229, 210, 230, 198, 153, 229, 238, 206, 190, 153,
+			121, 239, 205, 251, 248, 61, 70, 186, 0, 91, 149, 95, 55, 164,
+			11, 51, 131, 101, 76, 161, 215, 14, 148, 216, 170, 20, 109, 212,
+			91, 132, 116, 97, 192, 204, 119, 8, 233, 162, 100, 140, 136, 59,
+			46, 58, 22, 10, 248, 14, 33, 93, 88, 0, 243, 29, 3, 237,
+			0, 246, 21, 170, 242, 245, 240, 211, 128, 249, 23, 88, 232, 117,
+			185, 76, 94, 55, 4, 100, 70, 55, 162, 33, 123, 221, 108, 89,
+			131, 27, 75, 85, 249, 47, 32, 200, 151, 168, 86, 187, 184, 29,
+			87, 245, 223, 2, 120, 249, 73, 10, 110, 244, 246, 200, 192, 232,
+			19, 177, 144, 106, 196, 223, 159, 135, 50, 22, 202, 63, 166, 227,
+			116, 35, 75, 210, 129, 209, 222, 61, 61, 197, 72, 219, 244, 167,
+			36, 172, 15, 135, 132, 80, 69, 14, 137, 222, 94, 38, 217, 113,
+			192, 33, 236, 61, 168, 202, 48, 161, 227, 65, 238, 248, 2, 21,
+			127, 138, 227, 131, 171, 109, 64, 26, 19, 169, 230, 198, 63, 241,
+			208, 44, 167, 233, 112, 66, 32, 6, 244, 193, 101, 37, 110, 59,
+			57, 196, 37, 24, 110, 87, 88, 6, 114, 17, 134, 219, 166, 34,
+			5, 147, 9, 158, 152, 184, 166, 223, 242, 96, 20, 124, 1, 60,
+			131, 160, 208, 114, 81, 160, 254, 123, 16, 89, 115, 167, 240, 102,
+			176, 129, 59, 21, 150, 146, 92, 204, 224, 142, 170, 202, 223, 11,
+			55, 67, 161, 18, 251, 113, 67, 255, 70, 124, 121, 8, 7, 206,
+			42, 161, 225, 218, 168, 37, 31, 193, 207, 229, 160, 163, 34, 207,
+			240, 65, 175, 107, 39, 24, 216, 133, 254, 115, 93, 17, 83, 170,
+			125, 234, 238, 223, 112, 225, 178, 36, 221, 128, 123, 152, 128, 7,
+			152, 60, 130, 199, 189, 44, 245, 86, 141, 76, 238, 245, 59, 131,
+			131, 249, 129, 109, 100, 9, 85, 14, 134, 132, 163, 119, 129, 19,
+			209, 150, 191, 151, 218, 227, 33, 216, 189, 36, 185, 88, 48, 192,
+			112, 113, 224, 243, 244, 212, 135, 148, 59, 64, 170, 184, 151, 50,
+			74, 71, 74, 42, 71, 250, 38, 17, 140, 234, 187, 182, 154, 183,
+			154, 119, 111, 182, 127, 178, 121, 119, 187, 189, 211, 190, 133, 129,
+			94, 53, 105, 56, 16, 79, 112, 10, 98, 97, 217, 51, 106, 97,
+			109, 133, 101, 204, 85, 161, 39, 80, 98, 191, 178, 236, 247, 8,
+			37, 246, 107, 117, 249, 145, 155, 96, 128, 223, 199, 171, 250, 42,
+			102, 201, 43, 48, 123, 222, 77, 46, 37, 217, 36, 108, 207, 72,
+			168, 150, 107, 126, 230, 241, 184, 96, 97, 64, 40, 228, 117, 80,
+			24, 16, 184, 201, 65, 133, 85, 192, 144, 74, 34, 28, 52, 86,
+			100, 203, 13, 40, 82, 98, 16, 175, 232, 119, 230, 6, 68, 86,
+			19, 111, 96, 71, 188, 27, 213, 192, 6, 133, 119, 163, 220, 205,
+			160, 194, 238, 122, 188, 27, 85, 193, 6, 117, 14, 101, 51, 21,
+			3, 70, 81, 131, 249, 149, 201, 202, 24, 17, 90, 136, 185, 12,
+			111, 202, 18, 59, 152, 13, 138, 129, 24, 213, 234, 238, 246, 64,
+			137, 71, 4, 54, 130, 203, 144, 186, 143, 188, 219, 177, 221, 30,
+			17, 216, 136, 185, 46, 148, 120, 212, 88, 113, 183, 135, 74, 140,
+			169, 184, 1, 46, 67, 139, 28, 123, 183, 131, 187, 142, 151, 56,
+			208, 206, 100, 199, 139, 241, 234, 25, 249, 235, 144, 238, 23, 74,
+			76, 163, 53, 253, 169, 151, 25, 226, 42, 71, 231, 218, 162, 119,
+			205, 219, 181, 163, 212, 185, 81, 157, 244, 145, 36, 178, 230, 179,
+			79, 9, 153, 164, 200, 132, 189, 202, 231, 88, 177, 254, 24, 104,
+			126, 140, 183, 210, 24, 91, 16, 196, 150, 0, 217, 0, 209, 117,
+			195, 199, 248, 105, 138, 81, 208, 177, 136, 116, 169, 97, 242, 241,
+			91, 31, 75, 218, 124, 164, 60, 97, 99, 109, 55, 111, 222, 242,
+			6, 99, 208, 205, 230, 226, 230, 89, 244, 153, 164, 124, 49, 117,
+			162, 48, 52, 78, 177, 105, 229, 76, 222, 6, 225, 190, 113, 78,
+			62, 99, 66, 70, 74, 60, 137, 26, 250, 63, 65, 114, 197, 218,
+			16, 147, 158, 99, 125, 206, 150, 228, 243, 188, 57, 123, 208, 212,
+			151, 58, 50, 135, 164, 38, 221, 192, 170, 126, 134, 189, 117, 247,
+			59, 131, 7, 4, 202, 108, 171, 101, 88, 44, 99, 95, 106, 241,
+			185, 222, 222, 253, 3, 102, 127, 20, 251, 154, 153, 115, 23, 168,
+			198, 195, 232, 20, 120, 33, 3, 151, 26, 217, 8, 14, 36, 73,
+			119, 177, 230, 14, 224, 182, 36, 189, 25, 33, 235, 20, 137, 208,
+			236, 163, 39, 238, 104, 105, 242, 175, 197, 147, 197, 124, 19, 96,
+			15, 61, 169, 213, 17, 81, 105, 169, 87, 86, 209, 211, 32, 90,
+			209, 255, 14, 146, 75, 100, 102, 125, 17, 253, 240, 53, 48, 201,
+			194, 168, 230, 135, 237, 74, 159, 12, 95, 142, 106, 76, 52, 249,
+			133, 84, 227, 23, 191, 136, 108, 150, 106, 242, 5, 100, 59, 69,
+			100, 67, 78, 243, 211, 32, 90, 200, 59, 2, 116, 44, 170, 188,
+			67, 160, 163, 222, 144, 79, 3, 170, 101, 24, 125, 22, 132, 13,
+			253, 152, 77, 104, 51, 166, 202, 206, 222, 158, 3, 220, 236, 244,
+			11, 159, 74, 94, 86, 214, 178, 253, 48, 30, 123, 104, 0, 23,
+			152, 142, 168, 174, 53, 142, 131, 147, 135, 23, 14, 135, 23, 250,
+			157, 241, 3, 155, 160, 191, 71, 201, 216, 37, 148, 8, 195, 56,
+			22, 185, 25, 168, 232, 179, 64, 178, 67, 47, 18, 104, 214, 192,
+			133, 160, 0, 10, 21, 63, 11, 194, 95, 6, 194, 94, 22, 192,
+			62, 123, 134, 127, 223, 48, 91, 9, 69, 83, 162, 95, 4, 209,
+			186, 190, 152, 180, 108, 201, 108, 158, 242, 61, 87, 139, 191, 232,
+			130, 37, 32, 151, 124, 242, 24, 223, 199, 210, 81, 0, 113, 22,
+			143, 92, 202, 59, 202, 128, 150, 57, 81, 205, 59, 12, 214, 76,
+			77, 231, 29, 2, 29, 231, 214, 228, 46, 141, 42, 80, 209, 231,
+			65, 148, 232, 75, 47, 59, 42, 90, 26, 71, 141, 43, 136, 205,
+			67, 121, 126, 5, 14, 89, 209, 231, 65, 245, 108, 222, 33, 240,
+			143, 181, 117, 242, 163, 150, 129, 253, 18, 54, 136, 176, 101, 3,
+			20, 227, 200, 142, 28, 248, 95, 229, 100, 47, 27, 160, 24, 47,
+			15, 231, 79, 21, 249, 254, 203, 187, 15, 187, 157, 65, 55, 237,
+			115, 86, 26, 57, 18, 143, 147, 139, 51, 150, 203, 151, 205, 51,
+			137, 219, 102, 72, 73, 243, 61, 21, 193, 11, 60, 21, 112, 119,
+			140, 211, 78, 70, 126, 184, 197, 93, 106, 193, 87, 210, 237, 100,
+			221, 14, 121, 32, 23, 118, 185, 249, 193, 159, 23, 109, 150, 90,
+			114, 164, 107, 227, 189, 255, 185, 140, 135, 127, 90, 119, 69, 164,
+			74, 73, 160, 255, 30, 38, 115, 132, 157, 225, 3, 118, 50, 11,
+			254, 10, 254, 51, 228, 174, 189, 189, 159, 238, 177, 36, 204, 146,
+			135, 195, 79, 168, 224, 127, 154, 52, 47, 93, 187, 190, 187, 211,
+			220, 190, 123, 165, 125, 173, 125, 243, 234, 102, 139, 114, 38, 72,
+			201, 147, 73, 147, 180, 188, 43, 70, 199, 55, 87, 39, 25, 8,
+			117, 185, 121, 237, 242, 230, 246, 246, 102, 203, 40, 8, 121, 142,
+			28, 197, 1, 186, 252, 124, 78, 46, 245, 84, 128, 28, 165, 189,
+			147, 29, 20, 211, 97, 55, 178, 66, 170, 105, 254, 125, 100, 115,
+			154, 71, 21, 230, 28, 100, 119, 8, 121, 221, 14, 24, 230, 242,
+			177, 83, 82, 145, 31, 138, 127, 247, 211, 251, 135, 50, 153, 12,
+			14, 135, 147, 238, 126, 186, 231, 121, 50, 212, 194, 25, 249, 115,
+			151, 213, 81, 15, 207, 234, 159, 229, 175, 35, 181, 137, 221, 10,
+			174, 223, 141, 239, 205, 100, 211, 216, 241, 61, 60, 37, 31, 179,
+			23, 17, 232, 163, 126, 175, 219, 3, 65, 8, 28, 120, 54, 131,
+			195, 127, 154, 239, 14, 169, 135, 124, 218, 198, 209, 176, 126, 146,
+			194, 249, 192, 198, 68, 253, 140, 150, 127, 112, 41, 132, 58, 172,
+			233, 223, 6, 201, 174, 217, 72, 88, 184, 132, 155, 126, 193, 129,
+			175, 97, 20, 29, 82, 213, 135, 247, 1, 134, 67, 135, 93, 247,
+			69, 143, 83, 196, 102, 118, 121, 213, 0, 3, 106, 3, 251, 164,
+			11, 163, 183, 91, 200, 51, 171, 98, 120, 223, 250, 166, 220, 109,
+			76, 33, 11, 74, 235, 102, 55, 119, 12, 148, 149, 208, 133, 170,
+			24, 154, 234, 54, 218, 67, 167, 86, 85, 249, 71, 87, 187, 98,
+			61, 172, 234, 223, 5, 201, 101, 187, 243, 77, 100, 26, 226, 152,
+			207, 211, 26, 114, 66, 16, 250, 147, 25, 129, 25, 163, 47, 183,
+			201, 118, 46, 231, 71, 152, 193, 159, 210, 157, 140, 179, 222, 39,
+			41, 208, 207, 58, 153, 177, 226, 193, 237, 51, 24, 82, 202, 251,
+			100, 224, 22, 151, 251, 46, 239, 96, 137, 195, 189, 251, 50, 28,
+			186, 214, 11, 198, 222, 245, 87, 184, 234, 48, 84, 244, 245, 101,
+			229, 184, 246, 63, 78, 200, 247, 94, 158, 225, 210, 79, 143, 91,
+			159, 157, 141, 101, 1, 201, 167, 95, 65, 28, 203, 241, 11, 141,
+			233, 175, 0, 146, 69, 31, 51, 209, 90, 31, 35, 150, 72, 31,
+			87, 156, 190, 253, 151, 80, 70, 56, 44, 168, 166, 60, 53, 83,
+			194, 68, 53, 158, 95, 23, 74, 63, 183, 63, 27, 169, 77, 185,
+			204, 137, 243, 110, 47, 41, 19, 47, 51, 215, 109, 31, 51, 27,
+			44, 178, 137, 133, 161, 126, 40, 79, 20, 178, 158, 149, 169, 251,
+			85, 232, 58, 234, 246, 243, 114, 209, 169, 235, 234, 244, 108, 212,
+			134, 46, 150, 104, 82, 239, 203, 147, 69, 209, 165, 76, 240, 208,
+			156, 56, 251, 162, 215, 125, 240, 215, 87, 172, 128, 127, 237, 255,
+			32, 118, 225, 155, 28, 187, 176, 144, 87, 110, 146, 165, 45, 142,
+			93, 168, 148, 182, 57, 118, 97, 169, 116, 213, 252, 44, 43, 113,
+			130, 254, 27, 43, 113, 178, 212, 38, 21, 225, 116, 169, 34, 223,
+			144, 97, 92, 82, 81, 181, 244, 90, 160, 207, 217, 220, 198, 22,
+			51, 187, 105, 114, 211, 218, 30, 155, 55, 218, 86, 186, 197, 16,
+			27, 213, 133, 37, 249, 161, 140, 98, 18, 110, 59, 186, 117, 244,
+			113, 2, 59, 153, 117, 4, 18, 229, 207, 201, 159, 4, 95, 139,
+			73, 46, 197, 117, 110, 133, 74, 212, 27, 223, 230, 22, 164, 212,
+			187, 91, 168, 64, 21, 27, 33, 181, 26, 126, 168, 47, 130, 232,
+			132, 106, 115, 111, 154, 203, 7, 178, 179, 102, 5, 4, 159, 60,
+			176, 221, 132, 47, 39, 173, 29, 247, 98, 152, 80, 86, 227, 21,
+			110, 133, 74, 172, 174, 126, 135, 91, 136, 22, 248, 254, 117, 249,
+			99, 243, 226, 16, 41, 109, 109, 221, 126, 137, 23, 179, 227, 147,
+			18, 16, 114, 197, 166, 151, 91, 58, 221, 8, 240, 69, 103, 227,
+			42, 183, 240, 154, 218, 6, 183, 144, 29, 247, 246, 143, 228, 71,
+			102, 4, 66, 137, 181, 240, 123, 122, 27, 250, 80, 198, 158, 118,
+			170, 31, 210, 233, 31, 0, 177, 107, 56, 120, 80, 148, 92, 68,
+			232, 252, 8, 82, 116, 229, 243, 32, 176, 176, 214, 226, 83, 220,
+			10, 149, 88, 59, 189, 206, 45, 188, 247, 91, 239, 18, 253, 35,
+			37, 94, 13, 183, 244, 69, 146, 131, 206, 60, 146, 37, 175, 99,
+			32, 28, 197, 15, 107, 56, 44, 146, 201, 100, 48, 72, 83, 200,
+			125, 55, 164, 94, 154, 81, 166, 32, 30, 135, 50, 237, 113, 141,
+			91, 161, 18, 175, 214, 223, 224, 22, 10, 182, 127, 183, 125, 47,
+			30, 141, 135, 135, 195, 119, 254, 59, 0, 132, 151, 207, 81, 129,
+			25, 1, 0},
 	)
 }
 
@@ -8,6 +8,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"regexp"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/luci/luci-go/common/data/text/templateproto"
@@ -17,7 +18,26 @@ import (
 // IsEmpty returns true if this metadata retry message only contains
 // zero-values.
 func (q *Quest_Desc_Meta_Retry) IsEmpty() bool {
-	return q.Crashed == 0 && q.Expired == 0 && q.Failed == 0 && q.TimedOut == 0
+	return (q.Crashed == 0 && q.Expired == 0 && q.Failed == 0 && q.TimedOut == 0 &&
+		google.DurationFromProto(q.Delay) == 0 && google.DurationFromProto(q.MaxDelay) == 0 &&
+		q.ReasonRegex == "")
+}
+
+// Normalize ensures that all retry delays are >= 0 and that the reason regex
+// compiles.
+func (q *Quest_Desc_Meta_Retry) Normalize() error {
+	if d := google.DurationFromProto(q.Delay); d < 0 {
+		return fmt.Errorf("desc.meta.retry.delay < 0: %s", d)
+	}
+	if d := google.DurationFromProto(q.MaxDelay); d < 0 {
+		return fmt.Errorf("desc.meta.retry.max_delay < 0: %s", d)
+	}
+	if q.ReasonRegex != "" {
+		if _, err := regexp.Compile(q.ReasonRegex); err != nil {
+			return fmt.Errorf("desc.meta.retry.reason_regex is invalid: %s", err)
+		}
+	}
+	return nil
 }
 
 // Normalize ensures that all timeouts are >= 0
@@ -64,6 +84,9 @@ func (q *Quest_Desc) Normalize() error {
 			q.Meta.Timeouts = &Quest_Desc_Meta_Timeouts{}
 		}
 	}
+	if err := q.Meta.Retry.Normalize(); err != nil {
+		return err
+	}
 	if err := q.Meta.Timeouts.Normalize(); err != nil {
 		return err
 	}
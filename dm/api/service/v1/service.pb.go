@@ -39,6 +39,8 @@ type DepsClient interface {
 	FinishAttempt(ctx context.Context, in *FinishAttemptReq, opts ...grpc.CallOption) (*google_protobuf2.Empty, error)
 	// runs queries, and walks along the dependency graph from the query results.
 	WalkGraph(ctx context.Context, in *WalkGraphReq, opts ...grpc.CallOption) (*GraphData, error)
+	// cancels Attempts (and optionally their unneeded dependencies).
+	CancelAttempts(ctx context.Context, in *CancelAttemptsReq, opts ...grpc.CallOption) (*google_protobuf2.Empty, error)
 }
 type depsPRPCClient struct {
 	client *prpc.Client
@@ -84,6 +86,15 @@ func (c *depsPRPCClient) WalkGraph(ctx context.Context, in *WalkGraphReq, opts .
 	return out, nil
 }
 
+func (c *depsPRPCClient) CancelAttempts(ctx context.Context, in *CancelAttemptsReq, opts ...grpc.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := c.client.Call(ctx, "dm.Deps", "CancelAttempts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type depsClient struct {
 	cc *grpc.ClientConn
 }
@@ -128,6 +139,15 @@ func (c *depsClient) WalkGraph(ctx context.Context, in *WalkGraphReq, opts ...gr
 	return out, nil
 }
 
+func (c *depsClient) CancelAttempts(ctx context.Context, in *CancelAttemptsReq, opts ...grpc.CallOption) (*google_protobuf2.Empty, error) {
+	out := new(google_protobuf2.Empty)
+	err := grpc.Invoke(ctx, "/dm.Deps/CancelAttempts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Deps service
 
 type DepsServer interface {
@@ -139,6 +159,8 @@ type DepsServer interface {
 	FinishAttempt(context.Context, *FinishAttemptReq) (*google_protobuf2.Empty, error)
 	// runs queries, and walks along the dependency graph from the query results.
 	WalkGraph(context.Context, *WalkGraphReq) (*GraphData, error)
+	// cancels Attempts (and optionally their unneeded dependencies).
+	CancelAttempts(context.Context, *CancelAttemptsReq) (*google_protobuf2.Empty, error)
 }
 
 func RegisterDepsServer(s prpc.Registrar, srv DepsServer) {
@@ -217,6 +239,24 @@ func _Deps_WalkGraph_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Deps_CancelAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAttemptsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DepsServer).CancelAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dm.Deps/CancelAttempts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DepsServer).CancelAttempts(ctx, req.(*CancelAttemptsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Deps_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dm.Deps",
 	HandlerType: (*DepsServer)(nil),
@@ -237,33 +277,39 @@ var _Deps_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WalkGraph",
 			Handler:    _Deps_WalkGraph_Handler,
 		},
+		{
+			MethodName: "CancelAttempts",
+			Handler:    _Deps_CancelAttempts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/luci/luci-go/dm/api/service/v1/service.proto",
 }
 
 func init() {
-	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/service.proto", fileDescriptor5)
+	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/service.proto", fileDescriptor6)
 }
 
-var fileDescriptor5 = []byte{
-	// 281 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x41, 0x4b, 0xfc, 0x30,
-	0x10, 0xc5, 0xf9, 0xff, 0x11, 0xc1, 0xc0, 0xa2, 0x06, 0x11, 0xa9, 0x5f, 0x41, 0x13, 0xd4, 0x83,
-	0x20, 0x28, 0x54, 0xb6, 0x7a, 0xf7, 0xe2, 0xb1, 0x4c, 0xdb, 0xd9, 0x36, 0x6c, 0xdb, 0xc4, 0x76,
-	0x52, 0xf5, 0xc3, 0x0b, 0x92, 0xa4, 0x5d, 0xd0, 0x15, 0xa9, 0x97, 0x90, 0xbc, 0xcc, 0xfb, 0x31,
-	0x6f, 0x86, 0x5d, 0x97, 0x8a, 0x2a, 0x9b, 0x89, 0x5c, 0x37, 0xb2, 0xb6, 0xb9, 0xf2, 0xc7, 0x79,
-	0xa9, 0x65, 0xd1, 0x48, 0x30, 0x4a, 0xf6, 0xd8, 0x0d, 0x2a, 0x47, 0x39, 0x5c, 0x4c, 0x57, 0x61,
-	0x3a, 0x4d, 0x9a, 0xff, 0x2f, 0x9a, 0xe8, 0xb4, 0xd4, 0xba, 0xac, 0x51, 0x7a, 0x25, 0xb3, 0x2b,
-	0x89, 0x8d, 0xa1, 0xf7, 0x50, 0x10, 0xdd, 0xcc, 0x27, 0x97, 0x1d, 0x98, 0x2a, 0x2d, 0x80, 0x60,
-	0xf4, 0xc6, 0xf3, 0xbd, 0xd8, 0xf6, 0xb6, 0xc3, 0x74, 0x0b, 0x71, 0x3f, 0x1f, 0x01, 0x39, 0xa9,
-	0x01, 0x08, 0x53, 0x7c, 0xc3, 0xdc, 0x92, 0xd2, 0xed, 0xc8, 0xb8, 0x9b, 0xcf, 0x58, 0xa9, 0x56,
-	0xf5, 0x55, 0x0a, 0x44, 0x6e, 0x06, 0x7f, 0x1f, 0xc1, 0x2b, 0xd4, 0xeb, 0x10, 0x22, 0x78, 0x2f,
-	0x3f, 0xfe, 0xb1, 0x9d, 0x25, 0x9a, 0x9e, 0xc7, 0x6c, 0x3f, 0xf1, 0x19, 0x1f, 0xdd, 0xef, 0x12,
-	0x08, 0xf8, 0xb1, 0x28, 0x1a, 0xf1, 0x4d, 0x7c, 0xc2, 0x97, 0xe8, 0x47, 0xbd, 0x37, 0x3c, 0x61,
-	0x87, 0xf1, 0x98, 0x31, 0x99, 0x22, 0xf2, 0x13, 0x57, 0xbc, 0x25, 0x07, 0x4c, 0xd8, 0xab, 0x98,
-	0xf6, 0x2a, 0x12, 0xb7, 0x57, 0x7e, 0xcb, 0x16, 0x0f, 0x3e, 0x66, 0x1c, 0x52, 0xf2, 0x23, 0x87,
-	0xf8, 0x22, 0xfd, 0x66, 0x3f, 0x63, 0x7b, 0xcf, 0x50, 0xaf, 0x7d, 0x67, 0xfc, 0xc0, 0x59, 0x37,
-	0x4f, 0x67, 0x5b, 0x38, 0x65, 0xd3, 0x76, 0xb6, 0xeb, 0xdd, 0x57, 0x9f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xef, 0xb9, 0x47, 0x29, 0xa1, 0x02, 0x00, 0x00,
+var fileDescriptor6 = []byte{
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x4a, 0xc4, 0x30,
+	0x10, 0xc6, 0x61, 0x11, 0xc1, 0xc0, 0xfa, 0x27, 0xa8, 0xc8, 0xfa, 0x0a, 0x9a, 0xa0, 0x1e, 0x04,
+	0x41, 0x97, 0xea, 0x56, 0xef, 0x5e, 0x3c, 0x96, 0xd9, 0x74, 0xb6, 0x0d, 0xdb, 0x36, 0xb1, 0x49,
+	0xab, 0xbe, 0x9c, 0xcf, 0x26, 0x49, 0x5a, 0x65, 0x5d, 0x59, 0xea, 0xa5, 0x34, 0x1f, 0xf3, 0xfd,
+	0x66, 0xe6, 0x63, 0xc8, 0x75, 0x26, 0x6d, 0xde, 0xcc, 0x99, 0x50, 0x25, 0x2f, 0x1a, 0x21, 0xfd,
+	0xe7, 0x3c, 0x53, 0x3c, 0x2d, 0x39, 0x68, 0xc9, 0x0d, 0xd6, 0xad, 0x14, 0xc8, 0xdb, 0x8b, 0xfe,
+	0x97, 0xe9, 0x5a, 0x59, 0x45, 0x47, 0x69, 0x39, 0x39, 0xcd, 0x94, 0xca, 0x0a, 0xe4, 0x5e, 0x99,
+	0x37, 0x0b, 0x8e, 0xa5, 0xb6, 0x1f, 0xa1, 0x60, 0x72, 0x33, 0x9c, 0x9c, 0xd5, 0xa0, 0xf3, 0x24,
+	0x05, 0x0b, 0x9d, 0x37, 0x1a, 0xee, 0xc5, 0xca, 0x34, 0x35, 0x26, 0x6b, 0x88, 0xfb, 0xe1, 0x08,
+	0x10, 0x56, 0xb6, 0x60, 0x31, 0xc1, 0x77, 0x14, 0x8d, 0x95, 0xaa, 0xea, 0x18, 0x77, 0xc3, 0x19,
+	0x0b, 0x59, 0x49, 0x93, 0x27, 0x60, 0xad, 0xcb, 0xe0, 0xff, 0x11, 0xbc, 0x41, 0xb1, 0x0c, 0x4b,
+	0x74, 0xde, 0xe9, 0x70, 0xaf, 0x80, 0x4a, 0x60, 0xd1, 0xf7, 0x36, 0x01, 0x70, 0xf9, 0x39, 0x22,
+	0x5b, 0x33, 0xd4, 0x86, 0x46, 0x64, 0x2f, 0xf6, 0x21, 0x3d, 0x39, 0xfc, 0x0c, 0x2c, 0xd0, 0x63,
+	0x96, 0x96, 0xec, 0x97, 0xf8, 0x8c, 0xaf, 0x93, 0x3f, 0x75, 0xa3, 0x69, 0x4c, 0x0e, 0xa2, 0x2e,
+	0xa4, 0xb8, 0xcf, 0x88, 0x9e, 0xb8, 0xe2, 0x35, 0x39, 0x60, 0xc2, 0x61, 0xb0, 0xfe, 0x30, 0x58,
+	0xec, 0x0e, 0x83, 0xde, 0x92, 0xf1, 0xa3, 0xcf, 0x29, 0x0a, 0xa3, 0xd2, 0x43, 0x87, 0x58, 0x91,
+	0x36, 0xd9, 0xcf, 0xc8, 0xce, 0x0b, 0x14, 0x4b, 0x3f, 0x19, 0xdd, 0x77, 0xd6, 0xef, 0xa7, 0xb3,
+	0x8d, 0x9d, 0xf2, 0xb3, 0xe3, 0x94, 0xec, 0x3e, 0xf8, 0x60, 0x3a, 0xb2, 0xa1, 0x47, 0xae, 0x60,
+	0x55, 0xdb, 0xd0, 0x6e, 0xbe, 0xed, 0xdf, 0x57, 0x5f, 0x03, 0x00, 0x78, 0x06, 0x4a, 0x18, 0x23,
+	0x03, 0x00, 0x00,
 }
@@ -21,7 +21,7 @@ type MultiPropertyValue struct {
 func (m *MultiPropertyValue) Reset()                    { *m = MultiPropertyValue{} }
 func (m *MultiPropertyValue) String() string            { return proto.CompactTextString(m) }
 func (*MultiPropertyValue) ProtoMessage()               {}
-func (*MultiPropertyValue) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{0} }
+func (*MultiPropertyValue) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{0} }
 
 func (m *MultiPropertyValue) GetValues() []*PropertyValue {
 	if m != nil {
@@ -44,7 +44,7 @@ type PropertyValue struct {
 func (m *PropertyValue) Reset()                    { *m = PropertyValue{} }
 func (m *PropertyValue) String() string            { return proto.CompactTextString(m) }
 func (*PropertyValue) ProtoMessage()               {}
-func (*PropertyValue) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{1} }
+func (*PropertyValue) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{1} }
 
 type isPropertyValue_Value interface {
 	isPropertyValue_Value()
@@ -277,7 +277,7 @@ type AttemptList struct {
 func (m *AttemptList) Reset()                    { *m = AttemptList{} }
 func (m *AttemptList) String() string            { return proto.CompactTextString(m) }
 func (*AttemptList) ProtoMessage()               {}
-func (*AttemptList) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{2} }
+func (*AttemptList) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{2} }
 
 func (m *AttemptList) GetTo() map[string]*AttemptList_Nums {
 	if m != nil {
@@ -293,7 +293,7 @@ type AttemptList_Nums struct {
 func (m *AttemptList_Nums) Reset()                    { *m = AttemptList_Nums{} }
 func (m *AttemptList_Nums) String() string            { return proto.CompactTextString(m) }
 func (*AttemptList_Nums) ProtoMessage()               {}
-func (*AttemptList_Nums) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{2, 0} }
+func (*AttemptList_Nums) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{2, 0} }
 
 func (m *AttemptList_Nums) GetNums() []uint32 {
 	if m != nil {
@@ -310,10 +310,10 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/types.proto", fileDescriptor6)
+	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/types.proto", fileDescriptor7)
 }
 
-var fileDescriptor6 = []byte{
+var fileDescriptor7 = []byte{
 	// 381 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xdf, 0xaa, 0xd3, 0x40,
 	0x10, 0xc6, 0xcf, 0x26, 0x69, 0x7b, 0xdc, 0x78, 0xa0, 0x2e, 0x72, 0x5c, 0x22, 0x68, 0xe8, 0x8d,
@@ -39,7 +39,7 @@ func (x WalkGraphReq_Mode_Direction) String() string {
 	return proto.EnumName(WalkGraphReq_Mode_Direction_name, int32(x))
 }
 func (WalkGraphReq_Mode_Direction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor7, []int{0, 0, 0}
+	return fileDescriptor8, []int{0, 0, 0}
 }
 
 // WalkGraphReq allows you to walk from one or more Quests through their
@@ -70,7 +70,7 @@ type WalkGraphReq struct {
 func (m *WalkGraphReq) Reset()                    { *m = WalkGraphReq{} }
 func (m *WalkGraphReq) String() string            { return proto.CompactTextString(m) }
 func (*WalkGraphReq) ProtoMessage()               {}
-func (*WalkGraphReq) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{0} }
+func (*WalkGraphReq) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{0} }
 
 func (m *WalkGraphReq) GetAuth() *Execution_Auth {
 	if m != nil {
@@ -126,7 +126,7 @@ type WalkGraphReq_Mode struct {
 func (m *WalkGraphReq_Mode) Reset()                    { *m = WalkGraphReq_Mode{} }
 func (m *WalkGraphReq_Mode) String() string            { return proto.CompactTextString(m) }
 func (*WalkGraphReq_Mode) ProtoMessage()               {}
-func (*WalkGraphReq_Mode) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{0, 0} }
+func (*WalkGraphReq_Mode) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{0, 0} }
 
 func (m *WalkGraphReq_Mode) GetDfs() bool {
 	if m != nil {
@@ -169,7 +169,7 @@ type WalkGraphReq_Limit struct {
 func (m *WalkGraphReq_Limit) Reset()                    { *m = WalkGraphReq_Limit{} }
 func (m *WalkGraphReq_Limit) String() string            { return proto.CompactTextString(m) }
 func (*WalkGraphReq_Limit) ProtoMessage()               {}
-func (*WalkGraphReq_Limit) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{0, 1} }
+func (*WalkGraphReq_Limit) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{0, 1} }
 
 func (m *WalkGraphReq_Limit) GetMaxDepth() int64 {
 	if m != nil {
@@ -217,7 +217,7 @@ type WalkGraphReq_Include struct {
 func (m *WalkGraphReq_Include) Reset()                    { *m = WalkGraphReq_Include{} }
 func (m *WalkGraphReq_Include) String() string            { return proto.CompactTextString(m) }
 func (*WalkGraphReq_Include) ProtoMessage()               {}
-func (*WalkGraphReq_Include) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{0, 2} }
+func (*WalkGraphReq_Include) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{0, 2} }
 
 func (m *WalkGraphReq_Include) GetQuest() *WalkGraphReq_Include_Options {
 	if m != nil {
@@ -294,7 +294,7 @@ func (m *WalkGraphReq_Include_Options) Reset()         { *m = WalkGraphReq_Inclu
 func (m *WalkGraphReq_Include_Options) String() string { return proto.CompactTextString(m) }
 func (*WalkGraphReq_Include_Options) ProtoMessage()    {}
 func (*WalkGraphReq_Include_Options) Descriptor() ([]byte, []int) {
-	return fileDescriptor7, []int{0, 2, 0}
+	return fileDescriptor8, []int{0, 2, 0}
 }
 
 func (m *WalkGraphReq_Include_Options) GetIds() bool {
@@ -342,7 +342,7 @@ type WalkGraphReq_Exclude struct {
 func (m *WalkGraphReq_Exclude) Reset()                    { *m = WalkGraphReq_Exclude{} }
 func (m *WalkGraphReq_Exclude) String() string            { return proto.CompactTextString(m) }
 func (*WalkGraphReq_Exclude) ProtoMessage()               {}
-func (*WalkGraphReq_Exclude) Descriptor() ([]byte, []int) { return fileDescriptor7, []int{0, 3} }
+func (*WalkGraphReq_Exclude) Descriptor() ([]byte, []int) { return fileDescriptor8, []int{0, 3} }
 
 func (m *WalkGraphReq_Exclude) GetQuests() []string {
 	if m != nil {
@@ -369,10 +369,10 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/walk_graph.proto", fileDescriptor7)
+	proto.RegisterFile("github.com/luci/luci-go/dm/api/service/v1/walk_graph.proto", fileDescriptor8)
 }
 
-var fileDescriptor7 = []byte{
+var fileDescriptor8 = []byte{
 	// 659 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xdd, 0x6e, 0x13, 0x3d,
 	0x10, 0xfd, 0xd2, 0xfc, 0xac, 0x33, 0x6d, 0xfa, 0x55, 0x96, 0xa8, 0xb6, 0x8b, 0x44, 0xab, 0x0a,
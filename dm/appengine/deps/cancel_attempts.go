@@ -0,0 +1,50 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package deps
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/luci/luci-go/common/logging"
+	dm "github.com/luci/luci-go/dm/api/service/v1"
+	"github.com/luci/luci-go/dm/appengine/mutate"
+	"github.com/luci/luci-go/tumble"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+func (d *deps) CancelAttempts(c context.Context, req *dm.CancelAttemptsReq) (ret *empty.Empty, err error) {
+	if err = canWrite(c); err != nil {
+		return
+	}
+
+	qids := make([]string, 0, len(req.Attempts.To))
+	for qid := range req.Attempts.To {
+		qids = append(qids, qid)
+	}
+	sort.Strings(qids)
+
+	muts := []tumble.Mutation{}
+	for _, qid := range qids {
+		for _, num := range req.Attempts.To[qid].Nums {
+			muts = append(muts, &mutate.CancelAttempt{
+				ID:      dm.NewAttemptID(qid, num),
+				Reason:  req.Reason,
+				Cascade: req.Cascade,
+			})
+		}
+	}
+
+	logging.Fields{
+		"attempts": len(muts),
+		"cascade":  req.Cascade,
+	}.Infof(c, "cancelling")
+	err = tumble.AddToJournal(c, muts...)
+	if err != nil {
+		return nil, grpcAnnotate(err, codes.Internal, "attempting to journal").Err()
+	}
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package deps
+
+import (
+	"testing"
+
+	ds "github.com/luci/gae/service/datastore"
+	dm "github.com/luci/luci-go/dm/api/service/v1"
+	"github.com/luci/luci-go/dm/appengine/model"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCancelAttempts(t *testing.T) {
+	t.Parallel()
+
+	Convey("CancelAttempts", t, func() {
+		ttest, c, _, s := testSetup()
+
+		qid := s.ensureQuest(c, "quest", 1)
+		ttest.Drain(c)
+
+		req := &dm.CancelAttemptsReq{
+			Attempts: dm.NewAttemptList(map[string][]uint32{qid: {1}}),
+			Reason:   "no longer needed",
+		}
+
+		Convey("bad", func() {
+			Convey("no auth", func() {
+				_, err := s.CancelAttempts(c, req)
+				So(err, ShouldBeRPCPermissionDenied, "not authorized")
+			})
+
+			Convey("no attempts", func() {
+				_, err := s.CancelAttempts(writer(c), &dm.CancelAttemptsReq{})
+				So(err, ShouldBeRPCInvalidArgument, "attempts field is required")
+			})
+
+			Convey("implicit attempts", func() {
+				req.Attempts = dm.NewAttemptList(map[string][]uint32{qid: nil})
+				_, err := s.CancelAttempts(writer(c), req)
+				So(err, ShouldBeRPCInvalidArgument, "must be listed explicitly")
+			})
+		})
+
+		Convey("good", func() {
+			_, err := s.CancelAttempts(writer(c), req)
+			So(err, ShouldBeNil)
+			ttest.Drain(c)
+
+			a := &model.Attempt{ID: *dm.NewAttemptID(qid, 1)}
+			e := model.ExecutionFromID(c, dm.NewExecutionID(qid, 1, 1))
+			So(ds.Get(c, a, e), ShouldBeNil)
+			So(a.State, ShouldEqual, dm.Attempt_ABNORMAL_FINISHED)
+			So(a.Result.AbnormalFinish, ShouldResemble, &dm.AbnormalFinish{
+				Status: dm.AbnormalFinish_CANCELLED, Reason: "no longer needed"})
+			So(e.State, ShouldEqual, dm.Execution_ABNORMAL_FINISHED)
+		})
+	})
+}
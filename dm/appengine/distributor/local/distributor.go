@@ -0,0 +1,233 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package local implements a DM distributor which runs Quest payloads as
+// subprocesses of the DM service itself.
+//
+// It is ONLY meant for local development (e.g. on dev_appserver or in
+// a standalone DM stand-in): running processes are tracked in memory, so they
+// are lost (and their Executions eventually marked MISSING) whenever the
+// service restarts.
+//
+// Each Execution gets its own working directory inside Config.WorkDir. The
+// process is started there with these additional environment variables:
+//   * DM_HOST - the host of the DM service.
+//   * DM_EXECUTION_AUTH - path to a JSONPB-encoded dm.Execution_Auth file,
+//     which the process should use to call ActivateExecution.
+//   * DM_PREVIOUS_RESULT - path to a file with the JSON result of the previous
+//     Execution of the same Attempt, if there was one.
+//
+// Stdout and stderr of the process go to the "output.log" file in the working
+// directory.
+package local
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"golang.org/x/net/context"
+
+	"github.com/luci/gae/service/info"
+	"github.com/luci/gae/service/taskqueue"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/dm/api/distributor/local"
+	dm "github.com/luci/luci-go/dm/api/service/v1"
+	"github.com/luci/luci-go/dm/appengine/distributor"
+)
+
+const (
+	// pollInterval is how often the process table is checked for finished
+	// processes (via HandleTaskQueueTask).
+	pollInterval = 5 * time.Second
+
+	// hedgePollTimeout is returned from Run as a hedge against a broken task
+	// queue polling chain (e.g. if the task queue is reset by dev_appserver).
+	hedgePollTimeout = 10 * time.Minute
+
+	authFile    = "execution_auth.json"
+	prevFile    = "previous_result.json"
+	outputFile  = "output.log"
+	defaultWork = "dm_local"
+)
+
+type localDist struct {
+	c   context.Context
+	cfg *distributor.Config
+}
+
+func (d *localDist) lConfig() *local.Config {
+	return d.cfg.Content.(*local.Config)
+}
+
+func (d *localDist) workDir() string {
+	if wd := d.lConfig().WorkDir; wd != "" {
+		return wd
+	}
+	return filepath.Join(os.TempDir(), defaultWork)
+}
+
+// executionDir returns the working directory of the Execution identified by
+// the token.
+func (d *localDist) executionDir(tok distributor.Token) string {
+	return filepath.Join(d.workDir(), d.cfg.Name, strings.Replace(string(tok), "|", "_", -1))
+}
+
+func parseParams(payload string) (*local.Parameters, error) {
+	ret := &local.Parameters{}
+	if err := jsonpb.UnmarshalString(payload, ret); err != nil {
+		return nil, errors.Annotate(err, "unmarshal").Err()
+	}
+	if len(ret.Cmd) == 0 {
+		return nil, errors.New("'cmd' is required")
+	}
+	return ret, nil
+}
+
+func (d *localDist) Run(desc *dm.Quest_Desc, auth *dm.Execution_Auth, prev *dm.JsonResult) (tok distributor.Token, _ time.Duration, err error) {
+	id := auth.Id
+	tok = distributor.Token(fmt.Sprintf("%s|%d|%d", id.Quest, id.Attempt, id.Id))
+
+	params, err := parseParams(desc.Parameters)
+	if err != nil {
+		return
+	}
+
+	if procs.get(tok) == nil {
+		dir := d.executionDir(tok)
+		if err = os.MkdirAll(dir, 0700); err != nil {
+			err = errors.Annotate(err, "creating working directory").Err()
+			return
+		}
+
+		env := append(os.Environ(),
+			"DM_HOST="+d.cfg.DMHost,
+			"DM_EXECUTION_AUTH="+filepath.Join(dir, authFile))
+
+		authData, merr := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(auth)
+		if merr != nil {
+			panic(merr)
+		}
+		if err = ioutil.WriteFile(filepath.Join(dir, authFile), []byte(authData), 0600); err != nil {
+			err = errors.Annotate(err, "writing execution auth").Err()
+			return
+		}
+		if prev != nil {
+			if err = ioutil.WriteFile(filepath.Join(dir, prevFile), []byte(prev.Object), 0600); err != nil {
+				err = errors.Annotate(err, "writing previous result").Err()
+				return
+			}
+			env = append(env, "DM_PREVIOUS_RESULT="+filepath.Join(dir, prevFile))
+		}
+		for k, v := range params.Env {
+			env = append(env, k+"="+v)
+		}
+
+		logging.Fields{
+			"eid": id,
+			"cmd": params.Cmd,
+			"dir": dir,
+		}.Infof(d.c, "local: starting process")
+		if err = procs.start(tok, id, params.Cmd, env, dir, filepath.Join(dir, outputFile)); err != nil {
+			err = errors.Annotate(err, "starting process").Err()
+			return
+		}
+	}
+
+	err = d.enqueuePoll(tok)
+	return tok, hedgePollTimeout, err
+}
+
+// enqueuePoll enqueues a task queue task which checks whether the process has
+// finished yet (see HandleTaskQueueTask).
+func (d *localDist) enqueuePoll(tok distributor.Token) error {
+	return d.cfg.EnqueueTask(d.c, &taskqueue.Task{
+		Payload: []byte(tok),
+		Delay:   pollInterval,
+	})
+}
+
+func (d *localDist) Cancel(_ *dm.Quest_Desc, tok distributor.Token) error {
+	if p := procs.get(tok); p != nil {
+		logging.Fields{"token": tok}.Infof(d.c, "local: cancelling process")
+		return p.cancel()
+	}
+	return nil
+}
+
+func (d *localDist) GetStatus(_ *dm.Quest_Desc, tok distributor.Token) (*dm.Result, error) {
+	p := procs.get(tok)
+	if p == nil {
+		return &dm.Result{
+			AbnormalFinish: &dm.AbnormalFinish{
+				Status: dm.AbnormalFinish_MISSING,
+				Reason: "local: unknown process (was the service restarted?)",
+			},
+		}, nil
+	}
+	if !p.finished() {
+		return nil, nil
+	}
+	procs.reported(tok, clock.Now(d.c))
+	return p.result(), nil
+}
+
+func (d *localDist) InfoURL(tok distributor.Token) string {
+	return "file://" + filepath.ToSlash(filepath.Join(d.executionDir(tok), outputFile))
+}
+
+func (d *localDist) HandleNotification(q *dm.Quest_Desc, note *distributor.Notification) (*dm.Result, error) {
+	return d.GetStatus(q, distributor.Token(note.Data))
+}
+
+func (d *localDist) HandleTaskQueueTask(r *http.Request) ([]*distributor.Notification, error) {
+	// body is a distributor.Token
+	rawTok, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	tok := distributor.Token(rawTok)
+
+	p := procs.get(tok)
+	switch {
+	case p == nil:
+		// DM will find out via GetStatus eventually.
+		logging.Fields{"token": tok}.Warningf(d.c, "local: unknown process, not polling it anymore")
+		return nil, nil
+
+	case !p.finished():
+		return nil, d.enqueuePoll(tok)
+	}
+
+	return []*distributor.Notification{{
+		ID:   p.eid,
+		Data: []byte(tok),
+	}}, nil
+}
+
+func (d *localDist) Validate(payload string) error {
+	_, err := parseParams(payload)
+	return err
+}
+
+// AddFactory adds this distributor implementation into the distributor
+// Registry.
+//
+// The distributor runs arbitrary processes on the service itself, so it works
+// only on the dev server.
+func AddFactory(m distributor.FactoryMap) {
+	m[(*local.Config)(nil)] = func(c context.Context, cfg *distributor.Config) (distributor.D, error) {
+		if !info.IsDevAppServer(c) {
+			return nil, errors.New("local distributor is available only on the dev server")
+		}
+		return &localDist{c, cfg}, nil
+	}
+}
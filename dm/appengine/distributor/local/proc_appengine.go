@@ -0,0 +1,78 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// +build appengine
+
+package local
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"reflect"
+)
+
+// setProcessGroup does nothing on AppEngine, there's no access to syscall.
+// killProcessTree looks up the children with 'ps' instead.
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// killProcessTree kills the process and all its descendants.
+//
+// The descendants are found with 'ps'. If it is not available, only the process
+// itself is killed.
+func killProcessTree(cmd *exec.Cmd) error {
+	tree := []int{cmd.Process.Pid}
+	if children, err := childProcesses(); err == nil {
+		for i := 0; i < len(tree); i++ {
+			tree = append(tree, children[tree[i]]...)
+		}
+	}
+	if err := cmd.Process.Kill(); err != nil {
+		return err
+	}
+	// The descendants may have finished already, ignore errors.
+	for _, pid := range tree[1:] {
+		if p, err := os.FindProcess(pid); err == nil {
+			p.Kill()
+		}
+	}
+	return nil
+}
+
+// childProcesses returns a map from a pid to pids of its children.
+func childProcesses() (map[int][]int, error) {
+	out, err := exec.Command("ps", "-A", "-o", "pid=", "-o", "ppid=").Output()
+	if err != nil {
+		return nil, err
+	}
+	children := map[int][]int{}
+	s := bufio.NewScanner(bytes.NewReader(out))
+	for s.Scan() {
+		var pid, ppid int
+		if _, err := fmt.Sscan(s.Text(), &pid, &ppid); err == nil {
+			children[ppid] = append(children[ppid], pid)
+		}
+	}
+	return children, s.Err()
+}
+
+// exitCode extracts the exit code from syscall.WaitStatus without importing
+// syscall. Processes killed by a signal get 128+signal, as on POSIX.
+func exitCode(err *exec.ExitError) int {
+	ws, ok := err.Sys().(interface {
+		Signaled() bool
+		ExitStatus() int
+	})
+	switch {
+	case !ok:
+		return 1
+	case ws.Signaled():
+		// syscall.Signal is an integer type.
+		return 128 + int(reflect.ValueOf(ws).MethodByName("Signal").Call(nil)[0].Int())
+	default:
+		return ws.ExitStatus()
+	}
+}
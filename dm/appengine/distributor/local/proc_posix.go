@@ -0,0 +1,37 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// +build !windows,!appengine
+
+package local
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup puts the process into its own process group, so the whole
+// tree can be killed by killProcessTree.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// killProcessTree kills the process group of a process started with
+// setProcessGroup.
+func killProcessTree(cmd *exec.Cmd) error {
+	if err := syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL); err != nil && err != syscall.ESRCH {
+		return err
+	}
+	return nil
+}
+
+func exitCode(err *exec.ExitError) int {
+	if ws, ok := err.Sys().(syscall.WaitStatus); ok {
+		if ws.Signaled() {
+			return 128 + int(ws.Signal())
+		}
+		return ws.ExitStatus()
+	}
+	return 1
+}
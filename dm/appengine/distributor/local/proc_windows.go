@@ -0,0 +1,29 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// +build windows,!appengine
+
+package local
+
+import (
+	"os/exec"
+	"strconv"
+	"syscall"
+)
+
+// setProcessGroup does nothing on Windows, killProcessTree uses taskkill to
+// find the children instead.
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// killProcessTree kills the process and all its children.
+func killProcessTree(cmd *exec.Cmd) error {
+	return exec.Command("taskkill", "/F", "/T", "/PID", strconv.Itoa(cmd.Process.Pid)).Run()
+}
+
+func exitCode(err *exec.ExitError) int {
+	if ws, ok := err.Sys().(syscall.WaitStatus); ok {
+		return ws.ExitStatus()
+	}
+	return 1
+}
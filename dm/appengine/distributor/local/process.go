@@ -0,0 +1,185 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"github.com/luci/luci-go/dm/api/distributor/local"
+	dm "github.com/luci/luci-go/dm/api/service/v1"
+	"github.com/luci/luci-go/dm/appengine/distributor"
+)
+
+// procs holds all processes started by this service instance.
+var procs = processTable{procs: map[distributor.Token]*process{}}
+
+// reportedRetention is how long to keep a finished process in the table after
+// its final status has been reported to DM, to answer retried requests.
+const reportedRetention = time.Hour
+
+// processTable is a set of processes, keyed by the distributor token.
+type processTable struct {
+	sync.Mutex
+	procs map[distributor.Token]*process
+}
+
+// get returns the process with the given token or nil if there's no such
+// process.
+func (t *processTable) get(tok distributor.Token) *process {
+	t.Lock()
+	defer t.Unlock()
+	return t.procs[tok]
+}
+
+// reported marks the finished process as reported to DM.
+//
+// Also removes processes reported more than reportedRetention ago, so the table
+// doesn't grow forever.
+func (t *processTable) reported(tok distributor.Token, now time.Time) {
+	t.Lock()
+	defer t.Unlock()
+	if p := t.procs[tok]; p != nil && p.reportedAt.IsZero() {
+		p.reportedAt = now
+	}
+	for tok, p := range t.procs {
+		if !p.reportedAt.IsZero() && now.Sub(p.reportedAt) > reportedRetention {
+			delete(t.procs, tok)
+		}
+	}
+}
+
+// start launches a new process and registers it under the given token.
+//
+// Does nothing if there's already a process with such token.
+func (t *processTable) start(tok distributor.Token, eid *dm.Execution_ID, argv, env []string, dir, logPath string) error {
+	t.Lock()
+	defer t.Unlock()
+	if _, ok := t.procs[tok]; ok {
+		return nil
+	}
+
+	out, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Env = env
+	cmd.Dir = dir
+	cmd.Stdout = out
+	cmd.Stderr = out
+	setProcessGroup(cmd)
+	if err := cmd.Start(); err != nil {
+		out.Close()
+		return err
+	}
+
+	p := &process{
+		eid:  eid,
+		cmd:  cmd,
+		done: make(chan struct{}),
+	}
+	t.procs[tok] = p
+	go func() {
+		defer close(p.done)
+		defer out.Close()
+		p.wait()
+	}()
+	return nil
+}
+
+// process is a single subprocess.
+type process struct {
+	eid *dm.Execution_ID
+	cmd *exec.Cmd
+
+	done chan struct{} // closed when the process exits
+
+	// reportedAt is when the final status was reported to DM, guarded by the
+	// processTable lock.
+	reportedAt time.Time
+
+	lock      sync.Mutex
+	cancelled bool
+
+	// These are set before 'done' is closed.
+	exitCode int
+	err      error // if the process couldn't be waited on
+}
+
+// wait waits for the process to exit and records its exit code.
+func (p *process) wait() {
+	err := p.cmd.Wait()
+	if err == nil {
+		return
+	}
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		p.exitCode = exitCode(exitErr)
+		return
+	}
+	p.err = err
+}
+
+// finished returns true if the process has exited.
+func (p *process) finished() bool {
+	select {
+	case <-p.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// cancel kills the process along with all its children.
+//
+// Does nothing if the process has already exited.
+func (p *process) cancel() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.cancelled || p.finished() {
+		return nil
+	}
+	p.cancelled = true
+	return killProcessTree(p.cmd)
+}
+
+// result returns the dm.Result of the exited process.
+func (p *process) result() *dm.Result {
+	p.lock.Lock()
+	cancelled := p.cancelled
+	p.lock.Unlock()
+
+	abnormal := func(status dm.AbnormalFinish_Status, reason string, args ...interface{}) *dm.Result {
+		return &dm.Result{
+			AbnormalFinish: &dm.AbnormalFinish{
+				Status: status,
+				Reason: fmt.Sprintf("local: "+reason, args...),
+			},
+		}
+	}
+
+	switch {
+	case cancelled:
+		return abnormal(dm.AbnormalFinish_CANCELLED, "cancelled")
+	case p.err != nil:
+		return abnormal(dm.AbnormalFinish_CRASHED, "%s", p.err)
+	case p.exitCode != 0:
+		return abnormal(dm.AbnormalFinish_FAILED, "process exited with code %d", p.exitCode)
+	}
+
+	data, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(&local.Result{
+		ExitCode: int64(p.exitCode),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &dm.Result{Data: dm.NewJsonResult(data)}
+}
@@ -0,0 +1,178 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"github.com/luci/luci-go/dm/api/distributor/local"
+	dm "github.com/luci/luci-go/dm/api/service/v1"
+	"github.com/luci/luci-go/dm/appengine/distributor"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// TestHelperProcess is not a real test. It is used as a subprocess by other
+// tests, see helperCmd.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("DM_LOCAL_HELPER") != "1" {
+		return
+	}
+	switch os.Args[len(os.Args)-1] {
+	case "sleep":
+		time.Sleep(time.Minute)
+	case "fail":
+		os.Exit(3)
+	case "tree":
+		// Starts a grandchild and sleeps.
+		argv := helperCmd("heartbeat")
+		if err := exec.Command(argv[0], argv[1:]...).Start(); err != nil {
+			os.Exit(1)
+		}
+		time.Sleep(time.Minute)
+	case "heartbeat":
+		// Appends to DM_LOCAL_HEARTBEAT file while alive.
+		f, err := os.OpenFile(os.Getenv("DM_LOCAL_HEARTBEAT"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			os.Exit(1)
+		}
+		for start := time.Now(); time.Since(start) < time.Minute; {
+			f.WriteString(".")
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	os.Exit(0)
+}
+
+func helperCmd(mode string) []string {
+	return []string{os.Args[0], "-test.run=TestHelperProcess", "--", mode}
+}
+
+func wait(p *process) {
+	select {
+	case <-p.done:
+	case <-time.After(30 * time.Second):
+		panic("process didn't exit in time")
+	}
+}
+
+func TestProcessTable(t *testing.T) {
+	t.Parallel()
+
+	Convey("processTable", t, func() {
+		dir, err := ioutil.TempDir("", "dm_local_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		tbl := processTable{procs: map[distributor.Token]*process{}}
+		env := append(os.Environ(), "DM_LOCAL_HELPER=1")
+		eid := dm.NewExecutionID("quest", 1, 1)
+		logPath := filepath.Join(dir, "output.log")
+
+		start := func(tok distributor.Token, mode string) *process {
+			So(tbl.start(tok, eid, helperCmd(mode), env, dir, logPath), ShouldBeNil)
+			p := tbl.get(tok)
+			So(p != nil, ShouldBeTrue)
+			return p
+		}
+
+		Convey("successful process", func() {
+			p := start("tok", "ok")
+			wait(p)
+			So(p.finished(), ShouldBeTrue)
+
+			rslt := p.result()
+			So(rslt.AbnormalFinish, ShouldBeNil)
+			data := &local.Result{}
+			So(jsonpb.UnmarshalString(rslt.Data.Object, data), ShouldBeNil)
+			So(data.ExitCode, ShouldEqual, 0)
+		})
+
+		Convey("failing process", func() {
+			p := start("tok", "fail")
+			wait(p)
+			So(p.result().AbnormalFinish, ShouldResemble, &dm.AbnormalFinish{
+				Status: dm.AbnormalFinish_FAILED,
+				Reason: "local: process exited with code " + strconv.Itoa(3),
+			})
+		})
+
+		Convey("start is idempotent", func() {
+			p := start("tok", "sleep")
+			defer p.cancel()
+			So(start("tok", "ok") == p, ShouldBeTrue)
+		})
+
+		Convey("cancel", func() {
+			p := start("tok", "sleep")
+			So(p.finished(), ShouldBeFalse)
+			So(p.cancel(), ShouldBeNil)
+			wait(p)
+			So(p.result().AbnormalFinish.Status, ShouldEqual, dm.AbnormalFinish_CANCELLED)
+
+			// Second cancel is noop.
+			So(p.cancel(), ShouldBeNil)
+		})
+
+		Convey("cancel kills grandchildren", func() {
+			heartbeat := filepath.Join(dir, "heartbeat")
+			size := func() int64 {
+				if fi, err := os.Stat(heartbeat); err == nil {
+					return fi.Size()
+				}
+				return 0
+			}
+			env = append(env, "DM_LOCAL_HEARTBEAT="+heartbeat)
+			p := start("tok", "tree")
+
+			// Wait for the grandchild to start.
+			deadline := time.Now().Add(30 * time.Second)
+			for size() == 0 {
+				if time.Now().After(deadline) {
+					panic("grandchild didn't start in time")
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			So(p.cancel(), ShouldBeNil)
+			wait(p)
+
+			// The grandchild doesn't write anymore.
+			time.Sleep(100 * time.Millisecond)
+			before := size()
+			time.Sleep(200 * time.Millisecond)
+			So(size(), ShouldEqual, before)
+		})
+
+		Convey("reported processes are eventually forgotten", func() {
+			now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+			wait(start("tok1", "ok"))
+			wait(start("tok2", "ok"))
+
+			tbl.reported("tok1", now)
+			So(tbl.get("tok1"), ShouldNotBeNil)
+
+			// Reporting again doesn't extend the retention.
+			tbl.reported("tok1", now.Add(reportedRetention/2))
+			tbl.reported("tok2", now.Add(reportedRetention+time.Second))
+			So(tbl.get("tok1"), ShouldBeNil)
+			So(tbl.get("tok2"), ShouldNotBeNil)
+		})
+
+		Convey("bad executable", func() {
+			err := tbl.start("tok", eid, []string{filepath.Join(dir, "missing")}, env, dir, logPath)
+			So(err, ShouldNotBeNil)
+			So(tbl.get("tok"), ShouldBeNil)
+		})
+	})
+}
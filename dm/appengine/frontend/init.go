@@ -7,10 +7,13 @@ package frontend
 import (
 	"net/http"
 
+	"google.golang.org/appengine"
+
 	"github.com/luci/luci-go/appengine/gaemiddleware"
 	"github.com/luci/luci-go/dm/appengine/deps"
 	"github.com/luci/luci-go/dm/appengine/distributor"
 	"github.com/luci/luci-go/dm/appengine/distributor/jobsim"
+	"github.com/luci/luci-go/dm/appengine/distributor/local"
 	"github.com/luci/luci-go/dm/appengine/distributor/swarming/v1"
 	"github.com/luci/luci-go/dm/appengine/mutate"
 	"github.com/luci/luci-go/grpc/discovery"
@@ -24,6 +27,10 @@ func init() {
 
 	distributors := distributor.FactoryMap{}
 	jobsim.AddFactory(distributors)
+	if appengine.IsDevAppServer() {
+		// Runs arbitrary local processes, never expose it in production.
+		local.AddFactory(distributors)
+	}
 	swarming.AddFactory(distributors)
 
 	reg := distributor.NewRegistry(distributors, mutate.FinishExecutionFn)
@@ -30,6 +30,12 @@ func (a *AttemptRetryState) Reset() {
 	*a = AttemptRetryState{}
 }
 
+// Total returns the sum of all of the AttemptRetryState counters (i.e. the
+// number of retries in a row so far).
+func (a *AttemptRetryState) Total() uint32 {
+	return a.Failed + a.Expired + a.TimedOut + a.Crashed
+}
+
 // Attempt is the datastore model for a DM Attempt. It has no parent key, but
 // it may have the following children entities:
 //   * FwdDep
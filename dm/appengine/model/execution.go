@@ -90,6 +90,12 @@ type Execution struct {
 	TimeToRun   time.Duration `gae:",noindex"` // timeouts.run
 	TimeToStop  time.Duration `gae:",noindex"` // pollTimeout || timeouts.stop
 
+	// RetryReason and RetryDelay are set when this Execution finished abnormally
+	// and DM decided to retry the Attempt. RetryDelay is the amount of time DM
+	// waited before scheduling the next Execution.
+	RetryReason string        `gae:",noindex"`
+	RetryDelay  time.Duration `gae:",noindex"`
+
 	// Token is a randomized nonce that's used to verify that RPCs verify from the
 	// expected client (the client that's currently running the Execution). The
 	// Token has 2 modes.
@@ -352,5 +358,11 @@ func (e *Execution) DataProto() (ret *dm.Execution_Data) {
 		ConfigVersion: e.DistributorConfigVersion,
 		Token:         e.DistributorToken,
 	}
+	if e.RetryReason != "" {
+		ret.Retry = &dm.Execution_Data_Retry{
+			Reason: e.RetryReason,
+			Delay:  google_pb.NewDuration(e.RetryDelay),
+		}
+	}
 	return ret
 }
@@ -0,0 +1,193 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"golang.org/x/net/context"
+
+	ds "github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/retry/transient"
+	dm "github.com/luci/luci-go/dm/api/service/v1"
+	"github.com/luci/luci-go/dm/appengine/distributor"
+	"github.com/luci/luci-go/dm/appengine/model"
+	"github.com/luci/luci-go/tumble"
+)
+
+// CancelAttempt moves a non-finished Attempt to the ABNORMAL_FINISHED state
+// with a CANCELLED status. If the Attempt has a current Execution which isn't
+// finished yet, the Execution is revoked and its distributor is asked to
+// cancel it.
+//
+// If Cascade is true, this will also emit CancelAttempt mutations for all of
+// the Attempt's dependencies. Those mutations have IfUnneeded set, so
+// a dependency is only cancelled if no other unfinished Attempt depends on
+// it.
+type CancelAttempt struct {
+	ID      *dm.Attempt_ID
+	Reason  string
+	Cascade bool
+
+	// IfUnneeded makes this mutation a no-op if there's any unfinished Attempt
+	// which depends on ID.
+	IfUnneeded bool
+}
+
+// Root implements tumble.Mutation
+func (ca *CancelAttempt) Root(c context.Context) *ds.Key {
+	return model.AttemptKeyFromID(c, ca.ID)
+}
+
+// isNeeded returns true if any unfinished Attempt depends on aid.
+//
+// The BackDeps live in a different entity group than the Attempt, so this is
+// a non-transactional (and therefore best-effort) check.
+func isNeeded(c context.Context, aid *dm.Attempt_ID) (bool, error) {
+	c = ds.WithoutTransaction(c)
+
+	bdg := &model.BackDepGroup{Dependee: *aid}
+	q := ds.NewQuery("BackDep").Ancestor(ds.KeyForObj(c, bdg))
+	bdeps := []*model.BackDep(nil)
+	if err := ds.GetAll(c, q, &bdeps); err != nil {
+		return false, errors.Annotate(err, "loading BackDeps").Err()
+	}
+	if len(bdeps) == 0 {
+		return false, nil
+	}
+
+	dependers := make([]*model.Attempt, len(bdeps))
+	for i, bdep := range bdeps {
+		dependers[i] = model.AttemptFromID(&bdep.Depender)
+	}
+	if err := ds.Get(c, dependers); err != nil {
+		return false, errors.Annotate(err, "loading dependers").Err()
+	}
+	for _, a := range dependers {
+		if !a.State.Terminal() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// cancelExecution asks the distributor of e to cancel it. Non-transient errors
+// are logged and otherwise ignored, since the Execution will be marked as
+// ABNORMAL_FINISHED by DM regardless of what the distributor does.
+func cancelExecution(c context.Context, qid string, e *model.Execution) error {
+	if e.DistributorToken == "" {
+		// The distributor never accepted this Execution.
+		return nil
+	}
+
+	dist, _, err := distributor.GetRegistry(c).MakeDistributor(c, e.DistributorConfigName)
+	if err != nil {
+		logging.Fields{
+			logging.ErrorKey: err,
+			"cfgName":        e.DistributorConfigName,
+		}.Errorf(c, "Could not MakeDistributor")
+		return nil
+	}
+
+	q := model.QuestFromID(qid)
+	if err := ds.Get(ds.WithoutTransaction(c), q); err != nil {
+		return errors.Annotate(err, "loading quest").Err()
+	}
+
+	err = dist.Cancel(&q.Desc, distributor.Token(e.DistributorToken))
+	if err != nil {
+		if transient.Tag.In(err) {
+			return err
+		}
+		logging.WithError(err).Warningf(c, "distributor failed to cancel execution")
+	}
+	return nil
+}
+
+// RollForward implements tumble.Mutation
+func (ca *CancelAttempt) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
+	a := model.AttemptFromID(ca.ID)
+	if err = ds.Get(c, a); err != nil {
+		if err == ds.ErrNoSuchEntity {
+			logging.Warningf(c, "EARLY EXIT: attempt does not exist")
+			err = nil
+		}
+		return
+	}
+
+	if a.State.Terminal() {
+		logging.Infof(c, "EARLY EXIT: attempt already finished: %s", a.State)
+		return
+	}
+
+	if ca.IfUnneeded {
+		var needed bool
+		if needed, err = isNeeded(c, ca.ID); err != nil {
+			logging.WithError(err).Errorf(c, "checking dependers")
+			return
+		}
+		if needed {
+			logging.Infof(c, "EARLY EXIT: attempt is still needed")
+			return
+		}
+	}
+
+	ab := &dm.AbnormalFinish{Status: dm.AbnormalFinish_CANCELLED, Reason: ca.Reason}
+	toPut := []interface{}{a}
+
+	if a.State == dm.Attempt_EXECUTING {
+		e := model.ExecutionFromID(c, ca.ID.Execution(a.CurExecution))
+		if err = ds.Get(c, e); err != nil {
+			logging.WithError(err).Errorf(c, "loading execution")
+			return
+		}
+		if !e.State.Terminal() {
+			if err = cancelExecution(c, ca.ID.Quest, e); err != nil {
+				logging.WithError(err).Errorf(c, "cancelling execution")
+				return
+			}
+			e.Token = nil
+			e.IsAbnormal = true
+			e.Result = dm.Result{AbnormalFinish: ab}
+			if err = e.ModifyState(c, dm.Execution_ABNORMAL_FINISHED); err != nil {
+				return
+			}
+			if err = ResetExecutionTimeout(c, e); err != nil {
+				logging.WithError(err).Errorf(c, "resetting timeout")
+				return
+			}
+			toPut = append(toPut, e)
+		}
+	}
+
+	if err = a.ModifyState(c, dm.Attempt_ABNORMAL_FINISHED); err != nil {
+		return
+	}
+	a.IsAbnormal = true
+	a.Result.AbnormalFinish = ab
+
+	if ca.Cascade {
+		fdeps := []*model.FwdDep(nil)
+		if err = ds.GetAll(c, ds.NewQuery("FwdDep").Ancestor(ds.KeyForObj(c, a)), &fdeps); err != nil {
+			logging.WithError(err).Errorf(c, "loading FwdDeps")
+			return
+		}
+		for _, fdep := range fdeps {
+			muts = append(muts, &CancelAttempt{
+				ID:         &fdep.Dependee,
+				Reason:     ca.Reason,
+				Cascade:    true,
+				IfUnneeded: true,
+			})
+		}
+	}
+
+	err = ds.Put(c, toPut...)
+	return
+}
+
+func init() {
+	tumble.Register((*CancelAttempt)(nil))
+}
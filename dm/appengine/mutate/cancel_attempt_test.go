@@ -0,0 +1,149 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"fmt"
+	"testing"
+
+	ds "github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/dm/api/service/v1"
+	"github.com/luci/luci-go/dm/appengine/distributor"
+	"github.com/luci/luci-go/dm/appengine/distributor/fake"
+	"github.com/luci/luci-go/dm/appengine/model"
+	"github.com/luci/luci-go/tumble"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCancelAttempt(t *testing.T) {
+	t.Parallel()
+
+	Convey("CancelAttempt", t, func() {
+		_, c, _ := fake.Setup(FinishExecutionFn)
+
+		qdesc := fake.QuestDesc("quest")
+		qid := qdesc.QuestID()
+		ca := &CancelAttempt{ID: dm.NewAttemptID(qid, 1), Reason: "because"}
+
+		Convey("Root", func() {
+			So(ca.Root(c).String(), ShouldEqual, fmt.Sprintf(`dev~app::/Attempt,"%s|fffffffe"`, qid))
+		})
+
+		Convey("RollForward", func() {
+			q := &model.Quest{ID: qid, Desc: *qdesc}
+			a := &model.Attempt{ID: *ca.ID, State: dm.Attempt_SCHEDULING}
+			So(ds.Put(c, q, a), ShouldBeNil)
+
+			Convey("missing attempt", func() {
+				ca.ID = dm.NewAttemptID(qid, 2)
+				muts, err := ca.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+			})
+
+			Convey("scheduling", func() {
+				muts, err := ca.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+
+				So(ds.Get(c, a), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_ABNORMAL_FINISHED)
+				So(a.IsAbnormal, ShouldBeTrue)
+				So(a.Result.AbnormalFinish, ShouldResemble, &dm.AbnormalFinish{
+					Status: dm.AbnormalFinish_CANCELLED, Reason: "because"})
+			})
+
+			Convey("executing", func() {
+				_, err := (&ScheduleExecution{ca.ID}).RollForward(c)
+				So(err, ShouldBeNil)
+
+				muts, err := ca.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+
+				e := model.ExecutionFromID(c, dm.NewExecutionID(qid, 1, 1))
+				So(ds.Get(c, a, e), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_ABNORMAL_FINISHED)
+				So(e.State, ShouldEqual, dm.Execution_ABNORMAL_FINISHED)
+				So(e.IsAbnormal, ShouldBeTrue)
+				So(e.Token, ShouldBeEmpty)
+				So(e.Result.AbnormalFinish.Status, ShouldEqual, dm.AbnormalFinish_CANCELLED)
+
+				Convey("the distributor task is cancelled", func() {
+					dist, _, err := distributor.GetRegistry(c).MakeDistributor(c, "fakeDistributor")
+					So(err, ShouldBeNil)
+					rslt, err := dist.GetStatus(qdesc, distributor.Token(e.DistributorToken))
+					So(err, ShouldBeNil)
+					So(rslt.AbnormalFinish.Status, ShouldEqual, dm.AbnormalFinish_CANCELLED)
+				})
+
+				Convey("the timeout is removed", func() {
+					ex, err := ds.Exists(c, ds.MakeKey(c,
+						"Attempt", a.ID.DMEncoded(),
+						"Execution", 1,
+						"tumble.Mutation", "n:timeout"))
+					So(err, ShouldBeNil)
+					So(ex.Any(), ShouldBeFalse)
+				})
+			})
+
+			Convey("already finished", func() {
+				a.State = dm.Attempt_FINISHED
+				So(ds.Put(c, a), ShouldBeNil)
+
+				muts, err := ca.RollForward(c)
+				So(err, ShouldBeNil)
+				So(muts, ShouldBeNil)
+
+				So(ds.Get(c, a), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_FINISHED)
+			})
+
+			Convey("cascade", func() {
+				// quest|1 depends on quest|2 and quest|3. quest|4 also depends on
+				// quest|3.
+				a.State = dm.Attempt_WAITING
+				ak := ds.KeyForObj(c, a)
+				other := &model.Attempt{ID: *dm.NewAttemptID(qid, 4), State: dm.Attempt_WAITING}
+				dep2 := &model.Attempt{ID: *dm.NewAttemptID(qid, 2), State: dm.Attempt_SCHEDULING}
+				dep3 := &model.Attempt{ID: *dm.NewAttemptID(qid, 3), State: dm.Attempt_SCHEDULING}
+				So(ds.Put(c, a, other, dep2, dep3), ShouldBeNil)
+				So(ds.Put(c,
+					&model.FwdDep{Depender: ak, Dependee: dep2.ID},
+					&model.FwdDep{Depender: ak, Dependee: dep3.ID},
+					&model.BackDep{
+						DependeeGroup: ds.KeyForObj(c, &model.BackDepGroup{Dependee: dep2.ID}),
+						Depender:      a.ID},
+					&model.BackDep{
+						DependeeGroup: ds.KeyForObj(c, &model.BackDepGroup{Dependee: dep3.ID}),
+						Depender:      a.ID},
+					&model.BackDep{
+						DependeeGroup: ds.KeyForObj(c, &model.BackDepGroup{Dependee: dep3.ID}),
+						Depender:      other.ID},
+				), ShouldBeNil)
+
+				ca.Cascade = true
+				muts, err := ca.RollForward(c)
+				So(err, ShouldBeNil)
+				// FwdDeps are ordered by their (inverted) attempt number.
+				So(muts, ShouldResemble, []tumble.Mutation{
+					&CancelAttempt{ID: &dep3.ID, Reason: "because", Cascade: true, IfUnneeded: true},
+					&CancelAttempt{ID: &dep2.ID, Reason: "because", Cascade: true, IfUnneeded: true},
+				})
+
+				for _, m := range muts {
+					_, err := m.RollForward(c)
+					So(err, ShouldBeNil)
+				}
+
+				So(ds.Get(c, a, dep2, dep3), ShouldBeNil)
+				So(a.State, ShouldEqual, dm.Attempt_ABNORMAL_FINISHED)
+				So(dep2.State, ShouldEqual, dm.Attempt_ABNORMAL_FINISHED)
+				So(dep3.State, ShouldEqual, dm.Attempt_SCHEDULING)
+			})
+		})
+	})
+}
@@ -6,12 +6,16 @@ package mutate
 
 import (
 	"fmt"
+	"regexp"
+	"time"
 
 	"golang.org/x/net/context"
 
 	ds "github.com/luci/gae/service/datastore"
 
+	"github.com/luci/luci-go/common/clock"
 	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/google"
 	dm "github.com/luci/luci-go/dm/api/service/v1"
 	"github.com/luci/luci-go/dm/appengine/model"
 	"github.com/luci/luci-go/tumble"
@@ -29,13 +33,20 @@ func (f *FinishExecution) Root(c context.Context) *ds.Key {
 	return model.ExecutionKeyFromID(c, f.EID)
 }
 
+// defaultMaxRetryDelay is the maximum delay between retried Executions when
+// the Quest doesn't specify one.
+const defaultMaxRetryDelay = time.Hour
+
 // shouldRetry loads the quest for this attempt, to determine if the attempt can
 // be retried. As a side-effect, it increments the RetryState counter for the
 // indicated failure type.
 //
-// If stat is not a retryable AbnormalFinish_Status, this will panic.
-func shouldRetry(c context.Context, a *model.Attempt, stat dm.AbnormalFinish_Status) (retry bool, err error) {
-	if !stat.CouldRetry() {
+// If the attempt should be retried, this also returns a human-readable reason
+// for the retry, and how long to wait before scheduling the next Execution.
+//
+// If ab.Status is not a retryable AbnormalFinish_Status, this will panic.
+func shouldRetry(c context.Context, a *model.Attempt, ab *dm.AbnormalFinish) (retry bool, reason string, delay time.Duration, err error) {
+	if !ab.Status.CouldRetry() {
 		return
 	}
 	q := model.QuestFromID(a.ID.Quest)
@@ -43,27 +54,62 @@ func shouldRetry(c context.Context, a *model.Attempt, stat dm.AbnormalFinish_Sta
 	if err = ds.Get(ds.WithoutTransaction(c), q); err != nil {
 		return
 	}
+	rpol := q.Desc.Meta.Retry
+
+	if rpol.ReasonRegex != "" {
+		matched, rerr := regexp.MatchString(rpol.ReasonRegex, ab.Reason)
+		if rerr != nil {
+			logging.WithError(rerr).Errorf(c, "bad retry reason_regex %q", rpol.ReasonRegex)
+		}
+		if !matched {
+			return
+		}
+	}
+
 	var cur, max uint32
-	switch stat {
+	switch ab.Status {
 	case dm.AbnormalFinish_FAILED:
-		cur, max = a.RetryState.Failed, q.Desc.Meta.Retry.Failed
+		cur, max = a.RetryState.Failed, rpol.Failed
 		a.RetryState.Failed++
 	case dm.AbnormalFinish_CRASHED:
-		cur, max = a.RetryState.Crashed, q.Desc.Meta.Retry.Crashed
+		cur, max = a.RetryState.Crashed, rpol.Crashed
 		a.RetryState.Crashed++
 	case dm.AbnormalFinish_EXPIRED:
-		cur, max = a.RetryState.Expired, q.Desc.Meta.Retry.Expired
+		cur, max = a.RetryState.Expired, rpol.Expired
 		a.RetryState.Expired++
 	case dm.AbnormalFinish_TIMED_OUT:
-		cur, max = a.RetryState.TimedOut, q.Desc.Meta.Retry.TimedOut
+		cur, max = a.RetryState.TimedOut, rpol.TimedOut
 		a.RetryState.TimedOut++
 	default:
-		panic(fmt.Errorf("do not know how to retry %q", stat))
+		panic(fmt.Errorf("do not know how to retry %q", ab.Status))
+	}
+	if retry = cur < max; retry {
+		reason = fmt.Sprintf("%s (retry %d of %d): %s", ab.Status, cur+1, max, ab.Reason)
+		delay = retryDelay(rpol, a.RetryState.Total())
 	}
-	retry = cur < max
 	return
 }
 
+// retryDelay returns how long to wait before the n'th retry in a row. The
+// delay doubles with each retry, up to the Quest's max_delay.
+func retryDelay(rpol *dm.Quest_Desc_Meta_Retry, n uint32) time.Duration {
+	delay := google.DurationFromProto(rpol.Delay)
+	if delay <= 0 {
+		return 0
+	}
+	maxDelay := google.DurationFromProto(rpol.MaxDelay)
+	if maxDelay <= 0 {
+		maxDelay = defaultMaxRetryDelay
+	}
+	for i := uint32(1); i < n && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
 // RollForward implements tumble.Mutation
 func (f *FinishExecution) RollForward(c context.Context) (muts []tumble.Mutation, err error) {
 	a := model.AttemptFromID(f.EID.AttemptID())
@@ -95,14 +141,18 @@ func (f *FinishExecution) RollForward(c context.Context) (muts []tumble.Mutation
 		}
 
 		var retry bool
-		if retry, err = shouldRetry(c, a, ab.Status); err != nil {
+		if retry, e.RetryReason, e.RetryDelay, err = shouldRetry(c, a, ab); err != nil {
 			return
 		} else if retry {
 			if err = a.ModifyState(c, dm.Attempt_SCHEDULING); err != nil {
 				return
 			}
 			a.DepMap.Reset()
-			muts = append(muts, &ScheduleExecution{&a.ID})
+			if e.RetryDelay > 0 {
+				muts = append(muts, &RetryExecution{&a.ID, clock.Now(c).UTC().Add(e.RetryDelay)})
+			} else {
+				muts = append(muts, &ScheduleExecution{&a.ID})
+			}
 		} else {
 			// ran out of retries, or non-retriable error type
 			if err = a.ModifyState(c, dm.Attempt_ABNORMAL_FINISHED); err != nil {
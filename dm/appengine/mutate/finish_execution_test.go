@@ -0,0 +1,133 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"testing"
+	"time"
+
+	ds "github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/dm/api/service/v1"
+	"github.com/luci/luci-go/dm/appengine/distributor/fake"
+	"github.com/luci/luci-go/dm/appengine/model"
+	"github.com/luci/luci-go/tumble"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFinishExecution(t *testing.T) {
+	t.Parallel()
+
+	Convey("FinishExecution", t, func() {
+		_, c, _ := fake.Setup(FinishExecutionFn)
+
+		qdesc := fake.QuestDesc("quest")
+		qdesc.Meta.Retry.Failed = 2
+		qid := qdesc.QuestID()
+
+		eid := dm.NewExecutionID(qid, 1, 1)
+		fe := NewFinishExecutionAbnormal(eid, dm.AbnormalFinish_FAILED, "flaky backend")
+
+		q := &model.Quest{ID: qid, Desc: *qdesc}
+		a := &model.Attempt{
+			ID:           *eid.AttemptID(),
+			State:        dm.Attempt_EXECUTING,
+			CurExecution: 1,
+		}
+		e := &model.Execution{
+			ID: 1, Attempt: ds.KeyForObj(c, a), State: dm.Execution_RUNNING}
+
+		put := func() {
+			So(ds.Put(c, q, a, e), ShouldBeNil)
+		}
+
+		Convey("retries immediately without a delay", func() {
+			put()
+			muts, err := fe.RollForward(c)
+			So(err, ShouldBeNil)
+			So(muts, ShouldResemble, []tumble.Mutation{&ScheduleExecution{&a.ID}})
+
+			So(ds.Get(c, a, e), ShouldBeNil)
+			So(a.State, ShouldEqual, dm.Attempt_SCHEDULING)
+			So(a.RetryState.Failed, ShouldEqual, 1)
+			So(e.State, ShouldEqual, dm.Execution_ABNORMAL_FINISHED)
+			So(e.DataProto().Retry, ShouldResemble, &dm.Execution_Data_Retry{
+				Reason: "FAILED (retry 1 of 2): flaky backend"})
+		})
+
+		Convey("retries with backoff", func() {
+			q.Desc.Meta.Retry.Delay = google.NewDuration(time.Minute)
+			a.RetryState.Failed = 1
+			put()
+
+			muts, err := fe.RollForward(c)
+			So(err, ShouldBeNil)
+			So(muts, ShouldResemble, []tumble.Mutation{&RetryExecution{
+				&a.ID, clock.Now(c).UTC().Add(2 * time.Minute)}})
+
+			So(ds.Get(c, e), ShouldBeNil)
+			So(e.DataProto().Retry, ShouldResemble, &dm.Execution_Data_Retry{
+				Reason: "FAILED (retry 2 of 2): flaky backend",
+				Delay:  google.NewDuration(2 * time.Minute),
+			})
+		})
+
+		Convey("doesn't retry when the reason doesn't match", func() {
+			q.Desc.Meta.Retry.ReasonRegex = "^connection reset"
+			put()
+
+			muts, err := fe.RollForward(c)
+			So(err, ShouldBeNil)
+			So(muts, ShouldBeNil)
+
+			So(ds.Get(c, a, e), ShouldBeNil)
+			So(a.State, ShouldEqual, dm.Attempt_ABNORMAL_FINISHED)
+			So(a.RetryState.Failed, ShouldEqual, 0)
+			So(e.DataProto().Retry, ShouldBeNil)
+		})
+
+		Convey("doesn't retry when out of retries", func() {
+			a.RetryState.Failed = 2
+			put()
+
+			muts, err := fe.RollForward(c)
+			So(err, ShouldBeNil)
+			So(muts, ShouldBeNil)
+
+			So(ds.Get(c, a), ShouldBeNil)
+			So(a.State, ShouldEqual, dm.Attempt_ABNORMAL_FINISHED)
+		})
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	Convey("retryDelay", t, func() {
+		rpol := &dm.Quest_Desc_Meta_Retry{}
+
+		Convey("no delay", func() {
+			So(retryDelay(rpol, 1), ShouldEqual, 0)
+			So(retryDelay(rpol, 10), ShouldEqual, 0)
+		})
+
+		Convey("doubles up to the default max", func() {
+			rpol.Delay = google.NewDuration(time.Minute)
+			So(retryDelay(rpol, 1), ShouldEqual, time.Minute)
+			So(retryDelay(rpol, 2), ShouldEqual, 2*time.Minute)
+			So(retryDelay(rpol, 3), ShouldEqual, 4*time.Minute)
+			So(retryDelay(rpol, 100), ShouldEqual, defaultMaxRetryDelay)
+		})
+
+		Convey("respects max_delay", func() {
+			rpol.Delay = google.NewDuration(time.Minute)
+			rpol.MaxDelay = google.NewDuration(3 * time.Minute)
+			So(retryDelay(rpol, 2), ShouldEqual, 2*time.Minute)
+			So(retryDelay(rpol, 3), ShouldEqual, 3*time.Minute)
+		})
+	})
+}
@@ -0,0 +1,46 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package mutate
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+
+	ds "github.com/luci/gae/service/datastore"
+	dm "github.com/luci/luci-go/dm/api/service/v1"
+	"github.com/luci/luci-go/dm/appengine/model"
+	"github.com/luci/luci-go/tumble"
+)
+
+// RetryExecution is a delayed ScheduleExecution. FinishExecution uses it to
+// back off between retried Executions of an Attempt, according to the Quest's
+// retry policy.
+type RetryExecution struct {
+	For      *dm.Attempt_ID
+	Deadline time.Time
+}
+
+var _ tumble.DelayedMutation = (*RetryExecution)(nil)
+
+// Root implements tumble.Mutation
+func (r *RetryExecution) Root(c context.Context) *ds.Key {
+	return model.AttemptKeyFromID(c, r.For)
+}
+
+// RollForward implements tumble.Mutation
+func (r *RetryExecution) RollForward(c context.Context) ([]tumble.Mutation, error) {
+	return (&ScheduleExecution{r.For}).RollForward(c)
+}
+
+// ProcessAfter implements tumble.DelayedMutation
+func (r *RetryExecution) ProcessAfter() time.Time { return r.Deadline }
+
+// HighPriority implements tumble.DelayedMutation
+func (r *RetryExecution) HighPriority() bool { return false }
+
+func init() {
+	tumble.Register((*RetryExecution)(nil))
+}
@@ -0,0 +1,95 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/luci/luci-go/common/cli"
+	"github.com/luci/luci-go/common/lhttp"
+	"github.com/luci/luci-go/common/logging"
+	dm "github.com/luci/luci-go/dm/api/service/v1"
+	"github.com/luci/luci-go/grpc/prpc"
+	"github.com/maruel/subcommands"
+)
+
+var cmdCancelAttempts = &subcommands.Command{
+	UsageLine: `cancel [options] QUEST_ID:ATTEMPT_NUM...`,
+	ShortDesc: "Cancels one or more DM Attempts.",
+	LongDesc: `This command cancels the given Attempts, stopping any Executions
+	they currently have. With -cascade, dependencies of the cancelled Attempts
+	which no other unfinished Attempt needs are cancelled too.`,
+	CommandRun: func() subcommands.CommandRun {
+		r := &cancelAttemptsRun{}
+		r.registerOptions()
+		return r
+	},
+}
+
+type cancelAttemptsRun struct {
+	cmdRun
+	host    string
+	reason  string
+	cascade bool
+}
+
+func (r *cancelAttemptsRun) registerOptions() {
+	r.Flags.StringVar(&r.host, "host", ":8080",
+		"The host to connect to")
+	r.Flags.StringVar(&r.reason, "reason", "cancelled via dmtool",
+		"The reason to record for the cancellation.")
+	r.Flags.BoolVar(&r.cascade, "cascade", false,
+		"Also cancel dependencies which are not needed by any other unfinished Attempt.")
+}
+
+// parseAttemptID parses a "QUEST_ID:ATTEMPT_NUM" string.
+func parseAttemptID(s string) (*dm.Attempt_ID, error) {
+	idx := strings.LastIndex(s, ":")
+	if idx <= 0 {
+		return nil, fmt.Errorf("expected QUEST_ID:ATTEMPT_NUM, got %q", s)
+	}
+	num, err := strconv.ParseUint(s[idx+1:], 10, 32)
+	if err != nil || num == 0 {
+		return nil, fmt.Errorf("bad attempt number in %q", s)
+	}
+	return dm.NewAttemptID(s[:idx], uint32(num)), nil
+}
+
+func (r *cancelAttemptsRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	r.cmd = cmdCancelAttempts
+	c := cli.GetContext(a, r, env)
+
+	if len(args) == 0 {
+		return r.argErr("at least one attempt is required")
+	}
+
+	req := &dm.CancelAttemptsReq{
+		Attempts: &dm.AttemptList{},
+		Reason:   r.reason,
+		Cascade:  r.cascade,
+	}
+	for _, arg := range args {
+		aid, err := parseAttemptID(arg)
+		if err != nil {
+			return r.argErr("%s", err)
+		}
+		req.Attempts.AddAIDs(aid)
+	}
+
+	client := &prpc.Client{
+		Host:    r.host,
+		Options: prpc.DefaultOptions(),
+	}
+	client.Options.Insecure = lhttp.IsLocalHost(r.host)
+	dc := dm.NewDepsPRPCClient(client)
+
+	if _, err := dc.CancelAttempts(c, req); err != nil {
+		logging.WithError(err).Errorf(c, "error cancelling attempts")
+		return 1
+	}
+	return 0
+}
@@ -45,6 +45,7 @@ var application = &cli.Application{
 		return logCfg.Use(ctx)
 	},
 	Commands: []*subcommands.Command{
+		cmdCancelAttempts,
 		cmdHashQuest,
 		cmdVisQuery,
 		subcommands.CmdHelp,
@@ -64,6 +64,10 @@ func (r *recordingClient) WalkGraph(ctx context.Context, in *dm.WalkGraphReq, op
 	return rsp, nil
 }
 
+func (r *recordingClient) CancelAttempts(ctx context.Context, in *dm.CancelAttemptsReq, opts ...grpc.CallOption) (*empty.Empty, error) {
+	panic("never happens")
+}
+
 var _ dm.DepsClient = (*recordingClient)(nil)
 
 func newEDRunner() (*editDistanceRun, *recordingClient) {
@@ -50,6 +50,14 @@ type ArchiveIndexConfig struct {
 	PrefixRange int32 `protobuf:"varint,2,opt,name=prefix_range,json=prefixRange" json:"prefix_range,omitempty"`
 	// If not zero, the maximum number of log data bytes between index entries.
 	ByteRange int32 `protobuf:"varint,3,opt,name=byte_range,json=byteRange" json:"byte_range,omitempty"`
+	// If true, archived log streams will be compressed. A compressed log stream
+	// is written as a series of independently-compressed blocks, each of which
+	// begins at an index entry, so it can still be read starting at any index
+	// entry.
+	Compress bool `protobuf:"varint,4,opt,name=compress" json:"compress,omitempty"`
+	// If not zero, the maximum number of uncompressed log stream bytes in a
+	// compressed block.
+	BlockSize int32 `protobuf:"varint,5,opt,name=block_size,json=blockSize" json:"block_size,omitempty"`
 }
 
 func (m *ArchiveIndexConfig) Reset()                    { *m = ArchiveIndexConfig{} }
@@ -78,6 +86,20 @@ func (m *ArchiveIndexConfig) GetByteRange() int32 {
 	return 0
 }
 
+func (m *ArchiveIndexConfig) GetCompress() bool {
+	if m != nil {
+		return m.Compress
+	}
+	return false
+}
+
+func (m *ArchiveIndexConfig) GetBlockSize() int32 {
+	if m != nil {
+		return m.BlockSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ArchiveIndexConfig)(nil), "svcconfig.ArchiveIndexConfig")
 }
@@ -87,16 +109,18 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 171 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x72, 0x4a, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xcf, 0x29, 0x4d, 0xce, 0x04, 0x13, 0xba, 0xe9, 0xf9,
-	0xfa, 0x39, 0xf9, 0xe9, 0x29, 0xf9, 0xe9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0xc9, 0xf9, 0x79, 0x69,
-	0x99, 0xe9, 0xfa, 0xc5, 0x65, 0xc9, 0x50, 0x56, 0x62, 0x51, 0x72, 0x46, 0x66, 0x59, 0x62, 0x8e,
-	0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x10, 0x27, 0x5c, 0x46, 0xa9, 0x92, 0x4b, 0xc8, 0x11, 0x2c,
-	0x99, 0xea, 0x99, 0x97, 0x92, 0x5a, 0xe1, 0x0c, 0x16, 0x15, 0x52, 0xe4, 0xe2, 0x29, 0x2e, 0x29,
-	0x4a, 0x4d, 0xcc, 0x8d, 0x2f, 0x4a, 0xcc, 0x4b, 0x4f, 0x95, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x0d,
-	0xe2, 0x86, 0x88, 0x05, 0x81, 0x84, 0x40, 0x4a, 0x0a, 0x8a, 0x52, 0xd3, 0x32, 0x2b, 0xa0, 0x4a,
-	0x98, 0x20, 0x4a, 0x20, 0x62, 0x10, 0x25, 0xb2, 0x5c, 0x5c, 0x49, 0x95, 0x25, 0xa9, 0x50, 0x05,
-	0xcc, 0x60, 0x05, 0x9c, 0x20, 0x11, 0xb0, 0x74, 0x12, 0x1b, 0xd8, 0x31, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x06, 0x73, 0xbd, 0x23, 0xd2, 0x00, 0x00, 0x00,
+	// 206 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x8f, 0xbf, 0x4e, 0xc3, 0x30,
+	0x10, 0xc6, 0x65, 0xa0, 0xa8, 0x35, 0x4c, 0x99, 0x22, 0x24, 0xa4, 0xc2, 0xd4, 0x85, 0x7a, 0xe0,
+	0x09, 0x80, 0x89, 0x35, 0x3c, 0x40, 0xe5, 0xb8, 0x57, 0xe7, 0x84, 0x93, 0xb3, 0x6c, 0x27, 0x0a,
+	0x79, 0x2e, 0x1e, 0xb0, 0xf2, 0x1f, 0x65, 0x39, 0xdd, 0xfd, 0xbe, 0xdf, 0x37, 0x1c, 0xff, 0xd4,
+	0x18, 0xba, 0xb1, 0x3d, 0x2a, 0xea, 0x85, 0x19, 0x15, 0xa6, 0xf1, 0xa6, 0x49, 0x18, 0xd2, 0x67,
+	0xd2, 0x42, 0x5a, 0x14, 0x8a, 0x86, 0x0b, 0x6a, 0xe1, 0x27, 0x55, 0x36, 0xe9, 0x54, 0x87, 0x93,
+	0x34, 0x47, 0xeb, 0x28, 0x50, 0xb5, 0x5b, 0x93, 0xd7, 0x7f, 0xc6, 0xab, 0x8f, 0x94, 0xc2, 0xf7,
+	0x70, 0x86, 0xf9, 0x2b, 0xe1, 0xea, 0x85, 0x3f, 0xfa, 0xe0, 0x40, 0xf6, 0x27, 0x27, 0x07, 0x0d,
+	0x35, 0xdb, 0xb3, 0xc3, 0xa6, 0x79, 0xc8, 0xac, 0x89, 0x28, 0x2a, 0xd6, 0xc1, 0x05, 0xe7, 0xa2,
+	0xdc, 0x64, 0x25, 0xb3, 0xac, 0x3c, 0x73, 0xde, 0xfe, 0x05, 0x28, 0xc2, 0x6d, 0x12, 0x76, 0x91,
+	0xe4, 0xf8, 0x89, 0x6f, 0x15, 0xf5, 0xd6, 0x81, 0xf7, 0xf5, 0xdd, 0x9e, 0x1d, 0xb6, 0xcd, 0x7a,
+	0xa7, 0xaa, 0x21, 0xf5, 0x7b, 0xf2, 0xb8, 0x40, 0xbd, 0x29, 0xd5, 0x48, 0x7e, 0x70, 0x81, 0xf6,
+	0x3e, 0x3d, 0xf2, 0x7e, 0x1d, 0x00, 0x2c, 0xef, 0x07, 0x01, 0x0e, 0x01, 0x00, 0x00,
 }
@@ -62,6 +62,14 @@ type ProjectConfig struct {
 	// Any unspecified index configuration will default to the service archival
 	// config.
 	ArchiveIndexConfig *ArchiveIndexConfig `protobuf:"bytes,12,opt,name=archive_index_config,json=archiveIndexConfig" json:"archive_index_config,omitempty"`
+	// The amount of time after a log stream has been created when it, its
+	// intermediate storage data, and its archived artifacts will be deleted.
+	//
+	// If this is not set, log streams are retained indefinitely.
+	StreamRetention *google_protobuf.Duration `protobuf:"bytes,13,opt,name=stream_retention,json=streamRetention" json:"stream_retention,omitempty"`
+	// If true, the retention cleanup pass will only report the log streams that
+	// have expired; it will not delete anything.
+	StreamRetentionDryRun bool `protobuf:"varint,14,opt,name=stream_retention_dry_run,json=streamRetentionDryRun" json:"stream_retention_dry_run,omitempty"`
 }
 
 func (m *ProjectConfig) Reset()                    { *m = ProjectConfig{} }
@@ -118,6 +126,20 @@ func (m *ProjectConfig) GetArchiveIndexConfig() *ArchiveIndexConfig {
 	return nil
 }
 
+func (m *ProjectConfig) GetStreamRetention() *google_protobuf.Duration {
+	if m != nil {
+		return m.StreamRetention
+	}
+	return nil
+}
+
+func (m *ProjectConfig) GetStreamRetentionDryRun() bool {
+	if m != nil {
+		return m.StreamRetentionDryRun
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ProjectConfig)(nil), "svcconfig.ProjectConfig")
 }
@@ -127,27 +149,29 @@ func init() {
 }
 
 var fileDescriptor2 = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xcd, 0x4a, 0xfb, 0x40,
-	0x14, 0xc5, 0xe9, 0xbf, 0x7f, 0xc5, 0x4e, 0xab, 0xb6, 0xc1, 0x45, 0x2c, 0x28, 0xc1, 0x55, 0x10,
-	0x4d, 0x40, 0x1f, 0x40, 0x52, 0x3f, 0x8a, 0x2b, 0x25, 0x3e, 0xc0, 0x30, 0x49, 0xa6, 0x93, 0xd1,
-	0x49, 0x26, 0xcc, 0x47, 0xcd, 0xdb, 0xf8, 0xaa, 0x92, 0xb9, 0x69, 0x11, 0x5d, 0x08, 0x6e, 0x42,
-	0x72, 0xcf, 0xef, 0x1e, 0x4e, 0xce, 0x45, 0x09, 0xe3, 0xa6, 0xb4, 0x59, 0x94, 0xcb, 0x2a, 0x16,
-	0x36, 0xe7, 0xee, 0x71, 0xc9, 0x64, 0x2c, 0x24, 0x2b, 0x24, 0x8b, 0x49, 0xc3, 0xe3, 0x5c, 0xd6,
-	0x2b, 0xce, 0x62, 0xbd, 0xce, 0xfb, 0xb7, 0x46, 0xc9, 0x57, 0x9a, 0x9b, 0xa8, 0x51, 0xd2, 0x48,
-	0x6f, 0xb4, 0x15, 0xe6, 0x8b, 0xbf, 0xb8, 0x11, 0x95, 0x97, 0x7c, 0x4d, 0x04, 0xd8, 0xcd, 0x4f,
-	0x99, 0x94, 0x4c, 0xd0, 0xd8, 0x7d, 0x65, 0x76, 0x15, 0x17, 0x56, 0x11, 0xc3, 0x65, 0x0d, 0xfa,
-	0xd9, 0xc7, 0x10, 0xed, 0x3f, 0x43, 0x80, 0x5b, 0x67, 0xe0, 0x5d, 0x20, 0x4f, 0x51, 0x52, 0x50,
-	0x85, 0x89, 0x35, 0x25, 0x66, 0x4a, 0xda, 0x46, 0xfb, 0xff, 0x82, 0x61, 0x38, 0x4a, 0xa7, 0xa0,
-	0x24, 0xd6, 0x94, 0x4b, 0x37, 0xef, 0xe8, 0x77, 0xc5, 0xcd, 0x37, 0x7a, 0x08, 0x34, 0x28, 0x5f,
-	0xe8, 0x1b, 0x74, 0x50, 0x91, 0x16, 0x6b, 0xa3, 0x28, 0xa9, 0x30, 0x61, 0xd4, 0xff, 0x1f, 0x0c,
-	0xc2, 0xf1, 0xd5, 0x71, 0x04, 0x31, 0xa3, 0x4d, 0xcc, 0xe8, 0xae, 0x8f, 0x99, 0x4e, 0x2a, 0xd2,
-	0xbe, 0x38, 0x3e, 0x61, 0xd4, 0x7b, 0x40, 0xb3, 0x46, 0xd1, 0x15, 0x6f, 0x31, 0x6d, 0x1b, 0x0e,
-	0x88, 0xbf, 0xf3, 0x9b, 0xc7, 0x14, 0x76, 0xee, 0xb7, 0x2b, 0xde, 0x39, 0x9a, 0x41, 0x51, 0x14,
-	0x33, 0x8d, 0x33, 0x9b, 0xbf, 0x51, 0xe3, 0xa3, 0x60, 0x10, 0x8e, 0xd2, 0xc3, 0x5e, 0x58, 0xea,
-	0x85, 0x1b, 0x43, 0x21, 0xb5, 0x2b, 0x44, 0x88, 0x3e, 0xbb, 0xf6, 0xc7, 0xc1, 0x20, 0xdc, 0xeb,
-	0x0a, 0xe9, 0x94, 0x44, 0x08, 0xc8, 0xa8, 0xbd, 0x27, 0x74, 0xb4, 0x71, 0xe6, 0x75, 0x41, 0x5b,
-	0x0c, 0x77, 0xf1, 0x27, 0x2e, 0xe4, 0x49, 0xb4, 0xbd, 0x54, 0x94, 0x00, 0xf6, 0xd8, 0x51, 0xd0,
-	0x7d, 0xea, 0x91, 0x1f, 0xb3, 0x6c, 0xd7, 0xfd, 0xcf, 0xf5, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x58, 0x02, 0x29, 0xb9, 0x5c, 0x02, 0x00, 0x00,
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x51, 0xdd, 0x0a, 0xd3, 0x30,
+	0x14, 0x66, 0x6e, 0x8a, 0xcb, 0xfe, 0xba, 0xa0, 0x10, 0x07, 0x4a, 0xf1, 0xaa, 0x88, 0xb6, 0xa0,
+	0x17, 0x5e, 0x4a, 0xe7, 0x74, 0x78, 0xa5, 0xd4, 0x07, 0x08, 0x69, 0x9b, 0xa5, 0xd1, 0xb4, 0x29,
+	0x69, 0x32, 0xbb, 0x97, 0xf1, 0x59, 0x65, 0x39, 0xdd, 0x90, 0x79, 0x31, 0xf0, 0xa6, 0x34, 0xe7,
+	0xfb, 0xe1, 0x3b, 0xdf, 0x41, 0xa9, 0x90, 0xb6, 0x72, 0x79, 0x5c, 0xe8, 0x3a, 0x51, 0xae, 0x90,
+	0xfe, 0xf3, 0x46, 0xe8, 0x44, 0x69, 0x51, 0x6a, 0x91, 0xb0, 0x56, 0x26, 0x85, 0x6e, 0x0e, 0x52,
+	0x24, 0xdd, 0xb1, 0x18, 0xfe, 0x5a, 0xa3, 0x7f, 0xf0, 0xc2, 0xc6, 0xad, 0xd1, 0x56, 0xe3, 0xe9,
+	0x15, 0xd8, 0x6c, 0xff, 0xc7, 0x8d, 0x99, 0xa2, 0x92, 0x47, 0xa6, 0xc0, 0x6e, 0xf3, 0x42, 0x68,
+	0x2d, 0x14, 0x4f, 0xfc, 0x2b, 0x77, 0x87, 0xa4, 0x74, 0x86, 0x59, 0xa9, 0x1b, 0xc0, 0x5f, 0xfe,
+	0x9e, 0xa0, 0xc5, 0x37, 0x08, 0xf0, 0xd1, 0x1b, 0xe0, 0xd7, 0x08, 0x1b, 0xce, 0x4a, 0x6e, 0x28,
+	0x73, 0xb6, 0xa2, 0xc2, 0x68, 0xd7, 0x76, 0xe4, 0x41, 0x38, 0x8e, 0xa6, 0x59, 0x00, 0x48, 0xea,
+	0x6c, 0xb5, 0xf7, 0xf3, 0x33, 0xfb, 0x97, 0x91, 0xf6, 0x86, 0x3d, 0x06, 0x36, 0x20, 0x7f, 0xb1,
+	0x3f, 0xa0, 0x65, 0xcd, 0x7a, 0xda, 0x59, 0xc3, 0x59, 0x4d, 0x99, 0xe0, 0x64, 0x12, 0x8e, 0xa2,
+	0xd9, 0xdb, 0x67, 0x31, 0xc4, 0x8c, 0x2f, 0x31, 0xe3, 0xdd, 0x10, 0x33, 0x9b, 0xd7, 0xac, 0xff,
+	0xee, 0xf9, 0xa9, 0xe0, 0xf8, 0x33, 0x5a, 0xb7, 0x86, 0x1f, 0x64, 0x4f, 0x79, 0xdf, 0x4a, 0xa0,
+	0x90, 0x87, 0xf7, 0x3c, 0x02, 0xd0, 0x7c, 0xba, 0x4a, 0xf0, 0x2b, 0xb4, 0x86, 0xa2, 0x38, 0x15,
+	0x1d, 0xcd, 0x5d, 0xf1, 0x93, 0x5b, 0x82, 0xc2, 0x51, 0x34, 0xcd, 0x56, 0x03, 0xb0, 0xef, 0xb6,
+	0x7e, 0x0c, 0x85, 0x34, 0xbe, 0x10, 0xa5, 0x86, 0xec, 0x1d, 0x99, 0x85, 0xa3, 0xe8, 0x71, 0x16,
+	0x00, 0x92, 0x2a, 0x05, 0x19, 0x3b, 0xfc, 0x15, 0x3d, 0xb9, 0x38, 0xcb, 0xa6, 0xe4, 0x3d, 0x85,
+	0xbb, 0x90, 0xb9, 0x0f, 0xf9, 0x3c, 0xbe, 0x5e, 0x2a, 0x4e, 0x81, 0xf6, 0xe5, 0xcc, 0x82, 0xee,
+	0x33, 0xcc, 0xfe, 0x99, 0xe1, 0x1d, 0x0a, 0x86, 0xbe, 0x0c, 0xb7, 0xbc, 0xf1, 0x1b, 0x2f, 0xee,
+	0x6d, 0xbc, 0x02, 0x49, 0x76, 0x51, 0xe0, 0xf7, 0x88, 0xdc, 0xba, 0xd0, 0xd2, 0x9c, 0xa8, 0x71,
+	0x0d, 0x59, 0xfa, 0x55, 0x9e, 0xde, 0x48, 0x76, 0xe6, 0x94, 0xb9, 0x26, 0x7f, 0xe4, 0xcd, 0xdf,
+	0xfd, 0x19, 0x00, 0xff, 0xad, 0x22, 0x9c, 0xdb, 0x02, 0x00, 0x00,
 }
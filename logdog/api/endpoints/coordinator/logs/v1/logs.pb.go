@@ -16,6 +16,8 @@ It has these top-level messages:
 	QueryResponse
 	ListRequest
 	ListResponse
+	SearchRequest
+	SearchResponse
 	LogStreamState
 */
 package logdog
@@ -881,6 +883,206 @@ func (m *ListResponse_Component) GetDesc() *logpb.LogStreamDescriptor {
 	return nil
 }
 
+// SearchRequest is the request structure for the user Search endpoint.
+//
+// Search scans a text log stream's lines for a pattern and returns the lines
+// that match it. Each Text line in a log entry is matched individually.
+//
+// A single request scans a bounded number of log entry bytes. If the search
+// stops before reaching the end of the log stream, the response's Next field
+// will be set, even if no matches were found.
+type SearchRequest struct {
+	// The request project to request.
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	// The path of the log stream to search.
+	Path string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	// The pattern to search for. Unless "regex" is true, this is matched as a
+	// literal substring.
+	Pattern string `protobuf:"bytes,3,opt,name=pattern" json:"pattern,omitempty"`
+	// If true, the pattern is a regular expression (RE2 syntax).
+	Regex bool `protobuf:"varint,4,opt,name=regex" json:"regex,omitempty"`
+	// If true, the pattern is matched case-insensitively.
+	IgnoreCase bool `protobuf:"varint,5,opt,name=ignore_case,json=ignoreCase" json:"ignore_case,omitempty"`
+	// The number of lines of context to return before and after each matching
+	// line.
+	Context int32 `protobuf:"varint,6,opt,name=context" json:"context,omitempty"`
+	// The maximum number of matches to return.
+	//
+	// If <= 0, no upper bound will be indicated. However, the returned result
+	// count is still subject to internal constraints.
+	MaxResults int32 `protobuf:"varint,7,opt,name=max_results,json=maxResults" json:"max_results,omitempty"`
+	// The maximum number of log entry bytes to scan. If zero, or if it exceeds
+	// the service's limit, the service's limit will be used.
+	ByteCount int32 `protobuf:"varint,8,opt,name=byte_count,json=byteCount" json:"byte_count,omitempty"`
+	// If not empty, indicates that this search should continue at the point
+	// where the previous search left off.
+	Next string `protobuf:"bytes,9,opt,name=next" json:"next,omitempty"`
+}
+
+func (m *SearchRequest) Reset()                    { *m = SearchRequest{} }
+func (m *SearchRequest) String() string            { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()               {}
+func (*SearchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *SearchRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetRegex() bool {
+	if m != nil {
+		return m.Regex
+	}
+	return false
+}
+
+func (m *SearchRequest) GetIgnoreCase() bool {
+	if m != nil {
+		return m.IgnoreCase
+	}
+	return false
+}
+
+func (m *SearchRequest) GetContext() int32 {
+	if m != nil {
+		return m.Context
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetMaxResults() int32 {
+	if m != nil {
+		return m.MaxResults
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetByteCount() int32 {
+	if m != nil {
+		return m.ByteCount
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
+// SearchResponse is the response structure for the user Search endpoint.
+type SearchResponse struct {
+	// Project is the project name that the log stream belongs to.
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	// The matching lines, in log stream order.
+	Matches []*SearchResponse_Match `protobuf:"bytes,2,rep,name=matches" json:"matches,omitempty"`
+	// If not empty, indicates that there is more of the log stream to search.
+	// The search can be continued by repeating the Search request with the same
+	// parameters and supplying this value in the Next field.
+	Next string `protobuf:"bytes,3,opt,name=next" json:"next,omitempty"`
+}
+
+func (m *SearchResponse) Reset()                    { *m = SearchResponse{} }
+func (m *SearchResponse) String() string            { return proto.CompactTextString(m) }
+func (*SearchResponse) ProtoMessage()               {}
+func (*SearchResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *SearchResponse) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *SearchResponse) GetMatches() []*SearchResponse_Match {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+func (m *SearchResponse) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
+// Match is a single matching line.
+type SearchResponse_Match struct {
+	// The stream index of the log entry containing the matching line.
+	StreamIndex int64 `protobuf:"varint,1,opt,name=stream_index,json=streamIndex" json:"stream_index,omitempty"`
+	// The index of the matching line in the log stream. Line indices begin at
+	// zero.
+	LineIndex int64 `protobuf:"varint,2,opt,name=line_index,json=lineIndex" json:"line_index,omitempty"`
+	// The matching line's text.
+	Line string `protobuf:"bytes,3,opt,name=line" json:"line,omitempty"`
+	// The lines preceding the matching line, in order.
+	//
+	// If this is a continued search, this will not include lines that preceded
+	// the point where the search was continued.
+	Before []string `protobuf:"bytes,4,rep,name=before" json:"before,omitempty"`
+	// The lines following the matching line, in order.
+	After []string `protobuf:"bytes,5,rep,name=after" json:"after,omitempty"`
+}
+
+func (m *SearchResponse_Match) Reset()                    { *m = SearchResponse_Match{} }
+func (m *SearchResponse_Match) String() string            { return proto.CompactTextString(m) }
+func (*SearchResponse_Match) ProtoMessage()               {}
+func (*SearchResponse_Match) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8, 0} }
+
+func (m *SearchResponse_Match) GetStreamIndex() int64 {
+	if m != nil {
+		return m.StreamIndex
+	}
+	return 0
+}
+
+func (m *SearchResponse_Match) GetLineIndex() int64 {
+	if m != nil {
+		return m.LineIndex
+	}
+	return 0
+}
+
+func (m *SearchResponse_Match) GetLine() string {
+	if m != nil {
+		return m.Line
+	}
+	return ""
+}
+
+func (m *SearchResponse_Match) GetBefore() []string {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *SearchResponse_Match) GetAfter() []string {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetRequest)(nil), "logdog.GetRequest")
 	proto.RegisterType((*GetRequest_SignURLRequest)(nil), "logdog.GetRequest.SignURLRequest")
@@ -894,6 +1096,9 @@ func init() {
 	proto.RegisterType((*ListRequest)(nil), "logdog.ListRequest")
 	proto.RegisterType((*ListResponse)(nil), "logdog.ListResponse")
 	proto.RegisterType((*ListResponse_Component)(nil), "logdog.ListResponse.Component")
+	proto.RegisterType((*SearchRequest)(nil), "logdog.SearchRequest")
+	proto.RegisterType((*SearchResponse)(nil), "logdog.SearchResponse")
+	proto.RegisterType((*SearchResponse_Match)(nil), "logdog.SearchResponse.Match")
 	proto.RegisterEnum("logdog.QueryRequest_Trinary", QueryRequest_Trinary_name, QueryRequest_Trinary_value)
 	proto.RegisterEnum("logdog.ListResponse_Component_Type", ListResponse_Component_Type_name, ListResponse_Component_Type_value)
 }
@@ -917,6 +1122,8 @@ type LogsClient interface {
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
 	// List returns log stream paths rooted under the path hierarchy.
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// Search returns the lines in a text log stream that match a pattern.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 }
 type logsPRPCClient struct {
 	client *prpc.Client
@@ -962,6 +1169,15 @@ func (c *logsPRPCClient) List(ctx context.Context, in *ListRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *logsPRPCClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.client.Call(ctx, "logdog.Logs", "Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type logsClient struct {
 	cc *grpc.ClientConn
 }
@@ -1006,6 +1222,15 @@ func (c *logsClient) List(ctx context.Context, in *ListRequest, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *logsClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := grpc.Invoke(ctx, "/logdog.Logs/Search", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Logs service
 
 type LogsServer interface {
@@ -1017,6 +1242,8 @@ type LogsServer interface {
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
 	// List returns log stream paths rooted under the path hierarchy.
 	List(context.Context, *ListRequest) (*ListResponse, error)
+	// Search returns the lines in a text log stream that match a pattern.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
 }
 
 func RegisterLogsServer(s prpc.Registrar, srv LogsServer) {
@@ -1095,6 +1322,24 @@ func _Logs_List_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Logs_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogsServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logdog.Logs/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogsServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Logs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "logdog.Logs",
 	HandlerType: (*LogsServer)(nil),
@@ -1115,6 +1360,10 @@ var _Logs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Logs_List_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _Logs_Search_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1/logs.proto",
@@ -1125,76 +1374,87 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 1130 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xef, 0x8e, 0xdb, 0x44,
-	0x10, 0xc7, 0x89, 0xf3, 0x6f, 0x9c, 0xbb, 0x86, 0xa5, 0x54, 0xc6, 0xa5, 0x6d, 0x9a, 0x52, 0x11,
-	0x24, 0x70, 0xda, 0x50, 0x28, 0x2a, 0x12, 0xa8, 0xbd, 0x5e, 0xcb, 0x9f, 0x83, 0xbb, 0x6e, 0x52,
-	0x24, 0x3e, 0x45, 0x4e, 0xb2, 0xe7, 0x1a, 0x9c, 0x5d, 0xe3, 0x5d, 0x1f, 0x97, 0x67, 0xe0, 0x13,
-	0x9f, 0x10, 0x8f, 0xc5, 0x23, 0x20, 0x1e, 0x80, 0x27, 0x40, 0x42, 0x3b, 0x6b, 0xc7, 0x69, 0x1a,
-	0x7a, 0x45, 0x94, 0x2f, 0x49, 0x66, 0xe6, 0x37, 0xbb, 0x3b, 0xbf, 0x99, 0xdf, 0x6e, 0xe0, 0xcb,
-	0x30, 0x52, 0x4f, 0xb2, 0xa9, 0x3f, 0x13, 0x8b, 0x41, 0x9c, 0xcd, 0x22, 0xfc, 0x78, 0x2f, 0x14,
-	0x83, 0x58, 0x84, 0x73, 0x11, 0x0e, 0x82, 0x24, 0x1a, 0x30, 0x3e, 0x4f, 0x44, 0xc4, 0x95, 0x1c,
-	0xcc, 0x84, 0x48, 0xe7, 0x11, 0x0f, 0x94, 0x48, 0x35, 0x40, 0x0e, 0x4e, 0x6e, 0xe2, 0xb7, 0x9f,
-	0xa4, 0x42, 0x09, 0x52, 0x37, 0x49, 0xde, 0xc1, 0x7f, 0x5e, 0x54, 0xaa, 0x40, 0x31, 0xb3, 0xaa,
-	0x37, 0x7c, 0x81, 0xd5, 0x62, 0x11, 0x26, 0x53, 0xfd, 0x99, 0xe7, 0x5c, 0x09, 0x85, 0x08, 0x63,
-	0x36, 0x40, 0x6b, 0x9a, 0x1d, 0x0f, 0x54, 0xb4, 0x60, 0x52, 0x05, 0x8b, 0x24, 0x07, 0x5c, 0xde,
-	0x04, 0xcc, 0xb3, 0x34, 0x50, 0x91, 0xe0, 0x26, 0xde, 0xfb, 0xa9, 0x0a, 0xf0, 0x90, 0x29, 0xca,
-	0x7e, 0xc8, 0x98, 0x54, 0xc4, 0x85, 0x46, 0x92, 0x8a, 0xef, 0xd8, 0x4c, 0xb9, 0x56, 0xd7, 0xea,
-	0xb7, 0x68, 0x61, 0x12, 0x02, 0x76, 0x12, 0xa8, 0x27, 0x6e, 0x05, 0xdd, 0xf8, 0x9b, 0x9c, 0x87,
-	0x1a, 0x16, 0xe0, 0x56, 0xbb, 0x56, 0xbf, 0x49, 0x8d, 0xa1, 0xbd, 0x11, 0x9f, 0xb3, 0x53, 0xd7,
-	0xee, 0x5a, 0xfd, 0x2a, 0x35, 0x06, 0xb9, 0x04, 0x30, 0x5d, 0x2a, 0x36, 0x99, 0x89, 0x8c, 0x2b,
-	0xb7, 0xd6, 0xb5, 0xfa, 0x35, 0xda, 0xd2, 0x9e, 0x3d, 0xed, 0x20, 0x17, 0xa1, 0x15, 0x8b, 0x30,
-	0x8f, 0xd6, 0x31, 0xda, 0x8c, 0x45, 0x68, 0x82, 0xd7, 0x61, 0x97, 0x0b, 0x3e, 0x99, 0x09, 0xae,
-	0xa2, 0x30, 0x13, 0x99, 0x74, 0x1b, 0xb8, 0xe1, 0x0e, 0x17, 0x7c, 0x6f, 0xe5, 0x24, 0x9f, 0xc3,
-	0xb9, 0x90, 0xa9, 0x89, 0x8c, 0x42, 0xce, 0xe6, 0x93, 0x2c, 0x8d, 0xa5, 0xdb, 0xec, 0x5a, 0x7d,
-	0x67, 0x78, 0xd5, 0x37, 0x14, 0xfa, 0x65, 0xa5, 0xfe, 0x28, 0x0a, 0xf9, 0x63, 0x7a, 0x90, 0x9b,
-	0x74, 0x27, 0x64, 0x6a, 0x84, 0x89, 0x8f, 0xd3, 0x58, 0x7a, 0x19, 0xec, 0x3e, 0x0d, 0x20, 0x1f,
-	0x40, 0x33, 0x8e, 0x8e, 0x99, 0xe6, 0x17, 0xa9, 0x71, 0x86, 0x6f, 0xf8, 0x86, 0x5b, 0xbf, 0xe0,
-	0xd6, 0xbf, 0x9f, 0x73, 0x4b, 0x57, 0x50, 0x72, 0x01, 0xea, 0x52, 0xa5, 0x2c, 0x58, 0x20, 0x71,
-	0x4d, 0x9a, 0x5b, 0x25, 0x49, 0x39, 0x75, 0x68, 0xf4, 0x1e, 0x81, 0x33, 0x0e, 0xa2, 0xf8, 0x25,
-	0x76, 0xa3, 0xf7, 0x47, 0x05, 0x1c, 0x2c, 0x5b, 0x26, 0x82, 0x4b, 0xf6, 0x9c, 0x35, 0xdf, 0x2d,
-	0xf2, 0x2b, 0x58, 0xde, 0x85, 0x82, 0xb4, 0x03, 0x11, 0x8e, 0xf0, 0xd0, 0x23, 0x1d, 0x2d, 0xba,
-	0xec, 0x83, 0x3d, 0x67, 0x72, 0x86, 0x9b, 0x39, 0x43, 0xcf, 0xc7, 0xc9, 0x2c, 0xb1, 0xf7, 0x99,
-	0x9c, 0xa5, 0x51, 0xa2, 0x44, 0x4a, 0x11, 0x47, 0xae, 0x81, 0xad, 0x87, 0xde, 0xb5, 0xbb, 0xd5,
-	0xbe, 0x33, 0x3c, 0x57, 0xe2, 0xf7, 0xb9, 0x4a, 0x97, 0x14, 0x83, 0xe4, 0x53, 0x70, 0xd6, 0xbb,
-	0x57, 0xc3, 0xb5, 0x2f, 0x3f, 0xd5, 0x3d, 0x53, 0x86, 0x5f, 0xf6, 0x8a, 0x82, 0x2c, 0xfb, 0x76,
-	0x02, 0x50, 0x46, 0xc8, 0x1d, 0x00, 0x76, 0x9a, 0x44, 0xa6, 0x29, 0x79, 0xd7, 0xbc, 0x67, 0xba,
-	0x36, 0x2e, 0x24, 0x43, 0xd7, 0xd0, 0x1b, 0x8d, 0x6b, 0x6d, 0x6f, 0x5c, 0xab, 0x68, 0xdc, 0x2f,
-	0x35, 0x68, 0x3f, 0xca, 0x58, 0xba, 0x7c, 0xc9, 0x42, 0xc2, 0x43, 0xa2, 0x90, 0x9a, 0xd4, 0x18,
-	0x3a, 0x9f, 0xb3, 0x53, 0x23, 0xa1, 0x16, 0xc5, 0xdf, 0xe4, 0x0a, 0x38, 0x8b, 0xe0, 0x74, 0x92,
-	0x32, 0x99, 0xc5, 0x4a, 0xe6, 0xfa, 0x81, 0x45, 0x70, 0x4a, 0x8d, 0x87, 0x5c, 0x85, 0xb6, 0x56,
-	0x0f, 0xe3, 0x6a, 0xa2, 0x96, 0x09, 0x73, 0x01, 0x93, 0x9d, 0xdc, 0x37, 0x5e, 0x26, 0x8c, 0x3c,
-	0x00, 0xc7, 0x94, 0x68, 0x10, 0x0e, 0xb2, 0x75, 0xbd, 0xe0, 0x7e, 0xbd, 0x38, 0xdf, 0xb4, 0x58,
-	0x67, 0x3d, 0x88, 0x62, 0xc5, 0x52, 0x0a, 0x72, 0xe5, 0x21, 0x37, 0xa0, 0xc6, 0xd9, 0x8f, 0x2c,
-	0x75, 0xdb, 0x67, 0xf2, 0x6d, 0x80, 0x3a, 0x43, 0xc4, 0x73, 0x96, 0xba, 0x3b, 0x67, 0x67, 0x20,
-	0x90, 0x5c, 0x83, 0x1d, 0x0c, 0x4e, 0x4e, 0x58, 0x2a, 0x75, 0x6f, 0x77, 0xb1, 0x9e, 0x36, 0x3a,
-	0xbf, 0x31, 0x3e, 0x32, 0x04, 0x5b, 0x05, 0xa1, 0x74, 0xcf, 0xe1, 0xc4, 0x5d, 0xde, 0x5a, 0xc9,
-	0x38, 0x08, 0x65, 0x3e, 0x80, 0x1a, 0x4b, 0x6e, 0x41, 0x3d, 0xc9, 0xd2, 0x90, 0xcd, 0xdd, 0x4e,
-	0xd7, 0xea, 0xef, 0x0e, 0xdf, 0xdc, 0x9e, 0x95, 0x46, 0x3c, 0x48, 0x97, 0x34, 0xc7, 0x7a, 0x1f,
-	0x43, 0x67, 0x93, 0x12, 0xf2, 0x36, 0xd4, 0x4e, 0x82, 0x38, 0x33, 0x97, 0xc5, 0xee, 0xf0, 0xd5,
-	0x7c, 0xe0, 0x4b, 0x1c, 0x35, 0x71, 0xef, 0x36, 0xb4, 0x56, 0xa7, 0x20, 0x1d, 0xa8, 0x7e, 0xcf,
-	0x96, 0xf9, 0xc8, 0xe8, 0x9f, 0x7a, 0x08, 0xcc, 0x3a, 0x66, 0x5e, 0x8c, 0x71, 0xa7, 0xf2, 0x91,
-	0xd5, 0x7b, 0x0b, 0x1a, 0xf9, 0x41, 0x48, 0x13, 0xec, 0x7b, 0x87, 0xe3, 0xcf, 0x3a, 0xaf, 0x90,
-	0x06, 0x54, 0xbf, 0xdd, 0x1f, 0x75, 0x2c, 0x52, 0x87, 0xca, 0xd7, 0x87, 0x9d, 0x4a, 0xef, 0xe7,
-	0x0a, 0xec, 0xe4, 0x87, 0x3f, 0xf3, 0x06, 0xf8, 0x10, 0x1a, 0xa6, 0x91, 0xd2, 0xad, 0x20, 0x69,
-	0x9b, 0xe5, 0x17, 0xe2, 0x43, 0x10, 0x2d, 0xc0, 0xab, 0x91, 0xac, 0x96, 0x23, 0xe9, 0xfd, 0x6a,
-	0x41, 0xdd, 0xe0, 0x56, 0x13, 0x6f, 0xad, 0x4d, 0xfc, 0xff, 0x7b, 0xd9, 0x5c, 0x02, 0xd0, 0xdf,
-	0x93, 0x52, 0x3e, 0x6d, 0xda, 0xd2, 0x9e, 0x23, 0x7c, 0xf4, 0xfe, 0xb4, 0xc0, 0x39, 0x88, 0xe4,
-	0x0b, 0xbc, 0x7a, 0x17, 0xa1, 0xa5, 0x8f, 0x3b, 0x99, 0x06, 0xb2, 0xe8, 0x40, 0x53, 0x3b, 0xee,
-	0x05, 0x92, 0xfd, 0x83, 0x6a, 0x0b, 0x32, 0xec, 0xa7, 0xf5, 0x99, 0x6b, 0x4b, 0xf0, 0x78, 0x89,
-	0xd2, 0x6d, 0x16, 0xa2, 0x39, 0xe4, 0xf1, 0x52, 0xbf, 0x70, 0x11, 0x9f, 0xc5, 0xd9, 0x9c, 0x4d,
-	0xf2, 0xf9, 0xab, 0x9b, 0x17, 0x2e, 0xf7, 0x1e, 0xa1, 0x53, 0x5f, 0x4a, 0xe2, 0xf8, 0x58, 0x32,
-	0x85, 0x0f, 0x60, 0x8d, 0xe6, 0xd6, 0xa6, 0xfe, 0x9b, 0x9b, 0xfa, 0xef, 0xfd, 0x55, 0x81, 0xb6,
-	0xa9, 0xf8, 0xcc, 0x21, 0x78, 0x6e, 0xc9, 0x5b, 0x3a, 0x4d, 0x3e, 0x01, 0x98, 0x89, 0x45, 0x22,
-	0x38, 0xe3, 0xaa, 0xb8, 0xdf, 0x57, 0x6a, 0x5b, 0xdf, 0xd4, 0xdf, 0x2b, 0x60, 0x74, 0x2d, 0xc3,
-	0xfb, 0xdd, 0x82, 0xd6, 0x2a, 0x82, 0x3b, 0x04, 0xf9, 0x1b, 0xab, 0x77, 0x08, 0x16, 0x8c, 0xdc,
-	0x06, 0x1b, 0xef, 0xa4, 0x0a, 0x4a, 0xe9, 0xda, 0xf3, 0xd7, 0xf6, 0x51, 0x5c, 0x98, 0x50, 0x4e,
-	0x59, 0xf5, 0xdf, 0x4c, 0x99, 0xfd, 0x62, 0x53, 0xd6, 0x7b, 0x07, 0x6c, 0xbc, 0xf1, 0x9a, 0x60,
-	0x1f, 0xdd, 0x45, 0xf5, 0x01, 0xd4, 0x47, 0x63, 0xba, 0x7f, 0xf7, 0xab, 0x8e, 0x45, 0x1c, 0x68,
-	0x1c, 0xd1, 0xc3, 0x2f, 0xf6, 0xf7, 0xc6, 0x9d, 0xca, 0xf0, 0x37, 0x0b, 0xec, 0x03, 0xfd, 0xc2,
-	0xf9, 0x50, 0x7d, 0xc8, 0x14, 0x21, 0xcf, 0xfe, 0x23, 0xf1, 0x5e, 0xdb, 0xf2, 0xce, 0x91, 0x1b,
-	0x60, 0xeb, 0x7f, 0x04, 0x64, 0x15, 0x5c, 0xfb, 0x7f, 0xb0, 0x3d, 0xe3, 0x16, 0xd4, 0x50, 0xad,
-	0xe4, 0xfc, 0xb6, 0xbb, 0xcb, 0x7b, 0x7d, 0xab, 0xa4, 0xc9, 0x4d, 0xb0, 0x35, 0x9d, 0xe5, 0x3e,
-	0x6b, 0xfa, 0xf0, 0xce, 0x6f, 0x63, 0x7c, 0x5a, 0x47, 0x75, 0xbd, 0xff, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x92, 0xcb, 0xcc, 0xa4, 0x5b, 0x0b, 0x00, 0x00,
+	// 1309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x5f, 0x73, 0xdb, 0xc4,
+	0x16, 0xbf, 0x92, 0xe5, 0x7f, 0xc7, 0x49, 0xea, 0xbb, 0xb7, 0xed, 0xe8, 0xba, 0xb7, 0xad, 0xeb,
+	0xde, 0x0e, 0x66, 0x06, 0xe4, 0xd6, 0x14, 0xca, 0x94, 0x19, 0x98, 0x36, 0x4d, 0x4b, 0x21, 0x25,
+	0xe9, 0xda, 0x65, 0x86, 0x27, 0x8f, 0x6c, 0x6f, 0x14, 0x81, 0xbc, 0x2b, 0x76, 0x57, 0x21, 0x7e,
+	0xe7, 0x89, 0x3e, 0xf1, 0xc4, 0xf0, 0xf1, 0x18, 0x3e, 0x00, 0x5f, 0x00, 0x66, 0x98, 0xfd, 0x23,
+	0xcb, 0x71, 0x4c, 0x13, 0xa0, 0xbc, 0xd8, 0x3a, 0x67, 0x7f, 0x67, 0xa5, 0x73, 0xce, 0xef, 0x77,
+	0x76, 0xe1, 0xd3, 0x28, 0x96, 0x87, 0xd9, 0x38, 0x98, 0xb0, 0x59, 0x2f, 0xc9, 0x26, 0xb1, 0xfe,
+	0x79, 0x3b, 0x62, 0xbd, 0x84, 0x45, 0x53, 0x16, 0xf5, 0xc2, 0x34, 0xee, 0x11, 0x3a, 0x4d, 0x59,
+	0x4c, 0xa5, 0xe8, 0x4d, 0x18, 0xe3, 0xd3, 0x98, 0x86, 0x92, 0x71, 0x05, 0x10, 0xbd, 0xa3, 0x3b,
+	0xfa, 0x3f, 0x48, 0x39, 0x93, 0x0c, 0x55, 0x4c, 0x50, 0x6b, 0xf7, 0x6f, 0x6f, 0x2a, 0x64, 0x28,
+	0x89, 0xd9, 0xb5, 0xd5, 0x3f, 0xc7, 0x6e, 0x09, 0x8b, 0xd2, 0xb1, 0xfa, 0xb5, 0x31, 0xd7, 0x23,
+	0xc6, 0xa2, 0x84, 0xf4, 0xb4, 0x35, 0xce, 0x0e, 0x7a, 0x32, 0x9e, 0x11, 0x21, 0xc3, 0x59, 0x6a,
+	0x01, 0xd7, 0x56, 0x01, 0xd3, 0x8c, 0x87, 0x32, 0x66, 0xd4, 0xac, 0x77, 0x5e, 0x96, 0x00, 0x9e,
+	0x10, 0x89, 0xc9, 0xd7, 0x19, 0x11, 0x12, 0xf9, 0x50, 0x4d, 0x39, 0xfb, 0x92, 0x4c, 0xa4, 0xef,
+	0xb4, 0x9d, 0x6e, 0x1d, 0xe7, 0x26, 0x42, 0xe0, 0xa5, 0xa1, 0x3c, 0xf4, 0x5d, 0xed, 0xd6, 0xcf,
+	0xe8, 0x22, 0x94, 0x75, 0x02, 0x7e, 0xa9, 0xed, 0x74, 0x6b, 0xd8, 0x18, 0xca, 0x1b, 0xd3, 0x29,
+	0x39, 0xf6, 0xbd, 0xb6, 0xd3, 0x2d, 0x61, 0x63, 0xa0, 0xab, 0x00, 0xe3, 0xb9, 0x24, 0xa3, 0x09,
+	0xcb, 0xa8, 0xf4, 0xcb, 0x6d, 0xa7, 0x5b, 0xc6, 0x75, 0xe5, 0xd9, 0x56, 0x0e, 0x74, 0x05, 0xea,
+	0x09, 0x8b, 0xec, 0x6a, 0x45, 0xaf, 0xd6, 0x12, 0x16, 0x99, 0xc5, 0x5b, 0xb0, 0x45, 0x19, 0x1d,
+	0x4d, 0x18, 0x95, 0x71, 0x94, 0xb1, 0x4c, 0xf8, 0x55, 0xfd, 0xc2, 0x4d, 0xca, 0xe8, 0xf6, 0xc2,
+	0x89, 0x9e, 0xc2, 0x85, 0x88, 0xc8, 0x91, 0x88, 0x23, 0x4a, 0xa6, 0xa3, 0x8c, 0x27, 0xc2, 0xaf,
+	0xb5, 0x9d, 0x6e, 0xa3, 0x7f, 0x23, 0x30, 0x25, 0x0c, 0x8a, 0x4c, 0x83, 0x41, 0x1c, 0xd1, 0x17,
+	0x78, 0xd7, 0x9a, 0x78, 0x33, 0x22, 0x72, 0xa0, 0x03, 0x5f, 0xf0, 0x44, 0xb4, 0x32, 0xd8, 0x3a,
+	0x09, 0x40, 0xef, 0x42, 0x2d, 0x89, 0x0f, 0x88, 0xaa, 0xaf, 0x2e, 0x4d, 0xa3, 0xff, 0xdf, 0xc0,
+	0xd4, 0x36, 0xc8, 0x6b, 0x1b, 0x3c, 0xb2, 0xb5, 0xc5, 0x0b, 0x28, 0xba, 0x0c, 0x15, 0x21, 0x39,
+	0x09, 0x67, 0xba, 0x70, 0x35, 0x6c, 0xad, 0xa2, 0x48, 0xb6, 0x74, 0xda, 0xe8, 0x3c, 0x87, 0xc6,
+	0x30, 0x8c, 0x93, 0xd7, 0xd8, 0x8d, 0xce, 0xcf, 0x2e, 0x34, 0x74, 0xda, 0x22, 0x65, 0x54, 0x90,
+	0x57, 0xec, 0xf9, 0x56, 0x1e, 0xef, 0xea, 0xf4, 0x2e, 0xe7, 0x45, 0xdb, 0x65, 0xd1, 0x40, 0x7f,
+	0xf4, 0x40, 0xad, 0xe6, 0x5d, 0x0e, 0xc0, 0x9b, 0x12, 0x31, 0xd1, 0x2f, 0x6b, 0xf4, 0x5b, 0x81,
+	0x66, 0x66, 0x81, 0x7d, 0x44, 0xc4, 0x84, 0xc7, 0xa9, 0x64, 0x1c, 0x6b, 0x1c, 0xba, 0x09, 0x9e,
+	0x22, 0xbd, 0xef, 0xb5, 0x4b, 0xdd, 0x46, 0xff, 0x42, 0x81, 0xdf, 0xa1, 0x92, 0xcf, 0xb1, 0x5e,
+	0x44, 0x1f, 0x41, 0x63, 0xb9, 0x7b, 0x65, 0xbd, 0xf7, 0xb5, 0x13, 0xdd, 0x33, 0x69, 0x04, 0x45,
+	0xaf, 0x30, 0x88, 0xa2, 0x6f, 0x47, 0x00, 0xc5, 0x0a, 0xba, 0x0f, 0x40, 0x8e, 0xd3, 0xd8, 0x34,
+	0xc5, 0x76, 0xad, 0x75, 0xaa, 0x6b, 0xc3, 0x5c, 0x32, 0x78, 0x09, 0xbd, 0xd2, 0xb8, 0xfa, 0xfa,
+	0xc6, 0xd5, 0xf3, 0xc6, 0xfd, 0x50, 0x86, 0x8d, 0xe7, 0x19, 0xe1, 0xf3, 0xd7, 0x2c, 0x24, 0xfd,
+	0x91, 0x5a, 0x48, 0x35, 0x6c, 0x0c, 0x15, 0x4f, 0xc9, 0xb1, 0x91, 0x50, 0x1d, 0xeb, 0x67, 0x74,
+	0x1d, 0x1a, 0xb3, 0xf0, 0x78, 0xc4, 0x89, 0xc8, 0x12, 0x29, 0xac, 0x7e, 0x60, 0x16, 0x1e, 0x63,
+	0xe3, 0x41, 0x37, 0x60, 0x43, 0xa9, 0x87, 0x50, 0x39, 0x92, 0xf3, 0x94, 0xf8, 0xa0, 0x83, 0x1b,
+	0xd6, 0x37, 0x9c, 0xa7, 0x04, 0x3d, 0x86, 0x86, 0x49, 0xd1, 0x20, 0x1a, 0xba, 0x5a, 0xb7, 0xf2,
+	0xda, 0x2f, 0x27, 0x17, 0x98, 0x16, 0xab, 0xa8, 0xc7, 0x71, 0x22, 0x09, 0xc7, 0x20, 0x16, 0x1e,
+	0x74, 0x1b, 0xca, 0x94, 0x7c, 0x43, 0xb8, 0xbf, 0x71, 0x66, 0xbd, 0x0d, 0x50, 0x45, 0xb0, 0x64,
+	0x4a, 0xb8, 0xbf, 0x79, 0x76, 0x84, 0x06, 0xa2, 0x9b, 0xb0, 0xa9, 0x17, 0x47, 0x47, 0x84, 0x0b,
+	0xd5, 0xdb, 0x2d, 0x9d, 0xcf, 0x86, 0x76, 0x7e, 0x6e, 0x7c, 0xa8, 0x0f, 0x9e, 0x0c, 0x23, 0xe1,
+	0x5f, 0x68, 0x97, 0x96, 0x59, 0x74, 0x22, 0x93, 0x61, 0x18, 0x09, 0x4b, 0x40, 0x85, 0x45, 0x77,
+	0xa1, 0x92, 0x66, 0x3c, 0x22, 0x53, 0xbf, 0xd9, 0x76, 0xba, 0x5b, 0xfd, 0xff, 0xad, 0x8f, 0xe2,
+	0x31, 0x0d, 0xf9, 0x1c, 0x5b, 0x6c, 0xeb, 0x03, 0x68, 0xae, 0x96, 0x04, 0xbd, 0x01, 0xe5, 0xa3,
+	0x30, 0xc9, 0xcc, 0xb0, 0xd8, 0xea, 0xff, 0xdb, 0x12, 0xbe, 0xc0, 0x61, 0xb3, 0xde, 0xba, 0x07,
+	0xf5, 0xc5, 0x57, 0xa0, 0x26, 0x94, 0xbe, 0x22, 0x73, 0x4b, 0x19, 0xf5, 0xa8, 0x48, 0x60, 0xf6,
+	0x31, 0x7c, 0x31, 0xc6, 0x7d, 0xf7, 0x7d, 0xa7, 0xf3, 0x7f, 0xa8, 0xda, 0x0f, 0x41, 0x35, 0xf0,
+	0x1e, 0xee, 0x0d, 0x3f, 0x6e, 0xfe, 0x0b, 0x55, 0xa1, 0xf4, 0xc5, 0xce, 0xa0, 0xe9, 0xa0, 0x0a,
+	0xb8, 0x9f, 0xed, 0x35, 0xdd, 0xce, 0xf7, 0x2e, 0x6c, 0xda, 0x8f, 0x3f, 0x73, 0x02, 0xbc, 0x07,
+	0x55, 0xd3, 0x48, 0xe1, 0xbb, 0xba, 0x68, 0xab, 0xe9, 0xe7, 0xe2, 0xd3, 0x20, 0x9c, 0x83, 0x17,
+	0x94, 0x2c, 0x15, 0x94, 0x6c, 0xfd, 0xe8, 0x40, 0xc5, 0xe0, 0x16, 0x8c, 0x77, 0x96, 0x18, 0xff,
+	0xcf, 0x0e, 0x9b, 0xab, 0x00, 0xea, 0x7f, 0x54, 0xc8, 0x67, 0x03, 0xd7, 0x95, 0x67, 0x5f, 0x1f,
+	0x7a, 0xbf, 0x38, 0xd0, 0xd8, 0x8d, 0xc5, 0x39, 0x4e, 0xbd, 0x2b, 0x50, 0x57, 0x9f, 0x3b, 0x1a,
+	0x87, 0x22, 0xef, 0x40, 0x4d, 0x39, 0x1e, 0x86, 0x82, 0xfc, 0x81, 0x6a, 0xf3, 0x62, 0x78, 0x27,
+	0xf5, 0x69, 0xb5, 0xc5, 0x68, 0x32, 0xd7, 0xd2, 0xad, 0xe5, 0xa2, 0xd9, 0xa3, 0xc9, 0x5c, 0x9d,
+	0x70, 0x31, 0x9d, 0x24, 0xd9, 0x94, 0x8c, 0x2c, 0xff, 0x2a, 0xe6, 0x84, 0xb3, 0xde, 0x7d, 0xed,
+	0x54, 0x43, 0x89, 0x1d, 0x1c, 0x08, 0x22, 0xf5, 0x01, 0x58, 0xc6, 0xd6, 0x5a, 0xd5, 0x7f, 0x6d,
+	0x55, 0xff, 0x9d, 0xdf, 0x5c, 0xd8, 0x30, 0x19, 0x9f, 0x49, 0x82, 0x57, 0xa6, 0xbc, 0xa6, 0xd3,
+	0xe8, 0x43, 0x80, 0x09, 0x9b, 0xa5, 0x8c, 0x12, 0x2a, 0xf3, 0xf9, 0xbe, 0x50, 0xdb, 0xf2, 0x4b,
+	0x83, 0xed, 0x1c, 0x86, 0x97, 0x22, 0x5a, 0x3f, 0x39, 0x50, 0x5f, 0xac, 0xe8, 0x37, 0x84, 0xf6,
+	0x8c, 0x55, 0x6f, 0x08, 0x67, 0x04, 0xdd, 0x03, 0x4f, 0xcf, 0x24, 0x57, 0x4b, 0xe9, 0xe6, 0xab,
+	0xf7, 0x0e, 0xb4, 0xb8, 0x74, 0x40, 0xc1, 0xb2, 0xd2, 0x9f, 0x61, 0x99, 0x77, 0x3e, 0x96, 0x75,
+	0xde, 0x04, 0x4f, 0x4f, 0xbc, 0x1a, 0x78, 0xfb, 0x0f, 0xb4, 0xfa, 0x00, 0x2a, 0x83, 0x21, 0xde,
+	0x79, 0xf0, 0xac, 0xe9, 0xa0, 0x06, 0x54, 0xf7, 0xf1, 0xde, 0x27, 0x3b, 0xdb, 0xc3, 0xa6, 0xdb,
+	0xf9, 0xd5, 0x81, 0xcd, 0x01, 0x09, 0xf9, 0xe4, 0xf0, 0xaf, 0x1d, 0x10, 0x0a, 0x1d, 0x4a, 0x49,
+	0x38, 0xb5, 0xa5, 0xcf, 0x4d, 0x45, 0x42, 0x4e, 0x22, 0x7b, 0xdb, 0xaa, 0x61, 0x63, 0x28, 0x42,
+	0xc4, 0x11, 0x65, 0x9c, 0x8c, 0x26, 0xaa, 0x8d, 0x96, 0x70, 0xc6, 0xb5, 0x1d, 0x9a, 0xfe, 0xeb,
+	0xe1, 0x7f, 0x9c, 0xdf, 0xb6, 0x72, 0x73, 0x95, 0x4b, 0xd5, 0x53, 0x67, 0xc9, 0xc9, 0x9b, 0x5c,
+	0x6d, 0xf5, 0x26, 0x97, 0x53, 0xa4, 0x5e, 0x50, 0xa4, 0xf3, 0xad, 0x0b, 0x5b, 0x79, 0xfa, 0xe7,
+	0x99, 0x42, 0xb3, 0x50, 0x4e, 0x0e, 0xc9, 0xa9, 0x29, 0x74, 0x72, 0x8b, 0xe0, 0x99, 0x42, 0xe1,
+	0x1c, 0xbc, 0x76, 0x0a, 0xbd, 0x74, 0xa0, 0xac, 0x61, 0xea, 0x04, 0xb4, 0x12, 0x34, 0xc7, 0xb7,
+	0xa3, 0x2f, 0xa7, 0x56, 0x96, 0x4f, 0xf3, 0x2b, 0x6a, 0x12, 0x53, 0x62, 0x01, 0xae, 0x06, 0xd4,
+	0x95, 0xc7, 0x2c, 0x23, 0xf0, 0x94, 0x91, 0xef, 0xaf, 0x9e, 0x95, 0x20, 0xc7, 0xe4, 0x80, 0x71,
+	0xa2, 0x79, 0x5f, 0xc7, 0xd6, 0x52, 0x5d, 0x09, 0x0f, 0x24, 0xe1, 0x7e, 0x59, 0xbb, 0x8d, 0xd1,
+	0xff, 0xce, 0x05, 0x6f, 0x57, 0xdd, 0x73, 0x02, 0x28, 0x3d, 0x21, 0x12, 0xa1, 0xd3, 0xf7, 0xd2,
+	0xd6, 0x7f, 0xd6, 0xdc, 0x76, 0xd0, 0x6d, 0xf0, 0xd4, 0xbd, 0x10, 0x2d, 0x16, 0x97, 0x6e, 0x89,
+	0xeb, 0x23, 0xee, 0x42, 0x59, 0xcf, 0x6c, 0x74, 0x71, 0xdd, 0x09, 0xd6, 0xba, 0xb4, 0x76, 0xb0,
+	0xa3, 0x3b, 0xe0, 0x29, 0x51, 0x15, 0xef, 0x59, 0x9a, 0x92, 0xad, 0x8b, 0xeb, 0x74, 0x87, 0xee,
+	0x41, 0xc5, 0xb4, 0x05, 0x5d, 0x5a, 0x6d, 0x93, 0x09, 0xbb, 0xbc, 0xbe, 0x7b, 0xe3, 0x8a, 0x1e,
+	0xce, 0xef, 0xfc, 0x3e, 0x00, 0x2f, 0x5e, 0x3c, 0xeb, 0x9a, 0x0d, 0x00, 0x00,
 }
@@ -81,3 +81,18 @@ func (s *DecoratedLogs) List(c context.Context, req *ListRequest) (rsp *ListResp
 	}
 	return
 }
+
+func (s *DecoratedLogs) Search(c context.Context, req *SearchRequest) (rsp *SearchResponse, err error) {
+	var newCtx context.Context
+	if s.Prelude != nil {
+		newCtx, err = s.Prelude(c, "Search", req)
+	}
+	if err == nil {
+		c = newCtx
+		rsp, err = s.Service.Search(c, req)
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(c, "Search", rsp, err)
+	}
+	return
+}
@@ -12,1096 +12,1215 @@ func init() {
 			"logdog.Logs",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 11, 112, 28, 201,
-			117, 32, 136, 204, 170, 46, 52, 18, 32, 1, 20, 65, 16, 44,
-			126, 144, 236, 225, 7, 32, 27, 13, 144, 243, 145, 134, 28, 106,
-			13, 146, 32, 217, 35, 12, 0, 53, 64, 209, 51, 150, 69, 22,
-			186, 19, 141, 154, 105, 84, 245, 84, 85, 3, 196, 72, 99, 201,
-			190, 177, 87, 218, 93, 59, 100, 175, 207, 227, 221, 85, 204, 222,
-			237, 197, 41, 206, 227, 93, 57, 164, 93, 233, 44, 95, 200, 187,
-			123, 242, 198, 106, 199, 31, 157, 173, 61, 91, 62, 157, 28, 94,
-			91, 113, 10, 41, 108, 237, 133, 108, 199, 109, 132, 238, 180, 23,
-			239, 101, 102, 125, 26, 0, 201, 25, 141, 35, 206, 183, 154, 144,
-			102, 240, 170, 178, 94, 190, 124, 249, 242, 189, 151, 239, 189, 204,
-			102, 95, 57, 205, 198, 155, 65, 208, 108, 137, 233, 118, 24, 196,
-			193, 106, 103, 109, 58, 246, 54, 68, 20, 187, 27, 237, 10, 62,
-			178, 7, 101, 131, 138, 110, 80, 186, 196, 250, 86, 116, 27, 123,
-			140, 245, 70, 162, 30, 248, 141, 104, 140, 112, 50, 97, 212, 52,
-			104, 143, 176, 130, 239, 250, 65, 52, 70, 57, 153, 40, 212, 36,
-			112, 229, 199, 216, 129, 122, 176, 81, 233, 194, 121, 101, 127, 130,
-			113, 9, 30, 45, 145, 231, 206, 53, 189, 120, 189, 179, 90, 169,
-			7, 27, 211, 205, 160, 229, 250, 205, 148, 196, 118, 188, 221, 22,
-			81, 74, 233, 127, 34, 228, 159, 80, 227, 198, 210, 149, 215, 233,
-			241, 27, 18, 243, 146, 106, 91, 185, 45, 90, 173, 119, 251, 193,
-			150, 191, 2, 223, 60, 253, 71, 39, 153, 101, 155, 199, 123, 94,
-			32, 236, 183, 6, 24, 25, 176, 141, 227, 61, 246, 133, 127, 61,
-			192, 241, 131, 122, 208, 226, 87, 58, 107, 107, 34, 140, 248, 20,
-			151, 168, 206, 68, 188, 225, 198, 46, 247, 252, 88, 132, 245, 117,
-			215, 111, 10, 190, 22, 132, 27, 110, 204, 248, 213, 160, 189, 29,
-			122, 205, 245, 152, 95, 152, 153, 121, 167, 250, 128, 87, 253, 122,
-			133, 243, 217, 86, 139, 227, 187, 136, 135, 34, 18, 225, 166, 104,
-			84, 24, 95, 143, 227, 118, 116, 113, 122, 186, 33, 54, 69, 43,
-			104, 139, 48, 210, 188, 128, 129, 182, 21, 17, 83, 171, 146, 136,
-			105, 198, 120, 77, 52, 188, 40, 14, 189, 213, 78, 236, 5, 62,
-			119, 253, 6, 239, 68, 130, 123, 62, 143, 130, 78, 88, 23, 248,
-			100, 213, 243, 221, 112, 27, 233, 138, 202, 124, 203, 139, 215, 121,
-			16, 226, 127, 131, 78, 204, 248, 70, 208, 240, 214, 188, 186, 11,
-			24, 202, 220, 13, 5, 111, 139, 112, 195, 139, 99, 209, 224, 237,
-			48, 216, 244, 26, 162, 193, 227, 117, 55, 230, 241, 58, 140, 174,
-			213, 10, 182, 60, 191, 201, 97, 38, 61, 248, 40, 130, 143, 24,
-			223, 16, 241, 69, 198, 56, 252, 115, 182, 139, 176, 136, 7, 107,
-			154, 162, 122, 208, 16, 124, 163, 19, 197, 60, 20, 177, 235, 249,
-			136, 213, 93, 13, 54, 225, 149, 226, 24, 227, 126, 16, 123, 117,
-			81, 230, 241, 186, 23, 241, 150, 23, 197, 128, 33, 219, 163, 223,
-			232, 34, 167, 225, 69, 245, 150, 235, 109, 136, 176, 178, 23, 17,
-			158, 159, 229, 133, 38, 162, 29, 6, 141, 78, 93, 164, 116, 176,
-			148, 144, 239, 139, 14, 198, 213, 232, 26, 65, 189, 179, 33, 252,
-			216, 213, 147, 52, 29, 132, 60, 136, 215, 69, 200, 55, 220, 88,
-			132, 158, 219, 138, 82, 86, 227, 4, 197, 235, 130, 241, 44, 245,
-			201, 160, 22, 132, 135, 95, 2, 98, 223, 221, 16, 64, 80, 86,
-			182, 252, 32, 125, 135, 124, 247, 226, 8, 70, 228, 75, 84, 65,
-			24, 241, 13, 119, 155, 175, 10, 144, 148, 6, 143, 3, 46, 252,
-			70, 16, 70, 2, 132, 162, 29, 6, 27, 65, 44, 184, 228, 73,
-			28, 241, 134, 8, 189, 77, 209, 224, 107, 97, 176, 193, 36, 23,
-			162, 96, 45, 222, 2, 49, 81, 18, 196, 163, 182, 168, 131, 4,
-			241, 118, 232, 129, 96, 133, 32, 59, 190, 148, 162, 40, 66, 218,
-			25, 95, 185, 89, 93, 230, 203, 139, 215, 87, 110, 207, 214, 230,
-			120, 117, 153, 47, 213, 22, 223, 91, 189, 54, 119, 141, 95, 121,
-			150, 175, 220, 156, 227, 87, 23, 151, 158, 173, 85, 111, 220, 92,
-			225, 55, 23, 231, 175, 205, 213, 150, 249, 236, 194, 53, 126, 117,
-			113, 97, 165, 86, 189, 114, 107, 101, 177, 182, 204, 120, 105, 118,
-			153, 87, 151, 75, 248, 102, 118, 225, 89, 62, 247, 195, 75, 181,
-			185, 229, 101, 190, 88, 227, 213, 103, 150, 230, 171, 115, 215, 248,
-			237, 217, 90, 109, 118, 97, 165, 58, 183, 92, 230, 213, 133, 171,
-			243, 183, 174, 85, 23, 110, 148, 249, 149, 91, 43, 124, 97, 113,
-			133, 241, 249, 234, 51, 213, 149, 185, 107, 124, 101, 177, 140, 221,
-			238, 252, 142, 47, 94, 231, 207, 204, 213, 174, 222, 156, 93, 88,
-			153, 189, 82, 157, 175, 174, 60, 139, 29, 94, 175, 174, 44, 64,
-			103, 215, 23, 107, 140, 207, 242, 165, 217, 218, 74, 245, 234, 173,
-			249, 217, 26, 95, 186, 85, 91, 90, 92, 158, 227, 48, 178, 107,
-			213, 229, 171, 243, 179, 213, 103, 230, 174, 85, 120, 117, 129, 47,
-			44, 242, 185, 247, 206, 45, 172, 240, 229, 155, 179, 243, 243, 249,
-			129, 50, 190, 120, 123, 97, 174, 6, 212, 103, 135, 201, 175, 204,
-			241, 249, 234, 236, 149, 249, 57, 232, 10, 199, 121, 173, 90, 155,
-			187, 186, 2, 3, 74, 255, 186, 90, 189, 54, 183, 176, 50, 59,
-			95, 102, 124, 121, 105, 238, 106, 117, 118, 190, 204, 231, 126, 120,
-			238, 153, 165, 249, 217, 218, 179, 101, 133, 116, 121, 238, 61, 183,
-			230, 22, 86, 170, 179, 243, 252, 218, 236, 51, 179, 55, 230, 150,
-			249, 196, 131, 184, 178, 84, 91, 188, 122, 171, 54, 247, 12, 80,
-			189, 120, 157, 47, 223, 186, 178, 188, 82, 93, 185, 181, 50, 199,
-			111, 44, 46, 94, 67, 102, 47, 207, 213, 222, 91, 189, 58, 183,
-			124, 137, 207, 47, 46, 35, 195, 110, 45, 207, 149, 25, 191, 54,
-			187, 50, 139, 93, 47, 213, 22, 175, 87, 87, 150, 47, 193, 223,
-			87, 110, 45, 87, 145, 113, 213, 133, 149, 185, 90, 237, 214, 210,
-			74, 117, 113, 97, 146, 223, 92, 188, 61, 247, 222, 185, 26, 191,
-			58, 123, 107, 121, 238, 26, 114, 120, 113, 1, 70, 11, 178, 50,
-			183, 88, 123, 22, 208, 2, 31, 112, 6, 202, 252, 246, 205, 185,
-			149, 155, 115, 53, 96, 42, 114, 107, 22, 216, 176, 188, 82, 171,
-			94, 93, 201, 54, 91, 172, 241, 149, 197, 218, 10, 203, 140, 147,
-			47, 204, 221, 152, 175, 222, 152, 91, 184, 58, 7, 175, 23, 1,
-			205, 237, 234, 242, 220, 36, 159, 173, 85, 151, 161, 65, 21, 59,
-			230, 183, 103, 159, 229, 139, 183, 112, 212, 48, 81, 183, 150, 231,
-			152, 252, 59, 35, 186, 101, 156, 79, 94, 189, 206, 103, 175, 189,
-			183, 10, 148, 171, 214, 75, 139, 203, 203, 85, 37, 46, 200, 182,
-			171, 55, 21, 207, 43, 140, 21, 25, 161, 182, 193, 139, 135, 224,
-			175, 162, 109, 148, 122, 46, 177, 126, 102, 22, 191, 209, 219, 35,
-			129, 1, 86, 0, 128, 218, 70, 169, 247, 16, 219, 199, 44, 132,
-			122, 36, 184, 159, 245, 74, 144, 72, 88, 53, 238, 181, 141, 146,
-			115, 81, 97, 124, 164, 103, 92, 97, 36, 18, 144, 141, 160, 219,
-			71, 18, 140, 4, 48, 62, 146, 96, 36, 136, 241, 145, 4, 35,
-			49, 108, 227, 17, 231, 184, 194, 120, 178, 231, 138, 194, 72, 37,
-			32, 27, 81, 128, 122, 135, 21, 70, 10, 24, 1, 148, 24, 41,
-			98, 4, 88, 53, 238, 181, 141, 147, 35, 179, 10, 227, 169, 158,
-			178, 194, 104, 72, 64, 54, 50, 168, 109, 156, 234, 61, 160, 48,
-			26, 128, 17, 64, 137, 209, 64, 140, 0, 171, 198, 189, 182, 113,
-			106, 244, 156, 194, 120, 186, 103, 90, 97, 52, 37, 32, 27, 153,
-			212, 54, 78, 247, 30, 81, 24, 77, 192, 8, 160, 196, 104, 34,
-			70, 128, 85, 227, 94, 219, 56, 125, 188, 162, 48, 158, 233, 41,
-			41, 140, 5, 9, 200, 70, 5, 106, 27, 103, 122, 29, 133, 177,
-			0, 24, 1, 148, 24, 11, 136, 17, 96, 213, 216, 176, 141, 51,
-			199, 78, 40, 140, 19, 61, 39, 20, 70, 75, 2, 178, 145, 69,
-			109, 99, 162, 119, 76, 97, 180, 0, 35, 128, 18, 163, 133, 24,
-			1, 86, 141, 123, 109, 99, 226, 8, 103, 191, 58, 200, 168, 217,
-			99, 155, 119, 122, 94, 32, 206, 47, 15, 242, 89, 158, 248, 70,
-			104, 201, 68, 36, 252, 56, 226, 46, 111, 7, 158, 31, 163, 253,
-			241, 54, 192, 31, 104, 136, 182, 240, 27, 194, 71, 251, 229, 250,
-			219, 242, 249, 75, 129, 47, 24, 232, 253, 186, 219, 18, 126, 195,
-			13, 203, 41, 22, 209, 224, 110, 196, 149, 195, 134, 118, 110, 45,
-			116, 235, 169, 53, 215, 47, 192, 88, 131, 247, 134, 48, 120, 51,
-			65, 75, 58, 35, 158, 207, 111, 173, 92, 229, 115, 237, 160, 190,
-			142, 221, 85, 120, 53, 230, 94, 196, 133, 15, 62, 0, 120, 42,
-			96, 47, 209, 210, 45, 133, 65, 75, 180, 99, 175, 206, 111, 132,
-			162, 25, 132, 158, 235, 243, 171, 138, 38, 190, 181, 238, 213, 215,
-			185, 184, 23, 11, 232, 16, 108, 91, 218, 72, 19, 206, 248, 170,
-			91, 127, 97, 203, 13, 161, 69, 192, 183, 133, 27, 242, 192, 223,
-			209, 165, 27, 69, 157, 13, 232, 213, 109, 181, 248, 134, 231, 119,
-			98, 129, 222, 11, 127, 98, 134, 37, 67, 106, 5, 126, 179, 204,
-			189, 138, 168, 240, 150, 112, 219, 233, 80, 67, 193, 75, 209, 134,
-			112, 67, 209, 40, 241, 40, 144, 78, 145, 31, 100, 91, 49, 30,
-			187, 171, 45, 1, 125, 250, 66, 64, 151, 107, 65, 40, 221, 195,
-			54, 248, 59, 104, 202, 121, 13, 29, 69, 47, 82, 102, 117, 102,
-			102, 230, 252, 20, 254, 111, 101, 102, 230, 34, 254, 239, 57, 24,
-			197, 147, 79, 62, 249, 228, 212, 249, 11, 83, 143, 158, 95, 185,
-			240, 232, 197, 199, 159, 188, 248, 248, 147, 149, 39, 245, 63, 207,
-			85, 24, 191, 178, 13, 12, 143, 67, 175, 30, 35, 43, 21, 73,
-			33, 160, 47, 243, 45, 193, 133, 31, 117, 66, 33, 159, 110, 9,
-			94, 7, 142, 5, 254, 166, 8, 99, 30, 7, 76, 205, 106, 176,
-			193, 121, 237, 250, 85, 254, 232, 163, 143, 62, 9, 238, 172, 224,
-			128, 210, 111, 70, 21, 198, 151, 133, 224, 63, 162, 253, 210, 173,
-			173, 173, 138, 39, 226, 181, 74, 16, 54, 167, 195, 181, 58, 252,
-			31, 62, 170, 196, 247, 226, 31, 157, 120, 152, 86, 147, 224, 10,
-			204, 221, 115, 55, 218, 45, 193, 207, 95, 228, 87, 131, 141, 118,
-			39, 22, 25, 41, 70, 114, 150, 22, 151, 171, 63, 204, 239, 130,
-			208, 76, 76, 222, 173, 40, 175, 50, 109, 148, 56, 247, 151, 228,
-			155, 116, 91, 18, 137, 248, 142, 154, 175, 9, 252, 124, 225, 214,
-			252, 252, 228, 228, 174, 237, 80, 108, 39, 102, 38, 47, 101, 104,
-			186, 240, 32, 154, 154, 34, 6, 44, 193, 90, 195, 221, 206, 208,
-			22, 197, 97, 167, 30, 99, 7, 155, 110, 139, 199, 155, 170, 199,
-			92, 243, 211, 241, 102, 153, 35, 65, 151, 222, 234, 144, 54, 43,
-			241, 38, 64, 247, 27, 145, 108, 212, 137, 68, 157, 159, 229, 231,
-			103, 102, 242, 35, 124, 116, 207, 17, 222, 246, 252, 71, 47, 240,
-			187, 55, 68, 188, 188, 29, 197, 98, 3, 94, 207, 70, 215, 189,
-			150, 88, 201, 79, 196, 245, 234, 252, 220, 74, 245, 153, 57, 190,
-			22, 43, 50, 246, 250, 230, 244, 90, 172, 41, 189, 85, 93, 88,
-			121, 226, 49, 30, 123, 245, 23, 34, 126, 153, 79, 76, 76, 200,
-			39, 147, 107, 113, 165, 177, 117, 211, 107, 174, 95, 115, 99, 252,
-			106, 146, 63, 245, 20, 127, 244, 194, 36, 255, 32, 199, 119, 243,
-			193, 150, 126, 165, 249, 54, 61, 205, 103, 129, 222, 70, 176, 21,
-			33, 74, 88, 76, 231, 103, 102, 50, 170, 40, 170, 36, 13, 4,
-			170, 160, 243, 79, 236, 92, 101, 9, 54, 248, 252, 252, 19, 143,
-			61, 246, 216, 59, 30, 125, 98, 102, 38, 89, 242, 171, 98, 45,
-			8, 5, 191, 229, 123, 247, 52, 150, 39, 223, 49, 211, 141, 165,
-			242, 214, 38, 115, 66, 142, 159, 79, 76, 72, 166, 76, 227, 100,
-			193, 63, 147, 124, 42, 75, 206, 3, 36, 24, 240, 0, 187, 52,
-			158, 83, 25, 60, 40, 0, 147, 57, 1, 120, 108, 79, 1, 120,
-			218, 221, 116, 249, 93, 57, 145, 149, 122, 39, 12, 133, 31, 67,
-			147, 103, 188, 86, 203, 139, 50, 2, 0, 26, 146, 111, 224, 83,
-			126, 153, 239, 253, 193, 125, 196, 156, 95, 78, 159, 86, 124, 177,
-			117, 165, 227, 181, 26, 34, 156, 152, 132, 129, 45, 43, 14, 169,
-			46, 36, 99, 38, 37, 46, 248, 7, 218, 44, 200, 177, 123, 126,
-			12, 35, 87, 45, 229, 208, 213, 176, 145, 3, 147, 149, 85, 192,
-			140, 180, 164, 60, 120, 124, 79, 30, 168, 81, 104, 187, 201, 151,
-			182, 227, 117, 185, 131, 201, 177, 63, 75, 254, 196, 100, 247, 220,
-			220, 16, 241, 213, 148, 27, 19, 147, 12, 255, 49, 76, 48, 234,
-			119, 138, 195, 236, 227, 132, 153, 38, 186, 119, 13, 58, 226, 252,
-			12, 225, 181, 212, 118, 107, 209, 11, 214, 208, 124, 34, 29, 145,
-			231, 215, 179, 82, 200, 118, 23, 67, 254, 12, 236, 104, 87, 133,
-			28, 201, 30, 86, 133, 237, 102, 86, 158, 227, 158, 95, 111, 117,
-			34, 111, 83, 84, 24, 219, 199, 10, 64, 157, 105, 155, 13, 122,
-			7, 29, 47, 0, 11, 64, 109, 175, 134, 136, 109, 52, 138, 131,
-			26, 50, 108, 163, 97, 31, 96, 95, 151, 227, 34, 182, 241, 60,
-			181, 157, 223, 39, 124, 33, 240, 167, 124, 209, 116, 99, 111, 83,
-			228, 253, 7, 87, 13, 148, 131, 9, 221, 205, 127, 168, 240, 5,
-			245, 161, 182, 204, 124, 211, 109, 117, 68, 36, 55, 200, 41, 50,
-			220, 198, 71, 177, 215, 106, 241, 117, 119, 83, 112, 63, 219, 39,
-			162, 86, 31, 50, 105, 7, 235, 65, 199, 143, 193, 44, 131, 183,
-			160, 93, 164, 110, 222, 41, 243, 91, 86, 255, 103, 187, 240, 135,
-			152, 182, 249, 60, 109, 140, 40, 30, 144, 2, 140, 90, 243, 135,
-			0, 15, 138, 251, 52, 100, 216, 198, 243, 67, 195, 171, 22, 198,
-			112, 30, 101, 127, 56, 194, 230, 51, 33, 172, 86, 167, 238, 225,
-			191, 166, 154, 193, 116, 43, 104, 54, 130, 230, 180, 219, 246, 166,
-			133, 223, 64, 63, 46, 154, 174, 7, 65, 216, 240, 124, 55, 14,
-			66, 104, 16, 77, 111, 158, 159, 142, 98, 55, 86, 81, 50, 219,
-			146, 95, 57, 15, 10, 216, 149, 254, 190, 193, 246, 207, 7, 205,
-			229, 56, 20, 238, 198, 50, 96, 176, 31, 97, 251, 240, 221, 157,
-			77, 17, 194, 110, 29, 99, 117, 125, 181, 1, 124, 248, 94, 249,
-			204, 126, 140, 245, 214, 67, 225, 198, 162, 129, 33, 187, 254, 11,
-			78, 119, 152, 174, 146, 172, 135, 154, 110, 106, 159, 98, 251, 99,
-			17, 110, 120, 190, 219, 186, 3, 62, 232, 189, 49, 3, 227, 128,
-			251, 244, 211, 42, 60, 180, 159, 98, 189, 110, 88, 95, 247, 54,
-			197, 152, 137, 200, 75, 21, 57, 158, 74, 158, 212, 202, 172, 108,
-			85, 245, 215, 130, 154, 254, 196, 30, 101, 86, 187, 19, 54, 69,
-			99, 172, 192, 201, 68, 177, 166, 32, 231, 127, 32, 172, 63, 243,
-			129, 125, 132, 245, 33, 13, 119, 58, 97, 75, 141, 177, 136, 15,
-			110, 133, 45, 251, 24, 99, 17, 118, 132, 111, 41, 190, 237, 147,
-			79, 224, 245, 97, 86, 108, 184, 177, 139, 47, 13, 124, 217, 11,
-			48, 188, 114, 88, 177, 30, 128, 82, 137, 37, 245, 197, 90, 2,
-			219, 167, 217, 96, 43, 104, 222, 17, 126, 28, 110, 223, 65, 185,
-			67, 26, 141, 218, 190, 86, 208, 156, 131, 167, 87, 225, 225, 211,
-			255, 122, 136, 89, 182, 105, 246, 204, 16, 246, 25, 130, 129, 71,
-			179, 199, 190, 240, 58, 201, 197, 16, 207, 63, 193, 87, 214, 5,
-			159, 191, 117, 181, 202, 103, 59, 241, 122, 16, 70, 149, 61, 2,
-			137, 183, 34, 12, 11, 169, 112, 77, 26, 118, 243, 34, 222, 12,
-			54, 69, 232, 131, 247, 237, 55, 84, 20, 105, 182, 237, 214, 1,
-			177, 87, 23, 126, 36, 202, 92, 205, 57, 191, 80, 153, 209, 75,
-			198, 245, 113, 105, 4, 29, 191, 161, 131, 90, 243, 213, 171, 115,
-			11, 203, 115, 124, 205, 107, 137, 100, 135, 107, 21, 247, 179, 62,
-			70, 141, 30, 219, 40, 246, 78, 176, 215, 136, 220, 174, 236, 235,
-			153, 33, 206, 207, 18, 158, 159, 78, 32, 199, 229, 171, 94, 195,
-			11, 5, 174, 101, 183, 197, 81, 168, 229, 122, 149, 241, 41, 216,
-			69, 180, 193, 41, 149, 31, 130, 195, 223, 138, 64, 29, 239, 196,
-			37, 54, 86, 69, 163, 33, 221, 111, 159, 207, 233, 197, 195, 67,
-			241, 98, 71, 68, 241, 116, 40, 162, 118, 224, 71, 66, 57, 107,
-			128, 69, 43, 229, 125, 197, 81, 118, 77, 235, 228, 193, 226, 9,
-			231, 29, 50, 230, 171, 89, 225, 201, 125, 135, 150, 117, 174, 150,
-			10, 58, 247, 146, 203, 72, 74, 78, 119, 14, 22, 247, 141, 101,
-			116, 231, 96, 113, 127, 70, 119, 14, 14, 30, 205, 232, 206, 193,
-			113, 206, 150, 180, 234, 180, 139, 21, 231, 42, 78, 53, 234, 254,
-			173, 117, 33, 25, 222, 10, 154, 170, 27, 190, 229, 194, 168, 154,
-			94, 20, 139, 48, 19, 49, 228, 87, 83, 53, 145, 83, 83, 118,
-			113, 240, 132, 86, 69, 22, 244, 112, 34, 163, 166, 236, 210, 100,
-			70, 77, 217, 229, 41, 182, 137, 164, 80, 219, 24, 45, 158, 112,
-			60, 36, 69, 117, 140, 235, 69, 138, 86, 150, 160, 51, 17, 215,
-			43, 154, 111, 136, 40, 114, 155, 176, 243, 146, 173, 228, 92, 122,
-			17, 159, 58, 95, 102, 201, 119, 200, 50, 80, 217, 18, 129, 231,
-			55, 19, 130, 169, 105, 155, 163, 69, 187, 162, 136, 162, 5, 160,
-			67, 107, 82, 96, 208, 232, 126, 205, 59, 106, 216, 198, 232, 56,
-			103, 55, 129, 96, 163, 199, 54, 15, 211, 9, 195, 185, 200, 51,
-			203, 30, 35, 159, 174, 135, 65, 106, 124, 200, 27, 34, 118, 189,
-			86, 164, 230, 46, 59, 140, 10, 147, 120, 13, 152, 161, 195, 236,
-			32, 123, 15, 179, 0, 2, 161, 56, 98, 30, 118, 174, 32, 43,
-			84, 176, 117, 57, 14, 66, 183, 41, 248, 173, 218, 60, 204, 81,
-			40, 118, 242, 68, 114, 203, 75, 186, 110, 84, 24, 27, 100, 189,
-			18, 165, 105, 155, 71, 204, 195, 24, 138, 145, 15, 10, 208, 9,
-			75, 97, 98, 27, 71, 250, 71, 82, 216, 176, 141, 35, 135, 198,
-			216, 143, 40, 154, 136, 109, 28, 51, 29, 103, 254, 77, 210, 20,
-			186, 91, 122, 50, 101, 230, 98, 87, 234, 64, 104, 142, 153, 71,
-			14, 39, 189, 131, 117, 59, 150, 161, 14, 4, 231, 88, 255, 193,
-			20, 54, 108, 227, 216, 216, 97, 246, 156, 162, 142, 218, 198, 184,
-			57, 230, 188, 251, 77, 82, 231, 70, 145, 216, 88, 109, 137, 198,
-			253, 136, 3, 1, 25, 55, 143, 57, 73, 231, 32, 34, 227, 25,
-			226, 128, 55, 227, 253, 7, 82, 216, 176, 141, 241, 209, 67, 236,
-			107, 68, 81, 103, 216, 198, 73, 115, 212, 249, 109, 130, 66, 26,
-			118, 68, 25, 3, 2, 64, 10, 232, 106, 79, 128, 231, 31, 111,
-			9, 225, 243, 25, 25, 226, 207, 89, 49, 190, 5, 196, 39, 148,
-			241, 234, 26, 227, 107, 110, 43, 210, 121, 2, 207, 111, 120, 117,
-			55, 22, 81, 154, 54, 233, 90, 188, 126, 0, 126, 136, 180, 18,
-			173, 109, 222, 10, 220, 6, 234, 58, 112, 207, 48, 88, 176, 33,
-			26, 158, 220, 137, 75, 158, 37, 90, 64, 246, 234, 182, 100, 51,
-			248, 67, 220, 107, 123, 97, 142, 65, 134, 105, 155, 39, 205, 241,
-			177, 132, 1, 70, 1, 6, 92, 76, 97, 98, 27, 39, 251, 134,
-			83, 24, 24, 50, 114, 144, 61, 162, 248, 99, 218, 198, 25, 243,
-			184, 51, 130, 179, 231, 119, 54, 86, 69, 8, 139, 30, 6, 145,
-			246, 98, 154, 182, 121, 198, 60, 57, 154, 96, 49, 11, 240, 89,
-			95, 10, 19, 219, 56, 195, 82, 25, 50, 13, 219, 56, 115, 244,
-			24, 115, 81, 189, 24, 182, 113, 142, 58, 206, 10, 76, 1, 186,
-			108, 94, 171, 220, 205, 170, 204, 252, 151, 85, 170, 197, 139, 248,
-			154, 39, 90, 141, 238, 117, 237, 182, 152, 94, 217, 137, 38, 1,
-			62, 156, 163, 19, 134, 210, 22, 134, 5, 93, 106, 77, 2, 60,
-			56, 183, 255, 160, 134, 128, 156, 177, 195, 236, 67, 72, 155, 105,
-			27, 211, 197, 49, 39, 228, 213, 204, 76, 10, 46, 29, 11, 101,
-			163, 208, 135, 109, 5, 205, 10, 159, 69, 146, 113, 170, 215, 93,
-			144, 28, 225, 235, 166, 94, 196, 3, 191, 181, 205, 184, 91, 127,
-			193, 15, 182, 90, 162, 209, 148, 57, 23, 183, 177, 225, 249, 94,
-			20, 135, 210, 77, 173, 183, 60, 112, 254, 19, 202, 129, 183, 211,
-			197, 115, 142, 162, 14, 56, 59, 93, 28, 208, 16, 177, 141, 233,
-			125, 218, 47, 7, 174, 78, 143, 30, 74, 124, 203, 255, 230, 36,
-			59, 222, 237, 5, 54, 58, 161, 140, 60, 237, 145, 181, 189, 200,
-			138, 215, 84, 147, 55, 157, 180, 253, 224, 238, 73, 219, 125, 26,
-			161, 206, 217, 158, 125, 112, 206, 86, 147, 249, 38, 82, 182, 175,
-			151, 100, 202, 182, 249, 131, 148, 237, 15, 82, 182, 63, 72, 217,
-			254, 32, 101, 251, 131, 148, 237, 15, 82, 182, 255, 5, 167, 108,
-			103, 179, 41, 219, 217, 183, 158, 178, 253, 161, 191, 198, 148, 109,
-			37, 155, 178, 173, 188, 245, 148, 237, 212, 223, 136, 148, 237, 207,
-			13, 200, 24, 200, 115, 61, 77, 226, 252, 228, 0, 159, 229, 218,
-			51, 202, 103, 108, 35, 175, 233, 131, 155, 187, 230, 221, 19, 141,
-			169, 150, 240, 155, 241, 58, 143, 218, 174, 143, 219, 109, 111, 67,
-			100, 83, 179, 140, 187, 240, 141, 12, 101, 102, 179, 177, 123, 167,
-			105, 51, 81, 86, 150, 11, 179, 202, 12, 233, 46, 41, 98, 157,
-			91, 69, 172, 245, 192, 175, 139, 118, 12, 22, 245, 5, 193, 75,
-			13, 119, 187, 132, 153, 227, 210, 70, 224, 199, 235, 37, 141, 38,
-			20, 45, 55, 150, 54, 42, 13, 172, 163, 77, 85, 206, 72, 195,
-			3, 79, 72, 248, 117, 161, 183, 88, 140, 199, 91, 217, 214, 42,
-			202, 139, 193, 161, 132, 85, 64, 130, 151, 4, 162, 220, 6, 88,
-			221, 32, 228, 81, 103, 53, 134, 225, 2, 71, 48, 112, 235, 102,
-			178, 10, 105, 154, 213, 109, 183, 195, 224, 158, 7, 150, 187, 181,
-			205, 207, 77, 157, 159, 41, 207, 204, 204, 96, 150, 56, 218, 35,
-			35, 153, 244, 140, 104, 115, 20, 162, 87, 210, 142, 68, 167, 17,
-			160, 107, 180, 51, 63, 25, 197, 110, 24, 243, 203, 188, 82, 169,
-			92, 234, 126, 39, 252, 70, 238, 77, 210, 145, 246, 126, 245, 91,
-			249, 58, 113, 221, 245, 76, 94, 6, 12, 9, 52, 37, 251, 210,
-			240, 165, 174, 143, 100, 248, 91, 126, 34, 255, 214, 31, 32, 164,
-			59, 241, 214, 248, 196, 142, 142, 158, 226, 51, 252, 244, 233, 110,
-			92, 239, 226, 51, 147, 252, 3, 58, 21, 179, 227, 163, 115, 151,
-			249, 249, 75, 59, 222, 170, 174, 47, 39, 137, 169, 153, 25, 213,
-			232, 101, 46, 90, 224, 253, 102, 8, 136, 18, 100, 239, 218, 149,
-			130, 167, 238, 79, 193, 212, 125, 40, 56, 183, 27, 5, 15, 149,
-			253, 77, 193, 115, 233, 140, 189, 121, 49, 216, 115, 178, 247, 22,
-			18, 249, 42, 59, 231, 151, 243, 115, 206, 207, 237, 96, 194, 165,
-			244, 35, 45, 1, 153, 89, 207, 126, 176, 67, 12, 210, 111, 242,
-			124, 206, 9, 93, 150, 197, 233, 7, 187, 114, 55, 157, 223, 180,
-			225, 187, 178, 13, 247, 232, 227, 220, 238, 125, 236, 42, 67, 187,
-			103, 183, 243, 43, 184, 225, 198, 2, 19, 61, 240, 175, 134, 104,
-			225, 14, 176, 59, 187, 7, 76, 223, 217, 112, 162, 225, 110, 71,
-			151, 31, 45, 235, 130, 145, 203, 231, 117, 46, 50, 51, 139, 186,
-			183, 137, 174, 87, 149, 235, 97, 128, 89, 113, 137, 42, 110, 228,
-			146, 129, 207, 21, 135, 88, 91, 199, 157, 223, 79, 71, 156, 58,
-			95, 70, 59, 144, 77, 3, 130, 218, 204, 26, 130, 174, 92, 213,
-			212, 163, 231, 31, 47, 63, 254, 142, 39, 64, 165, 193, 255, 25,
-			40, 223, 115, 93, 15, 247, 200, 239, 189, 159, 62, 103, 103, 98,
-			212, 239, 207, 229, 247, 222, 159, 203, 239, 189, 223, 62, 192, 254,
-			43, 67, 7, 169, 215, 168, 237, 252, 21, 213, 196, 190, 185, 204,
-			94, 118, 76, 44, 29, 148, 230, 96, 196, 91, 34, 194, 208, 153,
-			207, 3, 95, 36, 216, 194, 156, 5, 148, 123, 46, 151, 207, 48,
-			126, 87, 241, 234, 174, 138, 12, 129, 173, 112, 121, 59, 136, 60,
-			140, 173, 4, 33, 79, 210, 129, 119, 145, 32, 213, 176, 194, 175,
-			7, 97, 50, 81, 17, 146, 146, 233, 48, 8, 249, 70, 16, 138,
-			50, 119, 49, 62, 245, 146, 8, 3, 21, 204, 214, 145, 227, 28,
-			54, 185, 55, 93, 21, 44, 25, 158, 139, 153, 219, 166, 15, 182,
-			26, 155, 231, 233, 236, 158, 198, 92, 186, 17, 166, 48, 243, 96,
-			143, 244, 227, 26, 125, 127, 54, 253, 184, 150, 75, 63, 174, 229,
-			210, 143, 107, 153, 244, 227, 95, 212, 216, 133, 135, 72, 63, 182,
-			130, 102, 123, 21, 254, 173, 194, 70, 5, 124, 240, 192, 28, 163,
-			243, 128, 240, 83, 233, 207, 41, 59, 144, 100, 111, 174, 137, 168,
-			30, 122, 237, 56, 8, 49, 145, 23, 138, 53, 239, 158, 202, 206,
-			41, 200, 182, 153, 9, 59, 99, 149, 149, 195, 191, 237, 11, 172,
-			95, 229, 235, 226, 237, 182, 192, 156, 220, 254, 11, 195, 21, 164,
-			176, 34, 49, 175, 108, 183, 69, 77, 101, 245, 224, 111, 251, 4,
-			27, 128, 61, 181, 240, 99, 249, 145, 137, 248, 250, 213, 51, 108,
-			242, 78, 214, 151, 140, 6, 83, 117, 247, 79, 116, 166, 141, 237,
-			119, 50, 51, 118, 155, 209, 152, 197, 141, 137, 254, 11, 39, 21,
-			37, 187, 12, 179, 178, 226, 54, 35, 204, 253, 213, 240, 11, 251,
-			52, 27, 148, 33, 142, 59, 107, 94, 75, 220, 17, 247, 226, 177,
-			94, 164, 108, 159, 124, 124, 221, 107, 137, 185, 123, 177, 243, 14,
-			214, 151, 124, 106, 15, 49, 227, 5, 177, 173, 24, 5, 127, 218,
-			35, 172, 128, 210, 169, 216, 36, 129, 139, 244, 157, 164, 244, 60,
-			51, 87, 196, 189, 216, 62, 205, 10, 45, 207, 23, 209, 24, 65,
-			26, 135, 20, 141, 240, 174, 50, 239, 249, 162, 38, 95, 59, 23,
-			153, 9, 96, 138, 145, 100, 48, 218, 71, 89, 95, 67, 180, 188,
-			13, 47, 22, 161, 78, 148, 38, 15, 74, 143, 49, 235, 10, 82,
-			13, 179, 25, 172, 173, 69, 34, 198, 207, 205, 154, 130, 96, 54,
-			27, 110, 236, 226, 167, 3, 53, 252, 187, 244, 15, 9, 43, 94,
-			115, 99, 183, 25, 186, 27, 73, 3, 146, 54, 176, 207, 179, 222,
-			182, 27, 198, 158, 219, 82, 233, 231, 67, 138, 120, 253, 85, 101,
-			73, 190, 174, 233, 118, 206, 13, 214, 171, 158, 193, 64, 100, 246,
-			25, 80, 238, 171, 73, 0, 250, 137, 188, 151, 36, 191, 204, 26,
-			254, 13, 207, 90, 110, 20, 163, 60, 21, 107, 248, 119, 233, 147,
-			148, 21, 231, 85, 186, 214, 190, 200, 250, 97, 206, 239, 100, 134,
-			214, 127, 225, 240, 14, 17, 209, 186, 172, 198, 160, 245, 162, 28,
-			249, 9, 54, 32, 37, 90, 229, 194, 101, 199, 253, 242, 153, 204,
-			132, 159, 96, 3, 74, 172, 211, 116, 185, 89, 83, 162, 46, 155,
-			56, 172, 24, 137, 23, 59, 224, 65, 163, 4, 155, 181, 4, 182,
-			79, 48, 51, 6, 249, 97, 72, 86, 127, 102, 130, 111, 246, 212,
-			240, 149, 125, 134, 89, 82, 172, 198, 250, 177, 209, 62, 213, 72,
-			206, 218, 205, 158, 154, 122, 109, 79, 201, 148, 55, 48, 119, 108,
-			0, 155, 14, 118, 241, 252, 102, 79, 45, 105, 114, 165, 143, 245,
-			170, 133, 84, 250, 101, 3, 25, 38, 201, 173, 48, 179, 33, 162,
-			186, 226, 148, 179, 247, 186, 168, 97, 59, 123, 154, 245, 170, 20,
-			204, 24, 69, 49, 61, 152, 126, 130, 24, 43, 114, 237, 232, 86,
-			246, 89, 54, 12, 211, 116, 39, 199, 90, 201, 183, 65, 120, 177,
-			148, 97, 175, 110, 155, 227, 177, 153, 182, 93, 206, 240, 121, 143,
-			220, 189, 217, 149, 187, 119, 62, 79, 88, 65, 202, 198, 94, 18,
-			159, 157, 49, 186, 99, 198, 6, 118, 33, 252, 190, 50, 97, 238,
-			148, 137, 46, 169, 44, 188, 9, 169, 60, 59, 195, 88, 170, 47,
-			237, 34, 51, 87, 230, 126, 120, 101, 168, 199, 102, 204, 186, 82,
-			93, 152, 173, 61, 59, 68, 236, 1, 86, 188, 54, 187, 50, 123,
-			163, 54, 251, 204, 16, 125, 250, 159, 94, 103, 189, 118, 193, 236,
-			249, 199, 244, 190, 229, 10, 143, 255, 77, 40, 87, 216, 151, 45,
-			87, 128, 63, 137, 109, 244, 245, 158, 97, 156, 209, 66, 143, 109,
-			14, 244, 12, 17, 103, 68, 101, 120, 84, 82, 10, 108, 71, 133,
-			131, 11, 87, 0, 47, 105, 160, 48, 200, 250, 153, 89, 64, 23,
-			110, 31, 237, 7, 155, 91, 144, 14, 212, 62, 106, 105, 136, 218,
-			198, 190, 62, 166, 26, 18, 219, 216, 47, 243, 80, 5, 105, 170,
-			247, 211, 162, 134, 168, 109, 236, 239, 31, 80, 13, 169, 109, 12,
-			210, 65, 245, 10, 62, 27, 164, 76, 67, 240, 110, 223, 126, 246,
-			162, 140, 46, 140, 246, 188, 155, 56, 226, 44, 150, 69, 36, 249,
-			221, 100, 109, 97, 198, 163, 194, 87, 48, 57, 41, 157, 145, 181,
-			78, 171, 197, 35, 129, 155, 125, 207, 151, 9, 16, 224, 34, 48,
-			144, 169, 79, 87, 69, 36, 115, 92, 77, 207, 207, 228, 200, 181,
-			243, 58, 90, 60, 194, 254, 56, 169, 100, 27, 167, 35, 206, 239,
-			17, 150, 169, 22, 56, 19, 113, 41, 199, 124, 34, 20, 47, 118,
-			188, 80, 52, 38, 85, 229, 70, 196, 131, 208, 107, 122, 190, 139,
-			117, 203, 114, 139, 173, 93, 166, 43, 157, 184, 37, 66, 238, 249,
-			81, 236, 250, 117, 193, 183, 176, 76, 96, 29, 252, 63, 151, 203,
-			213, 12, 88, 102, 193, 25, 243, 26, 186, 139, 164, 204, 192, 229,
-			82, 156, 23, 0, 151, 30, 7, 136, 193, 197, 52, 91, 179, 151,
-			243, 83, 15, 54, 54, 2, 95, 251, 64, 152, 148, 202, 122, 203,
-			227, 116, 244, 88, 198, 91, 30, 151, 179, 166, 188, 229, 241, 190,
-			172, 183, 60, 110, 31, 96, 127, 150, 84, 195, 77, 82, 219, 249,
-			35, 197, 155, 92, 190, 27, 179, 1, 121, 238, 232, 73, 210, 161,
-			254, 142, 239, 189, 216, 17, 173, 109, 238, 53, 132, 31, 123, 107,
-			219, 114, 74, 146, 108, 178, 23, 175, 43, 17, 143, 234, 65, 91,
-			36, 233, 131, 246, 14, 78, 97, 103, 127, 221, 124, 2, 183, 116,
-			146, 142, 103, 221, 210, 201, 132, 79, 32, 235, 147, 125, 89, 183,
-			116, 114, 104, 152, 189, 83, 151, 155, 148, 233, 49, 231, 220, 78,
-			38, 41, 187, 130, 11, 47, 203, 44, 158, 41, 24, 41, 211, 73,
-			189, 145, 161, 22, 96, 26, 200, 20, 140, 148, 147, 66, 28, 106,
-			216, 70, 249, 200, 81, 246, 71, 68, 231, 160, 31, 167, 142, 243,
-			191, 116, 139, 237, 94, 61, 234, 233, 81, 174, 62, 119, 125, 126,
-			115, 101, 101, 137, 95, 149, 237, 167, 64, 133, 74, 14, 235, 120,
-			216, 134, 219, 16, 220, 221, 116, 189, 22, 214, 37, 197, 1, 72,
-			255, 181, 160, 201, 116, 202, 87, 102, 246, 95, 236, 136, 112, 59,
-			93, 100, 124, 67, 196, 174, 92, 179, 213, 88, 46, 0, 183, 21,
-			5, 216, 101, 187, 221, 242, 84, 14, 89, 165, 198, 153, 78, 145,
-			1, 215, 240, 171, 108, 2, 252, 113, 90, 214, 66, 107, 20, 96,
-			188, 197, 76, 2, 252, 241, 190, 108, 2, 252, 241, 177, 195, 236,
-			127, 34, 58, 3, 126, 153, 158, 117, 254, 217, 110, 66, 187, 234,
-			70, 34, 83, 9, 187, 11, 127, 252, 64, 167, 204, 101, 8, 4,
-			195, 151, 59, 170, 134, 164, 57, 80, 174, 149, 39, 34, 46, 238,
-			193, 246, 14, 63, 244, 66, 150, 233, 194, 141, 248, 134, 87, 15,
-			245, 86, 82, 154, 185, 72, 235, 13, 93, 18, 144, 203, 158, 95,
-			166, 143, 39, 217, 115, 11, 6, 115, 36, 147, 61, 191, 124, 244,
-			84, 38, 123, 126, 121, 98, 146, 253, 125, 57, 236, 130, 109, 92,
-			163, 227, 206, 79, 194, 176, 93, 172, 82, 114, 125, 238, 134, 171,
-			94, 28, 2, 131, 95, 16, 219, 211, 114, 1, 197, 110, 147, 187,
-			81, 20, 212, 61, 55, 78, 19, 128, 94, 148, 175, 38, 82, 147,
-			157, 204, 53, 24, 38, 156, 106, 220, 66, 166, 77, 37, 79, 27,
-			28, 244, 175, 219, 84, 17, 208, 100, 56, 5, 211, 54, 175, 209,
-			203, 103, 21, 201, 5, 11, 136, 212, 243, 86, 32, 182, 113, 109,
-			84, 15, 181, 96, 216, 198, 181, 99, 199, 217, 63, 144, 195, 177,
-			108, 227, 105, 122, 204, 249, 187, 132, 241, 234, 26, 104, 251, 178,
-			154, 20, 165, 58, 90, 45, 16, 169, 231, 3, 207, 71, 137, 106,
-			10, 76, 83, 54, 58, 33, 30, 145, 209, 149, 38, 113, 192, 67,
-			33, 75, 58, 101, 146, 83, 233, 110, 93, 188, 133, 27, 238, 46,
-			65, 119, 99, 254, 148, 212, 64, 239, 154, 62, 55, 253, 20, 168,
-			158, 119, 85, 96, 163, 161, 7, 101, 153, 182, 249, 52, 189, 54,
-			174, 8, 183, 10, 64, 170, 22, 77, 139, 216, 198, 211, 125, 122,
-			209, 90, 134, 109, 60, 125, 228, 40, 43, 49, 152, 60, 115, 161,
-			231, 71, 137, 51, 202, 193, 171, 213, 4, 168, 245, 42, 173, 178,
-			9, 90, 102, 161, 56, 192, 46, 49, 211, 36, 70, 143, 109, 46,
-			209, 31, 49, 156, 41, 92, 165, 94, 179, 19, 116, 34, 14, 174,
-			48, 199, 221, 142, 78, 7, 123, 33, 79, 118, 49, 81, 133, 99,
-			215, 4, 11, 193, 150, 216, 126, 118, 157, 89, 0, 129, 157, 171,
-			153, 7, 157, 119, 200, 69, 225, 249, 226, 140, 194, 165, 40, 40,
-			99, 101, 15, 110, 214, 27, 192, 67, 15, 243, 177, 10, 109, 133,
-			99, 237, 12, 81, 213, 95, 53, 115, 105, 8, 107, 99, 136, 170,
-			254, 170, 169, 18, 38, 162, 170, 191, 106, 253, 153, 247, 134, 109,
-			212, 14, 140, 176, 127, 78, 20, 37, 196, 54, 158, 51, 15, 59,
-			255, 157, 94, 160, 146, 150, 164, 47, 117, 132, 7, 100, 176, 170,
-			60, 43, 41, 204, 98, 163, 29, 111, 171, 183, 73, 142, 220, 71,
-			139, 0, 99, 240, 252, 142, 72, 124, 38, 31, 89, 140, 174, 42,
-			236, 163, 24, 182, 44, 39, 9, 116, 213, 167, 246, 101, 117, 161,
-			80, 35, 16, 178, 192, 201, 109, 108, 130, 1, 87, 133, 73, 68,
-			149, 149, 61, 103, 214, 14, 38, 195, 2, 243, 240, 92, 102, 216,
-			48, 117, 207, 169, 162, 55, 162, 202, 202, 158, 59, 52, 6, 238,
-			144, 137, 153, 176, 247, 81, 41, 234, 4, 88, 104, 188, 79, 186,
-			67, 0, 89, 182, 241, 190, 254, 65, 13, 17, 219, 120, 223, 208,
-			65, 13, 25, 182, 241, 190, 177, 195, 236, 36, 163, 38, 181, 205,
-			187, 61, 130, 56, 99, 92, 110, 120, 118, 151, 32, 224, 238, 221,
-			226, 126, 118, 131, 153, 38, 166, 203, 86, 233, 136, 115, 17, 25,
-			189, 186, 141, 181, 63, 160, 128, 52, 155, 20, 10, 165, 223, 214,
-			188, 16, 140, 131, 108, 166, 28, 47, 148, 121, 138, 179, 190, 74,
-			239, 14, 33, 93, 20, 231, 124, 85, 201, 188, 204, 194, 173, 42,
-			31, 130, 34, 205, 171, 246, 1, 54, 129, 20, 16, 219, 104, 208,
-			97, 231, 136, 164, 32, 75, 184, 170, 102, 73, 186, 32, 88, 180,
-			191, 58, 162, 208, 144, 76, 209, 62, 69, 238, 54, 84, 25, 17,
-			69, 222, 54, 6, 135, 216, 57, 6, 90, 208, 92, 239, 249, 49,
-			226, 140, 115, 189, 185, 235, 98, 76, 198, 235, 53, 193, 112, 172,
-			23, 135, 88, 137, 153, 38, 166, 254, 158, 167, 195, 206, 65, 169,
-			249, 245, 126, 48, 75, 149, 129, 3, 127, 158, 174, 75, 11, 109,
-			224, 192, 117, 169, 188, 76, 22, 62, 175, 168, 50, 112, 224, 207,
-			15, 14, 177, 15, 131, 6, 51, 96, 245, 182, 233, 7, 13, 39,
-			204, 201, 49, 74, 23, 87, 59, 253, 164, 207, 114, 182, 60, 12,
-			149, 155, 92, 136, 130, 135, 66, 21, 22, 110, 131, 240, 50, 85,
-			193, 213, 93, 8, 138, 46, 177, 70, 166, 106, 65, 13, 84, 1,
-			109, 54, 204, 92, 102, 1, 4, 227, 237, 152, 7, 157, 154, 92,
-			119, 184, 37, 43, 75, 51, 7, 11, 30, 13, 193, 75, 34, 12,
-			202, 201, 142, 38, 97, 201, 90, 232, 54, 55, 132, 159, 200, 13,
-			244, 199, 178, 29, 194, 50, 49, 148, 118, 232, 152, 109, 89, 192,
-			104, 40, 237, 208, 81, 203, 196, 80, 218, 161, 163, 180, 131, 161,
-			180, 67, 231, 192, 8, 171, 40, 26, 137, 109, 220, 51, 71, 156,
-			113, 233, 213, 120, 47, 37, 198, 55, 55, 68, 158, 116, 8, 82,
-			115, 207, 236, 28, 76, 16, 130, 220, 220, 203, 116, 8, 146, 115,
-			175, 127, 48, 133, 13, 219, 184, 103, 31, 96, 43, 170, 67, 106,
-			27, 31, 48, 109, 103, 46, 173, 167, 140, 51, 123, 13, 216, 90,
-			239, 152, 45, 205, 5, 216, 224, 185, 89, 198, 167, 100, 129, 99,
-			247, 1, 243, 222, 72, 210, 45, 216, 231, 15, 168, 58, 70, 67,
-			21, 122, 126, 160, 111, 95, 10, 27, 182, 241, 129, 161, 97, 84,
-			23, 6, 188, 124, 153, 142, 42, 17, 132, 33, 190, 76, 63, 104,
-			40, 49, 35, 22, 188, 236, 211, 16, 52, 101, 195, 26, 50, 108,
-			227, 229, 145, 131, 236, 215, 9, 3, 87, 194, 250, 41, 210, 243,
-			57, 66, 156, 95, 33, 103, 25, 159, 245, 177, 186, 115, 211, 107,
-			116, 220, 180, 96, 116, 59, 241, 124, 146, 170, 68, 84, 12, 157,
-			182, 8, 213, 30, 43, 14, 93, 63, 194, 226, 26, 48, 143, 137,
-			167, 198, 171, 113, 234, 110, 162, 228, 70, 140, 71, 235, 65, 167,
-			213, 0, 211, 156, 28, 28, 77, 149, 50, 54, 190, 23, 239, 90,
-			132, 157, 91, 172, 176, 219, 52, 76, 176, 152, 63, 69, 138, 67,
-			236, 151, 97, 65, 153, 180, 199, 54, 127, 154, 208, 115, 206, 199,
-			115, 62, 175, 246, 208, 80, 173, 37, 69, 237, 90, 41, 234, 193,
-			105, 181, 23, 169, 4, 44, 158, 55, 221, 205, 57, 44, 37, 174,
-			91, 73, 122, 15, 81, 208, 218, 84, 206, 67, 234, 213, 165, 197,
-			243, 186, 26, 233, 106, 210, 225, 32, 43, 0, 177, 166, 109, 253,
-			52, 161, 63, 69, 108, 156, 69, 19, 212, 60, 144, 239, 104, 144,
-			0, 120, 228, 180, 6, 13, 0, 39, 207, 74, 247, 30, 180, 184,
-			249, 11, 132, 58, 206, 151, 212, 88, 85, 105, 186, 170, 203, 206,
-			236, 156, 150, 118, 219, 166, 234, 141, 88, 178, 99, 146, 59, 49,
-			57, 106, 47, 210, 142, 235, 54, 119, 193, 51, 149, 51, 14, 134,
-			50, 20, 122, 95, 173, 66, 84, 12, 119, 175, 234, 172, 115, 202,
-			41, 181, 149, 85, 27, 5, 189, 221, 107, 136, 200, 107, 250, 160,
-			158, 58, 190, 187, 177, 170, 252, 149, 22, 236, 24, 130, 176, 33,
-			148, 81, 151, 236, 33, 166, 109, 253, 2, 161, 63, 77, 206, 41,
-			6, 144, 2, 142, 184, 168, 65, 100, 64, 223, 65, 13, 26, 0,
-			142, 29, 102, 127, 40, 217, 67, 109, 243, 53, 96, 207, 27, 247,
-			99, 15, 120, 48, 234, 232, 197, 46, 236, 233, 230, 141, 98, 5,
-			172, 103, 93, 72, 157, 27, 59, 236, 53, 21, 179, 193, 139, 144,
-			136, 25, 135, 61, 232, 67, 51, 34, 225, 67, 110, 239, 171, 93,
-			110, 201, 25, 106, 218, 214, 107, 132, 254, 2, 209, 146, 66, 11,
-			56, 88, 205, 25, 16, 141, 215, 82, 206, 80, 3, 192, 177, 195,
-			236, 183, 41, 114, 198, 176, 205, 95, 34, 116, 212, 249, 117, 170,
-			22, 73, 151, 139, 147, 51, 245, 122, 209, 161, 34, 144, 171, 52,
-			35, 29, 200, 42, 113, 47, 190, 152, 139, 190, 72, 151, 43, 123,
-			152, 66, 226, 82, 6, 171, 129, 13, 42, 124, 94, 53, 243, 234,
-			88, 145, 222, 244, 124, 153, 92, 116, 99, 180, 49, 21, 166, 188,
-			152, 60, 242, 172, 139, 178, 211, 41, 81, 12, 75, 122, 146, 27,
-			198, 196, 238, 231, 81, 229, 72, 76, 53, 244, 74, 130, 50, 209,
-			230, 235, 174, 58, 239, 32, 41, 148, 228, 169, 217, 48, 76, 219,
-			250, 37, 66, 95, 75, 102, 195, 40, 32, 131, 245, 108, 24, 4,
-			192, 190, 97, 13, 34, 251, 71, 14, 178, 24, 38, 163, 216, 99,
-			91, 191, 66, 232, 175, 18, 195, 105, 200, 217, 208, 12, 87, 100,
-			37, 7, 78, 36, 85, 96, 252, 49, 10, 232, 69, 188, 29, 180,
-			59, 178, 30, 5, 143, 27, 192, 182, 157, 241, 13, 55, 174, 175,
-			107, 197, 117, 38, 226, 119, 51, 137, 164, 187, 106, 127, 98, 22,
-			65, 181, 252, 10, 41, 14, 178, 105, 148, 8, 211, 54, 63, 69,
-			204, 3, 206, 9, 185, 239, 144, 130, 123, 17, 39, 40, 210, 165,
-			241, 160, 150, 149, 203, 99, 194, 166, 19, 190, 208, 67, 4, 53,
-			252, 41, 210, 183, 79, 131, 6, 128, 67, 54, 43, 35, 246, 130,
-			109, 254, 11, 98, 30, 114, 142, 231, 189, 210, 139, 178, 82, 57,
-			18, 232, 54, 36, 168, 11, 22, 54, 103, 26, 36, 0, 246, 107,
-			238, 21, 12, 0, 71, 70, 217, 57, 68, 109, 217, 230, 255, 72,
-			204, 35, 206, 177, 110, 207, 238, 98, 242, 32, 74, 48, 91, 178,
-			245, 128, 6, 9, 128, 251, 244, 42, 177, 12, 0, 199, 28, 246,
-			167, 148, 81, 179, 96, 91, 191, 65, 122, 254, 49, 37, 206, 191,
-			167, 50, 238, 88, 77, 142, 187, 248, 250, 232, 139, 31, 7, 184,
-			131, 142, 167, 66, 17, 197, 202, 82, 224, 137, 6, 169, 66, 114,
-			213, 254, 160, 17, 240, 108, 16, 126, 235, 134, 130, 55, 133, 47,
-			66, 156, 191, 213, 109, 25, 251, 197, 61, 169, 7, 130, 151, 223,
-			164, 98, 156, 203, 79, 66, 34, 221, 135, 8, 120, 36, 80, 25,
-			201, 74, 96, 189, 11, 76, 52, 248, 90, 232, 110, 136, 168, 146,
-			122, 116, 32, 37, 109, 21, 252, 60, 131, 90, 199, 171, 75, 123,
-			159, 84, 126, 128, 98, 148, 61, 148, 85, 172, 77, 237, 140, 188,
-			13, 129, 203, 59, 14, 100, 32, 14, 145, 131, 101, 148, 83, 155,
-			223, 59, 236, 70, 240, 106, 43, 88, 85, 214, 27, 230, 246, 55,
-			192, 122, 127, 9, 84, 118, 1, 172, 247, 111, 18, 58, 238, 252,
-			43, 165, 178, 119, 201, 163, 164, 102, 53, 131, 178, 91, 117, 235,
-			149, 13, 147, 145, 68, 106, 148, 93, 218, 13, 167, 190, 236, 194,
-			85, 161, 12, 94, 119, 125, 198, 95, 240, 131, 173, 29, 193, 100,
-			232, 85, 135, 175, 96, 218, 26, 193, 150, 223, 10, 220, 100, 59,
-			140, 29, 43, 213, 80, 64, 11, 255, 155, 132, 254, 134, 178, 240,
-			5, 180, 240, 191, 73, 232, 65, 13, 18, 0, 71, 29, 13, 26,
-			0, 30, 59, 206, 254, 123, 228, 135, 209, 99, 91, 95, 38, 244,
-			255, 38, 134, 243, 243, 132, 113, 57, 153, 114, 190, 61, 191, 217,
-			210, 163, 204, 184, 104, 34, 61, 148, 85, 15, 54, 218, 1, 70,
-			93, 214, 114, 2, 162, 12, 87, 153, 11, 183, 190, 206, 235, 65,
-			40, 207, 13, 54, 212, 13, 27, 46, 203, 108, 137, 121, 228, 187,
-			237, 104, 61, 192, 145, 239, 12, 112, 201, 37, 90, 128, 61, 132,
-			249, 101, 194, 6, 217, 143, 195, 246, 189, 128, 187, 8, 243, 43,
-			196, 28, 117, 94, 100, 123, 237, 42, 133, 170, 231, 207, 204, 162,
-			234, 160, 38, 234, 65, 216, 168, 46, 42, 139, 163, 182, 47, 44,
-			21, 231, 29, 52, 163, 56, 104, 115, 52, 204, 122, 37, 9, 166,
-			109, 125, 133, 152, 95, 38, 195, 232, 112, 23, 228, 62, 3, 200,
-			98, 233, 3, 2, 15, 250, 51, 45, 12, 120, 0, 206, 49, 85,
-			35, 33, 182, 249, 39, 196, 28, 115, 254, 217, 155, 182, 149, 111,
-			155, 105, 148, 38, 7, 237, 227, 223, 28, 211, 168, 167, 1, 156,
-			184, 63, 33, 230, 87, 200, 104, 194, 100, 112, 227, 254, 36, 59,
-			13, 4, 153, 220, 127, 32, 125, 96, 192, 131, 209, 67, 236, 159,
-			106, 129, 162, 182, 249, 45, 98, 30, 117, 254, 81, 38, 98, 43,
-			73, 84, 165, 151, 120, 11, 14, 72, 71, 154, 219, 185, 79, 226,
-			97, 117, 59, 9, 90, 130, 30, 75, 83, 15, 137, 175, 158, 72,
-			155, 242, 194, 92, 165, 0, 88, 18, 211, 212, 158, 95, 38, 107,
-			163, 7, 13, 254, 217, 183, 136, 249, 39, 100, 44, 25, 18, 24,
-			193, 111, 101, 7, 13, 146, 245, 45, 210, 127, 40, 125, 96, 192,
-			3, 231, 8, 251, 89, 61, 104, 195, 54, 191, 3, 131, 254, 176,
-			26, 116, 118, 23, 163, 183, 223, 201, 30, 237, 237, 30, 46, 250,
-			228, 201, 66, 215, 35, 3, 95, 231, 59, 196, 252, 22, 57, 154,
-			208, 13, 222, 206, 119, 178, 35, 3, 127, 231, 59, 217, 145, 25,
-			56, 16, 231, 8, 251, 182, 30, 153, 105, 155, 223, 37, 230, 148,
-			243, 181, 135, 25, 89, 25, 36, 55, 19, 32, 143, 178, 227, 203,
-			109, 213, 210, 148, 224, 153, 40, 183, 75, 83, 126, 83, 102, 236,
-			168, 82, 146, 225, 167, 187, 182, 76, 239, 57, 151, 125, 47, 22,
-			178, 93, 120, 184, 42, 239, 159, 202, 176, 13, 246, 217, 223, 37,
-			230, 119, 50, 108, 3, 15, 234, 187, 196, 204, 60, 32, 240, 224,
-			216, 68, 250, 192, 128, 7, 231, 202, 236, 107, 20, 173, 35, 177,
-			205, 143, 80, 122, 204, 249, 29, 202, 248, 108, 70, 163, 187, 81,
-			93, 160, 46, 156, 194, 173, 131, 104, 40, 75, 161, 60, 199, 40,
-			173, 43, 195, 20, 140, 82, 233, 242, 83, 159, 237, 102, 163, 129,
-			193, 183, 245, 238, 3, 118, 176, 114, 90, 242, 104, 101, 176, 189,
-			36, 103, 173, 84, 230, 165, 108, 166, 191, 84, 102, 188, 148, 205,
-			235, 151, 164, 251, 80, 202, 36, 242, 213, 180, 68, 73, 164, 62,
-			25, 136, 54, 102, 107, 161, 212, 182, 219, 59, 123, 215, 113, 178,
-			134, 88, 243, 124, 209, 184, 196, 61, 57, 87, 109, 45, 11, 137,
-			47, 197, 120, 59, 12, 234, 152, 120, 9, 120, 125, 61, 8, 34,
-			193, 221, 20, 181, 182, 98, 24, 66, 249, 8, 165, 9, 104, 1,
-			216, 63, 164, 65, 228, 254, 240, 152, 6, 13, 0, 143, 28, 101,
-			191, 46, 61, 23, 106, 155, 31, 163, 116, 92, 70, 81, 86, 146,
-			160, 144, 220, 11, 106, 111, 113, 167, 253, 76, 196, 56, 104, 203,
-			27, 2, 240, 92, 185, 12, 176, 197, 232, 212, 131, 94, 147, 199,
-			171, 252, 32, 151, 201, 118, 87, 131, 78, 156, 196, 159, 88, 174,
-			175, 50, 151, 70, 19, 143, 241, 10, 196, 151, 108, 88, 21, 25,
-			73, 254, 84, 58, 42, 160, 177, 62, 70, 233, 71, 232, 49, 53,
-			64, 208, 87, 31, 163, 106, 15, 83, 64, 109, 245, 49, 218, 167,
-			29, 21, 80, 77, 31, 163, 199, 142, 235, 225, 27, 182, 249, 234,
-			206, 225, 231, 78, 213, 255, 53, 15, 63, 219, 215, 67, 12, 63,
-			183, 161, 46, 160, 90, 123, 149, 210, 143, 209, 113, 53, 64, 80,
-			106, 175, 166, 195, 7, 149, 246, 106, 58, 124, 3, 199, 123, 236,
-			56, 251, 159, 229, 240, 77, 219, 124, 13, 86, 230, 63, 215, 195,
-			207, 31, 42, 222, 171, 239, 183, 101, 248, 178, 43, 214, 213, 215,
-			155, 103, 1, 168, 168, 215, 40, 125, 53, 97, 129, 89, 192, 81,
-			105, 22, 128, 122, 122, 141, 246, 233, 5, 0, 202, 233, 53, 122,
-			228, 104, 82, 117, 249, 225, 87, 9, 123, 247, 247, 125, 235, 11,
-			252, 183, 235, 210, 151, 183, 245, 42, 25, 231, 45, 84, 134, 126,
-			255, 37, 161, 127, 215, 96, 236, 134, 136, 107, 242, 166, 14, 123,
-			140, 245, 182, 195, 224, 121, 81, 143, 85, 237, 161, 6, 109, 155,
-			153, 109, 55, 94, 215, 181, 160, 240, 183, 61, 194, 10, 56, 0,
-			85, 181, 39, 129, 180, 232, 207, 196, 83, 204, 170, 232, 239, 24,
-			99, 224, 234, 101, 10, 186, 10, 181, 62, 120, 130, 197, 92, 246,
-			17, 214, 215, 10, 154, 234, 173, 133, 111, 139, 173, 160, 41, 95,
-			158, 98, 251, 253, 192, 191, 147, 110, 32, 177, 78, 179, 88, 219,
-			231, 7, 126, 154, 91, 180, 171, 108, 176, 41, 226, 59, 242, 60,
-			207, 157, 78, 216, 138, 198, 138, 88, 144, 117, 66, 223, 106, 147,
-			142, 180, 178, 236, 53, 253, 91, 181, 121, 5, 214, 246, 53, 69,
-			44, 107, 170, 111, 133, 173, 200, 233, 176, 253, 249, 6, 246, 227,
-			172, 216, 242, 214, 176, 64, 253, 193, 197, 135, 73, 83, 123, 148,
-			89, 82, 154, 145, 113, 197, 154, 130, 82, 38, 41, 214, 73, 75,
-			244, 30, 214, 191, 226, 122, 173, 183, 113, 54, 74, 127, 74, 89,
-			63, 14, 91, 94, 193, 114, 31, 156, 101, 253, 189, 44, 244, 28,
-			221, 253, 42, 32, 61, 203, 186, 188, 208, 120, 200, 242, 194, 71,
-			152, 9, 66, 63, 102, 98, 109, 225, 96, 218, 94, 85, 228, 194,
-			75, 251, 111, 177, 254, 236, 236, 201, 114, 186, 227, 185, 217, 147,
-			195, 168, 164, 115, 85, 99, 81, 58, 111, 155, 140, 165, 111, 236,
-			139, 140, 225, 29, 13, 56, 41, 73, 33, 228, 222, 85, 197, 153,
-			214, 93, 19, 215, 183, 251, 196, 245, 233, 137, 123, 181, 192, 6,
-			222, 211, 17, 225, 246, 219, 188, 144, 144, 72, 117, 187, 145, 4,
-			176, 40, 91, 220, 147, 75, 168, 175, 134, 127, 219, 227, 172, 127,
-			195, 189, 119, 39, 20, 81, 167, 21, 71, 106, 253, 176, 13, 247,
-			94, 77, 62, 217, 81, 129, 205, 118, 86, 96, 95, 207, 23, 118,
-			203, 34, 213, 83, 154, 247, 217, 193, 101, 202, 188, 175, 123, 173,
-			88, 132, 185, 98, 239, 25, 86, 240, 197, 150, 8, 85, 237, 234,
-			253, 248, 45, 27, 194, 23, 65, 171, 33, 194, 177, 125, 15, 254,
-			2, 27, 238, 188, 57, 107, 255, 46, 55, 103, 93, 80, 133, 225,
-			131, 40, 113, 199, 119, 29, 73, 119, 73, 248, 99, 201, 149, 86,
-			67, 88, 216, 126, 116, 247, 175, 66, 220, 225, 38, 23, 94, 93,
-			98, 67, 221, 44, 177, 207, 100, 107, 184, 119, 173, 144, 151, 239,
-			223, 122, 117, 249, 73, 214, 171, 8, 177, 139, 204, 188, 178, 184,
-			114, 115, 168, 199, 238, 101, 198, 179, 115, 203, 67, 196, 182, 24,
-			93, 88, 28, 162, 165, 159, 165, 108, 159, 34, 254, 129, 26, 224,
-			9, 214, 171, 182, 144, 170, 4, 184, 123, 248, 122, 241, 97, 163,
-			154, 110, 156, 136, 164, 145, 138, 164, 243, 15, 8, 179, 100, 187,
-			68, 226, 73, 70, 226, 255, 122, 149, 205, 49, 198, 224, 191, 119,
-			210, 229, 51, 80, 235, 131, 39, 120, 57, 69, 233, 63, 18, 214,
-			63, 239, 69, 15, 97, 245, 142, 176, 62, 32, 247, 206, 170, 27,
-			233, 25, 40, 194, 131, 43, 110, 36, 246, 88, 181, 154, 25, 102,
-			126, 125, 170, 181, 21, 248, 173, 109, 117, 93, 154, 90, 52, 139,
-			126, 107, 27, 44, 156, 10, 126, 220, 81, 242, 103, 73, 11, 167,
-			158, 46, 225, 195, 76, 161, 115, 47, 46, 113, 93, 232, 220, 181,
-			254, 139, 221, 235, 191, 244, 255, 80, 54, 32, 71, 252, 64, 33,
-			184, 239, 144, 119, 153, 105, 251, 93, 140, 97, 68, 207, 135, 45,
-			168, 210, 239, 201, 106, 203, 118, 90, 185, 170, 155, 213, 50, 95,
-			56, 255, 129, 176, 190, 228, 77, 114, 230, 132, 100, 206, 156, 188,
-			131, 153, 168, 147, 40, 46, 165, 71, 238, 143, 187, 130, 139, 11,
-			63, 72, 165, 204, 120, 51, 82, 102, 62, 156, 148, 149, 38, 153,
-			169, 75, 183, 151, 102, 113, 245, 49, 102, 45, 175, 212, 230, 102,
-			159, 25, 34, 118, 63, 235, 93, 170, 45, 62, 61, 119, 117, 101,
-			136, 94, 248, 93, 194, 204, 121, 176, 112, 21, 102, 220, 16, 177,
-			109, 239, 244, 72, 156, 3, 187, 216, 57, 123, 134, 153, 224, 17,
-			216, 201, 203, 140, 127, 176, 251, 23, 143, 177, 2, 174, 86, 123,
-			100, 55, 221, 229, 28, 220, 117, 73, 219, 231, 153, 9, 236, 76,
-			251, 201, 172, 15, 103, 100, 55, 142, 63, 253, 223, 126, 152, 200,
-			114, 244, 255, 124, 255, 114, 244, 191, 105, 183, 231, 221, 74, 203,
-			209, 47, 225, 159, 212, 54, 152, 42, 82, 55, 108, 163, 191, 247,
-			12, 251, 55, 84, 22, 127, 143, 244, 188, 135, 56, 191, 74, 121,
-			58, 139, 58, 72, 169, 174, 190, 83, 55, 222, 117, 194, 244, 236,
-			90, 39, 18, 33, 124, 192, 245, 206, 32, 41, 21, 75, 190, 202,
-			7, 159, 197, 61, 47, 138, 163, 50, 119, 85, 53, 113, 166, 51,
-			89, 161, 221, 169, 215, 133, 192, 179, 229, 77, 55, 108, 224, 25,
-			190, 96, 141, 111, 173, 139, 228, 238, 147, 60, 94, 188, 165, 26,
-			111, 195, 74, 202, 6, 129, 134, 133, 32, 22, 185, 152, 165, 36,
-			15, 111, 64, 9, 69, 220, 9, 125, 190, 6, 246, 26, 112, 168,
-			83, 130, 41, 222, 134, 204, 207, 203, 45, 33, 211, 136, 189, 150,
-			23, 111, 115, 125, 15, 183, 239, 182, 120, 61, 240, 163, 56, 116,
-			61, 63, 119, 11, 224, 72, 209, 102, 21, 93, 207, 62, 74, 15,
-			58, 39, 80, 102, 52, 19, 149, 106, 146, 21, 13, 210, 4, 103,
-			171, 195, 71, 233, 200, 72, 166, 58, 124, 52, 87, 29, 62, 218,
-			55, 148, 169, 14, 31, 61, 48, 194, 254, 5, 213, 213, 225, 227,
-			212, 118, 62, 65, 177, 43, 208, 112, 59, 243, 8, 208, 99, 83,
-			164, 117, 24, 32, 80, 106, 215, 187, 42, 184, 171, 171, 76, 85,
-			99, 137, 67, 114, 124, 249, 230, 236, 133, 199, 159, 224, 235, 110,
-			180, 46, 211, 28, 186, 250, 56, 211, 22, 208, 46, 7, 27, 130,
-			119, 98, 96, 20, 108, 128, 129, 215, 107, 158, 223, 224, 109, 55,
-			138, 100, 154, 13, 133, 215, 149, 153, 30, 213, 31, 118, 20, 99,
-			85, 114, 29, 247, 216, 81, 176, 33, 152, 158, 3, 47, 142, 184,
-			188, 197, 0, 131, 226, 219, 72, 117, 208, 70, 246, 1, 90, 141,
-			19, 200, 68, 250, 60, 63, 138, 133, 219, 144, 21, 90, 161, 128,
-			173, 248, 166, 144, 179, 44, 111, 138, 247, 226, 220, 225, 199, 113,
-			58, 122, 48, 83, 101, 62, 158, 171, 50, 31, 207, 85, 153, 143,
-			15, 13, 179, 170, 174, 50, 47, 209, 97, 231, 169, 180, 80, 73,
-			77, 229, 174, 119, 181, 157, 137, 84, 169, 24, 222, 108, 0, 178,
-			39, 26, 185, 123, 10, 75, 116, 220, 206, 220, 83, 88, 146, 7,
-			62, 84, 217, 121, 169, 119, 32, 83, 118, 94, 26, 28, 82, 149,
-			238, 134, 109, 156, 162, 182, 170, 116, 247, 124, 15, 19, 73, 217,
-			132, 165, 12, 219, 7, 9, 19, 114, 245, 220, 167, 104, 105, 56,
-			83, 207, 125, 42, 57, 243, 9, 186, 226, 84, 114, 230, 19, 116,
-			196, 169, 161, 97, 246, 127, 80, 93, 207, 61, 69, 15, 57, 95,
-			145, 98, 182, 225, 222, 243, 54, 58, 27, 153, 40, 12, 108, 129,
-			35, 213, 103, 39, 244, 43, 250, 86, 54, 25, 107, 145, 161, 67,
-			93, 122, 14, 43, 150, 101, 150, 16, 124, 134, 101, 167, 153, 112,
-			139, 138, 182, 40, 174, 202, 179, 187, 9, 255, 84, 53, 145, 223,
-			210, 43, 58, 74, 46, 195, 195, 143, 42, 124, 86, 223, 211, 143,
-			56, 240, 232, 135, 214, 17, 72, 13, 104, 28, 166, 213, 129, 27,
-			243, 150, 112, 163, 24, 15, 239, 2, 31, 39, 196, 166, 240, 185,
-			183, 6, 45, 55, 189, 160, 149, 92, 224, 134, 165, 112, 41, 225,
-			147, 242, 168, 112, 196, 248, 134, 235, 111, 115, 183, 33, 175, 136,
-			146, 179, 17, 193, 56, 213, 149, 19, 250, 218, 36, 113, 15, 84,
-			156, 172, 102, 145, 245, 81, 10, 83, 174, 244, 124, 138, 158, 178,
-			51, 23, 183, 77, 37, 51, 100, 18, 219, 152, 42, 218, 153, 210,
-			243, 169, 131, 163, 236, 231, 168, 46, 61, 127, 140, 142, 58, 175,
-			236, 53, 67, 168, 50, 49, 243, 23, 229, 53, 80, 82, 24, 153,
-			20, 227, 200, 73, 243, 3, 117, 63, 72, 102, 166, 146, 64, 178,
-			156, 202, 74, 254, 91, 6, 31, 167, 135, 175, 19, 52, 217, 16,
-			157, 198, 144, 76, 103, 170, 146, 210, 251, 169, 24, 95, 19, 113,
-			125, 61, 43, 212, 105, 230, 33, 74, 216, 137, 141, 80, 189, 248,
-			219, 217, 241, 229, 42, 223, 31, 163, 83, 135, 116, 117, 59, 242,
-			168, 55, 83, 249, 254, 88, 113, 56, 83, 249, 254, 216, 200, 65,
-			246, 97, 83, 87, 190, 95, 161, 142, 243, 29, 35, 119, 195, 35,
-			222, 22, 15, 26, 29, 77, 143, 214, 235, 137, 212, 163, 196, 103,
-			234, 3, 82, 114, 248, 44, 207, 61, 151, 31, 78, 52, 196, 154,
-			219, 105, 197, 147, 170, 218, 52, 198, 98, 5, 125, 181, 116, 148,
-			57, 215, 212, 145, 252, 102, 92, 214, 72, 128, 216, 69, 113, 208,
-			6, 25, 205, 220, 13, 38, 240, 108, 67, 166, 110, 65, 248, 56,
-			131, 152, 178, 72, 194, 149, 30, 46, 63, 44, 37, 244, 243, 58,
-			163, 194, 103, 249, 66, 54, 22, 149, 80, 138, 244, 133, 98, 35,
-			216, 84, 151, 110, 186, 113, 12, 6, 50, 185, 22, 13, 198, 120,
-			61, 8, 185, 144, 247, 40, 148, 121, 228, 110, 119, 91, 33, 144,
-			35, 240, 1, 120, 176, 118, 145, 241, 31, 121, 180, 204, 31, 43,
-			243, 39, 202, 252, 29, 63, 186, 23, 131, 176, 26, 86, 14, 249,
-			81, 77, 3, 48, 250, 162, 252, 250, 71, 203, 200, 133, 54, 140,
-			99, 85, 212, 221, 78, 36, 24, 127, 28, 143, 205, 200, 209, 193,
-			128, 118, 204, 73, 110, 68, 56, 109, 89, 82, 114, 7, 12, 174,
-			208, 199, 70, 51, 7, 12, 174, 36, 234, 217, 34, 182, 113, 165,
-			247, 96, 230, 128, 193, 149, 177, 195, 236, 43, 68, 223, 35, 123,
-			131, 46, 26, 206, 191, 195, 235, 65, 245, 220, 149, 149, 11, 163,
-			238, 16, 198, 254, 85, 181, 39, 119, 121, 26, 238, 73, 202, 232,
-			210, 75, 225, 82, 255, 68, 198, 136, 248, 173, 218, 124, 132, 107,
-			47, 3, 163, 150, 243, 131, 24, 59, 12, 194, 76, 209, 11, 214,
-			160, 176, 228, 102, 122, 125, 37, 104, 132, 215, 238, 119, 145, 149,
-			118, 46, 151, 41, 150, 251, 231, 238, 181, 189, 193, 198, 216, 205,
-			244, 94, 219, 170, 121, 214, 121, 82, 29, 33, 144, 193, 194, 212,
-			16, 166, 212, 37, 248, 86, 165, 205, 14, 42, 104, 212, 243, 215,
-			217, 86, 205, 27, 233, 157, 172, 61, 22, 224, 62, 154, 187, 206,
-			182, 122, 236, 84, 238, 58, 219, 234, 196, 36, 123, 58, 189, 206,
-			118, 222, 28, 113, 46, 241, 154, 82, 234, 217, 222, 51, 87, 245,
-			158, 137, 210, 250, 135, 228, 58, 102, 117, 232, 41, 123, 123, 237,
-			188, 89, 61, 155, 187, 189, 118, 62, 115, 255, 41, 120, 8, 243,
-			125, 131, 185, 219, 107, 231, 237, 3, 108, 46, 189, 189, 118, 193,
-			60, 224, 60, 241, 16, 196, 36, 117, 79, 73, 152, 40, 127, 81,
-			237, 130, 57, 63, 146, 187, 168, 118, 33, 67, 7, 140, 122, 161,
-			111, 127, 238, 162, 218, 133, 97, 27, 235, 151, 123, 104, 175, 109,
-			44, 209, 83, 74, 156, 123, 77, 219, 92, 162, 139, 250, 46, 211,
-			94, 11, 94, 106, 159, 178, 151, 216, 198, 210, 48, 215, 144, 97,
-			27, 75, 143, 156, 100, 255, 144, 200, 3, 51, 183, 122, 26, 196,
-			249, 105, 194, 51, 123, 182, 135, 220, 31, 192, 23, 233, 6, 129,
-			87, 99, 109, 175, 89, 90, 215, 45, 11, 13, 184, 203, 155, 30,
-			88, 221, 108, 61, 151, 148, 162, 236, 225, 175, 212, 106, 233, 131,
-			58, 183, 138, 7, 208, 231, 198, 211, 29, 183, 31, 222, 231, 198,
-			3, 32, 230, 109, 122, 43, 57, 229, 81, 128, 239, 139, 153, 19,
-			32, 183, 149, 207, 45, 79, 128, 220, 214, 62, 55, 193, 211, 29,
-			63, 240, 185, 223, 156, 207, 77, 112, 85, 221, 165, 183, 53, 191,
-			97, 77, 221, 77, 248, 13, 83, 121, 87, 249, 220, 4, 215, 211,
-			93, 229, 115, 19, 88, 77, 245, 183, 197, 231, 38, 184, 162, 234,
-			244, 174, 173, 250, 129, 245, 84, 87, 74, 157, 224, 106, 170, 43,
-			159, 155, 224, 90, 170, 15, 14, 177, 69, 60, 245, 83, 104, 246,
-			124, 148, 16, 231, 10, 207, 196, 40, 210, 69, 144, 187, 23, 254,
-			65, 187, 100, 125, 64, 168, 89, 4, 133, 161, 14, 8, 121, 244,
-			160, 243, 78, 190, 164, 164, 53, 189, 46, 30, 65, 60, 120, 171,
-			135, 26, 41, 254, 174, 10, 252, 21, 149, 56, 80, 131, 147, 199,
-			131, 60, 218, 60, 152, 57, 30, 228, 229, 142, 7, 121, 125, 67,
-			153, 227, 65, 222, 129, 17, 105, 177, 112, 224, 1, 61, 226, 188,
-			65, 186, 75, 42, 51, 71, 192, 165, 207, 161, 252, 147, 174, 75,
-			235, 97, 101, 43, 15, 46, 181, 85, 171, 219, 60, 18, 113, 172,
-			107, 183, 213, 139, 51, 17, 151, 23, 238, 39, 21, 35, 48, 165,
-			250, 182, 119, 38, 239, 221, 85, 47, 129, 13, 234, 48, 136, 144,
-			95, 157, 137, 120, 182, 82, 49, 61, 169, 3, 134, 111, 53, 45,
-			189, 74, 120, 2, 66, 23, 80, 79, 243, 132, 88, 48, 210, 161,
-			204, 121, 166, 96, 120, 52, 115, 158, 41, 56, 236, 176, 255, 75,
-			242, 132, 218, 198, 22, 61, 229, 124, 83, 242, 68, 220, 107, 187,
-			126, 67, 52, 118, 173, 110, 76, 84, 119, 114, 23, 94, 44, 124,
-			253, 219, 93, 79, 47, 47, 46, 200, 221, 78, 103, 163, 173, 93,
-			37, 21, 40, 73, 99, 32, 88, 207, 159, 27, 121, 246, 66, 112,
-			109, 61, 147, 122, 228, 75, 76, 94, 38, 187, 229, 69, 138, 61,
-			17, 222, 41, 235, 189, 36, 111, 240, 149, 102, 77, 127, 166, 175,
-			103, 85, 133, 27, 41, 229, 216, 37, 203, 157, 126, 165, 184, 70,
-			182, 104, 112, 68, 177, 5, 92, 225, 45, 154, 64, 196, 54, 182,
-			142, 114, 13, 25, 182, 177, 245, 200, 73, 246, 183, 152, 44, 130,
-			55, 94, 162, 143, 56, 23, 240, 14, 129, 204, 221, 132, 146, 60,
-			44, 112, 209, 42, 162, 209, 229, 174, 75, 116, 134, 9, 24, 18,
-			200, 178, 141, 151, 250, 15, 107, 136, 216, 198, 75, 206, 113, 13,
-			65, 95, 39, 74, 236, 221, 208, 177, 209, 99, 23, 94, 166, 63,
-			73, 12, 231, 41, 126, 51, 104, 53, 162, 189, 234, 24, 114, 58,
-			66, 26, 100, 89, 217, 117, 171, 54, 175, 137, 64, 31, 231, 101,
-			54, 194, 46, 49, 11, 32, 88, 156, 31, 50, 167, 156, 242, 142,
-			95, 84, 240, 162, 29, 14, 142, 188, 74, 93, 89, 112, 170, 220,
-			154, 15, 153, 47, 203, 59, 206, 169, 114, 107, 62, 100, 30, 75,
-			97, 98, 27, 31, 58, 62, 145, 194, 134, 109, 124, 232, 92, 153,
-			93, 84, 189, 19, 219, 252, 9, 98, 142, 58, 103, 213, 81, 44,
-			236, 47, 179, 64, 111, 213, 230, 203, 176, 11, 72, 150, 93, 133,
-			177, 33, 133, 139, 152, 118, 225, 39, 136, 249, 161, 169, 132, 28,
-			82, 64, 116, 41, 125, 4, 241, 171, 250, 76, 170, 10, 3, 127,
-			130, 140, 28, 100, 79, 42, 2, 168, 109, 190, 66, 204, 131, 206,
-			100, 55, 1, 50, 202, 176, 91, 255, 195, 10, 23, 53, 109, 235,
-			21, 98, 254, 132, 170, 76, 164, 170, 72, 239, 149, 44, 1, 48,
-			192, 87, 72, 255, 80, 250, 192, 128, 7, 7, 70, 240, 90, 51,
-			148, 197, 143, 16, 122, 204, 89, 229, 179, 126, 82, 197, 145, 37,
-			67, 78, 224, 78, 151, 110, 7, 97, 124, 211, 115, 25, 47, 193,
-			167, 234, 82, 150, 78, 216, 186, 163, 157, 214, 146, 42, 211, 160,
-			88, 166, 241, 17, 2, 226, 164, 214, 131, 105, 33, 13, 3, 26,
-			36, 0, 238, 27, 211, 160, 1, 224, 145, 163, 172, 134, 199, 32,
-			173, 191, 71, 122, 190, 78, 136, 115, 141, 103, 195, 213, 15, 233,
-			46, 225, 39, 89, 83, 209, 47, 79, 75, 154, 127, 143, 20, 71,
-			216, 227, 204, 52, 13, 179, 199, 182, 126, 134, 208, 255, 154, 24,
-			206, 41, 174, 242, 102, 249, 123, 64, 99, 245, 80, 94, 35, 32,
-			23, 53, 134, 39, 205, 159, 33, 189, 242, 36, 178, 129, 17, 74,
-			243, 99, 196, 220, 231, 60, 193, 175, 4, 241, 122, 122, 219, 25,
-			168, 249, 228, 186, 51, 149, 137, 217, 185, 105, 87, 103, 13, 101,
-			112, 18, 16, 21, 211, 7, 20, 30, 244, 15, 160, 4, 25, 24,
-			161, 52, 127, 142, 152, 3, 206, 36, 95, 244, 91, 219, 105, 79,
-			15, 129, 156, 200, 111, 123, 211, 7, 20, 30, 176, 254, 4, 57,
-			181, 205, 159, 39, 102, 191, 70, 254, 102, 40, 7, 194, 126, 158,
-			152, 86, 250, 0, 145, 245, 49, 60, 178, 97, 0, 135, 126, 145,
-			60, 156, 67, 57, 168, 79, 169, 90, 191, 136, 115, 163, 143, 173,
-			22, 16, 69, 81, 131, 4, 192, 190, 33, 13, 26, 0, 30, 24,
-			97, 255, 123, 81, 29, 55, 52, 63, 69, 168, 237, 252, 118, 17,
-			59, 148, 231, 252, 219, 110, 232, 110, 136, 88, 132, 186, 98, 15,
-			29, 56, 117, 209, 1, 168, 55, 112, 251, 162, 206, 106, 20, 123,
-			113, 39, 6, 199, 178, 217, 10, 86, 249, 68, 233, 108, 105, 18,
-			133, 43, 83, 130, 10, 159, 50, 158, 164, 172, 192, 197, 195, 99,
-			46, 101, 140, 159, 104, 183, 21, 158, 225, 17, 23, 161, 101, 118,
-			195, 245, 124, 21, 42, 80, 82, 251, 98, 199, 109, 121, 107, 120,
-			52, 45, 31, 173, 7, 7, 82, 133, 145, 212, 157, 173, 105, 239,
-			56, 239, 184, 124, 181, 189, 129, 53, 221, 241, 113, 75, 136, 119,
-			32, 180, 26, 117, 55, 108, 224, 144, 220, 118, 91, 184, 161, 190,
-			165, 86, 81, 172, 119, 4, 42, 206, 177, 26, 232, 13, 114, 59,
-			61, 18, 134, 190, 146, 228, 93, 154, 156, 171, 240, 210, 217, 179,
-			165, 100, 88, 174, 12, 102, 168, 97, 101, 154, 165, 150, 89, 239,
-			218, 37, 191, 37, 190, 228, 96, 122, 186, 109, 199, 183, 145, 128,
-			89, 2, 71, 96, 162, 116, 174, 52, 153, 9, 48, 174, 10, 30,
-			203, 159, 178, 194, 96, 224, 90, 166, 48, 16, 84, 2, 16, 149,
-			185, 62, 54, 186, 200, 56, 231, 83, 124, 14, 143, 216, 79, 148,
-			74, 147, 185, 40, 133, 155, 252, 218, 77, 84, 145, 13, 207, 158,
-			157, 62, 55, 125, 246, 236, 3, 90, 173, 5, 193, 244, 170, 27,
-			222, 167, 97, 250, 3, 64, 37, 213, 184, 148, 148, 47, 118, 161,
-			152, 62, 55, 189, 234, 190, 180, 39, 34, 172, 161, 247, 147, 131,
-			204, 57, 148, 178, 122, 189, 107, 170, 26, 188, 180, 234, 190, 84,
-			226, 19, 162, 210, 172, 148, 147, 198, 211, 47, 118, 238, 77, 183,
-			130, 150, 236, 174, 52, 153, 39, 227, 126, 131, 214, 247, 58, 222,
-			103, 36, 15, 26, 132, 194, 16, 111, 5, 83, 169, 232, 41, 186,
-			183, 214, 131, 72, 200, 145, 200, 42, 253, 36, 104, 10, 29, 150,
-			112, 92, 216, 70, 10, 34, 60, 135, 1, 228, 249, 184, 119, 207,
-			249, 47, 245, 16, 244, 116, 79, 63, 104, 6, 115, 20, 227, 175,
-			1, 36, 202, 137, 152, 182, 245, 41, 66, 127, 145, 28, 212, 7,
-			154, 11, 168, 111, 180, 114, 34, 217, 3, 102, 120, 134, 217, 252,
-			20, 25, 26, 102, 13, 212, 77, 212, 54, 63, 67, 232, 176, 243,
-			222, 236, 30, 76, 70, 222, 211, 104, 143, 36, 229, 140, 58, 205,
-			211, 189, 7, 75, 246, 138, 193, 26, 127, 30, 15, 210, 129, 178,
-			88, 146, 155, 89, 73, 35, 56, 12, 159, 33, 244, 83, 234, 48,
-			143, 129, 238, 194, 103, 8, 181, 52, 72, 0, 236, 29, 208, 160,
-			1, 224, 224, 16, 251, 8, 158, 245, 7, 240, 115, 64, 228, 75,
-			41, 145, 24, 19, 140, 186, 146, 135, 146, 52, 185, 9, 78, 168,
-			195, 223, 42, 222, 225, 66, 51, 253, 91, 31, 9, 233, 13, 145,
-			105, 6, 190, 125, 170, 22, 83, 102, 27, 166, 109, 125, 142, 208,
-			207, 144, 97, 69, 170, 81, 64, 218, 244, 64, 192, 166, 127, 46,
-			29, 136, 129, 148, 15, 14, 177, 31, 195, 113, 152, 182, 249, 121,
-			48, 4, 109, 190, 32, 238, 197, 232, 197, 128, 214, 193, 80, 92,
-			121, 231, 143, 3, 121, 145, 210, 79, 234, 216, 183, 190, 134, 67,
-			107, 75, 116, 38, 88, 230, 23, 147, 218, 161, 216, 244, 130, 142,
-			254, 172, 37, 214, 192, 55, 95, 75, 168, 7, 15, 232, 243, 132,
-			126, 46, 161, 222, 44, 32, 69, 90, 84, 192, 3, 250, 124, 42,
-			42, 224, 1, 125, 30, 68, 229, 15, 229, 52, 20, 108, 243, 11,
-			132, 142, 193, 110, 242, 153, 164, 116, 67, 123, 64, 59, 243, 18,
-			146, 8, 109, 175, 211, 12, 146, 212, 197, 121, 12, 73, 70, 161,
-			211, 110, 139, 80, 5, 19, 181, 137, 215, 140, 105, 84, 248, 205,
-			96, 75, 108, 138, 176, 172, 195, 167, 106, 142, 101, 39, 42, 171,
-			145, 252, 130, 216, 42, 104, 251, 85, 109, 212, 247, 72, 241, 74,
-			206, 20, 76, 219, 250, 2, 161, 159, 79, 4, 180, 32, 7, 219,
-			171, 65, 2, 96, 241, 128, 6, 13, 0, 71, 15, 177, 117, 100,
-			140, 101, 155, 255, 150, 208, 35, 206, 115, 250, 156, 250, 202, 118,
-			91, 116, 79, 175, 254, 61, 230, 40, 203, 146, 29, 122, 58, 77,
-			8, 116, 29, 217, 151, 116, 90, 166, 109, 253, 91, 66, 191, 64,
-			198, 20, 37, 86, 1, 251, 214, 51, 104, 17, 0, 251, 180, 42,
-			176, 12, 0, 199, 28, 233, 248, 24, 61, 182, 245, 6, 161, 191,
-			77, 12, 125, 1, 68, 122, 111, 29, 199, 29, 106, 43, 134, 169,
-			243, 181, 115, 137, 199, 211, 222, 32, 204, 97, 143, 37, 119, 92,
-			152, 191, 69, 204, 113, 231, 36, 126, 159, 22, 139, 41, 109, 217,
-			133, 100, 56, 189, 182, 194, 250, 45, 98, 190, 161, 206, 112, 24,
-			114, 187, 4, 152, 70, 210, 7, 4, 30, 28, 116, 210, 7, 6,
-			60, 56, 6, 27, 67, 224, 113, 175, 109, 254, 14, 161, 39, 21,
-			31, 122, 77, 219, 250, 29, 28, 138, 26, 105, 175, 133, 239, 245,
-			244, 245, 18, 0, 15, 28, 215, 160, 1, 224, 137, 71, 216, 10,
-			226, 42, 218, 230, 239, 18, 122, 198, 185, 206, 23, 176, 96, 224,
-			190, 83, 83, 215, 118, 126, 45, 86, 217, 24, 229, 249, 200, 115,
-			96, 233, 220, 20, 77, 219, 250, 93, 66, 127, 135, 156, 84, 189,
-			22, 45, 236, 231, 136, 6, 9, 128, 71, 79, 104, 208, 0, 240,
-			228, 105, 118, 11, 105, 234, 179, 205, 47, 3, 77, 55, 248, 98,
-			171, 241, 176, 52, 169, 95, 33, 190, 15, 81, 125, 38, 30, 132,
-			252, 93, 114, 70, 117, 219, 103, 97, 71, 154, 168, 62, 60, 128,
-			152, 16, 213, 103, 0, 120, 242, 52, 123, 9, 137, 98, 182, 249,
-			7, 132, 30, 117, 90, 50, 235, 155, 200, 114, 178, 132, 146, 56,
-			153, 166, 48, 70, 67, 39, 205, 221, 142, 31, 69, 148, 151, 11,
-			177, 156, 23, 154, 248, 99, 170, 81, 66, 57, 51, 109, 235, 15,
-			8, 253, 114, 66, 57, 43, 32, 53, 90, 212, 25, 1, 176, 79,
-			95, 227, 193, 12, 0, 15, 31, 97, 255, 129, 34, 233, 253, 182,
-			249, 199, 132, 114, 231, 247, 40, 95, 113, 155, 137, 154, 210, 199,
-			164, 224, 81, 28, 164, 3, 193, 151, 82, 99, 193, 90, 148, 153,
-			189, 21, 87, 111, 67, 193, 115, 77, 130, 188, 23, 25, 159, 226,
-			179, 153, 59, 191, 240, 59, 60, 107, 37, 127, 145, 190, 142, 247,
-			160, 101, 24, 227, 134, 153, 236, 178, 140, 160, 181, 130, 38, 30,
-			79, 148, 172, 138, 221, 166, 14, 113, 41, 245, 159, 98, 111, 187,
-			94, 88, 73, 186, 84, 78, 140, 159, 228, 249, 38, 124, 175, 53,
-			41, 215, 223, 3, 72, 128, 238, 50, 202, 79, 83, 161, 127, 160,
-			108, 83, 135, 34, 221, 38, 116, 84, 222, 107, 7, 144, 204, 80,
-			191, 105, 91, 127, 76, 232, 31, 144, 163, 106, 14, 250, 45, 100,
-			186, 214, 62, 253, 4, 64, 117, 68, 215, 160, 253, 6, 128, 199,
-			198, 217, 109, 156, 160, 1, 219, 252, 83, 66, 71, 157, 42, 151,
-			197, 131, 25, 137, 79, 231, 34, 35, 243, 41, 149, 65, 136, 255,
-			245, 207, 196, 217, 159, 84, 75, 200, 26, 48, 109, 235, 79, 9,
-			253, 99, 162, 47, 25, 26, 176, 176, 171, 62, 13, 18, 0, 153,
-			222, 173, 13, 24, 0, 30, 56, 200, 86, 228, 165, 46, 223, 32,
-			61, 127, 135, 18, 231, 58, 207, 21, 160, 61, 108, 72, 120, 215,
-			157, 62, 216, 213, 111, 144, 226, 65, 12, 123, 227, 77, 43, 223,
-			132, 253, 231, 165, 7, 135, 133, 229, 134, 70, 118, 153, 143, 12,
-			167, 247, 160, 124, 147, 208, 111, 144, 67, 250, 166, 147, 2, 34,
-			47, 102, 238, 65, 249, 166, 222, 153, 202, 123, 80, 190, 9, 59,
-			211, 171, 64, 8, 216, 131, 63, 35, 244, 199, 169, 225, 60, 170,
-			175, 242, 232, 250, 229, 17, 60, 43, 157, 24, 242, 100, 228, 233,
-			17, 102, 19, 109, 196, 159, 1, 59, 43, 204, 2, 16, 134, 247,
-			109, 98, 142, 56, 199, 209, 7, 76, 78, 239, 230, 115, 34, 202,
-			58, 152, 202, 58, 124, 155, 152, 127, 70, 108, 212, 253, 166, 58,
-			110, 252, 109, 29, 77, 50, 149, 117, 248, 54, 233, 31, 76, 31,
-			24, 240, 64, 254, 178, 180, 236, 150, 216, 230, 95, 18, 243, 152,
-			243, 101, 194, 147, 31, 126, 141, 255, 127, 19, 244, 214, 204, 2,
-			111, 255, 47, 137, 249, 109, 50, 146, 176, 130, 88, 56, 242, 148,
-			123, 4, 89, 113, 96, 44, 125, 96, 192, 131, 35, 71, 217, 55,
-			168, 98, 22, 181, 205, 239, 17, 243, 140, 243, 251, 50, 211, 5,
-			238, 238, 84, 219, 173, 191, 144, 143, 65, 54, 118, 137, 132, 163,
-			98, 204, 80, 45, 186, 14, 150, 71, 58, 24, 141, 147, 159, 250,
-			53, 184, 245, 81, 39, 252, 245, 94, 92, 11, 214, 110, 172, 148,
-			103, 39, 203, 93, 135, 83, 181, 79, 200, 210, 136, 121, 242, 123,
-			178, 187, 135, 217, 175, 237, 248, 118, 175, 96, 123, 87, 244, 124,
-			103, 243, 204, 104, 210, 157, 66, 74, 27, 203, 50, 73, 207, 23,
-			236, 124, 190, 71, 204, 191, 36, 199, 146, 217, 0, 119, 241, 123,
-			196, 204, 60, 32, 240, 224, 120, 41, 125, 96, 192, 131, 83, 167,
-			217, 15, 169, 233, 50, 108, 243, 195, 212, 28, 115, 102, 212, 65,
-			250, 164, 247, 204, 100, 237, 150, 182, 72, 233, 128, 141, 203, 135,
-			169, 249, 61, 114, 38, 233, 5, 182, 46, 31, 166, 102, 95, 250,
-			128, 192, 3, 150, 74, 150, 129, 253, 142, 30, 66, 183, 9, 111,
-			77, 122, 133, 210, 227, 206, 117, 85, 21, 32, 127, 64, 47, 167,
-			159, 241, 247, 79, 213, 17, 95, 85, 17, 150, 26, 33, 157, 75,
-			197, 105, 215, 250, 3, 19, 57, 175, 232, 195, 163, 38, 74, 243,
-			43, 52, 185, 180, 132, 96, 167, 246, 225, 204, 77, 69, 175, 208,
-			163, 199, 216, 255, 150, 220, 84, 244, 81, 74, 109, 231, 55, 73,
-			151, 135, 178, 243, 151, 86, 213, 121, 190, 141, 32, 20, 93, 91,
-			147, 76, 149, 41, 140, 44, 74, 141, 230, 110, 90, 32, 20, 109,
-			225, 38, 122, 224, 61, 89, 17, 206, 84, 118, 200, 11, 141, 96,
-			9, 164, 63, 61, 129, 174, 206, 118, 82, 36, 150, 9, 167, 9,
-			220, 16, 38, 225, 179, 244, 170, 162, 143, 82, 250, 10, 61, 158,
-			185, 170, 232, 163, 52, 119, 85, 209, 71, 105, 114, 115, 12, 8,
-			201, 71, 233, 208, 48, 251, 132, 41, 47, 97, 121, 149, 246, 252,
-			43, 74, 156, 87, 77, 158, 169, 149, 126, 200, 128, 53, 124, 145,
-			43, 0, 198, 18, 233, 236, 67, 46, 124, 96, 25, 152, 9, 249,
-			51, 221, 65, 184, 61, 21, 135, 2, 236, 195, 118, 75, 240, 56,
-			116, 193, 163, 115, 91, 122, 214, 149, 113, 99, 201, 109, 46, 74,
-			110, 163, 182, 139, 87, 65, 230, 171, 140, 188, 181, 196, 26, 150,
-			54, 182, 225, 207, 18, 95, 119, 27, 137, 180, 149, 92, 21, 65,
-			42, 115, 23, 69, 17, 67, 83, 176, 77, 214, 219, 66, 244, 210,
-			212, 168, 47, 106, 100, 151, 75, 165, 50, 218, 31, 252, 67, 27,
-			247, 139, 252, 3, 178, 143, 151, 249, 196, 82, 198, 6, 71, 147,
-			187, 227, 80, 4, 237, 142, 201, 5, 36, 48, 241, 105, 248, 241,
-			225, 208, 184, 121, 60, 231, 118, 224, 121, 72, 52, 211, 231, 242,
-			136, 86, 221, 151, 94, 230, 19, 250, 87, 210, 83, 100, 201, 61,
-			50, 175, 210, 226, 1, 246, 65, 125, 141, 204, 199, 41, 61, 232,
-			248, 50, 36, 157, 134, 196, 245, 154, 205, 94, 182, 168, 150, 90,
-			172, 149, 65, 46, 52, 31, 173, 7, 91, 232, 190, 40, 36, 153,
-			120, 18, 74, 24, 222, 19, 227, 214, 235, 34, 138, 114, 119, 190,
-			124, 156, 210, 87, 211, 75, 94, 10, 72, 79, 49, 115, 231, 203,
-			199, 105, 223, 80, 230, 206, 151, 143, 211, 3, 35, 236, 83, 68,
-			159, 242, 255, 4, 165, 135, 156, 127, 66, 210, 128, 186, 188, 146,
-			56, 67, 127, 78, 202, 50, 23, 237, 162, 184, 164, 113, 200, 85,
-			247, 37, 253, 224, 220, 244, 139, 157, 123, 104, 246, 18, 159, 52,
-			57, 128, 162, 130, 131, 217, 224, 93, 9, 99, 147, 120, 98, 31,
-			38, 2, 99, 217, 93, 1, 250, 116, 196, 96, 206, 63, 65, 233,
-			199, 147, 17, 147, 2, 14, 162, 152, 57, 59, 255, 9, 218, 103,
-			103, 206, 206, 127, 130, 30, 28, 101, 119, 244, 209, 249, 215, 41,
-			29, 118, 222, 35, 45, 39, 174, 154, 55, 19, 195, 203, 199, 237,
-			228, 165, 68, 153, 184, 157, 60, 219, 254, 58, 165, 159, 160, 135,
-			50, 103, 219, 95, 167, 42, 220, 37, 207, 182, 191, 78, 85, 184,
-			75, 158, 109, 127, 157, 14, 14, 177, 123, 250, 104, 251, 39, 65,
-			57, 63, 255, 32, 221, 252, 80, 129, 46, 25, 231, 98, 15, 17,
-			232, 146, 135, 210, 63, 73, 233, 235, 116, 56, 115, 40, 253, 147,
-			249, 67, 233, 159, 212, 170, 83, 30, 74, 255, 36, 168, 206, 80,
-			159, 73, 255, 52, 200, 81, 35, 13, 55, 102, 39, 24, 203, 147,
-			117, 36, 158, 47, 166, 14, 68, 238, 247, 185, 219, 121, 21, 192,
-			242, 151, 117, 231, 178, 86, 250, 12, 249, 167, 41, 253, 36, 181,
-			51, 103, 200, 63, 157, 114, 26, 182, 16, 159, 166, 189, 118, 230,
-			12, 249, 167, 65, 16, 62, 38, 69, 191, 96, 155, 159, 165, 212,
-			113, 126, 60, 243, 195, 229, 93, 108, 78, 126, 163, 90, 223, 193,
-			130, 172, 198, 101, 218, 105, 39, 119, 12, 72, 77, 170, 234, 29,
-			24, 222, 11, 28, 134, 65, 8, 146, 37, 127, 237, 8, 127, 159,
-			90, 46, 225, 76, 177, 78, 114, 18, 63, 25, 80, 193, 180, 173,
-			207, 82, 250, 233, 68, 116, 10, 146, 70, 61, 32, 80, 60, 159,
-			165, 189, 90, 238, 11, 6, 128, 99, 135, 217, 223, 150, 3, 178,
-			108, 243, 215, 40, 61, 224, 108, 115, 249, 107, 20, 40, 219, 239,
-			186, 204, 103, 202, 40, 182, 97, 71, 239, 19, 165, 27, 210, 22,
-			234, 247, 182, 96, 195, 248, 130, 215, 206, 135, 22, 50, 7, 253,
-			149, 142, 218, 165, 56, 25, 236, 95, 219, 149, 63, 61, 144, 25,
-			135, 101, 218, 214, 175, 81, 250, 89, 170, 111, 48, 176, 10, 72,
-			90, 175, 6, 9, 128, 197, 253, 26, 52, 0, 28, 182, 217, 175,
-			202, 113, 244, 218, 230, 191, 164, 116, 204, 249, 37, 178, 71, 245,
-			182, 20, 145, 93, 2, 164, 79, 225, 96, 223, 92, 72, 180, 59,
-			34, 202, 222, 114, 72, 180, 128, 33, 182, 127, 73, 233, 175, 209,
-			3, 106, 104, 189, 5, 28, 139, 30, 120, 47, 1, 176, 152, 188,
-			53, 0, 28, 61, 132, 233, 114, 203, 182, 190, 64, 123, 254, 79,
-			74, 156, 107, 60, 123, 38, 235, 97, 119, 209, 221, 238, 7, 88,
-			42, 96, 244, 23, 104, 113, 132, 253, 27, 224, 172, 5, 166, 234,
-			139, 96, 170, 62, 75, 242, 182, 170, 75, 227, 229, 54, 205, 93,
-			231, 152, 240, 140, 145, 238, 56, 65, 224, 165, 119, 83, 161, 112,
-			173, 123, 34, 116, 195, 250, 250, 118, 166, 102, 135, 37, 37, 180,
-			233, 173, 215, 233, 98, 151, 223, 229, 10, 129, 53, 118, 209, 18,
-			27, 25, 11, 96, 161, 205, 251, 34, 165, 95, 80, 247, 209, 90,
-			104, 243, 190, 168, 85, 21, 254, 118, 168, 249, 69, 109, 243, 44,
-			180, 121, 95, 4, 155, 247, 4, 50, 129, 216, 230, 27, 160, 170,
-			38, 144, 7, 9, 165, 202, 236, 161, 71, 238, 70, 185, 26, 14,
-			217, 43, 216, 157, 55, 40, 253, 162, 178, 59, 22, 218, 157, 55,
-			210, 94, 9, 34, 86, 118, 199, 66, 187, 243, 6, 168, 155, 175,
-			72, 222, 83, 219, 252, 18, 104, 246, 127, 247, 230, 220, 238, 156,
-			151, 240, 253, 120, 221, 243, 18, 209, 219, 238, 116, 91, 104, 241,
-			190, 68, 233, 27, 74, 109, 89, 104, 241, 190, 148, 50, 6, 56,
-			254, 37, 109, 57, 44, 180, 120, 95, 2, 203, 129, 211, 97, 244,
-			216, 214, 239, 81, 250, 109, 106, 56, 167, 213, 78, 77, 222, 124,
-			231, 169, 244, 84, 222, 5, 211, 199, 25, 44, 140, 161, 252, 30,
-			101, 7, 216, 16, 179, 0, 52, 123, 108, 235, 203, 212, 252, 3,
-			90, 192, 189, 24, 62, 33, 182, 249, 101, 232, 120, 136, 21, 229,
-			3, 88, 1, 255, 158, 90, 131, 108, 152, 245, 233, 39, 4, 31,
-			177, 236, 35, 10, 143, 246, 237, 207, 124, 71, 108, 243, 127, 165,
-			214, 112, 166, 17, 145, 143, 6, 178, 143, 40, 60, 26, 28, 202,
-			124, 71, 109, 243, 247, 169, 101, 103, 26, 1, 170, 223, 167, 214,
-			190, 236, 35, 108, 53, 52, 12, 138, 28, 199, 2, 100, 126, 149,
-			154, 35, 206, 22, 222, 230, 170, 85, 128, 254, 185, 115, 156, 149,
-			188, 177, 172, 112, 126, 123, 93, 248, 240, 96, 21, 195, 165, 217,
-			130, 121, 165, 56, 174, 184, 249, 26, 58, 252, 17, 248, 244, 198,
-			193, 29, 129, 38, 75, 5, 154, 190, 154, 227, 42, 46, 182, 175,
-			82, 21, 104, 178, 84, 160, 233, 171, 84, 5, 154, 44, 21, 104,
-			250, 42, 181, 15, 176, 178, 26, 14, 177, 205, 175, 81, 211, 118,
-			142, 202, 186, 177, 237, 118, 82, 195, 156, 14, 32, 237, 19, 22,
-			218, 215, 168, 249, 85, 58, 146, 96, 132, 29, 238, 215, 168, 42,
-			172, 177, 84, 188, 230, 107, 48, 181, 201, 3, 3, 30, 12, 13,
-			179, 127, 68, 85, 167, 212, 54, 191, 78, 205, 99, 206, 223, 161,
-			223, 95, 112, 75, 255, 106, 8, 147, 94, 204, 170, 224, 107, 94,
-			171, 37, 26, 178, 132, 65, 221, 11, 198, 229, 25, 225, 220, 120,
-			254, 191, 26, 19, 179, 84, 140, 229, 235, 212, 252, 26, 181, 19,
-			14, 130, 255, 240, 117, 106, 102, 30, 16, 120, 160, 98, 98, 150,
-			138, 177, 124, 157, 30, 57, 202, 126, 81, 243, 216, 176, 205, 63,
-			167, 230, 25, 231, 111, 211, 44, 5, 138, 209, 111, 34, 66, 246,
-			214, 121, 252, 182, 5, 214, 90, 25, 253, 248, 150, 227, 106, 41,
-			131, 193, 157, 254, 115, 106, 126, 157, 30, 75, 216, 103, 88, 200,
-			173, 204, 3, 2, 15, 84, 16, 203, 82, 193, 163, 63, 167, 167,
-			78, 99, 254, 206, 2, 238, 254, 71, 74, 79, 42, 189, 105, 152,
-			8, 50, 13, 90, 0, 246, 143, 104, 144, 0, 120, 112, 92, 131,
-			248, 109, 233, 17, 182, 192, 168, 213, 99, 91, 127, 65, 123, 254,
-			51, 37, 206, 15, 201, 223, 139, 82, 19, 4, 126, 195, 212, 154,
-			91, 87, 103, 3, 213, 214, 18, 151, 194, 139, 185, 152, 60, 143,
-			68, 184, 233, 213, 213, 53, 232, 22, 44, 248, 191, 160, 197, 1,
-			118, 131, 153, 22, 42, 171, 191, 162, 180, 236, 60, 137, 197, 221,
-			122, 27, 37, 23, 147, 14, 92, 96, 229, 128, 42, 193, 146, 17,
-			242, 238, 155, 61, 45, 169, 72, 254, 138, 90, 125, 26, 164, 0,
-			178, 17, 13, 26, 0, 142, 159, 101, 183, 177, 87, 98, 155, 255,
-			137, 210, 138, 83, 149, 7, 43, 210, 221, 91, 254, 40, 69, 215,
-			138, 191, 239, 49, 10, 217, 15, 65, 204, 86, 2, 82, 0, 251,
-			71, 53, 104, 0, 120, 162, 204, 22, 144, 10, 106, 155, 223, 165,
-			244, 130, 243, 67, 73, 84, 75, 146, 209, 21, 186, 143, 50, 165,
-			100, 93, 5, 24, 153, 200, 158, 37, 215, 220, 119, 169, 213, 175,
-			65, 196, 63, 48, 166, 65, 3, 192, 71, 102, 84, 231, 134, 109,
-			126, 143, 210, 105, 152, 85, 41, 188, 123, 244, 29, 6, 65, 156,
-			59, 102, 143, 182, 35, 241, 124, 146, 206, 65, 134, 190, 151, 142,
-			220, 160, 0, 38, 35, 55, 176, 187, 19, 83, 250, 14, 174, 255,
-			55, 0, 0, 255, 255, 231, 173, 70, 240, 212, 164, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 15, 116, 28, 199,
+			121, 39, 136, 238, 234, 25, 12, 10, 36, 1, 54, 64, 8, 108,
+			146, 98, 105, 36, 138, 0, 9, 12, 64, 80, 127, 44, 72, 242,
+			46, 73, 81, 18, 100, 138, 164, 65, 48, 90, 203, 231, 35, 27,
+			51, 133, 65, 91, 51, 221, 163, 238, 30, 128, 144, 86, 113, 44,
+			43, 222, 196, 113, 114, 78, 214, 183, 118, 118, 125, 190, 231, 205,
+			91, 71, 231, 187, 181, 227, 56, 241, 217, 185, 181, 243, 199, 155,
+			23, 91, 249, 227, 61, 103, 147, 205, 158, 227, 125, 187, 23, 191,
+			203, 243, 123, 137, 115, 239, 146, 123, 183, 111, 227, 243, 187, 247,
+			251, 170, 170, 187, 103, 0, 240, 143, 227, 236, 93, 238, 69, 79,
+			150, 81, 53, 245, 231, 251, 190, 170, 250, 234, 251, 87, 95, 243,
+			207, 79, 243, 163, 205, 40, 106, 182, 228, 92, 39, 142, 210, 104,
+			181, 187, 54, 151, 6, 109, 153, 164, 126, 187, 83, 163, 42, 119,
+			68, 53, 168, 153, 6, 213, 71, 249, 208, 138, 105, 227, 78, 242,
+			193, 68, 214, 163, 176, 145, 76, 90, 194, 154, 98, 203, 166, 232,
+			142, 243, 82, 232, 135, 81, 50, 105, 11, 107, 170, 180, 172, 10,
+			103, 127, 216, 226, 99, 245, 168, 93, 235, 27, 244, 236, 190, 108,
+			200, 203, 168, 186, 108, 61, 191, 160, 155, 52, 163, 150, 31, 54,
+			107, 81, 220, 44, 192, 184, 213, 145, 201, 220, 11, 97, 180, 25,
+			230, 240, 118, 86, 255, 163, 101, 253, 83, 155, 61, 117, 249, 236,
+			39, 236, 187, 159, 82, 189, 47, 235, 46, 181, 231, 100, 171, 245,
+			22, 116, 88, 65, 223, 103, 254, 199, 227, 188, 236, 58, 251, 6,
+			222, 101, 241, 223, 113, 184, 181, 199, 101, 251, 6, 220, 133, 47,
+			57, 226, 92, 212, 217, 138, 131, 230, 122, 42, 22, 230, 23, 230,
+			103, 23, 230, 23, 30, 16, 103, 187, 107, 98, 69, 214, 215, 195,
+			168, 21, 53, 3, 153, 204, 136, 165, 176, 94, 227, 92, 92, 8,
+			234, 50, 76, 100, 67, 116, 195, 134, 140, 69, 186, 46, 197, 153,
+			142, 95, 95, 151, 230, 151, 25, 241, 3, 50, 78, 130, 40, 20,
+			11, 181, 121, 49, 133, 6, 85, 253, 83, 117, 250, 81, 46, 182,
+			162, 174, 104, 251, 91, 34, 140, 82, 209, 77, 164, 72, 215, 131,
+			68, 172, 5, 45, 41, 228, 141, 186, 236, 164, 34, 8, 69, 61,
+			106, 119, 90, 129, 31, 214, 165, 216, 12, 210, 117, 145, 230, 195,
+			215, 184, 120, 155, 30, 33, 90, 77, 253, 32, 20, 190, 168, 71,
+			157, 45, 17, 173, 21, 155, 9, 63, 229, 92, 208, 63, 235, 105,
+			218, 89, 156, 155, 219, 220, 220, 172, 249, 4, 41, 17, 182, 165,
+			218, 37, 115, 23, 150, 206, 157, 191, 120, 229, 252, 236, 66, 109,
+			158, 115, 113, 53, 108, 201, 36, 17, 177, 124, 177, 27, 196, 178,
+			33, 86, 183, 132, 223, 233, 180, 130, 186, 191, 218, 146, 162, 229,
+			111, 138, 40, 22, 126, 51, 150, 178, 33, 210, 8, 176, 110, 198,
+			65, 26, 132, 205, 25, 145, 68, 107, 233, 166, 31, 75, 46, 26,
+			65, 146, 198, 193, 106, 55, 237, 33, 147, 129, 44, 72, 122, 26,
+			68, 161, 240, 67, 81, 61, 115, 69, 44, 93, 169, 138, 179, 103,
+			174, 44, 93, 153, 225, 226, 185, 165, 149, 167, 47, 93, 93, 17,
+			207, 157, 89, 94, 62, 115, 113, 101, 233, 252, 21, 113, 105, 89,
+			156, 187, 116, 241, 137, 165, 149, 165, 75, 23, 175, 136, 75, 79,
+			138, 51, 23, 223, 38, 222, 178, 116, 241, 137, 25, 33, 131, 116,
+			93, 198, 66, 222, 232, 196, 128, 62, 138, 69, 0, 2, 202, 70,
+			141, 139, 43, 82, 246, 76, 191, 22, 169, 85, 75, 58, 178, 30,
+			172, 5, 117, 129, 189, 214, 245, 155, 82, 52, 163, 13, 25, 135,
+			65, 216, 20, 29, 25, 183, 131, 4, 139, 152, 8, 63, 108, 112,
+			209, 10, 218, 65, 234, 167, 84, 177, 13, 163, 26, 231, 21, 110,
+			217, 46, 27, 29, 152, 196, 95, 21, 151, 185, 3, 71, 249, 16,
+			183, 43, 71, 213, 159, 170, 114, 108, 96, 137, 42, 135, 213, 159,
+			170, 114, 124, 96, 134, 42, 45, 245, 167, 170, 60, 48, 48, 71,
+			149, 250, 79, 85, 57, 49, 80, 165, 74, 174, 254, 84, 149, 119,
+			13, 220, 67, 149, 247, 169, 63, 85, 229, 228, 192, 163, 84, 121,
+			76, 253, 249, 7, 71, 184, 237, 12, 184, 78, 58, 240, 46, 203,
+			251, 205, 35, 226, 140, 200, 78, 158, 136, 37, 72, 38, 195, 52,
+			17, 190, 232, 68, 65, 72, 251, 15, 7, 76, 4, 97, 67, 118,
+			100, 216, 144, 97, 138, 205, 229, 135, 91, 170, 254, 165, 40, 148,
+			34, 138, 69, 43, 170, 251, 45, 46, 234, 126, 75, 134, 13, 63,
+			158, 17, 50, 172, 71, 13, 217, 16, 62, 198, 170, 71, 93, 213,
+			79, 51, 7, 208, 81, 172, 197, 126, 93, 17, 177, 248, 67, 202,
+			5, 113, 10, 42, 139, 88, 38, 81, 171, 139, 86, 53, 177, 178,
+			46, 245, 64, 1, 246, 100, 203, 79, 131, 13, 137, 125, 231, 135,
+			66, 118, 162, 250, 186, 240, 83, 113, 117, 229, 156, 104, 7, 141,
+			144, 78, 112, 20, 114, 241, 140, 31, 118, 253, 120, 75, 156, 154,
+			17, 167, 30, 121, 120, 126, 134, 48, 90, 151, 162, 19, 71, 45,
+			217, 73, 131, 186, 120, 42, 150, 205, 40, 14, 252, 48, 131, 94,
+			108, 174, 7, 245, 117, 33, 111, 164, 18, 192, 166, 235, 146, 239,
+			212, 106, 213, 175, 191, 176, 233, 199, 104, 17, 137, 45, 233, 199,
+			34, 10, 37, 216, 194, 153, 86, 75, 180, 131, 176, 155, 202, 68,
+			248, 177, 20, 15, 205, 103, 248, 181, 162, 176, 89, 19, 23, 164,
+			223, 201, 81, 142, 165, 168, 38, 109, 233, 199, 178, 81, 21, 73,
+			36, 210, 117, 63, 21, 97, 36, 90, 210, 239, 112, 221, 76, 164,
+			116, 230, 130, 68, 132, 82, 130, 174, 216, 185, 65, 152, 202, 184,
+			19, 75, 181, 25, 103, 68, 55, 193, 126, 245, 197, 219, 23, 30,
+			152, 93, 143, 186, 177, 104, 5, 161, 244, 99, 46, 104, 244, 119,
+			76, 225, 240, 39, 139, 115, 115, 13, 185, 33, 91, 81, 71, 198,
+			137, 225, 195, 245, 168, 77, 140, 116, 142, 90, 78, 3, 9, 144,
+			59, 246, 195, 38, 157, 209, 181, 56, 106, 139, 249, 249, 249, 83,
+			179, 244, 239, 202, 252, 252, 34, 253, 251, 60, 80, 127, 228, 145,
+			71, 30, 153, 61, 181, 48, 123, 250, 212, 202, 194, 233, 197, 7,
+			31, 89, 124, 240, 145, 218, 35, 230, 159, 231, 107, 226, 236, 22,
+			199, 66, 166, 113, 80, 7, 115, 64, 23, 66, 145, 70, 159, 17,
+			155, 82, 200, 48, 233, 198, 56, 153, 126, 138, 98, 29, 84, 142,
+			194, 13, 25, 167, 104, 172, 54, 75, 212, 22, 111, 95, 126, 242,
+			28, 23, 167, 79, 159, 126, 36, 199, 5, 156, 44, 144, 233, 26,
+			241, 177, 120, 173, 62, 23, 175, 213, 209, 162, 150, 222, 72, 167,
+			69, 195, 79, 165, 0, 255, 9, 155, 9, 144, 186, 87, 156, 191,
+			225, 183, 59, 45, 153, 112, 110, 254, 20, 167, 22, 197, 185, 168,
+			221, 233, 166, 178, 112, 22, 104, 194, 203, 151, 174, 44, 253, 61,
+			113, 29, 148, 153, 154, 190, 94, 211, 76, 52, 111, 148, 221, 61,
+			143, 170, 95, 178, 114, 45, 145, 233, 53, 189, 192, 83, 168, 157,
+			186, 120, 245, 194, 133, 233, 233, 29, 219, 209, 126, 159, 154, 159,
+			126, 180, 0, 211, 194, 173, 96, 106, 202, 20, 163, 68, 107, 13,
+			127, 171, 0, 91, 146, 198, 221, 122, 74, 103, 115, 195, 111, 137,
+			116, 67, 207, 216, 211, 252, 254, 116, 99, 70, 16, 64, 143, 126,
+			175, 40, 109, 212, 210, 13, 32, 120, 51, 140, 84, 163, 110, 34,
+			235, 226, 132, 56, 53, 63, 223, 139, 225, 233, 93, 49, 124, 46,
+			8, 79, 47, 136, 235, 79, 201, 244, 202, 86, 146, 202, 54, 126,
+			62, 147, 60, 25, 180, 228, 74, 239, 66, 60, 185, 116, 225, 252,
+			202, 210, 179, 231, 197, 90, 170, 193, 216, 173, 207, 253, 107, 169,
+			129, 244, 234, 210, 197, 149, 135, 30, 16, 105, 80, 127, 33, 17,
+			143, 139, 169, 169, 41, 85, 51, 189, 150, 214, 26, 155, 79, 7,
+			205, 245, 39, 252, 148, 122, 77, 139, 199, 30, 19, 167, 23, 166,
+			197, 223, 23, 244, 219, 133, 104, 211, 252, 100, 232, 54, 55, 39,
+			206, 136, 231, 130, 176, 17, 109, 38, 52, 36, 14, 203, 169, 249,
+			249, 2, 15, 75, 106, 89, 3, 197, 165, 78, 61, 180, 253, 24,
+			101, 163, 161, 251, 169, 135, 30, 120, 224, 129, 135, 79, 63, 52,
+			159, 179, 141, 85, 185, 22, 197, 82, 92, 13, 131, 27, 154, 215,
+			129, 153, 245, 143, 82, 251, 222, 22, 115, 74, 225, 47, 166, 166,
+			128, 65, 34, 230, 104, 177, 240, 239, 180, 152, 45, 130, 115, 139,
+			29, 140, 113, 78, 47, 228, 227, 28, 43, 140, 67, 27, 96, 186,
+			103, 3, 60, 176, 235, 6, 120, 198, 223, 240, 197, 117, 181, 248,
+			181, 122, 55, 142, 101, 152, 162, 201, 179, 65, 171, 21, 36, 133,
+			13, 0, 110, 42, 218, 84, 43, 30, 23, 187, 119, 184, 201, 54,
+			23, 143, 231, 181, 181, 80, 110, 158, 237, 6, 173, 134, 140, 167,
+			166, 129, 216, 21, 77, 33, 61, 133, 34, 204, 180, 22, 165, 132,
+			16, 104, 115, 145, 246, 250, 84, 16, 166, 192, 92, 183, 84, 168,
+			107, 180, 65, 130, 233, 233, 218, 42, 70, 158, 234, 33, 193, 131,
+			183, 32, 193, 82, 152, 164, 126, 152, 214, 194, 104, 179, 128, 181,
+			174, 21, 97, 180, 41, 30, 23, 61, 109, 110, 138, 104, 14, 247,
+			173, 49, 14, 163, 205, 90, 83, 166, 231, 177, 215, 84, 221, 212,
+			116, 1, 241, 94, 228, 117, 99, 20, 166, 118, 70, 244, 161, 93,
+			17, 213, 171, 101, 164, 12, 113, 121, 43, 93, 143, 66, 131, 234,
+			142, 203, 52, 53, 221, 247, 99, 237, 41, 153, 158, 203, 87, 125,
+			106, 154, 56, 253, 51, 87, 46, 93, 20, 207, 250, 157, 78, 16,
+			54, 57, 23, 75, 161, 170, 89, 139, 226, 182, 159, 206, 144, 216,
+			151, 195, 146, 110, 117, 232, 162, 235, 17, 91, 212, 197, 161, 37,
+			6, 78, 215, 207, 29, 221, 62, 106, 42, 72, 46, 126, 42, 130,
+			132, 230, 228, 186, 22, 147, 85, 95, 134, 212, 240, 202, 236, 203,
+			237, 40, 76, 215, 95, 153, 125, 185, 225, 111, 189, 178, 242, 50,
+			174, 238, 87, 22, 95, 110, 7, 225, 43, 139, 47, 39, 178, 254,
+			202, 219, 107, 47, 67, 88, 2, 191, 125, 229, 29, 207, 87, 185,
+			216, 92, 151, 177, 20, 170, 55, 6, 242, 91, 155, 254, 86, 98,
+			68, 94, 8, 218, 36, 9, 172, 65, 6, 104, 4, 205, 32, 77,
+			32, 210, 180, 164, 208, 51, 205, 8, 154, 106, 134, 11, 53, 217,
+			140, 160, 217, 102, 72, 46, 163, 41, 73, 42, 121, 73, 198, 209,
+			108, 199, 111, 128, 32, 184, 180, 55, 35, 51, 154, 244, 235, 235,
+			192, 75, 102, 82, 28, 164, 63, 205, 80, 102, 180, 252, 84, 247,
+			67, 209, 140, 68, 183, 131, 75, 252, 17, 211, 117, 42, 168, 201,
+			154, 174, 60, 181, 179, 172, 55, 61, 195, 105, 254, 168, 131, 146,
+			223, 82, 51, 85, 159, 175, 138, 164, 187, 182, 22, 220, 128, 52,
+			26, 212, 125, 136, 87, 88, 69, 108, 18, 146, 67, 167, 170, 87,
+			87, 206, 85, 167, 31, 237, 169, 229, 34, 200, 85, 152, 154, 56,
+			3, 201, 47, 141, 78, 171, 205, 144, 200, 56, 240, 91, 193, 75,
+			50, 22, 201, 122, 212, 109, 53, 12, 41, 161, 140, 93, 93, 57,
+			39, 166, 252, 36, 155, 13, 10, 16, 23, 213, 231, 171, 211, 88,
+			128, 80, 116, 226, 32, 84, 2, 205, 246, 173, 4, 66, 250, 61,
+			83, 117, 252, 56, 201, 167, 89, 149, 92, 144, 68, 7, 249, 166,
+			78, 170, 222, 106, 148, 174, 147, 252, 138, 190, 17, 233, 48, 6,
+			135, 100, 27, 28, 80, 147, 162, 181, 181, 68, 166, 36, 172, 61,
+			25, 65, 225, 161, 179, 54, 35, 170, 11, 243, 167, 30, 158, 157,
+			63, 53, 123, 234, 193, 149, 249, 83, 139, 167, 231, 23, 79, 61,
+			88, 155, 63, 245, 124, 85, 11, 229, 137, 160, 114, 118, 185, 116,
+			252, 36, 229, 130, 90, 210, 252, 81, 152, 75, 205, 15, 206, 8,
+			140, 86, 211, 7, 200, 223, 240, 175, 212, 227, 160, 147, 206, 64,
+			214, 237, 17, 212, 124, 129, 203, 81, 68, 171, 239, 148, 16, 64,
+			34, 173, 203, 170, 205, 174, 36, 83, 218, 254, 224, 86, 13, 63,
+			110, 112, 241, 246, 52, 90, 186, 114, 233, 10, 29, 178, 169, 233,
+			29, 196, 211, 90, 59, 122, 41, 104, 181, 124, 146, 237, 100, 56,
+			123, 245, 202, 92, 35, 170, 39, 115, 207, 201, 213, 185, 28, 148,
+			185, 101, 185, 38, 99, 25, 214, 229, 220, 83, 173, 104, 213, 111,
+			93, 187, 68, 48, 36, 115, 0, 104, 174, 48, 201, 52, 23, 109,
+			153, 174, 71, 141, 26, 184, 129, 226, 52, 51, 194, 207, 64, 18,
+			215, 33, 47, 130, 232, 53, 243, 199, 117, 131, 16, 80, 93, 149,
+			6, 91, 217, 224, 59, 162, 200, 197, 219, 175, 39, 105, 188, 70,
+			93, 11, 24, 69, 245, 164, 214, 161, 249, 8, 151, 133, 185, 86,
+			176, 26, 251, 241, 22, 9, 221, 181, 245, 180, 221, 186, 151, 254,
+			50, 125, 167, 73, 213, 231, 217, 70, 54, 147, 64, 79, 21, 199,
+			143, 189, 109, 246, 88, 123, 246, 88, 99, 229, 216, 211, 139, 199,
+			158, 93, 60, 118, 165, 118, 108, 237, 249, 227, 53, 113, 33, 120,
+			65, 110, 6, 176, 58, 4, 88, 194, 13, 63, 95, 165, 110, 34,
+			213, 104, 207, 68, 13, 159, 54, 235, 241, 68, 188, 253, 250, 210,
+			149, 75, 70, 164, 121, 146, 102, 32, 196, 181, 152, 245, 142, 41,
+			110, 236, 5, 239, 140, 26, 254, 44, 0, 171, 37, 81, 55, 174,
+			67, 26, 105, 202, 90, 40, 211, 57, 191, 19, 208, 154, 0, 45,
+			180, 34, 140, 230, 20, 184, 115, 219, 135, 39, 84, 243, 57, 184,
+			152, 6, 29, 51, 227, 133, 234, 151, 202, 88, 212, 253, 14, 157,
+			143, 104, 77, 52, 101, 40, 99, 95, 157, 52, 115, 202, 112, 42,
+			139, 228, 175, 113, 252, 195, 156, 1, 203, 101, 105, 101, 63, 255,
+			136, 197, 29, 103, 192, 30, 112, 217, 13, 123, 220, 251, 9, 75,
+			44, 231, 186, 173, 217, 247, 209, 26, 109, 119, 0, 44, 146, 32,
+			172, 23, 229, 43, 190, 179, 128, 37, 158, 237, 38, 169, 88, 149,
+			55, 85, 136, 248, 78, 26, 209, 243, 34, 8, 235, 173, 110, 18,
+			108, 64, 69, 220, 195, 75, 128, 174, 4, 240, 6, 77, 201, 114,
+			217, 141, 202, 136, 41, 49, 151, 221, 112, 199, 248, 55, 21, 34,
+			150, 203, 126, 208, 118, 189, 223, 183, 196, 197, 40, 156, 13, 101,
+			83, 105, 191, 134, 251, 18, 50, 190, 198, 12, 122, 240, 142, 124,
+			181, 38, 46, 234, 142, 153, 90, 185, 225, 183, 186, 50, 161, 221,
+			86, 24, 172, 13, 44, 147, 52, 104, 181, 196, 186, 191, 33, 69,
+			88, 156, 147, 134, 214, 29, 177, 167, 252, 84, 171, 229, 107, 81,
+			12, 117, 216, 216, 12, 250, 137, 165, 85, 197, 25, 253, 63, 190,
+			3, 65, 172, 18, 208, 52, 4, 177, 128, 116, 101, 175, 41, 49,
+			151, 253, 224, 232, 254, 213, 178, 98, 170, 252, 243, 99, 252, 66,
+			51, 72, 215, 187, 171, 164, 189, 182, 186, 245, 128, 254, 51, 219,
+			140, 230, 90, 81, 179, 17, 53, 177, 55, 231, 100, 216, 32, 75,
+			70, 50, 87, 143, 162, 184, 17, 132, 126, 26, 197, 104, 144, 204,
+			109, 156, 154, 75, 82, 63, 213, 86, 72, 183, 172, 122, 121, 183,
+			178, 136, 86, 255, 33, 227, 251, 46, 68, 205, 43, 105, 44, 253,
+			246, 21, 140, 224, 222, 203, 247, 82, 243, 107, 27, 202, 220, 71,
+			198, 208, 161, 229, 61, 84, 169, 77, 128, 238, 3, 124, 176, 30,
+			75, 48, 112, 178, 137, 14, 47, 120, 253, 102, 208, 90, 118, 139,
+			44, 155, 166, 238, 49, 190, 47, 133, 5, 42, 244, 91, 215, 96,
+			133, 185, 49, 201, 200, 208, 186, 215, 212, 46, 161, 210, 125, 140,
+			15, 250, 113, 125, 61, 216, 144, 147, 14, 13, 94, 173, 41, 124,
+			106, 189, 160, 214, 206, 168, 86, 75, 225, 90, 180, 108, 186, 184,
+			19, 188, 220, 233, 198, 77, 217, 152, 44, 9, 107, 170, 178, 172,
+			75, 222, 235, 22, 31, 46, 116, 112, 15, 241, 33, 130, 225, 90,
+			55, 110, 105, 28, 43, 84, 113, 53, 110, 185, 71, 56, 79, 104,
+			34, 250, 213, 166, 95, 135, 84, 13, 126, 62, 200, 43, 13, 63,
+			245, 233, 71, 70, 63, 14, 162, 140, 159, 60, 94, 33, 43, 167,
+			76, 21, 244, 149, 229, 172, 236, 222, 207, 71, 90, 81, 243, 154,
+			12, 211, 120, 235, 26, 109, 52, 130, 145, 45, 239, 109, 69, 205,
+			243, 168, 61, 135, 202, 103, 62, 54, 2, 139, 174, 51, 48, 111,
+			241, 95, 180, 200, 162, 235, 12, 184, 11, 159, 176, 122, 44, 186,
+			167, 30, 34, 81, 226, 194, 213, 115, 75, 226, 76, 55, 93, 143,
+			226, 164, 70, 230, 26, 50, 249, 66, 78, 72, 100, 188, 65, 166,
+			194, 171, 137, 196, 161, 34, 30, 163, 152, 158, 128, 104, 8, 105,
+			66, 217, 6, 111, 215, 236, 107, 206, 136, 186, 64, 214, 162, 110,
+			216, 48, 70, 40, 109, 110, 37, 139, 111, 102, 56, 44, 15, 140,
+			192, 96, 199, 6, 92, 86, 25, 152, 230, 31, 181, 148, 193, 110,
+			239, 192, 188, 229, 253, 164, 37, 122, 151, 19, 224, 248, 98, 53,
+			104, 4, 177, 212, 114, 24, 174, 179, 84, 170, 3, 10, 163, 50,
+			205, 118, 181, 3, 166, 171, 182, 44, 44, 88, 173, 4, 87, 250,
+			246, 177, 100, 123, 85, 54, 26, 196, 121, 131, 80, 156, 55, 135,
+			135, 108, 192, 50, 73, 231, 98, 153, 116, 34, 88, 78, 149, 161,
+			33, 169, 229, 108, 119, 111, 101, 130, 63, 97, 184, 238, 72, 229,
+			30, 239, 97, 113, 185, 176, 253, 49, 58, 112, 54, 123, 93, 232,
+			163, 162, 109, 176, 160, 50, 129, 210, 195, 29, 71, 42, 251, 76,
+			201, 114, 217, 200, 200, 97, 83, 98, 46, 27, 57, 42, 248, 101,
+			195, 28, 221, 74, 205, 59, 71, 107, 11, 214, 163, 228, 51, 204,
+			214, 138, 154, 122, 92, 177, 233, 99, 125, 155, 65, 146, 74, 24,
+			179, 51, 91, 250, 185, 156, 47, 228, 140, 168, 140, 33, 239, 49,
+			37, 203, 101, 110, 117, 218, 148, 152, 203, 220, 153, 89, 190, 65,
+			115, 219, 46, 155, 168, 220, 227, 5, 52, 183, 158, 137, 78, 132,
+			177, 193, 231, 16, 28, 79, 132, 57, 179, 162, 45, 147, 196, 111,
+			202, 154, 88, 82, 173, 212, 106, 5, 137, 152, 61, 53, 195, 179,
+			126, 68, 20, 112, 97, 53, 64, 16, 54, 51, 8, 237, 18, 38,
+			54, 204, 17, 36, 152, 216, 103, 168, 99, 51, 151, 77, 28, 21,
+			252, 105, 64, 200, 6, 92, 231, 160, 61, 197, 188, 69, 81, 56,
+			201, 16, 100, 112, 231, 38, 66, 179, 0, 209, 144, 169, 31, 180,
+			146, 204, 36, 158, 195, 109, 230, 100, 88, 229, 131, 252, 0, 127,
+			43, 47, 163, 132, 117, 62, 228, 28, 244, 206, 18, 238, 202, 225,
+			34, 174, 164, 81, 12, 251, 249, 213, 229, 11, 90, 77, 233, 29,
+			236, 56, 110, 110, 144, 39, 200, 166, 110, 212, 56, 223, 199, 7,
+			213, 144, 37, 140, 89, 40, 91, 46, 59, 52, 60, 158, 151, 153,
+			203, 14, 221, 53, 201, 223, 174, 65, 176, 92, 118, 196, 241, 188,
+			11, 119, 8, 66, 236, 111, 234, 2, 76, 132, 254, 46, 192, 224,
+			58, 58, 82, 0, 6, 23, 210, 145, 225, 3, 121, 153, 185, 236,
+			200, 228, 65, 254, 188, 6, 198, 118, 217, 81, 103, 210, 123, 203,
+			29, 2, 227, 39, 137, 108, 175, 182, 100, 227, 102, 176, 96, 189,
+			143, 22, 96, 193, 138, 31, 29, 30, 203, 203, 204, 101, 71, 39,
+			238, 226, 223, 176, 52, 48, 204, 101, 247, 57, 19, 222, 111, 91,
+			180, 197, 226, 174, 156, 17, 126, 171, 69, 51, 131, 151, 6, 18,
+			86, 165, 116, 83, 202, 80, 204, 147, 222, 103, 246, 166, 186, 101,
+			196, 38, 96, 205, 0, 17, 75, 107, 92, 172, 249, 45, 8, 151,
+			196, 18, 141, 50, 130, 67, 237, 167, 125, 72, 209, 89, 131, 79,
+			203, 112, 241, 214, 150, 104, 69, 62, 244, 200, 32, 132, 128, 68,
+			150, 235, 182, 108, 4, 80, 25, 18, 77, 162, 236, 208, 170, 89,
+			253, 150, 106, 6, 59, 166, 188, 209, 9, 226, 30, 122, 176, 18,
+			240, 171, 228, 101, 203, 101, 247, 13, 237, 207, 203, 192, 127, 252,
+			0, 191, 87, 147, 195, 113, 217, 113, 231, 110, 111, 156, 214, 38,
+			236, 182, 87, 101, 140, 19, 10, 114, 228, 131, 58, 37, 180, 26,
+			202, 203, 150, 203, 142, 243, 131, 121, 153, 185, 236, 248, 225, 35,
+			220, 199, 193, 194, 41, 59, 105, 123, 222, 10, 8, 76, 18, 82,
+			208, 154, 233, 39, 68, 97, 49, 149, 118, 173, 61, 124, 178, 213,
+			232, 63, 130, 208, 160, 245, 33, 204, 78, 57, 43, 99, 14, 115,
+			202, 153, 229, 178, 147, 251, 14, 152, 18, 230, 159, 60, 200, 223,
+			69, 192, 56, 46, 155, 171, 76, 122, 177, 88, 42, 44, 140, 20,
+			234, 30, 215, 87, 2, 201, 136, 173, 168, 9, 45, 24, 48, 210,
+			202, 173, 251, 216, 8, 50, 52, 77, 131, 68, 68, 97, 107, 139,
+			11, 191, 14, 7, 107, 75, 54, 80, 155, 70, 194, 111, 180, 131,
+			16, 190, 58, 37, 6, 214, 91, 1, 60, 69, 25, 168, 160, 221,
+			92, 101, 143, 41, 89, 46, 155, 219, 59, 102, 74, 204, 101, 115,
+			19, 119, 101, 178, 219, 191, 61, 194, 239, 238, 151, 178, 26, 93,
+			200, 248, 81, 184, 155, 219, 121, 145, 87, 158, 208, 77, 238, 216,
+			235, 252, 158, 93, 188, 206, 123, 205, 136, 198, 233, 124, 234, 54,
+			157, 206, 6, 216, 59, 242, 57, 127, 253, 144, 242, 57, 175, 254,
+			173, 207, 249, 111, 125, 206, 255, 239, 248, 156, 159, 206, 125, 206,
+			79, 223, 220, 231, 92, 203, 125, 206, 181, 191, 170, 207, 249, 191,
+			25, 85, 34, 236, 213, 129, 85, 203, 251, 192, 168, 56, 35, 204,
+			185, 235, 117, 57, 39, 65, 51, 148, 141, 25, 177, 22, 220, 144,
+			141, 217, 150, 12, 155, 233, 186, 72, 58, 100, 234, 34, 213, 63,
+			111, 14, 3, 204, 29, 187, 150, 11, 90, 49, 239, 81, 139, 151,
+			96, 158, 221, 201, 199, 157, 249, 122, 49, 106, 61, 10, 113, 62,
+			18, 209, 10, 94, 144, 162, 218, 240, 183, 170, 28, 75, 93, 37,
+			163, 106, 213, 12, 67, 174, 105, 197, 50, 115, 163, 96, 16, 230,
+			247, 100, 35, 88, 211, 6, 43, 115, 3, 115, 178, 173, 230, 173,
+			181, 86, 142, 203, 35, 39, 21, 64, 8, 50, 61, 66, 89, 101,
+			163, 88, 36, 221, 213, 20, 138, 59, 40, 66, 138, 182, 159, 79,
+			91, 19, 203, 198, 131, 235, 119, 58, 113, 116, 35, 104, 251, 116,
+			25, 159, 156, 61, 53, 63, 51, 63, 63, 79, 110, 235, 219, 242,
+			140, 102, 96, 208, 28, 61, 224, 66, 162, 19, 157, 68, 118, 27,
+			17, 169, 72, 198, 120, 159, 53, 192, 245, 19, 167, 226, 113, 81,
+			171, 213, 30, 237, 255, 77, 134, 141, 158, 95, 178, 137, 12, 143,
+			53, 191, 170, 142, 166, 182, 102, 150, 245, 113, 33, 195, 70, 86,
+			154, 85, 115, 153, 242, 163, 125, 157, 104, 3, 232, 46, 234, 111,
+			211, 129, 74, 102, 146, 96, 77, 76, 109, 155, 232, 49, 49, 47,
+			238, 191, 191, 127, 172, 55, 139, 249, 105, 241, 178, 241, 140, 108,
+			235, 116, 242, 113, 113, 234, 209, 109, 191, 234, 169, 31, 207, 28,
+			100, 243, 243, 186, 209, 43, 66, 182, 18, 185, 51, 0, 111, 222,
+			17, 128, 199, 110, 14, 192, 236, 77, 0, 56, 185, 19, 0, 183,
+			229, 132, 206, 139, 39, 243, 13, 122, 231, 187, 96, 215, 181, 222,
+			125, 143, 168, 142, 197, 37, 127, 188, 119, 201, 197, 201, 28, 77,
+			93, 165, 199, 203, 23, 221, 116, 209, 100, 200, 59, 108, 219, 5,
+			121, 159, 94, 58, 247, 236, 185, 34, 137, 243, 14, 39, 111, 190,
+			188, 121, 195, 55, 23, 27, 238, 50, 199, 201, 157, 231, 152, 189,
+			197, 10, 22, 156, 236, 25, 173, 105, 1, 51, 67, 55, 254, 211,
+			144, 173, 212, 223, 193, 249, 134, 131, 185, 189, 225, 84, 195, 223,
+			74, 30, 63, 61, 99, 66, 92, 30, 63, 101, 92, 162, 134, 140,
+			226, 241, 108, 101, 167, 250, 126, 170, 61, 25, 71, 228, 156, 167,
+			57, 167, 210, 198, 109, 251, 234, 50, 248, 111, 230, 170, 139, 125,
+			186, 135, 211, 117, 31, 252, 146, 107, 227, 253, 76, 65, 243, 210,
+			13, 41, 176, 71, 91, 97, 180, 83, 169, 154, 84, 197, 148, 86,
+			107, 48, 150, 38, 253, 180, 186, 128, 131, 68, 116, 98, 89, 167,
+			216, 155, 213, 45, 145, 246, 104, 17, 186, 233, 140, 18, 150, 242,
+			91, 166, 232, 146, 243, 19, 158, 221, 75, 126, 203, 140, 94, 235,
+			117, 221, 156, 54, 245, 106, 164, 158, 56, 130, 162, 235, 200, 224,
+			30, 244, 16, 10, 193, 86, 213, 211, 73, 117, 70, 123, 251, 242,
+			209, 112, 119, 244, 56, 219, 212, 88, 28, 22, 169, 28, 196, 157,
+			70, 171, 153, 221, 117, 10, 227, 98, 156, 190, 81, 185, 104, 7,
+			245, 184, 119, 220, 219, 29, 246, 84, 82, 45, 26, 238, 175, 86,
+			70, 249, 31, 102, 134, 251, 183, 217, 227, 222, 239, 88, 226, 10,
+			9, 5, 217, 156, 90, 202, 44, 74, 5, 125, 134, 230, 217, 211,
+			167, 30, 156, 121, 240, 225, 135, 112, 191, 225, 127, 228, 169, 57,
+			217, 87, 89, 48, 62, 139, 139, 81, 42, 23, 49, 106, 34, 197,
+			42, 204, 115, 208, 200, 224, 234, 33, 214, 7, 105, 34, 106, 47,
+			114, 29, 197, 53, 215, 14, 66, 113, 2, 133, 118, 16, 206, 173,
+			199, 226, 132, 88, 120, 64, 172, 199, 115, 13, 127, 75, 156, 16,
+			167, 31, 122, 176, 182, 240, 160, 192, 17, 153, 195, 221, 106, 34,
+			0, 212, 69, 107, 116, 165, 129, 146, 203, 222, 214, 99, 248, 127,
+			91, 143, 225, 255, 109, 238, 24, 127, 15, 51, 182, 45, 223, 118,
+			189, 255, 211, 54, 132, 184, 51, 147, 191, 145, 202, 65, 47, 158,
+			19, 204, 28, 166, 68, 144, 180, 77, 231, 37, 10, 101, 54, 90,
+			220, 35, 106, 169, 205, 232, 139, 121, 46, 174, 235, 117, 184, 174,
+			117, 88, 237, 231, 140, 146, 128, 148, 194, 40, 22, 153, 159, 224,
+			58, 109, 55, 221, 80, 237, 115, 195, 5, 18, 2, 165, 48, 97,
+			20, 139, 118, 20, 195, 68, 65, 190, 6, 56, 158, 181, 73, 204,
+			72, 203, 61, 163, 41, 7, 197, 170, 228, 25, 122, 62, 249, 112,
+			154, 33, 118, 23, 53, 239, 133, 179, 127, 139, 244, 248, 33, 176,
+			61, 10, 21, 187, 248, 37, 252, 30, 191, 132, 223, 227, 151, 240,
+			11, 126, 137, 47, 254, 23, 124, 225, 54, 252, 18, 173, 168, 217,
+			89, 133, 31, 66, 235, 187, 37, 170, 184, 165, 243, 193, 187, 133,
+			222, 92, 253, 83, 155, 143, 101, 102, 221, 39, 100, 66, 222, 218,
+			40, 38, 11, 127, 44, 215, 130, 27, 218, 108, 175, 75, 174, 203,
+			157, 208, 111, 75, 242, 72, 12, 45, 211, 223, 238, 2, 31, 214,
+			134, 124, 240, 90, 242, 55, 236, 91, 216, 15, 127, 66, 103, 181,
+			118, 133, 126, 89, 217, 234, 200, 101, 109, 238, 199, 223, 238, 61,
+			124, 15, 236, 25, 50, 76, 85, 39, 152, 241, 135, 150, 135, 117,
+			29, 53, 121, 19, 31, 202, 176, 153, 44, 221, 210, 3, 146, 55,
+			118, 223, 196, 157, 212, 111, 38, 147, 101, 193, 166, 134, 23, 238,
+			211, 144, 236, 128, 102, 109, 197, 111, 38, 228, 20, 88, 166, 30,
+			240, 30, 172, 6, 161, 31, 111, 93, 131, 141, 253, 154, 188, 145,
+			78, 14, 18, 100, 123, 85, 53, 162, 202, 206, 223, 72, 189, 135,
+			249, 80, 214, 213, 29, 229, 236, 5, 185, 165, 9, 133, 63, 97,
+			86, 160, 237, 168, 201, 164, 10, 139, 246, 155, 172, 42, 248, 213,
+			138, 188, 145, 186, 247, 243, 18, 194, 45, 97, 145, 0, 144, 163,
+			26, 72, 252, 86, 187, 16, 132, 114, 89, 253, 236, 253, 247, 22,
+			119, 80, 206, 199, 180, 10, 99, 186, 135, 249, 80, 67, 82, 132,
+			177, 140, 245, 108, 121, 133, 251, 0, 47, 211, 1, 72, 38, 25,
+			145, 226, 112, 255, 44, 181, 39, 233, 103, 194, 99, 89, 183, 245,
+			30, 225, 195, 133, 234, 59, 66, 239, 1, 94, 62, 75, 132, 194,
+			6, 82, 1, 7, 68, 23, 103, 89, 151, 176, 129, 96, 182, 36,
+			88, 247, 44, 211, 223, 213, 127, 108, 241, 202, 19, 126, 234, 55,
+			99, 191, 157, 53, 176, 242, 6, 238, 41, 62, 216, 241, 227, 52,
+			240, 91, 218, 21, 118, 151, 70, 196, 244, 170, 93, 86, 63, 47,
+			155, 118, 222, 83, 124, 80, 215, 1, 92, 232, 106, 106, 43, 239,
+			93, 86, 5, 204, 147, 4, 47, 41, 28, 156, 101, 250, 27, 117,
+			45, 63, 73, 105, 11, 87, 150, 233, 239, 234, 39, 109, 94, 185,
+			160, 93, 71, 238, 34, 31, 198, 54, 187, 86, 64, 109, 120, 225,
+			224, 182, 93, 105, 248, 229, 50, 71, 235, 75, 212, 24, 91, 94,
+			29, 34, 237, 151, 83, 19, 15, 171, 58, 229, 149, 187, 135, 239,
+			209, 39, 41, 119, 221, 57, 203, 250, 116, 169, 38, 30, 175, 36,
+			112, 174, 132, 117, 229, 251, 114, 150, 179, 178, 123, 15, 119, 82,
+			108, 89, 78, 96, 13, 23, 22, 251, 233, 129, 101, 250, 201, 61,
+			206, 203, 106, 39, 79, 14, 83, 163, 189, 186, 145, 90, 181, 167,
+			7, 150, 245, 207, 238, 172, 114, 191, 129, 184, 147, 123, 168, 233,
+			72, 31, 205, 159, 30, 88, 206, 154, 156, 29, 226, 131, 250, 236,
+			86, 127, 220, 33, 130, 41, 112, 107, 220, 105, 200, 164, 174, 41,
+			229, 237, 126, 20, 151, 169, 157, 59, 199, 7, 181, 185, 121, 210,
+			166, 45, 123, 32, 239, 66, 35, 214, 104, 33, 150, 77, 43, 247,
+			4, 223, 143, 101, 186, 214, 67, 90, 69, 183, 17, 252, 112, 185,
+			64, 94, 211, 182, 135, 198, 78, 222, 246, 74, 129, 206, 187, 248,
+			17, 157, 62, 63, 162, 251, 56, 31, 198, 205, 15, 59, 78, 16,
+			133, 147, 101, 98, 126, 135, 250, 129, 62, 151, 55, 89, 46, 182,
+			247, 190, 96, 241, 18, 97, 180, 235, 129, 41, 46, 184, 189, 109,
+			193, 123, 183, 20, 187, 245, 150, 114, 182, 111, 169, 190, 77, 93,
+			186, 131, 77, 93, 189, 135, 15, 23, 112, 115, 43, 220, 185, 120,
+			233, 226, 249, 209, 1, 252, 245, 252, 133, 165, 179, 163, 214, 137,
+			69, 206, 243, 75, 0, 245, 43, 231, 255, 222, 202, 232, 128, 203,
+			121, 249, 236, 210, 197, 51, 203, 111, 27, 181, 220, 61, 188, 242,
+			196, 153, 149, 51, 79, 45, 159, 121, 118, 212, 70, 27, 200, 243,
+			163, 236, 153, 15, 95, 228, 131, 110, 201, 25, 120, 195, 190, 169,
+			155, 246, 193, 191, 9, 110, 218, 125, 69, 55, 45, 254, 180, 92,
+			54, 52, 48, 197, 5, 183, 75, 3, 174, 179, 103, 224, 128, 229,
+			141, 139, 51, 69, 119, 0, 174, 198, 154, 224, 156, 179, 18, 4,
+			222, 61, 165, 17, 62, 204, 157, 18, 201, 187, 123, 237, 97, 136,
+			20, 40, 88, 46, 219, 107, 151, 77, 201, 118, 217, 222, 33, 174,
+			27, 90, 46, 219, 103, 239, 213, 13, 225, 144, 218, 103, 87, 76,
+			201, 118, 217, 190, 225, 61, 186, 161, 237, 178, 17, 123, 68, 55,
+			132, 24, 57, 98, 115, 83, 194, 111, 123, 247, 241, 38, 53, 100,
+			46, 27, 183, 135, 189, 231, 57, 73, 233, 179, 116, 75, 193, 150,
+			149, 244, 248, 138, 40, 14, 4, 151, 153, 208, 108, 65, 41, 3,
+			8, 4, 4, 253, 17, 217, 71, 61, 65, 120, 95, 13, 165, 181,
+			175, 154, 153, 22, 238, 139, 241, 12, 45, 102, 187, 108, 124, 136,
+			243, 23, 149, 109, 240, 208, 192, 101, 203, 147, 39, 200, 39, 109,
+			168, 213, 200, 152, 9, 121, 198, 16, 6, 8, 51, 157, 146, 240,
+			214, 186, 240, 139, 74, 122, 86, 18, 132, 74, 183, 128, 5, 1,
+			171, 200, 117, 215, 85, 60, 192, 0, 26, 205, 32, 44, 120, 51,
+			141, 190, 113, 168, 114, 136, 255, 251, 76, 223, 184, 207, 30, 247,
+			190, 102, 241, 130, 35, 247, 56, 233, 124, 8, 56, 156, 50, 246,
+			232, 105, 237, 54, 79, 68, 20, 7, 77, 120, 141, 49, 50, 9,
+			149, 153, 28, 122, 182, 155, 182, 36, 94, 103, 32, 174, 140, 44,
+			232, 128, 116, 29, 58, 133, 47, 20, 251, 194, 40, 103, 32, 225,
+			6, 13, 51, 69, 230, 1, 246, 133, 58, 93, 23, 33, 211, 26,
+			60, 176, 23, 23, 85, 56, 22, 2, 202, 118, 19, 48, 235, 81,
+			187, 29, 133, 70, 206, 196, 110, 235, 209, 57, 238, 179, 43, 5,
+			157, 227, 190, 161, 162, 206, 113, 159, 59, 198, 255, 36, 11, 54,
+			170, 217, 174, 247, 239, 52, 49, 242, 253, 123, 60, 17, 16, 16,
+			251, 200, 97, 86, 133, 98, 13, 210, 72, 116, 195, 224, 197, 46,
+			172, 140, 1, 76, 169, 193, 218, 150, 240, 11, 99, 144, 50, 97,
+			52, 239, 122, 212, 145, 122, 251, 112, 209, 217, 70, 26, 154, 236,
+			175, 155, 48, 86, 201, 101, 181, 140, 48, 56, 82, 181, 161, 162,
+			112, 95, 27, 221, 207, 223, 100, 124, 253, 167, 236, 35, 222, 201,
+			237, 84, 209, 103, 66, 197, 126, 22, 168, 35, 244, 56, 118, 25,
+			93, 141, 115, 12, 167, 241, 212, 222, 73, 83, 98, 46, 59, 117,
+			232, 48, 255, 119, 150, 241, 42, 46, 218, 158, 247, 175, 250, 119,
+			226, 110, 83, 152, 5, 208, 42, 17, 124, 25, 79, 175, 172, 92,
+			22, 231, 84, 251, 217, 21, 128, 68, 52, 52, 6, 234, 182, 223,
+			144, 194, 223, 240, 131, 150, 137, 64, 189, 16, 53, 159, 136, 154,
+			220, 248, 244, 96, 46, 9, 197, 139, 93, 25, 111, 229, 231, 6,
+			161, 147, 190, 58, 134, 75, 169, 218, 211, 126, 43, 137, 104, 74,
+			56, 104, 180, 147, 80, 59, 59, 185, 80, 98, 7, 145, 137, 122,
+			25, 114, 179, 146, 203, 22, 51, 114, 131, 39, 44, 14, 21, 93,
+			154, 139, 147, 7, 249, 255, 100, 25, 159, 230, 89, 251, 132, 247,
+			207, 119, 218, 135, 171, 62, 158, 75, 26, 13, 97, 39, 130, 132,
+			145, 113, 130, 146, 177, 143, 26, 27, 125, 49, 31, 74, 133, 255,
+			104, 121, 48, 144, 153, 197, 6, 251, 51, 136, 121, 97, 10, 63,
+			233, 177, 116, 168, 203, 85, 63, 141, 210, 62, 52, 40, 186, 6,
+			79, 167, 236, 178, 179, 246, 33, 83, 178, 92, 118, 246, 240, 49,
+			83, 98, 46, 59, 59, 53, 205, 255, 161, 194, 179, 228, 178, 37,
+			251, 168, 247, 195, 192, 211, 167, 168, 15, 152, 175, 226, 213, 32,
+			69, 140, 168, 120, 65, 110, 205, 209, 2, 138, 212, 111, 10, 63,
+			73, 162, 122, 224, 103, 106, 57, 77, 93, 192, 71, 241, 167, 39,
+			162, 102, 182, 154, 240, 24, 208, 98, 194, 158, 86, 64, 93, 17,
+			145, 28, 96, 24, 152, 166, 200, 253, 185, 165, 50, 160, 50, 43,
+			83, 178, 92, 182, 52, 225, 153, 18, 115, 217, 210, 145, 187, 249,
+			79, 43, 248, 203, 46, 187, 100, 31, 241, 126, 204, 226, 98, 9,
+			142, 24, 109, 177, 203, 206, 123, 171, 133, 93, 242, 206, 40, 128,
+			253, 34, 141, 154, 146, 140, 116, 141, 46, 89, 226, 50, 103, 127,
+			26, 137, 88, 170, 168, 55, 116, 231, 134, 195, 154, 232, 23, 186,
+			111, 250, 246, 174, 159, 138, 199, 20, 219, 120, 243, 220, 201, 185,
+			199, 192, 47, 222, 92, 131, 46, 100, 176, 40, 151, 0, 155, 217,
+			109, 101, 203, 101, 151, 134, 204, 193, 43, 51, 151, 93, 58, 116,
+			152, 87, 57, 150, 199, 185, 50, 16, 90, 222, 132, 186, 227, 244,
+			140, 250, 204, 169, 27, 219, 1, 107, 184, 82, 217, 195, 31, 229,
+			142, 99, 33, 172, 230, 170, 221, 98, 222, 44, 157, 180, 160, 217,
+			141, 186, 136, 239, 185, 145, 234, 123, 16, 208, 210, 30, 18, 153,
+			250, 150, 104, 126, 96, 81, 36, 205, 85, 190, 143, 63, 201, 203,
+			24, 10, 215, 255, 115, 206, 1, 239, 97, 181, 207, 131, 80, 30,
+			215, 99, 105, 8, 102, 232, 133, 48, 25, 38, 26, 32, 26, 174,
+			219, 108, 216, 154, 160, 0, 5, 26, 167, 132, 129, 120, 94, 182,
+			92, 246, 220, 240, 104, 94, 102, 46, 123, 110, 108, 156, 127, 198,
+			210, 19, 91, 46, 187, 230, 28, 244, 126, 198, 28, 49, 53, 117,
+			54, 180, 54, 151, 226, 186, 90, 210, 66, 150, 218, 157, 178, 221,
+			73, 183, 244, 175, 58, 34, 4, 104, 99, 239, 2, 228, 32, 236,
+			202, 76, 124, 10, 129, 136, 210, 105, 160, 201, 225, 1, 109, 40,
+			77, 52, 68, 54, 167, 145, 129, 141, 89, 181, 17, 73, 58, 195,
+			194, 111, 108, 224, 26, 213, 177, 31, 150, 142, 203, 185, 86, 192,
+			18, 11, 115, 77, 7, 9, 89, 58, 46, 231, 218, 93, 147, 252,
+			127, 181, 53, 150, 182, 203, 94, 112, 238, 245, 190, 102, 115, 50,
+			67, 145, 112, 162, 207, 0, 128, 151, 234, 232, 38, 42, 70, 95,
+			63, 54, 44, 128, 214, 43, 204, 136, 139, 50, 193, 233, 211, 129,
+			225, 186, 43, 110, 247, 181, 150, 159, 166, 146, 92, 152, 216, 236,
+			58, 228, 61, 136, 113, 130, 181, 69, 183, 90, 171, 214, 132, 10,
+			121, 231, 198, 205, 135, 174, 136, 129, 145, 13, 196, 196, 173, 250,
+			105, 208, 86, 212, 81, 143, 0, 76, 171, 68, 15, 6, 109, 195,
+			175, 167, 4, 149, 182, 4, 235, 37, 58, 67, 180, 84, 142, 198,
+			219, 88, 8, 213, 26, 241, 31, 97, 164, 41, 0, 17, 75, 110,
+			241, 34, 72, 153, 255, 95, 19, 99, 77, 29, 73, 217, 108, 227,
+			100, 228, 139, 128, 43, 238, 5, 231, 174, 188, 108, 185, 236, 133,
+			201, 187, 243, 50, 115, 217, 11, 247, 84, 33, 157, 58, 22, 54,
+			124, 219, 86, 236, 196, 178, 7, 28, 148, 184, 41, 149, 93, 214,
+			30, 30, 49, 37, 203, 101, 237, 209, 3, 166, 196, 92, 214, 158,
+			60, 200, 239, 227, 182, 99, 187, 206, 139, 3, 27, 150, 55, 41,
+			148, 174, 187, 243, 161, 197, 93, 251, 98, 101, 31, 127, 138, 59,
+			142, 141, 105, 19, 123, 220, 91, 4, 154, 98, 117, 139, 98, 98,
+			192, 198, 13, 133, 244, 16, 250, 150, 88, 11, 98, 220, 169, 170,
+			153, 22, 65, 9, 16, 155, 164, 169, 68, 243, 21, 155, 164, 169,
+			68, 75, 83, 54, 29, 178, 196, 29, 227, 83, 52, 165, 229, 178,
+			174, 189, 223, 59, 164, 166, 44, 66, 122, 60, 233, 29, 19, 219,
+			186, 171, 173, 140, 54, 89, 25, 187, 58, 158, 198, 166, 45, 221,
+			29, 25, 229, 51, 28, 151, 71, 105, 107, 224, 191, 178, 44, 239,
+			168, 48, 170, 123, 31, 238, 5, 61, 195, 193, 13, 187, 85, 25,
+			229, 85, 238, 56, 12, 4, 120, 217, 222, 239, 29, 80, 87, 164,
+			209, 246, 139, 112, 48, 194, 237, 101, 13, 7, 35, 220, 94, 214,
+			112, 48, 194, 237, 229, 145, 81, 254, 42, 56, 63, 99, 3, 110,
+			249, 221, 150, 253, 227, 22, 243, 226, 30, 14, 65, 231, 86, 104,
+			211, 77, 54, 141, 102, 20, 180, 213, 212, 181, 160, 56, 26, 140,
+			205, 58, 8, 110, 75, 121, 91, 84, 188, 82, 127, 72, 34, 137,
+			252, 102, 176, 26, 231, 123, 1, 18, 120, 169, 243, 110, 139, 239,
+			231, 171, 188, 140, 162, 61, 224, 58, 239, 181, 156, 3, 222, 178,
+			226, 105, 164, 73, 207, 40, 143, 31, 142, 36, 109, 104, 88, 152,
+			103, 50, 197, 209, 140, 9, 7, 13, 237, 107, 179, 31, 48, 35,
+			207, 224, 175, 113, 62, 194, 7, 213, 28, 37, 154, 164, 80, 97,
+			161, 98, 120, 52, 175, 96, 168, 24, 27, 231, 115, 26, 44, 203,
+			117, 126, 212, 114, 198, 189, 163, 4, 85, 18, 188, 164, 21, 215,
+			62, 188, 68, 54, 130, 85, 162, 30, 249, 28, 22, 13, 49, 60,
+			146, 87, 48, 84, 184, 99, 252, 170, 158, 195, 118, 157, 247, 91,
+			142, 235, 157, 207, 131, 254, 204, 146, 0, 157, 150, 159, 164, 219,
+			86, 197, 224, 138, 160, 102, 191, 72, 224, 28, 18, 187, 68, 227,
+			86, 242, 10, 11, 21, 67, 123, 243, 10, 134, 138, 209, 253, 124,
+			15, 246, 133, 109, 185, 206, 79, 88, 246, 132, 90, 33, 68, 211,
+			162, 56, 100, 138, 244, 43, 223, 111, 138, 12, 197, 241, 3, 252,
+			139, 136, 116, 118, 220, 242, 135, 172, 129, 55, 44, 203, 251, 148,
+			117, 130, 139, 51, 33, 194, 50, 130, 141, 160, 209, 245, 243, 224,
+			197, 173, 76, 200, 203, 98, 232, 128, 65, 210, 197, 155, 118, 165,
+			18, 166, 177, 31, 38, 148, 183, 1, 50, 110, 38, 133, 146, 94,
+			107, 78, 9, 109, 196, 132, 23, 156, 86, 217, 139, 250, 156, 105,
+			82, 227, 27, 233, 118, 201, 177, 79, 38, 175, 65, 67, 103, 14,
+			36, 137, 15, 89, 149, 81, 14, 59, 175, 227, 96, 51, 126, 212,
+			178, 79, 122, 31, 209, 55, 172, 62, 168, 90, 24, 37, 222, 147,
+			5, 64, 235, 225, 50, 228, 12, 111, 74, 68, 254, 6, 175, 31,
+			4, 136, 112, 162, 154, 73, 169, 85, 8, 225, 228, 254, 217, 208,
+			82, 84, 246, 147, 62, 70, 65, 146, 71, 30, 105, 29, 65, 31,
+			37, 199, 30, 40, 19, 180, 158, 41, 90, 40, 30, 186, 223, 20,
+			25, 138, 211, 39, 148, 166, 226, 96, 145, 127, 214, 178, 61, 239,
+			171, 26, 53, 29, 211, 172, 227, 123, 11, 106, 222, 229, 157, 148,
+			104, 163, 53, 102, 234, 157, 82, 27, 179, 103, 85, 198, 68, 43,
+			124, 120, 23, 213, 254, 133, 0, 129, 203, 82, 45, 177, 54, 77,
+			96, 253, 252, 88, 95, 182, 57, 97, 180, 162, 173, 117, 30, 163,
+			155, 54, 36, 130, 140, 192, 92, 186, 161, 223, 94, 213, 98, 91,
+			11, 202, 79, 20, 55, 164, 22, 118, 20, 190, 56, 127, 63, 107,
+			217, 21, 141, 62, 78, 223, 207, 90, 67, 7, 76, 145, 161, 56,
+			121, 144, 255, 91, 69, 13, 219, 117, 62, 5, 106, 188, 113, 51,
+			106, 64, 110, 211, 65, 248, 59, 80, 163, 159, 20, 26, 115, 28,
+			74, 99, 134, 233, 65, 213, 111, 103, 180, 133, 184, 160, 6, 230,
+			2, 134, 131, 219, 198, 59, 67, 187, 71, 47, 55, 170, 132, 66,
+			21, 199, 255, 83, 57, 33, 176, 240, 159, 202, 9, 97, 51, 20,
+			39, 15, 242, 223, 182, 137, 16, 204, 117, 126, 201, 178, 39, 188,
+			47, 218, 122, 199, 247, 9, 118, 134, 233, 209, 229, 106, 78, 16,
+			240, 219, 202, 222, 116, 153, 181, 39, 202, 200, 27, 233, 98, 143,
+			229, 7, 130, 136, 38, 107, 207, 88, 250, 50, 105, 144, 92, 131,
+			215, 112, 170, 89, 80, 167, 80, 231, 102, 16, 170, 80, 4, 63,
+			37, 238, 95, 227, 90, 110, 232, 29, 188, 40, 20, 244, 140, 78,
+			63, 104, 250, 100, 51, 17, 79, 225, 217, 53, 220, 59, 84, 15,
+			136, 57, 87, 93, 201, 134, 52, 117, 36, 131, 81, 107, 5, 161,
+			2, 79, 147, 151, 149, 136, 158, 134, 248, 204, 66, 113, 104, 191,
+			41, 18, 181, 199, 15, 240, 20, 180, 175, 12, 184, 229, 95, 177,
+			236, 175, 88, 204, 107, 112, 157, 48, 69, 209, 87, 67, 161, 55,
+			165, 1, 2, 247, 176, 145, 22, 59, 81, 167, 171, 2, 215, 40,
+			106, 31, 230, 4, 46, 218, 126, 90, 215, 210, 95, 212, 60, 158,
+			136, 235, 218, 244, 12, 249, 226, 186, 1, 177, 130, 27, 248, 87,
+			172, 202, 8, 159, 3, 16, 182, 227, 58, 191, 102, 57, 99, 222,
+			61, 74, 151, 82, 219, 114, 145, 214, 35, 49, 49, 215, 80, 148,
+			106, 66, 35, 225, 148, 169, 135, 65, 17, 44, 244, 215, 172, 161,
+			189, 166, 200, 80, 28, 117, 249, 12, 141, 94, 114, 157, 95, 183,
+			156, 187, 188, 187, 123, 197, 190, 69, 186, 207, 50, 185, 212, 12,
+			93, 42, 83, 115, 67, 204, 146, 133, 226, 176, 161, 94, 137, 161,
+			56, 62, 193, 79, 210, 208, 101, 215, 249, 178, 229, 28, 242, 142,
+			244, 203, 85, 139, 89, 69, 146, 1, 93, 86, 173, 247, 152, 162,
+			133, 226, 94, 115, 40, 202, 12, 197, 73, 143, 255, 145, 205, 109,
+			167, 228, 150, 127, 207, 130, 205, 219, 251, 215, 182, 50, 113, 46,
+			101, 111, 32, 66, 189, 79, 16, 24, 143, 146, 159, 206, 34, 89,
+			138, 230, 242, 20, 25, 111, 210, 177, 228, 140, 31, 82, 18, 181,
+			80, 125, 253, 88, 154, 87, 146, 121, 120, 138, 122, 237, 17, 36,
+			105, 191, 166, 141, 225, 206, 132, 153, 169, 166, 56, 44, 0, 162,
+			71, 209, 106, 165, 234, 185, 102, 155, 177, 227, 181, 216, 111, 203,
+			164, 150, 139, 86, 216, 37, 29, 109, 103, 61, 78, 60, 37, 168,
+			171, 187, 58, 11, 11, 3, 219, 83, 128, 207, 104, 43, 159, 86,
+			255, 130, 182, 196, 97, 5, 135, 34, 19, 32, 13, 126, 60, 49,
+			114, 178, 185, 0, 139, 207, 2, 122, 1, 94, 109, 69, 171, 250,
+			230, 197, 218, 254, 30, 110, 222, 175, 130, 33, 151, 112, 243, 254,
+			161, 101, 31, 245, 126, 69, 51, 228, 29, 124, 84, 249, 149, 88,
+			24, 178, 159, 49, 155, 131, 76, 250, 80, 210, 123, 201, 236, 52,
+			166, 201, 224, 227, 107, 3, 140, 168, 251, 33, 23, 136, 165, 207,
+			133, 61, 205, 93, 48, 171, 49, 171, 97, 217, 26, 209, 102, 136,
+			119, 18, 70, 197, 167, 137, 245, 49, 43, 209, 237, 252, 135, 150,
+			125, 192, 20, 45, 32, 56, 225, 153, 34, 67, 241, 200, 221, 252,
+			159, 17, 250, 16, 205, 191, 105, 217, 63, 110, 51, 239, 131, 22,
+			23, 228, 146, 210, 203, 27, 132, 120, 152, 66, 99, 23, 165, 41,
+			83, 69, 130, 72, 187, 19, 225, 198, 140, 214, 122, 246, 131, 190,
+			133, 102, 148, 217, 191, 30, 197, 234, 57, 88, 67, 103, 245, 241,
+			121, 81, 187, 76, 66, 191, 147, 172, 71, 132, 168, 102, 63, 57,
+			149, 13, 82, 36, 189, 127, 211, 226, 35, 252, 221, 48, 73, 148,
+			32, 56, 187, 206, 183, 44, 103, 194, 123, 145, 239, 166, 165, 201,
+			118, 144, 166, 189, 251, 64, 79, 176, 44, 235, 81, 220, 88, 186,
+			164, 239, 19, 173, 56, 112, 99, 223, 219, 218, 14, 51, 221, 55,
+			230, 178, 129, 52, 91, 210, 210, 253, 183, 140, 228, 93, 210, 210,
+			253, 183, 172, 225, 253, 121, 5, 67, 5, 132, 86, 91, 131, 109,
+			185, 206, 159, 91, 206, 164, 247, 207, 239, 248, 218, 251, 190, 221,
+			114, 234, 246, 88, 149, 205, 32, 252, 155, 115, 203, 25, 138, 66,
+			218, 250, 243, 34, 205, 33, 111, 253, 185, 53, 60, 150, 87, 48,
+			84, 76, 220, 197, 255, 7, 179, 85, 108, 215, 249, 75, 203, 57,
+			236, 253, 19, 125, 196, 115, 142, 168, 131, 173, 41, 163, 7, 118,
+			190, 113, 23, 36, 187, 72, 161, 36, 39, 173, 110, 101, 54, 83,
+			48, 164, 220, 123, 145, 9, 204, 217, 62, 210, 194, 146, 175, 79,
+			50, 215, 251, 48, 23, 208, 10, 158, 30, 3, 63, 196, 168, 191,
+			44, 98, 8, 65, 234, 47, 173, 225, 187, 242, 10, 134, 10, 239,
+			16, 255, 73, 131, 33, 115, 157, 247, 216, 206, 97, 239, 135, 52,
+			134, 69, 189, 193, 104, 175, 153, 86, 244, 253, 198, 141, 196, 226,
+			236, 188, 26, 32, 33, 144, 188, 199, 46, 160, 1, 145, 228, 61,
+			118, 1, 13, 70, 80, 123, 135, 248, 183, 13, 26, 142, 235, 188,
+			223, 118, 102, 189, 111, 220, 14, 26, 148, 190, 160, 96, 106, 79,
+			138, 200, 244, 104, 66, 185, 131, 240, 120, 210, 163, 4, 105, 209,
+			166, 128, 40, 177, 129, 12, 215, 172, 105, 113, 246, 30, 153, 121,
+			55, 122, 241, 29, 8, 134, 120, 80, 228, 70, 200, 73, 2, 137,
+			230, 253, 182, 115, 56, 175, 128, 194, 108, 31, 153, 202, 43, 160,
+			48, 219, 39, 103, 248, 55, 32, 53, 151, 176, 21, 126, 218, 182,
+			143, 120, 191, 99, 195, 51, 152, 179, 92, 63, 169, 75, 98, 86,
+			179, 36, 168, 203, 134, 102, 229, 90, 146, 75, 242, 56, 61, 236,
+			58, 195, 115, 137, 91, 227, 218, 217, 225, 206, 4, 53, 159, 51,
+			178, 62, 180, 65, 181, 6, 189, 195, 194, 110, 32, 69, 85, 45,
+			81, 117, 70, 84, 139, 97, 9, 213, 25, 46, 170, 197, 32, 4,
+			29, 25, 90, 45, 68, 29, 232, 53, 72, 50, 243, 127, 134, 136,
+			185, 109, 214, 176, 89, 101, 88, 223, 218, 62, 187, 49, 33, 53,
+			228, 26, 124, 6, 143, 138, 64, 41, 113, 29, 179, 240, 153, 108,
+			3, 175, 97, 84, 39, 127, 77, 36, 234, 235, 81, 132, 151, 71,
+			249, 208, 217, 221, 105, 57, 68, 223, 172, 88, 70, 113, 120, 212,
+			20, 45, 20, 247, 79, 154, 34, 67, 241, 208, 97, 88, 36, 176,
+			54, 182, 235, 124, 204, 182, 143, 42, 139, 196, 74, 102, 71, 33,
+			138, 104, 126, 163, 89, 102, 47, 149, 205, 158, 205, 243, 216, 44,
+			173, 17, 219, 35, 234, 198, 164, 234, 233, 108, 149, 33, 210, 103,
+			230, 78, 108, 127, 53, 234, 234, 71, 149, 148, 156, 165, 56, 215,
+			12, 222, 156, 160, 147, 204, 146, 244, 100, 234, 161, 6, 35, 243,
+			164, 42, 124, 192, 120, 62, 102, 107, 253, 173, 68, 198, 155, 143,
+			217, 67, 70, 112, 128, 254, 246, 49, 251, 200, 221, 6, 91, 230,
+			58, 175, 111, 199, 86, 223, 179, 255, 89, 176, 45, 206, 117, 27,
+			216, 102, 32, 40, 124, 192, 159, 94, 207, 177, 5, 119, 122, 61,
+			199, 22, 188, 233, 117, 96, 251, 107, 10, 91, 199, 117, 62, 133,
+			115, 247, 25, 131, 109, 126, 93, 27, 134, 180, 211, 84, 223, 23,
+			108, 213, 84, 188, 111, 174, 59, 199, 216, 129, 126, 158, 99, 12,
+			253, 233, 83, 246, 144, 217, 205, 14, 67, 241, 208, 97, 126, 5,
+			8, 59, 3, 110, 249, 23, 108, 251, 215, 108, 230, 157, 227, 162,
+			16, 234, 99, 238, 243, 66, 48, 147, 72, 234, 235, 146, 238, 27,
+			82, 76, 118, 100, 37, 52, 7, 210, 174, 56, 191, 96, 15, 186,
+			252, 89, 48, 124, 196, 23, 184, 206, 103, 109, 103, 175, 247, 120,
+			191, 226, 210, 167, 97, 116, 67, 51, 159, 108, 228, 210, 155, 209,
+			49, 20, 183, 196, 112, 22, 141, 87, 201, 43, 108, 84, 12, 239,
+			225, 255, 138, 233, 25, 45, 215, 249, 85, 204, 248, 203, 140, 223,
+			98, 206, 190, 105, 180, 52, 27, 173, 153, 180, 85, 217, 179, 92,
+			46, 94, 106, 5, 171, 179, 5, 8, 87, 91, 81, 253, 133, 154,
+			56, 15, 241, 151, 254, 70, 134, 157, 58, 44, 213, 121, 163, 153,
+			190, 249, 120, 255, 132, 217, 42, 250, 121, 98, 31, 159, 188, 106,
+			61, 212, 200, 17, 0, 47, 57, 139, 217, 242, 192, 116, 221, 166,
+			240, 100, 173, 181, 85, 20, 186, 8, 184, 28, 21, 188, 232, 205,
+			36, 225, 126, 141, 69, 71, 242, 108, 192, 67, 140, 1, 111, 104,
+			110, 76, 130, 36, 80, 9, 229, 166, 193, 54, 65, 58, 78, 173,
+			78, 28, 79, 178, 11, 226, 38, 114, 101, 1, 90, 26, 67, 41,
+			71, 106, 108, 78, 178, 6, 162, 63, 178, 203, 212, 92, 162, 88,
+			81, 181, 164, 249, 162, 91, 54, 42, 134, 247, 104, 21, 7, 108,
+			237, 43, 182, 125, 183, 247, 143, 180, 84, 177, 243, 214, 221, 69,
+			117, 236, 243, 105, 226, 186, 65, 164, 219, 76, 65, 168, 53, 175,
+			100, 181, 32, 140, 21, 210, 58, 176, 118, 72, 208, 61, 64, 82,
+			82, 193, 126, 181, 237, 120, 194, 6, 241, 21, 219, 222, 107, 138,
+			22, 138, 251, 14, 154, 34, 67, 241, 240, 145, 44, 128, 252, 31,
+			125, 210, 226, 111, 217, 45, 142, 229, 182, 51, 219, 32, 195, 77,
+			95, 98, 155, 239, 107, 186, 28, 239, 123, 8, 114, 255, 171, 71,
+			183, 255, 24, 227, 252, 41, 153, 46, 67, 114, 72, 82, 188, 3,
+			239, 196, 17, 220, 176, 58, 154, 217, 20, 17, 248, 219, 241, 211,
+			117, 29, 208, 76, 127, 35, 108, 152, 16, 208, 209, 192, 170, 144,
+			7, 19, 35, 144, 146, 153, 96, 226, 35, 156, 99, 47, 23, 2,
+			69, 75, 203, 67, 168, 81, 65, 162, 135, 248, 16, 146, 210, 168,
+			95, 203, 244, 107, 165, 21, 53, 213, 143, 199, 248, 190, 48, 10,
+			175, 229, 198, 19, 10, 57, 175, 44, 239, 13, 163, 48, 143, 21,
+			112, 151, 248, 72, 19, 89, 98, 233, 89, 7, 178, 226, 36, 147,
+			21, 138, 212, 188, 199, 100, 238, 201, 49, 173, 225, 241, 199, 213,
+			229, 11, 186, 184, 188, 183, 41, 83, 84, 201, 198, 213, 184, 149,
+			120, 93, 190, 175, 183, 129, 251, 32, 175, 180, 130, 53, 9, 250,
+			222, 58, 168, 57, 107, 138, 152, 85, 181, 121, 137, 112, 149, 101,
+			93, 202, 137, 164, 73, 71, 133, 234, 91, 249, 240, 138, 31, 180,
+			190, 143, 171, 81, 253, 35, 155, 15, 19, 218, 176, 43, 36, 242,
+			38, 99, 206, 152, 254, 24, 116, 120, 97, 194, 16, 45, 99, 112,
+			148, 153, 73, 143, 155, 133, 45, 179, 219, 12, 91, 190, 151, 59,
+			216, 244, 147, 142, 96, 133, 72, 105, 195, 164, 150, 233, 71, 247,
+			239, 240, 225, 226, 234, 169, 56, 219, 187, 123, 86, 79, 161, 81,
+			203, 215, 106, 153, 39, 249, 186, 109, 112, 158, 255, 226, 46, 114,
+			78, 121, 46, 104, 81, 178, 0, 235, 221, 31, 72, 20, 90, 247,
+			45, 220, 208, 206, 11, 55, 100, 22, 238, 67, 37, 190, 231, 173,
+			93, 25, 111, 125, 31, 151, 14, 83, 209, 214, 210, 25, 156, 84,
+			1, 7, 17, 97, 7, 116, 132, 240, 190, 4, 49, 235, 71, 249,
+			112, 219, 191, 113, 45, 150, 73, 183, 149, 38, 250, 252, 240, 182,
+			127, 99, 89, 213, 108, 123, 76, 194, 183, 63, 38, 121, 178, 247,
+			141, 138, 10, 126, 63, 102, 104, 95, 68, 174, 240, 98, 229, 201,
+			160, 149, 202, 184, 231, 221, 202, 60, 47, 133, 114, 83, 198, 147,
+			123, 110, 73, 111, 213, 208, 157, 231, 165, 168, 213, 144, 241, 228,
+			222, 91, 247, 160, 134, 219, 179, 131, 237, 219, 33, 59, 216, 130,
+			126, 227, 50, 34, 88, 113, 23, 245, 96, 210, 255, 186, 229, 129,
+			44, 109, 215, 40, 133, 169, 31, 222, 185, 87, 76, 22, 160, 44,
+			169, 215, 163, 124, 180, 159, 36, 238, 241, 226, 99, 148, 29, 31,
+			251, 168, 223, 191, 247, 135, 50, 247, 241, 65, 13, 8, 194, 193,
+			207, 94, 90, 121, 122, 116, 192, 29, 228, 236, 109, 231, 175, 140,
+			90, 110, 153, 219, 23, 47, 141, 218, 213, 159, 180, 249, 94, 13,
+			252, 45, 57, 192, 67, 124, 80, 91, 93, 244, 211, 130, 126, 244,
+			205, 225, 163, 70, 203, 166, 113, 182, 37, 89, 190, 37, 189, 159,
+			182, 120, 89, 33, 155, 237, 120, 171, 176, 227, 255, 122, 153, 205,
+			17, 206, 193, 156, 174, 229, 199, 103, 207, 242, 16, 106, 40, 45,
+			73, 245, 207, 44, 62, 124, 33, 72, 110, 227, 214, 59, 196, 135,
+			0, 250, 53, 56, 133, 245, 10, 84, 80, 113, 214, 79, 228, 46,
+			167, 214, 16, 195, 201, 137, 225, 30, 205, 206, 22, 228, 70, 157,
+			18, 78, 31, 154, 75, 97, 107, 11, 57, 233, 180, 76, 116, 77,
+			239, 63, 156, 225, 202, 242, 94, 93, 123, 153, 42, 11, 47, 32,
+			112, 1, 150, 178, 23, 16, 125, 231, 191, 210, 127, 254, 171, 255,
+			183, 205, 247, 40, 140, 111, 185, 9, 110, 138, 242, 14, 43, 237,
+			190, 153, 115, 200, 137, 81, 8, 219, 206, 164, 211, 123, 218, 138,
+			147, 214, 206, 153, 102, 203, 133, 30, 222, 127, 176, 248, 80, 246,
+			75, 246, 124, 78, 111, 22, 252, 237, 62, 204, 29, 226, 90, 88,
+			128, 125, 11, 247, 222, 124, 236, 26, 29, 46, 234, 144, 239, 50,
+			118, 39, 187, 204, 185, 189, 93, 86, 157, 230, 142, 121, 176, 113,
+			249, 12, 157, 62, 206, 203, 87, 86, 150, 207, 159, 121, 118, 212,
+			114, 135, 249, 224, 229, 229, 75, 207, 156, 63, 183, 50, 106, 87,
+			191, 99, 241, 189, 87, 36, 132, 230, 239, 237, 130, 64, 107, 63,
+			77, 101, 28, 106, 210, 155, 34, 54, 97, 44, 155, 90, 218, 170,
+			44, 171, 2, 54, 92, 208, 12, 163, 88, 94, 171, 251, 137, 52,
+			27, 78, 85, 157, 195, 222, 157, 212, 175, 145, 110, 24, 105, 203,
+			20, 251, 239, 146, 193, 109, 119, 73, 175, 36, 87, 233, 151, 228,
+			204, 22, 25, 202, 183, 72, 245, 53, 155, 239, 51, 232, 223, 14,
+			23, 34, 47, 169, 220, 198, 133, 122, 135, 168, 61, 139, 86, 203,
+			166, 241, 142, 92, 232, 199, 44, 94, 162, 102, 219, 94, 249, 96,
+			82, 214, 251, 202, 231, 8, 231, 240, 17, 232, 6, 54, 53, 24,
+			66, 141, 250, 25, 79, 223, 130, 80, 154, 241, 241, 3, 14, 164,
+			202, 36, 79, 251, 126, 104, 89, 151, 176, 42, 254, 90, 42, 227,
+			201, 18, 85, 171, 194, 194, 251, 108, 238, 92, 128, 156, 83, 227,
+			236, 41, 153, 186, 238, 118, 185, 212, 27, 235, 169, 211, 196, 154,
+			231, 14, 228, 66, 55, 251, 177, 32, 37, 238, 220, 227, 1, 94,
+			34, 174, 239, 142, 247, 177, 112, 213, 231, 64, 95, 173, 238, 117,
+			10, 47, 41, 147, 52, 159, 167, 192, 37, 189, 241, 157, 206, 157,
+			251, 48, 47, 171, 101, 113, 15, 244, 47, 147, 234, 54, 209, 95,
+			173, 230, 122, 230, 63, 254, 148, 165, 94, 46, 253, 38, 251, 255,
+			85, 130, 201, 31, 200, 95, 46, 61, 70, 127, 218, 46, 227, 250,
+			61, 19, 115, 217, 240, 192, 20, 255, 117, 184, 176, 7, 92, 103,
+			124, 224, 173, 150, 247, 57, 91, 228, 203, 111, 212, 126, 157, 29,
+			82, 39, 133, 196, 167, 60, 180, 231, 13, 190, 135, 24, 29, 132,
+			81, 44, 51, 181, 59, 235, 213, 163, 149, 11, 121, 35, 72, 210,
+			4, 15, 193, 213, 235, 152, 194, 100, 100, 73, 78, 186, 245, 186,
+			148, 148, 191, 167, 233, 199, 13, 122, 190, 78, 166, 27, 169, 51,
+			63, 244, 143, 75, 95, 25, 161, 132, 116, 217, 147, 7, 192, 128,
+			71, 255, 61, 94, 34, 165, 247, 210, 183, 148, 98, 153, 118, 227,
+			80, 172, 65, 220, 3, 108, 250, 129, 124, 62, 110, 67, 197, 41,
+			41, 251, 30, 55, 241, 232, 65, 43, 72, 183, 96, 188, 163, 40,
+			178, 208, 111, 193, 254, 130, 124, 105, 80, 210, 11, 207, 142, 198,
+			43, 46, 175, 153, 87, 71, 19, 246, 1, 4, 74, 20, 136, 168,
+			25, 11, 38, 208, 85, 89, 76, 62, 34, 51, 39, 116, 212, 41,
+			25, 197, 216, 196, 208, 168, 41, 33, 233, 227, 216, 56, 255, 5,
+			219, 188, 225, 57, 106, 187, 222, 199, 109, 26, 27, 55, 162, 177,
+			133, 20, 136, 157, 70, 162, 41, 211, 204, 156, 89, 199, 151, 116,
+			8, 39, 108, 36, 223, 188, 35, 208, 141, 213, 24, 138, 196, 87,
+			158, 62, 179, 240, 224, 67, 136, 91, 161, 97, 77, 211, 204, 116,
+			139, 182, 24, 246, 74, 212, 150, 162, 155, 130, 50, 129, 76, 136,
+			184, 107, 65, 216, 16, 29, 63, 161, 140, 224, 126, 140, 124, 11,
+			194, 87, 94, 113, 61, 31, 58, 3, 251, 85, 41, 234, 100, 33,
+			77, 162, 54, 146, 122, 117, 77, 156, 128, 80, 169, 161, 200, 222,
+			133, 28, 77, 33, 172, 176, 248, 13, 195, 154, 49, 1, 38, 193,
+			135, 119, 88, 210, 111, 192, 200, 133, 93, 3, 67, 234, 6, 81,
+			33, 209, 105, 201, 131, 252, 185, 0, 66, 112, 143, 102, 4, 70,
+			8, 238, 209, 158, 183, 64, 71, 71, 247, 243, 37, 243, 22, 168,
+			106, 239, 247, 30, 203, 3, 45, 245, 98, 37, 102, 87, 21, 41,
+			125, 60, 209, 41, 248, 40, 23, 61, 118, 151, 204, 95, 138, 32,
+			181, 99, 213, 46, 23, 30, 7, 85, 7, 179, 167, 66, 204, 101,
+			213, 145, 81, 253, 0, 137, 185, 236, 152, 237, 234, 7, 72, 65,
+			24, 80, 28, 103, 97, 61, 181, 239, 51, 202, 208, 204, 230, 192,
+			171, 155, 99, 58, 166, 87, 37, 18, 60, 150, 101, 48, 192, 171,
+			155, 99, 163, 251, 249, 255, 102, 155, 87, 55, 179, 246, 93, 222,
+			31, 168, 157, 211, 246, 111, 4, 237, 110, 187, 96, 22, 135, 21,
+			36, 209, 147, 116, 227, 176, 102, 210, 31, 42, 227, 183, 242, 212,
+			152, 23, 65, 56, 117, 188, 112, 12, 208, 141, 158, 22, 136, 180,
+			223, 212, 174, 233, 6, 3, 103, 129, 66, 58, 16, 18, 230, 75,
+			85, 155, 100, 57, 37, 169, 83, 77, 156, 73, 146, 110, 27, 203,
+			136, 34, 117, 215, 199, 177, 133, 60, 232, 234, 165, 18, 215, 157,
+			225, 21, 111, 73, 24, 237, 144, 123, 2, 132, 155, 146, 27, 50,
+			68, 62, 158, 32, 21, 27, 65, 212, 202, 18, 39, 82, 124, 110,
+			14, 56, 101, 129, 161, 28, 46, 109, 228, 5, 243, 27, 141, 64,
+			39, 114, 81, 211, 38, 38, 53, 23, 12, 154, 240, 221, 32, 151,
+			158, 132, 223, 46, 11, 237, 212, 35, 101, 75, 130, 132, 137, 179,
+			217, 146, 56, 150, 203, 102, 43, 174, 41, 49, 151, 205, 30, 152,
+			224, 63, 101, 155, 7, 66, 15, 216, 19, 222, 107, 187, 45, 9,
+			48, 137, 201, 200, 156, 244, 178, 141, 204, 194, 153, 133, 22, 170,
+			85, 10, 35, 157, 56, 45, 135, 43, 119, 212, 169, 181, 171, 245,
+			246, 165, 207, 40, 228, 201, 66, 178, 97, 138, 78, 18, 51, 66,
+			182, 126, 57, 91, 89, 213, 169, 136, 145, 252, 115, 77, 34, 168,
+			172, 181, 211, 59, 207, 36, 163, 31, 53, 2, 249, 64, 238, 2,
+			126, 25, 249, 74, 68, 20, 67, 62, 188, 79, 122, 160, 178, 191,
+			240, 62, 233, 129, 241, 3, 252, 135, 28, 243, 62, 233, 172, 237,
+			121, 255, 7, 203, 15, 171, 223, 106, 209, 39, 123, 178, 143, 92,
+			105, 154, 229, 251, 154, 246, 116, 33, 0, 42, 159, 95, 156, 17,
+			61, 245, 170, 227, 84, 67, 174, 249, 221, 86, 58, 173, 35, 219,
+			83, 138, 198, 66, 132, 17, 146, 162, 103, 239, 196, 40, 74, 153,
+			8, 140, 47, 42, 224, 184, 98, 99, 37, 105, 212, 193, 46, 212,
+			220, 23, 27, 16, 121, 209, 162, 181, 236, 100, 35, 144, 129, 150,
+			140, 146, 23, 103, 30, 34, 184, 26, 144, 129, 71, 177, 83, 211,
+			24, 108, 0, 73, 62, 47, 22, 13, 142, 25, 164, 4, 95, 44,
+			219, 209, 134, 78, 70, 75, 178, 57, 29, 83, 181, 171, 183, 125,
+			81, 34, 241, 183, 250, 175, 14, 108, 156, 32, 73, 19, 17, 173,
+			45, 114, 241, 246, 211, 51, 226, 129, 25, 241, 208, 140, 120, 248,
+			29, 187, 17, 8, 43, 171, 81, 62, 109, 96, 0, 161, 23, 85,
+			239, 119, 32, 68, 63, 162, 175, 181, 136, 85, 89, 247, 187, 137,
+			228, 226, 65, 236, 58, 141, 29, 16, 218, 182, 38, 61, 24, 97,
+			180, 30, 80, 178, 205, 82, 46, 97, 11, 24, 22, 139, 103, 96,
+			103, 7, 205, 211, 54, 60, 3, 59, 59, 121, 144, 255, 129, 101,
+			210, 37, 63, 101, 95, 98, 222, 87, 40, 113, 174, 89, 172, 25,
+			45, 89, 232, 236, 215, 52, 161, 246, 174, 224, 93, 106, 102, 196,
+			51, 46, 236, 44, 109, 38, 55, 64, 34, 11, 43, 53, 19, 87,
+			151, 47, 36, 116, 186, 10, 101, 98, 92, 240, 53, 96, 194, 40,
+			46, 132, 241, 81, 84, 29, 207, 190, 147, 163, 67, 2, 69, 66,
+			31, 59, 234, 3, 43, 159, 92, 29, 68, 122, 165, 101, 136, 128,
+			80, 43, 246, 20, 159, 228, 79, 235, 148, 184, 3, 46, 91, 114,
+			78, 120, 143, 232, 151, 95, 202, 90, 156, 223, 94, 57, 116, 217,
+			120, 148, 29, 73, 164, 81, 141, 174, 222, 60, 111, 238, 0, 222,
+			10, 58, 135, 243, 50, 94, 11, 30, 57, 150, 151, 241, 94, 112,
+			106, 154, 63, 163, 103, 182, 92, 118, 193, 25, 247, 30, 21, 203,
+			154, 45, 23, 39, 51, 162, 35, 33, 158, 251, 206, 140, 121, 77,
+			71, 35, 101, 99, 227, 202, 190, 80, 72, 4, 140, 75, 251, 194,
+			208, 72, 94, 102, 46, 187, 224, 142, 241, 243, 122, 110, 219, 101,
+			23, 157, 49, 239, 161, 219, 152, 59, 139, 211, 204, 76, 123, 57,
+			202, 184, 180, 47, 22, 166, 197, 59, 163, 139, 67, 251, 242, 50,
+			115, 217, 197, 253, 46, 61, 119, 26, 176, 7, 93, 118, 217, 54,
+			175, 63, 7, 203, 40, 25, 185, 109, 208, 114, 217, 229, 253, 230,
+			161, 240, 32, 115, 217, 229, 123, 239, 227, 255, 24, 111, 33, 44,
+			164, 204, 108, 88, 222, 143, 91, 162, 160, 65, 221, 166, 208, 141,
+			30, 185, 212, 141, 208, 22, 125, 129, 242, 30, 215, 21, 156, 145,
+			190, 104, 6, 184, 6, 11, 199, 91, 239, 1, 29, 167, 83, 156,
+			79, 11, 178, 32, 243, 213, 202, 24, 9, 178, 244, 156, 235, 185,
+			219, 23, 100, 45, 60, 159, 97, 207, 105, 57, 75, 189, 241, 122,
+			78, 11, 178, 86, 246, 70, 145, 4, 89, 11, 116, 189, 254, 183,
+			130, 236, 157, 9, 178, 22, 101, 172, 186, 158, 17, 24, 139, 117,
+			93, 11, 178, 22, 157, 136, 235, 90, 144, 181, 32, 200, 214, 191,
+			47, 130, 172, 69, 103, 162, 174, 185, 172, 69, 130, 108, 93, 11,
+			178, 22, 157, 135, 250, 200, 40, 191, 68, 47, 247, 74, 205, 129,
+			31, 181, 44, 239, 172, 40, 24, 1, 242, 125, 173, 203, 183, 167,
+			77, 154, 71, 126, 205, 10, 206, 184, 126, 228, 23, 216, 7, 188,
+			55, 225, 243, 3, 180, 1, 245, 192, 102, 63, 134, 126, 129, 205,
+			37, 154, 130, 171, 146, 62, 38, 151, 70, 26, 27, 245, 196, 47,
+			208, 36, 180, 105, 143, 6, 122, 143, 170, 39, 126, 193, 216, 184,
+			186, 51, 8, 211, 200, 62, 228, 189, 97, 245, 71, 30, 228, 146,
+			141, 190, 230, 181, 72, 160, 181, 254, 220, 17, 157, 169, 239, 154,
+			248, 138, 255, 39, 248, 106, 163, 126, 237, 161, 127, 56, 142, 7,
+			36, 52, 138, 9, 113, 195, 162, 105, 97, 77, 114, 224, 156, 234,
+			215, 156, 68, 80, 253, 6, 12, 137, 232, 253, 20, 111, 123, 115,
+			187, 160, 110, 69, 204, 29, 87, 207, 106, 30, 242, 153, 17, 193,
+			42, 187, 44, 210, 188, 202, 134, 71, 158, 69, 251, 39, 76, 137,
+			185, 44, 58, 232, 241, 255, 75, 17, 193, 118, 217, 166, 125, 204,
+			251, 150, 34, 130, 188, 209, 241, 67, 132, 234, 237, 96, 144, 204,
+			120, 185, 137, 184, 131, 194, 28, 154, 175, 154, 170, 119, 173, 144,
+			47, 186, 237, 142, 17, 71, 180, 201, 32, 183, 6, 28, 79, 250,
+			81, 45, 102, 167, 55, 23, 86, 246, 168, 225, 81, 174, 94, 211,
+			170, 207, 35, 97, 47, 101, 159, 254, 106, 228, 87, 139, 233, 134,
+			148, 210, 41, 146, 209, 35, 148, 91, 22, 72, 70, 83, 242, 158,
+			151, 254, 182, 13, 249, 114, 83, 191, 244, 183, 105, 51, 108, 30,
+			54, 111, 58, 177, 237, 55, 239, 189, 143, 255, 29, 34, 17, 115,
+			217, 75, 246, 189, 222, 2, 184, 76, 30, 184, 167, 21, 14, 21,
+			134, 103, 206, 117, 99, 7, 161, 215, 182, 153, 131, 17, 178, 82,
+			217, 101, 47, 13, 31, 52, 37, 203, 101, 47, 121, 119, 155, 18,
+			230, 186, 167, 202, 223, 130, 137, 217, 128, 91, 122, 197, 254, 97,
+			139, 121, 143, 137, 167, 35, 188, 116, 222, 37, 252, 170, 231, 156,
+			171, 43, 25, 194, 253, 22, 46, 71, 3, 4, 201, 17, 175, 240,
+			113, 254, 40, 47, 163, 4, 230, 255, 46, 103, 214, 155, 201, 35,
+			59, 117, 38, 255, 32, 217, 38, 68, 144, 203, 210, 188, 229, 182,
+			181, 232, 240, 46, 231, 72, 94, 182, 92, 246, 174, 187, 167, 242,
+			50, 115, 217, 187, 78, 206, 240, 69, 61, 153, 229, 58, 175, 90,
+			206, 132, 119, 66, 191, 162, 36, 24, 11, 39, 238, 234, 242, 133,
+			25, 72, 210, 217, 57, 210, 1, 37, 182, 14, 49, 126, 213, 4,
+			224, 218, 58, 196, 248, 85, 19, 214, 109, 235, 16, 227, 87, 173,
+			241, 3, 252, 17, 61, 157, 237, 58, 175, 225, 45, 233, 116, 255,
+			116, 74, 213, 190, 217, 108, 8, 79, 121, 173, 56, 27, 226, 238,
+			94, 51, 79, 68, 109, 29, 238, 251, 154, 53, 54, 206, 59, 88,
+			36, 188, 155, 249, 17, 203, 62, 226, 173, 226, 213, 163, 137, 43,
+			43, 206, 169, 150, 98, 187, 68, 180, 13, 10, 177, 17, 248, 8,
+			209, 12, 154, 161, 78, 120, 213, 141, 91, 215, 140, 136, 87, 213,
+			145, 41, 54, 133, 169, 254, 136, 101, 239, 49, 69, 11, 197, 189,
+			147, 166, 200, 80, 60, 116, 152, 47, 211, 163, 227, 242, 251, 173,
+			129, 111, 90, 150, 247, 132, 40, 218, 102, 111, 83, 26, 161, 46,
+			69, 182, 141, 183, 146, 8, 205, 123, 191, 85, 25, 231, 15, 226,
+			209, 40, 34, 211, 126, 194, 178, 255, 107, 139, 121, 199, 132, 118,
+			21, 22, 15, 137, 47, 82, 93, 73, 10, 169, 70, 130, 50, 249,
+			56, 63, 97, 13, 170, 100, 10, 12, 150, 48, 215, 249, 128, 229,
+			236, 245, 30, 18, 103, 241, 73, 189, 44, 57, 37, 56, 112, 150,
+			157, 82, 59, 12, 50, 78, 81, 184, 204, 176, 60, 76, 7, 157,
+			125, 32, 123, 245, 170, 131, 206, 62, 96, 13, 239, 161, 221, 129,
+			22, 150, 235, 252, 148, 229, 236, 241, 166, 5, 220, 98, 249, 76,
+			183, 49, 56, 182, 222, 79, 89, 206, 96, 94, 97, 163, 130, 15,
+			103, 131, 219, 174, 243, 65, 203, 25, 54, 131, 223, 9, 228, 216,
+			105, 31, 180, 156, 114, 94, 65, 131, 13, 113, 122, 161, 133, 87,
+			224, 206, 135, 173, 219, 147, 215, 246, 154, 39, 225, 232, 81, 49,
+			69, 203, 117, 62, 108, 13, 141, 154, 34, 67, 113, 108, 156, 255,
+			97, 197, 188, 254, 253, 180, 101, 187, 222, 111, 87, 104, 124, 149,
+			123, 164, 227, 199, 126, 91, 34, 93, 133, 142, 255, 133, 104, 101,
+			178, 173, 128, 13, 193, 56, 152, 116, 87, 147, 52, 72, 187, 41,
+			164, 182, 102, 43, 90, 21, 83, 213, 19, 213, 105, 186, 23, 10,
+			161, 234, 232, 138, 43, 194, 248, 208, 242, 47, 108, 194, 90, 160,
+			57, 1, 221, 240, 218, 219, 162, 183, 104, 219, 87, 89, 25, 242,
+			77, 250, 98, 215, 111, 5, 107, 244, 138, 180, 215, 160, 28, 164,
+			153, 209, 4, 209, 122, 250, 219, 123, 106, 118, 90, 102, 58, 156,
+			230, 34, 192, 137, 237, 134, 164, 30, 81, 202, 132, 86, 163, 142,
+			47, 161, 1, 37, 191, 211, 65, 30, 88, 157, 172, 94, 67, 44,
+			252, 180, 168, 228, 175, 70, 70, 89, 236, 228, 207, 57, 73, 76,
+			81, 180, 203, 250, 37, 53, 81, 61, 113, 162, 154, 161, 133, 167,
+			161, 57, 90, 133, 102, 249, 149, 105, 52, 88, 37, 141, 170, 241,
+			178, 220, 26, 185, 10, 75, 191, 38, 18, 171, 132, 27, 122, 170,
+			122, 178, 58, 93, 176, 159, 173, 74, 1, 170, 130, 183, 224, 173,
+			226, 90, 33, 204, 24, 28, 0, 64, 213, 242, 188, 211, 201, 34,
+			158, 162, 204, 138, 243, 237, 78, 186, 37, 166, 170, 213, 233, 30,
+			21, 29, 80, 107, 103, 124, 77, 53, 60, 113, 98, 238, 228, 220,
+			137, 19, 183, 104, 181, 22, 69, 115, 171, 126, 124, 147, 134, 153,
+			218, 45, 170, 186, 113, 85, 67, 185, 109, 136, 185, 147, 115, 171,
+			254, 75, 187, 14, 68, 1, 138, 161, 121, 23, 219, 59, 36, 134,
+			18, 253, 75, 213, 16, 213, 85, 255, 165, 170, 152, 146, 181, 102,
+			109, 38, 107, 60, 247, 98, 247, 198, 92, 43, 106, 169, 233, 170,
+			211, 189, 96, 220, 12, 105, 147, 117, 247, 38, 152, 220, 10, 9,
+			61, 66, 186, 25, 205, 102, 123, 195, 192, 189, 185, 30, 193, 194,
+			2, 76, 84, 200, 104, 102, 34, 196, 132, 85, 218, 130, 212, 70,
+			165, 252, 66, 61, 240, 235, 165, 227, 238, 51, 247, 246, 52, 40,
+			232, 222, 39, 230, 110, 181, 130, 61, 16, 3, 128, 36, 227, 69,
+			184, 197, 63, 157, 243, 34, 48, 210, 79, 155, 215, 162, 12, 66,
+			169, 243, 105, 107, 116, 63, 111, 16, 43, 178, 93, 231, 23, 45,
+			123, 191, 247, 3, 69, 245, 6, 208, 22, 180, 27, 61, 243, 113,
+			253, 52, 175, 95, 189, 201, 244, 174, 104, 77, 188, 179, 139, 104,
+			124, 240, 134, 203, 202, 195, 161, 38, 197, 85, 255, 139, 150, 93,
+			54, 69, 11, 197, 193, 61, 166, 200, 80, 28, 25, 229, 63, 2,
+			65, 153, 217, 204, 117, 62, 15, 152, 94, 202, 97, 34, 115, 87,
+			207, 69, 154, 125, 180, 39, 141, 138, 76, 30, 150, 230, 29, 36,
+			87, 174, 63, 29, 149, 67, 218, 144, 133, 102, 38, 225, 141, 114,
+			206, 229, 164, 68, 168, 252, 231, 115, 184, 113, 31, 127, 62, 135,
+			27, 161, 242, 159, 183, 70, 70, 249, 15, 18, 216, 142, 235, 124,
+			1, 92, 189, 35, 46, 202, 27, 41, 9, 89, 208, 26, 200, 198,
+			52, 147, 125, 156, 54, 163, 107, 144, 104, 102, 163, 179, 43, 152,
+			108, 52, 134, 245, 145, 32, 96, 62, 101, 172, 185, 201, 70, 0,
+			131, 161, 234, 214, 146, 107, 16, 136, 215, 50, 96, 17, 229, 254,
+			133, 124, 221, 17, 229, 254, 133, 124, 221, 17, 229, 254, 5, 172,
+			59, 61, 199, 103, 88, 145, 47, 89, 246, 36, 116, 178, 103, 179,
+			96, 0, 35, 172, 108, 183, 160, 171, 57, 205, 213, 154, 59, 55,
+			20, 31, 237, 29, 33, 179, 125, 119, 59, 29, 88, 24, 192, 245,
+			179, 219, 216, 208, 161, 81, 19, 79, 71, 155, 114, 67, 198, 250,
+			179, 207, 217, 10, 170, 73, 180, 253, 61, 251, 194, 219, 42, 56,
+			117, 246, 237, 220, 93, 60, 136, 10, 213, 146, 194, 109, 80, 99,
+			142, 119, 175, 95, 178, 42, 99, 166, 200, 80, 156, 184, 139, 175,
+			19, 29, 202, 174, 243, 27, 150, 125, 200, 123, 222, 36, 123, 88,
+			217, 234, 200, 254, 197, 195, 131, 227, 56, 168, 167, 73, 145, 2,
+			189, 7, 178, 112, 147, 240, 254, 188, 23, 106, 226, 114, 137, 166,
+			50, 235, 83, 182, 80, 212, 89, 2, 152, 93, 102, 40, 78, 122,
+			74, 2, 193, 235, 212, 55, 44, 251, 183, 45, 102, 210, 161, 232,
+			59, 123, 171, 67, 182, 145, 53, 10, 212, 19, 81, 216, 147, 229,
+			229, 13, 139, 123, 252, 1, 157, 234, 100, 192, 117, 126, 203, 114,
+			142, 122, 247, 81, 255, 60, 80, 77, 243, 177, 190, 65, 76, 158,
+			18, 188, 161, 253, 45, 203, 25, 207, 43, 44, 84, 28, 240, 242,
+			10, 134, 138, 35, 119, 235, 76, 38, 131, 174, 243, 59, 150, 125,
+			159, 198, 98, 176, 76, 69, 215, 20, 45, 20, 199, 238, 54, 69,
+			134, 226, 61, 247, 242, 21, 224, 104, 87, 92, 231, 127, 182, 236,
+			227, 222, 147, 226, 34, 121, 146, 111, 74, 101, 253, 185, 79, 65,
+			161, 23, 58, 101, 59, 73, 59, 234, 253, 101, 78, 230, 74, 153,
+			134, 61, 100, 138, 22, 138, 135, 239, 49, 69, 134, 226, 125, 247,
+			243, 171, 4, 194, 144, 235, 252, 46, 64, 120, 74, 92, 106, 53,
+			110, 23, 4, 21, 20, 114, 51, 24, 134, 202, 52, 174, 129, 97,
+			200, 66, 49, 131, 97, 136, 161, 120, 223, 253, 252, 37, 130, 129,
+			187, 206, 191, 177, 236, 195, 94, 75, 44, 245, 108, 186, 108, 107,
+			27, 190, 151, 1, 148, 210, 229, 161, 46, 159, 109, 159, 139, 76,
+			116, 242, 172, 162, 100, 151, 201, 56, 186, 81, 6, 40, 47, 209,
+			228, 102, 79, 114, 11, 197, 33, 147, 151, 134, 51, 20, 15, 30,
+			226, 255, 1, 166, 70, 102, 15, 187, 206, 191, 183, 108, 225, 125,
+			205, 22, 136, 113, 52, 220, 194, 60, 102, 64, 85, 26, 229, 112,
+			19, 216, 138, 113, 224, 140, 64, 254, 57, 131, 142, 90, 79, 131,
+			240, 151, 25, 33, 23, 185, 152, 21, 103, 10, 169, 252, 168, 31,
+			216, 166, 121, 117, 130, 60, 46, 69, 58, 192, 33, 144, 77, 133,
+			85, 33, 55, 88, 130, 11, 84, 81, 38, 69, 170, 64, 101, 175,
+			209, 76, 55, 31, 189, 227, 7, 113, 45, 155, 82, 203, 1, 161,
+			241, 164, 136, 169, 48, 104, 77, 171, 131, 114, 11, 16, 48, 93,
+			6, 69, 154, 24, 40, 204, 167, 227, 54, 140, 33, 205, 111, 2,
+			183, 153, 221, 132, 232, 108, 65, 134, 203, 68, 99, 195, 21, 134,
+			45, 20, 245, 27, 118, 102, 15, 51, 20, 143, 28, 229, 207, 209,
+			122, 236, 113, 157, 63, 66, 38, 145, 37, 161, 2, 10, 11, 219,
+			55, 39, 125, 97, 3, 231, 64, 69, 49, 253, 127, 120, 60, 45,
+			126, 219, 46, 131, 98, 79, 153, 70, 54, 9, 137, 246, 88, 40,
+			114, 163, 206, 236, 97, 40, 142, 29, 224, 43, 42, 31, 209, 31,
+			91, 3, 239, 179, 45, 239, 73, 163, 247, 222, 153, 185, 114, 71,
+			205, 23, 151, 215, 31, 91, 149, 3, 100, 131, 165, 36, 65, 223,
+			130, 62, 246, 232, 173, 77, 150, 16, 149, 204, 148, 189, 86, 75,
+			157, 164, 167, 228, 58, 223, 50, 59, 222, 209, 15, 215, 181, 166,
+			230, 232, 103, 235, 99, 227, 252, 28, 230, 5, 23, 254, 19, 203,
+			126, 183, 205, 188, 211, 58, 77, 71, 175, 194, 141, 205, 210, 50,
+			132, 46, 34, 154, 63, 111, 113, 136, 51, 255, 137, 197, 71, 121,
+			141, 151, 49, 38, 176, 249, 54, 18, 93, 221, 77, 66, 146, 65,
+			165, 96, 163, 209, 70, 117, 112, 92, 71, 191, 182, 255, 182, 49,
+			148, 56, 154, 39, 127, 219, 228, 185, 114, 52, 79, 254, 182, 165,
+			190, 134, 173, 230, 176, 92, 231, 47, 44, 231, 136, 247, 187, 150,
+			54, 135, 110, 159, 229, 111, 176, 237, 213, 224, 141, 196, 89, 127,
+			97, 57, 110, 70, 8, 8, 187, 127, 97, 141, 77, 230, 21, 12,
+			21, 135, 14, 243, 63, 182, 53, 101, 108, 215, 249, 174, 229, 28,
+			247, 126, 95, 57, 76, 32, 250, 205, 118, 252, 250, 11, 178, 177,
+			11, 113, 12, 135, 5, 45, 206, 20, 65, 148, 125, 25, 19, 52,
+			111, 150, 106, 89, 115, 177, 128, 132, 124, 157, 169, 194, 104, 157,
+			102, 203, 236, 68, 183, 32, 201, 115, 132, 22, 224, 208, 18, 20,
+			207, 141, 182, 134, 178, 187, 88, 122, 159, 216, 214, 119, 55, 123,
+			111, 222, 82, 141, 180, 173, 121, 1, 155, 92, 106, 206, 97, 227,
+			217, 53, 84, 88, 28, 187, 76, 148, 62, 146, 87, 88, 168, 184,
+			187, 154, 87, 48, 84, 28, 187, 159, 255, 93, 189, 54, 204, 117,
+			126, 200, 118, 38, 189, 121, 177, 210, 59, 85, 97, 101, 158, 216,
+			113, 101, 204, 144, 144, 216, 127, 200, 118, 134, 242, 10, 11, 21,
+			124, 60, 175, 160, 73, 38, 238, 34, 33, 132, 146, 116, 189, 134,
+			55, 114, 79, 210, 148, 173, 32, 161, 132, 217, 61, 28, 147, 190,
+			244, 170, 95, 184, 235, 160, 157, 252, 22, 48, 206, 54, 90, 208,
+			140, 203, 224, 57, 245, 107, 230, 57, 181, 67, 9, 222, 94, 179,
+			179, 180, 58, 216, 165, 175, 217, 238, 65, 83, 100, 40, 30, 62,
+			194, 255, 151, 44, 83, 214, 143, 218, 182, 235, 253, 166, 37, 150,
+			110, 174, 67, 152, 55, 176, 248, 54, 76, 206, 133, 72, 76, 200,
+			130, 124, 106, 100, 77, 74, 242, 91, 107, 167, 195, 28, 203, 142,
+			244, 179, 227, 252, 214, 226, 230, 204, 150, 158, 235, 132, 90, 216,
+			220, 249, 199, 109, 72, 180, 216, 202, 226, 120, 10, 38, 33, 73,
+			122, 80, 230, 11, 208, 201, 176, 144, 179, 207, 60, 197, 133, 143,
+			4, 69, 173, 164, 192, 42, 143, 226, 232, 126, 254, 113, 71, 101,
+			5, 250, 144, 61, 240, 43, 182, 229, 125, 200, 17, 133, 208, 85,
+			195, 49, 13, 128, 187, 92, 44, 232, 81, 188, 87, 64, 134, 222,
+			74, 33, 67, 127, 181, 5, 51, 145, 80, 223, 7, 143, 226, 173,
+			217, 52, 150, 120, 161, 184, 133, 204, 207, 177, 15, 129, 201, 111,
+			153, 69, 214, 215, 13, 207, 210, 11, 233, 61, 153, 116, 252, 186,
+			220, 22, 21, 18, 172, 101, 247, 83, 181, 189, 133, 63, 171, 98,
+			221, 111, 232, 141, 156, 136, 170, 175, 141, 30, 51, 194, 167, 157,
+			7, 42, 110, 66, 25, 52, 218, 16, 73, 69, 26, 235, 69, 51,
+			216, 227, 213, 234, 12, 25, 171, 232, 15, 115, 221, 46, 138, 151,
+			213, 28, 175, 136, 41, 115, 73, 226, 86, 76, 166, 119, 30, 67,
+			3, 180, 243, 72, 62, 6, 193, 58, 103, 54, 134, 219, 28, 198,
+			239, 29, 231, 228, 182, 113, 110, 115, 152, 185, 147, 189, 3, 173,
+			250, 47, 189, 34, 166, 244, 21, 92, 24, 44, 75, 108, 244, 33,
+			187, 50, 198, 255, 190, 201, 107, 244, 17, 219, 62, 224, 133, 180,
+			224, 122, 10, 176, 102, 115, 68, 179, 192, 173, 32, 49, 39, 43,
+			53, 103, 223, 28, 18, 157, 147, 62, 218, 36, 171, 141, 30, 36,
+			63, 119, 74, 116, 161, 196, 69, 126, 29, 233, 21, 244, 6, 47,
+			193, 43, 234, 124, 36, 127, 107, 142, 171, 250, 35, 182, 150, 47,
+			84, 18, 162, 143, 216, 99, 227, 252, 211, 150, 201, 106, 241, 113,
+			219, 190, 203, 251, 167, 86, 110, 242, 197, 75, 141, 30, 112, 123,
+			54, 85, 190, 235, 96, 68, 44, 90, 202, 86, 253, 151, 76, 197,
+			73, 88, 213, 232, 186, 202, 100, 192, 236, 17, 8, 25, 155, 170,
+			61, 230, 165, 42, 89, 207, 176, 167, 171, 160, 59, 89, 91, 251,
+			76, 200, 57, 130, 48, 47, 125, 60, 71, 16, 188, 236, 227, 246,
+			144, 107, 138, 12, 197, 3, 19, 252, 154, 201, 12, 241, 9, 219,
+			222, 239, 189, 85, 93, 241, 164, 87, 223, 137, 149, 169, 215, 178,
+			132, 144, 0, 94, 180, 44, 169, 212, 13, 159, 176, 181, 133, 166,
+			68, 252, 228, 19, 182, 182, 208, 168, 212, 13, 159, 176, 71, 70,
+			249, 13, 147, 185, 225, 147, 96, 172, 239, 20, 75, 223, 7, 219,
+			140, 50, 205, 112, 205, 24, 110, 102, 155, 81, 57, 23, 62, 153,
+			19, 13, 134, 164, 79, 26, 182, 87, 130, 47, 210, 249, 164, 61,
+			186, 159, 199, 38, 229, 194, 207, 99, 83, 52, 114, 251, 87, 113,
+			181, 240, 230, 200, 80, 171, 38, 46, 229, 183, 120, 207, 71, 195,
+			59, 189, 199, 151, 247, 102, 164, 55, 198, 150, 12, 64, 24, 143,
+			126, 62, 167, 35, 228, 239, 159, 71, 190, 2, 93, 100, 40, 30,
+			152, 224, 31, 200, 30, 150, 127, 214, 182, 61, 239, 221, 133, 143,
+			167, 247, 17, 81, 189, 123, 50, 112, 26, 66, 38, 235, 209, 38,
+			210, 148, 232, 11, 66, 51, 61, 237, 230, 6, 91, 21, 50, 142,
+			163, 24, 219, 196, 167, 0, 67, 250, 168, 182, 58, 109, 154, 221,
+			99, 252, 44, 175, 68, 6, 63, 108, 62, 159, 205, 225, 135, 205,
+			231, 179, 246, 160, 73, 5, 134, 60, 118, 159, 181, 39, 15, 242,
+			127, 160, 224, 47, 187, 206, 47, 217, 246, 152, 183, 37, 212, 87,
+			93, 104, 95, 190, 249, 113, 49, 15, 34, 170, 235, 68, 139, 203,
+			184, 46, 162, 142, 52, 159, 86, 140, 68, 242, 66, 208, 233, 213,
+			169, 11, 89, 42, 52, 247, 208, 31, 226, 208, 23, 45, 197, 117,
+			226, 102, 234, 248, 205, 32, 244, 123, 192, 134, 77, 232, 151, 108,
+			109, 170, 42, 81, 146, 188, 95, 178, 43, 251, 76, 17, 201, 11,
+			237, 253, 46, 255, 156, 2, 123, 208, 117, 126, 217, 182, 39, 189,
+			255, 206, 218, 37, 206, 85, 29, 215, 29, 12, 116, 143, 17, 110,
+			119, 102, 146, 235, 183, 200, 241, 239, 217, 36, 87, 178, 7, 75,
+			4, 186, 193, 115, 208, 66, 81, 155, 228, 74, 100, 23, 250, 101,
+			123, 226, 46, 114, 164, 150, 221, 242, 151, 236, 129, 255, 221, 134,
+			35, 181, 248, 52, 37, 191, 245, 111, 174, 79, 246, 95, 251, 184,
+			33, 64, 215, 47, 217, 149, 113, 254, 235, 32, 100, 25, 87, 196,
+			151, 113, 69, 124, 214, 234, 189, 35, 250, 120, 81, 143, 250, 104,
+			100, 109, 35, 117, 224, 197, 132, 153, 56, 27, 32, 200, 19, 153,
+			97, 111, 139, 245, 64, 198, 120, 77, 179, 85, 136, 187, 224, 89,
+			228, 97, 158, 227, 61, 63, 168, 36, 8, 244, 198, 79, 154, 209,
+			101, 75, 182, 11, 172, 184, 76, 119, 205, 151, 13, 87, 41, 147,
+			46, 251, 101, 115, 215, 148, 233, 174, 249, 50, 238, 154, 135, 8,
+			103, 216, 7, 193, 85, 166, 8, 229, 12, 48, 253, 161, 8, 96,
+			14, 140, 52, 118, 25, 103, 40, 19, 191, 127, 35, 159, 4, 252,
+			254, 13, 195, 239, 203, 164, 95, 189, 97, 31, 152, 80, 145, 62,
+			101, 48, 252, 175, 130, 197, 126, 229, 206, 100, 215, 158, 187, 247,
+			175, 34, 186, 210, 234, 255, 53, 72, 174, 101, 186, 105, 190, 154,
+			211, 1, 55, 205, 87, 13, 11, 47, 211, 77, 243, 85, 176, 112,
+			34, 54, 12, 7, 95, 179, 237, 111, 219, 204, 187, 95, 171, 50,
+			164, 86, 0, 205, 140, 41, 22, 22, 221, 76, 66, 182, 130, 175,
+			217, 124, 140, 143, 242, 50, 138, 240, 249, 255, 174, 237, 252, 27,
+			187, 68, 250, 11, 213, 192, 152, 136, 137, 71, 121, 69, 53, 193,
+			118, 254, 215, 118, 121, 132, 239, 231, 67, 166, 198, 162, 42, 94,
+			172, 178, 81, 181, 119, 95, 161, 31, 18, 66, 218, 229, 253, 133,
+			70, 88, 221, 223, 179, 203, 123, 138, 85, 54, 170, 70, 70, 11,
+			253, 108, 215, 249, 125, 187, 236, 22, 26, 129, 26, 191, 111, 151,
+			247, 22, 171, 168, 213, 232, 126, 240, 92, 194, 5, 96, 126, 221,
+			118, 198, 189, 77, 202, 192, 107, 206, 51, 132, 212, 236, 157, 87,
+			239, 173, 85, 19, 226, 57, 68, 202, 212, 163, 246, 42, 242, 92,
+			21, 22, 52, 227, 2, 120, 199, 90, 56, 92, 224, 5, 237, 66,
+			30, 201, 109, 6, 149, 178, 54, 168, 124, 221, 100, 104, 43, 107,
+			131, 202, 215, 109, 109, 80, 41, 107, 131, 202, 215, 109, 119, 140,
+			207, 104, 216, 45, 215, 249, 134, 237, 184, 222, 97, 90, 78, 216,
+			245, 205, 57, 207, 161, 205, 199, 131, 190, 247, 13, 147, 153, 165,
+			172, 237, 18, 223, 176, 117, 62, 240, 178, 182, 75, 124, 3, 251,
+			229, 159, 216, 122, 6, 219, 117, 190, 105, 59, 71, 188, 247, 217,
+			218, 24, 160, 233, 83, 80, 51, 110, 203, 98, 99, 190, 62, 195,
+			149, 160, 176, 10, 171, 66, 171, 5, 55, 93, 46, 245, 250, 66,
+			189, 107, 237, 1, 254, 255, 171, 134, 158, 178, 182, 37, 124, 211,
+			214, 134, 158, 178, 182, 37, 124, 211, 214, 134, 158, 178, 182, 37,
+			124, 211, 62, 116, 152, 127, 216, 16, 148, 185, 206, 159, 218, 206,
+			113, 239, 31, 216, 197, 233, 52, 85, 239, 192, 236, 243, 189, 19,
+			244, 251, 102, 45, 210, 236, 241, 175, 102, 44, 202, 169, 201, 202,
+			68, 154, 35, 121, 133, 133, 10, 109, 153, 41, 107, 35, 201, 159,
+			218, 199, 238, 39, 39, 79, 217, 102, 174, 243, 103, 182, 118, 242,
+			148, 17, 100, 135, 162, 97, 140, 24, 237, 207, 236, 225, 113, 83,
+			180, 80, 60, 112, 212, 20, 169, 111, 245, 94, 254, 73, 198, 109,
+			103, 208, 45, 255, 39, 123, 224, 167, 153, 229, 253, 183, 76, 244,
+			60, 44, 53, 11, 115, 11, 165, 94, 245, 41, 222, 239, 122, 24,
+			145, 212, 253, 16, 11, 129, 87, 207, 133, 37, 61, 110, 190, 187,
+			134, 97, 40, 140, 3, 158, 67, 58, 63, 185, 10, 130, 219, 58,
+			68, 140, 75, 30, 19, 131, 96, 97, 149, 199, 106, 197, 124, 179,
+			6, 151, 131, 95, 8, 52, 195, 211, 11, 52, 149, 141, 66, 14,
+			254, 22, 169, 108, 103, 140, 105, 56, 67, 71, 67, 103, 66, 96,
+			114, 153, 45, 31, 143, 30, 142, 101, 54, 189, 132, 208, 194, 139,
+			193, 168, 147, 24, 159, 83, 140, 84, 92, 230, 48, 234, 224, 152,
+			94, 38, 209, 251, 226, 225, 120, 82, 184, 202, 180, 14, 0, 79,
+			42, 118, 140, 121, 217, 21, 70, 26, 141, 68, 89, 186, 214, 0,
+			162, 22, 156, 32, 168, 253, 39, 187, 114, 128, 188, 146, 131, 224,
+			224, 223, 177, 111, 63, 46, 106, 144, 36, 148, 239, 152, 75, 115,
+			144, 174, 165, 239, 24, 9, 101, 144, 36, 148, 239, 64, 66, 65,
+			124, 219, 32, 142, 244, 119, 33, 59, 28, 207, 117, 225, 109, 232,
+			225, 208, 40, 202, 100, 115, 64, 64, 249, 110, 62, 7, 88, 237,
+			119, 205, 197, 60, 72, 2, 202, 119, 193, 104, 95, 160, 57, 108,
+			215, 121, 149, 217, 7, 188, 119, 152, 57, 104, 55, 100, 131, 130,
+			155, 214, 196, 213, 144, 28, 52, 85, 122, 124, 95, 45, 114, 176,
+			32, 41, 174, 58, 98, 150, 240, 181, 30, 60, 101, 106, 193, 61,
+			157, 125, 13, 72, 205, 13, 145, 225, 85, 150, 65, 6, 4, 95,
+			101, 25, 246, 56, 85, 175, 178, 177, 113, 254, 12, 65, 6, 35,
+			32, 235, 13, 52, 79, 11, 32, 6, 216, 219, 177, 108, 118, 91,
+			126, 92, 140, 10, 155, 90, 62, 191, 32, 146, 173, 48, 245, 111,
+			76, 103, 19, 67, 221, 124, 141, 105, 109, 104, 144, 142, 228, 107,
+			76, 107, 197, 131, 116, 36, 95, 99, 35, 163, 228, 228, 24, 132,
+			186, 249, 94, 102, 223, 229, 61, 184, 235, 196, 6, 95, 36, 31,
+			152, 13, 194, 68, 134, 8, 240, 218, 144, 180, 215, 213, 140, 208,
+			31, 223, 155, 207, 8, 253, 241, 189, 76, 235, 143, 131, 136, 142,
+			116, 222, 203, 14, 76, 240, 255, 146, 102, 44, 185, 206, 251, 152,
+			61, 238, 93, 238, 79, 40, 104, 50, 160, 235, 228, 5, 185, 26,
+			99, 246, 63, 142, 173, 242, 0, 227, 32, 168, 125, 75, 222, 78,
+			156, 207, 12, 24, 40, 131, 239, 99, 90, 171, 26, 36, 101, 240,
+			125, 172, 50, 98, 138, 12, 69, 119, 140, 255, 130, 69, 208, 148,
+			93, 231, 3, 204, 158, 244, 254, 217, 110, 90, 85, 230, 73, 253,
+			207, 161, 84, 221, 158, 70, 53, 72, 154, 227, 7, 114, 28, 161,
+			225, 124, 128, 105, 141, 106, 144, 52, 199, 15, 176, 137, 187, 248,
+			79, 42, 28, 7, 93, 231, 131, 88, 227, 87, 119, 195, 177, 143,
+			11, 129, 242, 96, 168, 133, 172, 141, 74, 43, 15, 204, 203, 77,
+			98, 150, 224, 36, 241, 70, 80, 199, 149, 78, 207, 86, 103, 118,
+			170, 204, 104, 2, 77, 56, 67, 0, 42, 225, 7, 115, 4, 192,
+			105, 62, 200, 42, 102, 199, 64, 37, 252, 32, 118, 204, 75, 180,
+			99, 42, 174, 243, 97, 102, 187, 94, 235, 86, 90, 5, 93, 135,
+			96, 15, 119, 30, 86, 163, 251, 245, 217, 110, 6, 237, 74, 137,
+			38, 55, 167, 184, 130, 216, 78, 150, 241, 151, 10, 98, 59, 217,
+			232, 126, 254, 3, 220, 118, 42, 110, 249, 35, 108, 224, 243, 204,
+			242, 158, 206, 110, 55, 45, 167, 102, 215, 219, 205, 181, 215, 237,
+			247, 27, 244, 87, 204, 249, 17, 86, 153, 160, 104, 245, 10, 216,
+			240, 71, 193, 196, 30, 191, 173, 23, 28, 69, 238, 169, 20, 218,
+			36, 119, 136, 86, 136, 69, 127, 212, 160, 87, 33, 22, 253, 81,
+			195, 164, 42, 196, 162, 63, 10, 38, 181, 128, 153, 161, 215, 124,
+			140, 217, 159, 102, 204, 171, 10, 74, 196, 161, 115, 57, 170, 203,
+			206, 28, 199, 226, 105, 172, 144, 78, 243, 49, 198, 71, 248, 91,
+			121, 25, 67, 0, 252, 159, 97, 206, 33, 239, 76, 49, 178, 165,
+			39, 77, 118, 190, 27, 181, 14, 108, 174, 188, 254, 25, 32, 192,
+			84, 180, 68, 255, 51, 76, 187, 125, 42, 90, 162, 255, 25, 198,
+			39, 242, 10, 134, 138, 131, 30, 191, 174, 161, 128, 221, 146, 57,
+			7, 53, 19, 234, 153, 190, 103, 150, 204, 86, 149, 81, 113, 167,
+			79, 103, 8, 124, 84, 181, 144, 177, 187, 162, 51, 118, 127, 188,
+			8, 20, 174, 166, 143, 51, 237, 139, 170, 104, 45, 224, 227, 236,
+			174, 73, 126, 82, 3, 101, 187, 206, 235, 204, 25, 247, 14, 137,
+			149, 126, 56, 244, 23, 239, 10, 227, 227, 122, 121, 157, 105, 53,
+			166, 162, 133, 226, 215, 153, 86, 99, 42, 90, 40, 126, 157, 185,
+			99, 252, 243, 150, 158, 0, 150, 70, 230, 28, 245, 94, 215, 79,
+			113, 136, 227, 118, 98, 89, 55, 111, 177, 251, 102, 133, 65, 76,
+			125, 2, 37, 19, 71, 141, 196, 107, 78, 86, 67, 159, 156, 162,
+			22, 86, 204, 10, 137, 205, 160, 207, 167, 154, 8, 217, 41, 250,
+			172, 168, 154, 109, 208, 222, 135, 25, 34, 27, 187, 128, 46, 196,
+			206, 79, 50, 103, 79, 94, 81, 66, 197, 222, 177, 188, 2, 102,
+			85, 54, 238, 229, 21, 132, 238, 145, 187, 249, 89, 141, 190, 227,
+			58, 63, 199, 156, 187, 189, 133, 2, 246, 107, 17, 222, 58, 223,
+			26, 123, 51, 168, 163, 6, 201, 225, 192, 221, 247, 115, 69, 56,
+			112, 251, 253, 28, 27, 63, 152, 87, 48, 84, 28, 62, 66, 105,
+			10, 42, 88, 166, 207, 48, 251, 136, 126, 163, 209, 51, 99, 66,
+			4, 47, 156, 88, 51, 59, 157, 37, 242, 43, 126, 134, 105, 209,
+			187, 66, 126, 197, 207, 48, 157, 166, 185, 130, 231, 71, 206, 103,
+			152, 78, 211, 92, 33, 209, 231, 51, 236, 208, 97, 254, 117, 92,
+			2, 21, 216, 102, 62, 7, 38, 250, 91, 183, 101, 155, 193, 189,
+			143, 43, 183, 112, 36, 183, 139, 96, 218, 184, 129, 130, 209, 29,
+			243, 109, 177, 205, 56, 163, 153, 155, 145, 27, 123, 116, 30, 158,
+			7, 192, 39, 119, 110, 158, 169, 144, 121, 230, 115, 57, 27, 3,
+			141, 63, 103, 184, 116, 133, 204, 51, 159, 3, 151, 190, 200, 237,
+			242, 128, 91, 254, 23, 108, 224, 55, 153, 229, 253, 93, 60, 52,
+			202, 226, 152, 160, 96, 204, 174, 249, 117, 157, 44, 65, 123, 118,
+			8, 152, 23, 123, 130, 84, 204, 5, 167, 132, 228, 50, 120, 205,
+			191, 96, 149, 61, 252, 41, 238, 148, 233, 121, 197, 23, 153, 61,
+			227, 61, 66, 47, 241, 140, 146, 161, 210, 91, 24, 191, 33, 69,
+			163, 42, 141, 68, 43, 10, 57, 125, 21, 74, 24, 200, 114, 157,
+			47, 178, 242, 144, 41, 218, 40, 242, 113, 83, 100, 40, 30, 61,
+			129, 88, 160, 50, 5, 124, 252, 42, 179, 107, 222, 18, 61, 133,
+			237, 213, 109, 10, 79, 89, 251, 150, 82, 223, 16, 59, 63, 99,
+			85, 243, 96, 83, 253, 42, 43, 103, 69, 228, 152, 101, 195, 19,
+			166, 200, 80, 188, 103, 134, 95, 36, 40, 108, 215, 249, 151, 204,
+			94, 240, 254, 110, 230, 67, 86, 216, 23, 166, 132, 45, 70, 111,
+			180, 252, 235, 57, 122, 82, 169, 41, 157, 77, 14, 180, 254, 37,
+			43, 15, 155, 34, 141, 191, 103, 210, 20, 25, 138, 247, 206, 235,
+			201, 17, 65, 201, 236, 57, 172, 170, 210, 151, 119, 153, 59, 142,
+			162, 180, 39, 119, 16, 64, 202, 77, 181, 217, 228, 16, 155, 127,
+			35, 199, 156, 217, 40, 102, 152, 67, 138, 254, 13, 118, 207, 44,
+			191, 66, 147, 59, 174, 243, 6, 179, 31, 244, 206, 155, 43, 124,
+			155, 118, 137, 205, 187, 77, 61, 45, 18, 34, 211, 78, 51, 8,
+			192, 72, 222, 96, 229, 61, 166, 104, 163, 184, 215, 51, 69, 216,
+			94, 217, 177, 211, 171, 229, 78, 28, 165, 209, 233, 255, 103, 0,
+			121, 134, 65, 71, 175, 178, 0, 0},
 	)
 }
 
@@ -21,17 +21,23 @@ const (
 	StreamType_TEXT     StreamType = 0
 	StreamType_BINARY   StreamType = 1
 	StreamType_DATAGRAM StreamType = 2
+	//
+	// JSON-lines. Its log entries have Text content, and each of its lines is a
+	// JSON object.
+	StreamType_JSON StreamType = 3
 )
 
 var StreamType_name = map[int32]string{
 	0: "TEXT",
 	1: "BINARY",
 	2: "DATAGRAM",
+	3: "JSON",
 }
 var StreamType_value = map[string]int32{
 	"TEXT":     0,
 	"BINARY":   1,
 	"DATAGRAM": 2,
+	"JSON":     3,
 }
 
 func (x StreamType) String() string {
@@ -39,6 +45,39 @@ func (x StreamType) String() string {
 }
 func (StreamType) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{0} }
 
+//
+// Compression is the compression scheme of an archived log stream.
+type LogIndex_Compression int32
+
+const (
+	// The log stream is a series of uncompressed RecordIO frames.
+	LogIndex_NONE LogIndex_Compression = 0
+	//
+	// The log stream is a series of RecordIO frames, each of which contains a
+	// zlib-compressed block. Each block, once decompressed, is a series of
+	// RecordIO frames in the same format as an uncompressed log stream.
+	//
+	// Blocks are compressed independently. The first block contains only the
+	// LogStreamDescriptor, and every index Entry begins a new block, so an
+	// Entry's "offset" is the byte offset of the compressed block that begins
+	// with its LogEntry.
+	LogIndex_ZLIB LogIndex_Compression = 1
+)
+
+var LogIndex_Compression_name = map[int32]string{
+	0: "NONE",
+	1: "ZLIB",
+}
+var LogIndex_Compression_value = map[string]int32{
+	"NONE": 0,
+	"ZLIB": 1,
+}
+
+func (x LogIndex_Compression) String() string {
+	return proto.EnumName(LogIndex_Compression_name, int32(x))
+}
+func (LogIndex_Compression) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{5, 0} }
+
 // *
 // Log stream descriptor data. This is the full set of information that
 // describes a logging stream.
@@ -167,6 +206,16 @@ type Text_Line struct {
 	// If this is an empty string, this line is continued in the next sequential
 	// line, and the line's sequence number does not advance.
 	Delimiter string `protobuf:"bytes,2,opt,name=delimiter" json:"delimiter,omitempty"`
+	//
+	// For JSON streams, the fields parsed from the line's JSON object.
+	//
+	// Nested object fields are flattened, joining their keys with ".". String
+	// values are stored verbatim, and other values as their compact JSON
+	// encoding.
+	//
+	// A line that is continued in the next sequential line has no fields. They
+	// are stored with the line's final segment.
+	Fields map[string]string `protobuf:"bytes,3,rep,name=fields" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *Text_Line) Reset()                    { *m = Text_Line{} }
@@ -188,6 +237,13 @@ func (m *Text_Line) GetDelimiter() string {
 	return ""
 }
 
+func (m *Text_Line) GetFields() map[string]string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
 // Binary stream content.
 type Binary struct {
 	// The byte offset in the stream of the first byte of data.
@@ -541,6 +597,12 @@ type LogIndex struct {
 	// This is optional. If zero, there is either no information about the number
 	// of log entries, or there are zero entries in the stream.
 	LogEntryCount uint64 `protobuf:"varint,5,opt,name=log_entry_count,json=logEntryCount" json:"log_entry_count,omitempty"`
+	//
+	// The compression scheme of the archived log stream.
+	//
+	// If this is not NONE, the index may not include an Entry for the last log
+	// entry in the stream.
+	Compression LogIndex_Compression `protobuf:"varint,6,opt,name=compression,enum=logpb.LogIndex_Compression" json:"compression,omitempty"`
 }
 
 func (m *LogIndex) Reset()                    { *m = LogIndex{} }
@@ -583,6 +645,13 @@ func (m *LogIndex) GetLogEntryCount() uint64 {
 	return 0
 }
 
+func (m *LogIndex) GetCompression() LogIndex_Compression {
+	if m != nil {
+		return m.Compression
+	}
+	return LogIndex_NONE
+}
+
 //
 // Entry is a single index entry.
 //
@@ -674,59 +743,66 @@ func init() {
 	proto.RegisterType((*LogIndex)(nil), "logpb.LogIndex")
 	proto.RegisterType((*LogIndex_Entry)(nil), "logpb.LogIndex.Entry")
 	proto.RegisterEnum("logpb.StreamType", StreamType_name, StreamType_value)
+	proto.RegisterEnum("logpb.LogIndex_Compression", LogIndex_Compression_name, LogIndex_Compression_value)
 }
 
 func init() { proto.RegisterFile("github.com/luci/luci-go/logdog/api/logpb/log.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 782 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdb, 0x6e, 0xdb, 0x46,
-	0x10, 0x15, 0x25, 0xea, 0x36, 0x94, 0x2a, 0x79, 0x7b, 0x63, 0x89, 0xa2, 0x95, 0x84, 0xc2, 0x15,
-	0x0c, 0x98, 0x6a, 0xd5, 0x02, 0x31, 0xfc, 0x26, 0x47, 0x8e, 0x6d, 0xc0, 0x49, 0x8c, 0xb5, 0x1e,
-	0x92, 0x27, 0x62, 0x25, 0xad, 0x98, 0x4d, 0x28, 0x2e, 0x43, 0xae, 0x02, 0x29, 0x9f, 0x92, 0x5f,
-	0x08, 0x90, 0x3f, 0xc8, 0x27, 0xe5, 0x1f, 0x82, 0xbd, 0xe8, 0xe2, 0x5b, 0x80, 0xbc, 0x10, 0xb3,
-	0x33, 0x67, 0x66, 0x67, 0xce, 0x9c, 0x25, 0xf4, 0x43, 0x26, 0x5e, 0x2d, 0xc6, 0xfe, 0x84, 0xcf,
-	0x7b, 0xd1, 0x62, 0xc2, 0xd4, 0xe7, 0x30, 0xe4, 0xbd, 0x88, 0x87, 0x53, 0x1e, 0xf6, 0x48, 0xc2,
-	0xa4, 0x99, 0x8c, 0xe5, 0xd7, 0x4f, 0x52, 0x2e, 0x38, 0x2a, 0x2a, 0x87, 0xf7, 0x67, 0xc8, 0x79,
-	0x18, 0xd1, 0x9e, 0x72, 0x8e, 0x17, 0xb3, 0x9e, 0x60, 0x73, 0x9a, 0x09, 0x32, 0x4f, 0x34, 0xce,
-	0xfb, 0xe3, 0x36, 0x60, 0xba, 0x48, 0x89, 0x60, 0x3c, 0xd6, 0xf1, 0xce, 0x97, 0x3c, 0xfc, 0x78,
-	0xc9, 0xc3, 0x6b, 0x91, 0x52, 0x32, 0x1f, 0xd2, 0x6c, 0x92, 0xb2, 0x44, 0xf0, 0x14, 0xfd, 0x02,
-	0xa5, 0x24, 0xa5, 0x33, 0xb6, 0x74, 0xad, 0x96, 0xd5, 0xad, 0x62, 0x73, 0x42, 0x08, 0xec, 0x98,
-	0xcc, 0xa9, 0x9b, 0x57, 0x5e, 0x65, 0xa3, 0x3e, 0x38, 0x99, 0xca, 0x0f, 0xc4, 0x2a, 0xa1, 0x6e,
-	0xa1, 0x65, 0x75, 0x7f, 0xe8, 0xef, 0xf9, 0xaa, 0x43, 0x5f, 0x57, 0x1e, 0xad, 0x12, 0x8a, 0x21,
-	0xdb, 0xd8, 0xa8, 0x0d, 0xb5, 0x09, 0x8f, 0x05, 0x8d, 0x85, 0x4e, 0xb2, 0x55, 0x3d, 0xc7, 0xf8,
-	0x14, 0xe4, 0x08, 0xaa, 0x9b, 0x69, 0xdc, 0x62, 0xcb, 0xea, 0x3a, 0x7d, 0xcf, 0xd7, 0xe3, 0xf8,
-	0xeb, 0x71, 0xfc, 0xd1, 0x1a, 0x81, 0xb7, 0x60, 0x74, 0x04, 0xb6, 0x20, 0x61, 0xe6, 0x96, 0x5a,
-	0x85, 0xae, 0xd3, 0xff, 0xcb, 0x74, 0x72, 0xcf, 0x98, 0xfe, 0x88, 0x84, 0xd9, 0x69, 0x2c, 0xd2,
-	0x15, 0x56, 0x19, 0x68, 0x1f, 0x1a, 0x63, 0x16, 0x93, 0x74, 0x15, 0xcc, 0x58, 0x44, 0x03, 0xba,
-	0x14, 0x6e, 0x59, 0x75, 0x56, 0xd7, 0xee, 0x27, 0x2c, 0xa2, 0xa7, 0x4b, 0xe1, 0x3d, 0x82, 0xea,
-	0x26, 0x15, 0x35, 0xa1, 0xf0, 0x86, 0xae, 0x0c, 0x51, 0xd2, 0x44, 0x3f, 0x41, 0xf1, 0x1d, 0x89,
-	0x16, 0x6b, 0x9a, 0xf4, 0xe1, 0x38, 0x7f, 0x64, 0x75, 0x5e, 0x83, 0x3d, 0xa2, 0x4b, 0x81, 0xf6,
-	0xa1, 0x18, 0xb1, 0x98, 0x66, 0xae, 0xa5, 0x7a, 0x6c, 0x9a, 0x1e, 0x65, 0xcc, 0xbf, 0x64, 0x31,
-	0xc5, 0x3a, 0xec, 0x1d, 0x83, 0x2d, 0x8f, 0xdb, 0x8a, 0xd6, 0x4e, 0x45, 0xf4, 0x3b, 0x54, 0xa7,
-	0x34, 0x62, 0x73, 0x26, 0x68, 0x6a, 0xee, 0xda, 0x3a, 0x3a, 0xff, 0x43, 0xe9, 0x44, 0x75, 0x2d,
-	0xb7, 0xc9, 0x67, 0xb3, 0x8c, 0x0a, 0x95, 0x6e, 0x63, 0x73, 0x92, 0xdb, 0x9c, 0x12, 0x41, 0x54,
-	0x6a, 0x0d, 0x2b, 0xbb, 0xf3, 0xc1, 0x82, 0xca, 0x90, 0x08, 0x12, 0xa6, 0x64, 0xbe, 0x01, 0x58,
-	0x5b, 0x00, 0xfa, 0x17, 0xca, 0x09, 0x49, 0x05, 0x23, 0x91, 0xca, 0x73, 0xfa, 0xbf, 0x9a, 0xe6,
-	0xd7, 0x59, 0xfe, 0x95, 0x0e, 0xe3, 0x35, 0xce, 0x3b, 0x83, 0xb2, 0xf1, 0xc9, 0x41, 0x58, 0x3c,
-	0xa5, 0x5a, 0x57, 0x75, 0xac, 0x0f, 0xf2, 0x9e, 0x8c, 0xbd, 0xd7, 0x7c, 0xd9, 0x58, 0xd9, 0xd2,
-	0x17, 0x91, 0x4c, 0x28, 0x3d, 0x55, 0xb0, 0xb2, 0x3b, 0x9f, 0xf2, 0x50, 0xb9, 0xe4, 0xa1, 0xe6,
-	0xfd, 0x18, 0x1c, 0xb9, 0xf3, 0x60, 0x67, 0x34, 0xa7, 0xff, 0xdb, 0x1d, 0x89, 0x0c, 0x8d, 0xe2,
-	0x31, 0x48, 0xf4, 0x73, 0x3d, 0x79, 0x1b, 0x6a, 0x5a, 0xd1, 0x81, 0xee, 0x46, 0x5f, 0xec, 0x68,
-	0xdf, 0x85, 0xea, 0xa9, 0x0d, 0x35, 0x23, 0x6b, 0x0d, 0x29, 0x68, 0x88, 0xf6, 0x69, 0x88, 0x07,
-	0x95, 0x8c, 0xbe, 0x5d, 0xd0, 0x78, 0xa2, 0x15, 0x6c, 0xe3, 0xcd, 0x19, 0xb5, 0xc1, 0x16, 0x52,
-	0x3f, 0xa0, 0xda, 0x72, 0x76, 0x16, 0x7c, 0x9e, 0xc3, 0x2a, 0x84, 0xfe, 0x86, 0x92, 0x96, 0x95,
-	0xeb, 0x28, 0x50, 0xdd, 0x80, 0xf4, 0xd6, 0xce, 0x73, 0xd8, 0x84, 0xd1, 0x21, 0x54, 0xa6, 0x86,
-	0x5c, 0xb7, 0xa6, 0xa0, 0x8d, 0x5b, 0x9c, 0x9f, 0xe7, 0xf0, 0x06, 0x72, 0x52, 0x85, 0xb2, 0x79,
-	0x48, 0x9d, 0x8f, 0x05, 0x45, 0x98, 0x6e, 0xd7, 0x07, 0x7b, 0x4a, 0xb3, 0x89, 0x61, 0xca, 0x7b,
-	0xf8, 0x5d, 0x60, 0x85, 0x43, 0x3d, 0x28, 0xd3, 0x58, 0xa4, 0x8c, 0x66, 0x6e, 0x5e, 0xc9, 0xf4,
-	0xe7, 0x6d, 0x8a, 0xaa, 0xe8, 0xeb, 0xb7, 0xb3, 0x46, 0xa1, 0x03, 0xd8, 0x93, 0x6b, 0x0a, 0x6e,
-	0x50, 0xab, 0x79, 0x6b, 0xc8, 0xc0, 0xd5, 0x0e, 0xbd, 0x6b, 0xec, 0x0d, 0x8e, 0xed, 0x2d, 0xf6,
-	0x7a, 0x87, 0xe7, 0x7d, 0x68, 0x44, 0x3c, 0x0c, 0xe4, 0x35, 0xab, 0x60, 0xc2, 0x17, 0xb1, 0x50,
-	0x3f, 0x04, 0x1b, 0xd7, 0x23, 0x23, 0x86, 0xc7, 0xd2, 0xe9, 0x7d, 0xb6, 0xa0, 0xa8, 0xb5, 0xf1,
-	0x90, 0xe2, 0x77, 0x37, 0x96, 0xbf, 0xb3, 0xb1, 0xda, 0x3d, 0x8d, 0x7f, 0x53, 0x13, 0xf6, 0x5d,
-	0x4d, 0xdc, 0x52, 0x65, 0xf1, 0x3b, 0x54, 0x79, 0xf0, 0x0f, 0xc0, 0xf6, 0x7f, 0x89, 0x2a, 0x60,
-	0x8f, 0x4e, 0x5f, 0x8c, 0x9a, 0x39, 0x04, 0x50, 0x3a, 0xb9, 0x78, 0x36, 0xc0, 0x2f, 0x9b, 0x16,
-	0xaa, 0x41, 0x65, 0x38, 0x18, 0x0d, 0xce, 0xf0, 0xe0, 0x69, 0x33, 0x3f, 0x2e, 0xa9, 0x82, 0xff,
-	0x7d, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x12, 0x93, 0xb0, 0x44, 0x06, 0x00, 0x00,
+	// 868 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5d, 0x6e, 0x23, 0x45,
+	0x10, 0xf6, 0xd8, 0xe3, 0xbf, 0x1a, 0x87, 0x78, 0x9b, 0xbf, 0x61, 0x58, 0x41, 0x6c, 0xa1, 0x10,
+	0xad, 0xb4, 0x63, 0x61, 0x56, 0x22, 0x44, 0xe2, 0x21, 0xd9, 0x64, 0x37, 0x41, 0x21, 0x59, 0x75,
+	0xfc, 0x00, 0xbc, 0x58, 0x6d, 0xbb, 0x3d, 0xb4, 0x18, 0x4f, 0x0f, 0x33, 0x6d, 0x64, 0x73, 0x07,
+	0x2e, 0xc0, 0x15, 0x90, 0xb8, 0x01, 0x37, 0xe1, 0x0a, 0xdc, 0x01, 0x75, 0x75, 0xdb, 0x33, 0xeb,
+	0xec, 0x22, 0xe5, 0xc5, 0xaa, 0xae, 0xfa, 0xaa, 0xab, 0xea, 0xeb, 0xaf, 0xc6, 0x30, 0x8c, 0x84,
+	0xfa, 0x69, 0x39, 0x09, 0xa7, 0x72, 0x31, 0x88, 0x97, 0x53, 0x81, 0x3f, 0x4f, 0x23, 0x39, 0x88,
+	0x65, 0x34, 0x93, 0xd1, 0x80, 0xa5, 0x42, 0x9b, 0xe9, 0x44, 0xff, 0x86, 0x69, 0x26, 0x95, 0x24,
+	0x75, 0x74, 0x04, 0x9f, 0x46, 0x52, 0x46, 0x31, 0x1f, 0xa0, 0x73, 0xb2, 0x9c, 0x0f, 0x94, 0x58,
+	0xf0, 0x5c, 0xb1, 0x45, 0x6a, 0x70, 0xc1, 0x27, 0xbb, 0x80, 0xd9, 0x32, 0x63, 0x4a, 0xc8, 0xc4,
+	0xc4, 0xfb, 0xff, 0x56, 0xe1, 0xdd, 0x6b, 0x19, 0xdd, 0xa9, 0x8c, 0xb3, 0xc5, 0x39, 0xcf, 0xa7,
+	0x99, 0x48, 0x95, 0xcc, 0xc8, 0x07, 0xd0, 0x48, 0x33, 0x3e, 0x17, 0x2b, 0xdf, 0x39, 0x70, 0x8e,
+	0xda, 0xd4, 0x9e, 0x08, 0x01, 0x37, 0x61, 0x0b, 0xee, 0x57, 0xd1, 0x8b, 0x36, 0x19, 0x82, 0x97,
+	0x63, 0xfe, 0x58, 0xad, 0x53, 0xee, 0xd7, 0x0e, 0x9c, 0xa3, 0x77, 0x86, 0x8f, 0x42, 0xec, 0x30,
+	0x34, 0x37, 0x8f, 0xd6, 0x29, 0xa7, 0x90, 0x6f, 0x6d, 0xd2, 0x83, 0xce, 0x54, 0x26, 0x8a, 0x27,
+	0xca, 0x24, 0xb9, 0x78, 0x9f, 0x67, 0x7d, 0x08, 0x39, 0x86, 0xf6, 0x76, 0x1a, 0xbf, 0x7e, 0xe0,
+	0x1c, 0x79, 0xc3, 0x20, 0x34, 0xe3, 0x84, 0x9b, 0x71, 0xc2, 0xd1, 0x06, 0x41, 0x0b, 0x30, 0x39,
+	0x06, 0x57, 0xb1, 0x28, 0xf7, 0x1b, 0x07, 0xb5, 0x23, 0x6f, 0xf8, 0x99, 0xed, 0xe4, 0x0d, 0x63,
+	0x86, 0x23, 0x16, 0xe5, 0x17, 0x89, 0xca, 0xd6, 0x14, 0x33, 0xc8, 0x21, 0xec, 0x4f, 0x44, 0xc2,
+	0xb2, 0xf5, 0x78, 0x2e, 0x62, 0x3e, 0xe6, 0x2b, 0xe5, 0x37, 0xb1, 0xb3, 0x3d, 0xe3, 0x7e, 0x21,
+	0x62, 0x7e, 0xb1, 0x52, 0xc1, 0x57, 0xd0, 0xde, 0xa6, 0x92, 0x2e, 0xd4, 0x7e, 0xe6, 0x6b, 0x4b,
+	0x94, 0x36, 0xc9, 0x7b, 0x50, 0xff, 0x95, 0xc5, 0xcb, 0x0d, 0x4d, 0xe6, 0x70, 0x52, 0x3d, 0x76,
+	0xfa, 0xff, 0x38, 0xe0, 0x8e, 0xf8, 0x4a, 0x91, 0x43, 0xa8, 0xc7, 0x22, 0xe1, 0xb9, 0xef, 0x60,
+	0x93, 0x5d, 0xdb, 0xa4, 0x8e, 0x85, 0xd7, 0x22, 0xe1, 0xd4, 0x84, 0x83, 0x3f, 0x1d, 0x70, 0xf5,
+	0xb9, 0xb8, 0xd3, 0x29, 0xdd, 0x49, 0x1e, 0x43, 0x7b, 0xc6, 0x63, 0xb1, 0x10, 0x8a, 0x67, 0xb6,
+	0x5a, 0xe1, 0x20, 0xcf, 0xa0, 0x31, 0x17, 0x3c, 0x9e, 0xe5, 0x7e, 0x0d, 0xab, 0x3c, 0xde, 0xad,
+	0x12, 0xbe, 0xc0, 0xb0, 0xa1, 0xc0, 0x62, 0x83, 0xaf, 0xc1, 0x2b, 0xb9, 0x1f, 0x34, 0xde, 0x33,
+	0x68, 0x9c, 0x21, 0x51, 0x5a, 0x40, 0x72, 0x3e, 0xcf, 0xb9, 0xc2, 0x44, 0x97, 0xda, 0x93, 0x16,
+	0xd0, 0x8c, 0x29, 0x86, 0xa9, 0x1d, 0x8a, 0x76, 0xff, 0x0f, 0x07, 0x5a, 0xe7, 0x4c, 0xb1, 0x28,
+	0x63, 0x8b, 0x2d, 0xc0, 0x29, 0x00, 0xe4, 0x0b, 0x68, 0xa6, 0x2c, 0x53, 0x82, 0xc5, 0x98, 0xe7,
+	0x0d, 0x3f, 0xb4, 0x83, 0x6c, 0xb2, 0xc2, 0x57, 0x26, 0x4c, 0x37, 0xb8, 0xe0, 0x25, 0x34, 0xad,
+	0x4f, 0xb7, 0x2b, 0x92, 0x19, 0x37, 0x52, 0xde, 0xa3, 0xe6, 0xa0, 0xeb, 0xe4, 0xe2, 0x37, 0x33,
+	0x83, 0x4b, 0xd1, 0xd6, 0xbe, 0x98, 0xe5, 0x0a, 0x25, 0xdc, 0xa2, 0x68, 0xf7, 0xff, 0xaa, 0x42,
+	0xeb, 0x5a, 0x46, 0x86, 0x8b, 0x13, 0xf0, 0xb4, 0xcc, 0xc6, 0xa5, 0xd1, 0xbc, 0xe1, 0x47, 0xf7,
+	0x54, 0x79, 0x6e, 0x97, 0x8c, 0x82, 0x46, 0xdf, 0x9a, 0xc9, 0x7b, 0xd0, 0x31, 0x4b, 0x34, 0x36,
+	0xdd, 0x98, 0xc2, 0x9e, 0xf1, 0x5d, 0x61, 0x4f, 0x3d, 0xe8, 0xd8, 0x4d, 0x32, 0x90, 0x9a, 0x81,
+	0x18, 0x9f, 0x81, 0x04, 0xd0, 0xca, 0xf9, 0x2f, 0x4b, 0x9e, 0x4c, 0xcd, 0xd2, 0xb8, 0x74, 0x7b,
+	0x26, 0x3d, 0x70, 0x95, 0x96, 0x2c, 0x60, 0x5b, 0x5e, 0xe9, 0xb1, 0x2f, 0x2b, 0x14, 0x43, 0xe4,
+	0x73, 0x68, 0x18, 0x25, 0xfb, 0x1e, 0x82, 0xf6, 0x2c, 0xc8, 0xbc, 0xda, 0x65, 0x85, 0xda, 0x30,
+	0x79, 0x0a, 0xad, 0x99, 0x25, 0xd7, 0xef, 0x20, 0x74, 0x7f, 0x87, 0xf3, 0xcb, 0x0a, 0xdd, 0x42,
+	0xce, 0xda, 0xd0, 0xb4, 0xbb, 0xdb, 0xff, 0xdd, 0x45, 0xc2, 0x4c, 0xbb, 0x21, 0xb8, 0x33, 0x9e,
+	0x4f, 0x2d, 0x53, 0xc1, 0xdb, 0x57, 0x91, 0x22, 0x8e, 0x0c, 0xa0, 0xc9, 0x13, 0x95, 0x09, 0x9e,
+	0xfb, 0x55, 0x94, 0xec, 0xfb, 0x45, 0x0a, 0xde, 0x18, 0x1a, 0xad, 0x6e, 0x50, 0xe4, 0x09, 0x3c,
+	0xd2, 0xcf, 0x34, 0x7e, 0x8d, 0x5a, 0xc3, 0xdb, 0xbe, 0x0e, 0xbc, 0x2a, 0xd1, 0xbb, 0xc1, 0xbe,
+	0xc6, 0xb1, 0x5b, 0x60, 0xef, 0x4a, 0x3c, 0x1f, 0xc2, 0x7e, 0x2c, 0xa3, 0xb1, 0x2e, 0xb3, 0x1e,
+	0x4f, 0xe5, 0x32, 0x51, 0xf8, 0x0d, 0x72, 0xe9, 0x5e, 0x6c, 0xc5, 0xf0, 0x5c, 0x3b, 0xc9, 0x37,
+	0xe0, 0x4d, 0xe5, 0x22, 0xcd, 0x78, 0x9e, 0x0b, 0x99, 0xf8, 0x0d, 0xfc, 0xf8, 0x7d, 0xbc, 0xdb,
+	0xf4, 0xf3, 0x02, 0x42, 0xcb, 0xf8, 0xe0, 0x6f, 0x07, 0xea, 0x46, 0x5a, 0x6f, 0x5b, 0x98, 0xf2,
+	0x83, 0x57, 0xef, 0x3d, 0x78, 0xe7, 0x0d, 0x73, 0xff, 0xaf, 0xa4, 0xdc, 0xfb, 0x92, 0xda, 0x11,
+	0x75, 0xfd, 0x01, 0xa2, 0xee, 0xf7, 0xc0, 0x2b, 0xcd, 0x46, 0x5a, 0xe0, 0xde, 0xdc, 0xde, 0x5c,
+	0x74, 0x2b, 0xda, 0xfa, 0xf1, 0xfa, 0xea, 0xac, 0xeb, 0x3c, 0x39, 0x01, 0x28, 0xfe, 0x04, 0xb4,
+	0x7f, 0x74, 0xf1, 0xfd, 0xa8, 0x5b, 0x21, 0x00, 0x8d, 0xb3, 0xab, 0x9b, 0x53, 0xfa, 0x43, 0xd7,
+	0x21, 0x1d, 0x68, 0x9d, 0x9f, 0x8e, 0x4e, 0x5f, 0xd2, 0xd3, 0xef, 0xba, 0x55, 0x8d, 0xf9, 0xf6,
+	0xee, 0xf6, 0xa6, 0x5b, 0x9b, 0x34, 0xb0, 0xfa, 0x97, 0xff, 0x0d, 0x00, 0x6b, 0xd4, 0x5e, 0xf5,
+	0x23, 0x07, 0x00, 0x00,
 }
@@ -38,7 +38,7 @@ func (d *LogStreamDescriptor) Validate(prefix bool) error {
 	}
 
 	switch d.StreamType {
-	case StreamType_TEXT, StreamType_BINARY, StreamType_DATAGRAM:
+	case StreamType_TEXT, StreamType_BINARY, StreamType_DATAGRAM, StreamType_JSON:
 		break
 
 	default:
@@ -83,7 +83,7 @@ func (e *LogEntry) Validate(d *LogStreamDescriptor) error {
 
 	// Check for content.
 	switch d.StreamType {
-	case StreamType_TEXT:
+	case StreamType_TEXT, StreamType_JSON:
 		if t := e.GetText(); t == nil || len(t.Lines) == 0 {
 			return ErrNoContent
 		}
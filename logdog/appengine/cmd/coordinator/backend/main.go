@@ -12,6 +12,7 @@ import (
 
 	"github.com/luci/luci-go/appengine/gaemiddleware"
 	"github.com/luci/luci-go/logdog/appengine/coordinator"
+	"github.com/luci/luci-go/logdog/appengine/coordinator/retention"
 	"github.com/luci/luci-go/server/router"
 	"github.com/luci/luci-go/tumble"
 
@@ -26,6 +27,7 @@ func init() {
 	r := router.New()
 	base := gaemiddleware.BaseProd().Extend(coordinator.ProdCoordinatorService)
 	tmb.InstallHandlers(r, base)
+	retention.InstallHandlers(r, base)
 	gaemiddleware.InstallHandlersWithMiddleware(r, base)
 
 	http.Handle("/", r)
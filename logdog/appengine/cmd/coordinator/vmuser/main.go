@@ -5,6 +5,7 @@
 package main
 
 import (
+	"flag"
 	"net/http"
 
 	// Importing pprof implicitly installs "/debug/*" profiling handlers.
@@ -37,6 +38,11 @@ import (
 
 // Run installs and executes this site.
 func main() {
+	flag.StringVar(&coordinator.LocalStoragePath, "local-storage-path", coordinator.LocalStoragePath,
+		"If set, use local on-disk intermediate storage rooted here instead of the configured storage. "+
+			"This is intended for development and single-machine deployments.")
+	flag.Parse()
+
 	mathrand.SeedRandomly()
 
 	r := router.New()
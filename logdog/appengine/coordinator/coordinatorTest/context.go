@@ -310,6 +310,14 @@ func Install() (context.Context, *Environment) {
 				Opts:    opts,
 			}, nil
 		},
+		IS: func() (coordinator.Storage, error) {
+			return &BigTableStorage{
+				Testing: e.BigTable,
+			}, nil
+		},
+		GS: func() (gs.Client, error) {
+			return &e.GSClient, nil
+		},
 		AP: func() (coordinator.ArchivalPublisher, error) {
 			return &e.ArchivalPublisher, nil
 		},
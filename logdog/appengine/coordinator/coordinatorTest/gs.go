@@ -36,7 +36,10 @@ func (c GSClient) NewWriter(gs.Path) (gs.Writer, error) {
 func (c GSClient) Rename(gs.Path, gs.Path) error { return errors.New("not implemented") }
 
 // Delete implements gs.Client.
-func (c GSClient) Delete(gs.Path) error { return errors.New("not implemented") }
+func (c GSClient) Delete(path gs.Path) error {
+	delete(c, path)
+	return nil
+}
 
 // NewReader implements gs.Client.
 func (c GSClient) NewReader(path gs.Path, offset int64, length int64) (io.ReadCloser, error) {
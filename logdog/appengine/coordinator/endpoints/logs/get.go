@@ -65,10 +65,44 @@ func (s *server) getImpl(c context.Context, req *logdog.GetRequest, tail bool) (
 		"tail":    tail,
 	}.Debugf(c, "Received get request.")
 
-	path := types.StreamPath(req.Path)
+	ls, lst, err := loadLogStream(c, req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := logdog.GetResponse{}
+	if req.State {
+		resp.State = buildLogStreamState(ls, lst)
+
+		resp.Desc, err = ls.DescriptorValue()
+		if err != nil {
+			log.WithError(err).Errorf(c, "Failed to deserialize descriptor protobuf.")
+			return nil, grpcutil.Internal
+		}
+	}
+
+	// Retrieve requested logs from storage, if requested.
+	if err := s.getLogs(c, req, &resp, tail, ls, lst); err != nil {
+		log.WithError(err).Errorf(c, "Failed to get logs.")
+		return nil, grpcutil.Internal
+	}
+
+	log.Fields{
+		"logCount": len(resp.Logs),
+	}.Debugf(c, "Get request completed successfully.")
+	return &resp, nil
+}
+
+// loadLogStream loads the log stream and log stream state for the supplied
+// path. It returns a gRPC error on failure.
+//
+// Purged log streams are reported as not found unless the user is an
+// administrator.
+func loadLogStream(c context.Context, p string) (*coordinator.LogStream, *coordinator.LogStreamState, error) {
+	path := types.StreamPath(p)
 	if err := path.Validate(); err != nil {
 		log.WithError(err).Errorf(c, "Invalid path supplied.")
-		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid path value")
+		return nil, nil, grpcutil.Errf(codes.InvalidArgument, "invalid path value")
 	}
 
 	ls := &coordinator.LogStream{ID: coordinator.LogStreamID(path)}
@@ -80,11 +114,11 @@ func (s *server) getImpl(c context.Context, req *logdog.GetRequest, tail bool) (
 	if err := ds.Get(c, ls, lst); err != nil {
 		if isNoSuchEntity(err) {
 			log.Errorf(c, "Log stream does not exist.")
-			return nil, grpcutil.Errf(codes.NotFound, "path not found")
+			return nil, nil, grpcutil.Errf(codes.NotFound, "path not found")
 		}
 
 		log.WithError(err).Errorf(c, "Failed to look up log stream.")
-		return nil, grpcutil.Internal
+		return nil, nil, grpcutil.Internal
 	}
 
 	// If this log entry is Purged and we're not admin, pretend it doesn't exist.
@@ -93,32 +127,11 @@ func (s *server) getImpl(c context.Context, req *logdog.GetRequest, tail bool) (
 			log.Fields{
 				log.ErrorKey: authErr,
 			}.Warningf(c, "Non-superuser requested purged log.")
-			return nil, grpcutil.Errf(codes.NotFound, "path not found")
-		}
-	}
-
-	resp := logdog.GetResponse{}
-	if req.State {
-		resp.State = buildLogStreamState(ls, lst)
-
-		var err error
-		resp.Desc, err = ls.DescriptorValue()
-		if err != nil {
-			log.WithError(err).Errorf(c, "Failed to deserialize descriptor protobuf.")
-			return nil, grpcutil.Internal
+			return nil, nil, grpcutil.Errf(codes.NotFound, "path not found")
 		}
 	}
 
-	// Retrieve requested logs from storage, if requested.
-	if err := s.getLogs(c, req, &resp, tail, ls, lst); err != nil {
-		log.WithError(err).Errorf(c, "Failed to get logs.")
-		return nil, grpcutil.Internal
-	}
-
-	log.Fields{
-		"logCount": len(resp.Logs),
-	}.Debugf(c, "Get request completed successfully.")
-	return &resp, nil
+	return ls, lst, nil
 }
 
 func (s *server) getLogs(c context.Context, req *logdog.GetRequest, resp *logdog.GetResponse,
@@ -590,8 +590,8 @@ func testGetImpl(t *testing.T, archived bool) {
 
 					So(resp.Logs[0].GetText(), ShouldResemble, &logpb.Text{
 						Lines: []*logpb.Text_Line{
-							{"log entry #0", "\n"},
-							{"another line of text", ""},
+							{Value: "log entry #0", Delimiter: "\n"},
+							{Value: "another line of text"},
 						},
 					})
 				})
@@ -139,7 +139,7 @@ func (r *queryRunner) runQuery(resp *logdog.QueryResponse) error {
 
 	if st := r.StreamType; st != nil {
 		switch v := st.Value; v {
-		case logpb.StreamType_TEXT, logpb.StreamType_BINARY, logpb.StreamType_DATAGRAM:
+		case logpb.StreamType_TEXT, logpb.StreamType_BINARY, logpb.StreamType_DATAGRAM, logpb.StreamType_JSON:
 			q = q.Eq("StreamType", v)
 
 		default:
@@ -0,0 +1,349 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package logs
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/luci/luci-go/common/errors"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/retry"
+	"github.com/luci/luci-go/common/retry/transient"
+	"github.com/luci/luci-go/grpc/grpcutil"
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/appengine/coordinator"
+	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/types"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// searchResultLimit is the maximum number of matches that will be returned
+	// in a single search.
+	searchResultLimit = 500
+
+	// searchContextLimit is the maximum number of context lines that may be
+	// requested before and after each match.
+	searchContextLimit = 50
+
+	// searchBytesLimit is the maximum number of log entry bytes that will be
+	// scanned in a single search.
+	searchBytesLimit = 32 * 1024 * 1024
+
+	// searchResponseBytesLimit is the maximum number of line bytes that a single
+	// search will return. Once it has been exceeded, no further matches will be
+	// added. See getBytesLimit for the constraints that this is subject to.
+	searchResponseBytesLimit = getBytesLimit
+)
+
+// Search returns the lines in a text log stream that match a pattern.
+func (s *server) Search(c context.Context, req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+	log.Fields{
+		"project": req.Project,
+		"path":    req.Path,
+		"pattern": req.Pattern,
+		"regex":   req.Regex,
+		"next":    req.Next,
+	}.Debugf(c, "Received search request.")
+
+	match, err := compileSearchPattern(req.Pattern, req.Regex, req.IgnoreCase)
+	if err != nil {
+		log.WithError(err).Errorf(c, "Invalid search pattern.")
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid pattern: %s", err)
+	}
+	if req.Context < 0 || req.Context > searchContextLimit {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "context must be between 0 and %d", searchContextLimit)
+	}
+	var start searchCursor
+	if req.Next != "" {
+		if start, err = parseSearchCursor(req.Next); err != nil {
+			log.WithError(err).Errorf(c, "Invalid next value.")
+			return nil, grpcutil.Errf(codes.InvalidArgument, "invalid next value")
+		}
+	}
+
+	ls, lst, err := loadLogStream(c, req.Path)
+	if err != nil {
+		return nil, err
+	}
+	switch ls.StreamType {
+	case logpb.StreamType_TEXT, logpb.StreamType_JSON:
+		break
+	default:
+		return nil, grpcutil.Errf(codes.InvalidArgument, "log stream is not a text stream")
+	}
+
+	byteLimit := int(req.ByteCount)
+	if byteLimit <= 0 || byteLimit > searchBytesLimit {
+		byteLimit = searchBytesLimit
+	}
+	searcher := lineSearcher{
+		match:     match,
+		context:   int(req.Context),
+		limit:     s.limit(int(req.MaxResults), searchResultLimit),
+		byteLimit: byteLimit,
+		next:      start,
+	}
+
+	svc := coordinator.GetServices(c)
+	st, err := svc.StorageForStream(c, lst)
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to create storage instance.")
+		return nil, grpcutil.Internal
+	}
+	defer st.Close()
+
+	if err := searcher.search(c, st, storage.GetRequest{
+		Project: coordinator.Project(c),
+		Path:    ls.Path(),
+	}); err != nil {
+		log.WithError(err).Errorf(c, "Failed to search log stream.")
+		return nil, grpcutil.Internal
+	}
+
+	resp := logdog.SearchResponse{
+		Project: req.Project,
+		Matches: searcher.matches,
+	}
+	if searcher.full || !searcher.exhausted {
+		resp.Next = searcher.next.String()
+	}
+
+	log.Fields{
+		"matchCount": len(resp.Matches),
+		"scanned":    searcher.scanned,
+		"next":       resp.Next,
+	}.Debugf(c, "Search request completed successfully.")
+	return &resp, nil
+}
+
+// compileSearchPattern returns a function that reports whether a line matches
+// the supplied search pattern.
+func compileSearchPattern(pattern string, regex, ignoreCase bool) (func(string) bool, error) {
+	if pattern == "" {
+		return nil, errors.New("a pattern is required")
+	}
+
+	if !regex {
+		if !ignoreCase {
+			return func(line string) bool { return strings.Contains(line, pattern) }, nil
+		}
+		pattern = regexp.QuoteMeta(pattern)
+	}
+	if ignoreCase {
+		pattern = "(?i)" + pattern
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return re.MatchString, nil
+}
+
+// searchCursor is the position of a line in a log stream. It is the point at
+// which a continued search resumes.
+type searchCursor struct {
+	// index is the stream index of the log entry containing the line.
+	index types.MessageIndex
+	// line is the offset of the line within its log entry.
+	line int
+}
+
+func parseSearchCursor(v string) (sc searchCursor, err error) {
+	var index int64
+	if _, err = fmt.Sscanf(v, "%d:%d", &index, &sc.line); err != nil {
+		return
+	}
+	if index < 0 || sc.line < 0 {
+		err = errors.New("negative cursor value")
+		return
+	}
+	sc.index = types.MessageIndex(index)
+	return
+}
+
+func (sc searchCursor) String() string { return fmt.Sprintf("%d:%d", sc.index, sc.line) }
+
+// lineSearcher scans a log stream's lines for matches.
+type lineSearcher struct {
+	match     func(string) bool
+	context   int
+	limit     int
+	byteLimit int
+
+	// matches is the set of matches that have been found.
+	matches []*logdog.SearchResponse_Match
+	// pending is the set of matches that are still collecting lines of context
+	// after them. Matches are completed in the order that they were found.
+	pending []*logdog.SearchResponse_Match
+	// before is the set of most recent lines, up to context lines.
+	before []string
+
+	// full is true once no more matches will be accepted.
+	full bool
+	// responseBytes is the number of line bytes in matches.
+	responseBytes int
+	// scanned is the number of log entry bytes that have been scanned.
+	scanned int
+
+	// next is the position of the next line to search.
+	next searchCursor
+	// exhausted is true if the search reached the end of the log stream's
+	// available log entries.
+	exhausted bool
+}
+
+// search scans the log entries in st, beginning at the searcher's next
+// position, until it reaches the end of the log stream or one of its limits.
+func (ls *lineSearcher) search(c context.Context, st coordinator.Storage, req storage.GetRequest) error {
+	var ierr error
+	for {
+		// Issue Get requests until one makes no progress. Our storage may return
+		// fewer log entries than are available.
+		req.Index = ls.next.index
+		progress, stopped := false, false
+		err := retry.Retry(c, transient.Only(retry.Default), func() error {
+			return st.Get(req, func(e *storage.Entry) bool {
+				if ls.scanned > 0 && ls.scanned+len(e.D) > ls.byteLimit {
+					// Not the first log entry, and we've exceeded our byte limit.
+					stopped = true
+					return false
+				}
+
+				var le *logpb.LogEntry
+				if le, ierr = e.GetLogEntry(); ierr != nil {
+					return false
+				}
+				progress = true
+				ls.scanned += len(e.D)
+
+				if !ls.addLogEntry(le) {
+					stopped = true
+					return false
+				}
+				req.Index = ls.next.index
+				return true
+			})
+		}, func(err error, delay time.Duration) {
+			log.Fields{
+				log.ErrorKey: err,
+				"delay":      delay,
+				"nextIndex":  req.Index,
+			}.Warningf(c, "Transient error while searching logs; retrying.")
+		})
+		switch {
+		case err == storage.ErrDoesNotExist:
+			ls.exhausted = true
+			return nil
+		case err != nil:
+			return err
+		case ierr != nil:
+			return errors.Annotate(ierr, "bad log entry data").Err()
+		case stopped:
+			return nil
+		case !progress:
+			ls.exhausted = true
+			return nil
+		}
+	}
+}
+
+// addLogEntry searches a log entry's lines. It returns false if the search is
+// complete.
+func (ls *lineSearcher) addLogEntry(le *logpb.LogEntry) bool {
+	index := types.MessageIndex(le.StreamIndex)
+	skip := 0
+	if index == ls.next.index {
+		skip = ls.next.line
+	}
+
+	lines := le.GetText().GetLines()
+	for i, line := range lines {
+		if i < skip {
+			// This line was searched by a previous request, but we can still use it
+			// as context.
+			ls.addBefore(line.Value)
+			continue
+		}
+
+		if !ls.full {
+			// This line will be searched, so a continued search resumes after it.
+			if i+1 < len(lines) {
+				ls.next = searchCursor{index, i + 1}
+			} else {
+				ls.next = searchCursor{index + 1, 0}
+			}
+		}
+		if !ls.addLine(index, int64(le.Sequence)+int64(i), line.Value) {
+			return false
+		}
+	}
+
+	if !ls.full {
+		ls.next = searchCursor{index + 1, 0}
+	}
+	return true
+}
+
+// addLine searches a single line. It returns false if the search is complete.
+func (ls *lineSearcher) addLine(index types.MessageIndex, lineIndex int64, line string) bool {
+	// Add this line as context to any pending matches.
+	for len(ls.pending) > 0 {
+		m := ls.pending[0]
+		if len(m.After) < ls.context {
+			for _, p := range ls.pending {
+				p.After = append(p.After, line)
+				ls.responseBytes += len(line)
+			}
+		}
+		if len(m.After) < ls.context {
+			break
+		}
+		ls.pending = ls.pending[1:]
+	}
+
+	if !ls.full && ls.match(line) {
+		m := logdog.SearchResponse_Match{
+			StreamIndex: int64(index),
+			LineIndex:   lineIndex,
+			Line:        line,
+		}
+		if len(ls.before) > 0 {
+			m.Before = append([]string(nil), ls.before...)
+		}
+		ls.matches = append(ls.matches, &m)
+		if ls.context > 0 {
+			ls.pending = append(ls.pending, &m)
+		}
+
+		ls.responseBytes += len(line)
+		for _, b := range m.Before {
+			ls.responseBytes += len(b)
+		}
+		if len(ls.matches) >= ls.limit || ls.responseBytes >= searchResponseBytesLimit {
+			ls.full = true
+		}
+	}
+	ls.addBefore(line)
+
+	return !(ls.full && len(ls.pending) == 0)
+}
+
+func (ls *lineSearcher) addBefore(line string) {
+	if ls.context == 0 {
+		return
+	}
+	if len(ls.before) == ls.context {
+		ls.before = append(ls.before[:0], ls.before[1:]...)
+	}
+	ls.before = append(ls.before, line)
+}
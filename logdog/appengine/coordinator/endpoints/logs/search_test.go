@@ -0,0 +1,212 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package logs
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	ct "github.com/luci/luci-go/logdog/appengine/coordinator/coordinatorTest"
+	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/types"
+
+	"github.com/golang/protobuf/proto"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func shouldHaveMatches(actual interface{}, expected ...interface{}) string {
+	resp := actual.(*logdog.SearchResponse)
+
+	respMatches := make([]string, len(resp.Matches))
+	for i, m := range resp.Matches {
+		respMatches[i] = fmt.Sprintf("%d:%d", m.StreamIndex, m.LineIndex)
+	}
+
+	expMatches := make([]string, len(expected))
+	for i, exp := range expected {
+		expMatches[i] = exp.(string)
+	}
+
+	return ShouldResemble(respMatches, expMatches)
+}
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a testing configuration, a Search request`, t, func() {
+		c, env := ct.Install()
+
+		svr := newService(&server{})
+
+		// Generate our test stream.
+		tls := ct.MakeStream(c, "proj-foo", "testing/+/foo/bar")
+		if err := tls.Put(c); err != nil {
+			panic(err)
+		}
+
+		// Each log entry has two lines. Log entries #1 and #3 report an error.
+		env.Clock.Add(time.Second)
+		for i := 0; i < 5; i++ {
+			le := tls.LogEntry(c, i)
+			le.Sequence = uint64(i * 2)
+
+			status := "ok"
+			if i%2 == 1 {
+				status = "ERROR"
+			}
+			le.GetText().Lines = append(le.GetText().Lines, &logpb.Text_Line{
+				Value:     fmt.Sprintf("status %d: %s", i, status),
+				Delimiter: "\n",
+			})
+
+			d, err := proto.Marshal(le)
+			if err != nil {
+				panic(err)
+			}
+			err = env.BigTable.Put(storage.PutRequest{
+				Project: tls.Project,
+				Path:    tls.Path,
+				Index:   types.MessageIndex(i),
+				Values:  [][]byte{d},
+			})
+			if err != nil {
+				panic(fmt.Errorf("failed to Put() LogEntry: %v", err))
+			}
+		}
+
+		req := logdog.SearchRequest{
+			Project: string(tls.Project),
+			Path:    string(tls.Path),
+			Pattern: "ERROR",
+		}
+
+		Convey(`Will fail with InvalidArgument if no pattern is supplied.`, func() {
+			req.Pattern = ""
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "invalid pattern")
+		})
+
+		Convey(`Will fail with InvalidArgument if the regular expression is invalid.`, func() {
+			req.Pattern = "(unbalanced"
+			req.Regex = true
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "invalid pattern")
+		})
+
+		Convey(`Will fail with InvalidArgument if the next value is invalid.`, func() {
+			req.Next = "not a cursor"
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "invalid next value")
+		})
+
+		Convey(`Will fail with NotFound if the log path does not exist.`, func() {
+			req.Path = "testing/+/does/not/exist"
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCNotFound)
+		})
+
+		Convey(`Will fail with InvalidArgument if the stream is not a text stream.`, func() {
+			tls = ct.MakeStream(c, "proj-foo", "testing/+/binary")
+			tls.Desc.StreamType = logpb.StreamType_BINARY
+			tls.Reload(c)
+			if err := tls.Put(c); err != nil {
+				panic(err)
+			}
+
+			req.Path = string(tls.Path)
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "not a text stream")
+		})
+
+		Convey(`Can find matching lines.`, func() {
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveMatches, "1:3", "3:7")
+			So(resp.Matches[0].Line, ShouldEqual, "status 1: ERROR")
+			So(resp.Next, ShouldEqual, "")
+		})
+
+		Convey(`Can find matching lines, ignoring case.`, func() {
+			req.Pattern = "error"
+			req.IgnoreCase = true
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveMatches, "1:3", "3:7")
+		})
+
+		Convey(`Can find lines matching a regular expression.`, func() {
+			req.Pattern = `entry #[24]$`
+			req.Regex = true
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveMatches, "2:4", "4:8")
+		})
+
+		Convey(`Treats the pattern literally if it is not a regular expression.`, func() {
+			req.Pattern = `entry #[24]$`
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveMatches)
+		})
+
+		Convey(`Will return context lines around each match.`, func() {
+			req.Context = 2
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveMatches, "1:3", "3:7")
+			So(resp.Matches[0].Before, ShouldResemble, []string{"status 0: ok", "log entry #1"})
+			So(resp.Matches[0].After, ShouldResemble, []string{"log entry #2", "status 2: ok"})
+			So(resp.Matches[1].Before, ShouldResemble, []string{"status 2: ok", "log entry #3"})
+			So(resp.Matches[1].After, ShouldResemble, []string{"log entry #4", "status 4: ok"})
+		})
+
+		Convey(`Will fail with InvalidArgument if too much context is requested.`, func() {
+			req.Context = searchContextLimit + 1
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "context must be between")
+		})
+
+		Convey(`Can page through matches.`, func() {
+			req.MaxResults = 1
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveMatches, "1:3")
+			So(resp.Next, ShouldEqual, "2:0")
+
+			req.Next = resp.Next
+			resp, err = svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveMatches, "3:7")
+			So(resp.Next, ShouldEqual, "4:0")
+
+			req.Next = resp.Next
+			resp, err = svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveMatches)
+			So(resp.Next, ShouldEqual, "")
+		})
+
+		Convey(`Can resume a search part of the way through a log entry.`, func() {
+			req.Pattern = "1"
+			req.Next = "1:1"
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveMatches, "1:3")
+		})
+
+		Convey(`Will stop scanning when its byte count is exhausted.`, func() {
+			req.ByteCount = 1
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveMatches)
+			So(resp.Next, ShouldEqual, "1:0")
+		})
+	})
+}
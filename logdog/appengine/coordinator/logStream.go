@@ -230,7 +230,7 @@ func (s *LogStream) validateImpl(enforceHashID bool) error {
 	}
 
 	switch s.StreamType {
-	case logpb.StreamType_TEXT, logpb.StreamType_BINARY, logpb.StreamType_DATAGRAM:
+	case logpb.StreamType_TEXT, logpb.StreamType_BINARY, logpb.StreamType_DATAGRAM, logpb.StreamType_JSON:
 		break
 
 	default:
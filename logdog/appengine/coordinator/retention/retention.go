@@ -0,0 +1,355 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package retention implements the Coordinator's log stream retention policy
+// enforcement.
+//
+// Projects may configure a stream retention period in their LogDog project
+// config. A periodic cleanup pass deletes the archived log streams in those
+// projects that are older than the retention period, along with their
+// intermediate storage data and archived artifacts. Log streams that haven't
+// been archived yet are left alone until they are.
+//
+// Each pass examines a page of the expired log streams, oldest first, and
+// records where it stopped so that the next pass resumes from there. Once all
+// expired log streams have been examined, the next pass starts over from the
+// oldest one, revisiting the log streams that weren't archived before.
+package retention
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/luci/luci-go/appengine/gaemiddleware"
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/gcloud/gs"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/logdog/appengine/coordinator"
+	"github.com/luci/luci-go/logdog/appengine/coordinator/config"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+	"github.com/luci/luci-go/server/router"
+
+	ds "github.com/luci/gae/service/datastore"
+
+	"golang.org/x/net/context"
+)
+
+// maxStreamsPerPass is the maximum number of expired log streams that will be
+// examined in a single project during a single cleanup pass. Any remaining
+// expired streams will be examined by subsequent passes.
+//
+// This is a variable so that it can be changed during testing.
+var maxStreamsPerPass = 500
+
+// cleanupState is the persistent state of a project's cleanup passes. There is
+// a single cleanupState entity in each project's namespace.
+type cleanupState struct {
+	_kind string `gae:"$kind,RetentionCleanupState"`
+	ID    int64  `gae:"$id,1"`
+
+	// Cursor is the query cursor to resume examining expired log streams from.
+	// If empty, the next pass starts from the oldest log stream.
+	Cursor string `gae:",noindex"`
+}
+
+// InstallHandlers installs the retention cleanup cron handler into the
+// supplied router.
+func InstallHandlers(r *router.Router, base router.MiddlewareChain) {
+	r.GET("/internal/cron/logdog/retention", base.Extend(gaemiddleware.RequireCron), cleanupCron)
+}
+
+// cleanupCron is the handler for the /internal/cron/logdog/retention GAE cron
+// task.
+func cleanupCron(c *router.Context) {
+	if _, err := Cleanup(c.Context); err != nil {
+		log.WithError(err).Errorf(c.Context, "Retention cleanup pass failed.")
+		c.Writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	c.Writer.WriteHeader(http.StatusOK)
+}
+
+// Report describes the result of a cleanup pass over a single project.
+type Report struct {
+	// Project is the name of the project.
+	Project cfgtypes.ProjectName
+	// DryRun is true if the project's retention policy is in dry-run mode. If
+	// true, nothing was deleted.
+	DryRun bool
+	// Threshold is the creation time before which log streams have expired.
+	Threshold time.Time
+
+	// Expired is the list of expired log stream paths that were deleted (or,
+	// in dry-run mode, that would have been deleted).
+	Expired []types.StreamPath
+	// Failed is the list of expired log stream paths that could not be deleted.
+	Failed []types.StreamPath
+}
+
+// Cleanup runs a cleanup pass over all active projects, enforcing each
+// project's retention policy.
+//
+// A Report is returned for each project that has a retention policy. If any
+// project could not be cleaned up, an error will be returned alongside the
+// Reports for the projects that were.
+func Cleanup(c context.Context) ([]*Report, error) {
+	projects, err := config.ActiveProjects(c)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to list active projects").Err()
+	}
+
+	var (
+		reports []*Report
+		merr    errors.MultiError
+	)
+	for _, project := range projects {
+		rep, err := cleanupProject(c, project)
+		if err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"project":    project,
+			}.Errorf(c, "Failed to clean up project.")
+			merr = append(merr, err)
+			continue
+		}
+		if rep != nil {
+			reports = append(reports, rep)
+		}
+	}
+
+	if len(merr) > 0 {
+		return reports, merr
+	}
+	return reports, nil
+}
+
+// cleanupProject runs a cleanup pass over a single project.
+//
+// If the project has no retention policy, cleanupProject will return a nil
+// Report.
+func cleanupProject(c context.Context, project cfgtypes.ProjectName) (*Report, error) {
+	if err := coordinator.WithProjectNamespace(&c, project, coordinator.NamespaceAccessNoAuth); err != nil {
+		return nil, errors.Annotate(err, "failed to enter project namespace").Err()
+	}
+
+	pcfg, err := coordinator.CurrentProjectConfig(c)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to load project config").Err()
+	}
+	retention := google.DurationFromProto(pcfg.StreamRetention)
+	if retention <= 0 {
+		return nil, nil
+	}
+
+	rep := Report{
+		Project:   project,
+		DryRun:    pcfg.StreamRetentionDryRun,
+		Threshold: clock.Now(c).Add(-retention),
+	}
+	c = log.SetFields(c, log.Fields{
+		"project":   project,
+		"dryRun":    rep.DryRun,
+		"threshold": rep.Threshold,
+	})
+
+	state := cleanupState{}
+	if err := ds.Get(c, &state); err != nil && err != ds.ErrNoSuchEntity {
+		return nil, errors.Annotate(err, "failed to load cleanup state").Err()
+	}
+
+	expired, next, err := queryExpired(c, state.Cursor, rep.Threshold)
+	if err != nil {
+		return nil, err
+	}
+	streams, err := filterArchived(c, expired)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resume from the next page in the next pass. Streams that fail to be
+	// deleted below are retried once we start over.
+	state.Cursor = next
+	if err := ds.Put(c, &state); err != nil {
+		return nil, errors.Annotate(err, "failed to save cleanup state").Err()
+	}
+	if len(streams) == 0 {
+		return &rep, nil
+	}
+
+	if rep.DryRun {
+		for _, ls := range streams {
+			rep.Expired = append(rep.Expired, ls.Path())
+			log.Fields{
+				"path":    ls.Path(),
+				"created": ls.Created,
+			}.Infof(c, "(Dry run) Log stream has expired.")
+		}
+		log.Infof(c, "(Dry run) Found %d expired log stream(s).", len(rep.Expired))
+		return &rep, nil
+	}
+
+	svc := coordinator.GetServices(c)
+	st, err := svc.IntermediateStorage(c)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to get intermediate storage").Err()
+	}
+	defer st.Close()
+
+	gsClient, err := svc.GSClient(c)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to get Google Storage client").Err()
+	}
+	defer func() {
+		if err := gsClient.Close(); err != nil {
+			log.WithError(err).Warningf(c, "Failed to close Google Storage client.")
+		}
+	}()
+
+	for _, ls := range streams {
+		if err := deleteStream(c, project, st, gsClient, ls); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       ls.Path(),
+			}.Errorf(c, "Failed to delete expired log stream.")
+			rep.Failed = append(rep.Failed, ls.Path())
+			continue
+		}
+		rep.Expired = append(rep.Expired, ls.Path())
+	}
+	log.Infof(c, "Deleted %d expired log stream(s) (%d failed).", len(rep.Expired), len(rep.Failed))
+	return &rep, nil
+}
+
+// queryExpired returns up to maxStreamsPerPass log streams created before
+// threshold, oldest first, resuming from cursor.
+//
+// It also returns the cursor to resume from in the next pass. If there are no
+// more expired log streams, it is empty.
+func queryExpired(c context.Context, cursor string, threshold time.Time) (
+	[]*coordinator.LogStream, string, error) {
+
+	// The query doesn't filter on the threshold, which changes between passes,
+	// so that the cursor remains valid for it.
+	q := ds.NewQuery("LogStream").Order("Created")
+	if cursor != "" {
+		cur, err := ds.DecodeCursor(c, cursor)
+		if err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"cursor":     cursor,
+			}.Warningf(c, "Failed to decode cursor, starting from the oldest log stream.")
+		} else {
+			q = q.Start(cur)
+		}
+	}
+
+	var (
+		expired []*coordinator.LogStream
+		next    ds.Cursor
+	)
+	err := ds.Run(c, q, func(ls *coordinator.LogStream, cb ds.CursorCB) error {
+		if !ls.Created.Before(threshold) {
+			return ds.Stop
+		}
+
+		expired = append(expired, ls)
+		if len(expired) == maxStreamsPerPass {
+			var err error
+			if next, err = cb(); err != nil {
+				return err
+			}
+			return ds.Stop
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", errors.Annotate(err, "failed to query expired log streams").Err()
+	}
+
+	if next == nil {
+		return expired, "", nil
+	}
+	return expired, next.String(), nil
+}
+
+// filterArchived returns the supplied log streams that have been archived.
+//
+// Log streams that are still streaming or awaiting archival may be written to
+// or archived while they are deleted, so they are skipped. Log streams without
+// state can never be archived, so they are returned.
+func filterArchived(c context.Context, streams []*coordinator.LogStream) ([]*coordinator.LogStream, error) {
+	states := make([]*coordinator.LogStreamState, len(streams))
+	for i, ls := range streams {
+		states[i] = ls.State(c)
+	}
+
+	err := ds.Get(c, states)
+	merr, ok := err.(errors.MultiError)
+	if !ok && err != nil {
+		return nil, errors.Annotate(err, "failed to load log stream states").Err()
+	}
+
+	archived := make([]*coordinator.LogStream, 0, len(streams))
+	for i, ls := range streams {
+		err = nil
+		if merr != nil {
+			err = merr[i]
+		}
+
+		switch err {
+		case nil:
+			if !states[i].ArchivalState().Archived() {
+				continue
+			}
+		case ds.ErrNoSuchEntity:
+			break
+		default:
+			return nil, errors.Annotate(err, "failed to load log stream state").Err()
+		}
+
+		archived = append(archived, ls)
+	}
+	return archived, nil
+}
+
+// deleteStream deletes a single log stream's data, archived artifacts, and
+// datastore entities.
+//
+// The datastore entities are deleted last, so that a stream whose data could
+// not be deleted will be found again by a subsequent cleanup pass.
+func deleteStream(c context.Context, project cfgtypes.ProjectName, st coordinator.Storage, gsClient gs.Client,
+	ls *coordinator.LogStream) error {
+
+	lst := ls.State(c)
+	switch err := ds.Get(c, lst); err {
+	case nil:
+		for _, u := range []string{lst.ArchiveIndexURL, lst.ArchiveStreamURL, lst.ArchiveDataURL} {
+			if u == "" {
+				continue
+			}
+			if err := gsClient.Delete(gs.Path(u)); err != nil {
+				return errors.Annotate(err, "failed to delete archive object %q", u).Err()
+			}
+		}
+
+	case ds.ErrNoSuchEntity:
+		// The stream has no state, so it has no archived artifacts.
+		break
+
+	default:
+		return errors.Annotate(err, "failed to load log stream state").Err()
+	}
+
+	if err := st.Purge(project, ls.Path()); err != nil {
+		return errors.Annotate(err, "failed to purge intermediate storage").Err()
+	}
+
+	if err := ds.Delete(c, lst, ls); err != nil {
+		return errors.Annotate(err, "failed to delete log stream entities").Err()
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/gcloud/gs"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/logdog/api/config/svcconfig"
+	ct "github.com/luci/luci-go/logdog/appengine/coordinator/coordinatorTest"
+	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/types"
+
+	ds "github.com/luci/gae/service/datastore"
+
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCleanup(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a testing configuration`, t, func() {
+		c, env := ct.Install()
+
+		// Register two archived streams and a streaming stream, then advance the
+		// clock and register a newer stream.
+		makeArchived := func(name string) *ct.TestStream {
+			ts := ct.MakeStream(c, "proj-foo", types.StreamPath("testing/+/"+name))
+			ts.State.TerminalIndex = 0
+			ts.State.ArchivedTime = env.Clock.Now()
+			ts.State.ArchiveIndexURL = "gs://bucket/" + name + "/index"
+			ts.State.ArchiveStreamURL = "gs://bucket/" + name + "/stream"
+			ts.State.ArchiveDataURL = "gs://bucket/" + name + "/data"
+			So(ts.Put(c), ShouldBeNil)
+			for _, u := range []string{"index", "stream", "data"} {
+				env.GSClient.Put(gs.Path("gs://bucket/"+name+"/"+u), []byte(u))
+			}
+			return ts
+		}
+		archived := makeArchived("archived")
+		env.Clock.Add(time.Minute)
+		archived2 := makeArchived("archived2")
+		env.Clock.Add(time.Minute)
+
+		streaming := ct.MakeStream(c, "proj-foo", "testing/+/streaming")
+		So(streaming.Put(c), ShouldBeNil)
+		So(env.BigTable.Put(storage.PutRequest{
+			Project: streaming.Project,
+			Path:    streaming.Path,
+			Index:   0,
+			Values:  [][]byte{[]byte("log data")},
+		}), ShouldBeNil)
+
+		env.Clock.Add(2 * time.Hour)
+		recent := ct.MakeStream(c, "proj-foo", "testing/+/recent")
+		So(recent.Put(c), ShouldBeNil)
+
+		env.Clock.Add(time.Hour)
+		ds.GetTestable(c).CatchupIndexes()
+
+		exists := func(ts *ct.TestStream) (exists bool) {
+			ts.WithProjectNamespace(c, func(c context.Context) {
+				switch err := ds.Get(c, ts.Stream); err {
+				case nil:
+					exists = true
+				case ds.ErrNoSuchEntity:
+					exists = false
+				default:
+					panic(err)
+				}
+			})
+			return
+		}
+
+		Convey(`Does nothing if the project has no retention policy.`, func() {
+			rep, err := cleanupProject(c, "proj-foo")
+			So(err, ShouldBeNil)
+			So(rep, ShouldBeNil)
+		})
+
+		Convey(`With a 2-hour retention policy`, func() {
+			env.ModProjectConfig(c, "proj-foo", func(pcfg *svcconfig.ProjectConfig) {
+				pcfg.StreamRetention = google.NewDuration(2 * time.Hour)
+			})
+
+			Convey(`Will delete expired archived streams, oldest first, and their data.`, func() {
+				rep, err := cleanupProject(c, "proj-foo")
+				So(err, ShouldBeNil)
+				So(rep.DryRun, ShouldBeFalse)
+				So(rep.Expired, ShouldResemble, []types.StreamPath{archived.Path, archived2.Path})
+				So(rep.Failed, ShouldBeNil)
+
+				So(exists(archived), ShouldBeFalse)
+				So(exists(archived2), ShouldBeFalse)
+				So(exists(recent), ShouldBeTrue)
+				So(env.GSClient, ShouldHaveLength, 0)
+
+				// The expired stream that hasn't been archived is left alone.
+				So(exists(streaming), ShouldBeTrue)
+				_, err = env.BigTable.Tail(streaming.Project, streaming.Path)
+				So(err, ShouldBeNil)
+			})
+
+			Convey(`Will page through expired streams across passes.`, func() {
+				defer func(v int) { maxStreamsPerPass = v }(maxStreamsPerPass)
+				maxStreamsPerPass = 1
+
+				// Make the unarchived stream the oldest one.
+				streaming.Stream.Created = archived.Stream.Created.Add(-time.Minute)
+				So(streaming.Put(c), ShouldBeNil)
+				ds.GetTestable(c).CatchupIndexes()
+
+				pass := func() []types.StreamPath {
+					rep, err := cleanupProject(c, "proj-foo")
+					So(err, ShouldBeNil)
+					So(rep.Failed, ShouldBeNil)
+					ds.GetTestable(c).CatchupIndexes()
+					return rep.Expired
+				}
+
+				// The unarchived stream doesn't block the streams after it.
+				So(pass(), ShouldBeNil)
+				So(pass(), ShouldResemble, []types.StreamPath{archived.Path})
+				So(pass(), ShouldResemble, []types.StreamPath{archived2.Path})
+				So(exists(streaming), ShouldBeTrue)
+
+				// Once all expired streams have been examined, we start over.
+				So(pass(), ShouldBeNil)
+				streaming.State.TerminalIndex = 0
+				streaming.State.ArchivedTime = env.Clock.Now()
+				So(streaming.Put(c), ShouldBeNil)
+				ds.GetTestable(c).CatchupIndexes()
+
+				So(pass(), ShouldResemble, []types.StreamPath{streaming.Path})
+				So(exists(streaming), ShouldBeFalse)
+				So(exists(recent), ShouldBeTrue)
+			})
+
+			Convey(`In dry-run mode, will only report expired streams.`, func() {
+				env.ModProjectConfig(c, "proj-foo", func(pcfg *svcconfig.ProjectConfig) {
+					pcfg.StreamRetentionDryRun = true
+				})
+
+				rep, err := cleanupProject(c, "proj-foo")
+				So(err, ShouldBeNil)
+				So(rep.DryRun, ShouldBeTrue)
+				So(rep.Expired, ShouldResemble, []types.StreamPath{archived.Path, archived2.Path})
+
+				So(exists(archived), ShouldBeTrue)
+				So(exists(archived2), ShouldBeTrue)
+				So(exists(streaming), ShouldBeTrue)
+
+				So(env.GSClient, ShouldHaveLength, 6)
+				_, err = env.BigTable.Tail(streaming.Project, streaming.Path)
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
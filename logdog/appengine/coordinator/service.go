@@ -5,6 +5,7 @@
 package coordinator
 
 import (
+	"os"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -21,6 +22,7 @@ import (
 	"github.com/luci/luci-go/logdog/common/storage/archive"
 	"github.com/luci/luci-go/logdog/common/storage/bigtable"
 	"github.com/luci/luci-go/logdog/common/storage/caching"
+	"github.com/luci/luci-go/logdog/common/storage/local"
 	"github.com/luci/luci-go/luci_config/common/cfgtypes"
 	"github.com/luci/luci-go/server/auth"
 	"github.com/luci/luci-go/server/router"
@@ -50,6 +52,21 @@ const (
 	maxGSFetchSize = int64(8 * 1024 * 1024)
 )
 
+// LocalStoragePath, if not empty, is the path of a local storage directory to
+// use for intermediate storage instead of the configured BigTable storage. It
+// should be the same directory as the backend services' "-local-storage-path".
+//
+// It defaults to the value of the LOGDOG_LOCAL_STORAGE_PATH environment
+// variable, and is intended for development and single-machine deployments.
+var LocalStoragePath = os.Getenv("LOGDOG_LOCAL_STORAGE_PATH")
+
+// localStorage is the local intermediate storage instance. It is shared by all
+// requests, and is created on first use.
+var localStorage struct {
+	sync.Mutex
+	st *local.Storage
+}
+
 // Services is a set of support services used by Coordinator.
 //
 // Each Services instance is valid for a singel request, but can be re-used
@@ -81,6 +98,17 @@ type Services interface {
 	// The caller must close the returned instance if successful.
 	StorageForStream(context.Context, *LogStreamState) (Storage, error)
 
+	// IntermediateStorage returns a Storage instance bound to intermediate
+	// storage, regardless of any stream's archival state.
+	//
+	// The caller must close the returned instance if successful.
+	IntermediateStorage(context.Context) (Storage, error)
+
+	// GSClient returns a Google Storage client with read/write access.
+	//
+	// The caller must close the returned client if successful.
+	GSClient(context.Context) (gs.Client, error)
+
 	// ArchivalPublisher returns an ArchivalPublisher instance.
 	ArchivalPublisher(context.Context) (ArchivalPublisher, error)
 }
@@ -203,7 +231,7 @@ func (s *prodServicesInst) ProjectConfig(c context.Context, project cfgtypes.Pro
 func (s *prodServicesInst) StorageForStream(c context.Context, lst *LogStreamState) (Storage, error) {
 	if !lst.ArchivalState().Archived() {
 		log.Debugf(c, "Log is not archived. Fetching from intermediate storage.")
-		return s.newBigTableStorage(c)
+		return s.IntermediateStorage(c)
 	}
 
 	log.Fields{
@@ -214,6 +242,17 @@ func (s *prodServicesInst) StorageForStream(c context.Context, lst *LogStreamSta
 	return s.newGoogleStorage(c, gs.Path(lst.ArchiveIndexURL), gs.Path(lst.ArchiveStreamURL))
 }
 
+func (s *prodServicesInst) IntermediateStorage(c context.Context) (Storage, error) {
+	if LocalStoragePath != "" {
+		return s.newLocalStorage(c)
+	}
+	return s.newBigTableStorage(c)
+}
+
+func (s *prodServicesInst) GSClient(c context.Context) (gs.Client, error) {
+	return s.newGSClient(c, gs.ReadWriteScopes)
+}
+
 func (s *prodServicesInst) newBigTableStorage(c context.Context) (Storage, error) {
 	cfg, err := s.Config(c)
 	if err != nil {
@@ -281,8 +320,31 @@ func (s *prodServicesInst) newBigTableStorage(c context.Context) (Storage, error
 	}, nil
 }
 
+// newLocalStorage returns the shared local intermediate storage instance,
+// creating it if necessary. Closing the returned instance does nothing.
+func (s *prodServicesInst) newLocalStorage(c context.Context) (Storage, error) {
+	localStorage.Lock()
+	defer localStorage.Unlock()
+
+	if localStorage.st == nil {
+		log.Fields{
+			"path": LocalStoragePath,
+		}.Infof(c, "Using local intermediate storage.")
+
+		st, err := local.New(local.Options{Dir: LocalStoragePath})
+		if err != nil {
+			log.WithError(err).Errorf(c, "Failed to create local storage instance.")
+			return nil, err
+		}
+		localStorage.st = st
+	}
+	return &sharedLocalStorage{
+		Storage: localStorage.st,
+	}, nil
+}
+
 func (s *prodServicesInst) newGoogleStorage(c context.Context, index, stream gs.Path) (Storage, error) {
-	gs, err := s.newGSClient(c)
+	gs, err := s.newGSClient(c, gs.ReadOnlyScopes)
 	if err != nil {
 		log.WithError(err).Errorf(c, "Failed to create Google Storage client.")
 		return nil, err
@@ -317,10 +379,10 @@ func (s *prodServicesInst) newGoogleStorage(c context.Context, index, stream gs.
 	return rv, nil
 }
 
-func (s *prodServicesInst) newGSClient(c context.Context) (gs.Client, error) {
+func (s *prodServicesInst) newGSClient(c context.Context, scopes []string) (gs.Client, error) {
 	// Get an Authenticator bound to the token scopes that we need for
 	// authenticated Cloud Storage access.
-	transport, err := auth.GetRPCTransport(c, auth.AsSelf, auth.WithScopes(gs.ReadOnlyScopes...))
+	transport, err := auth.GetRPCTransport(c, auth.AsSelf, auth.WithScopes(scopes...))
 	if err != nil {
 		log.WithError(err).Errorf(c, "Failed to create Cloud Storage transport.")
 		return nil, errors.New("failed to create Cloud Storage transport")
@@ -479,6 +541,19 @@ func (*bigTableStorage) GetSignedURLs(context.Context, *URLSigningRequest) (*URL
 	return nil, nil
 }
 
+// sharedLocalStorage is a Storage instance bound to the shared local storage.
+// Closing it does nothing.
+type sharedLocalStorage struct {
+	// Storage is the base storage.Storage instance.
+	storage.Storage
+}
+
+func (*sharedLocalStorage) Close() {}
+
+func (*sharedLocalStorage) GetSignedURLs(context.Context, *URLSigningRequest) (*URLSigningResponse, error) {
+	return nil, nil
+}
+
 type googleStorage struct {
 	// Storage is the base storage.Storage instance.
 	storage.Storage
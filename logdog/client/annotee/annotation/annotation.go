@@ -5,6 +5,7 @@
 package annotation
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -68,7 +69,11 @@ type State struct {
 	// stepMap is a map of step name to Step instance.
 	//
 	// If stepMap is nil, the State is considered uninitialized.
-	stepMap    map[string]*Step
+	stepMap map[string]*Step
+	// stepIDMap is a map of step ID to Step instance. Only steps that were
+	// created with an ID are included. Unlike stepMap, steps remain in stepIDMap
+	// after they are closed, so their IDs cannot be reused.
+	stepIDMap  map[string]*Step
 	latestStep *Step
 	rootStep   Step
 	// stepCursor is the current cursor step name. This will always point to a
@@ -95,13 +100,14 @@ func (s *State) initialize() {
 	}
 
 	s.stepMap = map[string]*Step{}
+	s.stepIDMap = map[string]*Step{}
 	s.stepLookup = map[*milo.Step]*Step{}
 
 	name := "steps"
 	if s.Execution != nil {
 		name = s.Execution.Name
 	}
-	s.rootStep.initializeStep(s, nil, name, false)
+	s.rootStep.initializeStep(s, nil, "", name, false)
 	s.rootStep.LogNameBase = s.LogNameBase
 	s.SetCurrentStep(nil)
 
@@ -202,6 +208,47 @@ func (s *State) Append(annotation string) error {
 		s.SetCurrentStep(step)
 		updatedIf(step, UpdateStructural, true)
 
+	// @@@STEP_CREATE@<id>@<parent-id>@<stepname>@@@
+	case "STEP_CREATE":
+		// Unlike BUILD_STEP, this creates a step with an explicit ID and parent,
+		// and does not close any other steps. This allows steps to run
+		// concurrently, with annotations directed at each one using STEP_SELECT.
+		parts := strings.SplitN(params, "@", 3)
+		if len(parts) != 3 {
+			return fmt.Errorf("STEP_CREATE parameter %q must be <id>@<parent-id>@<name>", params)
+		}
+		id, parentID, name := parts[0], parts[1], parts[2]
+		if id == "" {
+			return errors.New("STEP_CREATE requires a step ID")
+		}
+		if s.LookupStepID(id) != nil {
+			return fmt.Errorf("STEP_CREATE step ID %q is already in use", id)
+		}
+
+		parent := &s.rootStep
+		if parentID != "" {
+			var err error
+			if parent, err = s.LookupStepIDErr(parentID); err != nil {
+				return fmt.Errorf("STEP_CREATE could not lookup parent step: %s", err)
+			}
+			if parent.closed {
+				return fmt.Errorf("STEP_CREATE parent step %q is closed", parentID)
+			}
+		}
+
+		step := parent.AddStepWithID(id, name)
+		step.Start(annotatedNow)
+		s.SetCurrentStep(step)
+		updatedIf(step, UpdateStructural, true)
+
+	// @@@STEP_SELECT@<id>@@@
+	case "STEP_SELECT":
+		step, err := s.LookupStepIDErr(params)
+		if err != nil {
+			return fmt.Errorf("STEP_SELECT could not lookup step: %s", err)
+		}
+		s.SetCurrentStep(step)
+
 	//  @@@SEED_STEP <stepname>@@@
 	case "SEED_STEP":
 		step := s.LookupStep(params)
@@ -362,6 +409,24 @@ func (s *State) Append(annotation string) error {
 		}
 		updatedIf(step, UpdateIterative, step.SetProperty(parts[0], parts[1]))
 
+	// @@@SET_STEP_PROPERTY@<name>@<json>@@@
+	case "SET_STEP_PROPERTY":
+		step := s.CurrentStep()
+		parts := strings.SplitN(params, "@", 2)
+		if len(parts) == 1 {
+			parts = append(parts, "")
+		}
+		updatedIf(step, UpdateIterative, step.SetStepProperty(parts[0], parts[1]))
+
+	// @@@STEP_TAG@<key>@<value>@@@
+	case "STEP_TAG":
+		step := s.CurrentStep()
+		parts := strings.SplitN(params, "@", 2)
+		if len(parts) == 1 {
+			parts = append(parts, "")
+		}
+		updatedIf(step, UpdateIterative, step.SetTag(parts[0], parts[1]))
+
 		// @@@STEP_TRIGGER@<spec>@@@
 	case "STEP_TRIGGER":
 		// Annotee will stop short of sending an actual request to BuildBucket.
@@ -447,6 +512,19 @@ func (s *State) LookupStepErr(name string) (*Step, error) {
 	return nil, fmt.Errorf("no step named %q", name)
 }
 
+// LookupStepID returns the step with the supplied ID, or nil if no such step
+// exists.
+func (s *State) LookupStepID(id string) *Step { return s.stepIDMap[id] }
+
+// LookupStepIDErr returns the step with the supplied ID, or an error if no
+// such step exists.
+func (s *State) LookupStepIDErr(id string) (*Step, error) {
+	if as := s.LookupStepID(id); as != nil {
+		return as, nil
+	}
+	return nil, fmt.Errorf("no step with ID %q", id)
+}
+
 // ResolveStep returns the annotation package *Step corresponding to the
 // supplied *milo.Step. This is a reverse lookup operation.
 //
@@ -484,6 +562,9 @@ func (s *State) SetCurrentStep(v *Step) {
 
 func (s *State) registerStep(as *Step) {
 	s.stepMap[as.Name()] = as
+	if as.Id != "" {
+		s.stepIDMap[as.Id] = as
+	}
 	s.stepLookup[&as.Step] = as
 
 	if latest := s.latestStep; latest != nil {
@@ -570,10 +651,11 @@ type Step struct {
 
 func (as *Step) String() string { return string(as.LogNameBase) }
 
-func (as *Step) initializeStep(s *State, parent *Step, name string, legacy bool) *Step {
+func (as *Step) initializeStep(s *State, parent *Step, id, name string, legacy bool) *Step {
 	t := milo.Status_RUNNING
 	as.Step = milo.Step{
 		Name:   name,
+		Id:     id,
 		Status: t,
 	}
 
@@ -650,7 +732,13 @@ func (as *Step) BaseStream(name types.StreamName) types.StreamName {
 
 // AddStep generates a new substep.
 func (as *Step) AddStep(name string, legacy bool) *Step {
-	return (&Step{}).initializeStep(as.s, as, name, legacy)
+	return (&Step{}).initializeStep(as.s, as, "", name, legacy)
+}
+
+// AddStepWithID generates a new substep with an explicit ID. The ID must not
+// be used by any other step in the State.
+func (as *Step) AddStepWithID(id, name string) *Step {
+	return (&Step{}).initializeStep(as.s, as, id, name, false)
 }
 
 func (as *Step) regenerateLogPath() {
@@ -928,6 +1016,38 @@ func (as *Step) SetProperty(name, value string) bool {
 	return true
 }
 
+// SetStepProperty sets a key/value property that describes this Step only.
+// Unlike SetProperty, this is not a build property.
+func (as *Step) SetStepProperty(name, value string) bool {
+	for _, p := range as.StepProperty {
+		if p.Name == name {
+			if p.Value == value {
+				return false
+			}
+			p.Value = value
+			return true
+		}
+	}
+
+	as.StepProperty = append(as.StepProperty, &milo.Step_Property{
+		Name:  name,
+		Value: value,
+	})
+	return true
+}
+
+// SetTag sets a key/value tag for this Step.
+func (as *Step) SetTag(key, value string) bool {
+	if cur, ok := as.Tags[key]; ok && cur == value {
+		return false
+	}
+	if as.Tags == nil {
+		as.Tags = make(map[string]string)
+	}
+	as.Tags[key] = value
+	return true
+}
+
 // SetSTDOUTStream sets the LogDog STDOUT stream value, returning true if the
 // Step was updated.
 func (as *Step) SetSTDOUTStream(st *milo.LogdogStream) (updated bool) {
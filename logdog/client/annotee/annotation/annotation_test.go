@@ -269,6 +269,7 @@ func TestState(t *testing.T) {
 		{"coverage", nil},
 		{"nested", nil},
 		{"legacy", nil},
+		{"ids", nil},
 	}
 
 	if *generate {
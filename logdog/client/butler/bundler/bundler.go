@@ -14,6 +14,7 @@ import (
 	"github.com/luci/luci-go/common/proto/google"
 	"github.com/luci/luci-go/common/sync/cancelcond"
 	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/client/butler/output"
 	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 	"github.com/luci/luci-go/logdog/common/types"
 	"github.com/luci/luci-go/luci_config/common/cfgtypes"
@@ -46,6 +47,16 @@ type Config struct {
 	// bundled data. Other factors can cause the bundle to be sent before this,
 	// but it is an upper bound.
 	MaxBufferDelay time.Duration
+
+	// StreamRateLimit is the rate limit that applies to each individual stream.
+	StreamRateLimit RateLimit
+	// GlobalRateLimit is the rate limit that applies to all streams together.
+	GlobalRateLimit RateLimit
+	// StreamByteQuota, if >0, is the maximum number of log data bytes that a
+	// single stream may output. Log entries beyond it are dropped.
+	StreamByteQuota int64
+	// LimitMode is how rate limits are enforced.
+	LimitMode LimitMode
 }
 
 type bundlerStream interface {
@@ -77,6 +88,12 @@ type Bundler struct {
 
 	// prefixCounter is a global counter for Prefix-wide streams.
 	prefixCounter counter
+
+	// globalLimiter is the rate limiter shared by all streams. It is nil if
+	// there is no global rate limit.
+	globalLimiter *rateLimiter
+	// limitStats tracks the data dropped due to the Bundler's limits.
+	limitStats limitStats
 }
 
 // New instantiates a new Bundler instance.
@@ -86,6 +103,8 @@ func New(c Config) *Bundler {
 		finishedC: make(chan struct{}),
 		bundleC:   make(chan *logpb.ButlerLogBundle),
 		streams:   map[string]bundlerStream{},
+
+		globalLimiter: newRateLimiter(c.GlobalRateLimit),
 	}
 	b.streamsCond = cancelcond.New(&b.streamsLock)
 
@@ -122,8 +141,19 @@ func (b *Bundler) Register(p *streamproto.Properties) (Stream, error) {
 		},
 	}
 
+	if b.c.StreamRateLimit.enabled() || b.globalLimiter != nil || b.c.StreamByteQuota > 0 {
+		c.limits = &streamLimits{
+			clock:    b.getClock(),
+			truncate: b.c.LimitMode == LimitTruncate && truncatable(p.StreamType),
+			quota:    b.c.StreamByteQuota,
+			stream:   newRateLimiter(b.c.StreamRateLimit),
+			global:   b.globalLimiter,
+			stats:    &b.limitStats,
+		}
+	}
+
 	err := error(nil)
-	c.parser, err = newParser(p, &b.prefixCounter)
+	c.parser, err = newParser(p, &b.prefixCounter, b.c.MaxBundleSize)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create stream parser: %s", err)
 	}
@@ -174,6 +204,12 @@ func (b *Bundler) CloseAndFlush() {
 	<-b.finishedC
 }
 
+// Stats returns statistics about the data that the Bundler has dropped due to
+// its rate limits and quotas.
+func (b *Bundler) Stats() output.Stats {
+	return b.limitStats.stats()
+}
+
 // Next returns the next bundle, blocking until it is available.
 func (b *Bundler) Next() *logpb.ButlerLogBundle {
 	return <-b.bundleC
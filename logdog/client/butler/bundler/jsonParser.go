@@ -0,0 +1,169 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bundler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/types"
+)
+
+// jsonMaxLineSize returns the maximum size of a JSON line whose fields are
+// indexed, given the maximum bundle size.
+//
+// A line's fields are about as large as the line itself, and are added to its
+// final segment. Capping lines at a quarter of the bundle size leaves room for
+// both in a single bundle.
+func jsonMaxLineSize(maxBundleSize int) int {
+	if maxBundleSize > 0 && maxBundleSize/4 < types.MaxLogEntryDataSize {
+		return maxBundleSize / 4
+	}
+	return types.MaxLogEntryDataSize
+}
+
+// jsonParser is a parser implementation for the LogDog JSON stream type.
+//
+// JSON streams are split into lines exactly like TEXT streams. Each complete
+// line should be a JSON object (or blank), and its fields are indexed in the
+// Fields of the line's final segment. Lines that aren't valid JSON objects are
+// still emitted, but without fields.
+type jsonParser struct {
+	textParser
+
+	// maxLineSize is the maximum size of a single line whose fields are indexed.
+	// Larger lines are emitted without fields.
+	maxLineSize int
+
+	// line is the content of the current line, accumulated across its partial
+	// segments.
+	line bytes.Buffer
+	// oversize is true if the current line exceeds maxLineSize. Its content is
+	// no longer accumulated.
+	oversize bool
+}
+
+var _ parser = (*jsonParser)(nil)
+
+func (p *jsonParser) nextEntry(c *constraints) (*logpb.LogEntry, error) {
+	// A line's fields are added to its final segment in addition to its text,
+	// and are about as large as the line. Reserve room for the part of the
+	// current line that we've already seen, and half of what is left for the
+	// rest of it.
+	tc := *c
+	tc.limit = (c.limit - p.line.Len()) / 2
+
+	le, err := p.textParser.nextEntry(&tc)
+	if err != nil || le == nil {
+		return le, err
+	}
+
+	lines := le.GetText().Lines
+	for i, line := range lines {
+		if !p.oversize {
+			if p.line.Len()+len(line.Value) > p.maxLineSize {
+				p.oversize = true
+				p.line.Reset()
+			} else {
+				p.line.WriteString(line.Value)
+			}
+		}
+
+		// An undelimited segment is a partial line, unless it is the last line of a
+		// closed stream.
+		if line.Delimiter == "" && !(c.closed && i == len(lines)-1 && p.Len() == 0) {
+			continue
+		}
+
+		// A line that can't be indexed is emitted as plain text. Failing the
+		// stream would lose everything that follows it.
+		if !p.oversize {
+			if fields, err := parseJSONFields(p.line.Bytes()); err == nil {
+				line.Fields = fields
+			}
+		}
+		p.line.Reset()
+		p.oversize = false
+	}
+
+	// Fields can still be larger than our estimate (e.g., nested objects repeat
+	// their keys' prefixes). Emit lines without them rather than exceed our
+	// limit, starting with the last.
+	for i := len(lines) - 1; i >= 0 && protoSize(le) > c.limit; i-- {
+		lines[i].Fields = nil
+	}
+	return le, nil
+}
+
+// parseJSONFields parses a JSON-lines line into its flattened fields.
+//
+// A blank line has no fields.
+func parseJSONFields(line []byte) (map[string]string, error) {
+	if len(bytes.TrimSpace(line)) == 0 {
+		return nil, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(line))
+	dec.UseNumber()
+
+	var obj map[string]interface{}
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, errors.New("not a JSON object")
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("trailing data after JSON object")
+	}
+
+	fields := make(map[string]string, len(obj))
+	if err := flattenJSONFields(fields, "", obj); err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+// flattenJSONFields adds the values in obj to fields. Nested objects are
+// flattened, joining their keys with ".".
+func flattenJSONFields(fields map[string]string, prefix string, obj map[string]interface{}) error {
+	for k, v := range obj {
+		key := prefix + k
+
+		switch t := v.(type) {
+		case map[string]interface{}:
+			if err := flattenJSONFields(fields, key+".", t); err != nil {
+				return err
+			}
+
+		case string:
+			fields[key] = t
+
+		case json.Number:
+			fields[key] = t.String()
+
+		case bool:
+			fields[key] = strconv.FormatBool(t)
+
+		case nil:
+			fields[key] = "null"
+
+		default:
+			d, err := json.Marshal(t)
+			if err != nil {
+				return err
+			}
+			fields[key] = string(d)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bundler
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestJSONParser(t *testing.T) {
+	Convey(`Using a parser test stream`, t, func() {
+		s := &parserTestStream{
+			now:         time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+			prefixIndex: 1337,
+		}
+		p := &jsonParser{
+			textParser: textParser{
+				baseParser: s.base(),
+			},
+			maxLineSize: 1024,
+		}
+		c := &constraints{
+			limit: 2048,
+		}
+
+		Convey(`Indexes the flattened fields of each line.`, func() {
+			p.Append(dstr(s.now, `{"level": "error", "n": 1.5, "ok": true, "x": null, "req": {"id": 42, "tags": ["a", "b"]}}`+"\n\n"))
+
+			le, err := p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le.GetText().Lines, ShouldHaveLength, 2)
+			So(le.GetText().Lines[0].Fields, ShouldResemble, map[string]string{
+				"level":    "error",
+				"n":        "1.5",
+				"ok":       "true",
+				"x":        "null",
+				"req.id":   "42",
+				"req.tags": `["a","b"]`,
+			})
+			So(le.GetText().Lines[1].Fields, ShouldBeNil)
+		})
+
+		Convey(`Indexes a split line's fields in its final segment.`, func() {
+			c.allowSplit = true
+			c.limit = 60
+			p.Append(dstr(s.now, `{"level": "warn", "msg": "split in two"}`+"\n"))
+
+			le, err := p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, shouldMatchLogEntry, s.le(0, logpb.Text{
+				Lines: []*logpb.Text_Line{{Value: `{"level": "warn", "msg": "spli`}},
+			}))
+
+			le, err = p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, shouldMatchLogEntry, s.le(0, logpb.Text{
+				Lines: []*logpb.Text_Line{{
+					Value:     `t in two"}`,
+					Delimiter: "\n",
+					Fields:    map[string]string{"level": "warn", "msg": "split in two"},
+				}},
+			}))
+		})
+
+		Convey(`Indexes an undelimited final line when closed.`, func() {
+			c.allowSplit, c.closed = true, true
+			p.Append(dstr(s.now, `{"a": "b"}`))
+
+			le, err := p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le.GetText().Lines[0].Fields, ShouldResemble, map[string]string{"a": "b"})
+		})
+
+		Convey(`Emits a line that is not a JSON object without fields.`, func() {
+			for _, line := range []string{`not JSON`, `[1, 2]`, `null`, `{"a": 1} {"b": 2}`} {
+				p.Append(dstr(s.now, line+"\n"+`{"a": "b"}`+"\n"))
+
+				le, err := p.nextEntry(c)
+				So(err, ShouldBeNil)
+				So(le.GetText().Lines, ShouldResemble, []*logpb.Text_Line{
+					{Value: line, Delimiter: "\n"},
+					{Value: `{"a": "b"}`, Delimiter: "\n", Fields: map[string]string{"a": "b"}},
+				})
+			}
+		})
+
+		Convey(`Emits a line that is too large without fields.`, func() {
+			c.allowSplit = true
+			c.limit = 60
+			p.maxLineSize = 12
+			p.Append(dstr(s.now, `{"a": "bcdefghijklmnopqrstuvwxyz"}`+"\n"+`{"a": "b"}`+"\n"))
+
+			le, err := p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le.GetText().Lines, ShouldResemble, []*logpb.Text_Line{{Value: `{"a": "bcdefghijklmnopqrstuvwx`}})
+
+			// The next line is indexed again.
+			le, err = p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le.GetText().Lines, ShouldResemble, []*logpb.Text_Line{
+				{Value: `yz"}`, Delimiter: "\n"},
+				{Value: `{"a": "b"}`, Delimiter: "\n", Fields: map[string]string{"a": "b"}},
+			})
+		})
+
+		Convey(`Keeps a line near the limit within it.`, func() {
+			c.allowSplit = true
+			c.limit = 260
+
+			// Nested keys repeat their prefixes, so these fields are larger than the
+			// line itself.
+			line := `{"nested_object_key": {`
+			for i := 0; i < 4; i++ {
+				if i > 0 {
+					line += ", "
+				}
+				line += fmt.Sprintf(`"k%d": "%s"`, i, strings.Repeat("v", 8))
+			}
+			line += `}}`
+			p.Append(dstr(s.now, line+"\n"))
+
+			var les []*logpb.LogEntry
+			for {
+				le, err := p.nextEntry(c)
+				So(err, ShouldBeNil)
+				if le == nil {
+					break
+				}
+				So(protoSize(le), ShouldBeLessThanOrEqualTo, c.limit)
+				les = append(les, le)
+			}
+			lines := les[len(les)-1].GetText().Lines
+			So(lines[len(lines)-1].Fields, ShouldHaveLength, 4)
+
+			Convey(`Emits the line without fields if they don't fit.`, func() {
+				c.limit = 200
+				p.Append(dstr(s.now, line+"\n"))
+
+				le, err := p.nextEntry(c)
+				So(err, ShouldBeNil)
+				So(protoSize(le), ShouldBeLessThanOrEqualTo, c.limit)
+				So(le.GetText().Lines, ShouldResemble, []*logpb.Text_Line{{Value: line, Delimiter: "\n"}})
+			})
+		})
+	})
+}
+
+func TestJSONMaxLineSize(t *testing.T) {
+	Convey(`The JSON line size is capped by the bundle size.`, t, func() {
+		So(jsonMaxLineSize(0), ShouldEqual, types.MaxLogEntryDataSize)
+		So(jsonMaxLineSize(4*1024*1024), ShouldEqual, 1024*1024)
+		So(jsonMaxLineSize(1024*1024*1024), ShouldEqual, types.MaxLogEntryDataSize)
+	})
+}
@@ -0,0 +1,275 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bundler
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/client/butler/output"
+)
+
+// LimitMode describes how a Bundler enforces its rate limits.
+type LimitMode int
+
+const (
+	// LimitBlock holds a stream's data until it can be output without exceeding
+	// a rate limit. Once the stream's buffer is full, its writer will block.
+	LimitBlock LimitMode = iota
+	// LimitTruncate discards TEXT stream data that exceeds a rate limit,
+	// replacing it with a marker line reporting the number of bytes dropped.
+	//
+	// Other stream types are always blocked, since discarding some of their data
+	// would corrupt the rest.
+	LimitTruncate
+)
+
+var limitModeNames = map[string]LimitMode{
+	"block":    LimitBlock,
+	"truncate": LimitTruncate,
+}
+
+// String implements flag.Value.
+func (m LimitMode) String() string {
+	for k, v := range limitModeNames {
+		if v == m {
+			return k
+		}
+	}
+	return fmt.Sprintf("LimitMode(%d)", m)
+}
+
+// Set implements flag.Value.
+func (m *LimitMode) Set(v string) error {
+	mode, ok := limitModeNames[v]
+	if !ok {
+		return fmt.Errorf("unknown limit mode %q (must be \"block\" or \"truncate\")", v)
+	}
+	*m = mode
+	return nil
+}
+
+// RateLimit is a limit on the rate at which log data is output.
+type RateLimit struct {
+	// BytesPerSecond is the sustained number of log data bytes that may be
+	// output each second. If zero, bytes will not be limited.
+	BytesPerSecond int64
+	// EntriesPerSecond is the sustained number of log entries that may be output
+	// each second. If zero, log entries will not be limited.
+	EntriesPerSecond int64
+
+	// Burst is the period of unused rate that may accumulate and be spent at
+	// once. If zero, one second will be used.
+	Burst time.Duration
+}
+
+func (rl *RateLimit) enabled() bool {
+	return rl.BytesPerSecond > 0 || rl.EntriesPerSecond > 0
+}
+
+// Validate validates that the RateLimit is valid.
+func (rl *RateLimit) Validate() error {
+	switch {
+	case rl.BytesPerSecond < 0:
+		return errors.New("bytes per second must not be negative")
+	case rl.EntriesPerSecond < 0:
+		return errors.New("entries per second must not be negative")
+	case rl.Burst < 0:
+		return errors.New("burst must not be negative")
+	default:
+		return nil
+	}
+}
+
+// rateLimiter is a token bucket that tracks output against a RateLimit.
+//
+// The bucket may go into debt: output is allowed whenever the bucket is not
+// empty, and is then charged in full. This allows log entries that are larger
+// than the bucket to be output.
+//
+// A rateLimiter is goroutine-safe.
+type rateLimiter struct {
+	sync.Mutex
+	RateLimit
+
+	bytes   float64
+	entries float64
+	last    time.Time
+}
+
+// newRateLimiter returns a rateLimiter for rl, or nil if rl has no limits.
+func newRateLimiter(rl RateLimit) *rateLimiter {
+	if !rl.enabled() {
+		return nil
+	}
+	if rl.Burst <= 0 {
+		rl.Burst = time.Second
+	}
+	return &rateLimiter{RateLimit: rl}
+}
+
+// refillLocked adds the tokens that have accumulated since the last refill.
+func (rl *rateLimiter) refillLocked(now time.Time) {
+	burst := rl.Burst.Seconds()
+	if rl.last.IsZero() {
+		// Start with a full bucket.
+		rl.bytes = float64(rl.BytesPerSecond) * burst
+		rl.entries = float64(rl.EntriesPerSecond) * burst
+		rl.last = now
+		return
+	}
+
+	d := now.Sub(rl.last).Seconds()
+	if d <= 0 {
+		return
+	}
+	rl.bytes = math.Min(rl.bytes+(d*float64(rl.BytesPerSecond)), float64(rl.BytesPerSecond)*burst)
+	rl.entries = math.Min(rl.entries+(d*float64(rl.EntriesPerSecond)), float64(rl.EntriesPerSecond)*burst)
+	rl.last = now
+}
+
+// readyAt returns the earliest time at which output will be allowed. If it is
+// not after now, output is allowed immediately.
+//
+// A nil rateLimiter always allows output.
+func (rl *rateLimiter) readyAt(now time.Time) time.Time {
+	if rl == nil {
+		return now
+	}
+
+	rl.Lock()
+	defer rl.Unlock()
+	rl.refillLocked(now)
+
+	ready := now
+	for _, b := range []struct {
+		tokens float64
+		rate   int64
+	}{
+		{rl.bytes, rl.BytesPerSecond},
+		{rl.entries, rl.EntriesPerSecond},
+	} {
+		if b.rate > 0 && b.tokens <= 0 {
+			wait := time.Duration(math.Ceil(-b.tokens/float64(b.rate)*float64(time.Second))) + 1
+			if t := now.Add(wait); t.After(ready) {
+				ready = t
+			}
+		}
+	}
+	return ready
+}
+
+// take charges output against the rateLimiter.
+func (rl *rateLimiter) take(now time.Time, bytes int64) {
+	if rl == nil {
+		return
+	}
+
+	rl.Lock()
+	defer rl.Unlock()
+	rl.refillLocked(now)
+
+	rl.bytes -= float64(bytes)
+	rl.entries--
+}
+
+// limitStats tracks the data that a Bundler has dropped due to its limits.
+//
+// Its fields are accessed atomically.
+type limitStats struct {
+	droppedBytes   int64
+	droppedEntries int64
+}
+
+func (ls *limitStats) addDropped(bytes int64) {
+	atomic.AddInt64(&ls.droppedBytes, bytes)
+	atomic.AddInt64(&ls.droppedEntries, 1)
+}
+
+func (ls *limitStats) stats() output.Stats {
+	var st output.StatsBase
+	st.F.DroppedBytes = atomic.LoadInt64(&ls.droppedBytes)
+	st.F.DroppedEntries = atomic.LoadInt64(&ls.droppedEntries)
+	return &st
+}
+
+// streamLimits is the set of limits that apply to a single stream's output.
+type streamLimits struct {
+	clock clock.Clock
+
+	// truncate, if true, causes log entries that exceed a rate limit to be
+	// dropped. Otherwise, they will be held until the rate limit allows them.
+	truncate bool
+	// quota, if >0, is the maximum number of log data bytes that the stream may
+	// output. Log entries beyond it will be dropped.
+	quota int64
+
+	// stream is the stream's rate limiter. It may be nil.
+	stream *rateLimiter
+	// global is the Bundler's rate limiter, shared by all streams. It may be nil.
+	global *rateLimiter
+
+	// stats is the Bundler's limit statistics.
+	stats *limitStats
+}
+
+// readyAt returns the earliest time at which output will be allowed by both
+// the stream and global rate limiters.
+func (l *streamLimits) readyAt(now time.Time) time.Time {
+	ready := l.stream.readyAt(now)
+	if t := l.global.readyAt(now); t.After(ready) {
+		ready = t
+	}
+	return ready
+}
+
+func (l *streamLimits) take(now time.Time, bytes int64) {
+	l.stream.take(now, bytes)
+	l.global.take(now, bytes)
+}
+
+// truncatable returns true if log entries of the given stream type may be
+// dropped when the stream exceeds its rate limit.
+//
+// Text and JSON lines can be dropped without affecting the ones that follow.
+// Binary and datagram streams would be corrupted, so they are always throttled.
+func truncatable(st logpb.StreamType) bool {
+	switch st {
+	case logpb.StreamType_TEXT, logpb.StreamType_JSON:
+		return true
+	default:
+		return false
+	}
+}
+
+// logEntryDataSize returns the number of log data bytes in a LogEntry.
+func logEntryDataSize(le *logpb.LogEntry) (size int64) {
+	switch c := le.Content.(type) {
+	case *logpb.LogEntry_Text:
+		for _, line := range c.Text.Lines {
+			size += int64(len(line.Value) + len(line.Delimiter))
+		}
+	case *logpb.LogEntry_Binary:
+		size = int64(len(c.Binary.Data))
+	case *logpb.LogEntry_Datagram:
+		size = int64(len(c.Datagram.Data))
+	}
+	return
+}
+
+// inPartialDatagram returns true if le is part of a partial datagram whose
+// remaining parts have yet to be output.
+func inPartialDatagram(le *logpb.LogEntry) bool {
+	if p := le.GetDatagram().GetPartial(); p != nil {
+		return !p.Last
+	}
+	return false
+}
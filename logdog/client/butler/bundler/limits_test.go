@@ -0,0 +1,248 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bundler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/logdog/api/logpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A rateLimiter`, t, func() {
+		now := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		Convey(`Is nil if no limits are configured.`, func() {
+			rl := newRateLimiter(RateLimit{})
+			So(rl, ShouldBeNil)
+			So(rl.readyAt(now), ShouldResemble, now)
+		})
+
+		Convey(`Limiting bytes, allows a burst and then waits for the rate.`, func() {
+			rl := newRateLimiter(RateLimit{BytesPerSecond: 10, Burst: 2 * time.Second})
+
+			So(rl.readyAt(now), ShouldResemble, now)
+			rl.take(now, 25)
+			So(rl.readyAt(now), ShouldResemble, now.Add(500*time.Millisecond+1))
+			So(rl.readyAt(now.Add(time.Second)), ShouldResemble, now.Add(time.Second))
+
+			Convey(`Does not accumulate more than its burst.`, func() {
+				now = now.Add(time.Hour)
+				rl.take(now, 21)
+				So(rl.readyAt(now).After(now), ShouldBeTrue)
+			})
+		})
+
+		Convey(`Limiting entries, waits for the rate.`, func() {
+			rl := newRateLimiter(RateLimit{EntriesPerSecond: 2})
+
+			rl.take(now, 1024)
+			So(rl.readyAt(now), ShouldResemble, now)
+			rl.take(now, 1024)
+			rl.take(now, 1024)
+			So(rl.readyAt(now), ShouldResemble, now.Add(500*time.Millisecond+1))
+		})
+	})
+}
+
+func TestLimitMode(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A LimitMode can be set from its name.`, t, func() {
+		var m LimitMode
+		So(m.Set("truncate"), ShouldBeNil)
+		So(m, ShouldEqual, LimitTruncate)
+		So(m.String(), ShouldEqual, "truncate")
+
+		So(m.Set("invalid"), ShouldNotBeNil)
+	})
+}
+
+func TestTruncatable(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Only text and JSON streams are truncatable.`, t, func() {
+		So(truncatable(logpb.StreamType_TEXT), ShouldBeTrue)
+		So(truncatable(logpb.StreamType_JSON), ShouldBeTrue)
+		So(truncatable(logpb.StreamType_BINARY), ShouldBeFalse)
+		So(truncatable(logpb.StreamType_DATAGRAM), ShouldBeFalse)
+	})
+}
+
+func TestStreamLimits(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A testing stream with limits`, t, func() {
+		tc := testclock.New(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+		tp := testParser{}
+		ls := limitStats{}
+		c := streamConfig{
+			name:   "test",
+			parser: &tp,
+			template: logpb.ButlerLogBundle_Entry{
+				Desc: &logpb.LogStreamDescriptor{
+					Prefix: "test-prefix",
+					Name:   "test",
+				},
+			},
+			limits: &streamLimits{
+				clock: tc,
+				stats: &ls,
+			},
+		}
+		bb := &builder{
+			size: 1024,
+		}
+
+		logs := func() []*logpb.LogEntry {
+			if be := bb.bundle().Entries; len(be) == 1 {
+				return be[0].Logs
+			}
+			return nil
+		}
+
+		Convey(`When blocking on a 3 byte/second rate limit`, func() {
+			c.limits.stream = newRateLimiter(RateLimit{BytesPerSecond: 3})
+			s := newStream(c)
+			tp.tags(tc.Now(), "aaaa", "bbbb")
+
+			Convey(`Holds data until the rate limit allows it.`, func() {
+				So(s.nextBundleEntry(bb, false), ShouldBeTrue)
+				So(bb.bundle(), shouldHaveBundleEntries, "test:aaaa")
+
+				et, has := s.expireTime()
+				So(has, ShouldBeTrue)
+				So(et.After(tc.Now()), ShouldBeTrue)
+				So(s.nextBundleEntry(bb, false), ShouldBeFalse)
+
+				tc.Add(time.Second)
+				So(s.nextBundleEntry(bb, false), ShouldBeTrue)
+				So(bb.bundle(), shouldHaveBundleEntries, "test:aaaa:bbbb")
+				So(ls.stats().DroppedBytes(), ShouldEqual, 0)
+			})
+
+			Convey(`Drops data once its byte quota is exceeded.`, func() {
+				c.limits.quota = 4
+				tp.tags(tc.Now(), "cccc")
+				s.Close()
+
+				So(s.nextBundleEntry(bb, false), ShouldBeTrue)
+				So(s.isDrained(), ShouldBeTrue)
+
+				le := logs()
+				So(le, ShouldHaveLength, 2)
+				So(logEntryName(le[0]), ShouldEqual, "aaaa")
+				So(le[1].StreamIndex, ShouldEqual, 1)
+				So(le[1].GetText().Lines[1].Value, ShouldEqual,
+					"LogDog Butler: 8 bytes dropped (stream exceeded its 4 byte quota).")
+				So(bb.bundle().Entries[0].Terminal, ShouldBeTrue)
+				So(bb.bundle().Entries[0].TerminalIndex, ShouldEqual, 1)
+
+				So(ls.stats().DroppedBytes(), ShouldEqual, 8)
+				So(ls.stats().DroppedEntries(), ShouldEqual, 2)
+			})
+
+			Convey(`Marks dropped data with a JSON object in a JSON stream.`, func() {
+				c.limits.quota = 4
+				c.template.Desc.StreamType = logpb.StreamType_JSON
+				s = newStream(c)
+				s.Close()
+
+				So(s.nextBundleEntry(bb, false), ShouldBeTrue)
+
+				le := logs()
+				So(le, ShouldHaveLength, 2)
+				So(le[1].GetText().Lines[1], ShouldResemble, &logpb.Text_Line{
+					Value:     `{"logdog_butler":"LogDog Butler: 4 bytes dropped (stream exceeded its 4 byte quota)."}`,
+					Delimiter: "\n",
+					Fields: map[string]string{
+						"logdog_butler": "LogDog Butler: 4 bytes dropped (stream exceeded its 4 byte quota).",
+					},
+				})
+			})
+		})
+
+		Convey(`When truncating on a 3 byte/second rate limit`, func() {
+			c.limits.stream = newRateLimiter(RateLimit{BytesPerSecond: 3})
+			c.limits.truncate = true
+			s := newStream(c)
+			tp.tags(tc.Now(), "aaaa", "bbbb", "cccc")
+
+			Convey(`Drops data that exceeds the limit, and marks it.`, func() {
+				So(s.nextBundleEntry(bb, false), ShouldBeTrue)
+				So(bb.bundle(), shouldHaveBundleEntries, "test:aaaa")
+
+				_, has := s.expireTime()
+				So(has, ShouldBeFalse)
+				So(ls.stats().DroppedBytes(), ShouldEqual, 8)
+				So(ls.stats().DroppedEntries(), ShouldEqual, 2)
+
+				tc.Add(2 * time.Second)
+				tp.tags(tc.Now(), "dddd")
+				So(s.nextBundleEntry(bb, false), ShouldBeTrue)
+
+				le := logs()
+				So(le, ShouldHaveLength, 3)
+				So(le[1].StreamIndex, ShouldEqual, 1)
+				So(le[1].GetText().Lines, ShouldResemble, []*logpb.Text_Line{
+					{Delimiter: "\n"},
+					{Value: "LogDog Butler: 8 bytes dropped (rate limit exceeded).", Delimiter: "\n"},
+				})
+				So(logEntryName(le[2]), ShouldEqual, "dddd")
+				So(le[2].StreamIndex, ShouldEqual, 2)
+			})
+
+			Convey(`Marks dropped data with a JSON object in a JSON stream.`, func() {
+				c.template.Desc.StreamType = logpb.StreamType_JSON
+				s = newStream(c)
+
+				So(s.nextBundleEntry(bb, false), ShouldBeTrue)
+				So(bb.bundle(), shouldHaveBundleEntries, "test:aaaa")
+
+				tc.Add(2 * time.Second)
+				tp.tags(tc.Now(), "dddd")
+				So(s.nextBundleEntry(bb, false), ShouldBeTrue)
+
+				le := logs()
+				So(le, ShouldHaveLength, 3)
+				So(le[1].GetText().Lines[1], ShouldResemble, &logpb.Text_Line{
+					Value:     `{"logdog_butler":"LogDog Butler: 8 bytes dropped (rate limit exceeded)."}`,
+					Delimiter: "\n",
+					Fields: map[string]string{
+						"logdog_butler": "LogDog Butler: 8 bytes dropped (rate limit exceeded).",
+					},
+				})
+				So(logEntryName(le[2]), ShouldEqual, "dddd")
+			})
+		})
+
+		Convey(`With a global rate limit shared by two streams, blocks both.`, func() {
+			c.limits.global = newRateLimiter(RateLimit{EntriesPerSecond: 1})
+			s := newStream(c)
+			tp.tags(tc.Now(), "aaaa", "bbbb")
+
+			tp2 := testParser{}
+			c.name, c.parser = "test2", &tp2
+			c.template.Desc = &logpb.LogStreamDescriptor{Name: "test2"}
+			s2 := newStream(c)
+			tp2.tags(tc.Now(), "xxxx")
+
+			So(s.nextBundleEntry(bb, false), ShouldBeTrue)
+			So(s2.nextBundleEntry(bb, false), ShouldBeFalse)
+			So(bb.bundle(), shouldHaveBundleEntries, "test:aaaa")
+
+			tc.Add(time.Second)
+			So(s2.nextBundleEntry(bb, false), ShouldBeTrue)
+			So(s.nextBundleEntry(bb, false), ShouldBeFalse)
+			So(bb.bundle(), shouldHaveBundleEntries, "test:aaaa", "test2:xxxx")
+		})
+	})
+}
@@ -55,7 +55,11 @@ type parser interface {
 	firstChunkTime() (time.Time, bool)
 }
 
-func newParser(p *streamproto.Properties, c *counter) (parser, error) {
+// newParser creates a parser for a log stream with the supplied properties.
+//
+// maxBundleSize is the maximum size of the bundles that the parser's log
+// entries are added to. If it is <=0, it is not limited.
+func newParser(p *streamproto.Properties, c *counter, maxBundleSize int) (parser, error) {
 	base := baseParser{
 		counter:  c,
 		timeBase: google.TimeFromProto(p.Timestamp),
@@ -67,6 +71,14 @@ func newParser(p *streamproto.Properties, c *counter) (parser, error) {
 			baseParser: base,
 		}, nil
 
+	case logpb.StreamType_JSON:
+		return &jsonParser{
+			textParser: textParser{
+				baseParser: base,
+			},
+			maxLineSize: jsonMaxLineSize(maxBundleSize),
+		}, nil
+
 	case logpb.StreamType_BINARY:
 		return &binaryParser{
 			baseParser: base,
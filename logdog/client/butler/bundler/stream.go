@@ -5,6 +5,7 @@
 package bundler
 
 import (
+	"encoding/json"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -20,6 +21,10 @@ var (
 	dataBufferSize = 4096
 )
 
+// dropMarkerJSONField is the field that holds a JSON stream's drop marker
+// message.
+const dropMarkerJSONField = "logdog_butler"
+
 // Stream is an individual Bundler Stream. Data is added to the Stream as a
 // series of ordered binary chunks.
 //
@@ -61,6 +66,10 @@ type streamConfig struct {
 	// template is the minimally-populated Butler log bundle entry.
 	template logpb.ButlerLogBundle_Entry
 
+	// limits, if not nil, is the set of rate limits and quotas that apply to the
+	// stream's log entries.
+	limits *streamLimits
+
 	// onAppend, if not nil, is invoked when an attempt to append data to the
 	// stream occurs. If true is passed, the data was successfully appended. If
 	// false was passed, the data could not be appended immediately and the stream
@@ -109,6 +118,30 @@ type streamImpl struct {
 	// stream content processing hits a fatal state.
 	appendErr error
 
+	// sentBytes is the number of log data bytes that the stream has output.
+	//
+	// stateLock must be held when accessing this field.
+	sentBytes int64
+	// indexDelta is added to the stream index of each log entry that the parser
+	// produces, accounting for log entries that have been dropped.
+	//
+	// stateLock must be held when accessing this field.
+	indexDelta int64
+	// dropMarker, if not nil, is the first log entry that has been dropped since
+	// the stream last output one. It is output as a marker standing in for all of
+	// the dropped log entries.
+	//
+	// stateLock must be held when accessing this field.
+	dropMarker *logpb.LogEntry
+	// droppedBytes is the number of log data bytes that dropMarker stands in for.
+	//
+	// stateLock must be held when accessing this field.
+	droppedBytes int64
+	// dropReason is the reason that the most recent log entry was dropped.
+	//
+	// stateLock must be held when accessing this field.
+	dropReason string
+
 	// testAppendWaitCallback, if not nil, is called before Append blocks.
 	// This callback is used for testing coordination.
 	testAppendWaitCallback func()
@@ -237,10 +270,38 @@ func (s *streamImpl) expireTime() (t time.Time, has bool) {
 
 	if has {
 		t = t.Add(s.c.maximumBufferDuration)
+
+		// If we're being throttled, our data can't be consumed until our rate
+		// limits allow it.
+		if l := s.c.limits; l != nil && s.throttled() {
+			if ready := l.readyAt(l.clock.Now()); ready.After(t) {
+				t = ready
+			}
+		}
 	}
 	return
 }
 
+// throttled returns true if the stream holds log entries that exceed its rate
+// limits, rather than dropping them.
+func (s *streamImpl) throttled() bool {
+	s.stateLock.Lock()
+	defer s.stateLock.Unlock()
+	return s.throttledLocked()
+}
+
+func (s *streamImpl) throttledLocked() bool {
+	return !(s.c.limits.truncate || s.quotaExceededLocked())
+}
+
+// quotaExceededLocked returns true if the stream has output its byte quota.
+//
+// A partial datagram will be completed before its stream's quota takes effect.
+func (s *streamImpl) quotaExceededLocked() bool {
+	l := s.c.limits
+	return l.quota > 0 && s.sentBytes >= l.quota && !inPartialDatagram(s.lastLogEntry)
+}
+
 // nextBundleEntry generates bundles for this stream. The total bundle data size
 // must not exceed the supplied size.
 //
@@ -268,6 +329,10 @@ func (s *streamImpl) nextBundleEntry(bb *builder, aggressive bool) bool {
 
 	// If we're drained, populate our terminal state.
 	if s.noMoreDataLocked() {
+		if s.dropMarker != nil {
+			s.addDropMarkerLocked(bb)
+			modified = true
+		}
 		if s.lastLogEntry != nil {
 			bb.setStreamTerminal(&s.c.template, s.lastLogEntry.StreamIndex)
 		}
@@ -291,7 +356,25 @@ func (s *streamImpl) nextBundleEntryLocked(bb *builder, aggressive bool) (bool,
 	modified := false
 
 	for c.limit = bb.remaining(); c.limit > 0; c.limit = bb.remaining() {
-		emittedLog := false
+		var now time.Time
+		if l := s.c.limits; l != nil {
+			now = l.clock.Now()
+			if l.readyAt(now).After(now) {
+				// If we're throttled, leave our data in the parser until our rate
+				// limits allow it to be output.
+				if s.throttledLocked() {
+					return modified, nil
+				}
+			} else if s.dropMarker != nil && !s.quotaExceededLocked() {
+				// We're no longer dropping log entries, so mark the ones that we
+				// dropped before resuming output.
+				s.addDropMarkerLocked(bb)
+				modified = true
+				continue
+			}
+		}
+
+		parsedLog := false
 		err := s.withParserLock(func() error {
 			le, err := s.c.parser.nextEntry(&c)
 			if err != nil {
@@ -301,13 +384,20 @@ func (s *streamImpl) nextBundleEntryLocked(bb *builder, aggressive bool) (bool,
 			if le == nil {
 				return nil
 			}
+			parsedLog = true
+
+			if s.indexDelta != 0 {
+				le.StreamIndex = uint64(int64(le.StreamIndex) + s.indexDelta)
+			}
+			if s.c.limits != nil && s.dropLocked(now, le) {
+				return nil
+			}
 
 			// Enforce basic log entry consistency.
 			if err := s.fixupLogEntry(s.lastLogEntry, le); err != nil {
 				return err
 			}
 
-			emittedLog = true
 			modified = true
 
 			bb.add(&s.c.template, le)
@@ -315,13 +405,84 @@ func (s *streamImpl) nextBundleEntryLocked(bb *builder, aggressive bool) (bool,
 			return nil
 		})
 
-		if err != nil || !emittedLog {
+		if err != nil || !parsedLog {
 			return modified, err
 		}
 	}
 	return modified, nil
 }
 
+// dropLocked applies the stream's limits to a log entry that has been parsed.
+// If the log entry is to be output, it is charged against the stream's limits
+// and dropLocked returns false. Otherwise, the log entry is recorded as
+// dropped, and dropLocked returns true.
+//
+// The stream's stateLock must be held when calling this method.
+func (s *streamImpl) dropLocked(now time.Time, le *logpb.LogEntry) bool {
+	l := s.c.limits
+	size := logEntryDataSize(le)
+
+	reason := ""
+	switch {
+	case s.quotaExceededLocked():
+		reason = fmt.Sprintf("stream exceeded its %d byte quota", l.quota)
+	case l.readyAt(now).After(now):
+		reason = "rate limit exceeded"
+	default:
+		l.take(now, size)
+		s.sentBytes += size
+		return false
+	}
+
+	l.stats.addDropped(size)
+	s.droppedBytes += size
+	s.dropReason = reason
+
+	// A dropped TEXT log entry can be replaced with a marker. The first one
+	// that we drop is retained to become that marker, keeping its indices.
+	// Other log entries are discarded, and subsequent log entries are
+	// re-indexed to fill their place.
+	if s.dropMarker == nil && le.GetText() != nil {
+		s.dropMarker = le
+	} else {
+		s.indexDelta--
+	}
+	return true
+}
+
+// addDropMarkerLocked adds the stream's drop marker to the builder. The marker
+// is a log entry that reports how much data has been dropped.
+//
+// The stream's stateLock must be held when calling this method.
+func (s *streamImpl) addDropMarkerLocked(bb *builder) {
+	le := s.dropMarker
+	marker := logpb.Text_Line{
+		Value:     fmt.Sprintf("LogDog Butler: %d bytes dropped (%s).", s.droppedBytes, s.dropReason),
+		Delimiter: posixNewline,
+	}
+	if s.c.template.Desc.GetStreamType() == logpb.StreamType_JSON {
+		// Keep JSON streams valid by making the marker a JSON object.
+		marker.Fields = map[string]string{dropMarkerJSONField: marker.Value}
+		d, _ := json.Marshal(marker.Fields) // Cannot fail for a map[string]string.
+		marker.Value = string(d)
+	}
+	le.Content = &logpb.LogEntry_Text{Text: &logpb.Text{
+		Lines: []*logpb.Text_Line{&marker},
+	}}
+	if s.lastLogEntry != nil {
+		// Log entries may have been dropped in the middle of a line. Make sure that
+		// the marker is on a line of its own.
+		if lines := s.lastLogEntry.GetText().GetLines(); len(lines) > 0 && lines[len(lines)-1].Delimiter == "" {
+			le.GetText().Lines = append([]*logpb.Text_Line{{Delimiter: posixNewline}}, le.GetText().Lines...)
+		}
+	}
+
+	bb.add(&s.c.template, le)
+	s.lastLogEntry = le
+	s.dropMarker = nil
+	s.droppedBytes = 0
+}
+
 // fixupLogEntry asserts and corrects a log entry's stream offset and ordering
 // given the previous entry in the stream.
 //
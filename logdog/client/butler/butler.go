@@ -91,6 +91,16 @@ type Config struct {
 	// IOKeepAliveWriter is an io.Writer to send keep-alive updates through. This
 	// must be set for I/O keep-alive to be active.
 	IOKeepAliveWriter io.Writer
+
+	// StreamRateLimit is the rate limit to apply to each individual stream.
+	StreamRateLimit bundler.RateLimit
+	// GlobalRateLimit is the rate limit to apply to all streams together.
+	GlobalRateLimit bundler.RateLimit
+	// StreamByteQuota, if >0, is the maximum number of log data bytes that a
+	// single stream may send. Data beyond it will be dropped.
+	StreamByteQuota int64
+	// LimitMode is how the rate limits are enforced.
+	LimitMode bundler.LimitMode
 }
 
 // Validate validates that the configuration is sufficient to instantiate a
@@ -105,6 +115,15 @@ func (c *Config) Validate() error {
 	if err := c.Prefix.Validate(); err != nil {
 		return fmt.Errorf("invalid prefix: %v", err)
 	}
+	if err := c.StreamRateLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid stream rate limit: %v", err)
+	}
+	if err := c.GlobalRateLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid global rate limit: %v", err)
+	}
+	if c.StreamByteQuota < 0 {
+		return errors.New("butler: stream byte quota must not be negative")
+	}
 	return nil
 }
 
@@ -181,6 +200,10 @@ func New(ctx context.Context, config Config) (*Butler, error) {
 		Prefix:           config.Prefix,
 		MaxBufferedBytes: streamBufferSize,
 		MaxBundleSize:    config.Output.MaxSize(),
+		StreamRateLimit:  config.StreamRateLimit,
+		GlobalRateLimit:  config.GlobalRateLimit,
+		StreamByteQuota:  config.StreamByteQuota,
+		LimitMode:        config.LimitMode,
 	}
 	if config.BufferLogs {
 		bc.MaxBufferDelay = config.MaxBufferAge
@@ -310,11 +333,21 @@ func (b *Butler) Wait() error {
 	log.Debugf(b.ctx, "Output queue has shut down.")
 
 	log.Fields{
-		"stats": b.c.Output.Stats(),
+		"stats": b.Stats(),
 	}.Infof(b.ctx, "Message output has closed")
 	return b.getRunErr()
 }
 
+// Stats returns the Butler's output statistics. These include the statistics
+// of its Output, as well as any data that the Butler dropped before sending it
+// to its Output.
+func (b *Butler) Stats() output.Stats {
+	var st output.StatsBase
+	st.Merge(b.c.Output.Stats())
+	st.Merge(b.bundler.Stats())
+	return &st
+}
+
 // Streams returns a sorted list of stream names that have been registered to
 // the Butler.
 func (b *Butler) Streams() []types.StreamName {
@@ -248,6 +248,11 @@ func TestConfig(t *testing.T) {
 			conf.Project = "!!!!invalid!!!!"
 			So(conf.Validate(), ShouldErrLike, "invalid project")
 		})
+
+		Convey(`Will not validate with a negative rate limit.`, func() {
+			conf.GlobalRateLimit.BytesPerSecond = -1
+			So(conf.Validate(), ShouldErrLike, "invalid global rate limit")
+		})
 	})
 }
 
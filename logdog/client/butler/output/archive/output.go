@@ -0,0 +1,449 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package archive implements an output.Output that writes log streams to local
+// LogDog archive files.
+//
+// Each log stream is written as the same log entry, index, and (optionally)
+// data files that the Archivist writes to Google Storage, laid out in the same
+// way under a local base directory. This allows offline systems to produce
+// LogDog archives without a Coordinator.
+package archive
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/iotools"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/client/butler/output"
+	logdogArchive "github.com/luci/luci-go/logdog/common/archive"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+	"golang.org/x/net/context"
+)
+
+const (
+	// EntriesName is the name of the log entries archive file.
+	EntriesName = "logstream.entries"
+	// IndexName is the name of the log index archive file.
+	IndexName = "logstream.index"
+	// DataNamePrefix is the prefix of the data archive file. It is followed by
+	// the log stream's binary file extension, or DefaultDataExt if it has none.
+	DataNamePrefix = "data."
+	// DefaultDataExt is the data archive file extension to use for log streams
+	// which don't specify one.
+	DefaultDataExt = "bin"
+)
+
+// Options is the set of configuration options for the Output.
+type Options struct {
+	// Path is the base directory to write archives under. Each log stream is
+	// archived into the directory "<Path>/[<Project>/]<stream path>".
+	Path string
+	// Project, if not empty, is the project to include in each log stream's
+	// archive directory, matching the Archivist's Google Storage layout.
+	Project cfgtypes.ProjectName
+	// TempDir is the directory to buffer log entries in until their log stream
+	// is archived. If empty, the system's temporary directory is used.
+	TempDir string
+
+	// AlwaysRender, if true, causes a data file to be written for every log
+	// stream. Otherwise, it is only written for log streams which specify a
+	// binary file extension.
+	AlwaysRender bool
+
+	// StreamIndexRange, PrefixIndexRange, and ByteRange constrain how often
+	// index entries are emitted. See archive.Manifest for more information.
+	StreamIndexRange int
+	PrefixIndexRange int
+	ByteRange        int
+
+	// Compress, if true, causes log entry archive files to be compressed. See
+	// archive.Manifest for more information.
+	Compress bool
+
+	// Track, if true, causes log entry output to be tracked.
+	Track bool
+}
+
+// New creates a new archive Output from the specified Options.
+func (opt Options) New(c context.Context) output.Output {
+	o := archiveOutput{
+		Context: c,
+		Options: &opt,
+		streams: map[types.StreamPath]*stream{},
+	}
+	if opt.Track {
+		o.et = &output.EntryTracker{}
+	}
+	return &o
+}
+
+// archiveOutput is an output.Output implementation that writes log streams to
+// local archive files.
+//
+// Log entries are buffered in a temporary file per log stream until the log
+// stream is complete, at which point it is archived and its buffer is removed.
+// Only the location of each log entry in the buffer is kept in memory. Log
+// streams which are not complete when the Output is closed are archived with
+// the log entries that they have.
+type archiveOutput struct {
+	// Context is the context to use for logging.
+	context.Context
+	// Options are the configuration options.
+	*Options
+	// Mutex protects all other members.
+	sync.Mutex
+
+	// streams is a map of stream name to stream state.
+	streams map[types.StreamPath]*stream
+	// stats is the streaming stats for this instance.
+	stats output.StatsBase
+	// et is the singleton EntryTracker.
+	et *output.EntryTracker
+}
+
+// stream is the buffered state of a single log stream.
+type stream struct {
+	desc *logpb.LogStreamDescriptor
+
+	// buf is the temporary file that the log stream's serialized log entries are
+	// buffered in.
+	buf *os.File
+	// bufSize is the number of bytes written to buf.
+	bufSize int64
+	// entries is the location of each buffered log entry in buf, keyed on
+	// stream index.
+	entries map[uint64]bufferedEntry
+	// terminalIndex is the log stream's terminal index, or <0 if it has not been
+	// received.
+	terminalIndex types.MessageIndex
+	// archived is true if the log stream has been archived, or has failed to be
+	// buffered or archived.
+	archived bool
+}
+
+// bufferedEntry is the location of a serialized log entry in a stream's buffer.
+type bufferedEntry struct {
+	offset int64
+	size   int
+}
+
+// buffer appends le to s's buffer.
+func (s *stream) buffer(le *logpb.LogEntry) error {
+	data, err := proto.Marshal(le)
+	if err != nil {
+		return errors.Annotate(err, "failed to marshal log entry").Err()
+	}
+	if _, err := s.buf.Write(data); err != nil {
+		return errors.Annotate(err, "failed to buffer log entry").Err()
+	}
+	s.entries[le.StreamIndex] = bufferedEntry{s.bufSize, len(data)}
+	s.bufSize += int64(len(data))
+	return nil
+}
+
+// release removes s's buffer.
+func (s *stream) release() {
+	if s.buf != nil {
+		s.buf.Close()
+		os.Remove(s.buf.Name())
+		s.buf = nil
+	}
+	s.entries = nil
+}
+
+// complete returns true if s has a terminal index and every log entry up to
+// it has been received.
+func (s *stream) complete() bool {
+	return s.terminalIndex >= 0 && int64(len(s.entries)) == int64(s.terminalIndex)+1
+}
+
+func (o *archiveOutput) SendBundle(b *logpb.ButlerLogBundle) error {
+	o.Lock()
+	defer o.Unlock()
+
+	for _, be := range b.GetEntries() {
+		desc := be.GetDesc()
+		if desc == nil {
+			continue
+		}
+		path := desc.Path()
+
+		s, ok := o.streams[path]
+		if !ok {
+			s = &stream{
+				desc:          desc,
+				entries:       map[uint64]bufferedEntry{},
+				terminalIndex: -1,
+			}
+			o.streams[path] = s
+
+			var err error
+			if s.buf, err = ioutil.TempFile(o.TempDir, "logdog_archive"); err != nil {
+				o.failStreamLocked(s, errors.Annotate(err, "failed to create buffer file").Err())
+			}
+		}
+
+		if s.archived {
+			log.Fields{
+				"path":  path,
+				"count": len(be.GetLogs()),
+			}.Warningf(o, "Discarding log entries for already-archived stream.")
+			o.stats.F.DiscardedMessages += int64(len(be.GetLogs()))
+			continue
+		}
+
+		for _, le := range be.GetLogs() {
+			if err := s.buffer(le); err != nil {
+				o.failStreamLocked(s, err)
+				break
+			}
+		}
+		if s.archived {
+			continue
+		}
+		if be.Terminal {
+			s.terminalIndex = types.MessageIndex(be.TerminalIndex)
+		}
+
+		if s.complete() {
+			o.archiveStreamLocked(s)
+		}
+	}
+
+	if o.et != nil {
+		o.et.Track(b)
+	}
+	return nil
+}
+
+func (o *archiveOutput) MaxSize() int {
+	return 1024 * 1024 * 1024
+}
+
+func (o *archiveOutput) Stats() output.Stats {
+	o.Lock()
+	defer o.Unlock()
+
+	out := o.stats
+	return &out
+}
+
+func (o *archiveOutput) Record() *output.EntryRecord {
+	o.Lock()
+	defer o.Unlock()
+
+	if o.et == nil {
+		return nil
+	}
+	return o.et.Record()
+}
+
+func (o *archiveOutput) Close() {
+	o.Lock()
+	defer o.Unlock()
+
+	if o.streams == nil {
+		return
+	}
+
+	paths := make([]string, 0, len(o.streams))
+	for path := range o.streams {
+		paths = append(paths, string(path))
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		s := o.streams[types.StreamPath(path)]
+		if s.archived {
+			continue
+		}
+
+		if !s.complete() {
+			log.Fields{
+				"path":          path,
+				"terminalIndex": s.terminalIndex,
+				"count":         len(s.entries),
+			}.Warningf(o, "Archiving incomplete log stream.")
+		}
+		o.archiveStreamLocked(s)
+	}
+	o.streams = nil
+}
+
+// streamDir returns the directory that a log stream's archive files are
+// written to.
+func (o *archiveOutput) streamDir(path types.StreamPath) string {
+	parts := make([]string, 0, 3)
+	parts = append(parts, o.Path)
+	if o.Project != "" {
+		parts = append(parts, string(o.Project))
+	}
+	parts = append(parts, filepath.FromSlash(string(path)))
+	return filepath.Join(parts...)
+}
+
+// failStreamLocked stops buffering s, discarding its log entries. The failure
+// is logged and counted as an error.
+func (o *archiveOutput) failStreamLocked(s *stream, err error) {
+	log.Fields{
+		log.ErrorKey: err,
+		"path":       s.desc.Path(),
+		"count":      len(s.entries),
+	}.Errorf(o, "Failed to buffer log stream, discarding it.")
+	o.stats.F.Errors++
+
+	s.archived = true
+	s.release()
+}
+
+// archiveStreamLocked writes s's archive files and releases its buffered log
+// entries. Failures are logged and counted as errors.
+func (o *archiveOutput) archiveStreamLocked(s *stream) {
+	path := s.desc.Path()
+
+	indices := make([]uint64, 0, len(s.entries))
+	for idx := range s.entries {
+		indices = append(indices, idx)
+	}
+	sort.Sort(streamIndexSlice(indices))
+
+	src := bufferedEntrySource{
+		buf:     s.buf,
+		entries: make([]bufferedEntry, len(indices)),
+	}
+	for i, idx := range indices {
+		src.entries[i] = s.entries[idx]
+	}
+	count := len(indices)
+
+	s.archived = true
+	defer s.release()
+
+	bytes, err := o.writeArchive(s.desc, &src)
+	o.stats.F.SentBytes += bytes
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"path":       path,
+		}.Errorf(o, "Failed to write log stream archive.")
+		o.stats.F.Errors++
+		return
+	}
+	o.stats.F.SentMessages += int64(count)
+
+	log.Fields{
+		"path":  path,
+		"count": count,
+		"bytes": bytes,
+	}.Debugf(o, "Archived log stream.")
+}
+
+// writeArchive writes the archive files for a log stream, returning the number
+// of bytes written.
+func (o *archiveOutput) writeArchive(desc *logpb.LogStreamDescriptor, src *bufferedEntrySource) (
+	bytes int64, err error) {
+
+	dir := o.streamDir(desc.Path())
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return 0, errors.Annotate(err, "failed to create archive directory %q", dir).Err()
+	}
+
+	var files []*os.File
+	var writers []*iotools.CountingWriter
+	defer func() {
+		for i, f := range files {
+			bytes += writers[i].Count
+			if cerr := f.Close(); cerr != nil && err == nil {
+				err = errors.Annotate(cerr, "failed to close %q", f.Name()).Err()
+			}
+		}
+	}()
+
+	create := func(name string) (io.Writer, error) {
+		f, err := os.Create(filepath.Join(dir, name))
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to create %q", name).Err()
+		}
+		w := &iotools.CountingWriter{Writer: f}
+		files = append(files, f)
+		writers = append(writers, w)
+		return w, nil
+	}
+
+	m := logdogArchive.Manifest{
+		Desc:             desc,
+		Source:           src,
+		StreamIndexRange: o.StreamIndexRange,
+		PrefixIndexRange: o.PrefixIndexRange,
+		ByteRange:        o.ByteRange,
+		Logger:           log.Get(o),
+	}
+	if o.Compress {
+		m.Compression = logpb.LogIndex_ZLIB
+	}
+	if m.LogWriter, err = create(EntriesName); err != nil {
+		return
+	}
+	if m.IndexWriter, err = create(IndexName); err != nil {
+		return
+	}
+	if ext := desc.BinaryFileExt; ext != "" || o.AlwaysRender {
+		if ext == "" {
+			ext = DefaultDataExt
+		}
+		if m.DataWriter, err = create(fmt.Sprintf("%s%s", DataNamePrefix, ext)); err != nil {
+			return
+		}
+	}
+
+	if err = logdogArchive.Archive(m); err != nil {
+		err = errors.Annotate(err, "failed to archive log stream").Err()
+	}
+	return
+}
+
+// bufferedEntrySource is a renderer.Source that reads successive log entries
+// from a stream's buffer.
+type bufferedEntrySource struct {
+	buf *os.File
+	// entries is the location of the remaining log entries, in order.
+	entries []bufferedEntry
+}
+
+func (s *bufferedEntrySource) NextLogEntry() (*logpb.LogEntry, error) {
+	if len(s.entries) == 0 {
+		return nil, io.EOF
+	}
+	be := s.entries[0]
+	s.entries = s.entries[1:]
+
+	data := make([]byte, be.size)
+	if _, err := s.buf.ReadAt(data, be.offset); err != nil {
+		return nil, errors.Annotate(err, "failed to read buffered log entry").Err()
+	}
+
+	var le logpb.LogEntry
+	if err := proto.Unmarshal(data, &le); err != nil {
+		return nil, errors.Annotate(err, "failed to unmarshal buffered log entry").Err()
+	}
+	return &le, nil
+}
+
+// streamIndexSlice sorts stream indices.
+type streamIndexSlice []uint64
+
+func (s streamIndexSlice) Len() int           { return len(s) }
+func (s streamIndexSlice) Less(i, j int) bool { return s[i] < s[j] }
+func (s streamIndexSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
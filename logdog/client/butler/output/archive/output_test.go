@@ -0,0 +1,158 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package archive
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/luci/luci-go/common/data/recordio"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func textEntry(i int) *logpb.LogEntry {
+	return &logpb.LogEntry{
+		StreamIndex: uint64(i),
+		PrefixIndex: uint64(i),
+		Sequence:    uint64(i),
+		Content: &logpb.LogEntry_Text{Text: &logpb.Text{
+			Lines: []*logpb.Text_Line{{Value: strconv.Itoa(i), Delimiter: "\n"}},
+		}},
+	}
+}
+
+// readEntries reads an entries archive file, returning its descriptor and the
+// stream indices of its log entries.
+func readEntries(path string) (*logpb.LogStreamDescriptor, []uint64) {
+	data, err := ioutil.ReadFile(path)
+	So(err, ShouldBeNil)
+	frames, err := recordio.Split(data)
+	So(err, ShouldBeNil)
+	So(len(frames), ShouldBeGreaterThan, 0)
+
+	var desc logpb.LogStreamDescriptor
+	So(proto.Unmarshal(frames[0], &desc), ShouldBeNil)
+
+	var indices []uint64
+	for _, f := range frames[1:] {
+		var le logpb.LogEntry
+		So(proto.Unmarshal(f, &le), ShouldBeNil)
+		indices = append(indices, le.StreamIndex)
+	}
+	return &desc, indices
+}
+
+func TestArchiveOutput(t *testing.T) {
+	t.Parallel()
+
+	Convey(`An archive Output`, t, func() {
+		tdir, err := ioutil.TempDir("", "logdog_archive_output")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tdir)
+
+		bufDir := filepath.Join(tdir, "buf")
+		So(os.Mkdir(bufDir, 0755), ShouldBeNil)
+		bufFiles := func() []os.FileInfo {
+			files, err := ioutil.ReadDir(bufDir)
+			So(err, ShouldBeNil)
+			return files
+		}
+
+		o := Options{Path: tdir, Project: "proj", TempDir: bufDir}.New(context.Background())
+		closed := false
+		defer func() {
+			if !closed {
+				o.Close()
+			}
+		}()
+
+		desc := &logpb.LogStreamDescriptor{
+			Prefix:     "foo",
+			Name:       "bar",
+			StreamType: logpb.StreamType_TEXT,
+		}
+		dir := filepath.Join(tdir, "proj", "foo", "+", "bar")
+
+		Convey(`Archives a log stream once it is complete.`, func() {
+			So(o.SendBundle(&logpb.ButlerLogBundle{Entries: []*logpb.ButlerLogBundle_Entry{
+				{Desc: desc, Logs: []*logpb.LogEntry{textEntry(2), textEntry(0)}},
+			}}), ShouldBeNil)
+			_, err := os.Stat(filepath.Join(dir, EntriesName))
+			So(os.IsNotExist(err), ShouldBeTrue)
+			So(bufFiles(), ShouldHaveLength, 1)
+
+			So(o.SendBundle(&logpb.ButlerLogBundle{Entries: []*logpb.ButlerLogBundle_Entry{
+				{Desc: desc, Logs: []*logpb.LogEntry{textEntry(1)}, Terminal: true, TerminalIndex: 2},
+			}}), ShouldBeNil)
+
+			d, indices := readEntries(filepath.Join(dir, EntriesName))
+			So(d, ShouldResemble, desc)
+			So(indices, ShouldResemble, []uint64{0, 1, 2})
+
+			idxData, err := ioutil.ReadFile(filepath.Join(dir, IndexName))
+			So(err, ShouldBeNil)
+			var idx logpb.LogIndex
+			So(proto.Unmarshal(idxData, &idx), ShouldBeNil)
+			So(idx.LogEntryCount, ShouldEqual, 3)
+			So(idx.Entries, ShouldHaveLength, 3)
+
+			_, err = os.Stat(filepath.Join(dir, DataNamePrefix+DefaultDataExt))
+			So(os.IsNotExist(err), ShouldBeTrue)
+
+			So(o.Stats().SentMessages(), ShouldEqual, 3)
+			So(o.Stats().Errors(), ShouldEqual, 0)
+			So(bufFiles(), ShouldHaveLength, 0)
+
+			Convey(`Discards entries sent after archival.`, func() {
+				So(o.SendBundle(&logpb.ButlerLogBundle{Entries: []*logpb.ButlerLogBundle_Entry{
+					{Desc: desc, Logs: []*logpb.LogEntry{textEntry(3)}},
+				}}), ShouldBeNil)
+				So(o.Stats().DiscardedMessages(), ShouldEqual, 1)
+			})
+		})
+
+		Convey(`Archives incomplete log streams on Close, with a data file.`, func() {
+			desc.BinaryFileExt = "txt"
+			So(o.SendBundle(&logpb.ButlerLogBundle{Entries: []*logpb.ButlerLogBundle_Entry{
+				{Desc: desc, Logs: []*logpb.LogEntry{textEntry(0), textEntry(2)}},
+			}}), ShouldBeNil)
+
+			o.Close()
+			closed = true
+
+			_, indices := readEntries(filepath.Join(dir, EntriesName))
+			So(indices, ShouldResemble, []uint64{0, 2})
+
+			data, err := ioutil.ReadFile(filepath.Join(dir, DataNamePrefix+"txt"))
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, "0\n2\n")
+			So(bufFiles(), ShouldHaveLength, 0)
+
+			Convey(`Can be closed again.`, func() {
+				So(o.Close, ShouldNotPanic)
+			})
+		})
+
+		Convey(`Discards a log stream that can't be buffered.`, func() {
+			So(os.Remove(bufDir), ShouldBeNil)
+			So(o.SendBundle(&logpb.ButlerLogBundle{Entries: []*logpb.ButlerLogBundle_Entry{
+				{Desc: desc, Logs: []*logpb.LogEntry{textEntry(0)}, Terminal: true, TerminalIndex: 0},
+			}}), ShouldBeNil)
+			So(o.Stats().Errors(), ShouldEqual, 1)
+
+			o.Close()
+			closed = true
+			_, err := os.Stat(filepath.Join(dir, EntriesName))
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+	})
+}
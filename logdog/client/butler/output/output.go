@@ -59,6 +59,12 @@ type Stats interface {
 	DiscardedMessages() int64
 	// Errors returns the number of errors encountered during operation.
 	Errors() int64
+	// DroppedBytes returns the number of log data bytes that were dropped before
+	// reaching the Output because they exceeded a rate limit or quota.
+	DroppedBytes() int64
+	// DroppedEntries returns the number of log entries that were dropped before
+	// reaching the Output because they exceeded a rate limit or quota.
+	DroppedEntries() int64
 }
 
 // StatsBase is a simple implementation of the Stats interface.
@@ -68,6 +74,8 @@ type StatsBase struct {
 		SentMessages      int64 // The number of messages sent.
 		DiscardedMessages int64 // The number of messages that have been discarded.
 		Errors            int64 // The number of errors encountered.
+		DroppedBytes      int64 // The number of log data bytes dropped by limits.
+		DroppedEntries    int64 // The number of log entries dropped by limits.
 	}
 }
 
@@ -97,10 +105,22 @@ func (s *StatsBase) Errors() int64 {
 	return s.F.Errors
 }
 
+// DroppedBytes implements Stats.
+func (s *StatsBase) DroppedBytes() int64 {
+	return s.F.DroppedBytes
+}
+
+// DroppedEntries implements Stats.
+func (s *StatsBase) DroppedEntries() int64 {
+	return s.F.DroppedEntries
+}
+
 // Merge merges the values from one Stats block into another.
 func (s *StatsBase) Merge(o Stats) {
 	s.F.SentBytes += o.SentBytes()
 	s.F.SentMessages += o.SentMessages()
 	s.F.DiscardedMessages += o.DiscardedMessages()
 	s.F.Errors += o.Errors()
+	s.F.DroppedBytes += o.DroppedBytes()
+	s.F.DroppedEntries += o.DroppedEntries()
 }
@@ -23,6 +23,9 @@ func (t StreamType) DefaultContentType() types.ContentType {
 	case logpb.StreamType_TEXT:
 		return types.ContentTypeText
 
+	case logpb.StreamType_JSON:
+		return types.ContentTypeJSONLines
+
 	case logpb.StreamType_DATAGRAM:
 		return types.ContentTypeLogdogDatagram
 
@@ -45,6 +48,7 @@ var (
 		"text":     StreamType(logpb.StreamType_TEXT),
 		"binary":   StreamType(logpb.StreamType_BINARY),
 		"datagram": StreamType(logpb.StreamType_DATAGRAM),
+		"json":     StreamType(logpb.StreamType_JSON),
 	}
 )
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/luci/luci-go/common/clock"
 	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/retry/transient"
 	"github.com/luci/luci-go/logdog/api/logpb"
 	"github.com/luci/luci-go/logdog/client/coordinator"
 	"github.com/luci/luci-go/logdog/common/fetcher"
@@ -24,6 +25,10 @@ const (
 	defaultBytes = 1024 * 1024 * 1 // 1 MB
 
 	noStreamDelay = 5 * time.Second
+
+	// transientErrorDelay is the amount of time to wait before retrying a
+	// request that failed with a transient error when following a stream.
+	transientErrorDelay = 5 * time.Second
 )
 
 // coordinatorSource is a fetcher.Source implementation that uses the
@@ -35,6 +40,14 @@ type coordinatorSource struct {
 	tidx      types.MessageIndex
 	tailFirst bool
 
+	// follow, if true, causes transient errors to be retried indefinitely. It
+	// also causes gaps in archived log streams to be skipped rather than waited
+	// on, since an archived stream will never receive its missing entries.
+	follow bool
+	// missing is the number of log entries that were skipped because they were
+	// missing from an archived log stream.
+	missing int64
+
 	streamState *coordinator.LogStream
 }
 
@@ -50,24 +63,43 @@ func (s *coordinatorSource) LogEntries(c context.Context, req *fetcher.LogReques
 	)
 
 	// If we haven't terminated, use this opportunity to fetch/update our stream
-	// state.
+	// state. When following, we also need to know when the stream is archived.
 	var streamState coordinator.LogStream
-	reqStream := (s.streamState == nil || s.streamState.State.TerminalIndex < 0)
+	reqStream := (s.streamState == nil || s.streamState.State.TerminalIndex < 0 ||
+		(s.follow && !s.streamState.State.Archived))
 	if reqStream {
 		params = append(params, coordinator.WithState(&streamState))
 	}
 
+	nonContiguous := false
 	for {
 		logs, err := s.stream.Get(c, params...)
-		switch err {
-		case nil:
+		switch {
+		case err == nil:
 			if reqStream {
 				s.streamState = &streamState
 				s.tidx = streamState.State.TerminalIndex
 			}
-			return logs, s.tidx, nil
 
-		case coordinator.ErrNoSuchStream:
+			if len(logs) == 0 && !nonContiguous && s.canSkipGap(req.Index) {
+				// The requested index is missing from an archived stream, so it will
+				// never become available. Fetch whatever follows it instead.
+				log.Fields{
+					"index":         req.Index,
+					"terminalIndex": s.tidx,
+				}.Warningf(c, "Log entry is missing from archived stream. Skipping.")
+				params = append(params, coordinator.NonContiguous())
+				nonContiguous = true
+				continue
+			}
+
+			tidx := s.tidx
+			if nonContiguous {
+				tidx = s.accountGaps(c, req.Index, logs)
+			}
+			return logs, tidx, nil
+
+		case err == coordinator.ErrNoSuchStream:
 			log.WithError(err).Warningf(c, "Stream does not exist. Sleeping pending registration.")
 
 			// Delay, interrupting if our Context is interrupted.
@@ -75,15 +107,66 @@ func (s *coordinatorSource) LogEntries(c context.Context, req *fetcher.LogReques
 				return nil, 0, tr.Err
 			}
 
-		default:
-			if err != nil {
-				return nil, 0, err
+		case s.follow && transient.Tag.In(err):
+			log.WithError(err).Warningf(c, "Transient error fetching logs. Retrying.")
+
+			if tr := <-clock.After(c, transientErrorDelay); tr.Incomplete() {
+				return nil, 0, tr.Err
 			}
+
+		default:
+			return nil, 0, err
 		}
 	}
 }
 
+// canSkipGap returns true if a contiguous fetch starting at index returned no
+// logs and will never do so, because the stream is archived and index is
+// within its bounds.
+func (s *coordinatorSource) canSkipGap(index types.MessageIndex) bool {
+	return s.follow && s.streamState != nil && s.streamState.State.Archived &&
+		s.tidx >= 0 && index <= s.tidx
+}
+
+// accountGaps records the number of log entries missing from a non-contiguous
+// set of logs fetched starting at index, and returns the terminal index to
+// report to the fetcher.
+//
+// If no logs were returned, every entry from index through the terminal index
+// is missing. In this case, the returned terminal index is the one immediately
+// preceding index, so that the fetcher stops waiting for them.
+func (s *coordinatorSource) accountGaps(c context.Context, index types.MessageIndex, logs []*logpb.LogEntry) types.MessageIndex {
+	tidx := s.tidx
+	missing := int64(0)
+	if len(logs) == 0 {
+		missing = int64(s.tidx-index) + 1
+		tidx = index - 1
+	} else {
+		next := int64(index)
+		for _, le := range logs {
+			missing += int64(le.StreamIndex) - next
+			next = int64(le.StreamIndex) + 1
+		}
+	}
+
+	if missing > 0 {
+		log.Fields{
+			"index":   index,
+			"missing": missing,
+		}.Warningf(c, "Skipped log entries missing from archived stream.")
+		s.missing += missing
+	}
+	return tidx
+}
+
+// descriptor returns the descriptor from the most recently fetched stream state.
+//
+// It is safe to call concurrently with LogEntries: the stream state is replaced,
+// not modified, when it is refreshed.
 func (s *coordinatorSource) descriptor() (*logpb.LogStreamDescriptor, error) {
+	s.Lock()
+	defer s.Unlock()
+
 	if s.streamState != nil {
 		return &s.streamState.Desc, nil
 	}
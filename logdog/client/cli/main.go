@@ -162,6 +162,7 @@ func Main(ctx context.Context, params Parameters) int {
 				newQueryCommand(),
 				newListCommand(),
 				newLatestCommand(),
+				newTailCommand(),
 				authcli.SubcommandLogin(authOptions, "auth-login", false),
 				authcli.SubcommandLogout(authOptions, "auth-logout", false),
 				authcli.SubcommandInfo(authOptions, "auth-info", false),
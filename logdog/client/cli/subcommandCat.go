@@ -13,6 +13,7 @@ import (
 
 	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/flag/flagenum"
+	"github.com/luci/luci-go/common/flag/stringmapflag"
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/proto/google"
 	"github.com/luci/luci-go/common/proto/milo"
@@ -27,7 +28,13 @@ import (
 	"golang.org/x/net/context"
 )
 
-var errDatagramNotSupported = errors.New("datagram not supported")
+var (
+	errDatagramNotSupported = errors.New("datagram not supported")
+
+	// errMissingEntries is returned by catPath when following a log stream that
+	// terminated with some of its log entries missing.
+	errMissingEntries = errors.New("log stream is missing entries")
+)
 
 type timestampsFlag string
 
@@ -55,9 +62,14 @@ type catCommandRun struct {
 	fetchSize  int
 	fetchBytes int
 	raw        bool
+	follow     bool
 
 	timestamps      timestampsFlag
 	showStreamIndex bool
+
+	// where is the set of field values that a JSON stream's lines must have in
+	// order to be rendered.
+	where stringmapflag.Value
 }
 
 func newCatCommand() *subcommands.Command {
@@ -67,8 +79,14 @@ func newCatCommand() *subcommands.Command {
 		CommandRun: func() subcommands.CommandRun {
 			cmd := &catCommandRun{}
 
-			cmd.Flags.Int64Var(&cmd.index, "index", 0, "Starting index.")
+			cmd.Flags.Int64Var(&cmd.index, "index", 0,
+				"Starting index. If negative, counts back from the latest log entry in the stream "+
+					"(e.g., -1 starts at the latest log entry).")
 			cmd.Flags.Int64Var(&cmd.count, "count", 0, "The number of log entries to fetch.")
+			cmd.Flags.BoolVar(&cmd.follow, "follow", false,
+				"Keep polling for new log entries until the stream terminates, retrying transient "+
+					"errors. Gaps in archived streams are skipped. Exits with 0 if the full stream was "+
+					"written, or 3 if entries were missing from it. Cannot be used with -count.")
 			cmd.Flags.Var(&cmd.timestamps, "timestamps",
 				"When rendering text logs, prefix them with their timestamps. Options are: "+timestampFlagEnum.Choices())
 			cmd.Flags.BoolVar(&cmd.showStreamIndex, "show-stream-index", false,
@@ -78,6 +96,10 @@ func newCatCommand() *subcommands.Command {
 					"a larger buffer will have higher throughput.")
 			cmd.Flags.IntVar(&cmd.fetchSize, "fetch-size", 0, "Constrains the number of log entries to fetch per request.")
 			cmd.Flags.IntVar(&cmd.fetchBytes, "fetch-bytes", 0, "Constrains the number of bytes to fetch per request.")
+			cmd.Flags.Var(&cmd.where, "where",
+				"Only render JSON stream lines whose KEY field has VALUE, specified as KEY=VALUE. Nested "+
+					"fields are specified by joining their keys with \".\" (e.g., \"request.id\"). Can be "+
+					"specified multiple times, in which case all conditions must match.")
 			cmd.Flags.BoolVar(&cmd.raw, "raw", false,
 				"Reproduce original log stream, instead of attempting to render for humans.")
 			return cmd
@@ -133,6 +155,10 @@ func (cmd *catCommandRun) Run(scApp subcommands.Application, args []string, _ su
 			"value": cmd.buffer,
 		}.Errorf(a, "Buffer size must be >0.")
 	}
+	if cmd.follow && cmd.count != 0 {
+		log.Errorf(a, "-follow cannot be used with -count.")
+		return 1
+	}
 
 	coords := make(map[string]*coordinator.Client, len(addrs))
 	for _, addr := range addrs {
@@ -159,8 +185,11 @@ func (cmd *catCommandRun) Run(scApp subcommands.Application, args []string, _ su
 				"index":      i,
 			}.Errorf(a, "Failed to fetch log stream.")
 
-			if err == context.DeadlineExceeded {
+			switch err {
+			case context.DeadlineExceeded:
 				return 2
+			case errMissingEntries:
+				return 3
 			}
 			return 1
 		}
@@ -173,12 +202,18 @@ func (cmd *catCommandRun) catPath(c context.Context, coord *coordinator.Client,
 	// Pull stream information.
 	src := coordinatorSource{
 		stream: coord.Stream(addr.Project, addr.Path),
+		follow: cmd.follow,
 	}
 	src.tidx = -1 // Must be set to probe for state.
 
+	index, err := resolveStartIndex(c, src.stream, cmd.index)
+	if err != nil {
+		return err
+	}
+
 	f := fetcher.New(c, fetcher.Options{
 		Source:      &src,
-		Index:       types.MessageIndex(cmd.index),
+		Index:       index,
 		Count:       cmd.count,
 		BufferCount: cmd.fetchSize,
 		BufferBytes: int64(cmd.fetchBytes),
@@ -204,12 +239,68 @@ func (cmd *catCommandRun) catPath(c context.Context, coord *coordinator.Client,
 			return getDatagramWriter(c, desc)(w, dg)
 		},
 	}
+	if len(cmd.where) > 0 {
+		rend.TextFilter = func(le *logpb.LogEntry, line *logpb.Text_Line) bool {
+			return matchFields(line.Fields, cmd.where)
+		}
+	}
 	if _, err := io.CopyBuffer(os.Stdout, &rend, make([]byte, cmd.buffer)); err != nil {
 		return err
 	}
+
+	if cmd.follow {
+		src.Lock()
+		defer src.Unlock()
+
+		fields := log.Fields{
+			"project":       addr.Project,
+			"path":          addr.Path,
+			"terminalIndex": src.tidx,
+		}
+		if src.missing > 0 {
+			fields["missing"] = src.missing
+			fields.Warningf(c, "Log stream terminated with missing entries.")
+			return errMissingEntries
+		}
+		fields.Infof(c, "Log stream terminated.")
+	}
 	return nil
 }
 
+// resolveStartIndex returns the stream index to start fetching from. A
+// negative index is resolved relative to the stream's latest log entry, so -1
+// is the latest log entry.
+func resolveStartIndex(c context.Context, stream *coordinator.Stream, index int64) (types.MessageIndex, error) {
+	if index >= 0 {
+		return types.MessageIndex(index), nil
+	}
+
+	le, err := stream.Tail(c)
+	switch {
+	case err == coordinator.ErrNoSuchStream, err == nil && le == nil:
+		// No log entries yet, so start at the beginning.
+		return 0, nil
+	case err != nil:
+		return 0, errors.Annotate(err, "failed to tail log stream").Err()
+	}
+
+	index += int64(le.StreamIndex) + 1
+	if index < 0 {
+		index = 0
+	}
+	return types.MessageIndex(index), nil
+}
+
+// matchFields returns true if fields has every one of the values in where.
+func matchFields(fields map[string]string, where map[string]string) bool {
+	for k, v := range where {
+		if fv, ok := fields[k]; !ok || fv != v {
+			return false
+		}
+	}
+	return true
+}
+
 func (cmd *catCommandRun) getTextPrefix(desc *logpb.LogStreamDescriptor, le *logpb.LogEntry) string {
 	var parts []string
 	if cmd.timestamps != timestampsOff {
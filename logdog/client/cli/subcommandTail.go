@@ -0,0 +1,172 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cli
+
+import (
+	"bufio"
+	"os"
+	"time"
+
+	"github.com/luci/luci-go/common/clock/clockflag"
+	"github.com/luci/luci-go/common/errors"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/logdog/client/coordinator"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+
+	"github.com/maruel/subcommands"
+	"golang.org/x/net/context"
+)
+
+const (
+	// defaultTailDiscoverInterval is the default amount of time in between
+	// queries for new log streams.
+	defaultTailDiscoverInterval = 15 * time.Second
+
+	// defaultTailOrderWindow is the default amount of time that log lines are
+	// held for ordering before being written.
+	defaultTailOrderWindow = 2 * time.Second
+)
+
+type tailCommandRun struct {
+	subcommands.CommandRunBase
+
+	name       string
+	index      int64
+	discover   clockflag.Duration
+	window     clockflag.Duration
+	timestamps timestampsFlag
+}
+
+func newTailCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "tail [options] prefix",
+		ShortDesc: "Follow all text log streams under a prefix, interleaving their output.",
+		LongDesc: "Follow all text log streams under a prefix whose names match a glob, like a " +
+			"multi-file \"tail -f\". New log streams are discovered as they are registered. Log lines " +
+			"are written in timestamp order, each prefixed with the name of its log stream.\n\n" +
+			"Lines are held for -order-window before being written, so that lines from other log " +
+			"streams with earlier timestamps can be written ahead of them. Lines which arrive later " +
+			"than that are written as soon as possible.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &tailCommandRun{
+				discover: clockflag.Duration(defaultTailDiscoverInterval),
+				window:   clockflag.Duration(defaultTailOrderWindow),
+			}
+
+			cmd.Flags.StringVar(&cmd.name, "name", "**",
+				"Only follow log streams whose names match this glob (see \"query -path\").")
+			cmd.Flags.Int64Var(&cmd.index, "index", 0,
+				"Starting index for log streams which exist when the command starts. If negative, counts "+
+					"back from each log stream's latest log entry. Log streams discovered later are always "+
+					"written from their beginning.")
+			cmd.Flags.Var(&cmd.discover, "discover-interval",
+				"The amount of time in between queries for new log streams. "+clockflag.DurationHelp)
+			cmd.Flags.Var(&cmd.window, "order-window",
+				"The amount of time to hold log lines for timestamp ordering. "+clockflag.DurationHelp)
+			cmd.Flags.Var(&cmd.timestamps, "timestamps",
+				"Prefix log lines with their timestamps. Options are: "+timestampFlagEnum.Choices())
+			return cmd
+		},
+	}
+}
+
+func (cmd *tailCommandRun) Run(scApp subcommands.Application, args []string, _ subcommands.Env) int {
+	a := scApp.(*application)
+
+	if len(args) != 1 {
+		log.Errorf(a, "Exactly one argument, the log stream prefix, must be supplied.")
+		return 1
+	}
+	if cmd.discover <= 0 || cmd.window < 0 {
+		log.Errorf(a, "-discover-interval must be >0 and -order-window must be >=0.")
+		return 1
+	}
+
+	project, prefix, _, err := a.splitPath(args[0])
+	if err != nil {
+		log.WithError(err).Errorf(a, "Invalid path specifier.")
+		return 1
+	}
+	prefixName := types.StreamName(prefix).Trim()
+	if err := prefixName.Validate(); err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"prefix":     prefixName,
+		}.Errorf(a, "Invalid log stream prefix.")
+		return 1
+	}
+
+	coord, err := a.coordinatorClient("")
+	if err != nil {
+		errors.Log(a, errors.Annotate(err, "could not create Coordinator client").Err())
+		return 1
+	}
+
+	t := newTailer(
+		&coordinatorTailBackend{coord: coord, project: project},
+		prefixName.AsPathPrefix(types.StreamName(cmd.name)))
+	t.index = cmd.index
+	t.discover = time.Duration(cmd.discover)
+	t.window = time.Duration(cmd.window)
+	t.timestamps = cmd.timestamps
+
+	tctx, cancelFunc := a.timeoutCtx(a)
+	defer cancelFunc()
+
+	bw := bufio.NewWriter(os.Stdout)
+	defer bw.Flush()
+
+	switch err := t.run(tctx, bw); err {
+	case nil, context.Canceled:
+		return 0
+	case context.DeadlineExceeded:
+		return 2
+	default:
+		log.Fields{
+			log.ErrorKey: err,
+			"project":    project,
+			"path":       t.path,
+		}.Errorf(a, "Failed to tail log streams.")
+		return 1
+	}
+}
+
+// coordinatorTailBackend is a tailBackend implementation that uses the
+// Coordinator API.
+type coordinatorTailBackend struct {
+	coord   *coordinator.Client
+	project cfgtypes.ProjectName
+}
+
+func (b *coordinatorTailBackend) query(c context.Context, path types.StreamPath, cb func(types.StreamPath)) error {
+	qo := coordinator.QueryOptions{
+		StreamType: coordinator.Text,
+		Purged:     coordinator.No,
+	}
+	return b.coord.Query(c, b.project, string(path), qo, func(s *coordinator.LogStream) bool {
+		cb(s.Path)
+		return true
+	})
+}
+
+func (b *coordinatorTailBackend) stream(path types.StreamPath) tailStream {
+	s := &coordinatorTailStream{coordinatorSource{
+		stream: b.coord.Stream(b.project, path),
+		follow: true,
+	}}
+	s.tidx = -1 // Must be set to probe for state.
+	return s
+}
+
+// coordinatorTailStream is a tailStream implementation that uses the
+// Coordinator API.
+type coordinatorTailStream struct {
+	coordinatorSource
+}
+
+func (s *coordinatorTailStream) startIndex(c context.Context, index int64) (types.MessageIndex, error) {
+	return resolveStartIndex(c, s.stream, index)
+}
@@ -0,0 +1,310 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cli
+
+import (
+	"bufio"
+	"container/heap"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/common/retry/transient"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/fetcher"
+	"github.com/luci/luci-go/logdog/common/types"
+
+	"golang.org/x/net/context"
+)
+
+// tailBackend discovers and opens the log streams followed by a tailer.
+//
+// It is implemented on top of the Coordinator by coordinatorTailBackend.
+type tailBackend interface {
+	// query calls cb for every text log stream matching the path query. It
+	// returns when all log streams have been visited.
+	query(c context.Context, path types.StreamPath, cb func(types.StreamPath)) error
+	// stream returns the log stream at the given path.
+	stream(path types.StreamPath) tailStream
+}
+
+// tailStream is a single log stream followed by a tailer.
+//
+// Its fetcher.Source retries transient errors and waits for new log entries
+// until the log stream terminates.
+type tailStream interface {
+	fetcher.Source
+
+	// startIndex resolves a starting index. If index is negative, it counts back
+	// from the log stream's latest log entry.
+	startIndex(c context.Context, index int64) (types.MessageIndex, error)
+	// descriptor returns the log stream's descriptor. It is available after log
+	// entries have been fetched.
+	descriptor() (*logpb.LogStreamDescriptor, error)
+}
+
+// tailLine is a single line of text from a followed log stream.
+type tailLine struct {
+	// name is the name of the log stream that the line belongs to.
+	name types.StreamName
+	// ts is the line's timestamp.
+	ts time.Time
+	// text is the line's text, without its delimiter.
+	text string
+
+	// received is the time when the line was received by the tailer.
+	received time.Time
+	// seq is the order in which the line was received by the tailer. It is used
+	// to preserve the order of lines with the same timestamp.
+	seq int64
+}
+
+// tailLineHeap is a heap of tailLine, ordered by timestamp.
+type tailLineHeap []*tailLine
+
+func (h tailLineHeap) Len() int { return len(h) }
+func (h tailLineHeap) Less(i, j int) bool {
+	if !h[i].ts.Equal(h[j].ts) {
+		return h[i].ts.Before(h[j].ts)
+	}
+	return h[i].seq < h[j].seq
+}
+func (h tailLineHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *tailLineHeap) Push(x interface{}) { *h = append(*h, x.(*tailLine)) }
+func (h *tailLineHeap) Pop() interface{} {
+	old := *h
+	v := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return v
+}
+
+// tailer discovers and follows log streams, writing their interleaved lines.
+type tailer struct {
+	backend tailBackend
+	// path is the (globbed) path query to follow log streams for.
+	path types.StreamPath
+
+	// index is the starting index for log streams found by the first query.
+	index int64
+	// discover is the amount of time in between queries for new log streams.
+	discover time.Duration
+	// window is the amount of time to hold log lines for timestamp ordering.
+	window time.Duration
+	// timestamps controls the timestamps in line prefixes.
+	timestamps timestampsFlag
+
+	// lineC receives lines from all followed log streams.
+	lineC chan *tailLine
+	// streams is the set of log streams that are being followed. It is only
+	// accessed by the discovery goroutine.
+	streams map[types.StreamPath]struct{}
+}
+
+// newTailer creates a tailer following log streams matching path.
+func newTailer(backend tailBackend, path types.StreamPath) *tailer {
+	return &tailer{
+		backend:  backend,
+		path:     path,
+		discover: defaultTailDiscoverInterval,
+		window:   defaultTailOrderWindow,
+		lineC:    make(chan *tailLine),
+		streams:  make(map[types.StreamPath]struct{}),
+	}
+}
+
+// run discovers and follows log streams until c is done or discovery fails,
+// writing their lines to w.
+func (t *tailer) run(c context.Context, w *bufio.Writer) error {
+	c, cancelFunc := context.WithCancel(c)
+	defer cancelFunc()
+
+	discoverErrC := make(chan error, 1)
+	go func() {
+		discoverErrC <- t.discoverLoop(c)
+	}()
+
+	// Check for lines to write several times per ordering window.
+	flushInterval := t.window / 4
+	if flushInterval < 100*time.Millisecond {
+		flushInterval = 100 * time.Millisecond
+	}
+	timer := clock.NewTimer(c)
+	defer timer.Stop()
+	timer.Reset(flushInterval)
+
+	var pending tailLineHeap
+	seq := int64(0)
+	for {
+		select {
+		case line := <-t.lineC:
+			line.received = clock.Now(c)
+			line.seq = seq
+			seq++
+			heap.Push(&pending, line)
+
+		case <-timer.GetC():
+			if err := t.writeLines(w, &pending, clock.Now(c).Add(-t.window)); err != nil {
+				return err
+			}
+			timer.Reset(flushInterval)
+
+		case err := <-discoverErrC:
+			// Discovery only stops if it fails or our Context is done. Either way,
+			// write everything that we have.
+			if werr := t.writeLines(w, &pending, time.Time{}); werr != nil {
+				return werr
+			}
+			return err
+		}
+	}
+}
+
+// writeLines writes pending lines in timestamp order, stopping at the first
+// line which was received after the cutoff time. If cutoff is zero, all pending
+// lines are written.
+func (t *tailer) writeLines(w *bufio.Writer, pending *tailLineHeap, cutoff time.Time) error {
+	for pending.Len() > 0 {
+		line := (*pending)[0]
+		if !cutoff.IsZero() && line.received.After(cutoff) {
+			break
+		}
+		heap.Pop(pending)
+
+		if _, err := w.WriteString(t.linePrefix(line)); err != nil {
+			return err
+		}
+		if _, err := w.WriteString(line.text); err != nil {
+			return err
+		}
+		if err := w.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+func (t *tailer) linePrefix(line *tailLine) string {
+	parts := make([]string, 0, 2)
+	switch t.timestamps {
+	case timestampsLocal:
+		parts = append(parts, line.ts.Local().Format(time.StampMilli))
+	case timestampsUTC:
+		parts = append(parts, line.ts.UTC().Format(time.StampMilli))
+	}
+	parts = append(parts, string(line.name))
+	return strings.Join(parts, " ") + "| "
+}
+
+// discoverLoop periodically queries for log streams matching the tailer's
+// path, and starts following any that it hasn't seen before.
+//
+// Log streams that exist on the first successful query start at the configured
+// index. Log streams discovered afterwards start at their beginning.
+func (t *tailer) discoverLoop(c context.Context) error {
+	first := true
+	for {
+		var found []types.StreamPath
+		err := t.backend.query(c, t.path, func(path types.StreamPath) {
+			if _, ok := t.streams[path]; !ok {
+				t.streams[path] = struct{}{}
+				found = append(found, path)
+			}
+		})
+		switch {
+		case err == nil:
+		case c.Err() != nil:
+			return c.Err()
+		case transient.Tag.In(err):
+			log.WithError(err).Warningf(c, "Transient error querying for log streams. Retrying.")
+		default:
+			return errors.Annotate(err, "failed to query for log streams").Err()
+		}
+
+		index := int64(0)
+		if first {
+			index = t.index
+		}
+		for _, path := range found {
+			log.Fields{
+				"path": path,
+			}.Infof(c, "Following log stream.")
+			go t.follow(c, path, index)
+		}
+		if err == nil {
+			first = false
+		}
+
+		if tr := clock.Sleep(c, t.discover); tr.Incomplete() {
+			return tr.Err
+		}
+	}
+}
+
+// follow fetches a single log stream until it terminates, sending its lines to
+// the tailer's line channel.
+func (t *tailer) follow(c context.Context, path types.StreamPath, index int64) {
+	_, name := path.Split()
+	stream := t.backend.stream(path)
+
+	startIndex, err := stream.startIndex(c, index)
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"path":       path,
+		}.Errorf(c, "Failed to resolve starting index.")
+		return
+	}
+
+	f := fetcher.New(c, fetcher.Options{
+		Source: stream,
+		Index:  startIndex,
+	})
+	for {
+		le, err := f.NextLogEntry()
+		switch err {
+		case nil:
+		case io.EOF:
+			log.Fields{
+				"path": path,
+			}.Infof(c, "Log stream terminated.")
+			return
+		case context.Canceled, context.DeadlineExceeded:
+			return
+		default:
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       path,
+			}.Errorf(c, "Failed to fetch log stream.")
+			return
+		}
+
+		desc, err := stream.descriptor()
+		if err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       path,
+			}.Errorf(c, "Failed to get log stream descriptor.")
+			return
+		}
+		ts := google.TimeFromProto(desc.Timestamp).Add(google.DurationFromProto(le.TimeOffset))
+
+		for _, l := range le.GetText().GetLines() {
+			line := &tailLine{
+				name: name,
+				ts:   ts,
+				text: l.Value,
+			}
+			select {
+			case t.lineC <- line:
+			case <-c.Done():
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/common/retry/transient"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/fetcher"
+	"github.com/luci/luci-go/logdog/common/types"
+
+	"golang.org/x/net/context"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var testTailEpoch = time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// fakeTailStream is a terminated log stream with one text line per log entry.
+type fakeTailStream struct {
+	entries []*logpb.LogEntry
+}
+
+func newFakeTailStream(offset time.Duration, lines ...string) *fakeTailStream {
+	s := &fakeTailStream{}
+	for i, l := range lines {
+		s.entries = append(s.entries, &logpb.LogEntry{
+			StreamIndex: uint64(i),
+			TimeOffset:  google.NewDuration(offset + time.Duration(i)*time.Second),
+			Content: &logpb.LogEntry_Text{Text: &logpb.Text{
+				Lines: []*logpb.Text_Line{{Value: l}},
+			}},
+		})
+	}
+	return s
+}
+
+func (s *fakeTailStream) LogEntries(c context.Context, req *fetcher.LogRequest) ([]*logpb.LogEntry, types.MessageIndex, error) {
+	var logs []*logpb.LogEntry
+	if int(req.Index) < len(s.entries) {
+		logs = s.entries[req.Index:]
+	}
+	if req.Count > 0 && len(logs) > req.Count {
+		logs = logs[:req.Count]
+	}
+	return logs, types.MessageIndex(len(s.entries) - 1), nil
+}
+
+func (s *fakeTailStream) startIndex(c context.Context, index int64) (types.MessageIndex, error) {
+	return types.MessageIndex(index), nil
+}
+
+func (s *fakeTailStream) descriptor() (*logpb.LogStreamDescriptor, error) {
+	return &logpb.LogStreamDescriptor{Timestamp: google.NewTimestamp(testTailEpoch)}, nil
+}
+
+// fakeTailBackend serves fakeTailStreams.
+type fakeTailBackend struct {
+	sync.Mutex
+
+	streams map[types.StreamPath]*fakeTailStream
+	// queryErrs are returned by consecutive queries instead of their results.
+	// nil entries let the query succeed.
+	queryErrs []error
+	// onQuery, if not nil, is called before the n'th query (starting with 1).
+	onQuery func(n int)
+	queries int
+}
+
+func (b *fakeTailBackend) query(c context.Context, path types.StreamPath, cb func(types.StreamPath)) error {
+	b.Lock()
+	b.queries++
+	n := b.queries
+	b.Unlock()
+
+	if b.onQuery != nil {
+		b.onQuery(n)
+	}
+	if n <= len(b.queryErrs) && b.queryErrs[n-1] != nil {
+		return b.queryErrs[n-1]
+	}
+
+	b.Lock()
+	paths := make([]string, 0, len(b.streams))
+	for p := range b.streams {
+		paths = append(paths, string(p))
+	}
+	b.Unlock()
+
+	sort.Strings(paths)
+	for _, p := range paths {
+		cb(types.StreamPath(p))
+	}
+	return nil
+}
+
+func (b *fakeTailBackend) stream(path types.StreamPath) tailStream {
+	b.Lock()
+	defer b.Unlock()
+	return b.streams[path]
+}
+
+func (b *fakeTailBackend) addStream(path types.StreamPath, s *fakeTailStream) {
+	b.Lock()
+	defer b.Unlock()
+	b.streams[path] = s
+}
+
+// syncBuffer is a bytes.Buffer that can be read while it is written to.
+type syncBuffer struct {
+	sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.Lock()
+	defer b.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.Lock()
+	defer b.Unlock()
+	return b.buf.String()
+}
+
+func TestTailer(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A tailer with a fake backend`, t, func() {
+		c, tc := testclock.UseTime(context.Background(), testTailEpoch)
+		c, cancelFunc := context.WithCancel(c)
+		defer cancelFunc()
+
+		be := &fakeTailBackend{streams: map[types.StreamPath]*fakeTailStream{}}
+		tl := newTailer(be, "a/+/**")
+		tl.discover = time.Hour
+		tl.window = 0
+		flushInterval := 100 * time.Millisecond // the minimum, see tailer.run
+
+		// discoverC and flushC are signaled when the tailer starts waiting for the
+		// next discovery round and the next flush, respectively.
+		discoverC := make(chan struct{}, 1)
+		flushC := make(chan struct{}, 1)
+		signal := func(ch chan struct{}) {
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		}
+		tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+			switch d {
+			case tl.discover:
+				signal(discoverC)
+			case flushInterval:
+				signal(flushC)
+			}
+		})
+
+		Convey(`follow resumes from the index and stops when the stream terminates`, func() {
+			be.addStream("a/+/s", newFakeTailStream(0, "0", "1", "2", "3", "4"))
+			tl.lineC = make(chan *tailLine, 10)
+
+			// follow returns on its own once the terminal entry is fetched.
+			tl.follow(c, "a/+/s", 2)
+			close(tl.lineC)
+
+			var lines []string
+			for l := range tl.lineC {
+				So(l.name, ShouldEqual, types.StreamName("s"))
+				lines = append(lines, fmt.Sprintf("%s@%s", l.text, l.ts.Sub(testTailEpoch)))
+			}
+			So(lines, ShouldResemble, []string{"2@2s", "3@3s", "4@4s"})
+		})
+
+		Convey(`run starts only initially found streams at the index`, func() {
+			be.addStream("a/+/one", newFakeTailStream(0, "one-0", "one-1", "one-2"))
+			be.onQuery = func(n int) {
+				if n == 2 {
+					be.addStream("a/+/two", newFakeTailStream(time.Minute, "two-0", "two-1"))
+				}
+			}
+			tl.index = 1
+
+			out := &syncBuffer{}
+			errC := make(chan error, 1)
+			go func() {
+				errC <- tl.run(c, bufio.NewWriter(out))
+			}()
+
+			// flushUntil flushes pending lines until the output is as expected.
+			flushUntil := func(expected string) {
+				for out.String() != expected {
+					<-flushC
+					tc.Add(flushInterval)
+				}
+			}
+
+			// The first query finds "one".
+			<-discoverC
+			flushUntil("" +
+				"one| one-1\n" +
+				"one| one-2\n")
+
+			// The second query finds "two", which is followed from its beginning.
+			tc.Add(tl.discover)
+			<-discoverC
+			flushUntil("" +
+				"one| one-1\n" +
+				"one| one-2\n" +
+				"two| two-0\n" +
+				"two| two-1\n")
+
+			cancelFunc()
+			So(<-errC, ShouldEqual, context.Canceled)
+		})
+
+		Convey(`run retries transient query errors and stops on fatal ones`, func() {
+			be.queryErrs = []error{
+				errors.New("boom", transient.Tag),
+				errors.New("fatal"),
+			}
+			errC := make(chan error, 1)
+			go func() {
+				errC <- tl.run(c, bufio.NewWriter(&bytes.Buffer{}))
+			}()
+
+			// Retried after the discovery interval.
+			<-discoverC
+			tc.Add(tl.discover)
+
+			So(<-errC, ShouldErrLike, "failed to query for log streams")
+			So(be.queries, ShouldEqual, 2)
+		})
+	})
+}
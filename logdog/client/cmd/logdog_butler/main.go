@@ -30,6 +30,7 @@ import (
 	"github.com/luci/luci-go/common/runtime/profiling"
 	grpcLogging "github.com/luci/luci-go/grpc/logging"
 	"github.com/luci/luci-go/logdog/client/butler"
+	"github.com/luci/luci-go/logdog/client/butler/bundler"
 	"github.com/luci/luci-go/logdog/client/butler/output"
 	"github.com/luci/luci-go/logdog/client/butlerlib/streamproto"
 	"github.com/luci/luci-go/logdog/common/types"
@@ -72,6 +73,11 @@ type application struct {
 	maxBufferAge clockflag.Duration
 	noBufferLogs bool
 
+	streamRateLimit bundler.RateLimit
+	globalRateLimit bundler.RateLimit
+	streamByteQuota int64
+	limitMode       bundler.LimitMode
+
 	prof profiling.Profiler
 
 	client *http.Client
@@ -115,6 +121,19 @@ func (a *application) addFlags(fs *flag.FlagSet) {
 			"of wire-format efficiency.")
 	fs.Var(&a.ioKeepAliveInterval, "io-keepalive-stderr",
 		"If supplied, periodically write messages to STDERR if data is received on any Butler stream.")
+	fs.Int64Var(&a.streamRateLimit.BytesPerSecond, "stream-rate-limit-bytes", 0,
+		"If >0, the maximum number of bytes per second that each stream may send.")
+	fs.Int64Var(&a.streamRateLimit.EntriesPerSecond, "stream-rate-limit-entries", 0,
+		"If >0, the maximum number of log entries per second that each stream may send.")
+	fs.Int64Var(&a.globalRateLimit.BytesPerSecond, "rate-limit-bytes", 0,
+		"If >0, the maximum number of bytes per second that all streams together may send.")
+	fs.Int64Var(&a.globalRateLimit.EntriesPerSecond, "rate-limit-entries", 0,
+		"If >0, the maximum number of log entries per second that all streams together may send.")
+	fs.Int64Var(&a.streamByteQuota, "stream-byte-quota", 0,
+		"If >0, the maximum number of bytes that each stream may send. Data beyond this is dropped.")
+	fs.Var(&a.limitMode, "rate-limit-mode",
+		"How rate limits are enforced: 'block' makes writers wait, 'truncate' drops text stream "+
+			"data and replaces it with a marker line.")
 }
 
 func (a *application) authenticator(ctx context.Context) (*auth.Authenticator, error) {
@@ -158,6 +177,10 @@ func (a *application) runWithButler(out output.Output, runFunc func(*butler.Butl
 		TeeStderr:           os.Stderr,
 		IOKeepAliveInterval: time.Duration(a.ioKeepAliveInterval),
 		IOKeepAliveWriter:   os.Stderr,
+		StreamRateLimit:     a.streamRateLimit,
+		GlobalRateLimit:     a.globalRateLimit,
+		StreamByteQuota:     a.streamByteQuota,
+		LimitMode:           a.limitMode,
 	}
 	b, err := butler.New(a, butlerOpts)
 	if err != nil {
@@ -0,0 +1,55 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+
+	"github.com/luci/luci-go/common/flag/multiflag"
+	"github.com/luci/luci-go/logdog/client/butler/output"
+	archiveOutput "github.com/luci/luci-go/logdog/client/butler/output/archive"
+)
+
+func init() {
+	registerOutputFactory(&archiveOutputFactory{})
+}
+
+type archiveOutputFactory struct {
+	archiveOutput.Options
+}
+
+func (f *archiveOutputFactory) option() multiflag.Option {
+	opt := newOutputOption("archive", "Output that writes log streams to local LogDog archive files.", f)
+
+	flags := opt.Flags()
+	flags.StringVar(&f.Path, "path", "",
+		"Base directory to write archives to. Each log stream is written to <path>/<project>/<stream path>.")
+	flags.StringVar(&f.TempDir, "temp-dir", "",
+		"Directory to buffer log entries in until their log stream is archived. Defaults to the system temporary directory.")
+	flags.BoolVar(&f.AlwaysRender, "always-render", false,
+		"Write a data file for every log stream, not just those with a binary file extension.")
+	flags.IntVar(&f.StreamIndexRange, "index-stream-range", 0,
+		"If >0, the maximum number of log entries in between index entries.")
+	flags.IntVar(&f.PrefixIndexRange, "index-prefix-range", 0,
+		"If >0, the maximum number of log prefix indices in between index entries.")
+	flags.IntVar(&f.ByteRange, "index-byte-range", 0,
+		"If >0, the maximum number of log entry bytes in between index entries.")
+	flags.BoolVar(&f.Compress, "compress", false,
+		"Compress log entry archives into blocks, each of which begins at an index entry.")
+	flags.BoolVar(&f.Track, "track", false,
+		"Track each sent message and dump at the end. This adds CPU/memory overhead.")
+
+	return opt
+}
+
+func (f *archiveOutputFactory) configOutput(a *application) (output.Output, error) {
+	if f.Path == "" {
+		return nil, errors.New("missing required output path")
+	}
+	f.Project = a.project
+	return f.New(a), nil
+}
+
+func (f *archiveOutputFactory) scopes() []string { return nil }
@@ -51,6 +51,8 @@ const (
 	Binary
 	// Datagram selects only datagram streams.
 	Datagram
+	// JSON selects only JSON-lines streams.
+	JSON
 )
 
 // queryValue returns the StreamType for a specified QueryStreamType parameter.
@@ -63,6 +65,8 @@ func (t QueryStreamType) queryValue() logpb.StreamType {
 		return logpb.StreamType_BINARY
 	case Datagram:
 		return logpb.StreamType_DATAGRAM
+	case JSON:
+		return logpb.StreamType_JSON
 	default:
 		return -1
 	}
@@ -7,6 +7,9 @@
 package archive
 
 import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
 	"io"
 
 	"github.com/luci/luci-go/common/data/recordio"
@@ -18,6 +21,10 @@ import (
 	"github.com/golang/protobuf/proto"
 )
 
+// DefaultBlockSize is the default maximum number of uncompressed log stream
+// bytes in a compressed block.
+const DefaultBlockSize = 256 * 1024
+
 // Manifest is a set of archival parameters.
 type Manifest struct {
 	// Desc is the logpb.LogStreamDescriptor for the stream.
@@ -48,6 +55,17 @@ type Manifest struct {
 	// successive index entries.
 	ByteRange int
 
+	// Compression is the compression scheme to apply to the log stream. See
+	// logpb.LogIndex_Compression for a description of the compressed format.
+	//
+	// If the log stream is compressed and no index constraints are set, an index
+	// entry will be emitted every BlockSize bytes.
+	Compression logpb.LogIndex_Compression
+	// BlockSize, if >0, is the maximum number of uncompressed log stream bytes
+	// in a compressed block. Blocks may exceed this by up to one LogEntry. If
+	// <=0, DefaultBlockSize will be used.
+	BlockSize int
+
 	// Logger, if not nil, will be used to log status during archival.
 	Logger logging.Logger
 
@@ -70,9 +88,22 @@ func Archive(m Manifest) error {
 		Source:   m.Source,
 	}
 
-	// If no constraints are applied, index every LogEntry.
+	if m.BlockSize <= 0 {
+		m.BlockSize = DefaultBlockSize
+	}
+
+	// If no constraints are applied, index every LogEntry. Each index entry
+	// begins a compressed block, so compressed log streams are indexed once per
+	// block instead.
 	if m.StreamIndexRange <= 0 && m.PrefixIndexRange <= 0 && m.ByteRange <= 0 {
-		m.StreamIndexRange = 1
+		switch m.Compression {
+		case logpb.LogIndex_NONE:
+			m.StreamIndexRange = 1
+		case logpb.LogIndex_ZLIB:
+			m.ByteRange = m.BlockSize
+		default:
+			return fmt.Errorf("unsupported compression: %v", m.Compression)
+		}
 	}
 
 	// If we're constructing an index, allocate a stateful index builder.
@@ -81,7 +112,8 @@ func Archive(m Manifest) error {
 		idx = &indexBuilder{
 			Manifest: &m,
 			index: logpb.LogIndex{
-				Desc: m.Desc,
+				Desc:        m.Desc,
+				Compression: m.Compression,
 			},
 			sizeFunc: m.sizeFunc,
 		}
@@ -93,7 +125,13 @@ func Archive(m Manifest) error {
 			logC = make(chan *logpb.LogEntry)
 
 			taskC <- func() error {
-				if err := archiveLogs(m.LogWriter, m.Desc, logC, idx); err != nil {
+				var fw frameWriter
+				if m.Compression == logpb.LogIndex_ZLIB {
+					fw = &blockFrameWriter{w: m.LogWriter, blockSize: m.BlockSize}
+				} else {
+					fw = &plainFrameWriter{w: m.LogWriter}
+				}
+				if err := archiveLogs(fw, m.Desc, logC, idx); err != nil {
 					return err
 				}
 
@@ -154,17 +192,13 @@ func Archive(m Manifest) error {
 	return err
 }
 
-func archiveLogs(w io.Writer, d *logpb.LogStreamDescriptor, logC <-chan *logpb.LogEntry, idx *indexBuilder) error {
-	offset := int64(0)
+func archiveLogs(fw frameWriter, d *logpb.LogStreamDescriptor, logC <-chan *logpb.LogEntry, idx *indexBuilder) error {
 	out := func(pb proto.Message) error {
 		d, err := proto.Marshal(pb)
 		if err != nil {
 			return err
 		}
-
-		count, err := recordio.WriteFrame(w, d)
-		offset += int64(count)
-		return err
+		return fw.writeFrame(d)
 	}
 
 	// Start with our descriptor protobuf. Defer error handling until later, as
@@ -175,11 +209,111 @@ func archiveLogs(w io.Writer, d *logpb.LogStreamDescriptor, logC <-chan *logpb.L
 			continue
 		}
 
-		// Add this LogEntry to our index, noting the current offset.
+		// Add this LogEntry to our index, noting the current offset. If it is
+		// indexed, start a new block so that its index entry can reference it.
 		if idx != nil {
-			idx.addLogEntry(le, offset)
+			if e := idx.addLogEntry(le, fw.offset()); e != nil {
+				if err = fw.startBlock(); err != nil {
+					continue
+				}
+				e.Offset = uint64(fw.offset())
+			}
 		}
 		err = out(le)
 	}
+	if err != nil {
+		return err
+	}
+	return fw.close()
+}
+
+// frameWriter writes RecordIO frames to an archived log stream.
+type frameWriter interface {
+	// writeFrame writes a single frame.
+	writeFrame(d []byte) error
+	// offset returns the offset of the block that the next frame will be
+	// written to.
+	offset() int64
+	// startBlock causes the next frame to begin a new block.
+	startBlock() error
+	// close writes any buffered frames.
+	close() error
+}
+
+// plainFrameWriter is a frameWriter that writes uncompressed frames. Each frame
+// is its own block.
+type plainFrameWriter struct {
+	w     io.Writer
+	count int64
+}
+
+func (fw *plainFrameWriter) writeFrame(d []byte) error {
+	count, err := recordio.WriteFrame(fw.w, d)
+	fw.count += int64(count)
 	return err
 }
+
+func (fw *plainFrameWriter) offset() int64     { return fw.count }
+func (fw *plainFrameWriter) startBlock() error { return nil }
+func (fw *plainFrameWriter) close() error      { return nil }
+
+// blockFrameWriter is a frameWriter that buffers frames into blocks, writing
+// each block as a zlib-compressed frame.
+//
+// The first block only contains the first frame written, which is the log
+// stream's descriptor.
+type blockFrameWriter struct {
+	w         io.Writer
+	blockSize int
+
+	// count is the number of bytes written to w.
+	count int64
+	// block is the current, uncompressed block.
+	block bytes.Buffer
+	// frames is the number of frames that have been written.
+	frames int
+
+	// compressed is a reusable buffer for compressed block data.
+	compressed bytes.Buffer
+	zw         *zlib.Writer
+}
+
+func (fw *blockFrameWriter) writeFrame(d []byte) error {
+	if _, err := recordio.WriteFrame(&fw.block, d); err != nil {
+		return err
+	}
+	fw.frames++
+
+	if fw.frames == 1 || fw.block.Len() >= fw.blockSize {
+		return fw.startBlock()
+	}
+	return nil
+}
+
+func (fw *blockFrameWriter) offset() int64 { return fw.count }
+
+func (fw *blockFrameWriter) startBlock() error {
+	if fw.block.Len() == 0 {
+		return nil
+	}
+
+	fw.compressed.Reset()
+	if fw.zw == nil {
+		fw.zw = zlib.NewWriter(&fw.compressed)
+	} else {
+		fw.zw.Reset(&fw.compressed)
+	}
+	if _, err := fw.zw.Write(fw.block.Bytes()); err != nil {
+		return err
+	}
+	if err := fw.zw.Close(); err != nil {
+		return err
+	}
+	fw.block.Reset()
+
+	count, err := recordio.WriteFrame(fw.w, fw.compressed.Bytes())
+	fw.count += int64(count)
+	return err
+}
+
+func (fw *blockFrameWriter) close() error { return fw.startBlock() }
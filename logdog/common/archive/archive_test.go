@@ -6,8 +6,10 @@ package archive
 
 import (
 	"bytes"
+	"compress/zlib"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"strconv"
 	"testing"
 	"time"
@@ -414,5 +416,94 @@ func TestArchive(t *testing.T) {
 				})
 			})
 		})
+
+		Convey(`When compressing the log stream`, func() {
+			ts.add(0, 1, 2, 3, 4, 5)
+			m.Compression = logpb.LogIndex_ZLIB
+
+			// readBlocks decompresses each block in the log stream, returning the
+			// stream indices of the log entries in each block, keyed on the block's
+			// offset. The descriptor block is keyed on -1.
+			readBlocks := func() map[int64][]uint64 {
+				blocks := map[int64][]uint64{}
+				cr := iotools.CountingReader{Reader: &logB}
+				r := recordio.NewReader(&cr, 1024*1024)
+				for {
+					offset := cr.Count
+					d, err := r.ReadFrameAll()
+					if err == io.EOF {
+						return blocks
+					}
+					So(err, ShouldBeNil)
+
+					zr, err := zlib.NewReader(bytes.NewReader(d))
+					So(err, ShouldBeNil)
+					frames, err := ioutil.ReadAll(zr)
+					So(err, ShouldBeNil)
+					records, err := recordio.Split(frames)
+					So(err, ShouldBeNil)
+
+					if offset == 0 {
+						var d logpb.LogStreamDescriptor
+						So(records, ShouldHaveLength, 1)
+						So(proto.Unmarshal(records[0], &d), ShouldBeNil)
+						So(&d, ShouldResemble, desc)
+						blocks[-1] = nil
+						continue
+					}
+
+					indices := []uint64{}
+					for _, rec := range records {
+						var le logpb.LogEntry
+						So(proto.Unmarshal(rec, &le), ShouldBeNil)
+						indices = append(indices, le.StreamIndex)
+					}
+					blocks[offset] = indices
+				}
+			}
+
+			Convey(`Begins a block at each index entry.`, func() {
+				m.StreamIndexRange = 2
+				So(Archive(m), ShouldBeNil)
+
+				blocks := readBlocks()
+				So(blocks, ShouldHaveLength, 4)
+
+				var index logpb.LogIndex
+				So(proto.Unmarshal(indexB.Bytes(), &index), ShouldBeNil)
+				So(index.Compression, ShouldEqual, logpb.LogIndex_ZLIB)
+				So(index.LastStreamIndex, ShouldEqual, 5)
+				So(index.LogEntryCount, ShouldEqual, 6)
+
+				// The last log entry doesn't begin a block, so it isn't indexed.
+				So(index.Entries, ShouldHaveLength, 3)
+				for i, e := range index.Entries {
+					So(e.StreamIndex, ShouldEqual, i*2)
+					So(blocks[int64(e.Offset)], ShouldResemble, []uint64{e.StreamIndex, e.StreamIndex + 1})
+				}
+			})
+
+			Convey(`Limits the size of blocks.`, func() {
+				m.StreamIndexRange = 6
+				m.BlockSize = 1
+				So(Archive(m), ShouldBeNil)
+
+				// The descriptor, plus one block per log entry.
+				So(readBlocks(), ShouldHaveLength, 7)
+
+				var index logpb.LogIndex
+				So(proto.Unmarshal(indexB.Bytes(), &index), ShouldBeNil)
+				So(index.Entries, ShouldHaveLength, 1)
+			})
+
+			Convey(`Indexes each block if no index constraints are set.`, func() {
+				m.BlockSize = 1
+				So(Archive(m), ShouldBeNil)
+
+				var index logpb.LogIndex
+				So(proto.Unmarshal(indexB.Bytes(), &index), ShouldBeNil)
+				So(index.Entries, ShouldHaveLength, 6)
+			})
+		})
 	})
 }
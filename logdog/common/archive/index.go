@@ -25,7 +25,9 @@ type indexBuilder struct {
 	sizeFunc func(proto.Message) int
 }
 
-func (i *indexBuilder) addLogEntry(le *logpb.LogEntry, offset int64) {
+// addLogEntry adds a LogEntry, located at offset, to the index. If the LogEntry
+// is given an index entry, that entry is returned.
+func (i *indexBuilder) addLogEntry(le *logpb.LogEntry, offset int64) *logpb.LogIndex_Entry {
 	// Only calculate the size if we actually use it.
 	if i.ByteRange > 0 {
 		i.lastBytes += uint64(i.size(le))
@@ -51,7 +53,7 @@ func (i *indexBuilder) addLogEntry(le *logpb.LogEntry, offset int64) {
 			(i.ByteRange > 0 && i.lastBytes >= uint64(i.ByteRange))) {
 			// Not going to index this entry. Buffer it as a terminator.
 			i.latestBufferedEntry = &entry
-			return
+			return nil
 		}
 
 		i.lastBytes = 0
@@ -63,11 +65,14 @@ func (i *indexBuilder) addLogEntry(le *logpb.LogEntry, offset int64) {
 	// Update our counters.
 	i.lastStreamIndex = le.StreamIndex
 	i.lastPrefixIndex = le.PrefixIndex
+	return &entry
 }
 
 func (i *indexBuilder) emit(w io.Writer) error {
-	// Always include the last stream entry in the index.
-	if i.latestBufferedEntry != nil {
+	// Always include the last stream entry in the index. Compressed log streams
+	// can only index entries which begin a block, so the last stream entry is
+	// omitted if it doesn't.
+	if i.latestBufferedEntry != nil && i.index.Compression == logpb.LogIndex_NONE {
 		i.index.Entries = append(i.index.Entries, i.latestBufferedEntry)
 	}
 
@@ -7,6 +7,7 @@
 //
 //   - Text streams are rendered by emitting the logs and their newlines in
 //     order.
+//   - JSON streams are rendered like text streams.
 //   - Binary streams are rendered by emitting the sequential binary data
 //     verbatim.
 package renderer
@@ -46,6 +47,13 @@ type Renderer struct {
 	// resulting string is prepended to that text line on render.
 	TextPrefix func(le *logpb.LogEntry, line *logpb.Text_Line) string
 
+	// TextFilter, if not nil, is called to decide whether a text line should be
+	// rendered. If it returns false, the line will be skipped.
+	//
+	// A line may be split into several segments across log entries. TextFilter
+	// is called with the line's final segment, which holds the line's Fields.
+	TextFilter func(le *logpb.LogEntry, line *logpb.Text_Line) bool
+
 	// DatagramWriter is a function to call to render a complete datagram stream.
 	// If it returns false, or if nil, a hex dump renderer will be used to
 	// render the datagram.
@@ -61,6 +69,13 @@ type Renderer struct {
 
 	// dgBuf is a buffer used for partial datagrams.
 	dgBuf bytes.Buffer
+
+	// lineBuf is a buffer used for partial text lines when filtering.
+	lineBuf bytes.Buffer
+	// lineLE and line are the log entry and segment most recently added to
+	// lineBuf.
+	lineLE *logpb.LogEntry
+	line   *logpb.Text_Line
 }
 
 var _ io.Reader = (*Renderer)(nil)
@@ -92,15 +107,16 @@ func (r *Renderer) bufferNext() error {
 		switch {
 		case le.GetText() != nil:
 			for _, line := range le.GetText().Lines {
-				if r.TextPrefix != nil {
-					r.buf.WriteString(r.TextPrefix(le, line))
+				if r.TextFilter == nil {
+					r.renderLine(&r.buf, le, line)
+					continue
 				}
 
-				r.buf.WriteString(line.Value)
-				if !r.Raw {
-					r.buf.WriteRune('\n')
-				} else {
-					r.buf.WriteString(line.Delimiter)
+				// Buffer the line until it's complete, then filter it.
+				r.renderLine(&r.lineBuf, le, line)
+				r.lineLE, r.line = le, line
+				if line.Delimiter != "" || len(line.Fields) > 0 {
+					r.flushLine()
 				}
 			}
 
@@ -145,9 +161,36 @@ func (r *Renderer) bufferNext() error {
 		}
 	}
 
+	if err != nil && r.line != nil {
+		// The stream ended with an undelimited line.
+		r.flushLine()
+	}
 	return err
 }
 
+func (r *Renderer) renderLine(buf *bytes.Buffer, le *logpb.LogEntry, line *logpb.Text_Line) {
+	if r.TextPrefix != nil {
+		buf.WriteString(r.TextPrefix(le, line))
+	}
+
+	buf.WriteString(line.Value)
+	if !r.Raw {
+		buf.WriteRune('\n')
+	} else {
+		buf.WriteString(line.Delimiter)
+	}
+}
+
+// flushLine renders the buffered text line if it passes TextFilter, and resets
+// the line buffer.
+func (r *Renderer) flushLine() {
+	if r.TextFilter(r.lineLE, r.line) {
+		r.buf.Write(r.lineBuf.Bytes())
+	}
+	r.lineBuf.Reset()
+	r.lineLE, r.line = nil, nil
+}
+
 func dumpHex(w io.Writer, data []byte) (err error) {
 	// Hex dump.
 	d := hex.Dumper(w)
@@ -111,6 +111,41 @@ func TestRenderer(t *testing.T) {
 			})
 		})
 
+		Convey(`With JSON log entries split across segments`, func() {
+			ts.loadText(`{"level": `, "")
+			ts.loadLogEntry(&logpb.LogEntry{
+				Content: &logpb.LogEntry_Text{
+					Text: &logpb.Text{
+						Lines: []*logpb.Text_Line{
+							{Value: `"info"}`, Delimiter: "\n", Fields: map[string]string{"level": "info"}},
+							{Value: `{"level": "error"}`, Delimiter: "\n", Fields: map[string]string{"level": "error"}},
+							{Value: `{"level": `},
+						},
+					},
+				},
+			})
+			ts.loadLogEntry(&logpb.LogEntry{
+				Content: &logpb.LogEntry_Text{
+					Text: &logpb.Text{
+						Lines: []*logpb.Text_Line{
+							{Value: `"error"}`, Fields: map[string]string{"level": "error"}},
+						},
+					},
+				},
+			})
+
+			Convey(`When filtering, renders only the complete lines that pass the filter.`, func() {
+				r.Raw = true
+				r.TextFilter = func(le *logpb.LogEntry, line *logpb.Text_Line) bool {
+					return line.Fields["level"] == "error"
+				}
+
+				_, err := b.ReadFrom(r)
+				So(err, ShouldBeNil)
+				So(b.String(), ShouldEqual, `{"level": "error"}`+"\n"+`{"level": "error"}`)
+			})
+		})
+
 		Convey(`With BINARY log entries {{0x00}, {0x01, 0x02}, {}, {0x03}}`, func() {
 			ts.loadBinary([]byte{0x00})
 			ts.loadBinary([]byte{0x01, 0x02})
@@ -13,7 +13,10 @@
 package archive
 
 import (
+	"bufio"
 	"bytes"
+	"compress/zlib"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -43,6 +46,10 @@ const (
 	//
 	// 16MB is larger than the maximum log entry size
 	maxStreamRecordSize = 2 * types.MaxLogEntryDataSize
+
+	// zlibHeader is the first byte of a zlib stream using DEFLATE with a 32K
+	// window, which is what compress/zlib writes.
+	zlibHeader = 0x78
 )
 
 // Options is the set of configuration options for this Storage instance.
@@ -94,6 +101,10 @@ func (s *storageImpl) Close() {}
 func (s *storageImpl) Config(storage.Config) error  { return storage.ErrReadOnly }
 func (s *storageImpl) Put(storage.PutRequest) error { return storage.ErrReadOnly }
 
+func (s *storageImpl) Purge(cfgtypes.ProjectName, types.StreamPath) error {
+	return storage.ErrReadOnly
+}
+
 func (s *storageImpl) Get(req storage.GetRequest, cb storage.GetCallback) error {
 	idx, err := s.getIndex()
 	if err != nil {
@@ -147,59 +158,48 @@ func (s *storageImpl) getLogEntriesIter(st *getStrategy, cb storage.GetCallback)
 		}
 	}()
 
-	// Count how many bytes we've read.
-	cr := iotools.CountingReader{Reader: storageReader}
+	// Count how many bytes we've read. Buffer beneath the count, so that it
+	// reflects the bytes that we've consumed rather than the bytes that we've
+	// read ahead.
+	br := bufio.NewReader(storageReader)
+	var fs frameSource = &plainFrameSource{
+		Context: s,
+		cr:      iotools.CountingReader{Reader: br},
+		offset:  offset,
+	}
 
-	// Iteratively update our strategy's start offset each time we read a complete
-	// frame.
-	var (
-		rio       = recordio.NewReader(&cr, maxStreamRecordSize)
-		buf       bytes.Buffer
-		remaining = st.count
-	)
-	for {
-		// Reset the count so we know how much we read for this frame.
-		cr.Count = 0
+	// If we don't know the log stream's compression but are reading it from its
+	// beginning, we can identify it from its first frame.
+	compression := st.compression
+	if compression == logpb.LogIndex_NONE && offset == 0 && isCompressedStream(br) {
+		compression = logpb.LogIndex_ZLIB
+	}
+	switch compression {
+	case logpb.LogIndex_NONE:
+	case logpb.LogIndex_ZLIB:
+		fs = &blockFrameSource{blocks: fs}
+	default:
+		return fmt.Errorf("unsupported log stream compression: %v", compression)
+	}
 
-		sz, r, err := rio.ReadFrame()
+	remaining := st.count
+	for {
+		data, isDesc, err := fs.nextFrame()
 		if err != nil {
-			return errors.Annotate(err, "failed to read frame").Err()
-		}
-
-		buf.Reset()
-		buf.Grow(int(sz))
-
-		switch amt, err := buf.ReadFrom(r); {
-		case err != nil:
-			log.Fields{
-				log.ErrorKey:  err,
-				"frameOffset": offset,
-				"frameSize":   sz,
-			}.Errorf(s, "Failed to read frame data.")
-			return errors.Annotate(err, "failed to read frame data").Err()
-
-		case amt != sz:
-			// If we didn't buffer the complete frame, we hit a premature EOF.
-			return errors.Annotate(io.EOF, "incomplete frame read").Err()
+			return err
 		}
 
 		// If we read from offset 0, the first frame will be the log stream's
 		// descriptor, which we can discard.
-		discardFrame := (offset == 0)
-		offset += uint64(cr.Count)
-		if discardFrame {
+		if isDesc {
 			continue
 		}
 
 		// Punt this log entry to our callback, if appropriate.
-		entry := storage.MakeEntry(buf.Bytes(), -1)
+		entry := storage.MakeEntry(data, -1)
 		switch idx, err := entry.GetStreamIndex(); {
 		case err != nil:
-			log.Fields{
-				log.ErrorKey:  err,
-				"frameOffset": offset,
-				"frameSize":   sz,
-			}.Errorf(s, "Failed to get log entry index.")
+			log.WithError(err).Errorf(s, "Failed to get log entry index.")
 			return errors.Annotate(err, "failed to get log entry index").Err()
 
 		case idx < st.startIndex:
@@ -207,10 +207,10 @@ func (s *storageImpl) getLogEntriesIter(st *getStrategy, cb storage.GetCallback)
 			continue
 		}
 
-		// We want to punt this entry, but we also want to re-use our Buffer. Clone
-		// its data so it is independent.
-		entry.D = make([]byte, len(entry.D))
-		copy(entry.D, buf.Bytes())
+		// We want to punt this entry, but our frame source re-uses its buffer.
+		// Clone its data so it is independent.
+		entry.D = make([]byte, len(data))
+		copy(entry.D, data)
 		if !cb(entry) {
 			return nil
 		}
@@ -225,6 +225,113 @@ func (s *storageImpl) getLogEntriesIter(st *getStrategy, cb storage.GetCallback)
 	}
 }
 
+// frameSource returns successive frames from an archived log stream.
+type frameSource interface {
+	// nextFrame returns the next frame's data, and whether the frame is the log
+	// stream's descriptor.
+	//
+	// The returned data is only valid until the next call to nextFrame.
+	nextFrame() ([]byte, bool, error)
+}
+
+// plainFrameSource is a frameSource that reads uncompressed frames.
+type plainFrameSource struct {
+	context.Context
+
+	cr  iotools.CountingReader
+	rio recordio.Reader
+	buf bytes.Buffer
+
+	// offset is the log stream offset of the next frame.
+	offset uint64
+}
+
+func (fs *plainFrameSource) nextFrame() ([]byte, bool, error) {
+	if fs.rio == nil {
+		fs.rio = recordio.NewReader(&fs.cr, maxStreamRecordSize)
+	}
+
+	// Reset the count so we know how much we read for this frame.
+	fs.cr.Count = 0
+
+	sz, r, err := fs.rio.ReadFrame()
+	if err != nil {
+		return nil, false, errors.Annotate(err, "failed to read frame").Err()
+	}
+
+	fs.buf.Reset()
+	fs.buf.Grow(int(sz))
+
+	switch amt, err := fs.buf.ReadFrom(r); {
+	case err != nil:
+		log.Fields{
+			log.ErrorKey:  err,
+			"frameOffset": fs.offset,
+			"frameSize":   sz,
+		}.Errorf(fs, "Failed to read frame data.")
+		return nil, false, errors.Annotate(err, "failed to read frame data").Err()
+
+	case amt != sz:
+		// If we didn't buffer the complete frame, we hit a premature EOF.
+		return nil, false, errors.Annotate(io.EOF, "incomplete frame read").Err()
+	}
+
+	isDesc := (fs.offset == 0)
+	fs.offset += uint64(fs.cr.Count)
+	return fs.buf.Bytes(), isDesc, nil
+}
+
+// blockFrameSource is a frameSource that reads frames from a log stream's
+// compressed blocks. See logpb.LogIndex_ZLIB for the format.
+type blockFrameSource struct {
+	// blocks returns the log stream's compressed blocks.
+	blocks frameSource
+
+	// block is the current decompressed block, and blockIsDesc is true if it
+	// contains the log stream's descriptor.
+	block       bytes.Reader
+	blockIsDesc bool
+	buf         bytes.Buffer
+}
+
+func (fs *blockFrameSource) nextFrame() ([]byte, bool, error) {
+	for fs.block.Len() == 0 {
+		data, isDesc, err := fs.blocks.nextFrame()
+		if err != nil {
+			return nil, false, err
+		}
+
+		zr, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, false, errors.Annotate(err, "failed to read compressed block").Err()
+		}
+		fs.buf.Reset()
+		if _, err := fs.buf.ReadFrom(zr); err != nil {
+			return nil, false, errors.Annotate(err, "failed to decompress block").Err()
+		}
+		fs.block.Reset(fs.buf.Bytes())
+		fs.blockIsDesc = isDesc
+	}
+
+	frame, err := recordio.NewReader(&fs.block, maxStreamRecordSize).ReadFrameAll()
+	if err != nil {
+		return nil, false, errors.Annotate(err, "failed to read frame from block").Err()
+	}
+	return frame, fs.blockIsDesc, nil
+}
+
+// isCompressedStream returns true if the log stream read by br, starting at its
+// beginning, is compressed.
+//
+// An uncompressed log stream begins with a LogStreamDescriptor frame, whose
+// first byte is a field tag. A compressed log stream begins with a compressed
+// block, whose first byte is a zlib header. These never overlap.
+func isCompressedStream(br *bufio.Reader) bool {
+	hdr, _ := br.Peek(binary.MaxVarintLen64 + 1)
+	sz, n := binary.Uvarint(hdr)
+	return n > 0 && sz > 0 && n < len(hdr) && hdr[n] == zlibHeader
+}
+
 func (s *storageImpl) Tail(project cfgtypes.ProjectName, path types.StreamPath) (*storage.Entry, error) {
 	idx, err := s.getIndex()
 	if err != nil {
@@ -370,6 +477,9 @@ type getStrategy struct {
 	// count is the number of log entries that will be fetched. If 0, no upper
 	// bound was calculated.
 	count uint64
+
+	// compression is the log stream's compression, as reported by its index.
+	compression logpb.LogIndex_Compression
 }
 
 func (gs *getStrategy) length() int64 {
@@ -389,7 +499,8 @@ func (gs *getStrategy) setCount(v uint64) {
 
 func buildGetStrategy(req *storage.GetRequest, idx *logpb.LogIndex) *getStrategy {
 	st := getStrategy{
-		startIndex: req.Index,
+		startIndex:  req.Index,
+		compression: idx.Compression,
 	}
 
 	// If the user has requested an index past the end of the stream, return no
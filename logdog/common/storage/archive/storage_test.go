@@ -18,6 +18,7 @@ import (
 	"github.com/luci/luci-go/logdog/common/renderer"
 	"github.com/luci/luci-go/logdog/common/storage"
 	"github.com/luci/luci-go/logdog/common/storage/memory"
+	"github.com/luci/luci-go/logdog/common/types"
 
 	cloudStorage "cloud.google.com/go/storage"
 	"github.com/golang/protobuf/proto"
@@ -35,6 +36,12 @@ const (
 type logStreamGenerator struct {
 	lines []string
 
+	// compression is the compression to archive the log stream with.
+	compression logpb.LogIndex_Compression
+	// blockSize is the block size to archive a compressed log stream with. If
+	// zero, each log entry is written to its own block.
+	blockSize int
+
 	indexBuf  bytes.Buffer
 	streamBuf bytes.Buffer
 }
@@ -68,6 +75,11 @@ func (g *logStreamGenerator) generate(lines ...string) {
 		}
 	}
 
+	blockSize := g.blockSize
+	if blockSize <= 0 {
+		blockSize = 1
+	}
+
 	g.lines = lines
 	g.indexBuf.Reset()
 	g.streamBuf.Reset()
@@ -80,6 +92,8 @@ func (g *logStreamGenerator) generate(lines ...string) {
 		Source:      &src,
 		LogWriter:   &g.streamBuf,
 		IndexWriter: &g.indexBuf,
+		Compression: g.compression,
+		BlockSize:   blockSize,
 	})
 	if err != nil {
 		panic(err)
@@ -111,6 +125,14 @@ func (g *logStreamGenerator) sparseIndex(indices ...uint64) {
 	})
 }
 
+func (g *logStreamGenerator) index() *logpb.LogIndex {
+	var index logpb.LogIndex
+	if err := proto.Unmarshal(g.indexBuf.Bytes(), &index); err != nil {
+		panic(err)
+	}
+	return &index
+}
+
 func (g *logStreamGenerator) modIndex(fn func(*logpb.LogIndex)) {
 	var index logpb.LogIndex
 	if err := proto.Unmarshal(g.indexBuf.Bytes(), &index); err != nil {
@@ -241,75 +263,143 @@ func testArchiveStorage(t *testing.T, limit int64) {
 			So(st.Put(storage.PutRequest{}), ShouldEqual, storage.ErrReadOnly)
 		})
 
-		Convey(`Given a stream with 5 log entries`, func() {
-			gen.generate("foo", "bar", "baz", "qux", "quux")
+		for _, compression := range []logpb.LogIndex_Compression{logpb.LogIndex_NONE, logpb.LogIndex_ZLIB} {
+			Convey(fmt.Sprintf(`Given a stream with 5 log entries, compressed with %v`, compression), func() {
+				gen.compression = compression
+				gen.generate("foo", "bar", "baz", "qux", "quux")
+
+				// Basic test cases.
+				for _, tc := range []struct {
+					title string
+					mod   func()
+				}{
+					{`Complete index`, func() {}},
+					{`Empty index protobuf`, func() { gen.sparseIndex() }},
+					{`No index provided`, func() { stImpl.Index = "" }},
+					{`Invalid index path`, func() { stImpl.Index = "does-not-exist" }},
+					{`Sparse index with a start and terminal entry`, func() { gen.sparseIndex(0, 2, 4) }},
+					{`Sparse index with a terminal entry`, func() { gen.sparseIndex(1, 3, 4) }},
+					{`Sparse index missing a terminal entry`, func() { gen.sparseIndex(1, 3) }},
+				} {
+					Convey(fmt.Sprintf(`Test Case: %q`, tc.title), func() {
+						tc.mod()
+
+						// Run through per-testcase variant set.
+						for _, variant := range []struct {
+							title string
+							mod   func()
+						}{
+							{"with hints", func() {}},
+							{"without hints", func() { gen.pruneIndexHints() }},
+						} {
+							Convey(variant.title, func() {
+								variant.mod()
+								client.load(&gen)
+
+								var entries []string
+								collect := func(e *storage.Entry) bool {
+									entries = append(entries, gen.lineFromEntry(e))
+									return true
+								}
+
+								Convey(`Can Get [0..]`, func() {
+									So(st.Get(storage.GetRequest{}, collect), ShouldBeNil)
+									So(entries, ShouldResemble, gen.lines)
+								})
+
+								Convey(`Can Get [1..].`, func() {
+									So(st.Get(storage.GetRequest{Index: 1}, collect), ShouldBeNil)
+									So(entries, ShouldResemble, gen.lines[1:])
+								})
+
+								Convey(`Can Get [1..2].`, func() {
+									So(st.Get(storage.GetRequest{Index: 1, Limit: 2}, collect), ShouldBeNil)
+									So(entries, ShouldResemble, gen.lines[1:3])
+								})
+
+								Convey(`Can Get [5..].`, func() {
+									So(st.Get(storage.GetRequest{Index: 5}, collect), ShouldBeNil)
+									So(entries, ShouldHaveLength, 0)
+								})
+
+								Convey(`Can Get [4].`, func() {
+									So(st.Get(storage.GetRequest{Index: 4, Limit: 1}, collect), ShouldBeNil)
+									So(entries, ShouldResemble, gen.lines[4:])
+								})
+
+								Convey(`Can tail.`, func() {
+									e, err := st.Tail("", "")
+									So(err, ShouldBeNil)
+									So(gen.lineFromEntry(e), ShouldEqual, gen.lines[len(gen.lines)-1])
+								})
+							})
+						}
+					})
+				}
+			})
+		}
+
+		Convey(`Given a compressed stream with multiple log entries per block`, func() {
+			gen.compression = logpb.LogIndex_ZLIB
+			gen.blockSize = 64
+
+			lines := make([]string, 32)
+			for i := range lines {
+				lines[i] = fmt.Sprintf("line #%d", i)
+			}
+			gen.generate(lines...)
+
+			// Make sure that blocks hold several log entries, so some lookups land in
+			// between index entries.
+			blocks := gen.index().Entries
+			So(len(blocks), ShouldBeGreaterThan, 1)
+			So(len(blocks), ShouldBeLessThan, len(lines)/2)
 
-			// Basic test cases.
 			for _, tc := range []struct {
 				title string
 				mod   func()
 			}{
 				{`Complete index`, func() {}},
-				{`Empty index protobuf`, func() { gen.sparseIndex() }},
+				{`Index without hints`, func() { gen.pruneIndexHints() }},
+				{`Sparse index`, func() { gen.sparseIndex(blocks[len(blocks)/2].StreamIndex) }},
 				{`No index provided`, func() { stImpl.Index = "" }},
-				{`Invalid index path`, func() { stImpl.Index = "does-not-exist" }},
-				{`Sparse index with a start and terminal entry`, func() { gen.sparseIndex(0, 2, 4) }},
-				{`Sparse index with a terminal entry`, func() { gen.sparseIndex(1, 3, 4) }},
-				{`Sparse index missing a terminal entry`, func() { gen.sparseIndex(1, 3) }},
 			} {
 				Convey(fmt.Sprintf(`Test Case: %q`, tc.title), func() {
 					tc.mod()
+					client.load(&gen)
 
-					// Run through per-testcase variant set.
-					for _, variant := range []struct {
-						title string
-						mod   func()
-					}{
-						{"with hints", func() {}},
-						{"without hints", func() { gen.pruneIndexHints() }},
-					} {
-						Convey(variant.title, func() {
-							variant.mod()
-							client.load(&gen)
-
-							var entries []string
-							collect := func(e *storage.Entry) bool {
-								entries = append(entries, gen.lineFromEntry(e))
-								return true
-							}
-
-							Convey(`Can Get [0..]`, func() {
-								So(st.Get(storage.GetRequest{}, collect), ShouldBeNil)
-								So(entries, ShouldResemble, gen.lines)
-							})
-
-							Convey(`Can Get [1..].`, func() {
-								So(st.Get(storage.GetRequest{Index: 1}, collect), ShouldBeNil)
-								So(entries, ShouldResemble, gen.lines[1:])
-							})
+					var entries []string
+					collect := func(e *storage.Entry) bool {
+						entries = append(entries, gen.lineFromEntry(e))
+						return true
+					}
 
-							Convey(`Can Get [1..2].`, func() {
-								So(st.Get(storage.GetRequest{Index: 1, Limit: 2}, collect), ShouldBeNil)
-								So(entries, ShouldResemble, gen.lines[1:3])
-							})
+					Convey(`Can Get from every index.`, func() {
+						for i := range lines {
+							entries = nil
+							So(st.Get(storage.GetRequest{Index: types.MessageIndex(i)}, collect), ShouldBeNil)
+							So(entries, ShouldResemble, lines[i:])
+						}
+					})
 
-							Convey(`Can Get [5..].`, func() {
-								So(st.Get(storage.GetRequest{Index: 5}, collect), ShouldBeNil)
-								So(entries, ShouldHaveLength, 0)
-							})
+					Convey(`Can Get ranges spanning blocks.`, func() {
+						for i := range lines {
+							entries = nil
+							So(st.Get(storage.GetRequest{Index: types.MessageIndex(i), Limit: 5}, collect), ShouldBeNil)
 
-							Convey(`Can Get [4].`, func() {
-								So(st.Get(storage.GetRequest{Index: 4, Limit: 1}, collect), ShouldBeNil)
-								So(entries, ShouldResemble, gen.lines[4:])
-							})
+							end := i + 5
+							if end > len(lines) {
+								end = len(lines)
+							}
+							So(entries, ShouldResemble, lines[i:end])
+						}
+					})
 
-							Convey(`Can tail.`, func() {
-								e, err := st.Tail("", "")
-								So(err, ShouldBeNil)
-								So(gen.lineFromEntry(e), ShouldEqual, gen.lines[len(gen.lines)-1])
-							})
-						})
-					}
+					Convey(`Can tail.`, func() {
+						e, err := st.Tail("", "")
+						So(err, ShouldBeNil)
+						So(gen.lineFromEntry(e), ShouldEqual, lines[len(lines)-1])
+					})
 				})
 			}
 		})
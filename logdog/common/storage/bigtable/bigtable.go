@@ -31,6 +31,10 @@ const (
 	// bigTableRowMaxBytes is the maximum number of bytes that a single BigTable
 	// row may hold.
 	bigTableRowMaxBytes = 1024 * 1024 * 10 // 10MB
+
+	// bigTableBulkMaxMutations is the maximum number of mutations that will be
+	// sent in a single ApplyBulk request.
+	bigTableBulkMaxMutations = 1000
 )
 
 // btGetCallback is a callback that is invoked for each log data row returned
@@ -61,6 +65,9 @@ type btTable interface {
 	// If keysOnly is true, then the callback will return nil row data.
 	getLogData(c context.Context, rk *rowKey, limit int, keysOnly bool, cb btGetCallback) error
 
+	// deleteLogData deletes all rows belonging to the supplied row key's stream.
+	deleteLogData(context.Context, *rowKey) error
+
 	// setMaxLogAge updates the maximum log age policy for the log family.
 	setMaxLogAge(context.Context, time.Duration) error
 }
@@ -133,6 +140,44 @@ func (t *btTableProd) getLogData(c context.Context, rk *rowKey, limit int, keysO
 	return nil
 }
 
+func (t *btTableProd) deleteLogData(c context.Context, rk *rowKey) error {
+	// Collect the keys of all rows in the stream's path prefix.
+	var keys []string
+	rng := bigtable.NewRange(rk.pathPrefix(), rk.pathPrefixUpperBound())
+	err := t.base.logTable.ReadRows(c, rng, func(row bigtable.Row) bool {
+		keys = append(keys, row.Key())
+		return true
+	}, bigtable.RowFilter(bigtable.StripValueFilter()))
+	if err != nil {
+		return grpcutil.WrapIfTransient(err)
+	}
+
+	for len(keys) > 0 {
+		batch := keys
+		if len(batch) > bigTableBulkMaxMutations {
+			batch = batch[:bigTableBulkMaxMutations]
+		}
+		keys = keys[len(batch):]
+
+		muts := make([]*bigtable.Mutation, len(batch))
+		for i := range muts {
+			muts[i] = bigtable.NewMutation()
+			muts[i].DeleteRow()
+		}
+
+		errs, err := t.base.logTable.ApplyBulk(c, batch, muts)
+		if err != nil {
+			return wrapIfTransientForApply(err)
+		}
+		for _, err := range errs {
+			if err != nil {
+				return wrapIfTransientForApply(err)
+			}
+		}
+	}
+	return nil
+}
+
 func (t *btTableProd) setMaxLogAge(c context.Context, d time.Duration) error {
 	var logGCPolicy bigtable.GCPolicy
 	if d > 0 {
@@ -373,6 +373,30 @@ func (s *btStorage) Tail(project cfgtypes.ProjectName, path types.StreamPath) (*
 	return storage.MakeEntry(d, types.MessageIndex(latest.index)), nil
 }
 
+func (s *btStorage) Purge(project cfgtypes.ProjectName, path types.StreamPath) error {
+	ctx := log.SetFields(s, log.Fields{
+		"project": project,
+		"path":    path,
+	})
+
+	rk := newRowKey(string(project), string(path), 0, 0)
+	if err := s.raw.deleteLogData(ctx, rk); err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"project":    s.Project,
+			"instance":   s.Instance,
+			"table":      s.LogTable,
+		}.Errorf(ctx, "Failed to purge log rows.")
+		return err
+	}
+
+	// Reset our cached tail index, since it no longer refers to any row.
+	if s.Cache != nil {
+		putLastTailIndex(s, s.Cache, project, path, 0)
+	}
+	return nil
+}
+
 // rowWriter facilitates writing several consecutive data values to a single
 // BigTable row.
 type rowWriter struct {
@@ -254,6 +254,27 @@ func TestStorage(t *testing.T) {
 					So(err, ShouldEqual, storage.ErrDoesNotExist)
 				})
 			})
+
+			Convey(`Testing "Purge"...`, func() {
+				Convey(`Deletes all of the rows for "A".`, func() {
+					So(s.Purge(project, "A"), ShouldBeNil)
+
+					So(s.DataMap(), ShouldResemble, map[string][]byte{
+						ekey("B", 10, 1): records("10"),
+						ekey("B", 13, 2): records("12", "13"),
+						ekey("C", 2, 3):  records("0", "1", "2"),
+						ekey("C", 4, 1):  records("4"),
+					})
+
+					_, err := s.Tail(project, "A")
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
+
+				Convey(`Succeeds for a stream with no rows.`, func() {
+					So(s.Purge(project, "INVALID"), ShouldBeNil)
+					So(s.DataMap(), ShouldHaveLength, 6)
+				})
+			})
 		})
 	})
 }
@@ -170,6 +170,28 @@ func (t *btTableTest) getLogData(c context.Context, rk *rowKey, limit int, keysO
 	return ierr
 }
 
+func (t *btTableTest) deleteLogData(c context.Context, rk *rowKey) error {
+	if t.err != nil {
+		return t.err
+	}
+
+	var keys [][]byte
+	prefix := []byte(rk.pathPrefix())
+	t.forEachItem(prefix, func(k, v []byte) bool {
+		if !bytes.HasPrefix(k, prefix) {
+			return false
+		}
+		keys = append(keys, k)
+		return true
+	})
+
+	coll := t.collection()
+	for _, k := range keys {
+		coll.Delete(&storageItem{k, nil})
+	}
+	return nil
+}
+
 func (t *btTableTest) setMaxLogAge(c context.Context, d time.Duration) error {
 	if t.err != nil {
 		return t.err
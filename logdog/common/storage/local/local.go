@@ -0,0 +1,748 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package local implements a storage.Storage that persists log entries to
+// local files.
+//
+// It is intended for development and small, single-machine deployments that
+// don't want to depend on BigTable.
+//
+// Each log stream is stored in its own directory, named after a hash of its
+// project and path. A log stream's records are appended to a series of segment
+// files. Each segment consists of a data file, containing the concatenated
+// record data, and an index file, containing a fixed-size entry for each
+// record that identifies its stream index and location in the data file.
+//
+// Records are written to the data file before their index entry, so a record
+// is only visible once its index entry is complete. Partially-written records
+// left behind by a crash are ignored, and are discarded before the log
+// stream's last segment is next written to.
+//
+// A log stream may only be written by one process at a time, but it may be
+// read concurrently by others, such as an archivist reading what a collector
+// writes.
+package local
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+)
+
+const (
+	// DefaultMaxSegmentSize is the default maximum size of a segment's data
+	// file.
+	DefaultMaxSegmentSize = 64 * 1024 * 1024
+
+	// DefaultMaxOpenStreams is the default maximum number of log streams to keep
+	// open.
+	DefaultMaxOpenStreams = 128
+
+	dataExt  = ".data"
+	indexExt = ".index"
+
+	// pathFileName is the name of the file in each log stream's directory which
+	// contains the log stream's path. It is informational.
+	pathFileName = "PATH"
+
+	// indexEntrySize is the size of a single index entry: the stream index
+	// (uint64), data offset (uint64), and data size (uint32).
+	indexEntrySize = 8 + 8 + 4
+)
+
+var errClosed = errors.New("storage is closed")
+
+// Options is the set of configuration parameters for a Storage instance.
+type Options struct {
+	// Dir is the directory to store log streams in. It will be created if it
+	// doesn't exist.
+	Dir string
+
+	// MaxSegmentSize, if >0, is the maximum size of a segment's data file. Once
+	// a segment reaches this size, a new one is started. If <=0,
+	// DefaultMaxSegmentSize will be used.
+	MaxSegmentSize int64
+
+	// MaxOpenStreams, if >0, is the maximum number of log streams whose files
+	// are kept open. Once more are open, the least recently used ones that
+	// aren't in use are closed. If <=0, DefaultMaxOpenStreams will be used.
+	MaxOpenStreams int
+
+	// MaxGetCount, if not zero, is the maximum number of records to retrieve from
+	// a single Get request.
+	MaxGetCount int
+}
+
+// Storage is an implementation of the storage.Storage interface that stores
+// data in local files.
+type Storage struct {
+	Options
+
+	// MaxLogAge is the configured maximum log age.
+	MaxLogAge time.Duration
+
+	// mu is held for reading by all operations and for writing by Close.
+	mu     sync.RWMutex
+	closed bool
+
+	// streamsMu protects streams, lru and the reference counts of the log
+	// streams in them.
+	streamsMu sync.Mutex
+	streams   map[streamKey]*logStream
+	// lru holds the open log streams, most recently used first.
+	lru list.List
+}
+
+var _ storage.Storage = (*Storage)(nil)
+
+type streamKey struct {
+	project cfgtypes.ProjectName
+	path    types.StreamPath
+}
+
+// New creates a new local Storage instance.
+func New(o Options) (*Storage, error) {
+	if o.Dir == "" {
+		return nil, errors.New("a storage directory must be specified")
+	}
+	if o.MaxSegmentSize <= 0 {
+		o.MaxSegmentSize = DefaultMaxSegmentSize
+	}
+	if o.MaxOpenStreams <= 0 {
+		o.MaxOpenStreams = DefaultMaxOpenStreams
+	}
+	if err := os.MkdirAll(o.Dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory %q: %v", o.Dir, err)
+	}
+
+	return &Storage{
+		Options: o,
+		streams: map[streamKey]*logStream{},
+	}, nil
+}
+
+// Close implements storage.Storage.
+func (s *Storage) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+
+	// Holding mu for writing means that no log streams are in use.
+	for _, ls := range s.streams {
+		ls.close()
+	}
+	s.streams = nil
+	s.lru.Init()
+}
+
+// Config implements storage.Storage.
+func (s *Storage) Config(cfg storage.Config) error {
+	return s.run(func() error {
+		s.MaxLogAge = cfg.MaxLogAge
+		return nil
+	})
+}
+
+// Put implements storage.Storage.
+func (s *Storage) Put(req storage.PutRequest) error {
+	return s.run(func() error {
+		ls, err := s.getLogStream(req.Project, req.Path, true)
+		if err != nil {
+			return err
+		}
+		defer s.releaseLogStream(ls)
+		return ls.put(req.Index, req.Values, s.MaxSegmentSize)
+	})
+}
+
+// Get implements storage.Storage.
+func (s *Storage) Get(req storage.GetRequest, cb storage.GetCallback) error {
+	return s.run(func() error {
+		ls, err := s.getLogStream(req.Project, req.Path, false)
+		if err != nil {
+			return err
+		}
+		if ls == nil {
+			return storage.ErrDoesNotExist
+		}
+		defer s.releaseLogStream(ls)
+
+		limit := req.Limit
+		if s.MaxGetCount > 0 && (limit <= 0 || s.MaxGetCount < limit) {
+			limit = s.MaxGetCount
+		}
+
+		// Records are immutable once written, so we can read them after releasing
+		// the log stream's lock.
+		locs, err := ls.getRange(req.Index, limit)
+		if err != nil {
+			return err
+		}
+		for _, loc := range locs {
+			var data []byte
+			if !req.KeysOnly {
+				if data, err = ls.read(loc); err != nil {
+					return err
+				}
+			}
+			if !cb(storage.MakeEntry(data, loc.index)) {
+				break
+			}
+		}
+		return nil
+	})
+}
+
+// Tail implements storage.Storage.
+func (s *Storage) Tail(project cfgtypes.ProjectName, path types.StreamPath) (e *storage.Entry, err error) {
+	err = s.run(func() error {
+		ls, err := s.getLogStream(project, path, false)
+		if err != nil {
+			return err
+		}
+		if ls == nil {
+			return storage.ErrDoesNotExist
+		}
+		defer s.releaseLogStream(ls)
+
+		loc, err := ls.latest()
+		if err != nil {
+			return err
+		}
+		if loc == nil {
+			return storage.ErrDoesNotExist
+		}
+
+		data, err := ls.read(loc)
+		if err != nil {
+			return err
+		}
+		e = storage.MakeEntry(data, loc.index)
+		return nil
+	})
+	return
+}
+
+// Purge implements storage.Storage.
+func (s *Storage) Purge(project cfgtypes.ProjectName, path types.StreamPath) error {
+	return s.run(func() error {
+		s.streamsMu.Lock()
+		defer s.streamsMu.Unlock()
+
+		if ls := s.streams[streamKey{project, path}]; ls != nil {
+			s.evictLocked(ls)
+		}
+		if err := os.RemoveAll(s.streamDir(project, path)); err != nil {
+			return fmt.Errorf("failed to remove log stream directory: %v", err)
+		}
+		return nil
+	})
+}
+
+// Count returns the number of log records for the given stream.
+func (s *Storage) Count(project cfgtypes.ProjectName, path types.StreamPath) (c int) {
+	s.run(func() error {
+		ls, err := s.getLogStream(project, path, false)
+		if err != nil {
+			return err
+		}
+		if ls != nil {
+			defer s.releaseLogStream(ls)
+
+			ls.Lock()
+			defer ls.Unlock()
+			c = len(ls.locs)
+		}
+		return nil
+	})
+	return
+}
+
+func (s *Storage) run(f func() error) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return errClosed
+	}
+	return f()
+}
+
+// streamDir returns the directory for a given log stream.
+func (s *Storage) streamDir(project cfgtypes.ProjectName, path types.StreamPath) string {
+	hash := sha256.Sum256([]byte(path))
+	return filepath.Join(s.Dir, string(project), hex.EncodeToString(hash[:]))
+}
+
+// getLogStream returns the log stream for the given project and path, loading
+// it from disk if necessary. The returned log stream must be released with
+// releaseLogStream once it is no longer in use.
+//
+// If the log stream doesn't exist and create is false, getLogStream will
+// return nil. If create is true, the log stream will be created.
+func (s *Storage) getLogStream(project cfgtypes.ProjectName, path types.StreamPath, create bool) (
+	*logStream, error) {
+
+	s.streamsMu.Lock()
+	defer s.streamsMu.Unlock()
+
+	key := streamKey{project, path}
+	if ls := s.streams[key]; ls != nil {
+		s.lru.MoveToFront(ls.lruElem)
+		ls.refs++
+		return ls, nil
+	}
+
+	dir := s.streamDir(project, path)
+	switch _, err := os.Stat(dir); {
+	case err == nil:
+	case os.IsNotExist(err):
+		if !create {
+			return nil, nil
+		}
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create log stream directory: %v", err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, pathFileName), []byte(path), 0644); err != nil {
+			return nil, fmt.Errorf("failed to write log stream path: %v", err)
+		}
+	default:
+		return nil, err
+	}
+
+	ls, err := loadLogStream(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load log stream %q: %v", path, err)
+	}
+	ls.key = key
+	ls.refs = 1
+	ls.lruElem = s.lru.PushFront(ls)
+	s.streams[key] = ls
+
+	// Close the least recently used log streams that aren't in use.
+	for e := s.lru.Back(); e != nil && len(s.streams) > s.MaxOpenStreams; {
+		prev := e.Prev()
+		if old := e.Value.(*logStream); old.refs == 0 {
+			s.evictLocked(old)
+		}
+		e = prev
+	}
+	return ls, nil
+}
+
+// releaseLogStream releases a log stream returned by getLogStream. If the log
+// stream was evicted while it was in use, it is closed.
+func (s *Storage) releaseLogStream(ls *logStream) {
+	s.streamsMu.Lock()
+	defer s.streamsMu.Unlock()
+
+	ls.refs--
+	if ls.refs == 0 && ls.lruElem == nil {
+		ls.close()
+	}
+}
+
+// evictLocked removes ls from the set of open log streams. It is closed now if
+// it isn't in use, or else when it is released.
+//
+// streamsMu must be held.
+func (s *Storage) evictLocked(ls *logStream) {
+	delete(s.streams, ls.key)
+	s.lru.Remove(ls.lruElem)
+	ls.lruElem = nil
+	if ls.refs == 0 {
+		ls.close()
+	}
+}
+
+// recordLoc is the location of a single record.
+type recordLoc struct {
+	index  types.MessageIndex
+	seg    *segment
+	offset int64
+	size   int
+}
+
+// segment is a single segment of a log stream.
+type segment struct {
+	num int
+
+	// data is a read-only handle to the segment's data file.
+	data *os.File
+	// dataSize is the size of the segment's valid data.
+	dataSize int64
+	// indexSize is the size of the segment's valid index entries.
+	indexSize int64
+
+	// dataW and indexW are append handles to the segment's data and index files.
+	// They are only opened for the log stream's current segment, once it is
+	// written to.
+	dataW  *os.File
+	indexW *os.File
+}
+
+func (seg *segment) close() {
+	for _, f := range []*os.File{seg.data, seg.dataW, seg.indexW} {
+		if f != nil {
+			f.Close()
+		}
+	}
+	seg.data, seg.dataW, seg.indexW = nil, nil, nil
+}
+
+// logStream is the loaded state of a single log stream.
+//
+// A log stream may be written by at most one process at a time, but may be
+// read by any number of others. Readers pick up records written by other
+// processes by refreshing their state from the log stream's index files.
+type logStream struct {
+	sync.Mutex
+
+	// key, refs and lruElem are owned by Storage, and are protected by its
+	// streamsMu. refs is the number of operations using the log stream, and
+	// lruElem is nil once the log stream has been evicted.
+	key     streamKey
+	refs    int
+	lruElem *list.Element
+
+	dir      string
+	segments []*segment
+
+	locs        map[types.MessageIndex]*recordLoc
+	latestIndex types.MessageIndex
+}
+
+func segmentPath(dir string, num int, ext string) string {
+	return filepath.Join(dir, fmt.Sprintf("%08d%s", num, ext))
+}
+
+// loadLogStream loads a log stream's segments from dir.
+func loadLogStream(dir string) (*logStream, error) {
+	ls := logStream{
+		dir:         dir,
+		locs:        map[types.MessageIndex]*recordLoc{},
+		latestIndex: -1,
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var nums []int
+	for _, fi := range files {
+		name := fi.Name()
+		if !strings.HasSuffix(name, indexExt) {
+			continue
+		}
+		var num int
+		if _, err := fmt.Sscanf(strings.TrimSuffix(name, indexExt), "%d", &num); err != nil {
+			continue
+		}
+		nums = append(nums, num)
+	}
+	sort.Ints(nums)
+
+	for _, num := range nums {
+		if err := ls.loadSegmentLocked(num); err != nil {
+			ls.close()
+			return nil, fmt.Errorf("failed to load segment %d: %v", num, err)
+		}
+	}
+	return &ls, nil
+}
+
+// loadSegmentLocked opens segment num and loads its index entries.
+func (ls *logStream) loadSegmentLocked(num int) error {
+	data, err := os.OpenFile(segmentPath(ls.dir, num, dataExt), os.O_RDONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+
+	seg := &segment{
+		num:  num,
+		data: data,
+	}
+	if err := ls.loadIndexLocked(seg); err != nil {
+		data.Close()
+		return err
+	}
+	ls.segments = append(ls.segments, seg)
+	return nil
+}
+
+// loadIndexLocked loads any index entries in seg's index file that haven't
+// been loaded yet.
+//
+// Loading stops at the first entry which is incomplete or which refers to data
+// that hasn't been completely written. These may be in the process of being
+// written by another process, or may have been left behind by a writer which
+// crashed. The latter are discarded by repairLocked before a segment is
+// written to.
+func (ls *logStream) loadIndexLocked(seg *segment) error {
+	f, err := os.Open(segmentPath(ls.dir, seg.num, indexExt))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := f.Seek(seg.indexSize, 0); err != nil {
+		return err
+	}
+	indexData, err := ioutil.ReadAll(f)
+	if err != nil {
+		return err
+	}
+	st, err := seg.data.Stat()
+	if err != nil {
+		return err
+	}
+
+	for len(indexData) >= indexEntrySize {
+		ent := indexData[:indexEntrySize]
+		loc := recordLoc{
+			index:  types.MessageIndex(binary.BigEndian.Uint64(ent[0:8])),
+			seg:    seg,
+			offset: int64(binary.BigEndian.Uint64(ent[8:16])),
+			size:   int(binary.BigEndian.Uint32(ent[16:20])),
+		}
+		end := loc.offset + int64(loc.size)
+		if loc.offset != seg.dataSize || end > st.Size() {
+			break
+		}
+
+		ls.addLoc(&loc)
+		seg.dataSize = end
+		seg.indexSize += indexEntrySize
+		indexData = indexData[indexEntrySize:]
+	}
+	return nil
+}
+
+// refreshLocked loads any records that were written by other processes since
+// the log stream was last loaded or refreshed.
+func (ls *logStream) refreshLocked() error {
+	if n := len(ls.segments); n > 0 {
+		seg := ls.segments[n-1]
+		if seg.dataW != nil {
+			// We are the writer, so our state is current.
+			return nil
+		}
+		if err := ls.loadIndexLocked(seg); err != nil {
+			return err
+		}
+	}
+
+	// Load any segments that were added.
+	for {
+		num := 0
+		if n := len(ls.segments); n > 0 {
+			num = ls.segments[n-1].num + 1
+		}
+		switch _, err := os.Stat(segmentPath(ls.dir, num, indexExt)); {
+		case os.IsNotExist(err):
+			return nil
+		case err != nil:
+			return err
+		}
+
+		if err := ls.loadSegmentLocked(num); err != nil {
+			return err
+		}
+	}
+}
+
+func (ls *logStream) close() {
+	ls.Lock()
+	defer ls.Unlock()
+
+	for _, seg := range ls.segments {
+		seg.close()
+	}
+}
+
+func (ls *logStream) addLoc(loc *recordLoc) {
+	ls.locs[loc.index] = loc
+	if loc.index > ls.latestIndex {
+		ls.latestIndex = loc.index
+	}
+}
+
+// repairLocked discards any partially-written data and index entries from the
+// end of seg, so that appends pick up where its valid data leaves off.
+func (ls *logStream) repairLocked(seg *segment) error {
+	if err := os.Truncate(segmentPath(ls.dir, seg.num, indexExt), seg.indexSize); err != nil {
+		return err
+	}
+	return os.Truncate(segmentPath(ls.dir, seg.num, dataExt), seg.dataSize)
+}
+
+// writableSegment returns the segment to write a record of the given size to,
+// starting a new one if the current segment would exceed maxSize.
+func (ls *logStream) writableSegment(size int, maxSize int64) (*segment, error) {
+	var seg *segment
+	if len(ls.segments) > 0 {
+		seg = ls.segments[len(ls.segments)-1]
+		if seg.dataSize > 0 && seg.dataSize+int64(size) > maxSize {
+			// Rotate. The old segment's write handles are no longer needed.
+			for _, f := range []*os.File{seg.dataW, seg.indexW} {
+				if f != nil {
+					if err := f.Close(); err != nil {
+						return nil, err
+					}
+				}
+			}
+			seg.dataW, seg.indexW = nil, nil
+			seg = nil
+		}
+	}
+
+	if seg == nil {
+		num := 0
+		if len(ls.segments) > 0 {
+			num = ls.segments[len(ls.segments)-1].num + 1
+		}
+
+		// Create the data file before the index file, since the index file's
+		// presence is what identifies a segment.
+		data, err := os.OpenFile(segmentPath(ls.dir, num, dataExt), os.O_RDONLY|os.O_CREATE, 0644)
+		if err != nil {
+			return nil, err
+		}
+		indexW, err := os.OpenFile(segmentPath(ls.dir, num, indexExt), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			data.Close()
+			return nil, err
+		}
+		indexW.Close()
+
+		seg = &segment{
+			num:  num,
+			data: data,
+		}
+		ls.segments = append(ls.segments, seg)
+	}
+
+	if seg.dataW == nil {
+		if err := ls.repairLocked(seg); err != nil {
+			return nil, err
+		}
+
+		var err error
+		if seg.dataW, err = os.OpenFile(segmentPath(ls.dir, seg.num, dataExt), os.O_WRONLY|os.O_APPEND, 0); err != nil {
+			return nil, err
+		}
+		if seg.indexW, err = os.OpenFile(segmentPath(ls.dir, seg.num, indexExt), os.O_WRONLY|os.O_APPEND, 0); err != nil {
+			seg.dataW.Close()
+			seg.dataW = nil
+			return nil, err
+		}
+	}
+	return seg, nil
+}
+
+func (ls *logStream) put(index types.MessageIndex, values [][]byte, maxSegmentSize int64) error {
+	ls.Lock()
+	defer ls.Unlock()
+
+	if err := ls.refreshLocked(); err != nil {
+		return err
+	}
+	for i := range values {
+		if _, ok := ls.locs[index+types.MessageIndex(i)]; ok {
+			return storage.ErrExists
+		}
+	}
+
+	var ent [indexEntrySize]byte
+	for i, v := range values {
+		seg, err := ls.writableSegment(len(v), maxSegmentSize)
+		if err != nil {
+			return err
+		}
+
+		loc := recordLoc{
+			index:  index + types.MessageIndex(i),
+			seg:    seg,
+			offset: seg.dataSize,
+			size:   len(v),
+		}
+		if _, err := seg.dataW.Write(v); err != nil {
+			return err
+		}
+
+		binary.BigEndian.PutUint64(ent[0:8], uint64(loc.index))
+		binary.BigEndian.PutUint64(ent[8:16], uint64(loc.offset))
+		binary.BigEndian.PutUint32(ent[16:20], uint32(loc.size))
+		if _, err := seg.indexW.Write(ent[:]); err != nil {
+			return err
+		}
+
+		seg.dataSize += int64(len(v))
+		seg.indexSize += indexEntrySize
+		ls.addLoc(&loc)
+	}
+	return nil
+}
+
+// getRange returns the locations of up to limit records starting at index. If
+// limit is <=0, all records starting at index will be returned.
+func (ls *logStream) getRange(index types.MessageIndex, limit int) ([]*recordLoc, error) {
+	ls.Lock()
+	defer ls.Unlock()
+
+	if err := ls.refreshLocked(); err != nil {
+		return nil, err
+	}
+
+	var locs []*recordLoc
+	for idx := index; idx <= ls.latestIndex; idx++ {
+		if loc, ok := ls.locs[idx]; ok {
+			locs = append(locs, loc)
+			if limit > 0 && len(locs) >= limit {
+				break
+			}
+		}
+	}
+	return locs, nil
+}
+
+// latest returns the location of the latest record, or nil if there are no
+// records.
+func (ls *logStream) latest() (*recordLoc, error) {
+	ls.Lock()
+	defer ls.Unlock()
+
+	if err := ls.refreshLocked(); err != nil {
+		return nil, err
+	}
+	return ls.locs[ls.latestIndex], nil
+}
+
+// read reads the data for the record at loc.
+func (ls *logStream) read(loc *recordLoc) ([]byte, error) {
+	data := make([]byte, loc.size)
+	if _, err := loc.seg.data.ReadAt(data, loc.offset); err != nil && !(err == io.EOF && loc.size == 0) {
+		return nil, fmt.Errorf("failed to read record %d: %v", loc.index, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,366 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type rec struct {
+	index types.MessageIndex
+	data  []byte
+}
+
+func numRec(v types.MessageIndex) *rec {
+	buf := bytes.Buffer{}
+	binary.Write(&buf, binary.BigEndian, v)
+	return &rec{
+		index: v,
+		data:  buf.Bytes(),
+	}
+}
+
+func mustGetIndex(e *storage.Entry) types.MessageIndex {
+	idx, err := e.GetStreamIndex()
+	if err != nil {
+		panic(err)
+	}
+	return idx
+}
+
+func TestLocalStorage(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A local Storage instance.`, t, func() {
+		tdir, err := ioutil.TempDir("", "logdog_local_storage")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tdir)
+
+		// Use a small segment size so that segment rotation is exercised.
+		opts := Options{
+			Dir:            tdir,
+			MaxSegmentSize: 24,
+		}
+		st, err := New(opts)
+		So(err, ShouldBeNil)
+		defer func() {
+			st.Close()
+		}()
+
+		project := cfgtypes.ProjectName("test-project")
+		path := types.StreamPath("testing/+/foo/bar")
+
+		Convey(`Can Put() log stream records {0..5, 7, 8, 10}.`, func() {
+			var indices []types.MessageIndex
+
+			putRange := func(start types.MessageIndex, count int) error {
+				req := storage.PutRequest{
+					Project: project,
+					Path:    path,
+					Index:   start,
+				}
+				for i := 0; i < count; i++ {
+					index := start + types.MessageIndex(i)
+					req.Values = append(req.Values, numRec(index).data)
+					indices = append(indices, index)
+				}
+				return st.Put(req)
+			}
+
+			So(putRange(0, 6), ShouldBeNil)
+			So(putRange(7, 2), ShouldBeNil)
+			So(putRange(10, 1), ShouldBeNil)
+
+			// Forward-indexed records.
+			recs := make([]*rec, len(indices))
+			for i, idx := range indices {
+				recs[i] = numRec(idx)
+			}
+
+			var getRecs []*rec
+			getAllCB := func(e *storage.Entry) bool {
+				getRecs = append(getRecs, &rec{
+					index: mustGetIndex(e),
+					data:  e.D,
+				})
+				return true
+			}
+
+			Convey(`Put()`, func() {
+				req := storage.PutRequest{
+					Project: project,
+					Path:    path,
+				}
+
+				Convey(`Will return ErrExists when putting an existing entry.`, func() {
+					req.Values = [][]byte{[]byte("ohai")}
+
+					So(st.Put(req), ShouldEqual, storage.ErrExists)
+				})
+
+				Convey(`Will not write any records if one already exists.`, func() {
+					req.Index = 5
+					req.Values = [][]byte{[]byte("ohai"), []byte("there")}
+
+					So(st.Put(req), ShouldEqual, storage.ErrExists)
+					So(st.Count(project, path), ShouldEqual, len(recs))
+				})
+
+				Convey(`Will return an error if closed.`, func() {
+					st.Close()
+
+					req.Index = 1337
+					So(st.Put(req), ShouldErrLike, "storage is closed")
+				})
+			})
+
+			Convey(`Get()`, func() {
+				req := storage.GetRequest{
+					Project: project,
+					Path:    path,
+				}
+
+				Convey(`Can retrieve all of the records correctly.`, func() {
+					So(st.Get(req, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldResemble, recs)
+				})
+
+				Convey(`Can retrieve records starting at an index.`, func() {
+					req.Index = 6
+
+					So(st.Get(req, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldResemble, recs[6:])
+				})
+
+				Convey(`Will adhere to GetRequest limit.`, func() {
+					req.Limit = 4
+
+					So(st.Get(req, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldResemble, recs[:4])
+				})
+
+				Convey(`Will adhere to hard limit.`, func() {
+					st.MaxGetCount = 3
+					req.Limit = 4
+
+					So(st.Get(req, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldResemble, recs[:3])
+				})
+
+				Convey(`Will omit data for keys-only requests.`, func() {
+					req.KeysOnly = true
+
+					So(st.Get(req, getAllCB), ShouldBeNil)
+					So(getRecs, ShouldHaveLength, len(recs))
+					So(getRecs[0].data, ShouldBeNil)
+				})
+
+				Convey(`Will stop iterating if callback returns false.`, func() {
+					count := 0
+					err := st.Get(req, func(*storage.Entry) bool {
+						count++
+						return false
+					})
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 1)
+				})
+
+				Convey(`Will fail to retrieve records if the project doesn't exist.`, func() {
+					req.Project = "project-does-not-exist"
+
+					So(st.Get(req, getAllCB), ShouldEqual, storage.ErrDoesNotExist)
+				})
+
+				Convey(`Will fail to retrieve records if the path doesn't exist.`, func() {
+					req.Path = "testing/+/does/not/exist"
+
+					So(st.Get(req, getAllCB), ShouldEqual, storage.ErrDoesNotExist)
+				})
+
+				Convey(`Will return an error if closed.`, func() {
+					st.Close()
+
+					So(st.Get(req, nil), ShouldErrLike, "storage is closed")
+				})
+			})
+
+			Convey(`Tail()`, func() {
+				Convey(`Can retrieve the tail record, 10.`, func() {
+					e, err := st.Tail(project, path)
+					So(err, ShouldBeNil)
+					So(e.D, ShouldResemble, numRec(10).data)
+					So(mustGetIndex(e), ShouldEqual, 10)
+				})
+
+				Convey(`Will fail to retrieve records if the project doesn't exist.`, func() {
+					_, err := st.Tail("project-does-not-exist", path)
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
+
+				Convey(`Will fail to retrieve records if the path doesn't exist.`, func() {
+					_, err := st.Tail(project, "testing/+/does/not/exist")
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
+
+				Convey(`Will return an error if closed.`, func() {
+					st.Close()
+					_, err := st.Tail("", "")
+					So(err, ShouldErrLike, "storage is closed")
+				})
+			})
+
+			Convey(`Purge()`, func() {
+				Convey(`Can delete all of a log stream's records.`, func() {
+					So(st.Purge(project, path), ShouldBeNil)
+					So(st.Count(project, path), ShouldEqual, 0)
+
+					_, err := st.Tail(project, path)
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+
+					// The log stream can be written again.
+					So(putRange(0, 1), ShouldBeNil)
+					So(st.Count(project, path), ShouldEqual, 1)
+				})
+
+				Convey(`Will succeed if the path doesn't exist.`, func() {
+					So(st.Purge(project, "testing/+/does/not/exist"), ShouldBeNil)
+				})
+			})
+
+			Convey(`Config()`, func() {
+				cfg := storage.Config{
+					MaxLogAge: time.Hour,
+				}
+
+				Convey(`Can update the configuration.`, func() {
+					So(st.Config(cfg), ShouldBeNil)
+					So(st.MaxLogAge, ShouldEqual, cfg.MaxLogAge)
+				})
+
+				Convey(`Will return an error if closed.`, func() {
+					st.Close()
+					So(st.Config(storage.Config{}), ShouldErrLike, "storage is closed")
+				})
+			})
+
+			Convey(`Persists records across instances.`, func() {
+				st.Close()
+				st, err = New(opts)
+				So(err, ShouldBeNil)
+
+				So(st.Get(storage.GetRequest{Project: project, Path: path}, getAllCB), ShouldBeNil)
+				So(getRecs, ShouldResemble, recs)
+
+				e, err := st.Tail(project, path)
+				So(err, ShouldBeNil)
+				So(mustGetIndex(e), ShouldEqual, 10)
+
+				Convey(`Can append after reloading.`, func() {
+					So(putRange(11, 1), ShouldBeNil)
+
+					e, err := st.Tail(project, path)
+					So(err, ShouldBeNil)
+					So(e.D, ShouldResemble, numRec(11).data)
+				})
+			})
+
+			Convey(`Another instance sees records as they are written.`, func() {
+				reader, err := New(opts)
+				So(err, ShouldBeNil)
+				defer reader.Close()
+
+				e, err := reader.Tail(project, path)
+				So(err, ShouldBeNil)
+				So(mustGetIndex(e), ShouldEqual, 10)
+
+				// Enough records to start new segments.
+				So(putRange(11, 4), ShouldBeNil)
+
+				So(reader.Get(storage.GetRequest{Project: project, Path: path, Index: 10}, getAllCB), ShouldBeNil)
+				So(getRecs, ShouldResemble, []*rec{numRec(10), numRec(11), numRec(12), numRec(13), numRec(14)})
+			})
+
+			Convey(`Closes the least recently used log streams.`, func() {
+				st.MaxOpenStreams = 2
+
+				paths := []types.StreamPath{"testing/+/a", "testing/+/b", path}
+				for i, p := range paths[:2] {
+					So(st.Put(storage.PutRequest{
+						Project: project,
+						Path:    p,
+						Values:  [][]byte{numRec(types.MessageIndex(i)).data},
+					}), ShouldBeNil)
+				}
+				So(len(st.streams), ShouldEqual, 2)
+				So(st.lru.Len(), ShouldEqual, 2)
+				So(st.streams[streamKey{project, path}], ShouldBeNil)
+
+				// Evicted log streams are loaded again when they are used.
+				So(st.Get(storage.GetRequest{Project: project, Path: path}, getAllCB), ShouldBeNil)
+				So(getRecs, ShouldResemble, recs)
+				So(len(st.streams), ShouldEqual, 2)
+				So(st.streams[streamKey{project, "testing/+/a"}], ShouldBeNil)
+
+				e, err := st.Tail(project, "testing/+/a")
+				So(err, ShouldBeNil)
+				So(e.D, ShouldResemble, numRec(0).data)
+
+				Convey(`Can append after being evicted.`, func() {
+					So(putRange(11, 1), ShouldBeNil)
+
+					e, err := st.Tail(project, path)
+					So(err, ShouldBeNil)
+					So(e.D, ShouldResemble, numRec(11).data)
+				})
+			})
+
+			Convey(`Recovers from partially-written records.`, func() {
+				st.Close()
+
+				// Find the last segment, and simulate a crash part of the way through
+				// writing another record to it.
+				dir := st.streamDir(project, path)
+				segs, err := filepath.Glob(filepath.Join(dir, "*"+indexExt))
+				So(err, ShouldBeNil)
+				So(len(segs), ShouldBeGreaterThan, 1)
+				last := segs[len(segs)-1]
+
+				appendFile := func(path string, d []byte) {
+					f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+					So(err, ShouldBeNil)
+					defer f.Close()
+					_, err = f.Write(d)
+					So(err, ShouldBeNil)
+				}
+				appendFile(last[:len(last)-len(indexExt)]+dataExt, []byte("partial"))
+				appendFile(last, []byte{0x00, 0x01})
+
+				st, err = New(opts)
+				So(err, ShouldBeNil)
+
+				So(st.Get(storage.GetRequest{Project: project, Path: path}, getAllCB), ShouldBeNil)
+				So(getRecs, ShouldResemble, recs)
+
+				So(putRange(11, 1), ShouldBeNil)
+				e, err := st.Tail(project, path)
+				So(err, ShouldBeNil)
+				So(e.D, ShouldResemble, numRec(11).data)
+			})
+		})
+	})
+}
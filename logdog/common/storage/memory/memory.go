@@ -172,6 +172,14 @@ func (s *Storage) Tail(project cfgtypes.ProjectName, path types.StreamPath) (*st
 	return storage.MakeEntry(r.data, r.index), nil
 }
 
+// Purge implements storage.Storage.
+func (s *Storage) Purge(project cfgtypes.ProjectName, path types.StreamPath) error {
+	return s.run(func() error {
+		delete(s.streams, streamKey{project, path})
+		return nil
+	})
+}
+
 // Count returns the number of log records for the given stream.
 func (s *Storage) Count(project cfgtypes.ProjectName, path types.StreamPath) (c int) {
 	s.run(func() error {
@@ -191,6 +191,16 @@ func TestBigTable(t *testing.T) {
 				})
 			})
 
+			Convey(`Purge()`, func() {
+				Convey(`Can delete all of a log stream's records.`, func() {
+					So(st.Purge(project, path), ShouldBeNil)
+					So(st.Count(project, path), ShouldEqual, 0)
+
+					_, err := st.Tail(project, path)
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
+			})
+
 			Convey(`Config()`, func() {
 				cfg := storage.Config{
 					MaxLogAge: time.Hour,
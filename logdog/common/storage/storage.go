@@ -119,6 +119,11 @@ type Storage interface {
 	// index.
 	Tail(cfgtypes.ProjectName, types.StreamPath) (*Entry, error)
 
+	// Purge deletes all of the log records for the specified log stream.
+	//
+	// It is not an error if the log stream has no log records.
+	Purge(cfgtypes.ProjectName, types.StreamPath) error
+
 	// Config installs the supplied configuration parameters into the storage
 	// instance.
 	Config(Config) error
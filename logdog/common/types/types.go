@@ -13,6 +13,8 @@ type ContentType string
 const (
 	// ContentTypeText is a stream content type for text streams
 	ContentTypeText ContentType = "text/plain"
+	// ContentTypeJSONLines is a stream content type for JSON-lines streams.
+	ContentTypeJSONLines = "application/x-ndjson"
 	// ContentTypeBinary is a stream content type for binary streams.
 	ContentTypeBinary = "application/octet-stream"
 
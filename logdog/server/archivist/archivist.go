@@ -149,6 +149,13 @@ type Settings struct {
 	// IndexByteRange is the maximum number of stream data bytes in between index
 	// entries. See archive.Manifest for more information.
 	IndexByteRange int
+
+	// Compress, if true, means that archived log streams should be compressed.
+	// See archive.Manifest for more information.
+	Compress bool
+	// CompressBlockSize is the maximum number of uncompressed log stream bytes
+	// in a compressed block. See archive.Manifest for more information.
+	CompressBlockSize int
 }
 
 // SettingsLoader returns archival Settings for a given project.
@@ -658,9 +665,13 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 		StreamIndexRange: sa.IndexStreamRange,
 		PrefixIndexRange: sa.IndexPrefixRange,
 		ByteRange:        sa.IndexByteRange,
+		BlockSize:        sa.CompressBlockSize,
 
 		Logger: log.Get(c),
 	}
+	if sa.Compress {
+		m.Compression = logpb.LogIndex_ZLIB
+	}
 	if err = archive.Archive(m); err != nil {
 		log.WithError(err).Errorf(c, "Failed to archive log stream.")
 		return
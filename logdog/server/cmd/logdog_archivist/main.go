@@ -254,6 +254,10 @@ func (a *application) GetSettingsLoader(acfg *svcconfig.Archivist) archivist.Set
 			IndexPrefixRange: indexParam(func(ic *svcconfig.ArchiveIndexConfig) int32 { return ic.PrefixRange }),
 			IndexByteRange:   indexParam(func(ic *svcconfig.ArchiveIndexConfig) int32 { return ic.ByteRange }),
 			AlwaysRender:     (acfg.RenderAllStreams || pcfg.RenderAllStreams),
+
+			Compress: (acfg.GetArchiveIndexConfig().GetCompress() ||
+				pcfg.GetArchiveIndexConfig().GetCompress()),
+			CompressBlockSize: indexParam(func(ic *svcconfig.ArchiveIndexConfig) int32 { return ic.BlockSize }),
 		}
 
 		// Fold project settings into loaded ones.
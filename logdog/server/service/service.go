@@ -37,6 +37,7 @@ import (
 	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/services/v1"
 	"github.com/luci/luci-go/logdog/common/storage"
 	"github.com/luci/luci-go/logdog/common/storage/bigtable"
+	"github.com/luci/luci-go/logdog/common/storage/local"
 	"github.com/luci/luci-go/logdog/server/retryServicesClient"
 	"github.com/luci/luci-go/logdog/server/service/config"
 	"github.com/luci/luci-go/luci_config/common/cfgtypes"
@@ -127,6 +128,14 @@ type Service struct {
 	// testConfigFilePath is the path to a local configuration service filesystem
 	// (impl/filesystem) root. This is used for testing.
 	testConfigFilePath string
+	// localStoragePath, if not empty, is the path of a local storage directory
+	// to use for intermediate storage instead of the configured storage.
+	localStoragePath string
+	// localStorage is the local intermediate storage instance, shared by all
+	// IntermediateStorage callers. It is created on first use and closed when the
+	// service exits.
+	localStorageMu sync.Mutex
+	localStorage   *local.Storage
 	// serviceConfig is the cached service configuration.
 	serviceConfig svcconfig.Config
 	configCache   config.MessageCache
@@ -268,6 +277,9 @@ func (s *Service) runImpl(c context.Context, f func(context.Context) error) erro
 	// Clear our shutdown function on termination.
 	defer s.SetShutdownFunc(nil)
 
+	// Close our local intermediate storage, if it was used.
+	defer s.closeLocalStorage()
+
 	// Run main service function.
 	return f(c)
 }
@@ -303,6 +315,9 @@ func (s *Service) addFlags(c context.Context, fs *flag.FlagSet) {
 		"If non-zero, poll for configuration changes and kill the application if one is detected.")
 	fs.StringVar(&s.testConfigFilePath, "test-config-file-path", s.testConfigFilePath,
 		"(Testing) If set, load configuration from a local filesystem rooted here.")
+	fs.StringVar(&s.localStoragePath, "local-storage-path", s.localStoragePath,
+		"If set, use local on-disk intermediate storage rooted here instead of the configured storage. "+
+			"This is intended for development and single-machine deployments.")
 }
 
 // probeGCEEnvironment fills in any parameters that can be probed from Google
@@ -522,7 +537,18 @@ func (s *Service) ServiceID() string {
 //
 // If "rw" is true, Read/Write access will be requested. Otherwise, read-only
 // access will be requested.
+//
+// Local intermediate storage is shared by all callers and is closed when the
+// service exits, so closing the returned instance does nothing.
 func (s *Service) IntermediateStorage(c context.Context, rw bool) (storage.Storage, error) {
+	if s.localStoragePath != "" {
+		st, err := s.getLocalStorage(c)
+		if err != nil {
+			return nil, err
+		}
+		return sharedStorage{st}, nil
+	}
+
 	cfg := s.ServiceConfig()
 	if cfg.GetStorage() == nil {
 		log.Errorf(c, "Missing storage configuration.")
@@ -561,6 +587,44 @@ func (s *Service) IntermediateStorage(c context.Context, rw bool) (storage.Stora
 	return bt, nil
 }
 
+// getLocalStorage returns the service's local intermediate storage instance,
+// creating it if necessary.
+func (s *Service) getLocalStorage(c context.Context) (*local.Storage, error) {
+	s.localStorageMu.Lock()
+	defer s.localStorageMu.Unlock()
+
+	if s.localStorage == nil {
+		log.Fields{
+			"path": s.localStoragePath,
+		}.Infof(c, "Using local intermediate storage.")
+
+		st, err := local.New(local.Options{Dir: s.localStoragePath})
+		if err != nil {
+			return nil, err
+		}
+		s.localStorage = st
+	}
+	return s.localStorage, nil
+}
+
+func (s *Service) closeLocalStorage() {
+	s.localStorageMu.Lock()
+	defer s.localStorageMu.Unlock()
+
+	if s.localStorage != nil {
+		s.localStorage.Close()
+		s.localStorage = nil
+	}
+}
+
+// sharedStorage is a storage.Storage that is owned by the Service. Closing it
+// does nothing.
+type sharedStorage struct {
+	storage.Storage
+}
+
+func (sharedStorage) Close() {}
+
 // GSClient returns an authenticated Google Storage client instance.
 func (s *Service) GSClient(c context.Context) (gs.Client, error) {
 	// Get an Authenticator bound to the token scopes that we need for
@@ -12,688 +12,690 @@ func init() {
 			"milo.Buildbot", "milo.BuildInfo",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 212, 124, 111, 108, 28, 201,
-			149, 223, 84, 87, 207, 112, 88, 252, 95, 164, 36, 170, 197, 149,
-			106, 41, 137, 34, 181, 212, 136, 162, 180, 90, 253, 89, 201, 59,
-			34, 135, 210, 236, 81, 36, 61, 67, 174, 86, 123, 246, 106, 155,
-			51, 69, 178, 87, 51, 221, 179, 221, 61, 164, 184, 9, 22, 9,
-			98, 24, 151, 28, 112, 134, 17, 195, 8, 2, 35, 185, 196, 129,
-			1, 123, 131, 24, 112, 108, 192, 193, 2, 198, 45, 98, 127, 75,
-			144, 111, 65, 16, 4, 249, 146, 0, 249, 154, 143, 119, 64, 62,
-			4, 239, 85, 85, 119, 15, 69, 237, 218, 190, 79, 39, 248, 207,
-			188, 174, 170, 223, 123, 245, 234, 85, 213, 171, 87, 175, 200, 254,
-			219, 12, 59, 183, 27, 4, 187, 45, 121, 181, 19, 6, 113, 176,
-			221, 221, 185, 26, 123, 109, 25, 197, 110, 187, 83, 194, 79, 124,
-			68, 85, 40, 153, 10, 211, 119, 89, 255, 166, 169, 195, 39, 89,
-			95, 36, 27, 129, 223, 140, 38, 137, 32, 179, 180, 102, 72, 62,
-			193, 242, 190, 235, 7, 209, 164, 37, 200, 108, 190, 166, 136, 7,
-			159, 177, 241, 70, 208, 46, 29, 193, 124, 48, 156, 32, 110, 192,
-			167, 13, 242, 193, 27, 187, 94, 188, 215, 221, 46, 53, 130, 246,
-			213, 221, 160, 229, 250, 187, 169, 136, 157, 248, 176, 35, 163, 84,
-			210, 191, 38, 228, 95, 91, 244, 225, 198, 131, 159, 89, 103, 31,
-			42, 228, 13, 93, 183, 244, 68, 182, 90, 127, 226, 7, 7, 254,
-			38, 180, 121, 247, 127, 94, 96, 5, 110, 159, 205, 61, 39, 236,
-			63, 13, 50, 50, 200, 233, 217, 28, 95, 252, 114, 80, 96, 131,
-			70, 208, 18, 15, 186, 59, 59, 50, 140, 196, 21, 161, 160, 46,
-			69, 162, 233, 198, 174, 240, 252, 88, 134, 141, 61, 215, 223, 149,
-			98, 39, 8, 219, 110, 204, 196, 82, 208, 57, 12, 189, 221, 189,
-			88, 44, 46, 44, 220, 210, 13, 68, 213, 111, 148, 132, 40, 183,
-			90, 2, 203, 34, 17, 202, 72, 134, 251, 178, 89, 98, 98, 47,
-			142, 59, 209, 157, 171, 87, 155, 114, 95, 182, 130, 142, 12, 35,
-			163, 11, 232, 104, 71, 11, 113, 101, 91, 9, 113, 149, 49, 81,
-			147, 77, 47, 138, 67, 111, 187, 27, 123, 129, 47, 92, 191, 41,
-			186, 145, 20, 158, 47, 162, 160, 27, 54, 36, 126, 217, 246, 124,
-			55, 60, 68, 185, 162, 121, 113, 224, 197, 123, 34, 8, 241, 255,
-			131, 110, 204, 68, 59, 104, 122, 59, 94, 195, 5, 132, 121, 225,
-			134, 82, 116, 100, 216, 246, 226, 88, 54, 69, 39, 12, 246, 189,
-			166, 108, 138, 120, 207, 141, 69, 188, 7, 189, 107, 181, 130, 3,
-			207, 223, 21, 48, 146, 30, 52, 138, 160, 17, 19, 109, 25, 223,
-			97, 76, 192, 191, 203, 71, 4, 139, 68, 176, 99, 36, 106, 4,
-			77, 41, 218, 221, 40, 22, 161, 140, 93, 207, 71, 84, 119, 59,
-			216, 135, 34, 173, 49, 38, 252, 32, 246, 26, 114, 94, 196, 123,
-			94, 36, 90, 94, 20, 3, 66, 150, 163, 223, 60, 34, 78, 211,
-			139, 26, 45, 215, 107, 203, 176, 244, 42, 33, 60, 63, 171, 11,
-			35, 68, 39, 12, 154, 221, 134, 76, 229, 96, 169, 32, 127, 43,
-			57, 152, 208, 189, 107, 6, 141, 110, 91, 250, 177, 107, 6, 233,
-			106, 16, 138, 32, 222, 147, 161, 104, 187, 177, 12, 61, 183, 21,
-			165, 170, 198, 1, 138, 247, 36, 19, 89, 233, 147, 78, 173, 73,
-			15, 91, 2, 176, 239, 182, 37, 8, 148, 181, 45, 63, 72, 203,
-			80, 239, 94, 28, 65, 143, 124, 5, 21, 132, 145, 104, 187, 135,
-			98, 91, 130, 165, 52, 69, 28, 8, 233, 55, 131, 48, 146, 96,
-			20, 157, 48, 104, 7, 177, 20, 74, 39, 113, 36, 154, 50, 244,
-			246, 101, 83, 236, 132, 65, 155, 41, 45, 68, 193, 78, 124, 0,
-			102, 162, 45, 72, 68, 29, 217, 0, 11, 18, 157, 208, 3, 195,
-			10, 193, 118, 124, 101, 69, 81, 132, 178, 51, 177, 249, 168, 90,
-			23, 245, 245, 149, 205, 39, 229, 90, 69, 84, 235, 98, 163, 182,
-			254, 94, 117, 185, 178, 44, 30, 60, 21, 155, 143, 42, 98, 105,
-			125, 227, 105, 173, 250, 240, 209, 166, 120, 180, 190, 186, 92, 169,
-			213, 69, 121, 109, 89, 44, 173, 175, 109, 214, 170, 15, 182, 54,
-			215, 107, 117, 38, 166, 203, 117, 81, 173, 79, 99, 73, 121, 237,
-			169, 168, 188, 191, 81, 171, 212, 235, 98, 189, 38, 170, 143, 55,
-			86, 171, 149, 101, 241, 164, 92, 171, 149, 215, 54, 171, 149, 250,
-			188, 168, 174, 45, 173, 110, 45, 87, 215, 30, 206, 139, 7, 91,
-			155, 98, 109, 125, 147, 137, 213, 234, 227, 234, 102, 101, 89, 108,
-			174, 207, 35, 219, 151, 219, 137, 245, 21, 241, 184, 82, 91, 122,
-			84, 94, 219, 44, 63, 168, 174, 86, 55, 159, 34, 195, 149, 234,
-			230, 26, 48, 91, 89, 175, 49, 81, 22, 27, 229, 218, 102, 117,
-			105, 107, 181, 92, 19, 27, 91, 181, 141, 245, 122, 69, 64, 207,
-			150, 171, 245, 165, 213, 114, 245, 113, 101, 185, 36, 170, 107, 98,
-			109, 93, 84, 222, 171, 172, 109, 138, 250, 163, 242, 234, 106, 111,
-			71, 153, 88, 127, 178, 86, 169, 129, 244, 217, 110, 138, 7, 21,
-			177, 90, 45, 63, 88, 173, 0, 43, 236, 231, 114, 181, 86, 89,
-			218, 132, 14, 165, 191, 150, 170, 203, 149, 181, 205, 242, 234, 60,
-			19, 245, 141, 202, 82, 181, 188, 58, 47, 42, 239, 87, 30, 111,
-			172, 150, 107, 79, 231, 53, 104, 189, 242, 205, 173, 202, 218, 102,
-			181, 188, 42, 150, 203, 143, 203, 15, 43, 117, 49, 251, 117, 90,
-			217, 168, 173, 47, 109, 213, 42, 143, 65, 234, 245, 21, 81, 223,
-			122, 80, 223, 172, 110, 110, 109, 86, 196, 195, 245, 245, 101, 84,
-			118, 189, 82, 123, 175, 186, 84, 169, 223, 21, 171, 235, 117, 84,
-			216, 86, 189, 50, 207, 196, 114, 121, 179, 140, 172, 55, 106, 235,
-			43, 213, 205, 250, 93, 248, 253, 96, 171, 94, 69, 197, 85, 215,
-			54, 43, 181, 218, 214, 198, 102, 117, 125, 109, 78, 60, 90, 127,
-			82, 121, 175, 82, 19, 75, 229, 173, 122, 101, 25, 53, 188, 190,
-			6, 189, 5, 91, 169, 172, 215, 158, 2, 44, 232, 1, 71, 96,
-			94, 60, 121, 84, 217, 124, 84, 169, 129, 82, 81, 91, 101, 80,
-			67, 125, 179, 86, 93, 218, 204, 86, 91, 175, 137, 205, 245, 218,
-			38, 203, 244, 83, 172, 85, 30, 174, 86, 31, 86, 214, 150, 42,
-			80, 188, 14, 48, 79, 170, 245, 202, 156, 40, 215, 170, 117, 168,
-			80, 69, 198, 226, 73, 249, 169, 88, 223, 194, 94, 195, 64, 109,
-			213, 43, 76, 253, 206, 152, 238, 60, 142, 167, 168, 174, 136, 242,
-			242, 123, 85, 144, 92, 215, 222, 88, 175, 215, 171, 218, 92, 80,
-			109, 75, 143, 180, 206, 75, 140, 21, 25, 177, 56, 21, 197, 83,
-			240, 171, 200, 233, 116, 238, 46, 27, 96, 118, 241, 255, 244, 229,
-			20, 49, 200, 242, 64, 88, 156, 78, 247, 157, 98, 67, 172, 128,
-			84, 78, 145, 195, 172, 79, 145, 68, 209, 186, 114, 31, 167, 211,
-			206, 29, 141, 120, 62, 119, 78, 35, 18, 69, 168, 74, 192, 246,
-			124, 130, 72, 0, 241, 124, 130, 72, 16, 241, 124, 130, 72, 40,
-			167, 231, 157, 179, 26, 241, 66, 238, 129, 70, 180, 20, 161, 42,
-			89, 64, 245, 141, 105, 68, 11, 16, 129, 84, 136, 22, 34, 2,
-			173, 43, 247, 113, 122, 97, 162, 172, 17, 47, 230, 230, 53, 34,
-			85, 132, 170, 68, 45, 78, 47, 246, 141, 107, 68, 10, 136, 64,
-			42, 68, 138, 136, 64, 235, 202, 125, 156, 94, 60, 249, 134, 70,
-			156, 201, 93, 213, 136, 182, 34, 84, 37, 219, 226, 116, 166, 239,
-			140, 70, 180, 1, 17, 72, 133, 104, 35, 34, 208, 186, 114, 31,
-			167, 51, 103, 75, 26, 241, 82, 110, 90, 35, 230, 21, 161, 42,
-			229, 45, 78, 47, 245, 57, 26, 49, 15, 136, 64, 42, 196, 60,
-			34, 2, 173, 43, 83, 78, 47, 189, 246, 186, 70, 156, 205, 189,
-			174, 17, 11, 138, 80, 149, 10, 22, 167, 179, 125, 147, 26, 177,
-			0, 136, 64, 42, 196, 2, 34, 2, 173, 43, 247, 113, 58, 123,
-			70, 176, 95, 143, 48, 203, 206, 113, 251, 89, 238, 57, 113, 62,
-			31, 17, 101, 145, 248, 70, 184, 147, 201, 72, 250, 113, 36, 92,
-			209, 9, 60, 63, 198, 253, 199, 107, 131, 63, 208, 148, 29, 233,
-			55, 165, 143, 251, 151, 235, 31, 170, 239, 159, 6, 190, 100, 176,
-			238, 55, 220, 150, 244, 155, 110, 56, 159, 162, 200, 166, 112, 35,
-			161, 29, 54, 220, 231, 118, 66, 183, 145, 238, 230, 166, 0, 54,
-			107, 240, 222, 144, 6, 111, 38, 104, 41, 103, 196, 243, 197, 214,
-			230, 146, 168, 116, 130, 198, 30, 178, 43, 137, 106, 44, 188, 72,
-			72, 31, 124, 0, 240, 84, 96, 191, 196, 157, 110, 35, 12, 90,
-			178, 19, 123, 13, 241, 48, 148, 187, 65, 232, 185, 190, 88, 210,
-			50, 137, 131, 61, 175, 177, 39, 228, 139, 88, 2, 67, 216, 219,
-			210, 74, 70, 112, 38, 182, 221, 198, 243, 3, 55, 132, 26, 129,
-			56, 148, 110, 40, 2, 255, 37, 150, 110, 20, 117, 219, 192, 213,
-			109, 181, 68, 219, 243, 187, 177, 68, 239, 69, 220, 92, 96, 73,
-			151, 90, 129, 191, 59, 47, 188, 146, 44, 137, 150, 116, 59, 105,
-			87, 67, 41, 166, 163, 182, 116, 67, 217, 156, 22, 81, 160, 156,
-			34, 63, 200, 214, 98, 34, 118, 183, 91, 18, 120, 250, 82, 2,
-			203, 157, 32, 84, 238, 97, 7, 252, 29, 220, 202, 69, 13, 29,
-			69, 47, 210, 219, 234, 194, 194, 194, 181, 43, 248, 159, 205, 133,
-			133, 59, 248, 159, 15, 160, 23, 183, 111, 223, 190, 125, 229, 218,
-			226, 149, 235, 215, 54, 23, 175, 223, 121, 243, 246, 157, 55, 111,
-			151, 110, 155, 127, 31, 148, 152, 120, 112, 8, 10, 143, 67, 175,
-			17, 163, 42, 181, 72, 33, 192, 207, 139, 3, 41, 164, 31, 117,
-			67, 169, 190, 30, 72, 209, 0, 141, 5, 254, 190, 12, 99, 17,
-			7, 76, 143, 106, 208, 22, 162, 182, 178, 36, 174, 95, 191, 126,
-			27, 220, 89, 41, 0, 210, 223, 141, 74, 76, 212, 165, 20, 127,
-			106, 252, 210, 131, 131, 131, 146, 39, 227, 157, 82, 16, 238, 94,
-			13, 119, 26, 240, 95, 104, 84, 138, 95, 196, 223, 158, 253, 125,
-			106, 205, 129, 43, 80, 121, 225, 182, 59, 45, 41, 174, 221, 17,
-			75, 65, 187, 211, 141, 101, 198, 138, 81, 156, 141, 245, 122, 245,
-			125, 241, 17, 24, 205, 236, 220, 71, 37, 237, 85, 166, 149, 18,
-			231, 254, 174, 42, 73, 143, 37, 145, 140, 159, 233, 241, 154, 197,
-			230, 107, 91, 171, 171, 115, 115, 199, 214, 67, 179, 157, 93, 152,
-			187, 155, 145, 105, 241, 235, 100, 218, 149, 49, 160, 4, 59, 77,
-			247, 48, 35, 91, 20, 135, 221, 70, 140, 12, 246, 221, 150, 136,
-			247, 53, 199, 158, 234, 51, 241, 254, 188, 64, 129, 238, 254, 177,
-			93, 218, 47, 197, 251, 64, 125, 85, 143, 84, 165, 110, 36, 27,
-			226, 178, 184, 182, 176, 208, 219, 195, 235, 175, 236, 225, 19, 207,
-			191, 190, 40, 62, 122, 40, 227, 250, 97, 20, 203, 54, 20, 151,
-			163, 21, 175, 37, 55, 123, 7, 98, 165, 186, 90, 217, 172, 62,
-			174, 136, 157, 88, 139, 241, 170, 54, 51, 59, 177, 145, 116, 171,
-			186, 182, 121, 243, 134, 136, 189, 198, 243, 72, 220, 19, 179, 179,
-			179, 234, 203, 220, 78, 92, 106, 30, 60, 242, 118, 247, 150, 221,
-			24, 91, 205, 137, 183, 223, 22, 215, 23, 231, 196, 223, 23, 88,
-			182, 26, 28, 152, 34, 163, 183, 171, 87, 69, 25, 228, 109, 6,
-			7, 17, 66, 194, 100, 186, 182, 176, 144, 89, 138, 162, 82, 82,
-			65, 226, 18, 116, 237, 230, 203, 179, 44, 65, 131, 230, 215, 110,
-			222, 184, 113, 227, 173, 235, 55, 23, 22, 146, 41, 191, 45, 119,
-			130, 80, 138, 45, 223, 123, 97, 80, 110, 191, 181, 112, 20, 165,
-			244, 199, 13, 230, 172, 234, 191, 152, 157, 85, 74, 185, 138, 131,
-			5, 255, 230, 196, 149, 172, 56, 95, 99, 193, 128, 3, 234, 50,
-			56, 23, 51, 56, 104, 0, 115, 61, 6, 112, 227, 149, 6, 240,
-			174, 187, 239, 138, 143, 212, 64, 150, 26, 221, 48, 148, 126, 12,
-			85, 30, 123, 173, 150, 23, 101, 12, 0, 86, 72, 209, 198, 175,
-			226, 158, 120, 117, 131, 175, 48, 115, 113, 47, 253, 90, 242, 229,
-			193, 131, 174, 215, 106, 202, 112, 118, 14, 58, 86, 215, 26, 210,
-			44, 148, 98, 230, 20, 22, 252, 131, 58, 107, 170, 239, 158, 31,
-			67, 207, 117, 77, 213, 117, 221, 109, 212, 192, 92, 105, 27, 144,
-			81, 150, 84, 7, 111, 190, 82, 7, 186, 23, 102, 223, 20, 27,
-			135, 241, 158, 58, 193, 244, 168, 63, 43, 254, 236, 220, 209, 177,
-			121, 40, 227, 165, 84, 27, 179, 115, 12, 255, 81, 27, 54, 245,
-			103, 197, 49, 246, 35, 194, 108, 27, 221, 187, 166, 53, 225, 252,
-			5, 17, 181, 116, 239, 54, 166, 23, 236, 224, 246, 137, 114, 68,
-			158, 223, 200, 90, 33, 59, 222, 12, 197, 99, 56, 209, 110, 75,
-			213, 147, 87, 236, 42, 236, 184, 109, 229, 3, 225, 249, 141, 86,
-			55, 242, 246, 101, 137, 177, 33, 150, 7, 233, 108, 110, 55, 173,
-			103, 232, 120, 1, 153, 7, 105, 251, 12, 69, 56, 109, 22, 71,
-			12, 69, 57, 109, 242, 113, 246, 191, 85, 191, 8, 167, 31, 91,
-			220, 249, 175, 68, 172, 5, 254, 21, 95, 238, 186, 177, 183, 47,
-			123, 253, 7, 87, 119, 84, 192, 22, 122, 156, 255, 80, 18, 107,
-			186, 161, 217, 153, 197, 190, 219, 234, 202, 72, 29, 144, 83, 48,
-			60, 198, 71, 177, 215, 106, 137, 61, 119, 95, 10, 63, 203, 19,
-			161, 117, 67, 166, 246, 193, 70, 208, 245, 99, 216, 150, 193, 91,
-			48, 46, 210, 81, 221, 233, 237, 119, 94, 255, 151, 29, 163, 31,
-			98, 115, 251, 99, 171, 57, 161, 117, 64, 242, 208, 107, 163, 31,
-			2, 58, 40, 14, 25, 138, 114, 250, 241, 232, 216, 118, 1, 99,
-			56, 215, 217, 255, 56, 205, 222, 204, 132, 176, 90, 221, 134, 135,
-			255, 115, 101, 55, 184, 218, 246, 90, 193, 85, 183, 227, 169, 120,
-			207, 85, 180, 222, 237, 32, 214, 1, 55, 27, 138, 157, 175, 139,
-			203, 77, 159, 103, 67, 143, 221, 40, 150, 97, 77, 126, 210, 149,
-			81, 204, 57, 179, 125, 183, 45, 49, 14, 215, 95, 195, 223, 211,
-			159, 177, 9, 152, 4, 161, 140, 34, 217, 84, 213, 223, 173, 175,
-			175, 113, 135, 21, 209, 97, 241, 221, 22, 214, 47, 214, 18, 154,
-			223, 100, 69, 21, 47, 146, 77, 140, 221, 13, 44, 58, 71, 227,
-			117, 165, 100, 98, 212, 146, 186, 192, 191, 233, 198, 238, 36, 21,
-			100, 118, 176, 134, 191, 167, 37, 155, 120, 160, 123, 135, 255, 111,
-			100, 61, 201, 10, 109, 148, 70, 75, 171, 41, 62, 201, 250, 182,
-			213, 42, 129, 172, 251, 107, 134, 228, 103, 88, 63, 254, 124, 230,
-			119, 219, 200, 130, 214, 138, 248, 97, 173, 219, 158, 190, 196, 198,
-			122, 216, 96, 31, 141, 60, 36, 35, 207, 95, 18, 118, 162, 167,
-			102, 244, 199, 75, 52, 193, 242, 45, 175, 237, 197, 40, 77, 190,
-			166, 8, 126, 137, 141, 160, 29, 53, 229, 51, 189, 206, 76, 218,
-			168, 224, 97, 253, 89, 175, 26, 192, 176, 209, 13, 163, 32, 156,
-			204, 43, 134, 138, 154, 254, 54, 227, 189, 18, 98, 103, 174, 178,
-			2, 242, 141, 38, 137, 160, 179, 3, 139, 167, 74, 96, 37, 165,
-			151, 122, 93, 211, 213, 50, 240, 86, 22, 126, 241, 111, 8, 43,
-			154, 86, 124, 149, 157, 130, 117, 236, 56, 11, 25, 87, 12, 122,
-			76, 204, 113, 212, 199, 227, 26, 76, 231, 248, 99, 54, 241, 80,
-			198, 47, 15, 132, 115, 140, 172, 6, 241, 85, 253, 152, 206, 241,
-			13, 118, 226, 40, 156, 210, 197, 153, 99, 218, 152, 113, 116, 38,
-			143, 43, 84, 136, 239, 254, 21, 103, 5, 110, 219, 185, 50, 97,
-			191, 34, 24, 252, 181, 115, 124, 241, 103, 164, 39, 142, 123, 237,
-			166, 216, 220, 147, 98, 117, 107, 169, 42, 202, 221, 120, 47, 8,
-			163, 210, 43, 130, 185, 91, 17, 134, 230, 116, 200, 44, 13, 125,
-			122, 145, 216, 13, 246, 101, 232, 195, 9, 200, 111, 234, 72, 94,
-			185, 227, 54, 0, 216, 107, 72, 63, 146, 243, 226, 61, 25, 70,
-			112, 136, 90, 44, 45, 152, 101, 203, 245, 113, 121, 10, 186, 126,
-			211, 4, 22, 87, 171, 75, 149, 181, 122, 69, 236, 120, 45, 153,
-			68, 25, 10, 197, 65, 214, 207, 44, 154, 227, 180, 216, 55, 203,
-			74, 204, 42, 228, 184, 61, 144, 27, 33, 206, 52, 74, 111, 150,
-			20, 1, 178, 122, 13, 41, 154, 114, 199, 243, 61, 21, 101, 132,
-			125, 170, 0, 171, 251, 64, 113, 20, 142, 173, 5, 220, 166, 6,
-			173, 53, 88, 202, 10, 106, 225, 31, 44, 188, 102, 40, 139, 211,
-			193, 179, 111, 24, 138, 114, 58, 120, 243, 93, 221, 140, 112, 58,
-			100, 173, 235, 34, 88, 15, 135, 10, 142, 161, 44, 78, 135, 206,
-			92, 53, 20, 229, 116, 232, 206, 159, 232, 102, 22, 167, 195, 86,
-			77, 23, 1, 200, 112, 225, 140, 161, 160, 108, 234, 154, 161, 40,
-			167, 195, 111, 175, 177, 183, 213, 145, 152, 231, 38, 136, 179, 128,
-			29, 12, 213, 112, 99, 208, 211, 245, 124, 125, 202, 76, 162, 165,
-			240, 91, 77, 224, 82, 186, 45, 243, 226, 9, 16, 64, 237, 202,
-			227, 22, 207, 108, 130, 227, 22, 63, 149, 217, 4, 199, 173, 98,
-			102, 19, 28, 239, 31, 202, 108, 130, 227, 163, 99, 172, 204, 44,
-			155, 112, 251, 84, 78, 16, 231, 77, 45, 79, 212, 9, 252, 72,
-			138, 182, 140, 34, 119, 87, 102, 5, 83, 130, 8, 207, 87, 215,
-			6, 201, 24, 216, 160, 177, 83, 197, 41, 246, 38, 179, 109, 140,
-			219, 156, 182, 38, 156, 89, 241, 100, 79, 38, 225, 95, 211, 54,
-			18, 102, 125, 134, 179, 188, 31, 196, 122, 143, 34, 40, 254, 105,
-			235, 212, 89, 20, 145, 160, 248, 167, 173, 130, 161, 8, 167, 167,
-			251, 70, 12, 69, 57, 61, 205, 199, 217, 61, 228, 71, 56, 157,
-			178, 230, 64, 159, 137, 199, 163, 53, 183, 19, 202, 104, 207, 151,
-			81, 212, 171, 74, 188, 7, 73, 248, 194, 222, 56, 101, 157, 158,
-			208, 216, 164, 0, 112, 103, 12, 5, 224, 83, 23, 12, 69, 57,
-			157, 186, 52, 203, 174, 34, 95, 139, 211, 115, 214, 152, 51, 45,
-			30, 126, 234, 117, 58, 178, 41, 62, 142, 2, 95, 221, 177, 28,
-			29, 57, 197, 201, 178, 185, 125, 206, 154, 154, 211, 104, 86, 30,
-			0, 250, 12, 69, 56, 61, 87, 28, 52, 20, 229, 244, 220, 200,
-			40, 187, 202, 44, 219, 226, 246, 249, 220, 37, 226, 156, 239, 49,
-			24, 56, 171, 187, 105, 60, 27, 39, 138, 30, 14, 128, 58, 95,
-			156, 66, 27, 81, 65, 47, 107, 2, 69, 176, 80, 201, 23, 172,
-			243, 74, 201, 22, 42, 249, 130, 182, 17, 29, 15, 235, 31, 49,
-			20, 229, 244, 2, 31, 215, 40, 132, 211, 139, 214, 9, 141, 2,
-			42, 187, 104, 93, 152, 208, 53, 193, 157, 184, 152, 160, 128, 202,
-			46, 246, 143, 26, 138, 114, 122, 113, 124, 66, 163, 88, 156, 206,
-			88, 39, 53, 10, 168, 99, 198, 186, 120, 66, 215, 4, 117, 204,
-			104, 117, 88, 200, 112, 166, 56, 102, 40, 202, 233, 204, 196, 9,
-			118, 139, 89, 54, 229, 246, 229, 220, 21, 226, 204, 31, 111, 175,
-			95, 161, 23, 74, 56, 189, 92, 60, 205, 102, 152, 109, 99, 232,
-			110, 222, 26, 115, 78, 139, 119, 143, 14, 155, 105, 3, 98, 82,
-			84, 217, 188, 117, 89, 89, 4, 69, 149, 205, 107, 49, 85, 192,
-			111, 94, 143, 26, 69, 149, 205, 143, 140, 178, 59, 204, 178, 109,
-			110, 47, 228, 110, 19, 167, 244, 210, 168, 181, 187, 173, 216, 3,
-			207, 30, 249, 136, 192, 23, 174, 208, 123, 177, 22, 212, 38, 156,
-			46, 20, 95, 67, 165, 97, 68, 240, 154, 30, 64, 27, 165, 185,
-			102, 45, 96, 204, 20, 200, 60, 20, 22, 13, 69, 56, 189, 166,
-			7, 208, 70, 105, 174, 233, 1, 68, 155, 88, 212, 3, 104, 227,
-			0, 46, 90, 215, 38, 116, 77, 24, 192, 197, 4, 5, 6, 112,
-			81, 15, 160, 141, 3, 184, 56, 62, 193, 42, 136, 98, 113, 122,
-			195, 226, 206, 45, 177, 10, 78, 130, 138, 226, 72, 225, 119, 219,
-			219, 50, 4, 245, 169, 77, 27, 190, 135, 50, 238, 134, 190, 152,
-			109, 202, 29, 183, 219, 138, 239, 136, 197, 133, 57, 173, 83, 27,
-			135, 254, 134, 181, 120, 66, 243, 128, 161, 191, 161, 117, 170, 108,
-			237, 134, 246, 71, 109, 28, 250, 27, 163, 99, 236, 45, 228, 79,
-			57, 189, 105, 157, 113, 46, 139, 170, 114, 66, 68, 224, 239, 6,
-			176, 54, 105, 198, 41, 187, 29, 183, 21, 201, 148, 35, 181, 185,
-			125, 211, 186, 193, 53, 42, 205, 3, 80, 193, 80, 132, 211, 155,
-			125, 39, 13, 5, 76, 78, 59, 154, 163, 205, 233, 45, 107, 194,
-			185, 44, 106, 170, 67, 154, 81, 20, 187, 33, 6, 178, 208, 7,
-			199, 157, 83, 57, 38, 9, 71, 176, 128, 91, 214, 205, 51, 26,
-			213, 206, 3, 144, 209, 49, 140, 240, 173, 100, 164, 108, 202, 233,
-			45, 62, 206, 150, 25, 84, 179, 223, 206, 149, 137, 115, 235, 213,
-			230, 221, 107, 64, 120, 87, 120, 212, 130, 242, 132, 211, 183, 139,
-			14, 91, 99, 182, 141, 17, 224, 251, 214, 172, 83, 78, 26, 168,
-			208, 164, 185, 35, 212, 95, 67, 25, 1, 112, 218, 39, 153, 150,
-			160, 245, 150, 24, 202, 155, 7, 35, 164, 247, 173, 132, 42, 112,
-			122, 127, 96, 202, 80, 132, 211, 251, 175, 157, 55, 20, 229, 244,
-			254, 204, 37, 246, 30, 202, 65, 56, 125, 199, 154, 112, 170, 216,
-			53, 165, 174, 196, 132, 228, 139, 56, 157, 37, 97, 208, 158, 23,
-			158, 154, 141, 242, 133, 219, 136, 69, 4, 27, 226, 39, 93, 25,
-			30, 138, 3, 55, 18, 187, 222, 190, 244, 181, 170, 243, 104, 206,
-			239, 88, 247, 103, 53, 83, 48, 231, 119, 180, 170, 243, 104, 206,
-			239, 104, 85, 231, 209, 156, 223, 225, 227, 201, 241, 230, 119, 107,
-			236, 238, 171, 142, 55, 141, 160, 221, 14, 124, 125, 184, 81, 103,
-			29, 223, 15, 84, 96, 52, 250, 195, 14, 57, 127, 77, 216, 240,
-			138, 235, 181, 186, 161, 92, 150, 177, 235, 181, 34, 126, 133, 217,
-			241, 97, 71, 29, 115, 134, 23, 79, 43, 119, 175, 183, 78, 105,
-			243, 176, 35, 107, 88, 13, 78, 1, 177, 124, 17, 107, 47, 24,
-			127, 243, 251, 108, 98, 199, 245, 90, 178, 249, 172, 217, 126, 102,
-			194, 230, 141, 195, 73, 138, 174, 245, 160, 130, 92, 126, 188, 234,
-			249, 207, 107, 92, 213, 92, 110, 47, 39, 245, 166, 27, 204, 6,
-			14, 124, 128, 245, 61, 172, 172, 85, 106, 229, 213, 209, 28, 31,
-			98, 253, 149, 247, 151, 42, 120, 245, 53, 74, 120, 63, 203, 87,
-			215, 86, 106, 229, 81, 139, 79, 178, 137, 229, 199, 207, 150, 43,
-			27, 149, 181, 229, 202, 218, 210, 211, 103, 43, 229, 234, 106, 101,
-			121, 148, 66, 155, 165, 242, 218, 82, 101, 21, 72, 27, 240, 42,
-			239, 111, 84, 107, 149, 229, 209, 252, 244, 191, 42, 50, 187, 30,
-			203, 206, 113, 231, 58, 62, 207, 250, 64, 203, 174, 111, 142, 104,
-			92, 9, 13, 13, 192, 61, 135, 146, 154, 169, 194, 47, 176, 66,
-			20, 187, 113, 55, 194, 163, 202, 176, 233, 97, 29, 191, 213, 116,
-			25, 191, 199, 70, 118, 148, 26, 159, 53, 149, 30, 241, 228, 50,
-			176, 56, 113, 156, 142, 107, 195, 59, 189, 227, 50, 207, 250, 162,
-			238, 118, 20, 203, 206, 100, 30, 245, 152, 21, 169, 174, 74, 106,
-			166, 10, 127, 139, 13, 69, 113, 51, 232, 198, 207, 162, 56, 148,
-			110, 123, 178, 144, 237, 198, 106, 176, 219, 12, 118, 235, 88, 82,
-			27, 84, 21, 21, 165, 27, 202, 48, 52, 13, 251, 190, 178, 161,
-			12, 67, 221, 240, 6, 235, 195, 197, 71, 54, 39, 139, 95, 123,
-			170, 53, 85, 249, 2, 203, 195, 168, 55, 39, 251, 191, 182, 141,
-			170, 152, 24, 220, 132, 160, 137, 193, 93, 101, 197, 78, 24, 236,
-			194, 153, 105, 242, 4, 2, 141, 103, 148, 179, 161, 139, 106, 73,
-			37, 126, 150, 217, 45, 207, 127, 62, 121, 18, 43, 51, 221, 57,
-			176, 71, 252, 206, 223, 96, 3, 152, 92, 240, 12, 168, 104, 242,
-			20, 42, 60, 91, 141, 97, 49, 252, 140, 52, 247, 142, 12, 227,
-			195, 201, 73, 172, 121, 132, 59, 22, 213, 146, 74, 206, 175, 8,
-			235, 211, 70, 196, 95, 103, 131, 218, 140, 128, 151, 196, 227, 103,
-			127, 109, 64, 127, 91, 245, 124, 201, 71, 25, 109, 28, 52, 245,
-			12, 131, 159, 252, 54, 235, 147, 254, 190, 23, 6, 190, 158, 83,
-			231, 94, 54, 207, 82, 69, 213, 168, 248, 113, 120, 88, 51, 245,
-			157, 59, 108, 48, 91, 0, 224, 207, 229, 161, 54, 126, 248, 9,
-			231, 110, 12, 246, 104, 134, 138, 184, 99, 221, 34, 206, 33, 235,
-			211, 134, 198, 5, 179, 209, 20, 73, 86, 129, 192, 254, 81, 174,
-			134, 37, 188, 204, 198, 210, 101, 201, 24, 147, 245, 42, 99, 122,
-			148, 171, 141, 166, 213, 213, 183, 7, 253, 137, 201, 59, 247, 89,
-			209, 12, 35, 8, 24, 7, 177, 142, 172, 228, 107, 138, 224, 83,
-			172, 191, 17, 180, 59, 45, 25, 235, 184, 74, 190, 150, 126, 112,
-			110, 96, 123, 84, 255, 177, 19, 254, 216, 78, 79, 255, 63, 194,
-			108, 24, 99, 140, 69, 184, 219, 178, 165, 219, 40, 130, 159, 99,
-			3, 110, 203, 115, 163, 103, 170, 76, 53, 101, 248, 105, 21, 43,
-			112, 70, 187, 97, 11, 87, 133, 254, 71, 185, 26, 16, 252, 54,
-			27, 106, 97, 199, 141, 78, 236, 175, 208, 201, 96, 43, 67, 243,
-			183, 217, 176, 23, 5, 45, 55, 150, 207, 130, 237, 143, 101, 35,
-			198, 208, 70, 98, 110, 85, 85, 182, 142, 69, 143, 114, 181, 33,
-			47, 251, 129, 95, 98, 125, 205, 54, 26, 180, 94, 12, 122, 22,
-			226, 71, 185, 90, 161, 217, 134, 95, 15, 250, 180, 46, 166, 107,
-			108, 48, 43, 15, 63, 201, 10, 120, 54, 79, 98, 56, 138, 130,
-			239, 157, 80, 238, 120, 47, 76, 44, 68, 81, 137, 162, 105, 38,
-			98, 118, 151, 13, 245, 200, 249, 74, 80, 206, 236, 61, 55, 218,
-			51, 27, 11, 252, 158, 246, 89, 65, 73, 251, 202, 86, 19, 44,
-			143, 123, 181, 25, 71, 21, 124, 154, 100, 125, 110, 28, 203, 118,
-			39, 214, 161, 45, 67, 130, 213, 200, 23, 178, 129, 225, 82, 28,
-			9, 90, 75, 63, 92, 126, 155, 21, 212, 34, 14, 91, 71, 109,
-			107, 109, 173, 186, 246, 112, 52, 7, 68, 125, 107, 105, 169, 82,
-			175, 143, 18, 32, 96, 191, 217, 170, 85, 70, 45, 32, 96, 23,
-			130, 106, 244, 221, 191, 185, 207, 250, 120, 222, 206, 253, 142, 16,
-			246, 31, 210, 120, 200, 191, 203, 198, 67, 102, 27, 115, 98, 113,
-			225, 218, 155, 127, 231, 98, 34, 143, 152, 149, 199, 152, 200, 4,
-			113, 222, 22, 74, 75, 198, 129, 147, 47, 116, 248, 74, 132, 65,
-			16, 11, 152, 192, 169, 204, 72, 132, 66, 79, 108, 229, 23, 230,
-			49, 90, 146, 31, 96, 115, 204, 206, 99, 248, 96, 200, 26, 118,
-			166, 80, 41, 216, 0, 27, 122, 160, 144, 174, 15, 103, 126, 229,
-			242, 229, 85, 252, 96, 200, 234, 55, 148, 197, 233, 208, 224, 16,
-			91, 68, 24, 194, 233, 136, 53, 236, 92, 76, 97, 246, 220, 72,
-			236, 120, 190, 23, 237, 201, 166, 136, 186, 141, 134, 140, 162, 157,
-			110, 171, 117, 152, 224, 17, 108, 100, 240, 160, 223, 35, 131, 67,
-			236, 6, 226, 89, 156, 142, 89, 195, 206, 165, 87, 224, 117, 253,
-			99, 17, 65, 140, 177, 4, 17, 65, 6, 135, 216, 59, 136, 72,
-			57, 29, 183, 134, 157, 235, 189, 136, 219, 82, 250, 34, 106, 236,
-			201, 102, 183, 37, 155, 243, 98, 187, 139, 73, 116, 226, 80, 198,
-			66, 239, 155, 9, 58, 28, 17, 198, 19, 116, 106, 113, 58, 62,
-			56, 196, 86, 85, 60, 231, 84, 238, 58, 113, 222, 17, 218, 177,
-			0, 151, 202, 164, 200, 69, 162, 29, 132, 82, 104, 63, 4, 206,
-			122, 42, 186, 19, 119, 195, 36, 190, 163, 198, 52, 19, 223, 57,
-			85, 60, 201, 230, 153, 109, 231, 0, 251, 180, 117, 153, 58, 103,
-			5, 130, 234, 97, 7, 127, 16, 26, 107, 199, 69, 187, 229, 57,
-			91, 5, 73, 6, 216, 34, 43, 0, 5, 195, 123, 198, 30, 213,
-			113, 3, 93, 25, 48, 92, 177, 43, 125, 25, 186, 173, 12, 194,
-			48, 235, 83, 109, 8, 52, 26, 72, 105, 139, 211, 51, 195, 35,
-			172, 172, 49, 9, 167, 175, 217, 220, 89, 20, 101, 95, 116, 253,
-			61, 215, 111, 182, 100, 83, 200, 23, 13, 217, 193, 76, 138, 160,
-			209, 232, 134, 178, 41, 154, 221, 16, 78, 18, 201, 20, 207, 176,
-			32, 136, 49, 148, 210, 22, 167, 175, 141, 142, 177, 79, 52, 11,
-			139, 83, 97, 15, 59, 219, 71, 197, 14, 37, 172, 102, 152, 6,
-			232, 10, 229, 205, 10, 207, 223, 9, 93, 117, 161, 13, 181, 96,
-			31, 10, 124, 233, 199, 243, 56, 144, 174, 47, 100, 24, 6, 33,
-			75, 18, 21, 69, 29, 173, 60, 142, 100, 107, 39, 35, 18, 116,
-			75, 216, 253, 41, 13, 50, 12, 14, 177, 47, 136, 150, 137, 114,
-			122, 193, 126, 205, 249, 156, 28, 21, 170, 217, 149, 61, 2, 45,
-			119, 253, 93, 25, 248, 226, 177, 142, 52, 37, 206, 118, 73, 108,
-			226, 180, 220, 11, 186, 173, 166, 216, 150, 76, 229, 52, 122, 59,
-			194, 69, 161, 46, 69, 152, 54, 130, 145, 177, 166, 236, 52, 117,
-			51, 196, 77, 115, 55, 81, 252, 6, 110, 224, 34, 148, 13, 88,
-			136, 152, 78, 134, 108, 72, 157, 144, 25, 116, 99, 225, 197, 153,
-			222, 129, 237, 94, 176, 39, 83, 218, 226, 244, 194, 153, 41, 54,
-			171, 59, 103, 115, 58, 99, 115, 231, 116, 58, 59, 224, 136, 213,
-			112, 253, 134, 108, 181, 112, 14, 152, 150, 112, 108, 157, 201, 12,
-			29, 38, 47, 141, 142, 177, 67, 141, 148, 231, 116, 206, 30, 117,
-			62, 238, 209, 18, 128, 25, 53, 249, 120, 107, 134, 171, 169, 124,
-			177, 231, 118, 49, 229, 178, 151, 111, 50, 33, 153, 154, 144, 114,
-			95, 134, 34, 116, 253, 121, 84, 130, 34, 15, 244, 18, 149, 17,
-			13, 78, 188, 115, 25, 195, 205, 91, 156, 206, 13, 143, 164, 129,
-			210, 55, 172, 209, 76, 160, 244, 13, 235, 50, 53, 193, 208, 2,
-			20, 22, 50, 129, 210, 55, 250, 6, 50, 129, 210, 55, 134, 71,
-			216, 109, 115, 89, 88, 178, 184, 51, 15, 198, 31, 160, 189, 187,
-			45, 157, 128, 34, 154, 50, 106, 132, 222, 182, 9, 224, 166, 51,
-			43, 189, 129, 43, 89, 111, 140, 102, 110, 224, 74, 73, 112, 22,
-			102, 68, 169, 63, 123, 3, 87, 26, 29, 99, 123, 200, 211, 226,
-			116, 209, 122, 195, 249, 83, 81, 221, 201, 2, 171, 69, 192, 139,
-			196, 75, 199, 177, 249, 164, 26, 76, 67, 99, 127, 158, 236, 177,
-			61, 56, 250, 203, 166, 216, 147, 233, 242, 97, 129, 29, 44, 90,
-			9, 85, 224, 116, 113, 96, 204, 80, 132, 211, 69, 62, 99, 40,
-			202, 233, 226, 220, 101, 118, 5, 195, 199, 249, 155, 185, 63, 39,
-			196, 17, 226, 33, 172, 42, 94, 227, 232, 214, 3, 107, 105, 44,
-			51, 161, 226, 155, 197, 65, 118, 205, 132, 138, 111, 89, 220, 185,
-			128, 22, 208, 244, 162, 78, 203, 61, 236, 137, 125, 47, 153, 9,
-			221, 19, 38, 190, 101, 221, 28, 206, 132, 137, 77, 88, 69, 133,
-			137, 111, 105, 69, 170, 48, 241, 173, 209, 49, 246, 24, 120, 209,
-			28, 183, 239, 90, 203, 212, 249, 134, 208, 142, 187, 9, 109, 71,
-			217, 136, 182, 112, 183, 97, 254, 184, 66, 31, 12, 174, 192, 97,
-			65, 120, 254, 126, 208, 48, 17, 111, 4, 167, 192, 234, 46, 27,
-			99, 107, 172, 0, 20, 116, 229, 158, 125, 209, 249, 134, 138, 109,
-			28, 223, 120, 62, 179, 99, 187, 48, 169, 133, 27, 238, 98, 186,
-			181, 216, 151, 141, 24, 131, 71, 96, 191, 136, 103, 3, 224, 96,
-			74, 231, 57, 189, 55, 52, 158, 210, 132, 211, 123, 19, 34, 165,
-			41, 167, 247, 206, 95, 96, 87, 181, 60, 132, 211, 119, 236, 113,
-			71, 152, 88, 11, 102, 10, 28, 4, 225, 115, 149, 248, 29, 34,
-			63, 216, 61, 71, 52, 0, 6, 81, 236, 123, 23, 19, 68, 12,
-			163, 216, 169, 68, 24, 72, 25, 24, 78, 105, 202, 233, 59, 99,
-			156, 173, 106, 142, 22, 167, 75, 246, 5, 231, 158, 208, 231, 30,
-			236, 87, 38, 211, 47, 198, 89, 238, 198, 82, 221, 169, 227, 146,
-			21, 69, 151, 132, 76, 171, 103, 196, 177, 108, 110, 47, 217, 239,
-			164, 29, 6, 131, 92, 178, 79, 165, 52, 225, 116, 105, 242, 92,
-			74, 83, 78, 151, 166, 207, 179, 247, 205, 165, 192, 138, 117, 210,
-			249, 147, 175, 26, 14, 99, 100, 96, 164, 191, 207, 208, 164, 247,
-			5, 43, 214, 50, 205, 220, 23, 172, 40, 199, 64, 223, 23, 172,
-			176, 177, 204, 125, 193, 202, 196, 9, 118, 211, 220, 23, 84, 173,
-			9, 103, 174, 103, 68, 180, 159, 37, 84, 236, 34, 117, 9, 208,
-			99, 75, 175, 13, 170, 214, 202, 73, 115, 53, 80, 0, 156, 98,
-			230, 218, 160, 218, 63, 146, 185, 54, 168, 242, 113, 246, 231, 4,
-			57, 82, 78, 87, 173, 139, 206, 103, 98, 221, 44, 86, 89, 75,
-			87, 78, 201, 209, 5, 75, 109, 83, 58, 237, 190, 19, 116, 186,
-			106, 211, 85, 193, 56, 102, 182, 170, 56, 232, 92, 105, 201, 125,
-			217, 50, 186, 205, 120, 166, 145, 196, 192, 176, 118, 214, 147, 110,
-			80, 155, 219, 171, 86, 213, 220, 179, 208, 2, 8, 55, 106, 40,
-			194, 233, 234, 152, 48, 20, 8, 126, 254, 2, 174, 186, 132, 18,
-			110, 175, 91, 79, 168, 243, 134, 168, 119, 183, 175, 192, 96, 69,
-			194, 151, 184, 126, 161, 3, 238, 75, 55, 222, 235, 241, 123, 147,
-			73, 10, 195, 177, 206, 198, 216, 89, 52, 81, 82, 204, 113, 251,
-			155, 246, 123, 121, 103, 88, 109, 57, 137, 107, 172, 108, 136, 20,
-			97, 82, 125, 147, 141, 233, 73, 132, 235, 83, 189, 48, 161, 39,
-			145, 89, 206, 60, 52, 15, 207, 71, 107, 170, 247, 0, 224, 94,
-			82, 47, 100, 104, 194, 105, 125, 96, 36, 165, 41, 167, 117, 140,
-			244, 42, 6, 132, 211, 173, 194, 156, 190, 123, 203, 50, 16, 145,
-			236, 184, 33, 76, 150, 213, 96, 119, 57, 216, 21, 233, 161, 93,
-			168, 243, 108, 134, 43, 216, 224, 86, 129, 167, 52, 192, 142, 95,
-			72, 105, 202, 233, 214, 165, 89, 109, 135, 54, 167, 79, 173, 115,
-			206, 156, 208, 49, 134, 200, 188, 152, 241, 34, 237, 30, 69, 202,
-			145, 130, 185, 16, 236, 24, 101, 90, 54, 54, 76, 168, 2, 167,
-			79, 7, 184, 161, 8, 167, 79, 199, 29, 67, 81, 78, 159, 190,
-			118, 150, 125, 3, 249, 229, 57, 253, 150, 245, 58, 184, 140, 2,
-			14, 197, 42, 226, 171, 121, 93, 138, 68, 125, 115, 121, 125, 107,
-			83, 119, 10, 163, 190, 122, 197, 72, 44, 39, 111, 115, 251, 91,
-			214, 211, 115, 26, 61, 95, 0, 64, 179, 1, 192, 190, 255, 173,
-			145, 41, 67, 81, 78, 191, 117, 78, 104, 206, 5, 78, 63, 252,
-			106, 206, 149, 90, 237, 171, 56, 23, 108, 110, 127, 104, 125, 235,
-			117, 141, 94, 64, 64, 195, 185, 64, 56, 253, 48, 225, 92, 160,
-			156, 126, 120, 78, 176, 111, 35, 231, 62, 78, 183, 173, 89, 103,
-			67, 60, 217, 147, 126, 178, 200, 152, 83, 197, 203, 139, 77, 109,
-			101, 9, 83, 90, 181, 51, 161, 210, 141, 63, 16, 179, 91, 155,
-			75, 115, 12, 179, 126, 62, 13, 124, 153, 200, 213, 103, 115, 123,
-			219, 250, 208, 200, 213, 87, 0, 118, 230, 206, 178, 143, 112, 186,
-			61, 117, 222, 80, 148, 211, 237, 153, 75, 236, 3, 148, 171, 200,
-			169, 180, 102, 156, 199, 71, 228, 194, 136, 223, 31, 38, 213, 203,
-			66, 21, 109, 110, 75, 107, 123, 86, 51, 46, 22, 128, 151, 17,
-			170, 72, 56, 149, 83, 70, 224, 34, 229, 84, 94, 184, 200, 254,
-			30, 10, 213, 207, 169, 103, 77, 57, 190, 40, 135, 219, 94, 28,
-			186, 225, 33, 12, 151, 122, 17, 148, 120, 244, 34, 150, 47, 226,
-			146, 168, 184, 141, 61, 35, 15, 120, 47, 106, 178, 52, 2, 63,
-			194, 131, 198, 190, 196, 166, 243, 42, 95, 88, 59, 60, 224, 44,
-			235, 189, 94, 248, 242, 0, 177, 19, 171, 238, 183, 129, 123, 66,
-			229, 57, 245, 6, 204, 250, 221, 79, 56, 245, 184, 89, 120, 251,
-			41, 167, 222, 233, 51, 236, 191, 88, 184, 44, 89, 220, 254, 196,
-			122, 65, 157, 191, 178, 132, 137, 152, 37, 10, 4, 153, 18, 215,
-			229, 82, 36, 192, 79, 119, 91, 45, 97, 226, 162, 152, 235, 221,
-			12, 100, 164, 236, 17, 21, 203, 132, 155, 116, 127, 218, 84, 20,
-			93, 223, 139, 163, 233, 121, 113, 224, 53, 246, 48, 171, 187, 233,
-			69, 141, 80, 198, 82, 149, 128, 138, 96, 83, 23, 109, 233, 70,
-			120, 240, 218, 62, 100, 189, 158, 147, 154, 221, 208, 84, 191, 198,
-			74, 130, 117, 250, 6, 62, 253, 80, 98, 76, 148, 69, 228, 97,
-			222, 33, 232, 20, 15, 86, 176, 228, 236, 4, 161, 152, 238, 200,
-			176, 33, 253, 88, 101, 5, 168, 38, 211, 120, 38, 13, 112, 205,
-			255, 8, 227, 131, 31, 1, 121, 109, 97, 65, 141, 192, 71, 9,
-			246, 71, 230, 166, 39, 131, 130, 209, 175, 100, 177, 134, 197, 240,
-			19, 198, 89, 3, 215, 70, 188, 184, 142, 237, 9, 103, 19, 215,
-			70, 196, 206, 92, 52, 246, 42, 168, 4, 222, 49, 190, 224, 242,
-			119, 161, 87, 159, 202, 48, 128, 35, 96, 90, 205, 139, 88, 106,
-			222, 137, 155, 161, 46, 196, 99, 251, 147, 9, 189, 86, 170, 43,
-			241, 88, 31, 5, 145, 38, 156, 198, 108, 36, 165, 41, 167, 49,
-			31, 103, 255, 148, 104, 49, 9, 167, 7, 246, 164, 243, 143, 212,
-			209, 48, 149, 48, 85, 243, 81, 89, 213, 14, 219, 141, 98, 225,
-			182, 14, 220, 195, 8, 118, 218, 22, 148, 199, 123, 174, 47, 224,
-			184, 42, 63, 233, 186, 45, 80, 152, 214, 41, 246, 47, 80, 15,
-			29, 231, 133, 135, 239, 4, 96, 148, 60, 117, 28, 222, 150, 216,
-			227, 76, 183, 192, 73, 57, 176, 227, 180, 91, 224, 204, 29, 100,
-			186, 5, 91, 196, 1, 27, 79, 105, 202, 233, 193, 201, 83, 108,
-			22, 103, 36, 227, 244, 83, 235, 180, 115, 6, 187, 148, 53, 228,
-			196, 128, 205, 164, 103, 54, 183, 63, 181, 94, 24, 111, 136, 21,
-			160, 165, 153, 77, 140, 112, 250, 233, 192, 184, 161, 40, 167, 159,
-			158, 156, 100, 17, 178, 24, 224, 244, 51, 107, 204, 217, 65, 22,
-			157, 208, 107, 235, 105, 255, 28, 109, 13, 167, 69, 234, 252, 43,
-			149, 37, 215, 150, 176, 148, 131, 81, 99, 42, 137, 202, 173, 204,
-			28, 241, 83, 203, 199, 99, 98, 55, 74, 151, 168, 1, 155, 219,
-			159, 89, 159, 158, 214, 18, 13, 20, 64, 8, 147, 99, 50, 64,
-			56, 253, 76, 159, 252, 136, 53, 64, 57, 253, 108, 120, 148, 61,
-			71, 105, 7, 185, 253, 15, 137, 245, 186, 243, 109, 81, 110, 170,
-			247, 148, 110, 11, 5, 233, 9, 75, 244, 158, 88, 160, 103, 145,
-			84, 66, 108, 75, 17, 194, 34, 11, 51, 212, 109, 5, 254, 110,
-			228, 53, 165, 154, 168, 141, 151, 142, 56, 131, 54, 114, 75, 200,
-			2, 144, 3, 35, 134, 36, 64, 142, 158, 49, 36, 5, 242, 172,
-			96, 43, 184, 42, 81, 94, 248, 14, 177, 254, 49, 161, 206, 77,
-			97, 2, 241, 218, 99, 73, 151, 151, 231, 242, 240, 42, 206, 61,
-			49, 139, 55, 188, 115, 194, 92, 153, 24, 25, 40, 120, 93, 223,
-			33, 140, 179, 121, 52, 115, 106, 229, 184, 253, 93, 98, 79, 56,
-			83, 234, 124, 166, 199, 194, 180, 195, 143, 37, 198, 198, 208, 156,
-			48, 105, 162, 240, 93, 98, 127, 135, 76, 104, 147, 196, 196, 9,
-			64, 96, 233, 7, 2, 31, 6, 70, 210, 15, 20, 62, 240, 113,
-			246, 150, 230, 73, 184, 253, 103, 196, 62, 225, 92, 82, 43, 133,
-			97, 154, 28, 189, 19, 238, 102, 33, 49, 236, 137, 205, 11, 127,
-			70, 236, 239, 102, 216, 147, 60, 130, 165, 236, 9, 162, 15, 140,
-			166, 31, 40, 124, 24, 7, 7, 21, 134, 124, 136, 219, 255, 132,
-			88, 211, 90, 205, 67, 54, 146, 102, 76, 134, 10, 64, 14, 140,
-			27, 146, 0, 57, 49, 101, 72, 10, 228, 185, 215, 217, 135, 152,
-			190, 83, 248, 30, 201, 253, 37, 33, 206, 134, 40, 139, 85, 48,
-			94, 53, 30, 73, 55, 240, 86, 67, 63, 203, 197, 21, 92, 184,
-			120, 188, 111, 38, 94, 139, 235, 39, 193, 33, 150, 68, 80, 74,
-			140, 13, 168, 108, 31, 251, 123, 164, 56, 136, 249, 10, 176, 106,
-			218, 223, 39, 214, 184, 51, 215, 19, 164, 48, 103, 107, 195, 42,
-			76, 102, 18, 46, 26, 58, 53, 168, 240, 125, 98, 125, 143, 12,
-			155, 92, 161, 60, 66, 21, 13, 73, 128, 236, 79, 74, 41, 144,
-			99, 156, 125, 135, 232, 4, 33, 251, 7, 196, 58, 237, 236, 195,
-			82, 165, 253, 168, 228, 45, 114, 210, 103, 188, 179, 81, 57, 58,
-			190, 122, 85, 140, 133, 122, 238, 122, 17, 218, 17, 70, 122, 88,
-			26, 174, 72, 167, 142, 231, 171, 233, 6, 91, 146, 121, 65, 212,
-			211, 9, 24, 248, 31, 16, 235, 251, 100, 220, 164, 42, 229, 81,
-			46, 211, 9, 130, 98, 246, 155, 116, 40, 24, 242, 31, 144, 83,
-			147, 108, 24, 250, 80, 204, 241, 194, 15, 137, 245, 47, 9, 85,
-			197, 112, 28, 176, 127, 72, 138, 67, 236, 164, 206, 94, 178, 255,
-			25, 177, 199, 157, 162, 40, 139, 173, 218, 170, 158, 43, 152, 185,
-			4, 5, 9, 73, 128, 28, 48, 138, 178, 40, 144, 99, 156, 149,
-			152, 74, 103, 177, 255, 57, 177, 207, 59, 66, 204, 174, 251, 112,
-			20, 158, 3, 171, 80, 62, 190, 242, 68, 77, 143, 84, 115, 90,
-			192, 6, 163, 134, 36, 64, 142, 157, 53, 36, 194, 189, 62, 205,
-			22, 152, 78, 171, 249, 17, 177, 47, 58, 211, 25, 112, 95, 232,
-			235, 41, 161, 174, 110, 122, 225, 237, 2, 54, 25, 51, 36, 1,
-			146, 11, 67, 82, 32, 207, 95, 96, 119, 153, 74, 211, 177, 255,
-			5, 177, 79, 57, 87, 178, 178, 39, 54, 122, 52, 246, 169, 174,
-			206, 18, 78, 249, 2, 182, 78, 72, 2, 228, 0, 55, 36, 5,
-			242, 196, 73, 246, 38, 166, 120, 21, 126, 76, 114, 255, 150, 16,
-			231, 146, 200, 94, 140, 41, 103, 239, 88, 101, 13, 168, 252, 46,
-			251, 199, 164, 56, 193, 94, 232, 4, 47, 251, 39, 196, 154, 208,
-			209, 72, 85, 253, 82, 164, 181, 208, 187, 163, 162, 35, 172, 180,
-			163, 215, 24, 204, 55, 209, 159, 146, 227, 17, 123, 249, 20, 6,
-			245, 247, 130, 54, 56, 83, 190, 182, 66, 181, 250, 253, 132, 88,
-			63, 38, 39, 77, 14, 89, 30, 101, 41, 26, 146, 0, 217, 63,
-			98, 72, 10, 36, 31, 103, 13, 148, 155, 112, 251, 167, 32, 247,
-			22, 202, 221, 10, 118, 197, 6, 94, 243, 161, 200, 178, 221, 137,
-			15, 231, 245, 218, 11, 95, 123, 4, 214, 159, 92, 237, 87, 166,
-			210, 178, 244, 208, 168, 68, 132, 137, 242, 83, 98, 253, 132, 76,
-			104, 33, 96, 162, 252, 52, 21, 145, 160, 20, 137, 136, 48, 81,
-			126, 10, 34, 190, 134, 34, 90, 220, 254, 156, 88, 220, 25, 73,
-			68, 212, 235, 191, 2, 183, 108, 94, 248, 156, 88, 63, 77, 192,
-			193, 114, 62, 79, 193, 161, 139, 159, 147, 254, 33, 67, 82, 32,
-			71, 199, 88, 21, 243, 230, 10, 63, 39, 185, 95, 17, 226, 220,
-			21, 61, 23, 152, 122, 5, 169, 102, 140, 217, 107, 72, 109, 101,
-			73, 194, 159, 9, 210, 13, 168, 52, 58, 251, 231, 164, 120, 130,
-			29, 234, 60, 58, 251, 23, 160, 215, 231, 40, 116, 181, 103, 82,
-			244, 234, 54, 245, 53, 116, 58, 217, 145, 218, 134, 27, 198, 168,
-			15, 205, 70, 8, 114, 92, 138, 212, 229, 98, 35, 240, 119, 188,
-			93, 173, 16, 204, 218, 43, 252, 130, 88, 63, 39, 167, 76, 26,
-			95, 30, 133, 41, 26, 146, 0, 169, 181, 141, 153, 123, 246, 47,
-			64, 219, 179, 58, 117, 207, 254, 37, 104, 219, 65, 193, 205, 108,
-			214, 29, 223, 115, 163, 189, 132, 15, 140, 234, 47, 137, 245, 11,
-			98, 210, 5, 97, 84, 127, 153, 242, 33, 8, 165, 21, 143, 185,
-			125, 246, 47, 65, 241, 75, 152, 120, 86, 248, 53, 201, 253, 142,
-			16, 231, 77, 161, 46, 127, 213, 116, 59, 50, 171, 147, 27, 157,
-			99, 85, 14, 179, 250, 215, 164, 56, 204, 254, 1, 209, 153, 103,
-			246, 23, 160, 243, 16, 69, 63, 2, 245, 199, 168, 158, 165, 186,
-			255, 125, 84, 143, 185, 106, 133, 47, 136, 245, 107, 50, 170, 147,
-			197, 64, 245, 95, 24, 149, 96, 190, 154, 253, 133, 81, 61, 38,
-			172, 217, 95, 128, 234, 207, 233, 140, 53, 251, 55, 176, 155, 142,
-			161, 252, 42, 59, 45, 99, 234, 152, 122, 86, 248, 13, 177, 190,
-			208, 26, 199, 228, 51, 104, 98, 224, 9, 34, 232, 93, 19, 211,
-			207, 236, 223, 192, 102, 112, 30, 225, 45, 110, 127, 9, 234, 57,
-			129, 240, 250, 174, 92, 159, 33, 18, 22, 48, 155, 190, 36, 214,
-			111, 244, 158, 150, 199, 217, 244, 37, 177, 250, 12, 73, 128, 44,
-			154, 30, 192, 108, 250, 18, 122, 112, 17, 89, 80, 110, 255, 150,
-			88, 39, 157, 83, 200, 34, 29, 189, 35, 76, 168, 205, 11, 191,
-			37, 214, 151, 73, 63, 104, 30, 27, 26, 38, 176, 182, 254, 150,
-			20, 199, 12, 137, 176, 19, 39, 146, 60, 186, 95, 156, 102, 55,
-			255, 144, 103, 66, 158, 191, 19, 244, 164, 208, 253, 109, 178, 240,
-			166, 255, 151, 197, 70, 241, 133, 68, 213, 223, 9, 204, 75, 152,
-			123, 172, 104, 158, 15, 232, 244, 153, 115, 153, 55, 21, 153, 154,
-			234, 195, 131, 32, 126, 148, 171, 37, 77, 160, 121, 116, 224, 134,
-			109, 207, 223, 213, 233, 52, 175, 106, 94, 215, 213, 160, 185, 105,
-			194, 95, 103, 131, 218, 48, 159, 237, 121, 126, 60, 57, 128, 121,
-			18, 3, 250, 219, 35, 207, 143, 157, 79, 244, 11, 150, 7, 65,
-			204, 207, 177, 1, 149, 45, 254, 44, 147, 50, 195, 212, 167, 53,
-			183, 45, 1, 79, 167, 120, 170, 26, 42, 239, 98, 64, 127, 235,
-			169, 242, 76, 141, 173, 78, 193, 24, 48, 175, 139, 182, 101, 232,
-			44, 178, 162, 145, 22, 19, 63, 130, 40, 54, 233, 57, 240, 27,
-			147, 190, 220, 232, 121, 146, 101, 232, 70, 152, 166, 130, 16, 211,
-			127, 65, 244, 243, 36, 213, 121, 149, 153, 202, 39, 89, 159, 238,
-			148, 70, 50, 36, 63, 171, 115, 151, 172, 163, 185, 75, 58, 115,
-			233, 27, 199, 101, 46, 209, 87, 166, 193, 189, 148, 183, 180, 184,
-			196, 250, 19, 121, 248, 77, 70, 31, 202, 152, 159, 60, 126, 144,
-			122, 30, 232, 100, 229, 127, 247, 255, 142, 171, 199, 52, 43, 95,
-			249, 152, 230, 173, 191, 99, 137, 35, 203, 236, 191, 91, 234, 53,
-			205, 104, 142, 19, 231, 63, 91, 40, 127, 210, 251, 227, 223, 211,
-			100, 202, 3, 245, 199, 163, 92, 17, 248, 242, 74, 20, 7, 29,
-			112, 199, 59, 232, 185, 119, 35, 40, 137, 3, 157, 142, 251, 216,
-			107, 5, 201, 173, 153, 217, 19, 152, 74, 29, 158, 23, 161, 220,
-			145, 161, 244, 27, 158, 191, 43, 188, 88, 135, 16, 119, 220, 182,
-			215, 242, 220, 80, 196, 18, 255, 206, 147, 139, 175, 41, 27, 210,
-			219, 199, 119, 255, 129, 239, 53, 220, 150, 78, 143, 111, 186, 177,
-			203, 196, 108, 59, 136, 226, 214, 225, 49, 193, 112, 147, 192, 24,
-			205, 33, 76, 91, 198, 46, 62, 239, 16, 213, 88, 184, 141, 56,
-			210, 161, 76, 233, 199, 225, 161, 250, 83, 19, 12, 123, 161, 21,
-			160, 131, 223, 7, 94, 180, 7, 93, 106, 73, 55, 52, 119, 128,
-			58, 249, 57, 8, 197, 190, 39, 15, 64, 239, 94, 8, 190, 78,
-			148, 121, 121, 52, 90, 28, 75, 95, 30, 141, 89, 183, 50, 47,
-			143, 198, 10, 253, 153, 151, 71, 99, 204, 201, 188, 60, 26, 187,
-			120, 147, 49, 149, 59, 50, 145, 187, 77, 146, 204, 143, 137, 226,
-			36, 62, 106, 201, 209, 28, 183, 79, 90, 175, 81, 231, 234, 75,
-			207, 7, 178, 207, 131, 92, 97, 22, 16, 148, 214, 100, 104, 231,
-			240, 118, 242, 36, 227, 236, 117, 86, 0, 10, 164, 155, 180, 207,
-			56, 28, 209, 244, 43, 152, 100, 31, 235, 83, 85, 108, 110, 79,
-			218, 39, 85, 200, 40, 167, 111, 28, 39, 245, 253, 95, 78, 223,
-			56, 78, 14, 156, 76, 105, 202, 233, 228, 105, 135, 205, 105, 30,
-			132, 83, 199, 158, 210, 94, 138, 94, 151, 148, 164, 122, 163, 79,
-			121, 17, 155, 219, 142, 61, 121, 38, 193, 34, 121, 104, 156, 242,
-			34, 0, 54, 112, 42, 165, 41, 167, 142, 115, 134, 77, 107, 94,
-			22, 167, 83, 246, 25, 103, 60, 229, 149, 221, 208, 84, 27, 56,
-			13, 77, 217, 206, 84, 2, 98, 229, 161, 85, 127, 74, 19, 78,
-			167, 88, 218, 33, 139, 114, 58, 165, 19, 255, 115, 176, 225, 9,
-			235, 50, 213, 247, 117, 199, 188, 211, 114, 133, 89, 74, 5, 172,
-			147, 137, 238, 65, 118, 193, 56, 251, 143, 152, 60, 162, 110, 145,
-			102, 236, 9, 231, 223, 19, 241, 40, 136, 98, 227, 223, 192, 122,
-			155, 189, 234, 78, 192, 204, 169, 35, 0, 102, 62, 248, 120, 113,
-			192, 196, 172, 44, 237, 150, 230, 197, 180, 217, 96, 74, 82, 189,
-			15, 135, 13, 115, 26, 255, 86, 133, 137, 145, 153, 67, 127, 246,
-			136, 3, 86, 172, 125, 44, 152, 175, 151, 162, 196, 189, 58, 194,
-			150, 37, 241, 170, 110, 26, 40, 197, 78, 216, 220, 158, 177, 133,
-			49, 15, 117, 243, 62, 147, 12, 153, 186, 234, 154, 209, 87, 93,
-			57, 125, 213, 53, 131, 62, 172, 210, 2, 230, 103, 76, 232, 44,
-			147, 30, 205, 245, 90, 162, 186, 97, 157, 179, 103, 82, 86, 4,
-			243, 74, 82, 86, 160, 225, 185, 12, 43, 176, 142, 57, 62, 142,
-			129, 155, 92, 49, 199, 237, 121, 235, 154, 206, 238, 40, 170, 23,
-			58, 67, 232, 74, 227, 60, 40, 217, 142, 115, 70, 232, 109, 225,
-			232, 12, 106, 38, 217, 16, 185, 2, 84, 29, 204, 100, 133, 148,
-			134, 78, 100, 178, 66, 74, 147, 167, 53, 38, 225, 116, 225, 8,
-			102, 210, 189, 94, 76, 82, 128, 170, 131, 153, 172, 143, 133, 4,
-			19, 250, 176, 48, 121, 154, 253, 204, 50, 105, 31, 183, 172, 51,
-			206, 143, 240, 114, 65, 57, 250, 248, 87, 114, 240, 0, 12, 187,
-			144, 222, 100, 69, 212, 221, 221, 149, 81, 18, 164, 199, 109, 71,
-			113, 21, 245, 160, 109, 158, 112, 204, 227, 159, 169, 115, 183, 91,
-			135, 34, 192, 121, 25, 248, 50, 154, 199, 107, 222, 236, 237, 136,
-			244, 131, 238, 238, 94, 178, 136, 170, 119, 60, 81, 208, 218, 151,
-			106, 249, 99, 134, 109, 73, 212, 210, 191, 158, 3, 40, 152, 146,
-			103, 238, 137, 225, 76, 172, 165, 77, 254, 84, 156, 74, 184, 8,
-			67, 21, 18, 80, 198, 186, 227, 201, 86, 51, 107, 178, 106, 43,
-			240, 226, 72, 253, 105, 192, 8, 111, 59, 130, 110, 232, 238, 226,
-			166, 137, 65, 114, 95, 54, 100, 20, 185, 225, 97, 146, 91, 99,
-			97, 74, 136, 25, 113, 156, 230, 183, 146, 220, 26, 11, 83, 66,
-			78, 100, 50, 87, 110, 77, 58, 248, 16, 147, 112, 251, 237, 220,
-			202, 31, 251, 16, 147, 224, 11, 155, 211, 24, 25, 33, 234, 133,
-			205, 9, 253, 216, 171, 103, 124, 210, 187, 85, 181, 76, 109, 75,
-			12, 255, 138, 56, 232, 73, 105, 185, 111, 189, 125, 38, 147, 210,
-			114, 191, 39, 165, 229, 126, 255, 104, 38, 165, 229, 254, 248, 4,
-			30, 31, 112, 74, 149, 173, 19, 206, 73, 189, 170, 123, 250, 117,
-			146, 185, 3, 79, 243, 21, 202, 214, 253, 19, 153, 124, 133, 178,
-			53, 144, 201, 87, 40, 15, 142, 102, 242, 21, 202, 227, 19, 236,
-			223, 16, 147, 176, 80, 177, 46, 59, 63, 84, 55, 27, 175, 186,
-			135, 126, 201, 236, 160, 178, 138, 88, 36, 107, 73, 146, 71, 160,
-			110, 133, 180, 75, 211, 213, 55, 141, 153, 96, 134, 138, 12, 30,
-			138, 174, 127, 69, 125, 145, 205, 35, 17, 32, 95, 76, 67, 247,
-			166, 123, 210, 35, 42, 86, 249, 68, 38, 61, 162, 98, 141, 103,
-			210, 35, 42, 19, 23, 51, 233, 17, 149, 217, 57, 115, 104, 249,
-			255, 1, 0, 0, 255, 255, 14, 248, 148, 97, 55, 84, 0, 0,
-		},
+			8, 0, 0, 0, 0, 0, 0, 255, 212, 124, 109, 108, 28, 201,
+			149, 216, 84, 87, 207, 112, 88, 252, 46, 82, 18, 213, 162, 86,
+			181, 35, 81, 156, 217, 29, 14, 63, 244, 177, 250, 216, 149, 151,
+			162, 40, 137, 107, 138, 18, 134, 212, 238, 90, 235, 141, 212, 156,
+			169, 153, 105, 171, 167, 123, 182, 187, 135, 20, 119, 35, 95, 130,
+			92, 112, 184, 92, 114, 8, 144, 92, 130, 195, 29, 14, 206, 225,
+			18, 36, 14, 46, 48, 96, 56, 129, 17, 195, 9, 238, 96, 192,
+			128, 17, 223, 191, 32, 200, 159, 252, 72, 126, 231, 247, 5, 200,
+			143, 224, 189, 170, 234, 153, 161, 40, 173, 237, 252, 186, 133, 238,
+			60, 175, 186, 234, 125, 213, 171, 87, 175, 94, 189, 34, 251, 113,
+			137, 157, 107, 134, 97, 211, 151, 75, 157, 40, 76, 194, 189, 110,
+			99, 41, 241, 218, 50, 78, 220, 118, 167, 130, 77, 124, 66, 117,
+			168, 152, 14, 133, 155, 108, 120, 215, 244, 225, 179, 108, 40, 150,
+			181, 48, 168, 199, 179, 68, 144, 34, 173, 26, 144, 207, 176, 108,
+			224, 6, 97, 60, 107, 9, 82, 204, 86, 21, 112, 251, 239, 19,
+			54, 93, 11, 219, 149, 35, 72, 111, 143, 167, 40, 31, 65, 211,
+			35, 242, 100, 85, 119, 105, 134, 190, 27, 52, 43, 97, 212, 236,
+			227, 241, 176, 35, 227, 165, 231, 65, 120, 16, 244, 248, 237, 236,
+			253, 53, 33, 127, 106, 209, 123, 143, 110, 127, 223, 122, 235, 158,
+			26, 253, 72, 15, 169, 124, 34, 125, 255, 155, 48, 96, 23, 198,
+			126, 244, 31, 22, 88, 142, 219, 227, 153, 223, 34, 236, 151, 54,
+			35, 163, 156, 142, 103, 248, 234, 95, 218, 98, 61, 236, 28, 70,
+			94, 179, 149, 136, 213, 229, 213, 229, 197, 213, 229, 213, 203, 226,
+			118, 183, 33, 118, 101, 173, 21, 132, 126, 216, 244, 100, 92, 22,
+			155, 65, 173, 194, 152, 216, 242, 106, 50, 136, 101, 93, 116, 131,
+			186, 140, 68, 210, 146, 98, 173, 227, 214, 90, 210, 124, 41, 139,
+			143, 101, 20, 123, 97, 32, 86, 43, 203, 162, 8, 29, 10, 250,
+			83, 161, 116, 147, 137, 195, 176, 43, 218, 238, 161, 8, 194, 68,
+			116, 99, 41, 146, 150, 23, 139, 134, 231, 75, 33, 95, 212, 100,
+			39, 17, 94, 32, 106, 97, 187, 227, 123, 110, 80, 147, 226, 192,
+			75, 90, 34, 233, 161, 175, 48, 241, 45, 141, 33, 220, 75, 92,
+			47, 16, 174, 168, 133, 157, 67, 17, 54, 250, 187, 9, 55, 97,
+			76, 224, 127, 173, 36, 233, 220, 88, 90, 58, 56, 56, 168, 184,
+			200, 41, 42, 214, 87, 253, 226, 165, 173, 205, 245, 141, 237, 157,
+			141, 197, 213, 202, 50, 99, 226, 113, 224, 203, 56, 22, 145, 252,
+			162, 235, 69, 178, 46, 246, 14, 133, 219, 233, 248, 94, 205, 221,
+			243, 165, 240, 221, 3, 17, 70, 194, 109, 70, 82, 214, 69, 18,
+			2, 175, 7, 145, 151, 120, 65, 179, 44, 226, 176, 145, 28, 184,
+			145, 100, 162, 238, 197, 73, 228, 237, 117, 147, 1, 53, 25, 206,
+			188, 120, 160, 67, 24, 8, 55, 16, 133, 181, 29, 177, 185, 83,
+			16, 183, 215, 118, 54, 119, 202, 76, 124, 178, 185, 123, 255, 225,
+			227, 93, 241, 201, 90, 181, 186, 182, 189, 187, 185, 177, 35, 30,
+			86, 197, 250, 195, 237, 59, 155, 187, 155, 15, 183, 119, 196, 195,
+			187, 98, 109, 251, 91, 226, 155, 155, 219, 119, 202, 66, 122, 73,
+			75, 70, 66, 190, 232, 68, 192, 125, 24, 9, 15, 20, 40, 235,
+			21, 38, 118, 164, 28, 32, 223, 8, 213, 172, 197, 29, 89, 243,
+			26, 94, 77, 128, 173, 117, 221, 166, 20, 205, 112, 95, 70, 129,
+			23, 52, 69, 71, 70, 109, 47, 134, 73, 140, 133, 27, 212, 153,
+			240, 189, 182, 151, 184, 9, 54, 188, 34, 81, 133, 177, 60, 35,
+			22, 167, 147, 153, 89, 248, 149, 231, 148, 103, 206, 177, 97, 102,
+			229, 207, 169, 159, 170, 113, 58, 179, 137, 141, 35, 234, 167, 106,
+			156, 201, 148, 177, 145, 168, 159, 170, 241, 68, 102, 9, 27, 245,
+			79, 213, 120, 50, 83, 192, 70, 166, 126, 170, 198, 83, 153, 183,
+			177, 241, 130, 250, 169, 26, 103, 51, 55, 177, 113, 94, 253, 252,
+			111, 103, 153, 101, 103, 184, 157, 100, 126, 139, 56, 191, 56, 43,
+			214, 68, 186, 242, 68, 36, 65, 101, 50, 72, 98, 225, 138, 78,
+			232, 5, 104, 127, 176, 192, 132, 23, 212, 101, 71, 6, 117, 25,
+			36, 96, 92, 110, 112, 168, 218, 191, 12, 3, 41, 194, 72, 248,
+			97, 205, 245, 153, 168, 185, 190, 12, 234, 110, 84, 22, 50, 168,
+			133, 117, 89, 23, 46, 224, 170, 133, 93, 53, 78, 59, 7, 208,
+			163, 104, 68, 110, 77, 41, 177, 255, 67, 194, 4, 122, 10, 132,
+			69, 36, 227, 208, 239, 66, 175, 138, 216, 109, 73, 141, 200, 3,
+			155, 244, 221, 196, 219, 151, 96, 119, 110, 32, 100, 39, 172, 181,
+			132, 155, 136, 199, 187, 235, 162, 237, 213, 3, 92, 193, 97, 192,
+			196, 71, 110, 208, 117, 163, 67, 177, 82, 22, 43, 215, 223, 91,
+			46, 163, 68, 45, 41, 58, 81, 232, 203, 78, 226, 213, 196, 189,
+			72, 54, 195, 200, 115, 131, 148, 123, 113, 208, 242, 106, 45, 33,
+			95, 36, 18, 152, 77, 90, 146, 29, 215, 107, 207, 173, 61, 63,
+			112, 35, 232, 17, 138, 67, 233, 70, 34, 12, 36, 184, 133, 53,
+			223, 23, 109, 47, 232, 38, 50, 22, 110, 36, 197, 213, 229, 84,
+			62, 63, 12, 154, 21, 177, 37, 221, 78, 79, 228, 72, 138, 66,
+			220, 150, 110, 36, 235, 5, 17, 135, 34, 105, 185, 137, 8, 66,
+			225, 75, 183, 195, 116, 55, 145, 224, 154, 243, 98, 17, 72, 9,
+			122, 5, 203, 245, 130, 68, 70, 157, 72, 42, 99, 44, 139, 110,
+			12, 246, 234, 138, 207, 86, 47, 47, 182, 194, 110, 36, 124, 47,
+			144, 110, 196, 4, 98, 255, 188, 8, 139, 63, 190, 177, 180, 84,
+			151, 251, 210, 15, 59, 50, 138, 141, 31, 174, 133, 109, 116, 164,
+			75, 216, 179, 4, 66, 128, 186, 35, 55, 104, 226, 26, 109, 68,
+			97, 91, 44, 47, 47, 175, 44, 226, 191, 221, 229, 229, 27, 248,
+			239, 9, 136, 126, 253, 250, 245, 235, 139, 43, 171, 139, 151, 86,
+			118, 87, 47, 221, 184, 114, 253, 198, 149, 235, 149, 235, 230, 191,
+			39, 21, 113, 251, 144, 193, 68, 38, 145, 87, 3, 231, 0, 67,
+			80, 68, 196, 94, 22, 7, 82, 200, 32, 238, 70, 176, 50, 221,
+			4, 192, 26, 104, 57, 12, 246, 101, 148, 64, 103, 101, 44, 97,
+			91, 124, 86, 189, 187, 206, 196, 165, 75, 151, 174, 247, 100, 1,
+			79, 230, 201, 164, 129, 126, 44, 106, 212, 150, 162, 70, 13, 122,
+			84, 146, 23, 73, 73, 212, 221, 68, 10, 240, 63, 65, 51, 6,
+			161, 206, 139, 141, 23, 110, 187, 227, 203, 152, 49, 243, 83, 172,
+			220, 16, 235, 97, 187, 211, 77, 100, 223, 90, 64, 130, 143, 30,
+			238, 108, 126, 42, 158, 129, 102, 138, 165, 103, 21, 237, 68, 123,
+			157, 210, 189, 231, 166, 250, 146, 194, 149, 88, 38, 79, 245, 4,
+			23, 161, 181, 184, 253, 120, 107, 171, 84, 58, 182, 31, 218, 123,
+			113, 185, 116, 179, 143, 167, 213, 175, 227, 169, 41, 19, 192, 18,
+			54, 234, 238, 97, 31, 111, 113, 18, 117, 107, 9, 174, 205, 125,
+			215, 23, 201, 190, 166, 56, 208, 253, 98, 178, 95, 22, 200, 208,
+			205, 223, 84, 164, 253, 74, 178, 15, 2, 190, 73, 34, 213, 169,
+			27, 203, 154, 120, 71, 172, 44, 47, 15, 74, 120, 233, 181, 18,
+			126, 226, 5, 151, 86, 197, 179, 123, 50, 217, 57, 140, 19, 217,
+			134, 207, 107, 241, 93, 207, 151, 187, 131, 19, 113, 119, 115, 107,
+			99, 119, 243, 193, 134, 104, 36, 154, 141, 215, 141, 185, 216, 72,
+			12, 167, 143, 55, 183, 119, 175, 94, 22, 137, 87, 123, 30, 139,
+			15, 68, 177, 88, 84, 45, 165, 70, 82, 169, 31, 220, 247, 154,
+			173, 59, 110, 130, 163, 74, 226, 253, 247, 197, 165, 213, 146, 248,
+			219, 2, 191, 109, 133, 7, 230, 147, 209, 219, 210, 146, 88, 19,
+			159, 120, 65, 61, 60, 136, 17, 37, 44, 150, 149, 229, 229, 62,
+			31, 22, 87, 210, 14, 202, 75, 173, 92, 125, 117, 25, 165, 216,
+			96, 248, 202, 213, 203, 151, 47, 191, 119, 233, 234, 114, 207, 109,
+			236, 201, 70, 24, 73, 241, 56, 240, 94, 104, 95, 7, 206, 236,
+			40, 150, 202, 111, 54, 153, 69, 37, 191, 40, 22, 65, 130, 88,
+			44, 225, 100, 193, 191, 146, 88, 236, 103, 231, 107, 44, 24, 240,
+			92, 90, 237, 225, 153, 239, 195, 131, 6, 80, 26, 48, 128, 203,
+			175, 53, 128, 143, 220, 125, 87, 60, 83, 147, 95, 169, 117, 163,
+			72, 6, 9, 116, 121, 224, 249, 190, 23, 247, 25, 0, 120, 83,
+			209, 198, 86, 241, 129, 120, 253, 128, 55, 152, 185, 248, 160, 215,
+			90, 9, 228, 193, 237, 174, 231, 215, 101, 84, 44, 129, 96, 59,
+			90, 67, 154, 132, 82, 76, 73, 135, 82, 66, 8, 232, 179, 141,
+			182, 94, 244, 130, 4, 36, 215, 61, 149, 232, 90, 108, 80, 65,
+			169, 84, 217, 3, 204, 197, 1, 21, 92, 249, 26, 21, 108, 6,
+			113, 226, 6, 73, 37, 8, 15, 250, 164, 214, 173, 34, 8, 15,
+			196, 7, 98, 160, 207, 27, 5, 237, 241, 253, 245, 18, 7, 225,
+			65, 165, 41, 147, 13, 176, 53, 213, 86, 44, 245, 9, 62, 40,
+			188, 238, 12, 64, 241, 120, 65, 175, 190, 86, 80, 61, 91, 38,
+			202, 16, 143, 14, 147, 86, 24, 24, 81, 143, 157, 166, 98, 233,
+			200, 199, 202, 61, 153, 172, 247, 102, 189, 88, 66, 79, 255, 209,
+			206, 195, 109, 241, 192, 237, 116, 188, 160, 201, 152, 216, 12, 84,
+			75, 35, 140, 218, 110, 82, 198, 176, 175, 199, 75, 114, 216, 193,
+			141, 110, 32, 108, 81, 27, 135, 142, 24, 24, 110, 63, 191, 214,
+			238, 163, 72, 65, 228, 226, 38, 194, 139, 145, 38, 211, 173, 64,
+			172, 240, 21, 68, 13, 47, 23, 191, 106, 135, 65, 210, 122, 185,
+			248, 85, 221, 61, 124, 185, 251, 21, 108, 221, 47, 111, 124, 213,
+			246, 130, 151, 55, 190, 138, 101, 237, 229, 103, 149, 175, 32, 88,
+			2, 127, 251, 242, 243, 39, 5, 38, 14, 90, 50, 146, 66, 141,
+			6, 68, 174, 127, 224, 30, 198, 38, 228, 133, 64, 27, 35, 129,
+			6, 196, 0, 117, 175, 233, 37, 49, 132, 52, 190, 20, 154, 82,
+			89, 32, 169, 50, 19, 138, 88, 89, 32, 181, 50, 198, 101, 72,
+			18, 163, 146, 47, 101, 20, 46, 118, 220, 58, 40, 4, 54, 237,
+			131, 208, 96, 147, 110, 173, 5, 114, 201, 52, 138, 131, 232, 79,
+			59, 148, 178, 142, 159, 106, 110, 32, 154, 161, 232, 118, 96, 19,
+			191, 110, 134, 22, 189, 138, 172, 232, 198, 149, 227, 99, 189, 82,
+			153, 33, 253, 176, 3, 144, 235, 43, 74, 133, 39, 5, 17, 119,
+			27, 13, 239, 5, 68, 163, 94, 205, 133, 240, 10, 102, 17, 140,
+			4, 227, 208, 98, 225, 241, 238, 122, 161, 116, 115, 160, 149, 9,
+			175, 119, 132, 169, 136, 53, 136, 252, 146, 240, 146, 50, 134, 88,
+			70, 158, 235, 123, 95, 202, 72, 196, 173, 176, 235, 215, 141, 42,
+			225, 48, 246, 120, 119, 93, 20, 221, 56, 165, 6, 7, 32, 38,
+			10, 79, 10, 37, 152, 128, 64, 116, 34, 47, 80, 1, 205, 171,
+			166, 4, 138, 116, 7, 72, 117, 220, 40, 238, 145, 217, 147, 76,
+			96, 68, 7, 241, 77, 13, 143, 122, 123, 97, 210, 194, 248, 21,
+			198, 134, 120, 134, 49, 50, 196, 175, 240, 1, 199, 164, 176, 209,
+			136, 101, 130, 193, 218, 221, 16, 14, 60, 184, 214, 202, 162, 176,
+			186, 188, 242, 222, 226, 242, 202, 226, 202, 149, 221, 229, 149, 27,
+			151, 150, 111, 172, 92, 169, 44, 175, 60, 41, 232, 160, 60, 22,
+			8, 167, 155, 75, 199, 141, 19, 38, 176, 39, 210, 15, 131, 94,
+			212, 124, 165, 44, 0, 91, 69, 47, 32, 119, 223, 221, 169, 69,
+			94, 39, 41, 67, 172, 59, 16, 168, 185, 2, 54, 71, 17, 238,
+			125, 71, 66, 0, 18, 234, 179, 172, 50, 118, 21, 153, 162, 249,
+			131, 183, 170, 187, 81, 157, 137, 207, 146, 112, 115, 231, 225, 14,
+			46, 178, 98, 233, 152, 240, 180, 210, 14, 191, 244, 124, 223, 197,
+			216, 78, 6, 139, 143, 119, 150, 234, 97, 45, 94, 250, 68, 238,
+			45, 245, 88, 89, 170, 202, 134, 140, 100, 80, 147, 75, 247, 252,
+			112, 207, 245, 159, 62, 68, 30, 226, 37, 96, 104, 169, 143, 72,
+			137, 137, 182, 76, 90, 97, 189, 2, 222, 64, 121, 154, 178, 112,
+			83, 150, 196, 51, 136, 23, 65, 233, 21, 243, 227, 153, 17, 8,
+			68, 221, 147, 70, 90, 89, 103, 199, 138, 200, 196, 103, 207, 226,
+			36, 106, 224, 208, 62, 137, 194, 90, 92, 233, 32, 61, 148, 101,
+			117, 201, 247, 246, 34, 55, 58, 196, 160, 187, 210, 74, 218, 254,
+			121, 252, 101, 198, 150, 240, 168, 207, 82, 67, 54, 68, 224, 156,
+			42, 22, 230, 191, 181, 56, 223, 94, 156, 175, 239, 206, 223, 191,
+			49, 255, 224, 198, 252, 78, 101, 190, 241, 100, 161, 34, 182, 188,
+			231, 242, 192, 131, 172, 131, 7, 83, 184, 239, 246, 102, 169, 27,
+			75, 133, 237, 163, 176, 238, 162, 177, 46, 196, 226, 179, 103, 155,
+			59, 15, 77, 72, 115, 23, 41, 160, 224, 58, 204, 250, 188, 200,
+			76, 190, 224, 59, 97, 221, 93, 4, 198, 42, 113, 216, 141, 106,
+			16, 141, 52, 101, 37, 144, 201, 146, 219, 241, 112, 78, 64, 44,
+			232, 133, 18, 45, 41, 118, 151, 94, 69, 143, 162, 246, 104, 48,
+			81, 2, 61, 166, 201, 11, 53, 46, 145, 145, 168, 185, 29, 92,
+			31, 97, 67, 52, 101, 32, 35, 87, 173, 52, 179, 202, 96, 85,
+			246, 171, 191, 194, 224, 63, 106, 103, 8, 167, 73, 126, 138, 253,
+			49, 97, 182, 157, 177, 50, 156, 190, 176, 102, 156, 223, 39, 162,
+			218, 59, 219, 26, 187, 15, 27, 104, 238, 192, 176, 136, 189, 160,
+			214, 31, 95, 177, 227, 3, 44, 241, 160, 27, 39, 98, 79, 190,
+			241, 64, 196, 142, 59, 17, 61, 17, 94, 80, 243, 187, 177, 183,
+			15, 71, 196, 81, 150, 5, 238, 178, 192, 222, 144, 129, 8, 167,
+			47, 242, 19, 6, 162, 156, 190, 224, 211, 236, 127, 41, 65, 8,
+			167, 223, 181, 184, 243, 95, 137, 216, 14, 131, 197, 64, 54, 213,
+			233, 215, 120, 95, 20, 198, 213, 146, 193, 57, 248, 88, 191, 90,
+			17, 219, 122, 96, 122, 172, 220, 119, 253, 174, 140, 209, 218, 250,
+			144, 181, 65, 202, 56, 241, 124, 95, 180, 220, 125, 41, 130, 126,
+			154, 136, 90, 15, 4, 155, 114, 19, 125, 44, 111, 132, 17, 28,
+			135, 77, 206, 224, 168, 178, 244, 81, 177, 172, 255, 143, 29, 163,
+			16, 146, 5, 49, 141, 66, 8, 8, 157, 31, 51, 16, 229, 244,
+			187, 147, 83, 123, 57, 229, 84, 217, 63, 152, 101, 87, 154, 94,
+			210, 234, 238, 225, 233, 213, 239, 214, 60, 252, 127, 139, 205, 112,
+			169, 237, 249, 33, 88, 166, 202, 24, 46, 97, 228, 181, 23, 38,
+			58, 163, 105, 195, 103, 231, 235, 18, 159, 133, 243, 108, 236, 129,
+			27, 39, 50, 170, 202, 47, 186, 50, 78, 56, 103, 118, 224, 182,
+			37, 38, 58, 135, 171, 248, 187, 240, 93, 54, 3, 129, 141, 218,
+			118, 85, 119, 240, 246, 220, 97, 121, 60, 157, 7, 174, 143, 253,
+			243, 213, 20, 230, 87, 89, 190, 29, 214, 189, 134, 39, 235, 152,
+			28, 29, 89, 117, 142, 230, 67, 43, 233, 118, 82, 77, 251, 2,
+			253, 186, 155, 184, 179, 84, 144, 226, 104, 21, 127, 23, 36, 155,
+			185, 173, 165, 195, 255, 53, 188, 158, 100, 185, 54, 114, 163, 185,
+			213, 16, 228, 107, 81, 27, 50, 66, 210, 195, 85, 3, 242, 51,
+			108, 24, 127, 62, 13, 186, 109, 36, 65, 171, 121, 108, 216, 238,
+			182, 11, 11, 108, 106, 128, 12, 202, 104, 248, 33, 125, 252, 124,
+			143, 176, 19, 3, 61, 227, 223, 156, 163, 25, 150, 197, 84, 27,
+			114, 147, 173, 42, 128, 47, 176, 9, 52, 156, 186, 124, 170, 99,
+			199, 89, 27, 21, 60, 174, 155, 117, 36, 200, 79, 178, 92, 173,
+			27, 197, 97, 52, 155, 69, 188, 26, 42, 124, 206, 248, 32, 135,
+			40, 204, 18, 203, 33, 93, 200, 99, 211, 226, 200, 234, 169, 10,
+			88, 73, 101, 160, 39, 116, 172, 234, 110, 125, 232, 173, 126, 244,
+			171, 255, 135, 176, 188, 25, 197, 183, 216, 41, 136, 77, 143, 179,
+			144, 105, 69, 96, 192, 196, 28, 71, 53, 30, 55, 160, 144, 225,
+			15, 216, 204, 61, 153, 188, 194, 18, 215, 163, 6, 62, 24, 140,
+			175, 147, 163, 144, 225, 143, 216, 137, 163, 232, 98, 248, 196, 207,
+			28, 51, 198, 204, 163, 51, 123, 220, 71, 24, 86, 200, 124, 244,
+			191, 39, 33, 171, 110, 103, 214, 8, 251, 17, 193, 172, 186, 157,
+			225, 171, 223, 39, 3, 89, 245, 149, 171, 24, 206, 109, 61, 94,
+			223, 20, 107, 221, 164, 21, 70, 113, 5, 83, 102, 152, 118, 135,
+			88, 45, 150, 209, 62, 166, 107, 31, 199, 18, 28, 27, 250, 121,
+			181, 241, 8, 8, 207, 33, 162, 83, 249, 217, 95, 53, 245, 110,
+			252, 148, 218, 196, 27, 97, 55, 168, 155, 68, 160, 78, 121, 99,
+			214, 61, 77, 222, 230, 50, 99, 144, 52, 165, 25, 78, 243, 153,
+			18, 171, 48, 43, 151, 225, 246, 72, 102, 130, 56, 5, 228, 222,
+			184, 20, 1, 188, 122, 53, 41, 234, 178, 225, 5, 30, 248, 98,
+			220, 138, 104, 14, 118, 162, 145, 252, 36, 27, 97, 118, 14, 55,
+			162, 81, 107, 27, 92, 25, 0, 132, 211, 209, 220, 89, 3, 89,
+			156, 142, 190, 245, 174, 129, 40, 167, 163, 87, 63, 210, 195, 8,
+			167, 99, 214, 67, 253, 9, 252, 225, 88, 206, 49, 144, 197, 233,
+			216, 153, 37, 3, 81, 78, 199, 110, 124, 83, 15, 179, 56, 29,
+			183, 170, 250, 147, 69, 56, 29, 207, 157, 49, 16, 124, 155, 91,
+			49, 16, 229, 116, 252, 253, 109, 246, 190, 74, 10, 243, 204, 12,
+			113, 150, 81, 64, 136, 152, 101, 12, 174, 61, 128, 189, 217, 132,
+			185, 224, 244, 204, 237, 130, 114, 41, 149, 222, 198, 203, 243, 39,
+			128, 1, 216, 189, 56, 157, 182, 184, 246, 220, 153, 44, 64, 121,
+			3, 17, 78, 167, 135, 141, 87, 207, 80, 78, 167, 39, 167, 216,
+			26, 179, 108, 194, 237, 83, 25, 65, 156, 43, 154, 129, 184, 19,
+			66, 142, 190, 45, 227, 24, 50, 242, 125, 156, 40, 202, 194, 11,
+			212, 230, 159, 42, 221, 6, 21, 157, 202, 207, 177, 43, 204, 182,
+			9, 112, 113, 218, 154, 113, 138, 226, 147, 150, 84, 241, 116, 202,
+			53, 152, 144, 113, 200, 144, 191, 14, 194, 68, 239, 66, 4, 183,
+			229, 211, 86, 206, 64, 132, 211, 211, 67, 19, 6, 162, 156, 158,
+			230, 211, 236, 3, 36, 64, 56, 157, 179, 74, 160, 49, 227, 174,
+			141, 110, 26, 145, 140, 91, 1, 222, 63, 244, 43, 11, 18, 146,
+			110, 74, 136, 228, 96, 252, 25, 3, 1, 182, 185, 11, 6, 162,
+			156, 206, 45, 20, 217, 18, 18, 178, 56, 61, 103, 77, 57, 5,
+			113, 239, 75, 175, 211, 145, 117, 241, 157, 56, 12, 16, 217, 32,
+			254, 20, 181, 149, 133, 17, 67, 6, 34, 156, 158, 203, 143, 26,
+			136, 114, 122, 110, 98, 146, 45, 49, 203, 182, 184, 125, 62, 179,
+			64, 156, 243, 3, 147, 14, 201, 101, 183, 119, 41, 130, 198, 174,
+			53, 12, 214, 116, 62, 63, 135, 243, 108, 129, 134, 47, 88, 51,
+			136, 215, 66, 189, 93, 208, 243, 108, 161, 222, 46, 12, 79, 24,
+			136, 114, 122, 129, 79, 235, 97, 132, 211, 121, 235, 132, 254, 4,
+			155, 254, 124, 58, 12, 102, 112, 126, 120, 210, 64, 148, 211, 249,
+			233, 25, 61, 204, 226, 244, 162, 117, 82, 127, 2, 9, 47, 106,
+			9, 45, 11, 216, 186, 152, 159, 50, 16, 229, 244, 226, 204, 9,
+			118, 141, 89, 54, 229, 246, 59, 153, 69, 226, 148, 143, 183, 170,
+			55, 136, 74, 9, 167, 239, 228, 79, 179, 139, 204, 182, 41, 136,
+			90, 182, 166, 156, 211, 226, 163, 163, 170, 55, 99, 128, 54, 69,
+			45, 148, 53, 95, 20, 181, 80, 214, 154, 167, 184, 184, 203, 19,
+			147, 236, 6, 179, 108, 155, 219, 203, 153, 235, 196, 169, 188, 162,
+			249, 118, 215, 79, 60, 200, 154, 32, 98, 188, 19, 19, 122, 79,
+			212, 156, 217, 132, 211, 229, 252, 89, 84, 139, 13, 156, 173, 232,
+			73, 176, 145, 252, 138, 214, 166, 141, 228, 87, 244, 36, 216, 72,
+			126, 69, 79, 130, 13, 26, 91, 213, 147, 96, 227, 36, 172, 166,
+			195, 96, 18, 86, 245, 36, 216, 56, 9, 171, 211, 51, 108, 3,
+			135, 89, 156, 94, 182, 184, 115, 77, 108, 193, 118, 12, 65, 29,
+			232, 32, 232, 182, 247, 100, 4, 26, 65, 78, 241, 74, 36, 146,
+			73, 55, 10, 68, 177, 46, 27, 110, 215, 79, 110, 136, 213, 229,
+			146, 86, 147, 141, 211, 119, 89, 171, 9, 38, 154, 211, 203, 58,
+			212, 179, 113, 250, 46, 79, 78, 177, 247, 144, 32, 229, 244, 170,
+			117, 198, 121, 7, 46, 93, 253, 110, 93, 138, 48, 104, 134, 224,
+			143, 52, 165, 30, 254, 134, 235, 199, 178, 71, 130, 102, 97, 100,
+			206, 64, 132, 211, 171, 67, 39, 13, 4, 88, 79, 59, 154, 132,
+			205, 233, 53, 107, 198, 121, 71, 84, 21, 203, 26, 115, 156, 184,
+			17, 30, 59, 48, 128, 197, 93, 72, 109, 249, 41, 9, 59, 11,
+			35, 141, 218, 96, 90, 174, 165, 218, 182, 41, 167, 215, 248, 52,
+			187, 195, 44, 59, 203, 237, 247, 51, 107, 196, 185, 246, 122, 35,
+			28, 156, 117, 240, 78, 175, 76, 123, 150, 112, 250, 126, 222, 97,
+			219, 204, 182, 179, 48, 237, 183, 172, 162, 179, 150, 14, 80, 217,
+			16, 223, 139, 147, 190, 121, 136, 100, 220, 245, 251, 133, 72, 9,
+			104, 147, 211, 178, 100, 173, 140, 13, 8, 83, 40, 199, 233, 173,
+			145, 57, 3, 17, 78, 111, 157, 61, 111, 32, 202, 233, 173, 139,
+			11, 236, 99, 228, 131, 112, 250, 161, 53, 227, 108, 162, 104, 74,
+			63, 169, 85, 200, 23, 73, 207, 180, 163, 176, 93, 22, 158, 90,
+			51, 242, 133, 91, 75, 68, 12, 187, 201, 23, 93, 25, 29, 138,
+			3, 55, 22, 77, 111, 95, 6, 41, 63, 96, 146, 31, 106, 221,
+			102, 209, 36, 63, 212, 186, 205, 162, 73, 126, 200, 167, 211, 195,
+			192, 239, 63, 98, 55, 95, 119, 24, 168, 133, 237, 118, 24, 232,
+			163, 0, 196, 46, 75, 110, 16, 132, 250, 2, 247, 215, 59, 18,
+			252, 53, 97, 227, 119, 93, 207, 239, 70, 242, 142, 76, 92, 207,
+			143, 249, 34, 179, 33, 119, 136, 97, 246, 248, 234, 105, 21, 28,
+			13, 246, 169, 64, 201, 65, 21, 187, 65, 12, 159, 200, 23, 137,
+			14, 117, 241, 55, 191, 197, 102, 26, 174, 231, 203, 250, 211, 122,
+			251, 169, 185, 102, 173, 29, 206, 82, 12, 68, 71, 21, 202, 59,
+			15, 182, 188, 224, 121, 149, 171, 158, 119, 218, 119, 210, 126, 133,
+			26, 179, 129, 2, 31, 97, 67, 247, 54, 182, 55, 170, 107, 91,
+			147, 25, 62, 198, 134, 55, 62, 93, 223, 120, 4, 23, 228, 147,
+			132, 15, 179, 236, 230, 246, 221, 234, 218, 164, 197, 103, 217, 204,
+			157, 7, 79, 239, 108, 60, 218, 216, 190, 179, 177, 189, 254, 173,
+			167, 119, 215, 54, 183, 54, 238, 76, 82, 24, 179, 190, 182, 189,
+			190, 177, 5, 160, 13, 248, 54, 62, 125, 180, 89, 221, 184, 51,
+			153, 45, 252, 114, 152, 217, 59, 137, 236, 28, 119, 10, 226, 227,
+			204, 242, 234, 179, 12, 91, 44, 175, 206, 203, 108, 8, 180, 238,
+			6, 230, 128, 195, 149, 16, 128, 160, 178, 174, 190, 84, 77, 23,
+			126, 129, 229, 226, 196, 77, 186, 49, 6, 250, 227, 70, 226, 29,
+			108, 171, 234, 111, 252, 3, 54, 1, 178, 119, 35, 249, 180, 174,
+			244, 138, 113, 255, 200, 234, 204, 113, 58, 175, 142, 55, 6, 96,
+			96, 41, 238, 238, 197, 137, 236, 204, 102, 5, 61, 194, 210, 142,
+			250, 82, 53, 93, 248, 123, 108, 44, 78, 234, 97, 55, 121, 26,
+			39, 145, 116, 219, 179, 185, 126, 49, 182, 194, 102, 61, 108, 238,
+			224, 151, 234, 168, 234, 168, 32, 61, 80, 70, 145, 25, 56, 244,
+			198, 129, 50, 138, 244, 192, 203, 108, 8, 221, 141, 172, 207, 230,
+			191, 246, 76, 104, 186, 242, 101, 150, 5, 43, 168, 207, 14, 127,
+			237, 24, 213, 49, 53, 192, 25, 65, 83, 3, 92, 98, 249, 78,
+			20, 54, 225, 196, 49, 123, 2, 17, 233, 195, 9, 42, 231, 145,
+			254, 84, 77, 59, 241, 183, 152, 237, 123, 193, 243, 217, 147, 216,
+			153, 105, 225, 192, 62, 177, 157, 191, 203, 70, 48, 129, 249, 20,
+			160, 120, 246, 148, 160, 71, 186, 49, 252, 12, 63, 99, 77, 189,
+			35, 163, 228, 112, 118, 86, 208, 87, 169, 227, 167, 106, 218, 137,
+			23, 153, 157, 184, 205, 120, 246, 180, 160, 189, 233, 199, 206, 187,
+			110, 51, 222, 8, 146, 232, 176, 138, 61, 248, 53, 152, 13, 217,
+			121, 154, 226, 119, 94, 143, 127, 20, 122, 26, 200, 249, 17, 97,
+			67, 218, 80, 249, 219, 108, 84, 155, 42, 200, 3, 231, 127, 80,
+			221, 136, 110, 219, 242, 2, 201, 39, 25, 173, 29, 212, 245, 170,
+			134, 159, 252, 58, 27, 146, 193, 190, 23, 133, 129, 94, 199, 231,
+			94, 93, 2, 149, 13, 213, 67, 177, 108, 250, 59, 55, 216, 104,
+			255, 7, 64, 254, 92, 30, 234, 5, 71, 159, 203, 67, 168, 173,
+			194, 252, 139, 38, 168, 128, 27, 214, 53, 226, 28, 178, 33, 109,
+			204, 92, 48, 27, 68, 154, 37, 253, 147, 4, 228, 239, 103, 170,
+			248, 133, 175, 177, 169, 158, 43, 52, 6, 107, 189, 206, 96, 239,
+			103, 170, 147, 189, 238, 202, 108, 111, 15, 167, 203, 202, 185, 197,
+			242, 198, 84, 128, 193, 36, 76, 116, 238, 35, 91, 85, 0, 159,
+			99, 195, 88, 204, 36, 19, 157, 249, 200, 86, 123, 13, 206, 101,
+			28, 143, 147, 113, 172, 147, 57, 86, 104, 231, 61, 54, 156, 206,
+			250, 175, 163, 169, 194, 255, 37, 204, 6, 3, 132, 46, 190, 187,
+			39, 125, 61, 76, 1, 252, 28, 27, 113, 125, 207, 141, 159, 34,
+			168, 135, 51, 108, 218, 130, 22, 206, 25, 237, 70, 62, 186, 172,
+			225, 251, 153, 42, 0, 252, 58, 27, 243, 81, 99, 70, 153, 246,
+			27, 148, 57, 234, 247, 193, 252, 125, 54, 238, 197, 161, 239, 38,
+			242, 169, 202, 56, 99, 214, 34, 181, 213, 77, 245, 77, 101, 182,
+			239, 103, 170, 99, 186, 179, 106, 224, 11, 108, 168, 222, 6, 235,
+			124, 174, 61, 213, 192, 174, 113, 63, 83, 205, 213, 219, 32, 236,
+			237, 33, 173, 143, 66, 149, 141, 246, 243, 3, 233, 12, 56, 202,
+			246, 210, 51, 10, 130, 246, 78, 36, 27, 222, 11, 173, 2, 13,
+			165, 51, 68, 123, 51, 84, 184, 201, 198, 6, 248, 124, 45, 82,
+			206, 236, 150, 27, 183, 52, 74, 252, 93, 8, 88, 78, 237, 113,
+			175, 29, 53, 195, 178, 24, 177, 232, 97, 10, 128, 140, 22, 100,
+			140, 219, 157, 68, 103, 173, 12, 8, 230, 38, 95, 200, 26, 166,
+			62, 113, 175, 160, 213, 94, 195, 59, 239, 179, 156, 218, 97, 96,
+			159, 171, 62, 222, 222, 222, 220, 190, 55, 153, 1, 96, 231, 241,
+			250, 250, 198, 206, 206, 36, 1, 0, 54, 199, 199, 213, 141, 73,
+			11, 0, 216, 50, 161, 27, 253, 232, 15, 190, 193, 134, 120, 214,
+			206, 252, 21, 33, 236, 199, 189, 84, 199, 191, 235, 79, 117, 20,
+			107, 37, 72, 119, 92, 249, 27, 151, 238, 184, 207, 172, 44, 166,
+			59, 102, 136, 243, 190, 80, 90, 50, 225, 101, 239, 142, 49, 10,
+			33, 253, 145, 72, 125, 230, 133, 20, 13, 2, 145, 208, 30, 65,
+			69, 173, 89, 56, 131, 140, 100, 71, 88, 137, 217, 89, 204, 12,
+			140, 89, 227, 206, 28, 42, 5, 188, 16, 32, 86, 169, 230, 168,
+			27, 192, 233, 94, 5, 128, 208, 21, 242, 29, 214, 176, 129, 32,
+			223, 49, 58, 198, 86, 17, 13, 225, 116, 194, 26, 119, 230, 123,
+			104, 90, 46, 84, 78, 6, 94, 220, 146, 117, 17, 119, 107, 53,
+			25, 199, 141, 174, 239, 31, 166, 248, 32, 132, 156, 72, 241, 65,
+			141, 222, 196, 232, 24, 187, 140, 248, 44, 78, 167, 172, 113, 103,
+			225, 53, 248, 186, 193, 177, 24, 225, 216, 50, 149, 98, 68, 36,
+			163, 99, 236, 67, 196, 8, 137, 13, 107, 220, 185, 52, 136, 113,
+			79, 202, 64, 196, 181, 150, 172, 119, 125, 89, 47, 139, 189, 46,
+			148, 122, 37, 226, 80, 130, 46, 113, 255, 79, 177, 195, 249, 115,
+			58, 197, 78, 45, 78, 167, 71, 199, 216, 150, 74, 213, 156, 202,
+			92, 34, 206, 135, 66, 71, 61, 16, 255, 137, 78, 20, 238, 123,
+			117, 25, 139, 54, 212, 138, 232, 32, 9, 142, 143, 48, 111, 129,
+			155, 64, 137, 149, 62, 178, 170, 57, 213, 199, 10, 152, 160, 83,
+			249, 147, 172, 12, 169, 27, 192, 125, 218, 122, 135, 58, 111, 137,
+			93, 125, 39, 14, 195, 33, 120, 133, 121, 214, 81, 149, 14, 210,
+			51, 152, 246, 57, 61, 52, 194, 86, 89, 14, 198, 90, 25, 78,
+			207, 216, 147, 58, 157, 160, 59, 3, 14, 87, 223, 226, 248, 125,
+			24, 198, 217, 144, 26, 67, 96, 208, 72, 15, 182, 56, 61, 51,
+			62, 193, 214, 52, 78, 194, 233, 89, 155, 59, 171, 98, 45, 16,
+			221, 160, 229, 6, 117, 95, 214, 117, 129, 44, 24, 124, 88, 171,
+			117, 161, 60, 181, 222, 133, 155, 68, 145, 46, 241, 62, 18, 48,
+			245, 103, 237, 177, 30, 108, 113, 122, 118, 114, 138, 125, 161, 73,
+			88, 156, 10, 123, 220, 217, 59, 202, 54, 150, 25, 170, 219, 111,
+			23, 219, 37, 228, 13, 27, 145, 171, 234, 172, 64, 56, 216, 192,
+			194, 64, 6, 73, 25, 39, 18, 138, 17, 163, 40, 140, 88, 175,
+			88, 23, 246, 92, 225, 37, 177, 244, 27, 125, 44, 129, 237, 8,
+			123, 184, 7, 3, 15, 163, 99, 236, 63, 18, 205, 19, 100, 76,
+			236, 179, 206, 191, 37, 71, 153, 170, 119, 229, 0, 67, 119, 186,
+			65, 83, 134, 129, 120, 160, 83, 76, 233, 201, 0, 82, 11, 94,
+			220, 127, 229, 220, 133, 133, 235, 53, 132, 139, 76, 45, 64, 201,
+			128, 206, 129, 213, 101, 167, 174, 135, 33, 45, 172, 117, 237, 177,
+			95, 195, 157, 95, 68, 178, 6, 142, 136, 65, 202, 172, 19, 133,
+			53, 41, 235, 40, 103, 216, 77, 132, 151, 244, 73, 7, 182, 123,
+			193, 158, 237, 193, 22, 167, 23, 206, 204, 177, 162, 22, 206, 230,
+			244, 162, 205, 157, 211, 189, 213, 1, 7, 192, 26, 84, 57, 251,
+			190, 172, 247, 97, 130, 67, 245, 197, 190, 169, 179, 33, 11, 52,
+			57, 197, 14, 53, 166, 44, 167, 37, 123, 210, 249, 206, 128, 150,
+			14, 220, 158, 154, 2, 112, 177, 42, 121, 44, 95, 180, 220, 110,
+			156, 86, 147, 166, 116, 211, 5, 201, 212, 130, 148, 251, 50, 18,
+			145, 27, 148, 81, 9, 10, 60, 208, 46, 170, 143, 53, 56, 143,
+			151, 250, 12, 55, 107, 113, 90, 26, 159, 232, 229, 64, 223, 181,
+			38, 77, 14, 52, 7, 80, 206, 64, 132, 211, 119, 135, 70, 12,
+			68, 57, 125, 119, 124, 130, 93, 55, 55, 125, 21, 139, 59, 101,
+			176, 118, 83, 0, 161, 75, 24, 69, 93, 198, 181, 200, 219, 51,
+			201, 216, 35, 139, 17, 79, 204, 21, 125, 98, 86, 215, 103, 149,
+			52, 209, 10, 39, 230, 202, 228, 20, 107, 33, 17, 11, 178, 61,
+			239, 58, 159, 137, 77, 157, 174, 212, 122, 51, 101, 48, 175, 156,
+			14, 203, 105, 55, 89, 239, 89, 152, 39, 7, 172, 11, 82, 15,
+			178, 46, 90, 178, 143, 39, 203, 6, 82, 41, 148, 227, 116, 117,
+			100, 202, 64, 144, 102, 226, 23, 13, 4, 105, 166, 210, 59, 108,
+			17, 83, 193, 217, 171, 153, 63, 32, 196, 17, 226, 30, 248, 13,
+			175, 118, 116, 115, 1, 111, 153, 72, 237, 193, 64, 212, 171, 249,
+			81, 182, 98, 210, 190, 215, 44, 238, 92, 64, 139, 168, 123, 113,
+			199, 119, 15, 7, 18, 215, 235, 102, 201, 106, 46, 85, 202, 215,
+			228, 113, 8, 58, 164, 107, 90, 115, 42, 229, 123, 109, 114, 138,
+			253, 75, 98, 114, 190, 55, 173, 41, 231, 159, 171, 101, 169, 151,
+			209, 230, 29, 204, 108, 120, 9, 154, 83, 45, 146, 88, 166, 1,
+			43, 3, 110, 224, 161, 234, 36, 237, 8, 234, 237, 6, 222, 23,
+			93, 85, 206, 239, 5, 224, 26, 152, 232, 69, 214, 48, 217, 210,
+			109, 43, 219, 243, 160, 226, 26, 174, 255, 98, 225, 37, 66, 238,
+			203, 0, 232, 224, 233, 9, 23, 38, 232, 31, 74, 101, 160, 178,
+			6, 226, 177, 84, 34, 176, 133, 155, 169, 68, 160, 160, 155, 195,
+			35, 6, 162, 156, 222, 28, 159, 100, 15, 64, 32, 154, 225, 246,
+			45, 235, 30, 117, 190, 33, 244, 177, 196, 100, 218, 227, 254, 4,
+			187, 112, 247, 96, 145, 67, 45, 55, 118, 90, 132, 163, 144, 240,
+			130, 253, 176, 102, 18, 240, 136, 156, 130, 242, 110, 177, 41, 182,
+			205, 114, 128, 28, 102, 227, 67, 123, 222, 249, 134, 174, 223, 62,
+			118, 112, 185, 47, 172, 112, 193, 243, 8, 55, 106, 118, 219, 80,
+			23, 182, 47, 107, 9, 38, 220, 192, 147, 32, 62, 27, 16, 142,
+			246, 224, 44, 167, 31, 142, 77, 247, 96, 72, 20, 205, 136, 30,
+			12, 169, 162, 243, 23, 216, 146, 230, 135, 112, 186, 110, 79, 59,
+			194, 164, 171, 160, 106, 76, 28, 132, 209, 115, 88, 85, 117, 47,
+			66, 122, 135, 125, 4, 65, 147, 235, 118, 31, 12, 24, 70, 198,
+			123, 48, 229, 116, 125, 138, 179, 45, 77, 192, 226, 244, 174, 125,
+			193, 249, 64, 232, 67, 28, 138, 209, 87, 90, 15, 11, 9, 141,
+			23, 246, 83, 172, 17, 130, 80, 101, 65, 232, 227, 31, 116, 239,
+			163, 110, 229, 0, 221, 169, 30, 76, 56, 189, 59, 123, 174, 7,
+			83, 78, 239, 22, 206, 179, 79, 205, 61, 193, 166, 117, 210, 249,
+			230, 155, 148, 109, 86, 1, 172, 162, 95, 69, 241, 250, 210, 32,
+			7, 168, 135, 13, 68, 56, 221, 100, 83, 6, 162, 156, 110, 206,
+			156, 96, 87, 145, 7, 202, 233, 150, 53, 227, 148, 6, 20, 172,
+			99, 59, 92, 181, 221, 216, 176, 0, 22, 156, 82, 160, 57, 24,
+			104, 44, 22, 54, 144, 45, 157, 239, 35, 22, 5, 164, 124, 154,
+			253, 158, 90, 131, 54, 167, 15, 173, 121, 231, 187, 226, 161, 113,
+			144, 253, 150, 170, 34, 159, 163, 78, 82, 237, 133, 240, 216, 102,
+			79, 138, 78, 216, 233, 250, 184, 70, 85, 62, 146, 153, 245, 153,
+			132, 157, 69, 31, 202, 227, 141, 246, 250, 194, 223, 88, 98, 93,
+			147, 62, 17, 164, 124, 219, 57, 224, 102, 210, 64, 132, 211, 135,
+			83, 194, 64, 148, 211, 135, 231, 47, 160, 107, 39, 148, 112, 187,
+			106, 61, 161, 206, 187, 98, 167, 187, 183, 8, 250, 135, 114, 254,
+			56, 125, 25, 19, 72, 55, 105, 13, 68, 211, 134, 8, 133, 5,
+			92, 101, 83, 236, 45, 52, 50, 146, 207, 112, 123, 215, 254, 86,
+			214, 25, 71, 45, 247, 2, 110, 101, 22, 36, 15, 171, 112, 151,
+			77, 105, 171, 199, 171, 176, 143, 115, 51, 142, 232, 239, 14, 158,
+			200, 13, 132, 23, 224, 106, 220, 25, 64, 96, 101, 114, 156, 126,
+			156, 235, 131, 9, 167, 31, 143, 76, 244, 96, 202, 233, 199, 152,
+			221, 70, 134, 96, 219, 250, 52, 87, 114, 174, 188, 66, 64, 196,
+			178, 227, 70, 96, 238, 91, 97, 243, 78, 216, 124, 213, 211, 245,
+			81, 133, 27, 177, 79, 115, 188, 7, 3, 218, 233, 11, 61, 152,
+			114, 250, 233, 66, 81, 91, 90, 150, 211, 111, 91, 231, 156, 146,
+			208, 41, 15, 8, 85, 221, 68, 157, 69, 118, 52, 125, 12, 207,
+			192, 188, 195, 70, 58, 99, 89, 27, 6, 166, 80, 142, 211, 111,
+			143, 112, 3, 17, 78, 191, 61, 237, 24, 136, 114, 250, 237, 179,
+			111, 177, 111, 32, 189, 28, 167, 79, 173, 183, 33, 16, 133, 151,
+			21, 207, 211, 10, 48, 109, 59, 59, 187, 119, 224, 149, 146, 113,
+			223, 94, 67, 232, 53, 159, 18, 206, 33, 134, 113, 3, 17, 78,
+			159, 78, 204, 25, 136, 114, 250, 244, 156, 208, 164, 134, 56, 117,
+			223, 76, 106, 163, 90, 125, 19, 169, 161, 28, 96, 48, 164, 134,
+			8, 167, 110, 74, 106, 136, 114, 234, 158, 19, 236, 115, 36, 149,
+			231, 84, 90, 69, 231, 17, 220, 146, 6, 169, 103, 48, 167, 145,
+			87, 61, 68, 245, 238, 58, 20, 193, 154, 152, 4, 235, 222, 196,
+			19, 81, 124, 188, 187, 94, 98, 105, 217, 101, 202, 72, 62, 7,
+			248, 205, 37, 103, 158, 112, 42, 231, 206, 235, 75, 206, 60, 229,
+			84, 94, 92, 96, 79, 144, 145, 97, 78, 91, 214, 69, 231, 193,
+			17, 70, 32, 212, 248, 53, 217, 120, 149, 139, 225, 28, 32, 55,
+			92, 12, 19, 78, 91, 115, 111, 27, 136, 114, 218, 186, 48, 207,
+			190, 66, 46, 24, 167, 190, 53, 231, 4, 98, 45, 218, 243, 18,
+			40, 210, 131, 25, 144, 232, 179, 210, 88, 95, 64, 222, 180, 34,
+			54, 220, 90, 203, 48, 0, 81, 15, 24, 28, 236, 144, 65, 140,
+			71, 144, 125, 184, 141, 9, 36, 84, 179, 6, 117, 19, 40, 65,
+			24, 173, 55, 88, 17, 200, 3, 232, 16, 167, 108, 50, 27, 168,
+			167, 80, 150, 83, 95, 71, 75, 196, 98, 132, 83, 159, 159, 52,
+			16, 229, 212, 63, 125, 134, 253, 149, 5, 92, 83, 139, 219, 137,
+			245, 21, 117, 254, 179, 37, 76, 18, 46, 213, 24, 240, 148, 134,
+			60, 11, 177, 128, 8, 222, 245, 125, 97, 210, 185, 21, 177, 153,
+			136, 122, 40, 97, 249, 64, 112, 2, 19, 10, 5, 184, 70, 252,
+			130, 233, 40, 186, 129, 151, 196, 133, 178, 56, 128, 231, 81, 16,
+			118, 212, 189, 184, 22, 201, 68, 170, 47, 160, 162, 131, 48, 122,
+			46, 218, 210, 141, 241, 72, 182, 119, 200, 6, 35, 46, 181, 66,
+			97, 168, 126, 166, 151, 230, 255, 244, 45, 124, 175, 1, 106, 78,
+			215, 68, 12, 143, 247, 224, 148, 21, 168, 35, 23, 184, 13, 184,
+			46, 43, 116, 100, 84, 147, 65, 2, 215, 103, 102, 72, 1, 244,
+			159, 132, 232, 168, 159, 97, 202, 241, 25, 172, 209, 149, 229, 101,
+			53, 3, 207, 82, 220, 207, 204, 13, 85, 31, 22, 204, 139, 153,
+			153, 160, 224, 208, 18, 198, 89, 13, 29, 40, 222, 116, 31, 216,
+			51, 206, 46, 250, 55, 196, 221, 119, 231, 57, 168, 160, 10, 68,
+			213, 248, 100, 48, 104, 130, 84, 80, 70, 93, 134, 7, 93, 105,
+			55, 47, 102, 61, 123, 78, 221, 159, 133, 177, 204, 129, 62, 19,
+			18, 170, 238, 208, 15, 152, 113, 186, 234, 22, 253, 128, 79, 179,
+			127, 66, 52, 87, 132, 211, 47, 237, 89, 231, 239, 169, 96, 180,
+			199, 80, 42, 232, 43, 172, 169, 93, 16, 234, 251, 116, 165, 243,
+			158, 20, 62, 124, 79, 90, 80, 82, 28, 49, 33, 191, 232, 194,
+			203, 161, 208, 168, 16, 197, 9, 219, 94, 130, 222, 192, 131, 74,
+			118, 243, 162, 18, 122, 237, 73, 20, 176, 79, 10, 8, 152, 190,
+			236, 147, 2, 246, 174, 47, 153, 137, 208, 44, 116, 226, 95, 158,
+			60, 197, 138, 96, 185, 214, 8, 167, 47, 173, 211, 206, 25, 177,
+			219, 111, 39, 11, 113, 202, 121, 186, 56, 70, 114, 208, 53, 133,
+			8, 167, 47, 71, 166, 13, 68, 57, 125, 121, 114, 150, 37, 136,
+			115, 148, 219, 127, 151, 88, 83, 78, 3, 145, 118, 34, 175, 173,
+			151, 241, 115, 253, 226, 211, 139, 123, 164, 180, 78, 116, 162, 3,
+			59, 161, 145, 98, 121, 136, 170, 136, 236, 59, 204, 167, 195, 212,
+			129, 176, 11, 143, 112, 217, 24, 242, 48, 154, 67, 178, 57, 3,
+			18, 0, 135, 70, 12, 72, 1, 28, 159, 100, 207, 145, 197, 49,
+			110, 255, 54, 177, 222, 118, 62, 23, 107, 245, 186, 167, 163, 24,
+			32, 62, 144, 116, 24, 160, 8, 140, 202, 24, 142, 13, 190, 15,
+			101, 76, 17, 120, 70, 88, 101, 46, 188, 131, 137, 189, 186, 46,
+			241, 77, 189, 84, 202, 217, 152, 141, 212, 82, 48, 7, 224, 200,
+			132, 1, 9, 128, 147, 103, 12, 72, 1, 124, 75, 176, 187, 192,
+			40, 165, 60, 247, 59, 196, 250, 135, 132, 58, 87, 133, 201, 207,
+			235, 200, 161, 231, 34, 158, 203, 195, 37, 92, 63, 162, 136, 183,
+			203, 37, 97, 174, 92, 12, 15, 20, 162, 159, 223, 33, 140, 179,
+			50, 218, 46, 20, 84, 216, 191, 75, 236, 25, 103, 78, 157, 205,
+			180, 254, 205, 56, 115, 154, 153, 64, 35, 194, 178, 10, 232, 222,
+			215, 64, 184, 253, 187, 100, 100, 162, 215, 64, 161, 129, 79, 179,
+			247, 52, 1, 194, 237, 223, 35, 246, 9, 103, 65, 21, 171, 26,
+			10, 233, 161, 58, 37, 101, 86, 190, 193, 68, 178, 56, 178, 175,
+			1, 81, 141, 76, 246, 26, 40, 52, 76, 67, 209, 5, 76, 230,
+			56, 183, 255, 17, 177, 10, 90, 129, 227, 54, 130, 70, 219, 227,
+			57, 0, 71, 166, 13, 72, 0, 156, 153, 51, 32, 5, 240, 220,
+			219, 108, 13, 180, 109, 77, 112, 251, 31, 19, 75, 56, 151, 196,
+			218, 49, 202, 133, 75, 45, 229, 65, 117, 38, 64, 191, 24, 215,
+			177, 155, 194, 56, 145, 67, 28, 39, 52, 129, 9, 2, 224, 73,
+			199, 128, 20, 192, 179, 231, 216, 23, 72, 111, 146, 219, 255, 148,
+			88, 11, 78, 77, 124, 51, 165, 162, 245, 226, 201, 215, 211, 130,
+			167, 225, 222, 115, 41, 158, 25, 29, 62, 195, 4, 1, 20, 153,
+			131, 111, 7, 63, 142, 118, 96, 204, 192, 147, 113, 202, 223, 164,
+			141, 52, 83, 48, 7, 96, 170, 158, 73, 2, 224, 204, 219, 6,
+			164, 0, 94, 184, 200, 254, 22, 22, 43, 229, 254, 144, 100, 190,
+			79, 136, 243, 72, 172, 9, 184, 43, 208, 134, 152, 78, 41, 94,
+			214, 136, 70, 232, 251, 225, 129, 126, 39, 129, 239, 49, 234, 105,
+			20, 229, 6, 105, 206, 139, 165, 137, 161, 10, 99, 35, 170, 182,
+			201, 254, 67, 146, 31, 197, 170, 16, 112, 249, 246, 31, 17, 107,
+			218, 41, 13, 164, 98, 76, 66, 193, 144, 138, 82, 183, 161, 37,
+			68, 55, 14, 35, 243, 6, 36, 220, 254, 35, 50, 60, 110, 64,
+			10, 224, 20, 103, 191, 77, 116, 49, 148, 253, 39, 196, 58, 237,
+			236, 139, 205, 52, 140, 3, 109, 122, 177, 98, 90, 175, 53, 184,
+			121, 66, 239, 229, 6, 234, 248, 143, 31, 181, 95, 130, 119, 193,
+			110, 91, 98, 206, 128, 245, 82, 50, 61, 23, 225, 5, 250, 201,
+			116, 24, 164, 239, 111, 7, 120, 6, 155, 255, 147, 30, 207, 4,
+			185, 26, 158, 49, 32, 5, 240, 20, 228, 243, 108, 219, 202, 103,
+			120, 238, 123, 196, 250, 55, 132, 170, 207, 112, 218, 176, 191, 71,
+			242, 99, 236, 164, 174, 211, 178, 255, 148, 216, 211, 78, 94, 172,
+			137, 199, 213, 173, 148, 134, 149, 197, 15, 41, 72, 0, 28, 49,
+			122, 177, 40, 128, 83, 156, 85, 16, 9, 229, 246, 159, 17, 251,
+			188, 35, 68, 241, 33, 188, 49, 111, 148, 96, 206, 213, 17, 66,
+			197, 189, 131, 74, 167, 57, 28, 48, 105, 64, 2, 224, 212, 91,
+			6, 68, 116, 111, 23, 216, 50, 34, 183, 185, 253, 175, 136, 61,
+			239, 20, 250, 144, 7, 66, 223, 169, 97, 77, 167, 140, 6, 209,
+			219, 57, 28, 50, 101, 64, 2, 32, 23, 6, 164, 0, 158, 191,
+			192, 110, 34, 250, 44, 183, 255, 53, 177, 79, 57, 139, 253, 188,
+			167, 22, 120, 52, 97, 171, 238, 251, 82, 74, 217, 28, 142, 78,
+			65, 2, 224, 8, 55, 32, 5, 240, 196, 73, 118, 5, 107, 219,
+			114, 127, 78, 50, 255, 158, 16, 103, 65, 244, 223, 230, 225, 178,
+			56, 94, 89, 96, 231, 224, 138, 255, 156, 228, 103, 216, 11, 93,
+			217, 102, 255, 128, 88, 51, 58, 133, 170, 186, 47, 196, 90, 11,
+			131, 187, 127, 210, 74, 181, 163, 189, 41, 150, 240, 232, 166, 244,
+			244, 117, 76, 58, 11, 88, 106, 133, 109, 56, 136, 5, 90, 84,
+			229, 212, 127, 96, 140, 78, 185, 244, 31, 144, 225, 9, 3, 82,
+			0, 249, 52, 171, 33, 155, 132, 219, 63, 4, 54, 31, 35, 155,
+			126, 216, 20, 143, 240, 42, 18, 57, 132, 11, 191, 195, 178, 222,
+			65, 160, 213, 120, 123, 228, 79, 55, 185, 58, 194, 237, 49, 199,
+			52, 119, 41, 71, 176, 12, 126, 216, 227, 8, 150, 193, 15, 123,
+			28, 17, 10, 32, 159, 102, 103, 145, 35, 139, 219, 63, 34, 22,
+			119, 38, 82, 142, 244, 166, 165, 122, 131, 185, 255, 168, 135, 203,
+			34, 0, 14, 143, 25, 144, 2, 56, 57, 197, 54, 177, 24, 48,
+			247, 99, 146, 249, 79, 132, 56, 55, 197, 192, 21, 170, 94, 253,
+			186, 45, 173, 54, 214, 143, 146, 76, 217, 162, 201, 192, 193, 228,
+			66, 25, 237, 143, 73, 254, 4, 59, 212, 197, 129, 246, 79, 64,
+			107, 207, 145, 199, 126, 60, 50, 26, 212, 92, 47, 6, 210, 5,
+			117, 71, 122, 155, 122, 80, 120, 251, 180, 119, 104, 54, 107, 224,
+			99, 33, 86, 215, 155, 181, 48, 104, 120, 77, 45, 63, 150, 34,
+			2, 237, 188, 1, 9, 183, 127, 98, 116, 137, 197, 136, 246, 79,
+			8, 159, 198, 48, 16, 93, 239, 79, 65, 151, 14, 242, 105, 86,
+			162, 150, 19, 46, 136, 83, 180, 48, 69, 63, 237, 161, 133, 41,
+			250, 169, 81, 43, 22, 43, 218, 63, 37, 147, 83, 108, 29, 203,
+			238, 114, 127, 65, 224, 142, 214, 185, 34, 212, 229, 50, 136, 248,
+			202, 2, 76, 111, 140, 142, 85, 104, 150, 112, 251, 47, 72, 126,
+			156, 253, 29, 162, 235, 238, 236, 159, 129, 70, 35, 228, 244, 8,
+			170, 223, 68, 177, 172, 167, 217, 95, 69, 177, 89, 84, 236, 207,
+			140, 6, 178, 184, 108, 126, 102, 20, 155, 69, 197, 254, 12, 20,
+			123, 78, 151, 231, 217, 63, 135, 93, 108, 10, 217, 197, 59, 115,
+			19, 91, 141, 153, 58, 59, 251, 231, 61, 108, 160, 207, 159, 155,
+			221, 10, 43, 237, 236, 159, 147, 41, 206, 206, 35, 54, 139, 219,
+			191, 0, 225, 79, 32, 54, 125, 211, 174, 15, 30, 41, 70, 48,
+			252, 95, 16, 107, 200, 128, 4, 192, 188, 225, 15, 252, 252, 47,
+			128, 191, 121, 196, 72, 185, 253, 75, 98, 157, 116, 78, 33, 198,
+			222, 84, 28, 193, 73, 179, 216, 207, 224, 4, 23, 246, 75, 146,
+			159, 50, 32, 98, 153, 57, 145, 22, 4, 254, 139, 89, 118, 245,
+			215, 121, 29, 4, 249, 195, 129, 90, 192, 255, 159, 114, 194, 194,
+			255, 180, 216, 36, 62, 140, 216, 12, 26, 161, 126, 56, 193, 63,
+			96, 121, 243, 106, 64, 215, 228, 232, 146, 160, 163, 61, 213, 99,
+			141, 219, 97, 114, 63, 163, 159, 224, 236, 133, 56, 60, 62, 112,
+			163, 182, 23, 52, 103, 173, 55, 14, 223, 209, 221, 96, 184, 25,
+			2, 69, 76, 218, 168, 158, 182, 188, 32, 153, 29, 193, 186, 141,
+			17, 221, 118, 223, 11, 18, 231, 11, 253, 112, 229, 118, 152, 64,
+			249, 139, 170, 40, 127, 218, 87, 135, 195, 84, 211, 182, 219, 150,
+			128, 79, 23, 167, 170, 30, 22, 246, 24, 209, 109, 3, 93, 224,
+			69, 209, 158, 140, 116, 121, 198, 136, 121, 84, 180, 39, 35, 103,
+			149, 229, 13, 183, 80, 81, 210, 10, 227, 68, 211, 194, 223, 208,
+			150, 184, 241, 115, 141, 29, 127, 67, 9, 11, 162, 40, 252, 62,
+			209, 175, 146, 148, 150, 213, 115, 1, 168, 7, 209, 66, 105, 76,
+			6, 132, 170, 53, 72, 40, 205, 90, 71, 11, 162, 116, 57, 212,
+			55, 142, 43, 135, 162, 175, 43, 135, 122, 181, 24, 106, 117, 157,
+			13, 167, 252, 240, 171, 140, 222, 147, 9, 63, 121, 252, 36, 13,
+			188, 203, 233, 231, 255, 163, 255, 193, 213, 27, 154, 187, 111, 124,
+			67, 243, 222, 223, 176, 162, 146, 13, 246, 223, 45, 245, 136, 102,
+			50, 195, 137, 243, 95, 44, 228, 63, 149, 254, 248, 103, 52, 125,
+			223, 195, 70, 67, 70, 224, 186, 195, 64, 46, 198, 73, 216, 129,
+			32, 183, 131, 241, 112, 55, 134, 47, 73, 168, 11, 137, 31, 120,
+			126, 152, 94, 86, 25, 127, 14, 247, 186, 158, 95, 47, 139, 72,
+			191, 21, 134, 140, 157, 103, 222, 37, 55, 220, 182, 231, 123, 110,
+			36, 18, 25, 181, 99, 12, 162, 225, 166, 91, 194, 107, 199, 154,
+			27, 132, 129, 87, 115, 125, 125, 160, 129, 194, 127, 38, 138, 237,
+			48, 78, 252, 67, 19, 98, 245, 44, 65, 152, 202, 203, 184, 132,
+			104, 218, 50, 113, 97, 8, 230, 216, 220, 26, 252, 101, 37, 220,
+			208, 37, 84, 246, 169, 191, 177, 196, 80, 10, 173, 128, 88, 255,
+			17, 28, 47, 110, 65, 172, 232, 75, 55, 10, 180, 52, 186, 108,
+			59, 140, 196, 190, 39, 15, 64, 239, 94, 4, 65, 135, 46, 227,
+			200, 65, 178, 104, 50, 63, 165, 159, 0, 101, 160, 50, 229, 154,
+			126, 230, 3, 159, 166, 114, 195, 6, 130, 58, 21, 102, 222, 17,
+			65, 78, 105, 106, 254, 42, 99, 170, 174, 100, 38, 115, 157, 164,
+			85, 33, 51, 249, 89, 124, 233, 146, 129, 75, 194, 147, 214, 89,
+			234, 44, 189, 242, 90, 161, 255, 85, 144, 43, 140, 3, 65, 109,
+			153, 108, 116, 6, 47, 5, 79, 50, 206, 222, 134, 75, 123, 184,
+			148, 227, 116, 214, 62, 227, 112, 177, 219, 123, 26, 163, 55, 37,
+			200, 18, 101, 244, 189, 222, 172, 190, 118, 203, 232, 123, 189, 217,
+			145, 147, 61, 152, 114, 58, 123, 218, 97, 37, 141, 146, 112, 234,
+			216, 115, 58, 126, 208, 110, 8, 55, 58, 189, 203, 246, 161, 134,
+			4, 149, 211, 135, 26, 18, 84, 206, 200, 169, 30, 76, 57, 117,
+			156, 51, 172, 160, 81, 91, 156, 206, 217, 103, 156, 233, 30, 234,
+			222, 238, 100, 198, 192, 123, 134, 57, 157, 244, 202, 232, 123, 186,
+			57, 214, 99, 23, 222, 52, 204, 233, 7, 7, 25, 216, 189, 4,
+			20, 217, 148, 6, 212, 169, 211, 194, 96, 146, 174, 48, 126, 81,
+			128, 211, 75, 21, 9, 172, 10, 198, 217, 95, 66, 6, 48, 3,
+			247, 48, 80, 29, 49, 227, 252, 144, 136, 251, 97, 156, 152, 64,
+			3, 156, 103, 255, 141, 119, 138, 204, 68, 234, 33, 196, 20, 1,
+			4, 152, 240, 94, 185, 40, 43, 205, 74, 89, 20, 204, 110, 81,
+			209, 127, 75, 0, 246, 206, 130, 254, 107, 80, 42, 138, 49, 199,
+			224, 254, 99, 1, 228, 56, 117, 176, 3, 139, 111, 33, 78, 227,
+			156, 35, 100, 89, 154, 186, 234, 246, 242, 158, 40, 4, 188, 229,
+			73, 39, 132, 64, 68, 67, 47, 234, 203, 166, 140, 190, 108, 186,
+			136, 161, 162, 18, 26, 235, 46, 102, 116, 245, 72, 74, 4, 20,
+			53, 104, 69, 4, 75, 34, 74, 125, 152, 65, 127, 165, 62, 204,
+			144, 139, 44, 113, 200, 34, 218, 118, 6, 110, 213, 202, 214, 10,
+			85, 186, 134, 83, 45, 45, 231, 199, 48, 64, 69, 147, 173, 216,
+			142, 115, 70, 104, 15, 126, 212, 216, 205, 11, 32, 85, 235, 81,
+			177, 71, 251, 106, 61, 42, 99, 39, 250, 106, 61, 42, 179, 167,
+			53, 78, 194, 233, 242, 17, 156, 169, 52, 131, 56, 225, 98, 108,
+			57, 197, 9, 82, 44, 167, 56, 65, 134, 229, 217, 211, 236, 251,
+			150, 169, 237, 184, 102, 157, 113, 254, 24, 111, 2, 96, 227, 19,
+			176, 225, 235, 35, 33, 108, 24, 122, 63, 20, 113, 183, 217, 148,
+			113, 154, 81, 199, 29, 66, 81, 21, 59, 97, 91, 91, 122, 92,
+			102, 80, 215, 228, 238, 249, 135, 34, 196, 53, 21, 6, 240, 23,
+			16, 205, 95, 45, 52, 87, 25, 50, 8, 187, 205, 86, 234, 239,
+			192, 194, 32, 187, 226, 195, 159, 104, 3, 79, 197, 12, 217, 138,
+			168, 166, 15, 210, 241, 47, 23, 98, 101, 157, 185, 137, 245, 226,
+			148, 91, 93, 206, 86, 71, 63, 90, 11, 35, 184, 153, 79, 77,
+			177, 225, 73, 191, 222, 111, 144, 186, 92, 34, 129, 251, 11, 243,
+			103, 5, 107, 97, 55, 114, 155, 184, 191, 97, 138, 59, 144, 112,
+			211, 238, 70, 186, 128, 207, 86, 171, 214, 212, 125, 168, 53, 123,
+			109, 216, 168, 21, 86, 236, 181, 89, 7, 223, 70, 18, 120, 191,
+			115, 247, 55, 125, 27, 9, 211, 245, 126, 254, 52, 38, 7, 112,
+			197, 222, 178, 78, 232, 119, 95, 3, 19, 146, 158, 159, 181, 147,
+			217, 147, 152, 216, 21, 73, 168, 25, 86, 43, 229, 86, 122, 73,
+			14, 182, 117, 75, 191, 211, 82, 171, 228, 214, 244, 12, 70, 234,
+			184, 70, 214, 172, 19, 206, 73, 237, 98, 61, 253, 170, 105, 224,
+			90, 25, 205, 106, 205, 74, 235, 66, 96, 204, 168, 65, 7, 102,
+			181, 54, 61, 195, 254, 140, 152, 210, 130, 13, 235, 29, 231, 159,
+			169, 171, 134, 87, 55, 62, 125, 204, 63, 106, 73, 208, 89, 157,
+			211, 211, 197, 159, 94, 190, 67, 154, 160, 110, 2, 138, 174, 190,
+			218, 235, 59, 194, 3, 46, 55, 56, 20, 221, 96, 81, 181, 200,
+			186, 232, 15, 193, 160, 166, 181, 0, 242, 20, 82, 129, 160, 92,
+			98, 195, 50, 183, 2, 160, 132, 141, 153, 121, 3, 81, 78, 55,
+			138, 165, 189, 92, 39, 10, 147, 240, 210, 255, 27, 0, 67, 5,
+			162, 25, 254, 84, 0, 0},
 	)
 }
 
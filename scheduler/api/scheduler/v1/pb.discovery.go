@@ -12,332 +12,324 @@ func init() {
 			"scheduler.Scheduler",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 164, 122, 205, 111, 35, 71,
-			122, 55, 187, 170, 249, 161, 210, 120, 52, 170, 209, 151, 233, 181,
-			247, 49, 199, 246, 104, 188, 52, 229, 25, 123, 198, 182, 6, 222,
-			119, 41, 170, 37, 181, 151, 34, 229, 38, 41, 89, 222, 23, 24,
-			55, 217, 69, 178, 157, 102, 55, 183, 63, 164, 225, 46, 6, 216,
-			92, 114, 206, 33, 127, 193, 94, 130, 108, 144, 227, 30, 242, 113,
-			89, 32, 64, 46, 57, 229, 156, 91, 14, 1, 114, 201, 53, 64,
-			16, 32, 120, 170, 171, 41, 74, 227, 217, 141, 147, 61, 172, 245,
-			235, 170, 250, 213, 243, 85, 245, 60, 79, 113, 216, 191, 172, 179,
-			55, 70, 65, 48, 242, 196, 206, 52, 12, 226, 160, 159, 12, 119,
-			196, 100, 26, 207, 106, 18, 242, 149, 116, 176, 150, 13, 86, 138,
-			44, 111, 224, 248, 222, 5, 187, 59, 8, 38, 181, 27, 227, 123,
-			76, 142, 158, 32, 60, 209, 190, 190, 63, 114, 227, 113, 210, 175,
-			13, 130, 201, 206, 40, 240, 108, 127, 116, 181, 205, 52, 158, 77,
-			69, 148, 238, 246, 31, 154, 246, 107, 66, 15, 79, 246, 126, 67,
-			222, 58, 76, 25, 79, 212, 188, 218, 153, 240, 188, 159, 250, 193,
-			165, 223, 197, 249, 95, 252, 197, 26, 43, 112, 253, 173, 220, 71,
-			119, 216, 63, 222, 98, 218, 45, 78, 223, 202, 241, 71, 191, 187,
-			5, 114, 193, 32, 240, 96, 47, 25, 14, 69, 24, 193, 7, 144,
-			82, 221, 143, 192, 177, 99, 27, 92, 63, 22, 225, 96, 108, 251,
-			35, 1, 195, 32, 156, 216, 49, 131, 70, 48, 157, 133, 238, 104,
-			28, 195, 163, 15, 63, 252, 84, 45, 0, 211, 31, 212, 0, 234,
-			158, 7, 114, 44, 130, 80, 68, 34, 188, 16, 78, 141, 193, 56,
-			142, 167, 209, 238, 206, 142, 35, 46, 132, 23, 76, 69, 24, 101,
-			54, 64, 37, 167, 74, 136, 15, 250, 169, 16, 59, 140, 129, 37,
-			28, 55, 138, 67, 183, 159, 196, 110, 224, 131, 237, 59, 144, 68,
-			2, 92, 31, 162, 32, 9, 7, 66, 126, 233, 187, 190, 29, 206,
-			164, 92, 81, 21, 46, 221, 120, 12, 65, 40, 255, 27, 36, 49,
-			131, 73, 224, 184, 67, 119, 96, 35, 67, 21, 236, 80, 192, 84,
-			132, 19, 55, 142, 133, 3, 211, 48, 184, 112, 29, 225, 64, 60,
-			182, 99, 136, 199, 168, 157, 231, 5, 151, 174, 63, 130, 65, 224,
-			59, 46, 46, 138, 112, 17, 131, 137, 136, 119, 25, 3, 252, 223,
-			251, 55, 4, 139, 32, 24, 102, 18, 13, 2, 71, 192, 36, 137,
-			98, 8, 69, 108, 187, 190, 100, 181, 251, 193, 5, 14, 41, 139,
-			49, 240, 131, 216, 29, 136, 42, 196, 99, 55, 2, 207, 141, 98,
-			100, 88, 220, 209, 119, 110, 136, 227, 184, 209, 192, 179, 221, 137,
-			8, 107, 175, 18, 194, 245, 23, 109, 145, 9, 49, 13, 3, 39,
-			25, 136, 43, 57, 216, 149, 32, 255, 39, 57, 24, 40, 237, 156,
-			96, 144, 76, 132, 31, 219, 153, 147, 118, 130, 16, 130, 120, 44,
-			66, 152, 216, 177, 8, 93, 219, 139, 174, 76, 45, 29, 20, 143,
-			5, 131, 69, 233, 231, 74, 181, 132, 43, 87, 34, 177, 111, 79,
-			4, 10, 180, 24, 91, 126, 112, 53, 38, 237, 238, 198, 17, 106,
-			228, 167, 84, 65, 24, 193, 196, 158, 65, 95, 96, 164, 56, 16,
-			7, 32, 124, 39, 8, 35, 129, 65, 49, 13, 131, 73, 16, 11,
-			72, 109, 18, 71, 224, 136, 208, 189, 16, 14, 12, 195, 96, 194,
-			82, 43, 68, 193, 48, 190, 196, 48, 81, 17, 4, 209, 84, 12,
-			48, 130, 96, 26, 186, 24, 88, 33, 198, 142, 159, 70, 81, 20,
-			73, 217, 25, 116, 143, 204, 14, 116, 218, 7, 221, 179, 186, 101,
-			128, 217, 129, 19, 171, 125, 106, 238, 27, 251, 176, 119, 14, 221,
-			35, 3, 26, 237, 147, 115, 203, 60, 60, 234, 194, 81, 187, 185,
-			111, 88, 29, 168, 183, 246, 161, 209, 110, 117, 45, 115, 175, 215,
-			109, 91, 29, 6, 149, 122, 7, 204, 78, 69, 142, 212, 91, 231,
-			96, 124, 117, 98, 25, 157, 14, 180, 45, 48, 143, 79, 154, 166,
-			177, 15, 103, 117, 203, 170, 183, 186, 166, 209, 169, 130, 217, 106,
-			52, 123, 251, 102, 235, 176, 10, 123, 189, 46, 180, 218, 93, 6,
-			77, 243, 216, 236, 26, 251, 208, 109, 87, 229, 182, 47, 175, 131,
-			246, 1, 28, 27, 86, 227, 168, 222, 234, 214, 247, 204, 166, 217,
-			61, 151, 27, 30, 152, 221, 22, 110, 118, 208, 182, 24, 212, 225,
-			164, 110, 117, 205, 70, 175, 89, 183, 224, 164, 103, 157, 180, 59,
-			6, 160, 102, 251, 102, 167, 209, 172, 155, 199, 198, 126, 13, 204,
-			22, 180, 218, 96, 156, 26, 173, 46, 116, 142, 234, 205, 230, 117,
-			69, 25, 180, 207, 90, 134, 133, 210, 47, 170, 9, 123, 6, 52,
-			205, 250, 94, 211, 192, 173, 164, 158, 251, 166, 101, 52, 186, 168,
-			208, 213, 95, 13, 115, 223, 104, 117, 235, 205, 42, 131, 206, 137,
-			209, 48, 235, 205, 42, 24, 95, 25, 199, 39, 205, 186, 117, 94,
-			85, 164, 29, 227, 203, 158, 209, 234, 154, 245, 38, 236, 215, 143,
-			235, 135, 70, 7, 182, 255, 144, 85, 78, 172, 118, 163, 103, 25,
-			199, 40, 117, 251, 0, 58, 189, 189, 78, 215, 236, 246, 186, 6,
-			28, 182, 219, 251, 210, 216, 29, 195, 58, 53, 27, 70, 231, 41,
-			52, 219, 29, 105, 176, 94, 199, 168, 50, 216, 175, 119, 235, 114,
-			235, 19, 171, 125, 96, 118, 59, 79, 241, 239, 189, 94, 199, 148,
-			134, 51, 91, 93, 195, 178, 122, 39, 93, 179, 221, 122, 0, 71,
-			237, 51, 227, 212, 176, 160, 81, 239, 117, 140, 125, 105, 225, 118,
-			11, 181, 197, 88, 49, 218, 214, 57, 210, 162, 29, 164, 7, 170,
-			112, 118, 100, 116, 143, 12, 11, 141, 42, 173, 85, 71, 51, 116,
-			186, 150, 217, 232, 46, 78, 107, 91, 208, 109, 91, 93, 182, 160,
-			39, 180, 140, 195, 166, 121, 104, 180, 26, 6, 14, 183, 145, 230,
-			204, 236, 24, 15, 160, 110, 153, 29, 156, 96, 202, 141, 225, 172,
-			126, 14, 237, 158, 212, 26, 29, 213, 235, 24, 44, 253, 123, 33,
-			116, 171, 210, 159, 96, 30, 64, 125, 255, 212, 68, 201, 213, 236,
-			147, 118, 167, 99, 170, 112, 145, 102, 107, 28, 41, 155, 215, 24,
-			43, 49, 141, 112, 10, 165, 77, 252, 171, 196, 105, 37, 247, 148,
-			45, 51, 189, 244, 175, 197, 92, 10, 110, 177, 60, 2, 194, 105,
-			165, 184, 201, 94, 99, 5, 137, 114, 41, 188, 205, 138, 41, 212,
-			82, 172, 38, 23, 57, 173, 148, 119, 21, 227, 189, 220, 143, 21,
-			163, 150, 130, 116, 18, 110, 123, 175, 184, 170, 24, 53, 100, 68,
-			152, 50, 106, 146, 17, 177, 154, 92, 228, 244, 222, 218, 231, 138,
-			241, 157, 92, 85, 49, 146, 20, 164, 147, 8, 162, 226, 93, 197,
-			72, 144, 17, 97, 202, 72, 36, 35, 98, 53, 185, 200, 233, 59,
-			27, 63, 82, 140, 239, 230, 126, 164, 24, 105, 10, 210, 73, 148,
-			112, 250, 110, 241, 13, 197, 72, 145, 17, 97, 202, 72, 37, 35,
-			98, 53, 185, 200, 233, 187, 111, 189, 175, 24, 223, 203, 85, 20,
-			163, 158, 130, 116, 146, 78, 56, 125, 175, 88, 86, 140, 58, 50,
-			34, 76, 25, 117, 201, 136, 88, 77, 166, 156, 190, 247, 230, 219,
-			138, 241, 126, 238, 109, 197, 152, 79, 65, 58, 41, 79, 56, 189,
-			95, 220, 82, 140, 121, 100, 68, 152, 50, 230, 37, 35, 98, 53,
-			185, 200, 233, 253, 55, 64, 49, 110, 231, 126, 168, 24, 11, 41,
-			72, 39, 21, 8, 167, 219, 115, 95, 23, 144, 113, 123, 238, 235,
-			130, 100, 220, 158, 251, 186, 64, 57, 221, 46, 191, 197, 254, 147,
-			48, 162, 231, 56, 253, 40, 119, 167, 252, 239, 4, 234, 48, 18,
-			190, 8, 221, 1, 200, 82, 7, 38, 34, 138, 236, 145, 72, 179,
-			245, 44, 72, 96, 96, 251, 16, 138, 15, 176, 38, 136, 3, 176,
-			47, 2, 215, 1, 71, 12, 93, 95, 102, 170, 100, 234, 97, 222,
-			23, 14, 187, 190, 94, 102, 202, 89, 144, 132, 80, 63, 49, 163,
-			26, 212, 33, 158, 77, 221, 129, 237, 129, 120, 110, 79, 166, 158,
-			0, 55, 66, 62, 89, 106, 196, 96, 71, 50, 225, 132, 226, 231,
-			137, 136, 98, 6, 42, 1, 133, 34, 154, 6, 62, 238, 60, 155,
-			202, 44, 101, 251, 200, 135, 117, 194, 56, 112, 106, 112, 16, 132,
-			224, 250, 81, 108, 251, 3, 145, 21, 14, 88, 10, 185, 3, 1,
-			7, 65, 0, 191, 76, 63, 1, 132, 211, 1, 236, 217, 225, 246,
-			141, 122, 176, 38, 203, 193, 7, 88, 70, 36, 161, 31, 193, 43,
-			198, 159, 166, 52, 47, 48, 7, 141, 5, 124, 209, 105, 183, 100,
-			210, 23, 209, 60, 35, 15, 131, 16, 190, 145, 179, 191, 65, 205,
-			82, 91, 200, 137, 65, 255, 91, 49, 136, 225, 155, 95, 190, 248,
-			166, 198, 24, 99, 84, 71, 191, 124, 84, 122, 173, 95, 144, 219,
-			124, 196, 126, 247, 17, 219, 91, 168, 68, 189, 100, 224, 202, 255,
-			251, 96, 20, 236, 68, 131, 177, 112, 18, 79, 132, 59, 246, 212,
-			93, 64, 23, 15, 175, 128, 42, 134, 151, 230, 31, 202, 191, 175,
-			104, 174, 252, 127, 182, 252, 69, 208, 143, 172, 212, 214, 124, 139,
-			21, 167, 97, 128, 50, 110, 105, 160, 109, 47, 89, 25, 228, 27,
-			172, 48, 72, 194, 40, 8, 183, 136, 28, 80, 136, 191, 193, 150,
-			166, 246, 72, 60, 139, 220, 95, 136, 45, 10, 218, 118, 222, 42,
-			225, 135, 142, 251, 11, 81, 57, 97, 75, 41, 251, 212, 155, 241,
-			10, 211, 191, 13, 250, 209, 150, 6, 116, 123, 249, 209, 237, 218,
-			149, 200, 95, 4, 125, 75, 142, 241, 31, 178, 101, 95, 60, 143,
-			159, 93, 219, 138, 225, 167, 134, 252, 82, 73, 24, 55, 253, 139,
-			32, 173, 46, 231, 98, 191, 207, 138, 223, 6, 253, 103, 161, 24,
-			74, 177, 151, 31, 173, 222, 96, 23, 67, 171, 240, 173, 252, 239,
-			255, 78, 17, 143, 221, 185, 182, 45, 234, 243, 9, 91, 118, 175,
-			190, 41, 181, 214, 23, 54, 190, 90, 97, 45, 206, 252, 195, 74,
-			126, 204, 10, 169, 208, 191, 199, 31, 119, 24, 253, 54, 232, 171,
-			197, 248, 103, 229, 27, 246, 218, 194, 142, 98, 248, 189, 172, 114,
-			143, 189, 118, 37, 226, 51, 215, 145, 196, 212, 186, 117, 245, 209,
-			116, 42, 127, 170, 49, 250, 69, 208, 255, 94, 196, 101, 86, 202,
-			198, 148, 176, 115, 204, 31, 176, 124, 20, 219, 113, 106, 238, 229,
-			71, 119, 175, 179, 116, 112, 200, 74, 103, 160, 215, 166, 54, 22,
-			152, 91, 58, 104, 219, 37, 75, 161, 202, 125, 86, 202, 166, 162,
-			7, 19, 247, 25, 206, 79, 34, 101, 174, 82, 226, 118, 36, 174,
-			252, 154, 48, 118, 101, 30, 254, 255, 216, 237, 5, 125, 175, 52,
-			217, 250, 110, 255, 137, 161, 181, 96, 31, 212, 235, 77, 198, 162,
-			216, 14, 99, 225, 60, 139, 35, 101, 173, 37, 245, 165, 43, 125,
-			140, 183, 98, 52, 78, 199, 169, 28, 103, 217, 167, 110, 196, 223,
-			102, 183, 226, 208, 29, 141, 68, 40, 156, 103, 253, 153, 84, 107,
-			201, 90, 158, 127, 219, 155, 161, 206, 74, 153, 124, 26, 169, 41,
-			226, 107, 44, 63, 116, 125, 219, 219, 42, 72, 83, 164, 128, 223,
-			103, 43, 131, 192, 31, 186, 163, 103, 161, 184, 112, 177, 76, 222,
-			42, 202, 101, 183, 211, 207, 150, 250, 202, 95, 103, 165, 11, 87,
-			92, 62, 75, 66, 111, 171, 148, 6, 21, 226, 94, 232, 61, 250,
-			47, 194, 150, 58, 153, 254, 252, 19, 86, 60, 20, 49, 30, 96,
-			190, 113, 221, 53, 217, 193, 43, 175, 189, 244, 29, 79, 70, 147,
-			221, 62, 20, 241, 194, 129, 225, 111, 126, 167, 89, 231, 52, 111,
-			188, 106, 24, 217, 30, 179, 210, 9, 58, 27, 35, 239, 229, 64,
-			43, 111, 220, 236, 235, 211, 123, 154, 63, 97, 75, 150, 136, 146,
-			201, 247, 93, 247, 152, 149, 234, 253, 32, 140, 191, 231, 178, 6,
-			91, 145, 203, 22, 98, 236, 149, 177, 244, 42, 146, 47, 254, 238,
-			62, 43, 242, 188, 158, 251, 99, 77, 99, 191, 213, 228, 139, 129,
-			158, 227, 143, 126, 163, 93, 107, 254, 31, 62, 145, 153, 167, 217,
-			107, 152, 80, 79, 226, 113, 16, 98, 78, 253, 206, 23, 128, 94,
-			36, 51, 165, 234, 179, 174, 250, 101, 55, 130, 81, 112, 33, 66,
-			95, 56, 144, 248, 142, 106, 255, 234, 83, 123, 128, 196, 238, 64,
-			248, 145, 168, 194, 169, 8, 49, 98, 224, 81, 237, 67, 150, 214,
-			0, 152, 255, 251, 216, 157, 38, 190, 147, 117, 163, 77, 179, 97,
-			180, 58, 6, 12, 93, 79, 212, 24, 91, 98, 132, 230, 56, 45,
-			20, 223, 81, 85, 106, 169, 180, 202, 246, 25, 41, 228, 184, 190,
-			156, 123, 95, 43, 127, 10, 243, 16, 3, 241, 124, 26, 68, 34,
-			130, 105, 210, 247, 220, 129, 76, 231, 82, 92, 177, 48, 71, 229,
-			240, 52, 95, 22, 48, 95, 46, 151, 86, 217, 95, 107, 76, 47,
-			200, 138, 118, 133, 236, 148, 255, 82, 3, 21, 170, 48, 20, 241,
-			96, 44, 34, 176, 61, 15, 48, 167, 64, 100, 199, 110, 52, 156,
-			97, 133, 178, 16, 186, 178, 211, 198, 19, 209, 119, 61, 55, 158,
-			65, 189, 209, 140, 106, 12, 204, 225, 226, 164, 154, 186, 109, 209,
-			94, 170, 43, 21, 14, 244, 147, 244, 233, 34, 27, 116, 2, 17,
-			249, 247, 99, 16, 207, 221, 40, 174, 166, 233, 158, 205, 155, 123,
-			41, 148, 27, 169, 178, 2, 189, 130, 53, 88, 33, 173, 190, 87,
-			10, 175, 101, 136, 112, 186, 114, 251, 245, 12, 81, 78, 87, 222,
-			249, 128, 117, 165, 146, 26, 167, 156, 236, 151, 15, 225, 250, 169,
-			154, 171, 186, 144, 92, 100, 93, 4, 35, 247, 66, 248, 168, 125,
-			21, 38, 129, 124, 148, 24, 8, 63, 134, 161, 27, 70, 241, 124,
-			127, 13, 105, 11, 107, 25, 34, 156, 242, 245, 251, 25, 162, 156,
-			242, 71, 123, 236, 223, 82, 43, 19, 78, 55, 201, 39, 229, 127,
-			214, 32, 59, 137, 112, 233, 122, 30, 76, 67, 113, 129, 196, 118,
-			18, 7, 19, 59, 118, 7, 160, 238, 47, 52, 182, 148, 228, 219,
-			160, 95, 131, 99, 219, 79, 108, 111, 113, 44, 74, 6, 99, 150,
-			22, 122, 97, 144, 140, 198, 105, 132, 162, 251, 209, 210, 49, 114,
-			219, 158, 23, 92, 10, 167, 6, 117, 127, 6, 83, 225, 59, 146,
-			51, 132, 48, 241, 101, 181, 185, 160, 52, 147, 175, 76, 233, 50,
-			241, 92, 12, 146, 24, 215, 205, 69, 69, 255, 128, 31, 196, 99,
-			185, 108, 136, 50, 225, 54, 182, 23, 10, 219, 153, 65, 154, 73,
-			230, 102, 65, 107, 111, 22, 110, 103, 8, 85, 95, 89, 207, 16,
-			229, 116, 19, 30, 179, 115, 105, 21, 202, 105, 153, 124, 90, 110,
-			194, 252, 158, 193, 163, 151, 76, 48, 158, 37, 103, 170, 253, 213,
-			232, 43, 4, 241, 131, 248, 166, 16, 84, 227, 180, 92, 88, 201,
-			16, 225, 180, 124, 103, 35, 67, 184, 239, 219, 79, 216, 111, 137,
-			148, 66, 231, 180, 66, 62, 41, 255, 57, 129, 236, 218, 146, 23,
-			64, 156, 22, 209, 184, 69, 28, 64, 118, 17, 57, 32, 211, 105,
-			21, 108, 156, 139, 98, 216, 48, 72, 194, 80, 248, 177, 183, 104,
-			101, 246, 29, 102, 70, 137, 109, 127, 86, 99, 12, 90, 65, 44,
-			223, 176, 228, 27, 158, 43, 195, 59, 114, 39, 174, 103, 135, 184,
-			217, 141, 123, 16, 196, 243, 129, 152, 198, 233, 244, 27, 99, 76,
-			86, 249, 110, 120, 45, 136, 193, 220, 151, 167, 115, 126, 174, 252,
-			40, 9, 197, 213, 147, 225, 194, 76, 169, 134, 112, 192, 197, 24,
-			24, 196, 137, 237, 121, 51, 240, 236, 24, 15, 248, 60, 149, 202,
-			122, 92, 25, 99, 110, 97, 29, 123, 223, 185, 155, 177, 193, 171,
-			204, 221, 140, 29, 92, 5, 30, 179, 127, 74, 163, 63, 207, 233,
-			3, 98, 148, 255, 94, 123, 73, 51, 185, 125, 180, 120, 224, 22,
-			132, 75, 111, 19, 219, 191, 102, 194, 8, 100, 182, 174, 190, 68,
-			181, 24, 27, 104, 98, 115, 40, 27, 174, 75, 219, 143, 101, 171,
-			133, 211, 193, 190, 122, 27, 27, 39, 215, 156, 83, 149, 205, 147,
-			116, 134, 234, 155, 100, 47, 36, 108, 135, 225, 65, 204, 66, 99,
-			174, 126, 94, 227, 244, 65, 33, 83, 24, 187, 209, 7, 27, 247,
-			50, 68, 57, 125, 80, 107, 48, 38, 123, 67, 189, 154, 123, 164,
-			205, 91, 149, 106, 233, 46, 59, 100, 186, 46, 111, 222, 26, 89,
-			47, 239, 162, 164, 24, 193, 87, 247, 99, 16, 66, 165, 82, 149,
-			23, 176, 186, 34, 163, 251, 233, 85, 140, 199, 116, 225, 30, 124,
-			141, 229, 145, 72, 231, 122, 141, 84, 165, 44, 8, 243, 72, 92,
-			202, 144, 198, 105, 109, 233, 78, 134, 40, 167, 181, 187, 107, 216,
-			254, 234, 242, 90, 220, 33, 107, 138, 69, 211, 185, 190, 67, 106,
-			25, 139, 150, 199, 193, 140, 5, 175, 186, 157, 165, 149, 12, 81,
-			78, 119, 248, 93, 102, 72, 22, 194, 233, 67, 178, 81, 254, 20,
-			230, 213, 63, 122, 233, 234, 80, 160, 110, 46, 54, 169, 19, 225,
-			99, 168, 97, 100, 98, 200, 143, 252, 32, 92, 208, 130, 232, 92,
-			127, 72, 118, 214, 212, 30, 24, 52, 15, 73, 49, 67, 26, 167,
-			15, 75, 171, 25, 162, 156, 62, 92, 91, 151, 246, 213, 184, 254,
-			113, 238, 147, 212, 190, 40, 229, 199, 165, 85, 169, 157, 124, 89,
-			121, 76, 228, 11, 0, 2, 29, 17, 203, 80, 129, 211, 199, 203,
-			183, 51, 164, 113, 250, 120, 229, 110, 134, 40, 167, 143, 55, 54,
-			21, 137, 198, 233, 19, 242, 186, 20, 81, 147, 38, 122, 66, 30,
-			103, 156, 104, 162, 39, 202, 68, 154, 52, 209, 147, 165, 181, 12,
-			81, 78, 159, 108, 110, 73, 17, 9, 215, 63, 203, 253, 56, 21,
-			17, 9, 63, 43, 149, 37, 187, 124, 170, 217, 37, 235, 146, 157,
-			72, 55, 238, 146, 207, 126, 32, 25, 136, 148, 113, 87, 177, 167,
-			175, 56, 187, 202, 141, 68, 202, 184, 171, 220, 40, 109, 243, 84,
-			185, 145, 72, 25, 159, 146, 221, 117, 53, 19, 101, 124, 58, 103,
-			65, 25, 159, 42, 55, 18, 41, 227, 83, 126, 151, 125, 38, 89,
-			8, 167, 159, 147, 141, 114, 117, 193, 141, 142, 24, 218, 137, 23,
-			203, 167, 133, 199, 31, 194, 229, 216, 29, 140, 209, 117, 19, 251,
-			185, 59, 73, 38, 202, 117, 68, 186, 238, 115, 242, 116, 77, 241,
-			162, 235, 62, 87, 174, 35, 82, 188, 207, 149, 235, 136, 116, 221,
-			231, 202, 117, 148, 235, 63, 201, 53, 82, 187, 224, 125, 253, 147,
-			210, 150, 212, 72, 62, 56, 213, 201, 123, 114, 9, 149, 174, 171,
-			43, 215, 81, 105, 150, 250, 242, 122, 134, 52, 78, 235, 27, 111,
-			103, 136, 114, 90, 127, 231, 93, 69, 162, 113, 186, 167, 92, 71,
-			165, 89, 246, 72, 61, 227, 68, 179, 236, 41, 179, 80, 105, 150,
-			61, 229, 58, 42, 205, 178, 183, 185, 197, 118, 24, 209, 117, 174,
-			31, 228, 76, 173, 124, 15, 210, 234, 21, 18, 223, 253, 121, 34,
-			188, 25, 184, 142, 240, 99, 60, 174, 145, 74, 210, 233, 107, 4,
-			222, 138, 7, 165, 219, 82, 4, 249, 204, 117, 168, 252, 171, 75,
-			255, 30, 146, 131, 212, 135, 186, 60, 166, 135, 74, 132, 244, 5,
-			236, 112, 105, 62, 70, 57, 61, 84, 254, 149, 33, 115, 68, 86,
-			21, 11, 42, 114, 68, 14, 215, 213, 76, 84, 228, 104, 206, 130,
-			138, 28, 45, 221, 202, 16, 229, 244, 104, 229, 14, 59, 100, 68,
-			207, 115, 189, 153, 179, 180, 242, 83, 184, 86, 72, 127, 183, 62,
-			215, 110, 220, 121, 21, 146, 42, 136, 247, 94, 179, 180, 46, 69,
-			147, 175, 110, 199, 74, 193, 188, 84, 240, 152, 52, 229, 19, 25,
-			194, 2, 14, 150, 50, 164, 113, 122, 172, 20, 204, 75, 5, 143,
-			239, 174, 177, 191, 213, 36, 141, 198, 233, 151, 164, 92, 254, 43,
-			13, 174, 245, 208, 178, 200, 80, 34, 202, 95, 244, 70, 34, 4,
-			123, 18, 96, 214, 245, 188, 107, 85, 27, 102, 168, 133, 44, 82,
-			99, 112, 20, 92, 138, 11, 17, 98, 150, 21, 33, 86, 237, 137,
-			231, 96, 233, 189, 184, 42, 251, 101, 7, 34, 123, 34, 110, 236,
-			221, 79, 98, 6, 125, 225, 5, 254, 8, 211, 120, 28, 128, 227,
-			14, 135, 2, 47, 53, 121, 23, 171, 232, 207, 75, 143, 124, 73,
-			142, 215, 149, 106, 232, 145, 47, 85, 244, 231, 165, 71, 190, 44,
-			205, 199, 40, 167, 95, 110, 189, 206, 62, 102, 68, 47, 112, 189,
-			151, 251, 153, 86, 222, 6, 89, 217, 136, 104, 224, 246, 197, 226,
-			189, 153, 118, 157, 73, 168, 202, 160, 212, 252, 5, 141, 211, 94,
-			105, 89, 154, 95, 62, 81, 158, 42, 243, 23, 164, 249, 79, 73,
-			79, 214, 195, 8, 11, 56, 88, 202, 144, 198, 233, 169, 50, 127,
-			65, 154, 255, 84, 197, 87, 1, 173, 127, 70, 54, 20, 11, 106,
-			115, 70, 78, 215, 213, 76, 212, 230, 108, 206, 130, 218, 156, 45,
-			173, 102, 136, 114, 122, 182, 182, 174, 88, 8, 167, 231, 115, 89,
-			240, 70, 56, 39, 103, 27, 106, 38, 41, 224, 32, 203, 144, 198,
-			233, 249, 114, 38, 11, 222, 8, 231, 115, 89, 40, 167, 95, 19,
-			174, 88, 168, 206, 245, 175, 201, 121, 38, 11, 205, 227, 96, 33,
-			67, 26, 167, 95, 23, 51, 109, 177, 166, 251, 250, 206, 42, 251,
-			7, 141, 17, 189, 200, 245, 81, 206, 213, 202, 127, 163, 65, 246,
-			182, 33, 13, 28, 46, 90, 88, 154, 93, 22, 115, 88, 70, 7,
-			190, 234, 243, 68, 132, 133, 48, 214, 216, 209, 46, 3, 128, 202,
-			190, 217, 169, 239, 53, 141, 253, 138, 68, 237, 83, 195, 178, 122,
-			173, 20, 156, 200, 95, 74, 210, 191, 45, 163, 107, 157, 155, 173,
-			67, 133, 122, 173, 214, 28, 116, 26, 71, 198, 126, 111, 78, 209,
-			233, 214, 173, 238, 213, 96, 175, 115, 98, 180, 246, 179, 193, 179,
-			186, 153, 142, 73, 111, 23, 53, 78, 71, 165, 59, 210, 54, 69,
-			244, 246, 152, 108, 74, 219, 20, 165, 183, 199, 100, 196, 165, 254,
-			69, 121, 155, 140, 149, 159, 138, 210, 219, 227, 165, 249, 24, 229,
-			116, 188, 190, 129, 61, 164, 94, 226, 121, 15, 187, 227, 242, 39,
-			176, 88, 59, 205, 141, 51, 13, 131, 169, 8, 99, 55, 253, 165,
-			18, 173, 130, 53, 89, 218, 19, 164, 63, 28, 162, 92, 37, 141,
-			83, 175, 196, 165, 92, 37, 148, 107, 66, 238, 73, 185, 74, 82,
-			174, 9, 241, 210, 203, 180, 36, 163, 112, 66, 86, 50, 164, 113,
-			58, 185, 243, 86, 134, 40, 167, 147, 183, 43, 108, 87, 178, 104,
-			156, 78, 201, 102, 249, 3, 144, 111, 64, 207, 98, 89, 20, 39,
-			190, 251, 28, 98, 119, 34, 162, 216, 158, 76, 177, 97, 158, 184,
-			131, 48, 136, 196, 32, 240, 157, 236, 8, 150, 100, 208, 78, 201,
-			228, 158, 34, 198, 160, 157, 170, 35, 88, 146, 65, 59, 45, 241,
-			12, 81, 78, 167, 235, 27, 236, 43, 185, 41, 225, 52, 36, 91,
-			229, 159, 194, 194, 91, 211, 255, 96, 95, 232, 136, 24, 2, 31,
-			175, 205, 97, 90, 143, 202, 7, 248, 48, 17, 115, 145, 240, 4,
-			132, 100, 186, 169, 182, 197, 156, 24, 206, 69, 66, 101, 195, 210,
-			221, 12, 81, 78, 195, 141, 77, 246, 103, 154, 148, 137, 114, 154,
-			144, 114, 249, 79, 52, 88, 124, 223, 146, 119, 161, 175, 174, 233,
-			120, 6, 219, 149, 63, 114, 125, 103, 247, 194, 246, 18, 81, 121,
-			112, 149, 155, 23, 170, 200, 84, 62, 182, 120, 153, 95, 98, 199,
-			56, 47, 235, 251, 105, 113, 38, 47, 192, 155, 175, 5, 224, 198,
-			145, 240, 134, 115, 125, 240, 44, 38, 36, 220, 82, 50, 227, 89,
-			76, 84, 188, 149, 228, 89, 76, 150, 214, 51, 132, 26, 108, 189,
-			206, 222, 147, 234, 232, 156, 94, 146, 181, 242, 235, 208, 76, 187,
-			138, 244, 13, 110, 49, 173, 164, 27, 96, 174, 189, 36, 73, 89,
-			145, 232, 121, 92, 151, 109, 128, 105, 245, 114, 41, 11, 35, 108,
-			47, 46, 249, 93, 102, 201, 13, 242, 156, 206, 200, 106, 217, 192,
-			58, 26, 93, 160, 254, 109, 192, 130, 214, 11, 65, 141, 5, 116,
-			234, 48, 44, 67, 47, 3, 108, 143, 250, 2, 210, 127, 41, 226,
-			204, 133, 201, 235, 92, 159, 145, 203, 44, 138, 243, 114, 143, 66,
-			134, 52, 78, 103, 197, 91, 25, 162, 156, 206, 86, 238, 176, 64,
-			10, 83, 224, 244, 5, 121, 179, 220, 135, 27, 79, 137, 48, 117,
-			253, 40, 171, 230, 119, 240, 76, 165, 19, 224, 66, 61, 17, 217,
-			131, 65, 16, 58, 42, 211, 164, 222, 68, 53, 94, 242, 222, 32,
-			20, 118, 188, 32, 105, 65, 231, 250, 11, 50, 91, 85, 210, 20,
-			242, 40, 64, 102, 54, 204, 22, 47, 150, 50, 159, 21, 40, 167,
-			47, 222, 248, 1, 251, 153, 148, 180, 200, 245, 95, 105, 100, 163,
-			124, 12, 217, 99, 38, 76, 3, 215, 79, 171, 189, 113, 50, 145,
-			63, 84, 217, 142, 221, 247, 132, 44, 9, 175, 165, 216, 27, 77,
-			237, 133, 237, 122, 56, 177, 198, 216, 109, 185, 85, 81, 231, 249,
-			95, 105, 228, 197, 155, 74, 202, 98, 94, 238, 86, 202, 160, 134,
-			112, 105, 53, 131, 20, 225, 218, 122, 246, 27, 206, 127, 7, 0,
-			0, 255, 255, 78, 188, 223, 29, 170, 36, 0, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 164, 90, 77, 108, 28, 71,
+			118, 158, 234, 234, 25, 206, 212, 72, 34, 89, 252, 209, 104, 180,
+			182, 159, 198, 187, 17, 185, 161, 134, 90, 202, 150, 100, 201, 176,
+			76, 137, 20, 77, 91, 166, 232, 33, 101, 217, 137, 13, 166, 167,
+			167, 102, 166, 229, 158, 174, 217, 238, 106, 146, 227, 172, 128, 36,
+			64, 178, 155, 67, 128, 93, 108, 2, 56, 88, 192, 193, 26, 14,
+			2, 108, 16, 228, 180, 135, 100, 47, 1, 18, 32, 167, 228, 144,
+			67, 128, 0, 123, 15, 144, 67, 206, 57, 228, 16, 188, 234, 170,
+			153, 166, 100, 57, 171, 68, 7, 123, 190, 250, 121, 245, 222, 247,
+			94, 189, 122, 85, 77, 246, 187, 140, 157, 239, 73, 217, 11, 197,
+			234, 48, 150, 74, 182, 211, 238, 170, 24, 12, 213, 168, 169, 33,
+			159, 206, 58, 155, 182, 179, 49, 197, 138, 155, 216, 127, 251, 49,
+			155, 243, 229, 160, 249, 68, 255, 109, 166, 123, 119, 17, 238, 146,
+			223, 176, 221, 61, 25, 122, 81, 175, 41, 227, 222, 100, 25, 53,
+			26, 138, 100, 245, 147, 72, 30, 69, 217, 146, 195, 246, 127, 17,
+			242, 133, 67, 183, 118, 111, 255, 204, 121, 113, 43, 155, 185, 107,
+			134, 55, 31, 138, 48, 124, 7, 7, 239, 227, 188, 183, 255, 174,
+			204, 74, 220, 61, 83, 104, 204, 176, 127, 118, 25, 57, 197, 233,
+			153, 2, 95, 251, 123, 23, 238, 200, 225, 40, 14, 122, 125, 5,
+			107, 151, 215, 46, 95, 90, 187, 188, 246, 10, 220, 78, 187, 176,
+			47, 252, 126, 36, 67, 217, 11, 68, 178, 2, 219, 145, 223, 100,
+			12, 238, 5, 190, 136, 18, 209, 129, 52, 234, 136, 24, 84, 95,
+			192, 250, 208, 243, 251, 194, 246, 172, 192, 251, 34, 78, 2, 25,
+			193, 90, 243, 50, 44, 225, 128, 134, 233, 106, 44, 223, 100, 48,
+			146, 41, 12, 188, 17, 68, 82, 65, 154, 8, 80, 253, 32, 129,
+			110, 16, 10, 16, 199, 190, 24, 42, 8, 34, 240, 229, 96, 24,
+			6, 94, 228, 11, 56, 10, 84, 31, 212, 68, 124, 147, 193, 135,
+			70, 130, 108, 43, 47, 136, 192, 3, 95, 14, 71, 32, 187, 249,
+			97, 224, 41, 198, 64, 255, 235, 43, 53, 188, 177, 186, 122, 116,
+			116, 212, 244, 180, 166, 154, 212, 48, 27, 151, 172, 222, 219, 190,
+			179, 185, 179, 183, 121, 105, 173, 121, 153, 49, 120, 16, 133, 34,
+			73, 32, 22, 223, 77, 131, 88, 116, 160, 61, 2, 111, 56, 12,
+			3, 223, 107, 135, 2, 66, 239, 8, 100, 12, 94, 47, 22, 162,
+			3, 74, 162, 174, 71, 113, 160, 130, 168, 183, 2, 137, 236, 170,
+			35, 47, 22, 12, 58, 65, 162, 226, 160, 157, 170, 19, 52, 89,
+			205, 130, 228, 196, 0, 25, 129, 23, 65, 99, 125, 15, 182, 247,
+			26, 112, 123, 125, 111, 123, 111, 133, 193, 195, 237, 253, 183, 238,
+			63, 216, 135, 135, 235, 173, 214, 250, 206, 254, 246, 230, 30, 220,
+			111, 193, 157, 251, 59, 27, 219, 251, 219, 247, 119, 246, 224, 254,
+			93, 88, 223, 249, 16, 222, 217, 222, 217, 88, 1, 17, 168, 190,
+			136, 65, 28, 15, 99, 212, 94, 198, 16, 32, 129, 162, 211, 100,
+			176, 39, 196, 137, 229, 187, 50, 243, 90, 50, 20, 126, 208, 13,
+			124, 192, 56, 75, 189, 158, 128, 158, 60, 20, 113, 20, 68, 61,
+			24, 138, 120, 16, 36, 232, 196, 4, 188, 168, 195, 32, 12, 6,
+			129, 242, 148, 110, 120, 202, 162, 38, 99, 101, 70, 28, 78, 103,
+			10, 53, 252, 85, 230, 148, 23, 54, 89, 133, 57, 229, 106, 246,
+			51, 107, 156, 43, 172, 232, 70, 146, 253, 204, 26, 231, 11, 191,
+			174, 27, 205, 207, 172, 113, 161, 208, 208, 141, 44, 251, 153, 53,
+			46, 22, 46, 232, 198, 111, 102, 63, 179, 198, 179, 133, 155, 186,
+			241, 91, 217, 207, 172, 177, 86, 120, 73, 55, 190, 148, 253, 252,
+			133, 195, 28, 183, 192, 105, 163, 48, 83, 255, 107, 7, 214, 161,
+			39, 34, 17, 7, 62, 232, 61, 4, 3, 145, 36, 104, 190, 234,
+			123, 74, 71, 167, 239, 69, 16, 139, 75, 58, 56, 37, 120, 135,
+			50, 232, 64, 71, 116, 3, 77, 77, 39, 213, 209, 160, 68, 135,
+			157, 156, 159, 96, 48, 140, 100, 26, 195, 250, 238, 118, 210, 132,
+			117, 80, 163, 97, 224, 123, 33, 136, 99, 111, 48, 12, 181, 227,
+			149, 212, 49, 31, 40, 240, 18, 237, 5, 12, 52, 145, 40, 6,
+			198, 43, 177, 72, 134, 18, 221, 132, 123, 29, 99, 218, 139, 80,
+			30, 12, 132, 234, 203, 78, 19, 238, 162, 111, 163, 68, 225, 222,
+			184, 97, 34, 60, 17, 241, 97, 224, 11, 184, 43, 37, 252, 182,
+			9, 122, 136, 135, 62, 220, 246, 226, 165, 39, 178, 77, 83, 39,
+			155, 101, 136, 133, 74, 227, 40, 129, 103, 244, 223, 204, 196, 60,
+			102, 248, 143, 186, 5, 194, 105, 163, 124, 186, 93, 210, 195, 174,
+			176, 127, 189, 197, 110, 247, 2, 213, 79, 219, 77, 95, 14, 86,
+			195, 212, 15, 244, 127, 46, 245, 228, 106, 226, 247, 69, 39, 13,
+			69, 188, 234, 13, 131, 28, 58, 252, 206, 4, 152, 84, 89, 25,
+			55, 212, 191, 46, 165, 54, 62, 98, 213, 183, 101, 59, 105, 101,
+			92, 241, 26, 155, 26, 198, 242, 145, 240, 85, 141, 0, 89, 170,
+			180, 44, 228, 139, 172, 228, 167, 113, 34, 227, 154, 163, 59, 12,
+			226, 231, 89, 101, 232, 245, 196, 65, 18, 124, 42, 106, 20, 200,
+			82, 177, 85, 198, 134, 189, 224, 83, 209, 216, 101, 149, 76, 250,
+			48, 28, 241, 6, 115, 31, 201, 118, 82, 35, 64, 151, 170, 107,
+			103, 154, 19, 149, 223, 150, 237, 150, 238, 227, 47, 177, 106, 36,
+			142, 213, 193, 137, 165, 24, 54, 221, 209, 45, 141, 31, 17, 198,
+			183, 163, 67, 233, 103, 59, 198, 234, 253, 109, 54, 245, 72, 182,
+			15, 98, 209, 213, 122, 87, 215, 102, 159, 16, 47, 186, 173, 210,
+			35, 253, 255, 255, 147, 37, 188, 206, 202, 137, 242, 84, 154, 136,
+			164, 230, 2, 93, 170, 180, 198, 184, 17, 178, 153, 19, 42, 161,
+			177, 215, 88, 53, 152, 180, 25, 155, 23, 114, 74, 77, 102, 180,
+			242, 35, 255, 119, 6, 94, 97, 37, 228, 75, 116, 191, 198, 89,
+			51, 140, 62, 146, 109, 51, 25, 127, 54, 126, 139, 157, 206, 173,
+			40, 186, 207, 197, 216, 203, 236, 244, 68, 197, 131, 160, 163, 5,
+			211, 214, 169, 73, 227, 118, 167, 241, 67, 194, 232, 219, 178, 253,
+			92, 130, 145, 85, 211, 103, 148, 29, 99, 190, 204, 138, 200, 112,
+			230, 138, 234, 218, 220, 73, 41, 123, 216, 213, 202, 70, 160, 71,
+			135, 94, 154, 136, 78, 205, 5, 178, 84, 110, 25, 212, 184, 200,
+			202, 118, 40, 122, 55, 13, 14, 112, 124, 154, 24, 186, 202, 105,
+			128, 98, 210, 164, 241, 133, 195, 216, 132, 30, 126, 139, 157, 201,
+			217, 59, 177, 164, 150, 211, 225, 4, 155, 173, 28, 63, 24, 98,
+			47, 48, 150, 40, 47, 86, 162, 115, 160, 18, 195, 86, 197, 180,
+			236, 107, 31, 99, 202, 75, 250, 89, 63, 213, 253, 204, 54, 237,
+			39, 252, 2, 59, 165, 226, 160, 215, 19, 177, 232, 28, 180, 71,
+			218, 172, 74, 171, 58, 110, 187, 61, 66, 155, 141, 49, 69, 221,
+			105, 16, 159, 103, 197, 110, 16, 121, 97, 173, 164, 169, 200, 0,
+			191, 200, 166, 125, 25, 117, 131, 222, 65, 44, 14, 3, 60, 125,
+			106, 83, 122, 218, 153, 172, 185, 101, 90, 249, 57, 86, 62, 12,
+			196, 209, 65, 26, 135, 181, 178, 30, 49, 133, 248, 65, 28, 54,
+			218, 108, 118, 63, 83, 64, 123, 241, 249, 55, 222, 147, 86, 57,
+			79, 89, 213, 120, 157, 77, 231, 215, 192, 157, 180, 204, 102, 114,
+			206, 136, 100, 228, 11, 189, 20, 109, 77, 79, 218, 119, 176, 185,
+			49, 96, 167, 55, 68, 59, 237, 221, 147, 189, 108, 238, 255, 219,
+			145, 231, 89, 165, 131, 18, 15, 66, 217, 179, 17, 218, 49, 75,
+			172, 253, 192, 101, 149, 61, 43, 135, 95, 99, 83, 91, 66, 97,
+			186, 227, 139, 39, 57, 176, 89, 170, 62, 255, 84, 59, 42, 121,
+			143, 157, 217, 18, 106, 162, 70, 194, 95, 248, 74, 245, 198, 98,
+			206, 63, 171, 27, 165, 189, 202, 202, 187, 24, 253, 184, 21, 159,
+			246, 69, 125, 241, 201, 26, 57, 59, 149, 248, 85, 86, 105, 137,
+			36, 29, 60, 239, 188, 87, 89, 121, 189, 45, 99, 245, 156, 211,
+			238, 176, 105, 61, 109, 162, 62, 127, 166, 79, 158, 41, 228, 46,
+			99, 147, 96, 225, 223, 200, 205, 207, 199, 144, 142, 211, 122, 253,
+			25, 189, 72, 217, 58, 171, 110, 9, 101, 35, 231, 107, 20, 201,
+			247, 156, 8, 180, 183, 63, 187, 204, 166, 120, 209, 45, 252, 57,
+			33, 236, 231, 68, 223, 0, 220, 2, 95, 251, 25, 57, 113, 3,
+			248, 206, 85, 216, 199, 106, 245, 193, 157, 109, 88, 79, 85, 95,
+			198, 88, 204, 132, 33, 232, 43, 2, 150, 198, 88, 111, 232, 210,
+			242, 65, 162, 75, 20, 93, 193, 39, 50, 141, 125, 1, 190, 236,
+			232, 66, 39, 171, 37, 127, 213, 107, 2, 203, 138, 47, 44, 188,
+			218, 88, 161, 166, 81, 7, 171, 41, 44, 144, 76, 121, 174, 111,
+			8, 77, 134, 101, 29, 45, 112, 90, 42, 124, 203, 212, 156, 229,
+			2, 103, 27, 204, 41, 21, 184, 91, 45, 188, 66, 234, 215, 97,
+			28, 237, 88, 15, 203, 68, 36, 48, 76, 219, 97, 224, 235, 58,
+			202, 220, 18, 38, 99, 76, 241, 212, 212, 133, 78, 9, 11, 157,
+			106, 121, 150, 253, 13, 97, 110, 169, 224, 20, 56, 157, 118, 86,
+			235, 127, 73, 192, 236, 26, 232, 10, 229, 247, 69, 2, 94, 24,
+			2, 22, 3, 144, 120, 42, 72, 186, 35, 44, 13, 115, 187, 8,
+			171, 102, 192, 108, 213, 14, 194, 64, 141, 96, 253, 206, 189, 164,
+			201, 96, 187, 155, 31, 212, 52, 39, 33, 242, 101, 170, 113, 188,
+			113, 164, 74, 171, 104, 59, 59, 82, 36, 209, 69, 5, 226, 56,
+			72, 212, 74, 86, 115, 98, 65, 158, 40, 228, 30, 197, 225, 252,
+			172, 158, 67, 175, 176, 83, 172, 136, 170, 19, 78, 167, 75, 167,
+			45, 114, 56, 157, 62, 115, 206, 34, 202, 233, 244, 55, 47, 177,
+			125, 109, 36, 225, 148, 59, 27, 245, 45, 56, 185, 193, 199, 166,
+			78, 146, 77, 130, 43, 122, 208, 11, 14, 69, 132, 214, 175, 192,
+			64, 38, 10, 98, 225, 139, 72, 65, 55, 136, 19, 53, 94, 159,
+			160, 216, 210, 188, 69, 14, 167, 124, 225, 162, 69, 148, 83, 190,
+			118, 155, 253, 71, 198, 178, 195, 233, 89, 231, 90, 253, 151, 4,
+			108, 82, 128, 163, 32, 12, 97, 24, 139, 67, 20, 236, 165, 74,
+			14, 60, 21, 248, 96, 178, 48, 146, 173, 53, 121, 36, 219, 77,
+			120, 215, 139, 82, 47, 204, 247, 37, 169, 223, 103, 89, 133, 29,
+			203, 180, 135, 247, 199, 32, 209, 238, 71, 166, 21, 202, 246, 194,
+			80, 30, 137, 78, 19, 214, 163, 17, 12, 69, 212, 193, 121, 50,
+			134, 56, 141, 116, 153, 159, 51, 154, 129, 23, 11, 51, 77, 28,
+			11, 31, 111, 109, 205, 137, 170, 232, 31, 188, 204, 246, 245, 180,
+			46, 242, 130, 14, 241, 194, 88, 120, 157, 17, 100, 167, 252, 152,
+			22, 135, 112, 122, 182, 116, 198, 34, 52, 125, 122, 193, 34, 202,
+			233, 89, 120, 149, 125, 168, 89, 161, 156, 214, 157, 235, 245, 123,
+			48, 78, 121, 184, 245, 210, 1, 198, 179, 150, 137, 43, 53, 115,
+			189, 207, 80, 4, 239, 217, 79, 40, 65, 9, 167, 245, 210, 180,
+			69, 14, 167, 245, 153, 69, 139, 112, 221, 11, 87, 217, 207, 29,
+			173, 133, 203, 105, 195, 185, 86, 255, 11, 7, 108, 6, 69, 45,
+			132, 66, 110, 5, 106, 128, 183, 96, 155, 112, 58, 128, 231, 187,
+			88, 1, 15, 199, 34, 31, 30, 248, 105, 28, 139, 72, 133, 121,
+			150, 217, 87, 208, 140, 26, 123, 209, 8, 159, 24, 118, 164, 18,
+			43, 89, 70, 208, 142, 67, 167, 5, 131, 32, 244, 98, 92, 236,
+			137, 148, 108, 95, 13, 244, 240, 39, 250, 152, 189, 199, 231, 131,
+			24, 182, 55, 244, 238, 28, 239, 171, 40, 73, 99, 115, 251, 67,
+			155, 114, 35, 181, 25, 162, 3, 65, 194, 192, 243, 85, 234, 133,
+			225, 8, 66, 79, 225, 6, 55, 225, 38, 58, 227, 11, 53, 186,
+			195, 50, 236, 226, 133, 105, 236, 102, 215, 225, 180, 49, 118, 179,
+			75, 57, 109, 192, 171, 236, 95, 178, 232, 47, 114, 186, 236, 108,
+			214, 255, 129, 60, 101, 153, 94, 62, 201, 111, 184, 156, 114, 89,
+			54, 241, 162, 188, 190, 250, 33, 37, 242, 194, 149, 167, 68, 229,
+			99, 3, 41, 222, 238, 234, 155, 238, 145, 23, 41, 228, 84, 175,
+			4, 222, 228, 77, 160, 159, 158, 112, 206, 202, 228, 165, 198, 92,
+			88, 245, 37, 84, 120, 29, 134, 41, 193, 134, 198, 216, 252, 34,
+			225, 116, 185, 100, 13, 46, 58, 156, 46, 47, 190, 108, 17, 229,
+			116, 185, 121, 135, 253, 123, 102, 126, 137, 211, 203, 206, 27, 245,
+			127, 35, 48, 57, 241, 32, 244, 210, 72, 231, 30, 15, 34, 113,
+			148, 55, 209, 110, 251, 236, 52, 130, 72, 30, 173, 64, 208, 139,
+			100, 140, 145, 21, 168, 132, 141, 131, 177, 9, 15, 101, 252, 73,
+			162, 221, 51, 217, 48, 9, 40, 41, 145, 129, 187, 94, 16, 38,
+			217, 235, 210, 221, 245, 237, 123, 155, 27, 7, 187, 173, 205, 241,
+			251, 10, 70, 163, 13, 240, 220, 102, 182, 97, 139, 241, 227, 123,
+			209, 69, 197, 160, 239, 29, 10, 24, 200, 24, 143, 190, 200, 4,
+			123, 78, 225, 100, 204, 73, 137, 112, 122, 185, 52, 99, 145, 195,
+			233, 229, 217, 134, 69, 148, 211, 203, 151, 94, 103, 91, 154, 146,
+			41, 78, 175, 56, 175, 213, 111, 64, 238, 192, 31, 95, 216, 81,
+			43, 93, 220, 65, 40, 77, 22, 204, 242, 241, 100, 205, 241, 146,
+			83, 132, 211, 43, 165, 89, 139, 28, 78, 175, 240, 151, 44, 162,
+			156, 94, 249, 246, 53, 198, 244, 219, 136, 123, 181, 240, 58, 25,
+			95, 245, 175, 150, 231, 80, 21, 87, 31, 128, 215, 157, 133, 250,
+			13, 140, 53, 76, 36, 147, 99, 74, 198, 208, 104, 172, 232, 115,
+			208, 156, 84, 201, 197, 140, 96, 204, 150, 39, 143, 35, 20, 84,
+			228, 244, 186, 83, 182, 136, 112, 122, 189, 50, 99, 17, 229, 244,
+			250, 220, 60, 171, 234, 37, 9, 167, 175, 57, 243, 166, 139, 20,
+			17, 217, 105, 120, 166, 188, 86, 153, 182, 136, 114, 250, 26, 159,
+			99, 155, 122, 154, 195, 233, 77, 103, 177, 126, 29, 198, 215, 99,
+			204, 194, 147, 236, 131, 218, 227, 163, 152, 24, 136, 72, 137, 142,
+			78, 1, 152, 91, 48, 118, 114, 122, 58, 69, 148, 51, 101, 17,
+			225, 244, 102, 121, 214, 34, 202, 233, 205, 249, 5, 77, 25, 225,
+			238, 27, 133, 245, 140, 50, 84, 235, 141, 242, 172, 214, 159, 32,
+			101, 183, 156, 154, 158, 66, 156, 130, 139, 136, 89, 84, 226, 244,
+			86, 245, 140, 69, 132, 211, 91, 211, 115, 22, 81, 78, 111, 45,
+			158, 53, 66, 8, 167, 111, 58, 231, 140, 16, 36, 225, 77, 67,
+			2, 113, 112, 181, 55, 43, 243, 22, 81, 78, 223, 60, 91, 211,
+			58, 57, 220, 189, 83, 216, 201, 116, 194, 115, 230, 78, 185, 174,
+			197, 57, 168, 211, 134, 163, 183, 163, 235, 56, 133, 18, 162, 178,
+			69, 132, 211, 13, 227, 10, 71, 107, 177, 97, 92, 161, 173, 223,
+			52, 174, 112, 180, 22, 155, 227, 105, 168, 197, 166, 113, 133, 163,
+			15, 244, 77, 62, 199, 94, 211, 211, 28, 78, 183, 156, 197, 250,
+			74, 206, 21, 29, 209, 245, 210, 16, 79, 13, 9, 175, 94, 134,
+			163, 126, 224, 247, 209, 63, 3, 239, 56, 24, 164, 3, 67, 191,
+			163, 233, 223, 50, 244, 59, 90, 129, 45, 67, 191, 163, 143, 199,
+			173, 249, 5, 246, 223, 68, 175, 66, 57, 125, 215, 121, 169, 254,
+			159, 4, 99, 83, 111, 129, 21, 144, 81, 56, 202, 111, 190, 108,
+			127, 203, 200, 148, 169, 34, 193, 115, 28, 239, 163, 226, 100, 152,
+			194, 82, 34, 4, 131, 73, 194, 108, 102, 195, 178, 228, 33, 147,
+			36, 192, 215, 223, 67, 47, 76, 69, 178, 140, 217, 227, 97, 95,
+			68, 88, 146, 42, 93, 112, 172, 152, 167, 187, 97, 56, 210, 15,
+			211, 190, 140, 244, 203, 116, 87, 28, 233, 218, 215, 139, 242, 81,
+			57, 81, 143, 193, 18, 86, 56, 240, 169, 136, 229, 178, 142, 200,
+			172, 202, 208, 57, 37, 146, 209, 37, 93, 236, 65, 238, 169, 101,
+			76, 20, 117, 209, 252, 49, 42, 114, 250, 110, 213, 18, 133, 199,
+			251, 187, 188, 110, 17, 18, 245, 194, 139, 58, 66, 40, 119, 119,
+			11, 123, 89, 132, 224, 168, 221, 114, 77, 187, 154, 98, 132, 188,
+			231, 252, 154, 158, 66, 117, 212, 190, 103, 132, 83, 29, 47, 239,
+			85, 23, 44, 34, 156, 190, 183, 120, 193, 34, 202, 233, 123, 223,
+			252, 150, 17, 66, 56, 109, 153, 168, 165, 58, 94, 90, 38, 94,
+			168, 142, 218, 150, 137, 90, 170, 227, 165, 117, 182, 198, 86, 153,
+			227, 186, 220, 125, 191, 240, 33, 169, 191, 140, 165, 113, 75, 116,
+			33, 141, 130, 239, 166, 2, 29, 217, 17, 145, 194, 108, 147, 152,
+			82, 79, 215, 232, 46, 158, 173, 239, 151, 207, 232, 53, 93, 84,
+			252, 161, 9, 109, 87, 103, 153, 135, 102, 77, 87, 103, 153, 135,
+			38, 180, 93, 173, 234, 67, 19, 218, 46, 70, 214, 7, 206, 172,
+			233, 66, 85, 63, 24, 79, 67, 85, 63, 168, 156, 178, 136, 114,
+			250, 193, 244, 12, 219, 98, 142, 91, 228, 238, 71, 133, 54, 169,
+			223, 204, 69, 202, 51, 53, 142, 190, 234, 216, 50, 38, 20, 9,
+			167, 31, 149, 23, 180, 46, 69, 52, 225, 99, 99, 66, 81, 179,
+			253, 177, 209, 165, 168, 77, 248, 216, 152, 80, 212, 38, 124, 60,
+			55, 207, 126, 129, 59, 160, 136, 54, 120, 78, 189, 254, 87, 36,
+			183, 210, 65, 128, 229, 10, 120, 70, 39, 8, 34, 37, 122, 34,
+			6, 111, 32, 177, 28, 11, 195, 220, 216, 236, 108, 204, 149, 23,
+			77, 6, 111, 201, 35, 113, 40, 98, 29, 209, 250, 76, 75, 195,
+			14, 180, 79, 68, 237, 228, 147, 76, 226, 13, 242, 61, 184, 118,
+			59, 85, 12, 218, 34, 148, 81, 15, 15, 74, 37, 161, 19, 116,
+			187, 2, 75, 64, 125, 58, 152, 0, 46, 234, 240, 240, 204, 78,
+			47, 106, 206, 189, 178, 229, 0, 195, 195, 171, 157, 99, 175, 48,
+			199, 45, 113, 87, 20, 62, 33, 245, 37, 12, 15, 232, 136, 196,
+			15, 218, 34, 159, 216, 179, 183, 161, 52, 54, 5, 113, 70, 48,
+			30, 182, 162, 92, 213, 4, 151, 144, 224, 174, 33, 184, 164, 9,
+			238, 26, 130, 75, 154, 224, 174, 33, 184, 164, 9, 238, 154, 24,
+			41, 33, 191, 61, 103, 209, 116, 161, 190, 189, 241, 52, 212, 183,
+			87, 153, 181, 136, 114, 218, 155, 95, 48, 211, 28, 78, 131, 241,
+			106, 78, 9, 17, 179, 136, 112, 26, 84, 237, 106, 152, 208, 130,
+			241, 106, 148, 211, 71, 14, 55, 93, 180, 136, 168, 100, 17, 225,
+			244, 209, 212, 105, 139, 112, 228, 204, 44, 251, 71, 194, 28, 119,
+			138, 187, 71, 133, 17, 169, 255, 45, 1, 251, 138, 168, 73, 138,
+			243, 44, 97, 151, 78, 124, 2, 47, 69, 79, 164, 67, 76, 96,
+			201, 13, 124, 239, 111, 108, 108, 239, 173, 223, 190, 183, 185, 209,
+			208, 232, 254, 251, 155, 173, 214, 131, 157, 12, 236, 174, 63, 216,
+			179, 29, 173, 205, 253, 214, 135, 219, 59, 91, 6, 61, 216, 217,
+			25, 131, 189, 59, 111, 109, 110, 60, 24, 139, 216, 219, 95, 111,
+			237, 79, 58, 31, 236, 237, 110, 238, 108, 216, 206, 135, 235, 219,
+			89, 159, 246, 24, 214, 42, 71, 229, 25, 77, 198, 20, 122, 236,
+			216, 57, 171, 13, 158, 210, 187, 250, 216, 80, 63, 165, 61, 118,
+			92, 225, 22, 81, 78, 143, 23, 22, 241, 9, 192, 45, 243, 226,
+			247, 10, 63, 34, 164, 126, 45, 183, 63, 115, 108, 12, 99, 57,
+			20, 177, 194, 221, 41, 187, 154, 6, 44, 239, 178, 43, 157, 174,
+			168, 181, 34, 101, 194, 233, 247, 202, 92, 43, 82, 70, 69, 30,
+			59, 186, 116, 117, 203, 58, 116, 30, 59, 211, 22, 17, 78, 31,
+			207, 188, 104, 17, 229, 244, 241, 133, 6, 187, 169, 167, 17, 238,
+			254, 14, 113, 206, 214, 47, 33, 237, 177, 58, 80, 9, 238, 202,
+			52, 10, 142, 65, 5, 3, 145, 40, 111, 48, 196, 23, 142, 65,
+			224, 199, 50, 17, 190, 140, 58, 88, 41, 158, 214, 162, 72, 81,
+			207, 158, 178, 80, 11, 43, 115, 11, 41, 194, 133, 69, 188, 38,
+			186, 101, 199, 225, 238, 239, 17, 167, 86, 127, 7, 114, 175, 181,
+			191, 194, 98, 176, 39, 148, 57, 47, 187, 56, 213, 11, 113, 146,
+			138, 83, 49, 214, 195, 41, 106, 217, 86, 15, 135, 32, 44, 207,
+			89, 72, 17, 46, 158, 101, 127, 130, 217, 168, 236, 80, 238, 254,
+			62, 113, 234, 245, 63, 32, 147, 187, 209, 65, 123, 132, 82, 241,
+			150, 162, 51, 163, 26, 193, 82, 227, 147, 32, 234, 220, 208, 103,
+			105, 99, 121, 82, 9, 228, 42, 203, 76, 41, 150, 203, 44, 112,
+			228, 37, 19, 169, 248, 81, 22, 203, 57, 157, 130, 158, 124, 200,
+			129, 64, 37, 34, 236, 142, 141, 160, 69, 173, 87, 217, 66, 130,
+			176, 178, 96, 161, 214, 186, 118, 142, 93, 212, 54, 184, 220, 253,
+			62, 113, 230, 235, 231, 224, 94, 118, 203, 51, 101, 64, 46, 125,
+			103, 243, 240, 40, 248, 254, 68, 170, 75, 16, 86, 166, 45, 164,
+			8, 249, 28, 219, 211, 82, 139, 220, 253, 67, 226, 204, 214, 55,
+			177, 82, 65, 134, 87, 204, 189, 118, 98, 95, 46, 52, 177, 124,
+			206, 252, 129, 5, 193, 145, 196, 59, 106, 91, 128, 223, 247, 162,
+			158, 46, 84, 179, 53, 138, 153, 212, 146, 89, 178, 72, 16, 78,
+			157, 178, 144, 34, 156, 158, 97, 67, 173, 65, 137, 187, 127, 68,
+			156, 23, 234, 109, 120, 226, 129, 29, 134, 65, 148, 216, 2, 126,
+			21, 183, 67, 54, 0, 14, 205, 227, 156, 231, 251, 50, 238, 152,
+			84, 158, 57, 11, 117, 127, 202, 57, 126, 44, 60, 149, 83, 175,
+			84, 212, 75, 90, 130, 74, 4, 97, 165, 102, 33, 69, 120, 254,
+			27, 236, 55, 181, 122, 83, 220, 253, 33, 113, 22, 235, 239, 130,
+			125, 214, 135, 161, 12, 162, 172, 92, 236, 167, 3, 253, 61, 214,
+			235, 232, 79, 240, 88, 72, 157, 56, 183, 114, 138, 4, 93, 240,
+			14, 189, 32, 196, 129, 99, 77, 166, 138, 90, 186, 213, 100, 138,
+			32, 172, 204, 90, 72, 17, 206, 99, 186, 118, 220, 10, 47, 253,
+			152, 20, 254, 148, 16, 86, 101, 212, 173, 16, 238, 254, 152, 148,
+			177, 158, 113, 221, 138, 83, 224, 238, 31, 19, 39, 11, 156, 10,
+			166, 2, 132, 101, 11, 9, 246, 86, 102, 44, 164, 8, 231, 230,
+			217, 47, 113, 111, 84, 112, 231, 124, 134, 123, 227, 159, 158, 107,
+			111, 40, 137, 79, 107, 50, 238, 216, 143, 197, 65, 20, 168, 192,
+			83, 50, 6, 217, 101, 166, 197, 26, 223, 132, 125, 41, 67, 28,
+			231, 41, 124, 172, 0, 137, 111, 169, 125, 47, 236, 98, 241, 145,
+			38, 34, 78, 192, 247, 34, 251, 249, 89, 73, 24, 102, 15, 141,
+			248, 72, 226, 133, 216, 142, 165, 170, 136, 69, 19, 54, 114, 213,
+			186, 94, 197, 42, 152, 29, 27, 224, 123, 33, 190, 46, 51, 99,
+			45, 230, 171, 207, 38, 92, 16, 109, 173, 217, 98, 21, 157, 175,
+			62, 35, 53, 164, 209, 113, 25, 47, 253, 132, 20, 254, 204, 48,
+			204, 8, 119, 127, 66, 202, 103, 217, 15, 144, 38, 134, 20, 127,
+			142, 97, 250, 105, 206, 174, 236, 99, 138, 81, 65, 215, 130, 90,
+			163, 72, 97, 69, 129, 111, 79, 152, 95, 159, 226, 98, 219, 62,
+			38, 51, 52, 76, 255, 169, 70, 55, 136, 58, 122, 156, 249, 196,
+			149, 27, 191, 148, 44, 235, 103, 29, 107, 18, 195, 35, 199, 253,
+			220, 166, 62, 134, 103, 142, 251, 57, 41, 215, 44, 164, 168, 232,
+			249, 111, 104, 147, 170, 188, 244, 83, 162, 223, 212, 49, 104, 170,
+			132, 187, 63, 37, 186, 146, 113, 221, 42, 90, 244, 5, 113, 94,
+			214, 243, 170, 58, 104, 190, 32, 206, 180, 133, 4, 123, 103, 94,
+			180, 144, 34, 188, 208, 96, 27, 122, 42, 225, 238, 151, 120, 132,
+			92, 133, 241, 199, 29, 140, 151, 110, 44, 116, 252, 15, 64, 137,
+			99, 165, 31, 1, 116, 61, 166, 7, 141, 255, 22, 193, 24, 82,
+			213, 190, 249, 210, 250, 166, 170, 207, 146, 47, 73, 133, 91, 72,
+			177, 119, 97, 177, 93, 26, 198, 82, 201, 43, 255, 51, 0, 153,
+			173, 47, 21, 221, 36, 0, 0},
 	)
 }
 
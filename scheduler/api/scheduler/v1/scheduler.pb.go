@@ -17,6 +17,9 @@ It has these top-level messages:
 	Job
 	JobState
 	Invocation
+	TriggerJobRequest
+	TriggerJobReply
+	DebugLogReply
 */
 package scheduler
 
@@ -106,6 +109,12 @@ type InvocationsRequest struct {
 	Cursor string  `protobuf:"bytes,2,opt,name=cursor" json:"cursor,omitempty"`
 	// page_size defaults to 50 which is maximum.
 	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	// If given, only invocations with one of these statuses are returned (see
+	// Invocation.status for possible values).
+	//
+	// When filtering, the reply may contain fewer than page_size invocations
+	// (even zero) and still have non-empty next_cursor.
+	Statuses []string `protobuf:"bytes,4,rep,name=statuses" json:"statuses,omitempty"`
 }
 
 func (m *InvocationsRequest) Reset()                    { *m = InvocationsRequest{} }
@@ -134,6 +143,13 @@ func (m *InvocationsRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (m *InvocationsRequest) GetStatuses() []string {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
 type InvocationsReply struct {
 	Invocations []*Invocation `protobuf:"bytes,1,rep,name=invocations" json:"invocations,omitempty"`
 	NextCursor  string        `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor" json:"next_cursor,omitempty"`
@@ -360,6 +376,77 @@ func (m *Invocation) GetViewUrl() string {
 	return ""
 }
 
+type TriggerJobRequest struct {
+	JobRef *JobRef `protobuf:"bytes,1,opt,name=job_ref,json=jobRef" json:"job_ref,omitempty"`
+	// triggered_by is an identity ("kind:value") to record as the initiator of
+	// the invocation. Tools that act on behalf of users can use it to put the
+	// real user there, if they are in "scheduler-trigger-on-behalf" group. Defaults
+	// to the identity of the caller.
+	TriggeredBy string `protobuf:"bytes,2,opt,name=triggered_by,json=triggeredBy" json:"triggered_by,omitempty"`
+}
+
+func (m *TriggerJobRequest) Reset()                    { *m = TriggerJobRequest{} }
+func (m *TriggerJobRequest) String() string            { return proto.CompactTextString(m) }
+func (*TriggerJobRequest) ProtoMessage()               {}
+func (*TriggerJobRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *TriggerJobRequest) GetJobRef() *JobRef {
+	if m != nil {
+		return m.JobRef
+	}
+	return nil
+}
+
+func (m *TriggerJobRequest) GetTriggeredBy() string {
+	if m != nil {
+		return m.TriggeredBy
+	}
+	return ""
+}
+
+type TriggerJobReply struct {
+	// invocation_nonce identifies the intent to start the invocation. It can be
+	// used to find the started invocation(s) later.
+	InvocationNonce int64 `protobuf:"varint,1,opt,name=invocation_nonce,json=invocationNonce" json:"invocation_nonce,omitempty"`
+}
+
+func (m *TriggerJobReply) Reset()                    { *m = TriggerJobReply{} }
+func (m *TriggerJobReply) String() string            { return proto.CompactTextString(m) }
+func (*TriggerJobReply) ProtoMessage()               {}
+func (*TriggerJobReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *TriggerJobReply) GetInvocationNonce() int64 {
+	if m != nil {
+		return m.InvocationNonce
+	}
+	return 0
+}
+
+type DebugLogReply struct {
+	InvocationRef *InvocationRef `protobuf:"bytes,1,opt,name=invocation_ref,json=invocationRef" json:"invocation_ref,omitempty"`
+	// debug_log is free form text log with debug messages.
+	DebugLog string `protobuf:"bytes,2,opt,name=debug_log,json=debugLog" json:"debug_log,omitempty"`
+}
+
+func (m *DebugLogReply) Reset()                    { *m = DebugLogReply{} }
+func (m *DebugLogReply) String() string            { return proto.CompactTextString(m) }
+func (*DebugLogReply) ProtoMessage()               {}
+func (*DebugLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *DebugLogReply) GetInvocationRef() *InvocationRef {
+	if m != nil {
+		return m.InvocationRef
+	}
+	return nil
+}
+
+func (m *DebugLogReply) GetDebugLog() string {
+	if m != nil {
+		return m.DebugLog
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*JobsRequest)(nil), "scheduler.JobsRequest")
 	proto.RegisterType((*JobsReply)(nil), "scheduler.JobsReply")
@@ -370,6 +457,9 @@ func init() {
 	proto.RegisterType((*Job)(nil), "scheduler.Job")
 	proto.RegisterType((*JobState)(nil), "scheduler.JobState")
 	proto.RegisterType((*Invocation)(nil), "scheduler.Invocation")
+	proto.RegisterType((*TriggerJobRequest)(nil), "scheduler.TriggerJobRequest")
+	proto.RegisterType((*TriggerJobReply)(nil), "scheduler.TriggerJobReply")
+	proto.RegisterType((*DebugLogReply)(nil), "scheduler.DebugLogReply")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -408,6 +498,14 @@ type SchedulerClient interface {
 	// If you want to abort a specific hung invocation, use this request instead
 	// of AbortJob.
 	AbortInvocation(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// TriggerJob launches a new invocation of a job right now, ignoring its
+	// schedule. Works for paused jobs too.
+	//
+	// Fails with FAILED_PRECONDITION if the job is already running and can't
+	// have more concurrent invocations.
+	TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*TriggerJobReply, error)
+	// GetDebugLog returns the debug log of a given invocation.
+	GetDebugLog(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*DebugLogReply, error)
 }
 type schedulerPRPCClient struct {
 	client *prpc.Client
@@ -471,6 +569,24 @@ func (c *schedulerPRPCClient) AbortInvocation(ctx context.Context, in *Invocatio
 	return out, nil
 }
 
+func (c *schedulerPRPCClient) TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*TriggerJobReply, error) {
+	out := new(TriggerJobReply)
+	err := c.client.Call(ctx, "scheduler.Scheduler", "TriggerJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerPRPCClient) GetDebugLog(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*DebugLogReply, error) {
+	out := new(DebugLogReply)
+	err := c.client.Call(ctx, "scheduler.Scheduler", "GetDebugLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type schedulerClient struct {
 	cc *grpc.ClientConn
 }
@@ -533,6 +649,24 @@ func (c *schedulerClient) AbortInvocation(ctx context.Context, in *InvocationRef
 	return out, nil
 }
 
+func (c *schedulerClient) TriggerJob(ctx context.Context, in *TriggerJobRequest, opts ...grpc.CallOption) (*TriggerJobReply, error) {
+	out := new(TriggerJobReply)
+	err := grpc.Invoke(ctx, "/scheduler.Scheduler/TriggerJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulerClient) GetDebugLog(ctx context.Context, in *InvocationRef, opts ...grpc.CallOption) (*DebugLogReply, error) {
+	out := new(DebugLogReply)
+	err := grpc.Invoke(ctx, "/scheduler.Scheduler/GetDebugLog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Scheduler service
 
 type SchedulerServer interface {
@@ -561,6 +695,14 @@ type SchedulerServer interface {
 	// If you want to abort a specific hung invocation, use this request instead
 	// of AbortJob.
 	AbortInvocation(context.Context, *InvocationRef) (*google_protobuf.Empty, error)
+	// TriggerJob launches a new invocation of a job right now, ignoring its
+	// schedule. Works for paused jobs too.
+	//
+	// Fails with FAILED_PRECONDITION if the job is already running and can't
+	// have more concurrent invocations.
+	TriggerJob(context.Context, *TriggerJobRequest) (*TriggerJobReply, error)
+	// GetDebugLog returns the debug log of a given invocation.
+	GetDebugLog(context.Context, *InvocationRef) (*DebugLogReply, error)
 }
 
 func RegisterSchedulerServer(s prpc.Registrar, srv SchedulerServer) {
@@ -675,6 +817,42 @@ func _Scheduler_AbortInvocation_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Scheduler_TriggerJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).TriggerJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.Scheduler/TriggerJobRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).TriggerJob(ctx, req.(*TriggerJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduler_GetDebugLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvocationRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulerServer).GetDebugLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scheduler.Scheduler/InvocationRef",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulerServer).GetDebugLog(ctx, req.(*InvocationRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Scheduler_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "scheduler.Scheduler",
 	HandlerType: (*SchedulerServer)(nil),
@@ -703,6 +881,14 @@ var _Scheduler_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AbortInvocation",
 			Handler:    _Scheduler_AbortInvocation_Handler,
 		},
+		{
+			MethodName: "TriggerJob",
+			Handler:    _Scheduler_TriggerJob_Handler,
+		},
+		{
+			MethodName: "GetDebugLog",
+			Handler:    _Scheduler_GetDebugLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/luci/luci-go/scheduler/api/scheduler/v1/scheduler.proto",
@@ -713,45 +899,53 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 640 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x56, 0x9a, 0x26, 0xb1, 0x27, 0x6d, 0x5a, 0x96, 0x52, 0x99, 0x54, 0x15, 0xc1, 0x1c, 0x1a,
-	0x90, 0x48, 0x44, 0xa0, 0xf4, 0x88, 0x68, 0x85, 0xaa, 0x56, 0x1c, 0x2a, 0xa7, 0xdc, 0x90, 0x8c,
-	0xed, 0xac, 0xdd, 0x8d, 0x5c, 0xaf, 0xd9, 0x9f, 0x40, 0xfa, 0x14, 0x3c, 0x03, 0xef, 0x89, 0x84,
-	0x76, 0x6d, 0xc7, 0x0e, 0x6d, 0x41, 0xe1, 0x92, 0xf8, 0xfb, 0xe6, 0x67, 0xe7, 0x9b, 0x99, 0x5d,
-	0x38, 0x8e, 0x88, 0xb8, 0x92, 0xfe, 0x20, 0xa0, 0xd7, 0xc3, 0x58, 0x06, 0x44, 0xff, 0xbc, 0x8c,
-	0xe8, 0x90, 0x07, 0x57, 0x78, 0x22, 0x63, 0xcc, 0x86, 0x5e, 0x4a, 0x2a, 0x68, 0xf6, 0xaa, 0x04,
-	0x83, 0x94, 0x51, 0x41, 0x91, 0xb9, 0x20, 0xba, 0x7b, 0x11, 0xa5, 0x51, 0x8c, 0x87, 0xda, 0xe0,
-	0xcb, 0x70, 0x88, 0xaf, 0x53, 0x31, 0xcf, 0xfc, 0xec, 0xcf, 0xd0, 0x3e, 0xa7, 0x3e, 0x77, 0xf0,
-	0x57, 0x89, 0xb9, 0x40, 0x16, 0xb4, 0x52, 0x46, 0xa7, 0x38, 0x10, 0x56, 0xad, 0x57, 0xeb, 0x9b,
-	0x4e, 0x01, 0xd1, 0x2e, 0x34, 0x03, 0xc9, 0x38, 0x65, 0xd6, 0x9a, 0x36, 0xe4, 0x08, 0xed, 0x81,
-	0x99, 0x7a, 0x11, 0x76, 0x39, 0xb9, 0xc1, 0x56, 0xbd, 0x57, 0xeb, 0x37, 0x1c, 0x43, 0x11, 0x63,
-	0x72, 0x83, 0xed, 0x0b, 0x30, 0xb3, 0xec, 0x69, 0x3c, 0x47, 0x36, 0xac, 0x4f, 0xa9, 0xcf, 0xad,
-	0x5a, 0xaf, 0xde, 0x6f, 0x8f, 0x3a, 0x83, 0xb2, 0xe4, 0x73, 0xea, 0x3b, 0xda, 0x86, 0x9e, 0x40,
-	0x3b, 0xc1, 0xdf, 0x85, 0xbb, 0x74, 0x14, 0x28, 0xea, 0x44, 0x33, 0xb6, 0x04, 0x74, 0x96, 0xcc,
-	0x68, 0xe0, 0x09, 0x42, 0x93, 0x45, 0xd9, 0x2f, 0xa0, 0x35, 0xa5, 0xbe, 0xcb, 0x70, 0xa8, 0xcb,
-	0x6e, 0x8f, 0x1e, 0xfc, 0x91, 0x1d, 0x87, 0x4e, 0x73, 0xaa, 0xff, 0xff, 0x4f, 0x48, 0x0c, 0xdb,
-	0x4b, 0xc7, 0x2a, 0x3d, 0x47, 0xd0, 0x26, 0x25, 0x97, 0xcb, 0x7a, 0x54, 0x39, 0xb8, 0x8c, 0x70,
-	0xaa, 0x9e, 0xff, 0x16, 0xf9, 0x06, 0x9a, 0x59, 0xd1, 0x7f, 0x99, 0xc7, 0x36, 0xd4, 0xa7, 0xd4,
-	0xcf, 0x83, 0xd5, 0xa7, 0xfd, 0x05, 0x36, 0x2b, 0x27, 0xe2, 0x70, 0xa5, 0xae, 0x3c, 0x83, 0xcd,
-	0xb2, 0x44, 0x97, 0x4c, 0x74, 0xe2, 0xba, 0xb3, 0x51, 0x92, 0x67, 0x13, 0xfb, 0x47, 0x0d, 0xea,
-	0xe7, 0xd4, 0x5f, 0x29, 0x71, 0x17, 0x8c, 0xc2, 0x96, 0x17, 0xbb, 0xc0, 0xe8, 0x39, 0x34, 0xb8,
-	0xf0, 0x44, 0xd6, 0xee, 0xf6, 0xe8, 0xe1, 0x72, 0x96, 0xb1, 0x32, 0x39, 0x99, 0x87, 0x9a, 0x5a,
-	0xea, 0x49, 0x8e, 0x27, 0xd6, 0x7a, 0xaf, 0xd6, 0x37, 0x9c, 0x1c, 0xd9, 0x07, 0x60, 0x14, 0xae,
-	0x6a, 0x82, 0x92, 0xb8, 0xca, 0x5f, 0xf2, 0xbc, 0x5d, 0x86, 0x24, 0x63, 0x8d, 0xed, 0x9f, 0x6b,
-	0x00, 0x65, 0x7b, 0xd0, 0x3b, 0xe8, 0x54, 0xf4, 0x96, 0x4a, 0xac, 0xbb, 0xe7, 0x87, 0x43, 0xa7,
-	0xd2, 0x1f, 0xa5, 0x6b, 0x1f, 0x80, 0x0b, 0x8f, 0x09, 0x3c, 0x71, 0x05, 0xcf, 0xbb, 0x65, 0xe6,
-	0xcc, 0xa5, 0x9e, 0x71, 0x48, 0x12, 0xc2, 0xaf, 0x32, 0x7b, 0x5d, 0xdb, 0xa1, 0xa0, 0x2e, 0x39,
-	0x7a, 0x0a, 0x1b, 0x82, 0x91, 0x28, 0xc2, 0x0c, 0x4f, 0x5c, 0x7f, 0xae, 0x65, 0x99, 0x4e, 0x7b,
-	0xc1, 0x1d, 0xcf, 0x95, 0xe6, 0x5c, 0x4c, 0x23, 0xdb, 0xd4, 0x0c, 0xa1, 0x1d, 0x68, 0x84, 0x24,
-	0xf1, 0x62, 0xab, 0xa9, 0x5b, 0x91, 0x01, 0x74, 0x00, 0x5b, 0x01, 0x4d, 0x42, 0x12, 0xb9, 0x0c,
-	0xcf, 0x08, 0x27, 0x34, 0xb1, 0x5a, 0x3a, 0xac, 0x93, 0xd1, 0x4e, 0xce, 0xa2, 0xc7, 0x60, 0xcc,
-	0x08, 0xfe, 0xe6, 0x4a, 0x16, 0x5b, 0x46, 0xb6, 0x54, 0x0a, 0x7f, 0x62, 0xf1, 0xe8, 0xd7, 0x1a,
-	0x98, 0xe3, 0x42, 0x3f, 0x3a, 0x82, 0xd6, 0x29, 0x16, 0xea, 0x02, 0xa3, 0xdd, 0xe5, 0xd1, 0x14,
-	0x17, 0xaf, 0xbb, 0x73, 0x8b, 0x57, 0x37, 0xe3, 0x23, 0x74, 0x4e, 0xb1, 0xa8, 0x5c, 0x18, 0xb4,
-	0x7f, 0x67, 0x5b, 0x17, 0x69, 0xf6, 0xee, 0x33, 0xab, 0x6c, 0x87, 0x60, 0x5c, 0xa8, 0x61, 0xab,
-	0xcd, 0xbb, 0xbd, 0x68, 0xdd, 0xdd, 0x41, 0xf6, 0xbe, 0x0d, 0x8a, 0xf7, 0x6d, 0xf0, 0x41, 0xbd,
-	0x6f, 0xe8, 0x2d, 0x98, 0x0e, 0xe6, 0xf2, 0x7a, 0xd5, 0xb8, 0x43, 0x30, 0xde, 0xfb, 0x94, 0x89,
-	0x15, 0xc3, 0x4e, 0x60, 0x4b, 0x87, 0x55, 0x76, 0xec, 0xde, 0x5d, 0xba, 0x2f, 0x89, 0xdf, 0xd4,
-	0xf8, 0xf5, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x39, 0x5c, 0xf0, 0x02, 0x06, 0x00, 0x00,
+	// 760 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x5f, 0x6f, 0xe3, 0x44,
+	0x10, 0x57, 0xea, 0xfc, 0xb1, 0xc7, 0x6d, 0xd2, 0x2e, 0xa5, 0x32, 0x09, 0x15, 0xc1, 0x3c, 0x34,
+	0x45, 0x22, 0x11, 0x81, 0xd2, 0x17, 0x24, 0xd4, 0x16, 0xa8, 0x5a, 0x55, 0xa8, 0x72, 0xca, 0x1b,
+	0x92, 0x89, 0xed, 0xb5, 0xbb, 0x91, 0xe3, 0x0d, 0xde, 0x75, 0x20, 0xfd, 0x04, 0x3c, 0xde, 0x7d,
+	0x85, 0xfb, 0xa4, 0xa7, 0x5d, 0xdb, 0xb1, 0xd3, 0x34, 0x3d, 0xe5, 0xee, 0x25, 0xf1, 0xfc, 0x66,
+	0x67, 0x76, 0x7e, 0xf3, 0x9b, 0xdd, 0x85, 0xcb, 0x80, 0xf0, 0xc7, 0xc4, 0xe9, 0xbb, 0x74, 0x3a,
+	0x08, 0x13, 0x97, 0xc8, 0x9f, 0xef, 0x02, 0x3a, 0x60, 0xee, 0x23, 0xf6, 0x92, 0x10, 0xc7, 0x83,
+	0xf1, 0x8c, 0x94, 0xac, 0xf9, 0xf7, 0x85, 0xd1, 0x9f, 0xc5, 0x94, 0x53, 0xa4, 0x2d, 0x81, 0x76,
+	0x27, 0xa0, 0x34, 0x08, 0xf1, 0x40, 0x3a, 0x9c, 0xc4, 0x1f, 0xe0, 0xe9, 0x8c, 0x2f, 0xd2, 0x75,
+	0xe6, 0x5f, 0xa0, 0xdf, 0x52, 0x87, 0x59, 0xf8, 0x9f, 0x04, 0x33, 0x8e, 0x0c, 0x68, 0xcc, 0x62,
+	0x3a, 0xc1, 0x2e, 0x37, 0x2a, 0xdd, 0x4a, 0x4f, 0xb3, 0x72, 0x13, 0x1d, 0x41, 0xdd, 0x4d, 0x62,
+	0x46, 0x63, 0x63, 0x47, 0x3a, 0x32, 0x0b, 0x75, 0x40, 0x9b, 0x8d, 0x03, 0x6c, 0x33, 0xf2, 0x84,
+	0x0d, 0xa5, 0x5b, 0xe9, 0xd5, 0x2c, 0x55, 0x00, 0x23, 0xf2, 0x84, 0xcd, 0x7b, 0xd0, 0xd2, 0xec,
+	0xb3, 0x70, 0x81, 0x4c, 0xa8, 0x4e, 0xa8, 0xc3, 0x8c, 0x4a, 0x57, 0xe9, 0xe9, 0xc3, 0x66, 0xbf,
+	0x28, 0xf9, 0x96, 0x3a, 0x96, 0xf4, 0xa1, 0xaf, 0x40, 0x8f, 0xf0, 0x7f, 0xdc, 0x5e, 0xd9, 0x0a,
+	0x04, 0x74, 0x25, 0x11, 0xf3, 0x6d, 0x05, 0xd0, 0x4d, 0x34, 0xa7, 0xee, 0x98, 0x13, 0x1a, 0x2d,
+	0xeb, 0xfe, 0x16, 0x1a, 0x13, 0xea, 0xd8, 0x31, 0xf6, 0x65, 0xdd, 0xfa, 0xf0, 0xe0, 0x59, 0x7a,
+	0xec, 0x5b, 0xf5, 0x89, 0xfc, 0xff, 0x28, 0x26, 0xa8, 0x0d, 0x2a, 0xe3, 0x63, 0x9e, 0x30, 0xcc,
+	0x8c, 0x6a, 0x57, 0xe9, 0x69, 0xd6, 0xd2, 0x36, 0x43, 0xd8, 0x5f, 0x29, 0x49, 0x90, 0x3d, 0x07,
+	0x9d, 0x14, 0x58, 0xc6, 0xf9, 0xf3, 0x52, 0x51, 0x45, 0x84, 0x55, 0x5e, 0xf9, 0xe1, 0x0e, 0xfc,
+	0x08, 0xf5, 0x94, 0xd0, 0x2b, 0x62, 0xed, 0x83, 0x32, 0xa1, 0x4e, 0x16, 0x2c, 0x3e, 0xcd, 0xbf,
+	0x61, 0xaf, 0xb4, 0x23, 0xf6, 0xb7, 0xea, 0xd8, 0x37, 0xb0, 0x57, 0x94, 0x68, 0x13, 0x4f, 0x26,
+	0x56, 0xac, 0xdd, 0x02, 0xbc, 0xf1, 0xcc, 0x37, 0x15, 0x50, 0x6e, 0xa9, 0xb3, 0x55, 0x62, 0xd1,
+	0xd5, 0xcc, 0x97, 0x15, 0xbb, 0xb4, 0xd1, 0x29, 0xd4, 0x44, 0x87, 0x53, 0x29, 0xf4, 0xe1, 0x67,
+	0xab, 0x59, 0x46, 0xc2, 0x65, 0xa5, 0x2b, 0x84, 0xa2, 0xb3, 0x71, 0xc2, 0xb0, 0x67, 0x54, 0xbb,
+	0x95, 0x9e, 0x6a, 0x65, 0x96, 0x79, 0x02, 0x6a, 0xbe, 0x54, 0xa8, 0x9b, 0x10, 0x3b, 0xd5, 0x2c,
+	0x6b, 0x97, 0x9a, 0x90, 0x91, 0xb4, 0xcd, 0x77, 0x3b, 0x00, 0x45, 0x7b, 0xd0, 0x2f, 0xd0, 0x2c,
+	0xf1, 0x2d, 0x98, 0x18, 0x2f, 0xeb, 0x87, 0x7d, 0xab, 0xd4, 0x1f, 0xc1, 0xeb, 0x18, 0x80, 0xf1,
+	0x71, 0xcc, 0xb1, 0x67, 0x73, 0x96, 0x75, 0x4b, 0xcb, 0x90, 0x07, 0xa9, 0xb1, 0x4f, 0x22, 0xc2,
+	0x1e, 0x53, 0xbf, 0x22, 0xfd, 0x90, 0x43, 0x0f, 0x0c, 0x7d, 0x0d, 0xbb, 0x3c, 0x26, 0x41, 0x80,
+	0x63, 0xec, 0xd9, 0xce, 0x42, 0xd2, 0xd2, 0x2c, 0x7d, 0x89, 0x5d, 0x2e, 0x04, 0xe7, 0x8c, 0x4c,
+	0x2d, 0x9d, 0xe2, 0xd4, 0x42, 0x87, 0x50, 0xf3, 0x49, 0x34, 0x0e, 0x8d, 0xba, 0x6c, 0x45, 0x6a,
+	0xa0, 0x13, 0x68, 0xb9, 0x34, 0xf2, 0x49, 0x60, 0xc7, 0x78, 0x4e, 0x18, 0xa1, 0x91, 0xd1, 0x90,
+	0x61, 0xcd, 0x14, 0xb6, 0x32, 0x14, 0x7d, 0x01, 0xea, 0x9c, 0xe0, 0x7f, 0xed, 0x24, 0x0e, 0x0d,
+	0x35, 0x1d, 0x2a, 0x61, 0xff, 0x19, 0x87, 0xa6, 0x03, 0x07, 0x0f, 0x69, 0x01, 0x52, 0xc5, 0xed,
+	0x0f, 0xde, 0x73, 0x56, 0x3b, 0x6b, 0xac, 0xcc, 0x9f, 0xa1, 0x55, 0xde, 0x43, 0x9c, 0xa4, 0x53,
+	0xd8, 0x2f, 0x89, 0x11, 0xd1, 0xc8, 0xc5, 0x72, 0x2b, 0xc5, 0x6a, 0x15, 0xf8, 0x1f, 0x02, 0x36,
+	0xa7, 0xb0, 0xf7, 0x2b, 0x76, 0x92, 0xe0, 0x8e, 0x06, 0x69, 0xec, 0x27, 0x0b, 0xd9, 0x01, 0xcd,
+	0x13, 0x19, 0xed, 0x90, 0x06, 0xf9, 0x84, 0x7a, 0xd9, 0x16, 0xc3, 0xff, 0xab, 0xa0, 0x8d, 0xf2,
+	0x3c, 0xe8, 0x1c, 0x1a, 0xd7, 0x98, 0x8b, 0xeb, 0x0e, 0x1d, 0xad, 0xf6, 0x20, 0xbf, 0xa5, 0xda,
+	0x87, 0x6b, 0xb8, 0x28, 0xf2, 0x0e, 0x9a, 0xd7, 0x98, 0x97, 0x6e, 0x10, 0x74, 0xfc, 0x62, 0x79,
+	0xcb, 0x34, 0x9d, 0x4d, 0x6e, 0x91, 0xed, 0x0c, 0xd4, 0x7b, 0x31, 0xfd, 0xe2, 0x28, 0xae, 0x6b,
+	0xd1, 0x3e, 0xea, 0xa7, 0xaf, 0x41, 0x3f, 0x7f, 0x0d, 0xfa, 0xbf, 0x89, 0xd7, 0x00, 0xfd, 0x04,
+	0x9a, 0x85, 0x59, 0x32, 0xdd, 0x36, 0xee, 0x0c, 0xd4, 0x0b, 0x87, 0xc6, 0x7c, 0xcb, 0xb0, 0x2b,
+	0x68, 0xc9, 0xb0, 0xd2, 0xa1, 0xdb, 0xa8, 0xc9, 0xc6, 0x24, 0xbf, 0x03, 0x14, 0xc3, 0x82, 0xbe,
+	0x2c, 0xc5, 0xaf, 0xcd, 0x69, 0xbb, 0xbd, 0xc1, 0x2b, 0x5a, 0x76, 0x01, 0xfa, 0x35, 0xe6, 0xf9,
+	0xe4, 0xbc, 0x52, 0x48, 0xd9, 0xb3, 0x32, 0x68, 0x4e, 0x5d, 0x96, 0xf6, 0xc3, 0xfb, 0x01, 0x00,
+	0xa3, 0xef, 0x0b, 0x2f, 0xbb, 0x07, 0x00, 0x00,
 }
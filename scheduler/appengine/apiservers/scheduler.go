@@ -9,7 +9,9 @@ import (
 	"github.com/luci/luci-go/scheduler/appengine/catalog"
 	"github.com/luci/luci-go/scheduler/appengine/engine"
 	"github.com/luci/luci-go/scheduler/appengine/presentation"
+	"github.com/luci/luci-go/scheduler/appengine/task"
 	"github.com/luci/luci-go/server/auth"
+	"github.com/luci/luci-go/server/auth/identity"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -17,6 +19,10 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 )
 
+// triggerOnBehalfGroup is a group of callers (e.g. tools acting for users)
+// allowed to attribute invocations they trigger to some other identity.
+const triggerOnBehalfGroup = "scheduler-trigger-on-behalf"
+
 // SchedulerServer implements scheduler.Scheduler API.
 type SchedulerServer struct {
 	Engine  engine.Engine
@@ -64,7 +70,22 @@ func (s SchedulerServer) GetJobs(ctx context.Context, in *scheduler.JobsRequest)
 	return &scheduler.JobsReply{Jobs: jobs, NextCursor: ""}, nil
 }
 
+// maxScannedInvocations limits how many invocations GetInvocations examines
+// per call when filtering them by status.
+const maxScannedInvocations = 500
+
 func (s SchedulerServer) GetInvocations(ctx context.Context, in *scheduler.InvocationsRequest) (*scheduler.InvocationsReply, error) {
+	var statuses map[task.Status]bool
+	if len(in.GetStatuses()) != 0 {
+		statuses = make(map[task.Status]bool, len(in.Statuses))
+		for _, s := range in.Statuses {
+			if !isKnownStatus(task.Status(s)) {
+				return nil, grpc.Errorf(codes.InvalidArgument, "unknown invocation status %q", s)
+			}
+			statuses[task.Status(s)] = true
+		}
+	}
+
 	ejob, err := s.Engine.GetJob(ctx, getJobId(in.GetJobRef()))
 	if err != nil {
 		return nil, grpc.Errorf(codes.Internal, "datastore error: %s", err)
@@ -78,9 +99,27 @@ func (s SchedulerServer) GetInvocations(ctx context.Context, in *scheduler.Invoc
 		pageSize = int(in.PageSize)
 	}
 
-	einvs, cursor, err := s.Engine.ListInvocations(ctx, ejob.JobID, pageSize, in.GetCursor())
-	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "datastore error: %s", err)
+	// When filtering, keep fetching pages until we have enough matching
+	// invocations. Always consume fetched pages entirely, so that the cursor
+	// points right after the last examined invocation.
+	var einvs []*engine.Invocation
+	cursor := in.GetCursor()
+	scanned := 0
+	for {
+		page, next, err := s.Engine.ListInvocations(ctx, ejob.JobID, pageSize-len(einvs), cursor)
+		if err != nil {
+			return nil, grpc.Errorf(codes.Internal, "datastore error: %s", err)
+		}
+		cursor = next
+		scanned += len(page)
+		for _, einv := range page {
+			if statuses == nil || statuses[einv.Status] {
+				einvs = append(einvs, einv)
+			}
+		}
+		if statuses == nil || len(einvs) == pageSize || cursor == "" || scanned >= maxScannedInvocations {
+			break
+		}
 	}
 	invs := make([]*scheduler.Invocation, len(einvs))
 	for i, einv := range einvs {
@@ -106,6 +145,30 @@ func (s SchedulerServer) GetInvocations(ctx context.Context, in *scheduler.Invoc
 	return &scheduler.InvocationsReply{Invocations: invs, NextCursor: cursor}, nil
 }
 
+// GetDebugLog returns the debug log of a given invocation.
+//
+// Debug logs may contain details of the job configuration (e.g. URLs), so they
+// are visible only to job owners, same as in the UI.
+func (s SchedulerServer) GetDebugLog(ctx context.Context, in *scheduler.InvocationRef) (*scheduler.DebugLogReply, error) {
+	if !presentation.IsJobOwner(ctx, in.GetJobRef().GetProject(), in.GetJobRef().GetJob()) {
+		return nil, grpc.Errorf(codes.PermissionDenied, "No permission to read the debug log")
+	}
+	einv, err := s.Engine.GetInvocation(ctx, getJobId(in.GetJobRef()), in.GetInvocationId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "datastore error: %s", err)
+	}
+	if einv == nil {
+		return nil, grpc.Errorf(codes.NotFound, "Invocation does not exist or you have no access")
+	}
+	return &scheduler.DebugLogReply{
+		InvocationRef: &scheduler.InvocationRef{
+			JobRef:       in.GetJobRef(),
+			InvocationId: einv.ID,
+		},
+		DebugLog: einv.DebugLog,
+	}, nil
+}
+
 //// Actions.
 
 func (s SchedulerServer) PauseJob(ctx context.Context, in *scheduler.JobRef) (*empty.Empty, error) {
@@ -133,6 +196,39 @@ func (s SchedulerServer) AbortInvocation(ctx context.Context, in *scheduler.Invo
 	})
 }
 
+// TriggerJob launches a new invocation of a job right now.
+//
+// The invocation is attributed to TriggerJobRequest.triggered_by identity, or
+// to the caller if it is not set. Only members of triggerOnBehalfGroup can
+// attribute invocations to someone else.
+func (s SchedulerServer) TriggerJob(ctx context.Context, in *scheduler.TriggerJobRequest) (*scheduler.TriggerJobReply, error) {
+	who := auth.CurrentIdentity(ctx)
+	if in.GetTriggeredBy() != "" {
+		triggeredBy, err := identity.MakeIdentity(in.TriggeredBy)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "bad 'triggered_by': %s", err)
+		}
+		if triggeredBy != who {
+			switch ok, err := auth.IsMember(ctx, triggerOnBehalfGroup); {
+			case err != nil:
+				return nil, grpc.Errorf(codes.Internal, "failed to check group membership: %s", err)
+			case !ok:
+				return nil, grpc.Errorf(codes.PermissionDenied, "No permission to trigger on behalf of %s", triggeredBy)
+			}
+		}
+		who = triggeredBy
+	}
+	var nonce int64
+	_, err := runAction(ctx, in.GetJobRef(), func() (err error) {
+		nonce, err = s.Engine.TriggerInvocation(ctx, getJobId(in.GetJobRef()), who)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &scheduler.TriggerJobReply{InvocationNonce: nonce}, nil
+}
+
 //// Private helpers.
 
 func runAction(ctx context.Context, jobRef *scheduler.JobRef, action func() error) (*empty.Empty, error) {
@@ -146,11 +242,22 @@ func runAction(ctx context.Context, jobRef *scheduler.JobRef, action func() erro
 		return nil, grpc.Errorf(codes.NotFound, "no such job")
 	case err == engine.ErrNoSuchInvocation:
 		return nil, grpc.Errorf(codes.NotFound, "no such invocation")
+	case err == engine.ErrJobAlreadyRunning:
+		return nil, grpc.Errorf(codes.FailedPrecondition, "the job is already running")
 	default:
 		return nil, grpc.Errorf(codes.Internal, "internal error: %s", err)
 	}
 }
 
+func isKnownStatus(s task.Status) bool {
+	switch s {
+	case task.StatusStarting, task.StatusRunning, task.StatusSucceeded,
+		task.StatusFailed, task.StatusOverrun, task.StatusAborted:
+		return true
+	}
+	return false
+}
+
 func getJobId(jobRef *scheduler.JobRef) string {
 	return jobRef.GetProject() + "/" + jobRef.GetJob()
 }
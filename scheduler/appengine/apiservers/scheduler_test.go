@@ -235,6 +235,116 @@ func TestGetInvocationsApi(t *testing.T) {
 				},
 			})
 		})
+
+		Convey("Filtered by status", func() {
+			// 5 pages of 2 invocations: 1 RUNNING + 1 SUCCEEDED each.
+			fakeEng.listInvocations = func(pageSize int, cursor string) ([]*engine.Invocation, string, error) {
+				page := 0
+				if cursor != "" {
+					fmt.Sscanf(cursor, "page%d", &page)
+				}
+				next := ""
+				if page < 4 {
+					next = fmt.Sprintf("page%d", page+1)
+				}
+				return []*engine.Invocation{
+					{ID: int64(page*2 + 1), Status: task.StatusRunning},
+					{ID: int64(page*2 + 2), Status: task.StatusSucceeded},
+				}, next, nil
+			}
+			ids := func(r *scheduler.InvocationsReply) (out []int64) {
+				for _, inv := range r.Invocations {
+					out = append(out, inv.InvocationRef.InvocationId)
+				}
+				return
+			}
+
+			r, err := ss.GetInvocations(ctx, &scheduler.InvocationsRequest{
+				JobRef:   &scheduler.JobRef{Project: "proj", Job: "job"},
+				PageSize: 3,
+				Statuses: []string{"SUCCEEDED"},
+			})
+			So(err, ShouldBeNil)
+			So(ids(r), ShouldResemble, []int64{2, 4, 6})
+			So(r.NextCursor, ShouldEqual, "page3")
+
+			r, err = ss.GetInvocations(ctx, &scheduler.InvocationsRequest{
+				JobRef:   &scheduler.JobRef{Project: "proj", Job: "job"},
+				PageSize: 3,
+				Cursor:   r.NextCursor,
+				Statuses: []string{"SUCCEEDED"},
+			})
+			So(err, ShouldBeNil)
+			So(ids(r), ShouldResemble, []int64{8, 10})
+			So(r.NextCursor, ShouldEqual, "")
+		})
+
+		Convey("Unknown status", func() {
+			_, err := ss.GetInvocations(ctx, &scheduler.InvocationsRequest{
+				JobRef:   &scheduler.JobRef{Project: "proj", Job: "job"},
+				Statuses: []string{"BOGUS"},
+			})
+			s, ok := status.FromError(err)
+			So(ok, ShouldBeTrue)
+			So(s.Code(), ShouldEqual, codes.InvalidArgument)
+		})
+	})
+}
+
+func TestGetDebugLogApi(t *testing.T) {
+	t.Parallel()
+
+	Convey("Scheduler GetDebugLog API works", t, func() {
+		ctx := gaetesting.TestingContext()
+		fakeEng, catalog := newTestEngine()
+		ss := SchedulerServer{fakeEng, catalog}
+		ref := &scheduler.InvocationRef{
+			JobRef:       &scheduler.JobRef{Project: "proj", Job: "job"},
+			InvocationId: 12,
+		}
+
+		Convey("PermissionDenied", func() {
+			ctx = auth.WithState(ctx, &authtest.FakeState{
+				Identity:       "user:dog@example.com",
+				IdentityGroups: []string{"dogs"},
+			})
+			fakeEng.getInvocation = func(jobID string, invID int64) (*engine.Invocation, error) {
+				panic("must not be called")
+			}
+			_, err := ss.GetDebugLog(ctx, ref)
+			s, ok := status.FromError(err)
+			So(ok, ShouldBeTrue)
+			So(s.Code(), ShouldEqual, codes.PermissionDenied)
+		})
+
+		ctx = auth.WithState(ctx, &authtest.FakeState{
+			Identity:       "user:admin@example.com",
+			IdentityGroups: []string{"administrators"},
+		})
+
+		Convey("OK", func() {
+			fakeEng.getInvocation = func(jobID string, invID int64) (*engine.Invocation, error) {
+				So(jobID, ShouldEqual, "proj/job")
+				So(invID, ShouldEqual, 12)
+				return &engine.Invocation{ID: 12, DebugLog: "log line\n"}, nil
+			}
+			r, err := ss.GetDebugLog(ctx, ref)
+			So(err, ShouldBeNil)
+			So(r, ShouldResemble, &scheduler.DebugLogReply{
+				InvocationRef: ref,
+				DebugLog:      "log line\n",
+			})
+		})
+
+		Convey("NotFound", func() {
+			fakeEng.getInvocation = func(jobID string, invID int64) (*engine.Invocation, error) {
+				return nil, nil
+			}
+			_, err := ss.GetDebugLog(ctx, ref)
+			s, ok := status.FromError(err)
+			So(ok, ShouldBeTrue)
+			So(s.Code(), ShouldEqual, codes.NotFound)
+		})
 	})
 }
 
@@ -313,6 +423,110 @@ func TestJobActionsApi(t *testing.T) {
 	})
 }
 
+func TestTriggerJobApi(t *testing.T) {
+	t.Parallel()
+
+	Convey("works", t, func() {
+		ctx := gaetesting.TestingContext()
+		fakeEng, catalog := newTestEngine()
+		ss := SchedulerServer{fakeEng, catalog}
+
+		Convey("PermissionDenied", func() {
+			ctx = auth.WithState(ctx, &authtest.FakeState{
+				Identity:       "user:dog@example.com",
+				IdentityGroups: []string{"dogs"},
+			})
+			_, err := ss.TriggerJob(ctx, &scheduler.TriggerJobRequest{
+				JobRef: &scheduler.JobRef{Project: "proj", Job: "job"},
+			})
+			s, ok := status.FromError(err)
+			So(ok, ShouldBeTrue)
+			So(s.Code(), ShouldEqual, codes.PermissionDenied)
+		})
+
+		ctx = auth.WithState(ctx, &authtest.FakeState{
+			Identity:       "user:admin@example.com",
+			IdentityGroups: []string{"administrators"},
+		})
+
+		Convey("OK as caller", func() {
+			fakeEng.triggerJob = func(jobID string, who identity.Identity) (int64, error) {
+				So(jobID, ShouldEqual, "proj/job")
+				So(who, ShouldEqual, identity.Identity("user:admin@example.com"))
+				return 123, nil
+			}
+			r, err := ss.TriggerJob(ctx, &scheduler.TriggerJobRequest{
+				JobRef: &scheduler.JobRef{Project: "proj", Job: "job"},
+			})
+			So(err, ShouldBeNil)
+			So(r, ShouldResemble, &scheduler.TriggerJobReply{InvocationNonce: 123})
+		})
+
+		Convey("OK with explicit identity", func() {
+			ctx = auth.WithState(ctx, &authtest.FakeState{
+				Identity:       "user:tool@example.com",
+				IdentityGroups: []string{"administrators", triggerOnBehalfGroup},
+			})
+			fakeEng.triggerJob = func(jobID string, who identity.Identity) (int64, error) {
+				So(who, ShouldEqual, identity.Identity("user:someone@example.com"))
+				return 123, nil
+			}
+			_, err := ss.TriggerJob(ctx, &scheduler.TriggerJobRequest{
+				JobRef:      &scheduler.JobRef{Project: "proj", Job: "job"},
+				TriggeredBy: "user:someone@example.com",
+			})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("OK with own identity", func() {
+			fakeEng.triggerJob = func(jobID string, who identity.Identity) (int64, error) {
+				So(who, ShouldEqual, identity.Identity("user:admin@example.com"))
+				return 123, nil
+			}
+			_, err := ss.TriggerJob(ctx, &scheduler.TriggerJobRequest{
+				JobRef:      &scheduler.JobRef{Project: "proj", Job: "job"},
+				TriggeredBy: "user:admin@example.com",
+			})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("Explicit identity requires a privileged group", func() {
+			fakeEng.triggerJob = func(jobID string, who identity.Identity) (int64, error) {
+				panic("must not be called")
+			}
+			_, err := ss.TriggerJob(ctx, &scheduler.TriggerJobRequest{
+				JobRef:      &scheduler.JobRef{Project: "proj", Job: "job"},
+				TriggeredBy: "user:someone@example.com",
+			})
+			s, ok := status.FromError(err)
+			So(ok, ShouldBeTrue)
+			So(s.Code(), ShouldEqual, codes.PermissionDenied)
+		})
+
+		Convey("Bad identity", func() {
+			_, err := ss.TriggerJob(ctx, &scheduler.TriggerJobRequest{
+				JobRef:      &scheduler.JobRef{Project: "proj", Job: "job"},
+				TriggeredBy: "not an identity",
+			})
+			s, ok := status.FromError(err)
+			So(ok, ShouldBeTrue)
+			So(s.Code(), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("Already running", func() {
+			fakeEng.triggerJob = func(jobID string, who identity.Identity) (int64, error) {
+				return 0, engine.ErrJobAlreadyRunning
+			}
+			_, err := ss.TriggerJob(ctx, &scheduler.TriggerJobRequest{
+				JobRef: &scheduler.JobRef{Project: "proj", Job: "job"},
+			})
+			s, ok := status.FromError(err)
+			So(ok, ShouldBeTrue)
+			So(s.Code(), ShouldEqual, codes.FailedPrecondition)
+		})
+	})
+}
+
 func TestAbortInvocationApi(t *testing.T) {
 	t.Parallel()
 
@@ -391,11 +605,13 @@ type fakeEngine struct {
 	getProjectJobs  func(projectID string) ([]*engine.Job, error)
 	getJob          func(jobID string) (*engine.Job, error)
 	listInvocations func(pageSize int, cursor string) ([]*engine.Invocation, string, error)
+	getInvocation   func(jobID string, invID int64) (*engine.Invocation, error)
 
 	pauseJob        func(jobID string, who identity.Identity) error
 	resumeJob       func(jobID string, who identity.Identity) error
 	abortJob        func(jobID string, who identity.Identity) error
 	abortInvocation func(jobID string, invID int64, who identity.Identity) error
+	triggerJob      func(jobID string, who identity.Identity) (int64, error)
 }
 
 func (f *fakeEngine) GetAllProjects(c context.Context) ([]string, error) {
@@ -419,7 +635,7 @@ func (f *fakeEngine) ListInvocations(c context.Context, jobID string, pageSize i
 }
 
 func (f *fakeEngine) GetInvocation(c context.Context, jobID string, invID int64) (*engine.Invocation, error) {
-	panic("not implemented")
+	return f.getInvocation(jobID, invID)
 }
 
 func (f *fakeEngine) GetInvocationsByNonce(c context.Context, invNonce int64) ([]*engine.Invocation, error) {
@@ -442,12 +658,16 @@ func (f *fakeEngine) ProcessPubSubPush(c context.Context, body []byte) error {
 	panic("not implemented")
 }
 
+func (f *fakeEngine) ProcessCallback(c context.Context, token string, body []byte) error {
+	panic("not implemented")
+}
+
 func (f *fakeEngine) PullPubSubOnDevServer(c context.Context, taskManagerName string, publisher string) error {
 	panic("not implemented")
 }
 
 func (f *fakeEngine) TriggerInvocation(c context.Context, jobID string, triggeredBy identity.Identity) (int64, error) {
-	panic("not implemented")
+	return f.triggerJob(jobID, triggeredBy)
 }
 
 func (f *fakeEngine) PauseJob(c context.Context, jobID string, who identity.Identity) error {
@@ -120,6 +120,22 @@ type Definition struct {
 	// Internally it is TaskDefWrapper proto message, but callers must treat it as
 	// an opaque byte blob.
 	Task []byte
+
+	// TriggeredJobIDs is a list of jobIDs of jobs which this job triggers.
+	//
+	// Each entry is a full job ID ("<ProjectID>/<JobName>"). Only jobs with
+	// JobFlavorTrigger flavor may trigger other jobs.
+	TriggeredJobIDs []string
+
+	// TriggeringPolicy is serialized messages.TriggeringPolicy proto that
+	// defines how the job handles incoming triggers.
+	//
+	// Nil if the job uses the default policy.
+	TriggeringPolicy []byte
+
+	// MaxConcurrentInvocations is how many invocations of the job can run at
+	// the same time. 0 is same as 1.
+	MaxConcurrentInvocations int
 }
 
 // New returns implementation of Catalog.
@@ -249,6 +265,13 @@ func (cat *catalog) GetProjectJobs(c context.Context, projectID string) ([]Defin
 			logging.Errorf(c, "Failed to marshal the task: %s/%s: %s", projectID, id, err)
 			continue
 		}
+		var policy []byte
+		if job.TriggeringPolicy != nil {
+			if policy, err = proto.Marshal(job.TriggeringPolicy); err != nil {
+				logging.Errorf(c, "Failed to marshal the triggering policy: %s/%s: %s", projectID, id, err)
+				continue
+			}
+		}
 		schedule := job.Schedule
 		if schedule == "" {
 			schedule = defaultJobSchedule
@@ -258,12 +281,15 @@ func (cat *catalog) GetProjectJobs(c context.Context, projectID string) ([]Defin
 			flavor = JobFlavorPeriodic
 		}
 		out = append(out, Definition{
-			JobID:       fmt.Sprintf("%s/%s", projectID, job.Id),
-			Flavor:      flavor,
-			Revision:    meta.Revision,
-			RevisionURL: revisionURL,
-			Schedule:    schedule,
-			Task:        packed,
+			JobID:            fmt.Sprintf("%s/%s", projectID, job.Id),
+			Flavor:           flavor,
+			Revision:         meta.Revision,
+			RevisionURL:      revisionURL,
+			Schedule:         schedule,
+			Task:             packed,
+			TriggeringPolicy: policy,
+
+			MaxConcurrentInvocations: int(job.MaxConcurrentInvocations),
 		})
 	}
 
@@ -290,13 +316,21 @@ func (cat *catalog) GetProjectJobs(c context.Context, projectID string) ([]Defin
 		if schedule == "" {
 			schedule = defaultTriggerSchedule
 		}
+		var triggered []string
+		if len(trigger.Triggers) != 0 {
+			triggered = make([]string, len(trigger.Triggers))
+			for i, jobName := range trigger.Triggers {
+				triggered[i] = fmt.Sprintf("%s/%s", projectID, jobName)
+			}
+		}
 		out = append(out, Definition{
-			JobID:       fmt.Sprintf("%s/%s", projectID, trigger.Id),
-			Flavor:      JobFlavorTrigger,
-			Revision:    meta.Revision,
-			RevisionURL: revisionURL,
-			Schedule:    schedule,
-			Task:        packed,
+			JobID:           fmt.Sprintf("%s/%s", projectID, trigger.Id),
+			Flavor:          JobFlavorTrigger,
+			Revision:        meta.Revision,
+			RevisionURL:     revisionURL,
+			Schedule:        schedule,
+			Task:            packed,
+			TriggeredJobIDs: triggered,
 		})
 	}
 
@@ -346,6 +380,17 @@ func (cat *catalog) validateJobProto(j *messages.Job) (proto.Message, error) {
 			return nil, fmt.Errorf("%s is not valid value for 'schedule' field - %s", j.Schedule, err)
 		}
 	}
+	if p := j.TriggeringPolicy; p != nil {
+		if _, ok := messages.TriggeringPolicy_Kind_name[int32(p.Kind)]; !ok {
+			return nil, fmt.Errorf("unknown triggering policy kind %d", p.Kind)
+		}
+		if p.MaxBatchSize < 0 {
+			return nil, fmt.Errorf("'max_batch_size' must be non-negative, got %d", p.MaxBatchSize)
+		}
+	}
+	if j.MaxConcurrentInvocations < 0 {
+		return nil, fmt.Errorf("'max_concurrent_invocations' must be non-negative, got %d", j.MaxConcurrentInvocations)
+	}
 
 	// Old-style config uses embedded TaskDefWrapper field. New configs have task
 	// definitions right in the Job message.
@@ -373,6 +418,11 @@ func (cat *catalog) validateTriggerProto(t *messages.Trigger) (proto.Message, er
 			return nil, fmt.Errorf("%s is not valid value for 'schedule' field - %s", t.Schedule, err)
 		}
 	}
+	for _, id := range t.Triggers {
+		if !jobIDRe.MatchString(id) {
+			return nil, fmt.Errorf("%q is not valid value for 'triggers' field", id)
+		}
+	}
 	return cat.extractTaskProto(t)
 }
 
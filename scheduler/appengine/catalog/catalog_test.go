@@ -196,28 +196,39 @@ func TestConfigReading(t *testing.T) {
 			So(defs, ShouldResemble, []Definition{
 				{
 					JobID:    "project1/noop-job-1",
-					Revision: "776a16076543daae60a3c9df9a3ea2d7a4067045",
+					Revision: "96d4d984bb795283269e5e10df4d2df1eedb549a",
 					Schedule: "*/10 * * * * * *",
 					Task:     []uint8{0xa, 0x0},
 				},
 				{
-					JobID:    "project1/noop-job-2",
-					Revision: "776a16076543daae60a3c9df9a3ea2d7a4067045",
-					Schedule: "*/10 * * * * * *",
-					Task:     []uint8{0xa, 0x0},
+					JobID:            "project1/noop-job-2",
+					Revision:         "96d4d984bb795283269e5e10df4d2df1eedb549a",
+					Schedule:         "*/10 * * * * * *",
+					Task:             []uint8{0xa, 0x0},
+					TriggeringPolicy: []uint8{0x8, 0x1, 0x10, 0x5},
 				},
 				{
 					JobID:    "project1/urlfetch-job-1",
-					Revision: "776a16076543daae60a3c9df9a3ea2d7a4067045",
+					Revision: "96d4d984bb795283269e5e10df4d2df1eedb549a",
 					Schedule: "*/10 * * * * * *",
 					Task:     []uint8{18, 21, 18, 19, 104, 116, 116, 112, 115, 58, 47, 47, 101, 120, 97, 109, 112, 108, 101, 46, 99, 111, 109},
+
+					MaxConcurrentInvocations: 3,
 				},
 				{
 					JobID:    "project1/urlfetch-job-2",
-					Revision: "776a16076543daae60a3c9df9a3ea2d7a4067045",
+					Revision: "96d4d984bb795283269e5e10df4d2df1eedb549a",
 					Schedule: "*/10 * * * * * *",
 					Task:     []uint8{18, 21, 18, 19, 104, 116, 116, 112, 115, 58, 47, 47, 101, 120, 97, 109, 112, 108, 101, 46, 99, 111, 109},
 				},
+				{
+					JobID:           "project1/noop-trigger",
+					Flavor:          JobFlavorTrigger,
+					Revision:        "96d4d984bb795283269e5e10df4d2df1eedb549a",
+					Schedule:        "with 30s interval",
+					Task:            []uint8{0xa, 0x0},
+					TriggeredJobIDs: []string{"project1/noop-job-1", "project1/noop-job-2"},
+				},
 			})
 
 			// Make sure URL fetch jobs are parsed correctly and identically.
@@ -331,6 +342,32 @@ job {
   id: "noop-job-2"
   schedule: "*/10 * * * * * *"
 
+  triggering_policy: {
+    kind: GREEDY_BATCHING
+    max_batch_size: 5
+  }
+
+  noop: {}
+}
+
+# Will be skipped since 'max_concurrent_invocations' is negative.
+job {
+  id: "broken-concurrency-job"
+  schedule: "*/10 * * * * * *"
+  max_concurrent_invocations: -1
+  noop: {}
+}
+
+# Will be skipped since 'max_batch_size' is negative.
+job {
+  id: "broken-policy-job"
+  schedule: "*/10 * * * * * *"
+
+  triggering_policy: {
+    kind: GREEDY_BATCHING
+    max_batch_size: -1
+  }
+
   noop: {}
 }
 
@@ -345,6 +382,7 @@ job {
 job {
   id: "urlfetch-job-1"
   schedule: "*/10 * * * * * *"
+  max_concurrent_invocations: 3
 
   url_fetch: {
     url: "https://example.com"
@@ -370,6 +408,24 @@ job {
 
   swarming: {}
 }
+
+trigger {
+  id: "noop-trigger"
+
+  triggers: "noop-job-1"
+  triggers: "noop-job-2"
+
+  noop: {}
+}
+
+# Will be skipped since 'triggers' has invalid job ID.
+trigger {
+  id: "broken-trigger"
+
+  triggers: "not a valid ID"
+
+  noop: {}
+}
 `
 
 const project2Cfg = `
@@ -7,6 +7,9 @@ package engine
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
@@ -46,6 +49,10 @@ import (
 var (
 	ErrNoSuchJob        = errors.New("no such job")
 	ErrNoSuchInvocation = errors.New("the invocation doesn't exist")
+
+	// ErrJobAlreadyRunning is returned by TriggerInvocation if the job is
+	// already running and can't have more concurrent invocations.
+	ErrJobAlreadyRunning = errors.New("the job is already running or about to start")
 )
 
 // Engine manages all scheduler jobs: keeps track of their state, runs state
@@ -102,6 +109,12 @@ type Engine interface {
 	// ProcessPubSubPush is called whenever incoming PubSub message is received.
 	ProcessPubSubPush(c context.Context, body []byte) error
 
+	// ProcessCallback is called whenever an HTTP request is POSTed to an URL
+	// produced by task.Controller's PrepareCallbackURL.
+	//
+	// 'token' is the token extracted from the URL, 'body' is the request body.
+	ProcessCallback(c context.Context, token string, body []byte) error
+
 	// PullPubSubOnDevServer is called on dev server to pull messages from PubSub
 	// subscription associated with given publisher.
 	//
@@ -153,6 +166,7 @@ type Config struct {
 	InvocationsQueuePath string          // URL of a task queue handler that starts jobs
 	InvocationsQueueName string          // queue name for job starts
 	PubSubPushPath       string          // URL to use in PubSub push config
+	CallbackPath         string          // URL to use for HTTP callbacks
 }
 
 // NewEngine returns default implementation of Engine.
@@ -194,12 +208,15 @@ type actionTaskPayload struct {
 	InvID int64  `json:",omitempty"` // ID of relevant Invocation
 
 	// For Job actions and timers (InvID == 0).
-	Kind                string `json:",omitempty"` // defines what fields below to examine
-	TickNonce           int64  `json:",omitempty"` // valid for "TickLaterAction" kind
-	InvocationNonce     int64  `json:",omitempty"` // valid for "StartInvocationAction" kind
-	TriggeredBy         string `json:",omitempty"` // valid for "StartInvocationAction" kind
-	Overruns            int    `json:",omitempty"` // valid for "RecordOverrunAction" kind
-	RunningInvocationID int64  `json:",omitempty"` // valid for "RecordOverrunAction" kind
+	Kind                string         `json:",omitempty"` // defines what fields below to examine
+	TickNonce           int64          `json:",omitempty"` // valid for "TickLaterAction" kind
+	InvocationNonce     int64          `json:",omitempty"` // valid for "StartInvocationAction" kind
+	TriggeredBy         string         `json:",omitempty"` // valid for "StartInvocationAction" kind
+	Triggers            []task.Trigger `json:",omitempty"` // valid for "StartInvocationAction", "FanOutTriggers" and "NewTriggers" kinds
+	TriggersLog         []string       `json:",omitempty"` // valid for "StartInvocationAction" kind
+	TriggeredJobIDs     []string       `json:",omitempty"` // valid for "FanOutTriggers" kind
+	Overruns            int            `json:",omitempty"` // valid for "RecordOverrunAction" kind
+	RunningInvocationID int64          `json:",omitempty"` // valid for "RecordOverrunAction" kind
 
 	// For Invocation actions and timers (InvID != 0).
 	InvTimer *invocationTimer `json:",omitempty"` // used for AddTimer calls
@@ -225,6 +242,10 @@ type Job struct {
 	cachedSchedule    *schedule.Schedule `gae:"-"`
 	cachedScheduleErr error              `gae:"-"`
 
+	// cachedPolicy and cachedPolicyErr are used by parseTriggeringPolicy().
+	cachedPolicy    TriggeringPolicy `gae:"-"`
+	cachedPolicyErr error            `gae:"-"`
+
 	// JobID is '<ProjectID>/<JobName>' string. JobName is unique with a project,
 	// but not globally. JobID is unique globally.
 	JobID string `gae:"$id"`
@@ -259,6 +280,21 @@ type Job struct {
 	// of the engine. See Catalog.UnmarshalTask().
 	Task []byte `gae:",noindex"`
 
+	// TriggeredJobIDs is a list of jobIDs of jobs which this job triggers.
+	//
+	// Triggers emitted by the job's invocations (via EmitTrigger) are delivered
+	// to all these jobs.
+	TriggeredJobIDs []string `gae:",noindex"`
+
+	// TriggeringPolicy is serialized messages.TriggeringPolicy proto that
+	// defines how the job handles incoming triggers. Empty for the default
+	// policy. See parseTriggeringPolicy().
+	TriggeringPolicy []byte `gae:",noindex"`
+
+	// MaxConcurrentInvocations is how many invocations of the job can run at
+	// the same time. 0 is same as 1.
+	MaxConcurrentInvocations int `gae:",noindex"`
+
 	// State is the job's state machine state, see StateMachine.
 	State JobState
 }
@@ -297,6 +333,15 @@ func (e *Job) parseSchedule() (*schedule.Schedule, error) {
 	return e.cachedSchedule, e.cachedScheduleErr
 }
 
+// parseTriggeringPolicy returns TriggeringPolicy object, parsing
+// e.TriggeringPolicy field.
+func (e *Job) parseTriggeringPolicy() (TriggeringPolicy, error) {
+	if e.cachedPolicy == nil && e.cachedPolicyErr == nil {
+		e.cachedPolicy, e.cachedPolicyErr = UnmarshalTriggeringPolicy(e.TriggeringPolicy)
+	}
+	return e.cachedPolicy, e.cachedPolicyErr
+}
+
 // isEqual returns true iff 'e' is equal to 'other'.
 func (e *Job) isEqual(other *Job) bool {
 	return e == other || (e.JobID == other.JobID &&
@@ -308,7 +353,10 @@ func (e *Job) isEqual(other *Job) bool {
 		e.RevisionURL == other.RevisionURL &&
 		e.Schedule == other.Schedule &&
 		bytes.Equal(e.Task, other.Task) &&
-		e.State == other.State)
+		equalStringSlices(e.TriggeredJobIDs, other.TriggeredJobIDs) &&
+		bytes.Equal(e.TriggeringPolicy, other.TriggeringPolicy) &&
+		e.MaxConcurrentInvocations == other.MaxConcurrentInvocations &&
+		e.State.isEqual(&other.State))
 }
 
 // matches returns true if job definition in the entity matches the one
@@ -318,7 +366,24 @@ func (e *Job) matches(def catalog.Definition) bool {
 	return e.JobID == def.JobID &&
 		e.Flavor == def.Flavor &&
 		e.Schedule == def.Schedule &&
-		bytes.Equal(e.Task, def.Task)
+		bytes.Equal(e.Task, def.Task) &&
+		equalStringSlices(e.TriggeredJobIDs, def.TriggeredJobIDs) &&
+		bytes.Equal(e.TriggeringPolicy, def.TriggeringPolicy) &&
+		e.MaxConcurrentInvocations == def.MaxConcurrentInvocations
+}
+
+// equalStringSlices returns true if two string slices are equal, treating nil
+// and empty slices as equal.
+func equalStringSlices(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 // Invocation entity stores single attempt to run a job. Its parent entity
@@ -351,6 +416,11 @@ type Invocation struct {
 	// Empty identity string if it was triggered by the service itself.
 	TriggeredBy identity.Identity
 
+	// TriggersRaw is JSON-serialized list of triggers that caused this
+	// invocation to start, if it was started by triggers emitted by some other
+	// job. See Triggers().
+	TriggersRaw []byte `gae:",noindex"`
+
 	// Revision is revision number of config.cfg when this invocation was created.
 	// For informational purpose.
 	Revision string `gae:",noindex"`
@@ -393,6 +463,7 @@ func (e *Invocation) isEqual(other *Invocation) bool {
 		e.Started == other.Started &&
 		e.Finished == other.Finished &&
 		e.InvocationNonce == other.InvocationNonce &&
+		bytes.Equal(e.TriggersRaw, other.TriggersRaw) &&
 		e.Revision == other.Revision &&
 		e.RevisionURL == other.RevisionURL &&
 		bytes.Equal(e.Task, other.Task) &&
@@ -404,6 +475,11 @@ func (e *Invocation) isEqual(other *Invocation) bool {
 		e.MutationsCount == other.MutationsCount)
 }
 
+// Triggers deserializes TriggersRaw.
+func (e *Invocation) Triggers() ([]task.Trigger, error) {
+	return unmarshalTriggers(e.TriggersRaw)
+}
+
 // debugLog appends a line to DebugLog field.
 func (e *Invocation) debugLog(c context.Context, format string, args ...interface{}) {
 	debugLog(c, &e.DebugLog, format, args...)
@@ -877,14 +953,21 @@ func (e *engineImpl) rollSM(c context.Context, job *Job, cb func(*StateMachine)
 	if err != nil {
 		return fmt.Errorf("bad schedule %q - %s", job.effectiveSchedule(), err)
 	}
+	policy, err := job.parseTriggeringPolicy()
+	if err != nil {
+		return fmt.Errorf("bad triggering policy - %s", err)
+	}
 	now := clock.Now(c).UTC()
 	rnd := mathrand.Get(c)
 	sm := StateMachine{
 		State:    job.State,
 		Now:      now,
 		Schedule: sched,
+		Policy:   policy,
 		Nonce:    func() int64 { return rnd.Int63() + 1 },
 		Context:  c,
+
+		MaxConcurrentInvocations: job.MaxConcurrentInvocations,
 	}
 	// All errors returned by state machine transition changes are transient.
 	// Fatal errors (when we have them) should be reflected as a state changing
@@ -933,6 +1016,8 @@ func (e *engineImpl) enqueueJobActions(c context.Context, jobID string, actions
 				Kind:            "StartInvocationAction",
 				InvocationNonce: a.InvocationNonce,
 				TriggeredBy:     string(a.TriggeredBy),
+				Triggers:        a.Triggers,
+				TriggersLog:     a.TriggersLog,
 			})
 			if err != nil {
 				return err
@@ -1024,9 +1109,14 @@ func (e *engineImpl) executeJobAction(c context.Context, payload *actionTaskPayl
 	case "StartInvocationAction":
 		return e.startInvocation(
 			c, payload.JobID, payload.InvocationNonce,
-			identity.Identity(payload.TriggeredBy), retryCount)
+			identity.Identity(payload.TriggeredBy), payload.Triggers,
+			payload.TriggersLog, retryCount)
 	case "RecordOverrunAction":
 		return e.recordOverrun(c, payload.JobID, payload.Overruns, payload.RunningInvocationID)
+	case "FanOutTriggers":
+		return e.fanOutTriggers(c, payload.JobID, payload.TriggeredJobIDs, payload.Triggers)
+	case "NewTriggers":
+		return e.newTriggers(c, payload.JobID, payload.Triggers)
 	default:
 		return fmt.Errorf("unexpected job action kind %q", payload.Kind)
 	}
@@ -1054,13 +1144,17 @@ func (e *engineImpl) TriggerInvocation(c context.Context, jobID string, triggere
 			return errSkipPut
 		}
 		invNonce = 0
-		return e.rollSM(c, job, func(sm *StateMachine) error {
-			if err := sm.OnManualInvocation(triggeredBy); err != nil {
+		rollErr := e.rollSM(c, job, func(sm *StateMachine) error {
+			if err = sm.OnManualInvocation(triggeredBy); err != nil {
 				return err
 			}
 			invNonce = sm.State.InvocationNonce
 			return nil
 		})
+		if err != nil {
+			return errSkipPut // e.g. ErrJobAlreadyRunning, don't retry it
+		}
+		return rollErr
 	})
 	if err == nil {
 		err = err2
@@ -1135,18 +1229,19 @@ func (e *engineImpl) AbortInvocation(c context.Context, jobID string, invID int6
 }
 
 // AbortJob resets the job to scheduled state, aborting a currently pending or
-// running invocation (if any).
+// running invocation (if any), as well as all invocations running concurrently
+// with it.
 //
 // Returns nil if the job is not currently running.
 func (e *engineImpl) AbortJob(c context.Context, jobID string, who identity.Identity) error {
 	// First we switch the job to the default state and disassociate the running
-	// invocation (if any) from the job entity.
-	var invID int64
+	// invocations (if any) from the job entity.
+	var invIDs []int64
 	err := e.txn(c, jobID, func(c context.Context, job *Job, isNew bool) error {
 		if isNew {
 			return errSkipPut // the job was removed, nothing to abort
 		}
-		invID = job.State.InvocationID
+		invIDs = job.State.RunningInvocations()
 		return e.rollSM(c, job, func(sm *StateMachine) error {
 			sm.OnManualAbort()
 			return nil
@@ -1156,13 +1251,14 @@ func (e *engineImpl) AbortJob(c context.Context, jobID string, who identity.Iden
 		return err
 	}
 
-	// Now we kill the invocation. We do it separately because it may involve
+	// Now we kill the invocations. We do it separately because it may involve
 	// an RPC to remote service (e.g. to cancel a task) that can't be done from
 	// the transaction.
-	if invID != 0 {
-		return e.AbortInvocation(c, jobID, invID, who)
+	errs := errors.NewLazyMultiError(len(invIDs))
+	for i, invID := range invIDs {
+		errs.Assign(i, e.AbortInvocation(c, jobID, invID, who))
 	}
-	return nil
+	return errs.Get()
 }
 
 // updateJob updates an existing job if its definition has changed, adds
@@ -1198,6 +1294,9 @@ func (e *engineImpl) updateJob(c context.Context, def catalog.Definition) error
 		job.Enabled = true
 		job.Schedule = def.Schedule
 		job.Task = def.Task
+		job.TriggeredJobIDs = def.TriggeredJobIDs
+		job.TriggeringPolicy = def.TriggeringPolicy
+		job.MaxConcurrentInvocations = def.MaxConcurrentInvocations
 
 		// Do state machine transitions.
 		if !oldEnabled {
@@ -1357,10 +1456,86 @@ func (e *engineImpl) invocationTimerTick(c context.Context, jobID string, invID
 	}
 }
 
+// enqueueTriggers is called within a transaction to asynchronously deliver
+// triggers emitted by an invocation of some job to all jobs it triggers.
+//
+// It adds a single "FanOutTriggers" task (to stay within the limit of
+// transactional tasks), which then adds a task per triggered job. See
+// fanOutTriggers.
+func (e *engineImpl) enqueueTriggers(c context.Context, jobID string, triggeredJobIDs []string, triggers []task.Trigger) error {
+	payload, err := json.Marshal(actionTaskPayload{
+		JobID:           jobID,
+		Kind:            "FanOutTriggers",
+		Triggers:        triggers,
+		TriggeredJobIDs: triggeredJobIDs,
+	})
+	if err != nil {
+		return err
+	}
+	return transient.Tag.Apply(tq.Add(c, e.InvocationsQueueName, &tq.Task{
+		Path:    e.InvocationsQueuePath,
+		Delay:   time.Second, // give the transaction time to land
+		Payload: payload,
+	}))
+}
+
+// fanOutTriggers is invoked via task queue to deliver triggers emitted by
+// some job to all jobs it triggers.
+//
+// It adds one named "NewTriggers" task per triggered job. Task names are
+// derived from the set of triggers, so retries of this call do not result in
+// duplicate deliveries.
+func (e *engineImpl) fanOutTriggers(c context.Context, jobID string, triggeredJobIDs []string, triggers []task.Trigger) error {
+	c = logging.SetField(c, "JobID", jobID)
+	if len(triggers) == 0 {
+		return nil
+	}
+	logging.Infof(c, "Delivering %d trigger(s) to %d job(s)", len(triggers), len(triggeredJobIDs))
+	tasks := make([]*tq.Task, len(triggeredJobIDs))
+	for i, triggeredJobID := range triggeredJobIDs {
+		payload, err := json.Marshal(actionTaskPayload{
+			JobID:    triggeredJobID,
+			Kind:     "NewTriggers",
+			Triggers: triggers,
+		})
+		if err != nil {
+			return err
+		}
+		h := sha256.New()
+		fmt.Fprintf(h, "%s\n", triggeredJobID)
+		for _, t := range triggers {
+			fmt.Fprintf(h, "%s\n", t.ID)
+		}
+		tasks[i] = &tq.Task{
+			Name:    "triggers-" + hex.EncodeToString(h.Sum(nil)),
+			Path:    e.InvocationsQueuePath,
+			Payload: payload,
+		}
+	}
+	err := errors.Filter(tq.Add(c, e.InvocationsQueueName, tasks...), tq.ErrTaskAlreadyAdded)
+	return transient.Tag.Apply(err)
+}
+
+// newTriggers is invoked via task queue to deliver triggers to a job.
+func (e *engineImpl) newTriggers(c context.Context, jobID string, triggers []task.Trigger) error {
+	return e.txn(c, jobID, func(c context.Context, job *Job, isNew bool) error {
+		if isNew || !job.Enabled {
+			logging.Warningf(c, "Skipping %d trigger(s), the job is gone or disabled", len(triggers))
+			return errSkipPut
+		}
+		logging.Infof(c, "Received %d trigger(s)", len(triggers))
+		return e.rollSM(c, job, func(sm *StateMachine) error {
+			sm.OnNewTriggers(triggers)
+			return nil
+		})
+	})
+}
+
 // startInvocation is called via task queue to start running a job. This call
 // may be retried by task queue service.
 func (e *engineImpl) startInvocation(c context.Context, jobID string, invocationNonce int64,
-	triggeredBy identity.Identity, retryCount int) error {
+	triggeredBy identity.Identity, triggers []task.Trigger, triggersLog []string,
+	retryCount int) error {
 
 	c = logging.SetField(c, "JobID", jobID)
 	c = logging.SetField(c, "InvNonce", invocationNonce)
@@ -1402,6 +1577,10 @@ func (e *engineImpl) startInvocation(c context.Context, jobID string, invocation
 		if err != nil {
 			return err
 		}
+		triggersRaw, err := marshalTriggers(triggers)
+		if err != nil {
+			return err
+		}
 		// Put new invocation entity, generate its ID.
 		inv = Invocation{
 			ID:              invID,
@@ -1409,6 +1588,7 @@ func (e *engineImpl) startInvocation(c context.Context, jobID string, invocation
 			Started:         clock.Now(c).UTC(),
 			InvocationNonce: invocationNonce,
 			TriggeredBy:     triggeredBy,
+			TriggersRaw:     triggersRaw,
 			Revision:        job.Revision,
 			RevisionURL:     job.RevisionURL,
 			Task:            job.Task,
@@ -1419,6 +1599,12 @@ func (e *engineImpl) startInvocation(c context.Context, jobID string, invocation
 		if triggeredBy != "" {
 			inv.debugLog(c, "Manually triggered by %s", triggeredBy)
 		}
+		for _, line := range triggersLog {
+			inv.debugLog(c, "%s", line)
+		}
+		for _, t := range triggers {
+			inv.debugLog(c, "Triggered by trigger %q", t.ID)
+		}
 		if retryCount >= invocationRetryLimit {
 			logging.Errorf(c, "Too many attempts, giving up")
 			inv.debugLog(c, "Too many attempts, giving up")
@@ -1646,6 +1832,50 @@ func (e *engineImpl) prepareTopic(c context.Context, params topicParams) (topic
 	return topic, tok, nil
 }
 
+// callbackAuthToken describes how to generate HMAC protected tokens embedded
+// into HTTP callback URLs.
+//
+// They live longer than pubsubAuthToken, since external services may take
+// a while to finish the work.
+var callbackAuthToken = tokens.TokenKind{
+	Algo:       tokens.TokenAlgoHmacSHA256,
+	Expiration: 8 * 24 * time.Hour,
+	SecretKey:  "callback_auth_token",
+	Version:    1,
+}
+
+// prepareCallbackURL returns an URL that can be used to send notifications
+// related to the given invocation over plain HTTP.
+//
+// The URL embeds HMAC protected token that securely identifies the invocation.
+func (e *engineImpl) prepareCallbackURL(c context.Context, inv *Invocation) (string, error) {
+	tok, err := callbackAuthToken.Generate(c, nil, map[string]string{
+		"job": inv.JobKey.StringID(),
+		"inv": fmt.Sprintf("%d", inv.ID),
+	}, 0)
+	if err != nil {
+		return "", err
+	}
+	scheme := "https"
+	if info.IsDevAppServer(c) {
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://%s%s?%s",
+		scheme, info.DefaultVersionHostname(c), e.CallbackPath,
+		url.Values{"token": {tok}}.Encode()), nil
+}
+
+func (e *engineImpl) ProcessCallback(c context.Context, token string, body []byte) error {
+	data, err := callbackAuthToken.Validate(c, token, nil)
+	if err != nil {
+		logging.Errorf(c, "Bad callback token - %s", err)
+		return err
+	}
+	return e.deliverNotification(c, "HTTP callback", data, &pubsub.PubsubMessage{
+		Data: base64.StdEncoding.EncodeToString(body),
+	})
+}
+
 func (e *engineImpl) ProcessPubSubPush(c context.Context, body []byte) error {
 	var pushBody struct {
 		Message pubsub.PubsubMessage `json:"message"`
@@ -1677,16 +1907,25 @@ func (e *engineImpl) handlePubSubMessage(c context.Context, msg *pubsub.PubsubMe
 	logging.Infof(c, "Received PubSub message %q", msg.MessageId)
 
 	// Extract Job and Invocation ID from validated auth_token.
-	var jobID string
-	var invID int64
 	data, err := pubsubAuthToken.Validate(c, msg.Attributes["auth_token"], nil)
 	if err != nil {
 		logging.Errorf(c, "Bad auth_token attribute - %s", err)
 		return err
 	}
-	jobID = data["job"]
-	if invID, err = strconv.ParseInt(data["inv"], 10, 64); err != nil {
-		logging.Errorf(c, "Could not parse 'inv' %q - %s", data["inv"], err)
+	return e.deliverNotification(c, "PubSub", data, msg)
+}
+
+// deliverNotification routes a notification to the task.Manager that handles
+// the invocation identified by 'tokenData' (as extracted from a validated
+// pubsubAuthToken or callbackAuthToken).
+//
+// 'source' is used in the invocation debug log.
+func (e *engineImpl) deliverNotification(c context.Context, source string, tokenData map[string]string, msg *pubsub.PubsubMessage) error {
+	var err error
+	var invID int64
+	jobID := tokenData["job"]
+	if invID, err = strconv.ParseInt(tokenData["inv"], 10, 64); err != nil {
+		logging.Errorf(c, "Could not parse 'inv' %q - %s", tokenData["inv"], err)
 		return err
 	}
 
@@ -1719,7 +1958,7 @@ func (e *engineImpl) handlePubSubMessage(c context.Context, msg *pubsub.PubsubMe
 	if err != nil {
 		logging.Errorf(c, "Error when handling the message - %s", err)
 		if !transient.Tag.In(err) && ctl.State().Status != task.StatusFailed {
-			ctl.DebugLog("Fatal error when handling PubSub notification, aborting invocation - %s", err)
+			ctl.DebugLog("Fatal error when handling %s notification, aborting invocation - %s", source, err)
 			ctl.State().Status = task.StatusFailed
 		}
 	}
@@ -1755,6 +1994,7 @@ type taskController struct {
 	state    task.State        // state mutated by TaskManager
 	debugLog string            // mutated by DebugLog
 	timers   []invocationTimer // mutated by AddTimer
+	triggers []task.Trigger    // mutated by EmitTrigger
 }
 
 // populateState populates 'state' using data in 'saved'.
@@ -1786,6 +2026,15 @@ func (ctl *taskController) Task() proto.Message {
 	return ctl.task
 }
 
+// Triggers is part of task.Controller interface.
+func (ctl *taskController) Triggers() []task.Trigger {
+	triggers, err := ctl.saved.Triggers()
+	if err != nil {
+		logging.WithError(err).Errorf(ctl.ctx, "Failed to deserialize the list of triggers")
+	}
+	return triggers
+}
+
 // State is part of task.Controller interface.
 func (ctl *taskController) State() *task.State {
 	return &ctl.state
@@ -1807,6 +2056,12 @@ func (ctl *taskController) AddTimer(ctx context.Context, delay time.Duration, na
 	})
 }
 
+// EmitTrigger is part of task.Controller interface.
+func (ctl *taskController) EmitTrigger(ctx context.Context, trigger task.Trigger) {
+	ctl.DebugLog("Emitting trigger %q", trigger.ID)
+	ctl.triggers = append(ctl.triggers, trigger)
+}
+
 // PrepareTopic is part of task.Controller interface.
 func (ctl *taskController) PrepareTopic(ctx context.Context, publisher string) (topic string, token string, err error) {
 	return ctl.eng.prepareTopic(ctx, topicParams{
@@ -1816,6 +2071,11 @@ func (ctl *taskController) PrepareTopic(ctx context.Context, publisher string) (
 	})
 }
 
+// PrepareCallbackURL is part of task.Controller interface.
+func (ctl *taskController) PrepareCallbackURL(ctx context.Context) (string, error) {
+	return ctl.eng.prepareCallbackURL(ctx, &ctl.saved)
+}
+
 // GetClient is part of task.Controller interface
 func (ctl *taskController) GetClient(ctx context.Context, timeout time.Duration) (*http.Client, error) {
 	// TODO(vadimsh): Use per-project service accounts, not a global service
@@ -1848,7 +2108,7 @@ func (ctl *taskController) saveImpl(ctx context.Context, updateJob bool) (err er
 	saving.TaskData = append([]byte(nil), ctl.state.TaskData...)
 	saving.ViewURL = ctl.state.ViewURL
 	saving.DebugLog += ctl.debugLog
-	if saving.isEqual(&ctl.saved) && len(ctl.timers) == 0 { // no changes at all?
+	if saving.isEqual(&ctl.saved) && len(ctl.timers) == 0 && len(ctl.triggers) == 0 { // no changes at all?
 		return nil
 	}
 	saving.MutationsCount++
@@ -1857,8 +2117,9 @@ func (ctl *taskController) saveImpl(ctx context.Context, updateJob bool) (err er
 	defer func() {
 		if err == nil {
 			ctl.saved = saving
-			ctl.debugLog = "" // debug log was successfully flushed
-			ctl.timers = nil  // timers were successfully scheduled
+			ctl.debugLog = ""  // debug log was successfully flushed
+			ctl.timers = nil   // timers were successfully scheduled
+			ctl.triggers = nil // triggers were successfully emitted
 		}
 	}()
 
@@ -1917,6 +2178,23 @@ func (ctl *taskController) saveImpl(ctx context.Context, updateJob bool) (err er
 			}
 		}
 
+		// Deliver emitted triggers to all triggered jobs. Do it regardless of
+		// the state of the Job entity too: triggers represent events that have
+		// already happened (e.g. new commits), it's not a good idea to drop them.
+		if len(ctl.triggers) > 0 {
+			switch {
+			case isNew:
+				logging.Warningf(c, "Dropping %d trigger(s), the job is gone", len(ctl.triggers))
+			case len(job.TriggeredJobIDs) == 0:
+				logging.Warningf(c, "Dropping %d trigger(s), the job doesn't trigger anything", len(ctl.triggers))
+			default:
+				err := ctl.eng.enqueueTriggers(c, job.JobID, job.TriggeredJobIDs, ctl.triggers)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
 		// Is Job entity still have this invocation as a current one?
 		switch {
 		case !updateJob:
@@ -1925,7 +2203,7 @@ func (ctl *taskController) saveImpl(ctx context.Context, updateJob bool) (err er
 		case isNew:
 			logging.Errorf(c, "Active job is unexpectedly gone")
 			return errSkipPut
-		case job.State.InvocationID != saving.ID:
+		case !job.State.IsActiveInvocation(saving.ID):
 			logging.Warningf(c, "The invocation is no longer current, the current is %d", job.State.InvocationID)
 			return errSkipPut
 		}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/luci/luci-go/scheduler/appengine/catalog"
 	"github.com/luci/luci-go/scheduler/appengine/messages"
+	"github.com/luci/luci-go/scheduler/appengine/schedule"
 	"github.com/luci/luci-go/scheduler/appengine/task"
 	"github.com/luci/luci-go/scheduler/appengine/task/noop"
 
@@ -769,7 +770,7 @@ func TestAborts(t *testing.T) {
 				So(ctl.Save(ctx), ShouldBeNil)
 				return nil
 			}
-			So(e.startInvocation(c, jobID, invNonce, "", 0), ShouldBeNil)
+			So(e.startInvocation(c, jobID, invNonce, "", nil, nil, 0), ShouldBeNil)
 
 			// It is alive and the job entity tracks it.
 			inv, err := e.GetInvocation(c, jobID, invID)
@@ -850,7 +851,7 @@ func TestAddTimer(t *testing.T) {
 				ctl.State().Status = task.StatusRunning
 				return nil
 			}
-			So(e.startInvocation(c, jobID, invNonce, "", 0), ShouldBeNil)
+			So(e.startInvocation(c, jobID, invNonce, "", nil, nil, 0), ShouldBeNil)
 
 			// The job is running.
 			job, err := e.GetJob(c, jobID)
@@ -957,6 +958,103 @@ func TestTrimDebugLog(t *testing.T) {
 	})
 }
 
+func TestEmitTriggers(t *testing.T) {
+	Convey("with mock jobs", t, func() {
+		c := newTestContext(epoch)
+		e, mgr := newTestEngine()
+
+		// A triggering job in "QUEUED" state (about to run an invocation).
+		const jobID = "abc/1"
+		const invNonce = int64(12345)
+		prepareQueuedJob(c, jobID, invNonce)
+		job, err := e.GetJob(c, jobID)
+		So(err, ShouldBeNil)
+		job.TriggeredJobIDs = []string{"abc/2"}
+		So(ds.Put(c, job), ShouldBeNil)
+
+		// A triggered job, waiting for triggers.
+		So(ds.Put(c, &Job{
+			JobID:     "abc/2",
+			ProjectID: "abc",
+			Enabled:   true,
+			Task:      noopTaskBytes(),
+			Schedule:  "triggered",
+			State: JobState{
+				State:     JobStateSuspended,
+				TickTime:  schedule.DistantFuture,
+				TickNonce: 1,
+			},
+		}), ShouldBeNil)
+
+		trigger := task.Trigger{
+			ID: "trigger-1",
+			Gitiles: &task.GitilesTrigger{
+				Repo:     "https://example.googlesource.com/repo",
+				Ref:      "refs/heads/master",
+				Revision: "deadbeef",
+			},
+		}
+
+		Convey("EmitTrigger works", func() {
+			// Start an invocation that emits a trigger.
+			mgr.launchTask = func(ctx context.Context, ctl task.Controller) error {
+				ctl.EmitTrigger(ctx, trigger)
+				ctl.State().Status = task.StatusSucceeded
+				return nil
+			}
+			So(e.startInvocation(c, jobID, invNonce, "", nil, nil, 0), ShouldBeNil)
+
+			// Added a task to fan out triggers.
+			tqt := ensureOneTask(c, "invs-q")
+			payload := actionTaskPayload{}
+			So(json.Unmarshal(tqt.Payload, &payload), ShouldBeNil)
+			So(payload, ShouldResemble, actionTaskPayload{
+				JobID:           "abc/1",
+				Kind:            "FanOutTriggers",
+				Triggers:        []task.Trigger{trigger},
+				TriggeredJobIDs: []string{"abc/2"},
+			})
+			tq.GetTestable(c).ResetTasks()
+
+			// It adds a task to deliver the trigger to the triggered job.
+			So(e.ExecuteSerializedAction(c, tqt.Payload, 0), ShouldBeNil)
+			tqt = ensureOneTask(c, "invs-q")
+			So(tqt.Name, ShouldStartWith, "triggers-")
+			payload = actionTaskPayload{}
+			So(json.Unmarshal(tqt.Payload, &payload), ShouldBeNil)
+			So(payload, ShouldResemble, actionTaskPayload{
+				JobID:    "abc/2",
+				Kind:     "NewTriggers",
+				Triggers: []task.Trigger{trigger},
+			})
+			tq.GetTestable(c).ResetTasks()
+
+			// The triggered job gets queued.
+			So(e.ExecuteSerializedAction(c, tqt.Payload, 0), ShouldBeNil)
+			job, err := e.GetJob(c, "abc/2")
+			So(err, ShouldBeNil)
+			So(job.State.State, ShouldEqual, JobStateQueued)
+			tqt = ensureOneTask(c, "invs-q")
+			tq.GetTestable(c).ResetTasks()
+
+			// The invocation receives the trigger.
+			var received []task.Trigger
+			mgr.launchTask = func(ctx context.Context, ctl task.Controller) error {
+				received = ctl.Triggers()
+				ctl.State().Status = task.StatusSucceeded
+				return nil
+			}
+			So(e.ExecuteSerializedAction(c, tqt.Payload, 0), ShouldBeNil)
+			So(received, ShouldResemble, []task.Trigger{trigger})
+
+			// The triggered job is back to waiting for triggers.
+			job, err = e.GetJob(c, "abc/2")
+			So(err, ShouldBeNil)
+			So(job.State.State, ShouldEqual, JobStateSuspended)
+		})
+	})
+}
+
 ////
 
 func newTestContext(now time.Time) context.Context {
@@ -0,0 +1,131 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/luci/luci-go/scheduler/appengine/messages"
+	"github.com/luci/luci-go/scheduler/appengine/task"
+)
+
+// TriggeringPolicy decides how pending triggers are converted into
+// invocations.
+//
+// It is consulted by the state machine each time the job is ready to start
+// a new invocation and there are pending triggers. See messages.TriggeringPolicy
+// for the list of supported policies.
+type TriggeringPolicy interface {
+	// Pick splits a non-empty list of pending triggers (oldest first) into ones
+	// to pass to a new invocation, ones to keep in the queue and ones to
+	// discard.
+	Pick(pending []task.Trigger) PolicyDecision
+}
+
+// PolicyDecision is returned by TriggeringPolicy.Pick.
+type PolicyDecision struct {
+	Triggers  []task.Trigger // triggers to pass to the new invocation
+	Pending   []task.Trigger // triggers to keep in the queue, oldest first
+	Discarded []task.Trigger // triggers that will never be processed
+	Reason    string         // human readable explanation of the decision
+}
+
+// Log returns lines to put into the debug log of the invocation to explain
+// the decision.
+func (d *PolicyDecision) Log() []string {
+	out := make([]string, 0, len(d.Discarded)+1)
+	out = append(out, d.Reason)
+	for _, t := range d.Discarded {
+		out = append(out, fmt.Sprintf("Discarded trigger %q", t.ID))
+	}
+	return out
+}
+
+// NewTriggeringPolicy returns TriggeringPolicy implementation given its
+// definition.
+//
+// nil definition corresponds to the default ONE_BY_ONE policy.
+func NewTriggeringPolicy(def *messages.TriggeringPolicy) (TriggeringPolicy, error) {
+	if def == nil {
+		return oneByOnePolicy{}, nil
+	}
+	switch def.Kind {
+	case messages.TriggeringPolicy_ONE_BY_ONE:
+		return oneByOnePolicy{}, nil
+	case messages.TriggeringPolicy_GREEDY_BATCHING:
+		if def.MaxBatchSize < 0 {
+			return nil, fmt.Errorf("max_batch_size must be non-negative, got %d", def.MaxBatchSize)
+		}
+		return greedyBatchingPolicy{maxBatchSize: int(def.MaxBatchSize)}, nil
+	case messages.TriggeringPolicy_NEWEST_ONLY:
+		return newestOnlyPolicy{}, nil
+	default:
+		return nil, fmt.Errorf("unknown triggering policy kind %s", def.Kind)
+	}
+}
+
+// UnmarshalTriggeringPolicy deserializes messages.TriggeringPolicy proto and
+// returns corresponding TriggeringPolicy implementation.
+//
+// Empty blob corresponds to the default ONE_BY_ONE policy.
+func UnmarshalTriggeringPolicy(blob []byte) (TriggeringPolicy, error) {
+	if len(blob) == 0 {
+		return NewTriggeringPolicy(nil)
+	}
+	def := &messages.TriggeringPolicy{}
+	if err := proto.Unmarshal(blob, def); err != nil {
+		return nil, err
+	}
+	return NewTriggeringPolicy(def)
+}
+
+// oneByOnePolicy starts a separate invocation for each trigger.
+type oneByOnePolicy struct{}
+
+func (oneByOnePolicy) Pick(pending []task.Trigger) PolicyDecision {
+	return PolicyDecision{
+		Triggers: pending[:1],
+		Pending:  pending[1:],
+		Reason: fmt.Sprintf(
+			"Processing trigger %q per ONE_BY_ONE triggering policy, %d more trigger(s) pending",
+			pending[0].ID, len(pending)-1),
+	}
+}
+
+// greedyBatchingPolicy passes all pending triggers to a single invocation.
+type greedyBatchingPolicy struct {
+	maxBatchSize int // 0 for unlimited
+}
+
+func (p greedyBatchingPolicy) Pick(pending []task.Trigger) PolicyDecision {
+	size := len(pending)
+	if p.maxBatchSize != 0 && size > p.maxBatchSize {
+		size = p.maxBatchSize
+	}
+	return PolicyDecision{
+		Triggers: pending[:size],
+		Pending:  pending[size:],
+		Reason: fmt.Sprintf(
+			"Batching %d trigger(s) into a single invocation per GREEDY_BATCHING triggering policy, %d more trigger(s) pending",
+			size, len(pending)-size),
+	}
+}
+
+// newestOnlyPolicy passes only the most recent trigger to an invocation,
+// discarding all older ones.
+type newestOnlyPolicy struct{}
+
+func (newestOnlyPolicy) Pick(pending []task.Trigger) PolicyDecision {
+	newest := pending[len(pending)-1]
+	return PolicyDecision{
+		Triggers:  pending[len(pending)-1:],
+		Discarded: pending[:len(pending)-1],
+		Reason: fmt.Sprintf(
+			"Processing the newest trigger %q per NEWEST_ONLY triggering policy, discarding %d older trigger(s)",
+			newest.ID, len(pending)-1),
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/luci/luci-go/scheduler/appengine/messages"
+	"github.com/luci/luci-go/scheduler/appengine/task"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTriggeringPolicy(t *testing.T) {
+	t.Parallel()
+
+	t1 := task.Trigger{ID: "t1"}
+	t2 := task.Trigger{ID: "t2"}
+	t3 := task.Trigger{ID: "t3"}
+	all := []task.Trigger{t1, t2, t3}
+
+	Convey("UnmarshalTriggeringPolicy works", t, func() {
+		p, err := UnmarshalTriggeringPolicy(nil)
+		So(err, ShouldBeNil)
+		So(p, ShouldResemble, oneByOnePolicy{})
+
+		blob, err := proto.Marshal(&messages.TriggeringPolicy{
+			Kind:         messages.TriggeringPolicy_GREEDY_BATCHING,
+			MaxBatchSize: 10,
+		})
+		So(err, ShouldBeNil)
+		p, err = UnmarshalTriggeringPolicy(blob)
+		So(err, ShouldBeNil)
+		So(p, ShouldResemble, greedyBatchingPolicy{maxBatchSize: 10})
+
+		_, err = UnmarshalTriggeringPolicy([]byte("garbage"))
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("NewTriggeringPolicy validates", t, func() {
+		_, err := NewTriggeringPolicy(&messages.TriggeringPolicy{
+			Kind:         messages.TriggeringPolicy_GREEDY_BATCHING,
+			MaxBatchSize: -1,
+		})
+		So(err, ShouldNotBeNil)
+
+		_, err = NewTriggeringPolicy(&messages.TriggeringPolicy{Kind: 123})
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("ONE_BY_ONE", t, func() {
+		d := oneByOnePolicy{}.Pick(all)
+		So(d.Triggers, ShouldResemble, []task.Trigger{t1})
+		So(d.Pending, ShouldResemble, []task.Trigger{t2, t3})
+		So(d.Discarded, ShouldHaveLength, 0)
+	})
+
+	Convey("GREEDY_BATCHING unlimited", t, func() {
+		d := greedyBatchingPolicy{}.Pick(all)
+		So(d.Triggers, ShouldResemble, all)
+		So(d.Pending, ShouldHaveLength, 0)
+		So(d.Discarded, ShouldHaveLength, 0)
+	})
+
+	Convey("GREEDY_BATCHING limited", t, func() {
+		d := greedyBatchingPolicy{maxBatchSize: 2}.Pick(all)
+		So(d.Triggers, ShouldResemble, []task.Trigger{t1, t2})
+		So(d.Pending, ShouldResemble, []task.Trigger{t3})
+		So(d.Discarded, ShouldHaveLength, 0)
+	})
+
+	Convey("NEWEST_ONLY", t, func() {
+		d := newestOnlyPolicy{}.Pick(all)
+		So(d.Triggers, ShouldResemble, []task.Trigger{t3})
+		So(d.Pending, ShouldHaveLength, 0)
+		So(d.Discarded, ShouldResemble, []task.Trigger{t1, t2})
+		So(d.Log(), ShouldResemble, []string{
+			`Processing the newest trigger "t3" per NEWEST_ONLY triggering policy, discarding 2 older trigger(s)`,
+			`Discarded trigger "t1"`,
+			`Discarded trigger "t2"`,
+		})
+	})
+}
@@ -5,13 +5,16 @@
 package engine
 
 import (
-	"errors"
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"golang.org/x/net/context"
 
+	"github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/scheduler/appengine/schedule"
+	"github.com/luci/luci-go/scheduler/appengine/task"
 	"github.com/luci/luci-go/server/auth/identity"
 )
 
@@ -29,7 +32,9 @@ const (
 	JobStateDisabled StateKind = "DISABLED"
 
 	// JobStateScheduled means the job is scheduled to start sometime in
-	// the future and previous invocation is NOT running currently.
+	// the future and previous invocation is NOT running currently. Invocations
+	// started concurrently with it (see JobState.ActiveInvocations) may still be
+	// running though.
 	JobStateScheduled StateKind = "SCHEDULED"
 
 	// JobStateSuspended means the job is not running now, and no ticks are
@@ -61,7 +66,8 @@ const (
 
 	// JobStateOverrun is same as "RUNNING", except the engine has also detected
 	// an overrun: the job's new invocation should have been started by now, but
-	// the previous one is still running.
+	// the previous one is still running (and the limit of concurrently running
+	// invocations doesn't allow to start another one).
 	JobStateOverrun StateKind = "OVERRUN"
 
 	// JobStateSlowQueue is same as "QUEUED", except the engine has also detected
@@ -103,6 +109,64 @@ type JobState struct {
 
 	// InvocationID is ID of currently running invocation or 0 if none is running.
 	InvocationID int64 `gae:",noindex"`
+
+	// ActiveInvocations is a list of IDs of invocations that are still running,
+	// but are no longer tracked via InvocationNonce and InvocationID, because
+	// the job has started another invocation concurrently with them.
+	//
+	// Always empty for jobs that don't allow concurrent invocations. See
+	// StateMachine.MaxConcurrentInvocations.
+	ActiveInvocations []int64 `gae:",noindex"`
+
+	// PendingTriggersRaw is JSON-serialized list of triggers (oldest first) that
+	// were delivered to the job, but haven't been processed by any invocation
+	// yet. See PendingTriggers().
+	PendingTriggersRaw []byte `gae:",noindex"`
+}
+
+// isEqual returns true iff 's' is equal to 'other'.
+func (s *JobState) isEqual(other *JobState) bool {
+	return s == other || (s.State == other.State &&
+		s.Overruns == other.Overruns &&
+		s.TickNonce == other.TickNonce &&
+		s.TickTime == other.TickTime &&
+		s.PrevTime == other.PrevTime &&
+		s.InvocationNonce == other.InvocationNonce &&
+		s.InvocationRetryCount == other.InvocationRetryCount &&
+		s.InvocationTime == other.InvocationTime &&
+		s.InvocationID == other.InvocationID &&
+		equalInt64Slices(s.ActiveInvocations, other.ActiveInvocations) &&
+		bytes.Equal(s.PendingTriggersRaw, other.PendingTriggersRaw))
+}
+
+// RunningInvocations returns IDs of all invocations of the job that are
+// starting or running now, including ones running concurrently.
+func (s *JobState) RunningInvocations() []int64 {
+	out := make([]int64, 0, len(s.ActiveInvocations)+1)
+	out = append(out, s.ActiveInvocations...)
+	if s.InvocationID != 0 {
+		out = append(out, s.InvocationID)
+	}
+	return out
+}
+
+// IsActiveInvocation returns true if the given invocation is the current
+// invocation of the job or one of invocations running concurrently with it.
+func (s *JobState) IsActiveInvocation(invocationID int64) bool {
+	if s.InvocationID == invocationID {
+		return true
+	}
+	for _, id := range s.ActiveInvocations {
+		if id == invocationID {
+			return true
+		}
+	}
+	return false
+}
+
+// PendingTriggers deserializes PendingTriggersRaw.
+func (s *JobState) PendingTriggers() ([]task.Trigger, error) {
+	return unmarshalTriggers(s.PendingTriggersRaw)
 }
 
 // IsExpectingInvocation returns true if the state machine accepts
@@ -142,6 +206,8 @@ func (a TickLaterAction) IsAction() bool { return true }
 type StartInvocationAction struct {
 	InvocationNonce int64
 	TriggeredBy     identity.Identity
+	Triggers        []task.Trigger
+	TriggersLog     []string // explains how triggers were picked, for debug log
 }
 
 // IsAction makes StartInvocationAction implement Action interface.
@@ -173,12 +239,22 @@ func (a RecordOverrunAction) IsAction() bool { return true }
 //
 // The lifecycle of a healthy job:
 // DISABLED -> SCHEDULED -> QUEUED -> QUEUED (starting) -> RUNNING -> SCHEDULED
+//
+// If the job allows concurrent invocations, a RUNNING job may go back to QUEUED
+// state when it is time to start a new invocation. The running invocation is
+// then moved to JobState.ActiveInvocations list.
 type StateMachine struct {
 	// Inputs.
 	Now      time.Time          // current time
 	Schedule *schedule.Schedule // knows when to run the job next time
+	Policy   TriggeringPolicy   // decides what triggers to process, nil for default
 	Nonce    func() int64       // produces a series of nonces on demand
 
+	// MaxConcurrentInvocations is how many invocations can run at once.
+	//
+	// Values less than 1 are treated as 1.
+	MaxConcurrentInvocations int
+
 	// Mutated.
 	State   JobState // state of the job, mutated in On* methods
 	Actions []Action // emitted actions
@@ -232,30 +308,34 @@ func (m *StateMachine) OnTimerTick(tickNonce int64) error {
 		m.resetTick()
 	}
 
-	// Was waiting for a tick to start a job? Add invocation to the queue.
-	if m.State.State == JobStateScheduled {
-		m.State.State = JobStateQueued
-		m.queueInvocation("")
+	// Was waiting for a tick to start a job (or can start one more invocation
+	// concurrently with the running one)? Add invocation to the queue.
+	if m.canQueueInvocation() {
+		m.queueNewInvocation("", nil, nil)
 		return nil
 	}
 
-	// Already running a job (or have one in the queue) and it's time to launch
-	// a new invocation? Skip this tick completely.
+	// Already running as many invocations as allowed (or have one in the queue)
+	// and it's time to launch a new invocation? Skip this tick completely.
 	//
-	// TODO(vadimsh): Make overrun policy configurable. Also handle permanently
-	// stuck jobs.
+	// TODO(vadimsh): Handle permanently stuck jobs.
+	runningID := m.State.InvocationID
 	switch m.State.State {
 	case JobStateRunning, JobStateOverrun:
 		m.State.State = JobStateOverrun
 	case JobStateQueued, JobStateSlowQueue:
 		m.State.State = JobStateSlowQueue
+	case JobStateScheduled:
+		// All slots are occupied by concurrently running invocations. Can happen
+		// if the limit was lowered while they were running.
+		runningID = m.State.ActiveInvocations[len(m.State.ActiveInvocations)-1]
 	default:
 		impossible("impossible state %s", m.State.State)
 	}
 	m.State.Overruns++
 	m.emitAction(RecordOverrunAction{
 		Overruns:            m.State.Overruns,
-		RunningInvocationID: m.State.InvocationID,
+		RunningInvocationID: runningID,
 	})
 	return nil
 }
@@ -287,6 +367,12 @@ func (m *StateMachine) OnInvocationStarted(invocationID int64) {
 
 // OnInvocationDone happens when invocation completes.
 func (m *StateMachine) OnInvocationDone(invocationID int64) {
+	// One of concurrently running invocations has finished? Forget about it and
+	// use the freed slot to process pending triggers, if any.
+	if m.removeActiveInvocation(invocationID) {
+		m.maybeProcessTriggers()
+		return
+	}
 	// Ignore unexpected events. Can happen if job was moved to disabled state
 	// while invocation was still running.
 	if m.State.State != JobStateRunning && m.State.State != JobStateOverrun {
@@ -338,25 +424,61 @@ func (m *StateMachine) OnScheduleChange() {
 
 // OnManualInvocation happens when user starts invocation via "Run now" button.
 // Manual invocation only works if the job is currently not running or not
-// queued for run (i.e. it is in Scheduled state waiting for a timer tick).
+// queued for run (i.e. it is in Scheduled state waiting for a timer tick), or
+// if it is running, but the limit of concurrent invocations is not reached yet.
 func (m *StateMachine) OnManualInvocation(triggeredBy identity.Identity) error {
-	if m.State.State != JobStateScheduled && m.State.State != JobStateSuspended {
-		return errors.New("the job is already running or about to start")
+	if !m.canQueueInvocation() {
+		return ErrJobAlreadyRunning
 	}
-	m.State.State = JobStateQueued
-	m.queueInvocation(triggeredBy)
+	m.queueNewInvocation(triggeredBy, nil, nil)
 	if !m.Schedule.IsAbsolute() {
 		m.resetTick() // will be set again when invocation ends
 	}
 	return nil
 }
 
-// OnManualAbort happens when users aborts the queued or running invocation.
+// OnNewTriggers happens when some other job emits triggers destined for this
+// job.
+//
+// Triggers are appended to the queue of pending triggers (skipping ones that
+// are already there). If the job is not running currently (or can run one more
+// invocation concurrently), a new invocation is queued right away to process
+// pending triggers. Otherwise the triggers will be processed when the current
+// invocation finishes.
+func (m *StateMachine) OnNewTriggers(triggers []task.Trigger) {
+	if m.State.State == JobStateDisabled {
+		return
+	}
+	pending := m.pendingTriggers()
+	seen := make(map[string]bool, len(pending))
+	for _, t := range pending {
+		seen[t.ID] = true
+	}
+	for _, t := range triggers {
+		if !seen[t.ID] {
+			seen[t.ID] = true
+			pending = append(pending, t)
+		}
+	}
+	m.setPendingTriggers(pending)
+	m.maybeProcessTriggers()
+}
+
+// OnManualAbort happens when users aborts the queued or running invocation
+// (along with all invocations running concurrently with it).
 func (m *StateMachine) OnManualAbort() {
 	// Pretend that it is finished. InvocationNonce is not 0 only if an invocation
 	// is running or queued.
 	if m.State.InvocationNonce != 0 {
+		m.State.ActiveInvocations = nil
 		m.invocationFinished()
+		return
+	}
+	// Only concurrently running invocations are left? The job is waiting for
+	// a tick already, keep it. Just free the slots.
+	if len(m.State.ActiveInvocations) != 0 {
+		m.State.ActiveInvocations = nil
+		m.maybeProcessTriggers()
 	}
 }
 
@@ -386,6 +508,59 @@ func (m *StateMachine) invocationFinished() {
 	m.resetInvocation()      // forget about just finished invocation
 	m.scheduleTick()         // start waiting for a new one
 	m.maybeSuspendOrResume() // switch back to suspended state if necessary
+	m.maybeProcessTriggers() // start processing pending triggers, if any
+}
+
+// maybeProcessTriggers queues an invocation to process pending triggers if
+// the job is waiting for a tick (i.e. not running currently) or can run one
+// more invocation concurrently.
+//
+// What triggers to pass to the invocation is decided by the triggering policy.
+func (m *StateMachine) maybeProcessTriggers() {
+	if !m.canQueueInvocation() {
+		return
+	}
+	pending := m.pendingTriggers()
+	if len(pending) == 0 {
+		return
+	}
+	policy := m.Policy
+	if policy == nil {
+		policy, _ = NewTriggeringPolicy(nil)
+	}
+	decision := policy.Pick(pending)
+	m.setPendingTriggers(decision.Pending)
+	if len(decision.Triggers) == 0 {
+		return // everything was discarded
+	}
+	m.queueNewInvocation("", decision.Triggers, decision.Log())
+	if !m.Schedule.IsAbsolute() {
+		m.resetTick() // will be set again when invocation ends
+	}
+}
+
+// pendingTriggers returns a list of pending triggers stored in the state.
+//
+// Broken list (should not really happen) is logged and discarded, to avoid
+// getting the job stuck.
+func (m *StateMachine) pendingTriggers() []task.Trigger {
+	triggers, err := m.State.PendingTriggers()
+	if err != nil {
+		if m.Context != nil {
+			logging.WithError(err).Errorf(m.Context, "Discarding broken list of pending triggers")
+		}
+		return nil
+	}
+	return triggers
+}
+
+// setPendingTriggers serializes the list of pending triggers into the state.
+func (m *StateMachine) setPendingTriggers(triggers []task.Trigger) {
+	blob, err := marshalTriggers(triggers)
+	if err != nil {
+		impossible("failed to serialize triggers - %s", err)
+	}
+	m.State.PendingTriggersRaw = blob
 }
 
 // maybeSuspendOrResume switches SCHEDULED state to SUSPENDED state in case
@@ -406,9 +581,65 @@ func (m *StateMachine) resetTick() {
 	m.State.TickNonce = 0
 }
 
+// maxConcurrentInvocations returns the effective limit on number of
+// concurrently running invocations.
+func (m *StateMachine) maxConcurrentInvocations() int {
+	if m.MaxConcurrentInvocations < 1 {
+		return 1
+	}
+	return m.MaxConcurrentInvocations
+}
+
+// canQueueInvocation returns true if a new invocation can be queued right now
+// without exceeding the limit of concurrently running invocations.
+//
+// It is possible only if the job is waiting for a tick, or if it is running
+// an invocation (not queued or starting it) and the limit allows one more.
+func (m *StateMachine) canQueueInvocation() bool {
+	switch m.State.State {
+	case JobStateScheduled, JobStateSuspended:
+		return len(m.State.ActiveInvocations) < m.maxConcurrentInvocations()
+	case JobStateRunning, JobStateOverrun:
+		return len(m.State.ActiveInvocations)+1 < m.maxConcurrentInvocations()
+	}
+	return false
+}
+
+// queueNewInvocation switches the job to QUEUED state and queues a new
+// invocation.
+//
+// If the job is running an invocation currently, it is moved to the list of
+// ActiveInvocations to let it finish concurrently with the new one. Must be
+// called only if canQueueInvocation() returns true.
+func (m *StateMachine) queueNewInvocation(triggeredBy identity.Identity, triggers []task.Trigger, triggersLog []string) {
+	if m.State.State == JobStateRunning || m.State.State == JobStateOverrun {
+		m.State.ActiveInvocations = append(m.State.ActiveInvocations, m.State.InvocationID)
+	}
+	m.State.State = JobStateQueued
+	m.queueInvocation(triggeredBy, triggers, triggersLog)
+}
+
+// removeActiveInvocation removes the invocation from the list of
+// ActiveInvocations. Returns false if it wasn't there.
+func (m *StateMachine) removeActiveInvocation(invocationID int64) bool {
+	for i, id := range m.State.ActiveInvocations {
+		if id == invocationID {
+			active := make([]int64, 0, len(m.State.ActiveInvocations)-1)
+			active = append(active, m.State.ActiveInvocations[:i]...)
+			active = append(active, m.State.ActiveInvocations[i+1:]...)
+			if len(active) == 0 {
+				active = nil
+			}
+			m.State.ActiveInvocations = active
+			return true
+		}
+	}
+	return false
+}
+
 // queueInvocation generates a new invocation nonce and asks engine to start
 // a new invocation.
-func (m *StateMachine) queueInvocation(triggeredBy identity.Identity) {
+func (m *StateMachine) queueInvocation(triggeredBy identity.Identity, triggers []task.Trigger, triggersLog []string) {
 	m.State.InvocationTime = m.Now
 	m.State.InvocationNonce = m.Nonce()
 	m.State.InvocationRetryCount = 0
@@ -417,6 +648,8 @@ func (m *StateMachine) queueInvocation(triggeredBy identity.Identity) {
 	m.emitAction(StartInvocationAction{
 		InvocationNonce: m.State.InvocationNonce,
 		TriggeredBy:     triggeredBy,
+		Triggers:        triggers,
+		TriggersLog:     triggersLog,
 	})
 }
 
@@ -434,6 +667,42 @@ func (m *StateMachine) emitAction(a Action) {
 	m.Actions = append(m.Actions, a)
 }
 
+// equalInt64Slices returns true iff 'a' and 'b' have same elements in same
+// order.
+func equalInt64Slices(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// marshalTriggers serializes a list of triggers to store it in the datastore.
+//
+// Returns nil for an empty list.
+func marshalTriggers(triggers []task.Trigger) ([]byte, error) {
+	if len(triggers) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(triggers)
+}
+
+// unmarshalTriggers is reverse of marshalTriggers.
+func unmarshalTriggers(blob []byte) ([]task.Trigger, error) {
+	if len(blob) == 0 {
+		return nil, nil
+	}
+	var triggers []task.Trigger
+	if err := json.Unmarshal(blob, &triggers); err != nil {
+		return nil, err
+	}
+	return triggers, nil
+}
+
 // impossible is never actually called.
 func impossible(msg string, args ...interface{}) {
 	panic(fmt.Errorf(msg, args...))
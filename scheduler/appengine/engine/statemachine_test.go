@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/luci/luci-go/scheduler/appengine/schedule"
+	"github.com/luci/luci-go/scheduler/appengine/task"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -182,6 +183,112 @@ func TestStateMachine(t *testing.T) {
 		So(m.state.State, ShouldEqual, JobStateScheduled)
 	})
 
+	Convey("Concurrent invocations", t, func() {
+		m := newTestStateMachine("*/5 * * * * * *")
+		m.maxConcurrent = 2
+
+		m.roll(func(sm *StateMachine) { sm.OnJobEnabled() })
+		So(m.state.State, ShouldEqual, JobStateScheduled)
+		m.actions = nil
+
+		// The first tick starts the first invocation.
+		m.now = m.now.Add(5 * time.Second)
+		m.roll(func(sm *StateMachine) { sm.OnTimerTick(1) })
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarting(3, 100, 0) })
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarted(100) })
+		So(m.state.State, ShouldEqual, JobStateRunning)
+		m.actions = nil
+
+		// The second tick starts another invocation, without an overrun.
+		m.now = m.now.Add(5 * time.Second)
+		m.roll(func(sm *StateMachine) { sm.OnTimerTick(2) })
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.state.ActiveInvocations, ShouldResemble, []int64{100})
+		So(m.actions, ShouldResemble, []Action{
+			TickLaterAction{epoch.Add(15 * time.Second), 4},
+			StartInvocationAction{InvocationNonce: 5},
+		})
+		m.actions = nil
+
+		// Manual invocation is rejected while the new one is starting.
+		err := m.rollWithErr(func(sm *StateMachine) error { return sm.OnManualInvocation("user:abc") })
+		So(err, ShouldNotBeNil)
+
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarting(5, 200, 0) })
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarted(200) })
+		So(m.state.State, ShouldEqual, JobStateRunning)
+		So(m.state.RunningInvocations(), ShouldResemble, []int64{100, 200})
+		So(m.state.IsActiveInvocation(100), ShouldBeTrue)
+		So(m.state.IsActiveInvocation(200), ShouldBeTrue)
+		So(m.state.IsActiveInvocation(300), ShouldBeFalse)
+
+		// The third tick is an overrun, since the limit is reached.
+		m.now = m.now.Add(5 * time.Second)
+		m.roll(func(sm *StateMachine) { sm.OnTimerTick(4) })
+		So(m.state.State, ShouldEqual, JobStateOverrun)
+		So(m.actions, ShouldResemble, []Action{
+			TickLaterAction{epoch.Add(20 * time.Second), 6},
+			RecordOverrunAction{Overruns: 1, RunningInvocationID: 200},
+		})
+		m.actions = nil
+
+		// The first invocation finishes. The job is still running the second one.
+		m.roll(func(sm *StateMachine) { sm.OnInvocationDone(100) })
+		So(m.state.State, ShouldEqual, JobStateOverrun)
+		So(m.state.ActiveInvocations, ShouldBeNil)
+		So(m.actions, ShouldBeNil)
+
+		// Now there's a free slot for the next tick.
+		m.now = m.now.Add(5 * time.Second)
+		m.roll(func(sm *StateMachine) { sm.OnTimerTick(6) })
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.state.ActiveInvocations, ShouldResemble, []int64{200})
+		So(m.actions, ShouldResemble, []Action{
+			TickLaterAction{epoch.Add(25 * time.Second), 7},
+			StartInvocationAction{InvocationNonce: 8},
+		})
+		m.actions = nil
+
+		// Abort forgets about all invocations.
+		m.roll(func(sm *StateMachine) { sm.OnManualAbort() })
+		So(m.state.State, ShouldEqual, JobStateScheduled)
+		So(m.state.RunningInvocations(), ShouldHaveLength, 0)
+	})
+
+	Convey("Concurrent invocations process triggers", t, func() {
+		m := newTestStateMachine("triggered")
+		m.maxConcurrent = 2
+
+		t1 := task.Trigger{ID: "t1"}
+		t2 := task.Trigger{ID: "t2"}
+		t3 := task.Trigger{ID: "t3"}
+
+		m.roll(func(sm *StateMachine) { sm.OnJobEnabled() })
+		m.roll(func(sm *StateMachine) { sm.OnNewTriggers([]task.Trigger{t1}) })
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarting(2, 100, 0) })
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarted(100) })
+		m.actions = nil
+
+		// The second trigger is processed concurrently, the third one waits.
+		m.roll(func(sm *StateMachine) { sm.OnNewTriggers([]task.Trigger{t2, t3}) })
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.state.ActiveInvocations, ShouldResemble, []int64{100})
+		So(m.actions, ShouldHaveLength, 1)
+		So(m.actions[0].(StartInvocationAction).Triggers, ShouldResemble, []task.Trigger{t2})
+		m.actions = nil
+
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarting(3, 200, 0) })
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarted(200) })
+		So(m.actions, ShouldBeNil)
+
+		// When the first invocation finishes, the third trigger is picked up.
+		m.roll(func(sm *StateMachine) { sm.OnInvocationDone(100) })
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.state.ActiveInvocations, ShouldResemble, []int64{200})
+		So(m.actions, ShouldHaveLength, 1)
+		So(m.actions[0].(StartInvocationAction).Triggers, ShouldResemble, []task.Trigger{t3})
+	})
+
 	Convey("Normal flow on rel schedule", t, func() {
 		m := newTestStateMachine("with 10s interval")
 
@@ -386,6 +493,173 @@ func TestStateMachine(t *testing.T) {
 		})
 		m.actions = nil
 	})
+
+	Convey("OnManualAbort keeps pending tick", t, func() {
+		m := newTestStateMachine("with 5s interval")
+		m.maxConcurrent = 2
+
+		m.roll(func(sm *StateMachine) { sm.OnJobEnabled() })
+		m.now = m.now.Add(4*time.Second + 725980746*time.Nanosecond)
+		m.roll(func(sm *StateMachine) { sm.OnTimerTick(1) })
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarting(2, 100, 0) })
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarted(100) })
+
+		// The second invocation runs concurrently and finishes first, the job
+		// goes back to waiting for a tick.
+		m.roll(func(sm *StateMachine) { sm.OnManualInvocation("user:abc") })
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarting(3, 200, 0) })
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarted(200) })
+		m.roll(func(sm *StateMachine) { sm.OnInvocationDone(200) })
+		So(m.state.State, ShouldEqual, JobStateScheduled)
+		So(m.state.ActiveInvocations, ShouldResemble, []int64{100})
+		So(m.state.TickNonce, ShouldEqual, 4)
+		m.actions = nil
+
+		// Aborting forgets about the first invocation, but keeps the tick.
+		m.now = m.now.Add(time.Second)
+		m.roll(func(sm *StateMachine) { sm.OnManualAbort() })
+		So(m.state.State, ShouldEqual, JobStateScheduled)
+		So(m.state.ActiveInvocations, ShouldBeNil)
+		So(m.state.TickNonce, ShouldEqual, 4)
+		So(m.actions, ShouldBeNil)
+
+		// The pending tick starts a new invocation.
+		m.now = m.now.Add(5 * time.Second)
+		m.roll(func(sm *StateMachine) { sm.OnTimerTick(4) })
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.actions, ShouldResemble, []Action{
+			StartInvocationAction{InvocationNonce: 5},
+		})
+	})
+
+	Convey("OnNewTriggers works", t, func() {
+		m := newTestStateMachine("triggered")
+
+		t1 := task.Trigger{ID: "t1"}
+		t2 := task.Trigger{ID: "t2"}
+		t3 := task.Trigger{ID: "t3"}
+
+		pending := func() []task.Trigger {
+			triggers, err := m.state.PendingTriggers()
+			So(err, ShouldBeNil)
+			return triggers
+		}
+
+		// Triggers are ignored in disabled state.
+		m.roll(func(sm *StateMachine) { sm.OnNewTriggers([]task.Trigger{t1}) })
+		So(m.state.State, ShouldEqual, JobStateDisabled)
+		So(pending(), ShouldBeNil)
+
+		// Enabling the job on triggered schedule suspends it.
+		m.roll(func(sm *StateMachine) { sm.OnJobEnabled() })
+		So(m.state.State, ShouldEqual, JobStateSuspended)
+		So(m.actions, ShouldBeNil)
+
+		// New triggers start an invocation for the oldest one.
+		m.roll(func(sm *StateMachine) { sm.OnNewTriggers([]task.Trigger{t1, t2}) })
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.actions, ShouldResemble, []Action{
+			StartInvocationAction{
+				InvocationNonce: 2,
+				Triggers:        []task.Trigger{t1},
+				TriggersLog: []string{
+					`Processing trigger "t1" per ONE_BY_ONE triggering policy, 1 more trigger(s) pending`,
+				},
+			},
+		})
+		So(pending(), ShouldResemble, []task.Trigger{t2})
+		m.actions = nil
+
+		// More triggers are queued while the job is running, duplicates skipped.
+		m.roll(func(sm *StateMachine) { sm.OnNewTriggers([]task.Trigger{t2, t3}) })
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.actions, ShouldBeNil)
+		So(pending(), ShouldResemble, []task.Trigger{t2, t3})
+
+		// Invocation starts and finishes.
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarting(2, 100, 0) })
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarted(100) })
+		So(m.state.State, ShouldEqual, JobStateRunning)
+		m.roll(func(sm *StateMachine) { sm.OnInvocationDone(100) })
+
+		// The next pending trigger is picked up right away.
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		So(m.actions, ShouldResemble, []Action{
+			StartInvocationAction{
+				InvocationNonce: 3,
+				Triggers:        []task.Trigger{t2},
+				TriggersLog: []string{
+					`Processing trigger "t2" per ONE_BY_ONE triggering policy, 1 more trigger(s) pending`,
+				},
+			},
+		})
+		So(pending(), ShouldResemble, []task.Trigger{t3})
+		m.actions = nil
+
+		// Disabling the job drops pending triggers.
+		m.roll(func(sm *StateMachine) { sm.OnJobDisabled() })
+		So(m.state.State, ShouldEqual, JobStateDisabled)
+		So(pending(), ShouldBeNil)
+	})
+
+	Convey("Triggering policy is respected", t, func() {
+		m := newTestStateMachine("triggered")
+
+		t1 := task.Trigger{ID: "t1"}
+		t2 := task.Trigger{ID: "t2"}
+		t3 := task.Trigger{ID: "t3"}
+
+		pending := func() []task.Trigger {
+			triggers, err := m.state.PendingTriggers()
+			So(err, ShouldBeNil)
+			return triggers
+		}
+
+		m.roll(func(sm *StateMachine) { sm.OnJobEnabled() })
+		So(m.state.State, ShouldEqual, JobStateSuspended)
+
+		// Start an invocation to accumulate triggers while it is running.
+		m.roll(func(sm *StateMachine) { sm.OnNewTriggers([]task.Trigger{t1}) })
+		So(m.state.State, ShouldEqual, JobStateQueued)
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarting(2, 100, 0) })
+		m.roll(func(sm *StateMachine) { sm.OnInvocationStarted(100) })
+		m.roll(func(sm *StateMachine) { sm.OnNewTriggers([]task.Trigger{t2, t3}) })
+		So(pending(), ShouldResemble, []task.Trigger{t2, t3})
+		m.actions = nil
+
+		Convey("GREEDY_BATCHING", func() {
+			m.policy = greedyBatchingPolicy{}
+			m.roll(func(sm *StateMachine) { sm.OnInvocationDone(100) })
+			So(m.state.State, ShouldEqual, JobStateQueued)
+			So(m.actions, ShouldResemble, []Action{
+				StartInvocationAction{
+					InvocationNonce: 3,
+					Triggers:        []task.Trigger{t2, t3},
+					TriggersLog: []string{
+						"Batching 2 trigger(s) into a single invocation per GREEDY_BATCHING triggering policy, 0 more trigger(s) pending",
+					},
+				},
+			})
+			So(pending(), ShouldBeNil)
+		})
+
+		Convey("NEWEST_ONLY", func() {
+			m.policy = newestOnlyPolicy{}
+			m.roll(func(sm *StateMachine) { sm.OnInvocationDone(100) })
+			So(m.state.State, ShouldEqual, JobStateQueued)
+			So(m.actions, ShouldResemble, []Action{
+				StartInvocationAction{
+					InvocationNonce: 3,
+					Triggers:        []task.Trigger{t3},
+					TriggersLog: []string{
+						`Processing the newest trigger "t3" per NEWEST_ONLY triggering policy, discarding 1 older trigger(s)`,
+						`Discarded trigger "t2"`,
+					},
+				},
+			})
+			So(pending(), ShouldBeNil)
+		})
+	})
 }
 
 type testStateMachine struct {
@@ -393,7 +667,10 @@ type testStateMachine struct {
 	now      time.Time
 	nonce    int64
 	schedule *schedule.Schedule
+	policy   TriggeringPolicy
 	actions  []Action
+
+	maxConcurrent int
 }
 
 func newTestStateMachine(scheduleExpr string) *testStateMachine {
@@ -414,10 +691,12 @@ func (t *testStateMachine) rollWithErr(cb func(sm *StateMachine) error) error {
 		State:    t.state,
 		Now:      t.now,
 		Schedule: t.schedule,
+		Policy:   t.policy,
 		Nonce: func() int64 {
 			nonce++
 			return nonce
 		},
+		MaxConcurrentInvocations: t.maxConcurrent,
 	}
 	if err := cb(&sm); err != nil {
 		return err
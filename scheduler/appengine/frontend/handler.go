@@ -14,6 +14,7 @@ package frontend
 
 import (
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -49,6 +50,7 @@ import (
 	"github.com/luci/luci-go/scheduler/appengine/task/noop"
 	"github.com/luci/luci-go/scheduler/appengine/task/swarming"
 	"github.com/luci/luci-go/scheduler/appengine/task/urlfetch"
+	"github.com/luci/luci-go/scheduler/appengine/task/webhook"
 	"github.com/luci/luci-go/scheduler/appengine/ui"
 )
 
@@ -65,6 +67,7 @@ var (
 		&noop.TaskManager{},
 		&swarming.TaskManager{},
 		&urlfetch.TaskManager{},
+		&webhook.TaskManager{},
 	}
 )
 
@@ -137,6 +140,7 @@ func init() {
 		InvocationsQueuePath: "/internal/tasks/invocations",
 		InvocationsQueueName: "invocations",
 		PubSubPushPath:       "/pubsub",
+		CallbackPath:         "/callback",
 	})
 
 	// Do global init before handling requests.
@@ -159,6 +163,7 @@ func init() {
 	})
 
 	r.POST("/pubsub", base, pubsubPushHandler) // auth is via custom tokens
+	r.POST("/callback", base, callbackHandler) // auth is via custom tokens
 	r.GET("/internal/cron/read-config", base.Extend(gaemiddleware.RequireCron), readConfigCron)
 	r.POST("/internal/tasks/read-project-config", base.Extend(gaemiddleware.RequireTaskQueue("read-project-config")), readProjectConfigTask)
 	r.POST("/internal/tasks/timers", base.Extend(gaemiddleware.RequireTaskQueue("timers")), actionTask)
@@ -199,6 +204,38 @@ func pubsubPushHandler(c *router.Context) {
 	rc.ok()
 }
 
+// maxCallbackBodySize limits the size of HTTP callback bodies.
+const maxCallbackBodySize = 64 * 1024
+
+// callbackHandler handles incoming HTTP callbacks from external services.
+//
+// Unlike PubSub pushes, callers are not Task Queues or PubSub, so fatal errors
+// are reported with 400 status code.
+//
+// Only webhook tasks use HTTP callbacks currently, so malformed bodies are
+// rejected here, before they reach the invocation.
+func callbackHandler(c *router.Context) {
+	rc := requestContext(*c)
+	body, err := ioutil.ReadAll(io.LimitReader(rc.Request.Body, maxCallbackBodySize))
+	if err != nil {
+		rc.fail(500, "Failed to read the request: %s", err)
+		return
+	}
+	if _, err := webhook.ParseCallback(body); err != nil {
+		rc.fail(400, "Bad callback body - %s", err)
+		return
+	}
+	err = globalEngine.ProcessCallback(rc.Context, rc.Request.URL.Query().Get("token"), body)
+	switch {
+	case transient.Tag.In(err):
+		rc.fail(500, "Transient error when processing the callback - %s", err)
+	case err != nil:
+		rc.fail(400, "Failed to process the callback - %s", err)
+	default:
+		rc.ok()
+	}
+}
+
 // pubsubPullHandler is called on dev server by developer to pull pubsub
 // messages from a topic created for a publisher.
 func pubsubPullHandler(c *router.Context) {
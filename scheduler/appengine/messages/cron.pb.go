@@ -10,11 +10,13 @@ It is generated from these files:
 It has these top-level messages:
 	Job
 	Trigger
+	TriggeringPolicy
 	NoopTask
 	GitilesTask
 	UrlFetchTask
 	SwarmingTask
 	BuildbucketTask
+	WebhookTask
 	ProjectConfig
 	TaskDefWrapper
 */
@@ -35,6 +37,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type TriggeringPolicy_Kind int32
+
+const (
+	// ONE_BY_ONE starts a separate invocation for each trigger, in order they
+	// arrived.
+	TriggeringPolicy_ONE_BY_ONE TriggeringPolicy_Kind = 0
+	// GREEDY_BATCHING passes all pending triggers (but no more than
+	// max_batch_size) to a single invocation.
+	TriggeringPolicy_GREEDY_BATCHING TriggeringPolicy_Kind = 1
+	// NEWEST_ONLY passes only the most recent pending trigger to an
+	// invocation, discarding all older ones.
+	TriggeringPolicy_NEWEST_ONLY TriggeringPolicy_Kind = 2
+)
+
+var TriggeringPolicy_Kind_name = map[int32]string{
+	0: "ONE_BY_ONE",
+	1: "GREEDY_BATCHING",
+	2: "NEWEST_ONLY",
+}
+var TriggeringPolicy_Kind_value = map[string]int32{
+	"ONE_BY_ONE":      0,
+	"GREEDY_BATCHING": 1,
+	"NEWEST_ONLY":     2,
+}
+
+func (x TriggeringPolicy_Kind) String() string {
+	return proto.EnumName(TriggeringPolicy_Kind_name, int32(x))
+}
+func (TriggeringPolicy_Kind) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 0} }
+
 // Job specifies a single regular job belonging to a project.
 //
 // Such jobs runs on a schedule or can be triggered by some trigger.
@@ -60,6 +92,13 @@ type Job struct {
 	//   - "triggered" schedule indicates that job is always started via "Run now"
 	//     button or via a trigger.
 	//
+	// Cron-like and interval schedules can be further refined:
+	//   - "TZ=America/Los_Angeles 0 3 * * *": evaluates the cron expression in
+	//     the given IANA timezone (instead of UTC), following DST transitions.
+	//   - "0 3 * * * except 2017-12-25,2017-12-30..2018-01-01": does not start
+	//     the job on listed calendar dates (or inclusive date ranges), evaluated
+	//     in the schedule's timezone.
+	//
 	// Default is "triggered".
 	Schedule string `protobuf:"bytes,2,opt,name=schedule" json:"schedule,omitempty"`
 	// Disabled is true to disable this job.
@@ -69,6 +108,20 @@ type Job struct {
 	// TODO(vadimsh): Remove this field once all configs are updated not to
 	// use it.
 	Task *TaskDefWrapper `protobuf:"bytes,4,opt,name=task" json:"task,omitempty"`
+	// TriggeringPolicy defines how the job handles triggers emitted by other
+	// jobs (see Trigger.triggers), in particular ones that arrive while the job
+	// is running.
+	//
+	// Default is to process triggers one by one, in order they arrived.
+	TriggeringPolicy *TriggeringPolicy `protobuf:"bytes,5,opt,name=triggering_policy,json=triggeringPolicy" json:"triggering_policy,omitempty"`
+	// MaxConcurrentInvocations is how many invocations of the job are allowed to
+	// run at the same time.
+	//
+	// When the limit is reached, new invocations are not started: ticks of
+	// the schedule are recorded as overruns and triggers stay in the queue.
+	//
+	// Default (or 0) is 1, meaning invocations never overlap.
+	MaxConcurrentInvocations int32 `protobuf:"varint,6,opt,name=max_concurrent_invocations,json=maxConcurrentInvocations" json:"max_concurrent_invocations,omitempty"`
 	// Noop is used for testing. It is "do nothing" task.
 	Noop *NoopTask `protobuf:"bytes,100,opt,name=noop" json:"noop,omitempty"`
 	// UrlFetch can be used to make a simple HTTP call.
@@ -77,6 +130,9 @@ type Job struct {
 	Swarming *SwarmingTask `protobuf:"bytes,102,opt,name=swarming" json:"swarming,omitempty"`
 	// BuildbucketTask can be used to schedule buildbucket job.
 	Buildbucket *BuildbucketTask `protobuf:"bytes,103,opt,name=buildbucket" json:"buildbucket,omitempty"`
+	// WebhookTask can be used to start work in an external service and wait for
+	// it to call back when it is done.
+	Webhook *WebhookTask `protobuf:"bytes,104,opt,name=webhook" json:"webhook,omitempty"`
 }
 
 func (m *Job) Reset()                    { *m = Job{} }
@@ -112,6 +168,20 @@ func (m *Job) GetTask() *TaskDefWrapper {
 	return nil
 }
 
+func (m *Job) GetTriggeringPolicy() *TriggeringPolicy {
+	if m != nil {
+		return m.TriggeringPolicy
+	}
+	return nil
+}
+
+func (m *Job) GetMaxConcurrentInvocations() int32 {
+	if m != nil {
+		return m.MaxConcurrentInvocations
+	}
+	return 0
+}
+
 func (m *Job) GetNoop() *NoopTask {
 	if m != nil {
 		return m.Noop
@@ -140,6 +210,13 @@ func (m *Job) GetBuildbucket() *BuildbucketTask {
 	return nil
 }
 
+func (m *Job) GetWebhook() *WebhookTask {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
 // Trigger specifies a job that triggers other jobs.
 //
 // It is a special kind of job that periodically checks the state of the world
@@ -156,6 +233,12 @@ type Trigger struct {
 	Schedule string `protobuf:"bytes,2,opt,name=schedule" json:"schedule,omitempty"`
 	// Disabled is true to disable this job.
 	Disabled bool `protobuf:"varint,3,opt,name=disabled" json:"disabled,omitempty"`
+	// Triggers is IDs of jobs (in the same project) to trigger.
+	//
+	// Each trigger emitted by this job (e.g. a new commit detected by Gitiles
+	// trigger) is delivered to all jobs from this list. Each of them then runs
+	// an invocation for the trigger, passing its payload to the task.
+	Triggers []string `protobuf:"bytes,4,rep,name=triggers" json:"triggers,omitempty"`
 	// Noop is used for testing. It is "do nothing" trigger.
 	Noop *NoopTask `protobuf:"bytes,100,opt,name=noop" json:"noop,omitempty"`
 	// Gitiles is used to trigger jobs for new commits on Gitiles.
@@ -188,6 +271,13 @@ func (m *Trigger) GetDisabled() bool {
 	return false
 }
 
+func (m *Trigger) GetTriggers() []string {
+	if m != nil {
+		return m.Triggers
+	}
+	return nil
+}
+
 func (m *Trigger) GetNoop() *NoopTask {
 	if m != nil {
 		return m.Noop
@@ -202,6 +292,39 @@ func (m *Trigger) GetGitiles() *GitilesTask {
 	return nil
 }
 
+// TriggeringPolicy defines how pending triggers are converted into
+// invocations of a triggered job.
+//
+// Triggers that arrive while the job is running are kept in a queue. When
+// the job is ready to start a new invocation, the policy decides what
+// pending triggers to pass to it.
+type TriggeringPolicy struct {
+	// Kind defines what policy to use. Default is ONE_BY_ONE.
+	Kind TriggeringPolicy_Kind `protobuf:"varint,1,opt,name=kind,enum=messages.TriggeringPolicy_Kind" json:"kind,omitempty"`
+	// MaxBatchSize limits how many triggers GREEDY_BATCHING policy can pass to
+	// a single invocation. Default (or 0) means "no limit".
+	MaxBatchSize int32 `protobuf:"varint,2,opt,name=max_batch_size,json=maxBatchSize" json:"max_batch_size,omitempty"`
+}
+
+func (m *TriggeringPolicy) Reset()                    { *m = TriggeringPolicy{} }
+func (m *TriggeringPolicy) String() string            { return proto.CompactTextString(m) }
+func (*TriggeringPolicy) ProtoMessage()               {}
+func (*TriggeringPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *TriggeringPolicy) GetKind() TriggeringPolicy_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return TriggeringPolicy_ONE_BY_ONE
+}
+
+func (m *TriggeringPolicy) GetMaxBatchSize() int32 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
 // NoopTask is used for testing. It is "do nothing" task.
 type NoopTask struct {
 }
@@ -209,7 +332,7 @@ type NoopTask struct {
 func (m *NoopTask) Reset()                    { *m = NoopTask{} }
 func (m *NoopTask) String() string            { return proto.CompactTextString(m) }
 func (*NoopTask) ProtoMessage()               {}
-func (*NoopTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*NoopTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 // GitilesTask specifies parameters of Swarming-based jobs.
 type GitilesTask struct {
@@ -222,7 +345,7 @@ type GitilesTask struct {
 func (m *GitilesTask) Reset()                    { *m = GitilesTask{} }
 func (m *GitilesTask) String() string            { return proto.CompactTextString(m) }
 func (*GitilesTask) ProtoMessage()               {}
-func (*GitilesTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*GitilesTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *GitilesTask) GetRepo() string {
 	if m != nil {
@@ -251,7 +374,7 @@ type UrlFetchTask struct {
 func (m *UrlFetchTask) Reset()                    { *m = UrlFetchTask{} }
 func (m *UrlFetchTask) String() string            { return proto.CompactTextString(m) }
 func (*UrlFetchTask) ProtoMessage()               {}
-func (*UrlFetchTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*UrlFetchTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *UrlFetchTask) GetMethod() string {
 	if m != nil {
@@ -300,7 +423,7 @@ type SwarmingTask struct {
 func (m *SwarmingTask) Reset()                    { *m = SwarmingTask{} }
 func (m *SwarmingTask) String() string            { return proto.CompactTextString(m) }
 func (*SwarmingTask) ProtoMessage()               {}
-func (*SwarmingTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*SwarmingTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 func (m *SwarmingTask) GetServer() string {
 	if m != nil {
@@ -390,7 +513,7 @@ type SwarmingTask_IsolatedRef struct {
 func (m *SwarmingTask_IsolatedRef) Reset()                    { *m = SwarmingTask_IsolatedRef{} }
 func (m *SwarmingTask_IsolatedRef) String() string            { return proto.CompactTextString(m) }
 func (*SwarmingTask_IsolatedRef) ProtoMessage()               {}
-func (*SwarmingTask_IsolatedRef) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5, 0} }
+func (*SwarmingTask_IsolatedRef) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6, 0} }
 
 func (m *SwarmingTask_IsolatedRef) GetIsolated() string {
 	if m != nil {
@@ -430,7 +553,7 @@ type BuildbucketTask struct {
 func (m *BuildbucketTask) Reset()                    { *m = BuildbucketTask{} }
 func (m *BuildbucketTask) String() string            { return proto.CompactTextString(m) }
 func (*BuildbucketTask) ProtoMessage()               {}
-func (*BuildbucketTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*BuildbucketTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *BuildbucketTask) GetServer() string {
 	if m != nil {
@@ -467,6 +590,52 @@ func (m *BuildbucketTask) GetTags() []string {
 	return nil
 }
 
+// WebhookTask specifies parameters of jobs that start some work in an external
+// service via an HTTP call and then wait for the service to report the outcome
+// through a callback URL.
+//
+// The scheduler POSTs a JSON object with invocation details (including
+// "callback_url") to 'url'. The service is expected to reply with HTTP 2xx to
+// accept the work, and later POST a JSON object {"status": "SUCCEEDED"} (or
+// "FAILED") to the callback URL. The callback URL embeds a signed token, so no
+// other authentication is needed to call it.
+type WebhookTask struct {
+	// Url is HTTPS URL to POST the invocation request to.
+	Url string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	// TimeoutSec is how long to wait for the initial request to complete.
+	// Default is 60 sec.
+	TimeoutSec int32 `protobuf:"varint,2,opt,name=timeout_sec,json=timeoutSec" json:"timeout_sec,omitempty"`
+	// CompletionTimeoutSec is how long to wait for the completion callback
+	// before failing the invocation. Default is 24 hours.
+	CompletionTimeoutSec int32 `protobuf:"varint,3,opt,name=completion_timeout_sec,json=completionTimeoutSec" json:"completion_timeout_sec,omitempty"`
+}
+
+func (m *WebhookTask) Reset()                    { *m = WebhookTask{} }
+func (m *WebhookTask) String() string            { return proto.CompactTextString(m) }
+func (*WebhookTask) ProtoMessage()               {}
+func (*WebhookTask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *WebhookTask) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *WebhookTask) GetTimeoutSec() int32 {
+	if m != nil {
+		return m.TimeoutSec
+	}
+	return 0
+}
+
+func (m *WebhookTask) GetCompletionTimeoutSec() int32 {
+	if m != nil {
+		return m.CompletionTimeoutSec
+	}
+	return 0
+}
+
 // ProjectConfig defines a schema for config file that describe jobs belonging
 // to some project.
 type ProjectConfig struct {
@@ -479,7 +648,7 @@ type ProjectConfig struct {
 func (m *ProjectConfig) Reset()                    { *m = ProjectConfig{} }
 func (m *ProjectConfig) String() string            { return proto.CompactTextString(m) }
 func (*ProjectConfig) ProtoMessage()               {}
-func (*ProjectConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*ProjectConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *ProjectConfig) GetJob() []*Job {
 	if m != nil {
@@ -507,12 +676,13 @@ type TaskDefWrapper struct {
 	SwarmingTask    *SwarmingTask    `protobuf:"bytes,3,opt,name=swarming_task,json=swarmingTask" json:"swarming_task,omitempty"`
 	BuildbucketTask *BuildbucketTask `protobuf:"bytes,4,opt,name=buildbucket_task,json=buildbucketTask" json:"buildbucket_task,omitempty"`
 	GitilesTask     *GitilesTask     `protobuf:"bytes,5,opt,name=gitiles_task,json=gitilesTask" json:"gitiles_task,omitempty"`
+	WebhookTask     *WebhookTask     `protobuf:"bytes,6,opt,name=webhook_task,json=webhookTask" json:"webhook_task,omitempty"`
 }
 
 func (m *TaskDefWrapper) Reset()                    { *m = TaskDefWrapper{} }
 func (m *TaskDefWrapper) String() string            { return proto.CompactTextString(m) }
 func (*TaskDefWrapper) ProtoMessage()               {}
-func (*TaskDefWrapper) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*TaskDefWrapper) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *TaskDefWrapper) GetNoop() *NoopTask {
 	if m != nil {
@@ -549,17 +719,27 @@ func (m *TaskDefWrapper) GetGitilesTask() *GitilesTask {
 	return nil
 }
 
+func (m *TaskDefWrapper) GetWebhookTask() *WebhookTask {
+	if m != nil {
+		return m.WebhookTask
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Job)(nil), "messages.Job")
 	proto.RegisterType((*Trigger)(nil), "messages.Trigger")
+	proto.RegisterType((*TriggeringPolicy)(nil), "messages.TriggeringPolicy")
 	proto.RegisterType((*NoopTask)(nil), "messages.NoopTask")
 	proto.RegisterType((*GitilesTask)(nil), "messages.GitilesTask")
 	proto.RegisterType((*UrlFetchTask)(nil), "messages.UrlFetchTask")
 	proto.RegisterType((*SwarmingTask)(nil), "messages.SwarmingTask")
 	proto.RegisterType((*SwarmingTask_IsolatedRef)(nil), "messages.SwarmingTask.IsolatedRef")
 	proto.RegisterType((*BuildbucketTask)(nil), "messages.BuildbucketTask")
+	proto.RegisterType((*WebhookTask)(nil), "messages.WebhookTask")
 	proto.RegisterType((*ProjectConfig)(nil), "messages.ProjectConfig")
 	proto.RegisterType((*TaskDefWrapper)(nil), "messages.TaskDefWrapper")
+	proto.RegisterEnum("messages.TriggeringPolicy_Kind", TriggeringPolicy_Kind_name, TriggeringPolicy_Kind_value)
 }
 
 func init() {
@@ -567,54 +747,70 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 780 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4b, 0x6f, 0xf3, 0x44,
-	0x14, 0x95, 0xf3, 0xce, 0x75, 0xd2, 0xb4, 0x23, 0x88, 0x86, 0x0a, 0x68, 0xe4, 0x45, 0x89, 0x78,
-	0x24, 0x52, 0x0a, 0x12, 0x52, 0x17, 0x08, 0x28, 0x20, 0xba, 0x40, 0x95, 0x5b, 0x84, 0x58, 0x20,
-	0xcb, 0x8f, 0x6b, 0x67, 0x5a, 0xdb, 0x63, 0xcd, 0x8c, 0x4b, 0xd9, 0xb3, 0xe7, 0x7f, 0xf4, 0x9f,
-	0xf0, 0xaf, 0x90, 0xc7, 0xcf, 0x56, 0x7c, 0x55, 0x3f, 0xe9, 0xdb, 0x44, 0xbe, 0xe7, 0x9e, 0x7b,
-	0x67, 0xe6, 0xdc, 0x99, 0x13, 0xf8, 0x26, 0x62, 0x6a, 0x9f, 0x7b, 0x1b, 0x9f, 0x27, 0xdb, 0x38,
-	0xf7, 0x99, 0xfe, 0xf9, 0x22, 0xe2, 0x5b, 0xe9, 0xef, 0x31, 0xc8, 0x63, 0x14, 0x5b, 0x37, 0xcb,
-	0x30, 0x8d, 0x58, 0x8a, 0xdb, 0x04, 0xa5, 0x74, 0x23, 0x94, 0x5b, 0x5f, 0xf0, 0x74, 0x93, 0x09,
-	0xae, 0x38, 0x99, 0xd4, 0xa0, 0xf5, 0x6f, 0x0f, 0xfa, 0x97, 0xdc, 0x23, 0x07, 0xd0, 0x63, 0x01,
-	0x35, 0x56, 0xc6, 0x7a, 0x6a, 0xf7, 0x58, 0x40, 0x8e, 0x61, 0x52, 0x37, 0xa3, 0x3d, 0x8d, 0x36,
-	0x71, 0x91, 0x0b, 0x98, 0x74, 0xbd, 0x18, 0x03, 0xda, 0x5f, 0x19, 0xeb, 0x89, 0xdd, 0xc4, 0xe4,
-	0x73, 0x18, 0x28, 0x57, 0xde, 0xd1, 0xc1, 0xca, 0x58, 0x9b, 0x3b, 0xba, 0xa9, 0x17, 0xda, 0xdc,
-	0xb8, 0xf2, 0xee, 0x02, 0xc3, 0xdf, 0x44, 0xb1, 0x33, 0x61, 0x6b, 0x16, 0x39, 0x85, 0x41, 0xca,
-	0x79, 0x46, 0x03, 0xcd, 0x26, 0x2d, 0xfb, 0x17, 0xce, 0xb3, 0xa2, 0xc2, 0xd6, 0x79, 0x72, 0x06,
-	0xd3, 0x5c, 0xc4, 0x4e, 0x88, 0xca, 0xdf, 0x53, 0xd4, 0xe4, 0x65, 0x4b, 0xfe, 0x55, 0xc4, 0x3f,
-	0x16, 0x19, 0x5d, 0x30, 0xc9, 0xab, 0x88, 0xec, 0x60, 0x22, 0xff, 0x74, 0x45, 0xc2, 0xd2, 0x88,
-	0x86, 0xcf, 0x6b, 0xae, 0xab, 0x4c, 0x59, 0x53, 0xf3, 0xc8, 0x39, 0x98, 0x5e, 0xce, 0xe2, 0xc0,
-	0xcb, 0xfd, 0x3b, 0x54, 0x34, 0xd2, 0x65, 0x1f, 0xb4, 0x65, 0xdf, 0xb5, 0x49, 0x5d, 0xd9, 0x65,
-	0x5b, 0x8f, 0x06, 0x8c, 0x6f, 0x04, 0x8b, 0x22, 0x14, 0xef, 0x4c, 0xcf, 0xd7, 0x2a, 0xb4, 0x85,
-	0x71, 0xc4, 0x14, 0x8b, 0x51, 0x56, 0xfa, 0xbc, 0xdf, 0x52, 0x7f, 0x2a, 0x13, 0x9a, 0x5d, 0xb3,
-	0x2c, 0x80, 0x49, 0xdd, 0xc2, 0xfa, 0x0a, 0xcc, 0x0e, 0x87, 0x10, 0x18, 0x08, 0xcc, 0x78, 0xb5,
-	0x7b, 0xfd, 0x5d, 0x62, 0xa1, 0xa4, 0xbd, 0x55, 0xbf, 0xc4, 0x42, 0x69, 0xfd, 0x0e, 0xb3, 0xae,
-	0xf4, 0x64, 0x09, 0xa3, 0x04, 0xd5, 0x9e, 0xd7, 0xe7, 0xae, 0x22, 0x72, 0x08, 0xfd, 0x5c, 0xc4,
-	0xd5, 0xb1, 0x8b, 0x4f, 0x72, 0x02, 0xa6, 0x62, 0x09, 0xf2, 0x5c, 0x39, 0x12, 0x7d, 0x7d, 0xe8,
-	0xa1, 0x0d, 0x15, 0x74, 0x8d, 0xbe, 0xf5, 0xf7, 0x00, 0x66, 0xdd, 0x11, 0x15, 0xbd, 0x25, 0x8a,
-	0x7b, 0x14, 0x75, 0xef, 0x32, 0x22, 0x14, 0xc6, 0x3e, 0x4f, 0x12, 0x37, 0x0d, 0xaa, 0xad, 0xd5,
-	0x21, 0xf9, 0x01, 0x66, 0x4c, 0xf2, 0xd8, 0x55, 0x18, 0x38, 0x02, 0x43, 0xbd, 0x88, 0xb9, 0xb3,
-	0xfe, 0xff, 0x0a, 0x6c, 0x7e, 0xae, 0xa8, 0x36, 0x86, 0xb6, 0xc9, 0xda, 0x80, 0x7c, 0x04, 0x80,
-	0x0f, 0x4a, 0xb8, 0x8e, 0x2b, 0x22, 0x49, 0x07, 0x7a, 0x8d, 0xa9, 0x46, 0xbe, 0x15, 0x91, 0x2c,
-	0xce, 0x86, 0xe9, 0x3d, 0x1d, 0x6a, 0xbc, 0xf8, 0x24, 0x1f, 0x03, 0x04, 0x2c, 0xc1, 0x54, 0x32,
-	0x9e, 0x4a, 0x3a, 0xd2, 0x89, 0x0e, 0x52, 0x28, 0xa9, 0xdc, 0x48, 0xd2, 0x71, 0xa9, 0x64, 0xf1,
-	0x5d, 0xdc, 0x80, 0x4c, 0x30, 0x2e, 0x98, 0xfa, 0x8b, 0x4e, 0xb4, 0x18, 0x4d, 0x4c, 0xbe, 0x84,
-	0x25, 0x3e, 0xa0, 0x9f, 0x2b, 0xc6, 0x53, 0xa7, 0xa3, 0x9a, 0xa4, 0x53, 0xcd, 0x7c, 0xaf, 0xc9,
-	0xde, 0x34, 0xfa, 0x49, 0xf2, 0x29, 0x1c, 0x45, 0xc2, 0xf5, 0xd1, 0xc9, 0x50, 0x30, 0x1e, 0x94,
-	0x05, 0xa0, 0x0b, 0x16, 0x3a, 0x71, 0xa5, 0x71, 0xcd, 0x3d, 0x85, 0x05, 0xe3, 0x4f, 0x5b, 0x9b,
-	0x9a, 0x39, 0x67, 0xbc, 0xd3, 0xf3, 0x38, 0x03, 0xb3, 0x23, 0x53, 0xb1, 0xe9, 0x5a, 0xa8, 0x6a,
-	0x28, 0x4d, 0x4c, 0x3e, 0x81, 0x45, 0x23, 0x7e, 0x35, 0xb7, 0x72, 0xfc, 0x07, 0x35, 0x7c, 0x5d,
-	0xce, 0xef, 0x43, 0x98, 0xa6, 0x6e, 0x82, 0x32, 0x73, 0x7d, 0xd4, 0x23, 0x9a, 0xda, 0x2d, 0x60,
-	0xfd, 0x63, 0xc0, 0xe2, 0xd9, 0x93, 0x7b, 0xe3, 0x4d, 0x58, 0xc2, 0xa8, 0x7a, 0xb5, 0xe5, 0x4a,
-	0x55, 0x54, 0xdc, 0x10, 0xfd, 0x48, 0x51, 0x54, 0xfd, 0xeb, 0xb0, 0x98, 0x54, 0x26, 0x78, 0x86,
-	0x42, 0x31, 0xac, 0x47, 0xdb, 0x41, 0x9a, 0x49, 0x0d, 0xdb, 0x49, 0x59, 0x7f, 0xc0, 0xfc, 0x4a,
-	0xf0, 0x5b, 0xf4, 0xd5, 0xf7, 0x3c, 0x0d, 0x59, 0x44, 0x4e, 0xa0, 0x7f, 0xcb, 0x3d, 0x6a, 0xac,
-	0xfa, 0x6b, 0x73, 0x37, 0x6f, 0x6f, 0xd7, 0x25, 0xf7, 0xec, 0x22, 0x43, 0x3e, 0x83, 0xb1, 0x2a,
-	0x4d, 0x41, 0xdf, 0x50, 0x73, 0x77, 0xd4, 0x31, 0xc5, 0x32, 0x61, 0xd7, 0x0c, 0xeb, 0xb1, 0x07,
-	0x07, 0x4f, 0x9d, 0xb2, 0x71, 0x00, 0xe3, 0x6d, 0x3c, 0xb2, 0xf7, 0x4a, 0x8f, 0x3c, 0x87, 0x79,
-	0xed, 0x7d, 0x8e, 0xf6, 0xed, 0xfe, 0x8b, 0x46, 0x39, 0x93, 0xdd, 0x37, 0x79, 0x01, 0x87, 0x1d,
-	0xfb, 0x73, 0x3a, 0xbe, 0xff, 0x82, 0x63, 0x2e, 0xbc, 0x67, 0xf3, 0xfc, 0x1a, 0x66, 0x95, 0x27,
-	0x95, 0x1d, 0x86, 0x2f, 0xd9, 0x97, 0x19, 0xb5, 0x81, 0x37, 0xd2, 0x7f, 0x66, 0x67, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x1e, 0xac, 0x47, 0x5b, 0x0f, 0x07, 0x00, 0x00,
+	// 1026 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xeb, 0x6e, 0xe3, 0x44,
+	0x14, 0xc6, 0xb9, 0x34, 0xc9, 0x71, 0x9a, 0x64, 0x87, 0xa5, 0x1a, 0x2a, 0xa0, 0x91, 0x85, 0x96,
+	0x8a, 0x4b, 0x22, 0xa5, 0x20, 0x21, 0xed, 0x4a, 0x68, 0xdb, 0x86, 0xd2, 0x05, 0xa5, 0x95, 0x5b,
+	0x54, 0xf5, 0x07, 0xb2, 0x7c, 0x99, 0x38, 0xb3, 0xb5, 0x3d, 0xd6, 0x8c, 0xdd, 0x66, 0xf7, 0x1f,
+	0x12, 0xff, 0x79, 0x10, 0xde, 0x83, 0x27, 0xe1, 0x41, 0xd0, 0x8c, 0xaf, 0x0d, 0xb4, 0x5a, 0x24,
+	0xfe, 0x44, 0x73, 0xce, 0xf9, 0xbe, 0xb9, 0x9c, 0xef, 0xf8, 0x9c, 0xc0, 0x77, 0x3e, 0x4d, 0x56,
+	0xa9, 0x33, 0x71, 0x59, 0x38, 0x0d, 0x52, 0x97, 0xaa, 0x9f, 0xaf, 0x7c, 0x36, 0x15, 0xee, 0x8a,
+	0x78, 0x69, 0x40, 0xf8, 0xd4, 0x8e, 0x63, 0x12, 0xf9, 0x34, 0x22, 0xd3, 0x90, 0x08, 0x61, 0xfb,
+	0x44, 0x4c, 0x5d, 0xce, 0xa2, 0x49, 0xcc, 0x59, 0xc2, 0x50, 0xb7, 0x70, 0x1a, 0xbf, 0xb6, 0xa0,
+	0xf9, 0x8a, 0x39, 0x68, 0x00, 0x0d, 0xea, 0x61, 0x6d, 0xac, 0xed, 0xf7, 0xcc, 0x06, 0xf5, 0xd0,
+	0x2e, 0x74, 0x8b, 0xcd, 0x70, 0x43, 0x79, 0x4b, 0x5b, 0xc6, 0x3c, 0x2a, 0x6c, 0x27, 0x20, 0x1e,
+	0x6e, 0x8e, 0xb5, 0xfd, 0xae, 0x59, 0xda, 0xe8, 0x4b, 0x68, 0x25, 0xb6, 0xb8, 0xc1, 0xad, 0xb1,
+	0xb6, 0xaf, 0xcf, 0xf0, 0xa4, 0x38, 0x68, 0x72, 0x69, 0x8b, 0x9b, 0x63, 0xb2, 0xbc, 0xe2, 0xf2,
+	0x66, 0xdc, 0x54, 0x28, 0x74, 0x02, 0x4f, 0x12, 0x4e, 0x7d, 0x9f, 0x70, 0x1a, 0xf9, 0x56, 0xcc,
+	0x02, 0xea, 0xbe, 0xc1, 0x6d, 0x45, 0xdd, 0xad, 0x51, 0x4b, 0xc8, 0xb9, 0x42, 0x98, 0xa3, 0x64,
+	0xc3, 0x83, 0x5e, 0xc0, 0x6e, 0x68, 0xaf, 0x2d, 0x97, 0x45, 0x6e, 0xca, 0x39, 0x89, 0x12, 0x8b,
+	0x46, 0xb7, 0xcc, 0xb5, 0x13, 0xca, 0x22, 0x81, 0xb7, 0xc6, 0xda, 0x7e, 0xdb, 0xc4, 0xa1, 0xbd,
+	0x3e, 0x2a, 0x01, 0xa7, 0x55, 0x1c, 0x3d, 0x83, 0x56, 0xc4, 0x58, 0x8c, 0x3d, 0x75, 0x32, 0xaa,
+	0x4e, 0x5e, 0x30, 0x16, 0xcb, 0x8b, 0x9b, 0x2a, 0x8e, 0x0e, 0xa0, 0x97, 0xf2, 0xc0, 0x5a, 0x92,
+	0xc4, 0x5d, 0x61, 0xa2, 0xc0, 0x3b, 0x15, 0xf8, 0x67, 0x1e, 0x7c, 0x2f, 0x23, 0x8a, 0xd0, 0x4d,
+	0x73, 0x0b, 0xcd, 0xa0, 0x2b, 0xee, 0x6c, 0x1e, 0xd2, 0xc8, 0xc7, 0xcb, 0x4d, 0xce, 0x45, 0x1e,
+	0xc9, 0x38, 0x05, 0x0e, 0x3d, 0x07, 0xdd, 0x49, 0x69, 0xe0, 0x39, 0xa9, 0x7b, 0x43, 0x12, 0xec,
+	0x2b, 0xda, 0x87, 0x15, 0xed, 0xb0, 0x0a, 0x2a, 0x66, 0x1d, 0x8d, 0xa6, 0xd0, 0xb9, 0x23, 0xce,
+	0x8a, 0xb1, 0x1b, 0xbc, 0x52, 0xc4, 0x0f, 0x2a, 0xe2, 0x55, 0x16, 0x50, 0xa4, 0x02, 0x65, 0xfc,
+	0xa9, 0x41, 0x27, 0xcf, 0xf1, 0xff, 0x56, 0x07, 0xbb, 0xd0, 0xcd, 0x45, 0x12, 0xb8, 0x35, 0x6e,
+	0x4a, 0x5e, 0x61, 0xbf, 0x73, 0xba, 0xa7, 0xd0, 0xf1, 0x69, 0x42, 0x03, 0x22, 0x30, 0xd9, 0x7c,
+	0xc8, 0x49, 0x16, 0xc8, 0x1e, 0x92, 0xa3, 0x8c, 0x3f, 0x34, 0x18, 0x6d, 0x16, 0x0b, 0x3a, 0x80,
+	0xd6, 0x0d, 0x8d, 0xb2, 0x37, 0x0d, 0x66, 0x7b, 0x0f, 0x97, 0xd5, 0xe4, 0x47, 0x1a, 0x79, 0xa6,
+	0x02, 0xa3, 0x4f, 0x61, 0x20, 0xeb, 0xc9, 0xb1, 0x13, 0x77, 0x65, 0x09, 0xfa, 0x36, 0x7b, 0x7c,
+	0xdb, 0xec, 0x87, 0xf6, 0xfa, 0x50, 0x3a, 0x2f, 0xe8, 0x5b, 0x62, 0xbc, 0x80, 0x96, 0xe4, 0xa0,
+	0x01, 0xc0, 0xd9, 0x62, 0x6e, 0x1d, 0x5e, 0x5b, 0x67, 0x8b, 0xf9, 0xe8, 0x3d, 0xf4, 0x3e, 0x0c,
+	0x4f, 0xcc, 0xf9, 0xfc, 0xf8, 0xda, 0x3a, 0x7c, 0x79, 0x79, 0xf4, 0xc3, 0xe9, 0xe2, 0x64, 0xa4,
+	0xa1, 0x21, 0xe8, 0x8b, 0xf9, 0xd5, 0xfc, 0xe2, 0xd2, 0x3a, 0x5b, 0xfc, 0x74, 0x3d, 0x6a, 0x18,
+	0x00, 0xdd, 0xe2, 0xc1, 0xc6, 0x37, 0xa0, 0xd7, 0x5e, 0x84, 0x10, 0xb4, 0x38, 0x89, 0x59, 0xae,
+	0x83, 0x5a, 0x67, 0xbe, 0xa5, 0xc0, 0x0d, 0x95, 0x4d, 0xb5, 0x36, 0xae, 0xa1, 0x5f, 0xaf, 0x3a,
+	0xb4, 0x03, 0x5b, 0x21, 0x49, 0x56, 0xac, 0x50, 0x30, 0xb7, 0xd0, 0x08, 0x9a, 0x29, 0x0f, 0x72,
+	0x01, 0xe5, 0x12, 0xed, 0x81, 0x9e, 0xd0, 0x90, 0xb0, 0x34, 0xb1, 0x04, 0x71, 0x95, 0x7c, 0x6d,
+	0x13, 0x72, 0xd7, 0x05, 0x71, 0x8d, 0xdf, 0x5a, 0xd0, 0xaf, 0x57, 0xa7, 0xdc, 0x5b, 0x10, 0x7e,
+	0x4b, 0x78, 0xb1, 0x77, 0x66, 0x21, 0x0c, 0x1d, 0x97, 0x85, 0xa1, 0x1d, 0x79, 0xf9, 0xd5, 0x0a,
+	0x13, 0xcd, 0xa1, 0x4f, 0x05, 0x0b, 0xec, 0x84, 0x78, 0x16, 0x27, 0x4b, 0x75, 0x88, 0x3e, 0x33,
+	0xfe, 0xbd, 0xfa, 0x27, 0xa7, 0x39, 0xd4, 0x24, 0x4b, 0x53, 0xa7, 0x95, 0x81, 0x3e, 0x06, 0x20,
+	0xeb, 0x84, 0xdb, 0x96, 0xcd, 0xfd, 0xa2, 0x98, 0x7a, 0xca, 0xf3, 0x92, 0xfb, 0x42, 0xbe, 0x8d,
+	0x44, 0xb7, 0xb8, 0xad, 0xfc, 0x72, 0x89, 0x3e, 0x01, 0xf0, 0x68, 0x48, 0x22, 0x91, 0x7f, 0xfc,
+	0x32, 0x50, 0xf3, 0xc8, 0x4c, 0x26, 0xb6, 0x2f, 0x70, 0x27, 0xcb, 0xa4, 0x5c, 0xcb, 0x7a, 0x8d,
+	0x39, 0x65, 0x9c, 0x26, 0x6f, 0x70, 0x57, 0x25, 0xa3, 0xb4, 0xd1, 0xd7, 0xb0, 0x43, 0xd6, 0xc4,
+	0x4d, 0x65, 0xb3, 0xb0, 0x6a, 0x59, 0x13, 0xb8, 0xa7, 0x90, 0x4f, 0xcb, 0xe8, 0x65, 0x99, 0x3f,
+	0x81, 0x3e, 0x87, 0x27, 0x3e, 0xb7, 0x5d, 0x62, 0xc5, 0x84, 0x53, 0xe6, 0x65, 0x04, 0x50, 0x84,
+	0xa1, 0x0a, 0x9c, 0x2b, 0xbf, 0xc2, 0x3e, 0x83, 0x21, 0x65, 0xf7, 0xb7, 0xd6, 0x15, 0x72, 0x9b,
+	0xb2, 0xda, 0x9e, 0xbb, 0x31, 0xe8, 0xb5, 0x34, 0xc9, 0x4b, 0x17, 0x89, 0xca, 0x45, 0x29, 0x6d,
+	0xf4, 0x19, 0x0c, 0xcb, 0xe4, 0xe7, 0xba, 0x65, 0xf2, 0x0f, 0x0a, 0xf7, 0x45, 0xa6, 0xdf, 0x47,
+	0xd0, 0x8b, 0xec, 0x90, 0x88, 0xd8, 0x76, 0x89, 0x92, 0xa8, 0x67, 0x56, 0x0e, 0xe3, 0x77, 0x0d,
+	0x86, 0x1b, 0xdd, 0xe6, 0xc1, 0x4a, 0xd8, 0x81, 0xad, 0x0c, 0x95, 0x9f, 0x94, 0x5b, 0xb2, 0x42,
+	0x54, 0x7f, 0x22, 0x3c, 0xdf, 0xbf, 0x30, 0xa5, 0x52, 0x31, 0x67, 0x31, 0xe1, 0x09, 0x25, 0x85,
+	0xb4, 0x35, 0x4f, 0xa9, 0x54, 0xbb, 0x52, 0xca, 0xb8, 0x05, 0xbd, 0xd6, 0xc5, 0x8a, 0xd2, 0xd6,
+	0x1e, 0x2c, 0xed, 0xc6, 0x66, 0x69, 0x4b, 0x3d, 0x5d, 0x16, 0xc6, 0x01, 0xd9, 0x14, 0x34, 0xff,
+	0x0c, 0x9e, 0x56, 0xd1, 0x2a, 0xf9, 0xc6, 0x2f, 0xb0, 0x7d, 0xce, 0xd9, 0x6b, 0xe2, 0x26, 0x47,
+	0x2c, 0x5a, 0x52, 0x1f, 0xed, 0x41, 0xf3, 0x35, 0x73, 0xb0, 0x36, 0x6e, 0xee, 0xeb, 0xb3, 0xed,
+	0xaa, 0xaa, 0x5f, 0x31, 0xc7, 0x94, 0x11, 0xf4, 0x05, 0x74, 0xf2, 0x9e, 0xa7, 0xbe, 0x0c, 0x7d,
+	0xf6, 0xe4, 0x1f, 0xcd, 0xc7, 0x2c, 0x10, 0xc6, 0x5f, 0x0d, 0x18, 0xdc, 0x9f, 0x91, 0x65, 0x9f,
+	0xd4, 0xfe, 0xcb, 0x58, 0x6a, 0xbc, 0xe3, 0x58, 0x7a, 0x0e, 0xdb, 0xc5, 0xb8, 0xb1, 0xd4, 0xc4,
+	0x6e, 0x3e, 0x3a, 0x9b, 0xfa, 0xa2, 0x66, 0xa1, 0x63, 0x18, 0xd5, 0x26, 0x8e, 0x55, 0x9b, 0xf8,
+	0x8f, 0x0c, 0xa9, 0xa1, 0x73, 0xdf, 0x81, 0xbe, 0x85, 0x7e, 0xde, 0xb9, 0xb3, 0x1d, 0xda, 0x8f,
+	0x35, 0x79, 0xdd, 0xaf, 0x0c, 0xc9, 0xcc, 0x87, 0x57, 0xc6, 0xdc, 0x7a, 0x6c, 0xce, 0xe9, 0x77,
+	0x95, 0xe1, 0x6c, 0xa9, 0x3f, 0x40, 0x07, 0x7f, 0x0f, 0x00, 0x1d, 0x1d, 0x2c, 0x0b, 0x43, 0x09,
+	0x00, 0x00,
 }
@@ -67,6 +67,11 @@ func GetPublicStateKind(j *engine.Job, traits task.Traits) PublicStateKind {
 		// Job invocation is still in the task queue, but new invocation should be
 		// starting now (so the queue is lagging for some reason).
 		return PublicStateStarting
+	case len(j.State.ActiveInvocations) != 0 &&
+		(j.State.State == engine.JobStateScheduled || j.State.State == engine.JobStateSuspended):
+		// The job is waiting for a tick or a trigger, but some of its invocations
+		// started concurrently are still running.
+		return PublicStateRunning
 	case j.Paused && j.State.State == engine.JobStateSuspended:
 		// Paused jobs don't have a schedule, so they are always in "SUSPENDED"
 		// state. Make it clearer that they are just paused. This applies to both
@@ -46,6 +46,10 @@ func TestGetPublicStateKind(t *testing.T) {
 		So(GetPublicStateKind(&engine.Job{
 			State: engine.JobState{State: engine.JobStateQueued, InvocationID: 1},
 		}, task.Traits{Multistage: false}), ShouldEqual, PublicStateRunning)
+
+		So(GetPublicStateKind(&engine.Job{
+			State: engine.JobState{State: engine.JobStateScheduled, ActiveInvocations: []int64{1}},
+		}, task.Traits{}), ShouldEqual, PublicStateRunning)
 	})
 }
 
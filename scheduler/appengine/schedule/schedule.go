@@ -33,6 +33,17 @@ type Schedule struct {
 	cronExpr  *cronexpr.Expression // set for absolute schedules
 	interval  time.Duration        // set for relative schedules
 	triggered bool                 // set for triggered schedule
+
+	location   *time.Location // timezone to evaluate the schedule in, never nil
+	exclusions []dateRange    // calendar dates when the job must not run
+}
+
+// dateRange is a range of whole calendar days in some timezone.
+//
+// It includes 'start' and excludes 'end'.
+type dateRange struct {
+	start time.Time // midnight of the first day of the range
+	end   time.Time // midnight of the day after the last day of the range
 }
 
 // IsAbsolute is true for schedules that do not depend on a job state.
@@ -52,16 +63,42 @@ func (s *Schedule) IsAbsolute() bool {
 //
 // 'now' is current time. 'prev' is when previous invocation has finished (or
 // zero time for first invocation).
+//
+// Moments that fall on excluded dates are skipped.
 func (s *Schedule) Next(now, prev time.Time) time.Time {
 	if s.triggered {
 		return DistantFuture
 	}
 
-	// For an absolute schedule just look at the time table.
+	// For an absolute schedule just look at the time table, skipping excluded
+	// dates.
 	if s.cronExpr != nil {
-		return s.cronExpr.Next(now)
+		next := s.cronNext(now)
+		for !next.IsZero() {
+			r := s.exclusionAt(next)
+			if r == nil {
+				break
+			}
+			next = s.cronNext(r.end.Add(-time.Nanosecond))
+		}
+		if next.IsZero() {
+			return next
+		}
+		return next.In(now.Location())
 	}
 
+	next := s.relativeNext(now, prev)
+	for {
+		r := s.exclusionAt(next)
+		if r == nil {
+			return next
+		}
+		next = r.end.In(now.Location())
+	}
+}
+
+// relativeNext implements Next for relative schedules, ignoring exclusions.
+func (s *Schedule) relativeNext(now, prev time.Time) time.Time {
 	// Using relative schedule and this is a first invocation ever? Randomize
 	// start time, so that a bunch of newly registered cron jobs do not start all
 	// at once. Otherwise just wait for 'interval' seconds after previous
@@ -79,6 +116,42 @@ func (s *Schedule) Next(now, prev time.Time) time.Time {
 	return next
 }
 
+// cronNext returns the closest moment after 'now' that matches the cron
+// expression, evaluated in the schedule's timezone.
+//
+// Returns zero time if there's no such moment.
+//
+// The expression is matched against the wall clock (to be insensitive to DST
+// transitions), and the result is then converted to a real moment in time. Each
+// wall clock time matches at most once, even if it happens twice due to DST.
+func (s *Schedule) cronNext(now time.Time) time.Time {
+	wall := toWall(now, s.location)
+	for {
+		nextWall := s.cronExpr.Next(wall)
+		if nextWall.IsZero() {
+			return nextWall
+		}
+		// A wall clock time after 'now' may still correspond to a moment before
+		// 'now' if the clock was moved back. Skip such moments.
+		if next := fromWall(nextWall, s.location); next.After(now) {
+			return next
+		}
+		wall = nextWall
+	}
+}
+
+// exclusionAt returns an excluded date range that contains 't' or nil if 't'
+// is not excluded.
+func (s *Schedule) exclusionAt(t time.Time) *dateRange {
+	for i := range s.exclusions {
+		r := &s.exclusions[i]
+		if !t.Before(r.start) && t.Before(r.end) {
+			return r
+		}
+	}
+	return nil
+}
+
 // String serializes the schedule to a human readable string.
 //
 // It can be passed to Parse to get back the schedule.
@@ -90,11 +163,12 @@ func (s *Schedule) String() string {
 //
 // Supported kinds of schedules (illustrated by examples):
 //   - "* 0 * * * *": standard cron-like expression. Cron engine will attempt
-//     to start a job at specified moments in time (based on UTC clock). If when
-//     triggering a job, previous invocation is still running, an overrun will
-//     be recorded (and next attempt to start a job happens based on the
-//     schedule, not when the previous invocation finishes). This is absolute
-//     schedule (i.e. doesn't depend on job state).
+//     to start a job at specified moments in time (based on UTC clock, unless
+//     a timezone is given, see below). If when triggering a job, previous
+//     invocation is still running, an overrun will be recorded (and next
+//     attempt to start a job happens based on the schedule, not when the
+//     previous invocation finishes). This is absolute schedule (i.e. doesn't
+//     depend on job state).
 //   - "with 10s interval": runs invocations in a loop, waiting 10s after
 //     finishing invocation before starting a new one. This is relative
 //     schedule. Overruns are not possible.
@@ -102,19 +176,38 @@ func (s *Schedule) String() string {
 //     in a loop without any pauses.
 //   - "triggered" schedule indicates that job is always started via "Run now"
 //     button. 'Next' always returns DistantFuture constant.
+//
+// Cron-like and relative schedules can be further refined:
+//   - "TZ=America/Los_Angeles 0 3 * * *": "TZ=<name>" prefix specifies an IANA
+//     timezone to evaluate the schedule in (instead of UTC). Cron expressions
+//     are matched against the local wall clock. Wall clock times skipped due to
+//     a DST transition are shifted forward by the length of the gap. Wall clock
+//     times that happen twice match only once (the first time).
+//   - "0 3 * * * except 2017-12-25,2017-12-31..2018-01-01": "except <list>"
+//     suffix specifies a comma-separated list of calendar dates or inclusive
+//     date ranges (in the schedule's timezone) when the job must not start.
 func Parse(expr string, randSeed uint64) (sched *Schedule, err error) {
-	toParse := ""
-	switch expr {
+	toParse, loc, err := parseTimezone(expr)
+	if err != nil {
+		return nil, err
+	}
+	toParse, exclusions, err := parseExclusions(toParse, loc)
+	if err != nil {
+		return nil, err
+	}
+	switch toParse {
 	case "triggered":
+		if toParse != expr {
+			return nil, errors.New("timezones and exclusions are not supported by \"triggered\" schedule")
+		}
 		return &Schedule{
 			asString:  "triggered",
 			randSeed:  randSeed,
 			triggered: true,
+			location:  loc,
 		}, nil
 	case "continuously":
 		toParse = "with 0s interval"
-	default:
-		toParse = expr
 	}
 	if strings.HasPrefix(toParse, "with ") {
 		sched, err = parseWithSchedule(toParse, randSeed)
@@ -124,10 +217,79 @@ func Parse(expr string, randSeed uint64) (sched *Schedule, err error) {
 	if sched != nil {
 		sched.asString = expr
 		sched.randSeed = randSeed
+		sched.location = loc
+		sched.exclusions = exclusions
 	}
 	return sched, err
 }
 
+// parseTimezone strips optional "TZ=<name> " prefix and returns the rest of
+// the expression and the timezone (UTC if there's no prefix).
+func parseTimezone(expr string) (string, *time.Location, error) {
+	if !strings.HasPrefix(expr, "TZ=") {
+		return expr, time.UTC, nil
+	}
+	tokens := strings.SplitN(expr, " ", 2)
+	if len(tokens) != 2 {
+		return "", nil, errors.New("expecting format \"TZ=<timezone> <schedule>\"")
+	}
+	name := strings.TrimPrefix(tokens[0], "TZ=")
+	// LoadLocation treats "" as UTC and "Local" as the server timezone. Neither
+	// makes sense here.
+	if name == "" || name == "Local" {
+		return "", nil, fmt.Errorf("bad timezone %q", name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return "", nil, fmt.Errorf("bad timezone %q - %s", name, err)
+	}
+	return strings.TrimSpace(tokens[1]), loc, nil
+}
+
+// parseExclusions strips optional " except <list>" suffix and returns the rest
+// of the expression and parsed list of excluded dates.
+func parseExclusions(expr string, loc *time.Location) (string, []dateRange, error) {
+	idx := strings.Index(expr, " except ")
+	if idx == -1 {
+		return expr, nil, nil
+	}
+	var out []dateRange
+	for _, item := range strings.Split(expr[idx+len(" except "):], ",") {
+		r, err := parseDateRange(strings.TrimSpace(item), loc)
+		if err != nil {
+			return "", nil, err
+		}
+		out = append(out, r)
+	}
+	return strings.TrimSpace(expr[:idx]), out, nil
+}
+
+// parseDateRange parses "YYYY-MM-DD" or "YYYY-MM-DD..YYYY-MM-DD" string.
+func parseDateRange(s string, loc *time.Location) (dateRange, error) {
+	const layout = "2006-01-02"
+	first, last := s, s
+	if idx := strings.Index(s, ".."); idx != -1 {
+		first, last = s[:idx], s[idx+2:]
+	}
+	start, err := time.ParseInLocation(layout, first, loc)
+	if err != nil {
+		return dateRange{}, fmt.Errorf("bad excluded date %q, expecting YYYY-MM-DD", first)
+	}
+	end, err := time.ParseInLocation(layout, last, loc)
+	if err != nil {
+		return dateRange{}, fmt.Errorf("bad excluded date %q, expecting YYYY-MM-DD", last)
+	}
+	if end.Before(start) {
+		return dateRange{}, fmt.Errorf("bad excluded range %q - the end is before the start", s)
+	}
+	// Midnight may not exist on days with DST transitions, so construct it as
+	// a wall clock time.
+	return dateRange{
+		start: fromWall(toWall(start, loc), loc),
+		end:   fromWall(toWall(end, loc).AddDate(0, 0, 1), loc),
+	}, nil
+}
+
 // parseWithSchedule parses "with <interval> interval" schedule string.
 func parseWithSchedule(expr string, randSeed uint64) (*Schedule, error) {
 	tokens := strings.SplitN(expr, " ", 3)
@@ -144,6 +306,50 @@ func parseWithSchedule(expr string, randSeed uint64) (*Schedule, error) {
 	return &Schedule{interval: interval}, nil
 }
 
+// toWall returns wall clock time of 't' in the given timezone, represented as
+// time.Time in UTC.
+func toWall(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(
+		t.Year(), t.Month(), t.Day(),
+		t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// fromWall is reverse of toWall: it returns a moment in time that has given
+// wall clock time in the given timezone.
+//
+// Wall clock times skipped due to a DST transition are shifted forward by the
+// length of the gap. Wall clock times that happen twice resolve to the earliest
+// moment.
+func fromWall(wall time.Time, loc *time.Location) time.Time {
+	// Transitions are rare, so zone offsets a day before and a day after 'wall'
+	// are offsets before and after the transition (if any).
+	guess := time.Date(
+		wall.Year(), wall.Month(), wall.Day(),
+		wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), loc)
+	_, offBefore := guess.Add(-24 * time.Hour).Zone()
+	_, offAfter := guess.Add(24 * time.Hour).Zone()
+	before := wall.Add(-time.Duration(offBefore) * time.Second).In(loc)
+	after := wall.Add(-time.Duration(offAfter) * time.Second).In(loc)
+	beforeOK := toWall(before, loc).Equal(wall)
+	afterOK := toWall(after, loc).Equal(wall)
+	switch {
+	case beforeOK && afterOK:
+		if after.Before(before) {
+			return after
+		}
+		return before
+	case afterOK:
+		return after
+	default:
+		// Either 'before' is the only match, or the wall clock time doesn't exist
+		// at all. In the latter case 'before' is the wall clock time interpreted
+		// using the offset before the transition, i.e. shifted forward by the
+		// length of the gap.
+		return before
+	}
+}
+
 // parseCronSchedule parses crontab-like schedule string.
 func parseCronSchedule(expr string, randSeed uint64) (*Schedule, error) {
 	cronexprLock.Lock()
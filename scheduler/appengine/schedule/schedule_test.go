@@ -67,3 +67,152 @@ func TestRelativeSchedule(t *testing.T) {
 		So(sched.Next(epoch.Add(31*time.Second), epoch.Add(15*time.Second)), ShouldResemble, epoch.Add(31*time.Second))
 	})
 }
+
+func TestTimezones(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("no tzdata - %s", err)
+	}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("no tzdata - %s", err)
+	}
+
+	// ticks returns first 'n' ticks of the schedule, starting from 'now'.
+	ticks := func(s *Schedule, now time.Time, n int) []time.Time {
+		out := make([]time.Time, n)
+		for i := range out {
+			now = s.Next(now, time.Time{})
+			out[i] = now
+		}
+		return out
+	}
+
+	Convey("Parsing success", t, func() {
+		sched, err := Parse("TZ=America/New_York 0 30 2 * * * *", 0)
+		So(err, ShouldBeNil)
+		So(sched.String(), ShouldEqual, "TZ=America/New_York 0 30 2 * * * *")
+		So(sched.IsAbsolute(), ShouldBeTrue)
+
+		sched, err = Parse("TZ=America/New_York with 10m interval", 0)
+		So(err, ShouldBeNil)
+		So(sched.IsAbsolute(), ShouldBeFalse)
+	})
+
+	Convey("Parsing error", t, func() {
+		_, err := Parse("TZ=Nowhere/Special 0 30 2 * * * *", 0)
+		So(err, ShouldNotBeNil)
+		_, err = Parse("TZ=Local 0 30 2 * * * *", 0)
+		So(err, ShouldNotBeNil)
+		_, err = Parse("TZ=America/New_York", 0)
+		So(err, ShouldNotBeNil)
+		_, err = Parse("TZ=America/New_York triggered", 0)
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Evaluated in the given timezone", t, func() {
+		sched, _ := Parse("TZ=America/New_York 0 30 2 * * * *", 0)
+		now := time.Date(2017, 6, 1, 0, 0, 0, 0, time.UTC)
+		So(sched.Next(now, time.Time{}), ShouldResemble, time.Date(2017, 6, 1, 6, 30, 0, 0, time.UTC))
+	})
+
+	Convey("Spring forward", t, func() {
+		// Clocks jump from 2:00 EST to 3:00 EDT on Mar 12, 2017. 2:30 doesn't
+		// exist that day, the job runs at 3:30 EDT instead.
+		sched, _ := Parse("TZ=America/New_York 0 30 2 * * * *", 0)
+		now := time.Date(2017, 3, 11, 12, 0, 0, 0, newYork).UTC()
+		So(ticks(sched, now, 3), ShouldResemble, []time.Time{
+			time.Date(2017, 3, 12, 2, 30, 0, 0, time.UTC).Add(5 * time.Hour), // 2:30 EST
+			time.Date(2017, 3, 13, 2, 30, 0, 0, time.UTC).Add(4 * time.Hour), // 2:30 EDT
+			time.Date(2017, 3, 14, 2, 30, 0, 0, time.UTC).Add(4 * time.Hour), // 2:30 EDT
+		})
+		So(ticks(sched, now, 1)[0].In(newYork).Hour(), ShouldEqual, 3)
+
+		// Same in a timezone east of UTC. Clocks jump from 2:00 CET to 3:00 CEST
+		// on Mar 26, 2017.
+		sched, _ = Parse("TZ=Europe/Berlin 0 30 2 * * * *", 0)
+		now = time.Date(2017, 3, 25, 12, 0, 0, 0, berlin).UTC()
+		So(ticks(sched, now, 2), ShouldResemble, []time.Time{
+			time.Date(2017, 3, 26, 1, 30, 0, 0, time.UTC), // 3:30 CEST
+			time.Date(2017, 3, 27, 0, 30, 0, 0, time.UTC), // 2:30 CEST
+		})
+	})
+
+	Convey("Fall back", t, func() {
+		// Clocks jump from 2:00 EDT to 1:00 EST on Nov 5, 2017. 1:30 happens twice
+		// that day, the job runs only once.
+		sched, _ := Parse("TZ=America/New_York 0 30 1 * * * *", 0)
+		now := time.Date(2017, 11, 4, 12, 0, 0, 0, newYork).UTC()
+		So(ticks(sched, now, 3), ShouldResemble, []time.Time{
+			time.Date(2017, 11, 5, 5, 30, 0, 0, time.UTC), // 1:30 EDT
+			time.Date(2017, 11, 6, 6, 30, 0, 0, time.UTC), // 1:30 EST
+			time.Date(2017, 11, 7, 6, 30, 0, 0, time.UTC), // 1:30 EST
+		})
+
+		// Hourly schedule doesn't repeat the hour.
+		sched, _ = Parse("TZ=America/New_York 0 0 * * * * *", 0)
+		now = time.Date(2017, 11, 5, 4, 30, 0, 0, time.UTC) // 0:30 EDT
+		So(ticks(sched, now, 3), ShouldResemble, []time.Time{
+			time.Date(2017, 11, 5, 5, 0, 0, 0, time.UTC), // 1:00 EDT
+			time.Date(2017, 11, 5, 7, 0, 0, 0, time.UTC), // 2:00 EST
+			time.Date(2017, 11, 5, 8, 0, 0, 0, time.UTC), // 3:00 EST
+		})
+
+		// Asking in the middle of the repeated hour doesn't go back in time.
+		sched, _ = Parse("TZ=America/New_York 0 30 1 * * * *", 0)
+		now = time.Date(2017, 11, 5, 6, 10, 0, 0, time.UTC) // 1:10 EST
+		So(sched.Next(now, time.Time{}), ShouldResemble, time.Date(2017, 11, 6, 6, 30, 0, 0, time.UTC))
+	})
+}
+
+func TestExclusions(t *testing.T) {
+	Convey("Parsing success", t, func() {
+		sched, err := Parse("0 0 3 * * * * except 2017-12-25, 2017-12-30..2018-01-01", 0)
+		So(err, ShouldBeNil)
+		So(sched.String(), ShouldEqual, "0 0 3 * * * * except 2017-12-25, 2017-12-30..2018-01-01")
+		So(sched.exclusions, ShouldHaveLength, 2)
+	})
+
+	Convey("Parsing error", t, func() {
+		_, err := Parse("0 0 3 * * * * except 2017-13-25", 0)
+		So(err, ShouldNotBeNil)
+		_, err = Parse("0 0 3 * * * * except 2017-12-25..", 0)
+		So(err, ShouldNotBeNil)
+		_, err = Parse("0 0 3 * * * * except 2017-12-25..2017-12-24", 0)
+		So(err, ShouldNotBeNil)
+		_, err = Parse("triggered except 2017-12-25", 0)
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Absolute schedule skips excluded dates", t, func() {
+		sched, _ := Parse("0 0 3 * * * * except 2017-12-25,2017-12-27..2017-12-28", 0)
+		now := time.Date(2017, 12, 24, 12, 0, 0, 0, time.UTC)
+		var ticks []time.Time
+		for i := 0; i < 3; i++ {
+			now = sched.Next(now, time.Time{})
+			ticks = append(ticks, now)
+		}
+		So(ticks, ShouldResemble, []time.Time{
+			time.Date(2017, 12, 26, 3, 0, 0, 0, time.UTC),
+			time.Date(2017, 12, 29, 3, 0, 0, 0, time.UTC),
+			time.Date(2017, 12, 30, 3, 0, 0, 0, time.UTC),
+		})
+	})
+
+	Convey("Exclusions are in the schedule timezone", t, func() {
+		sched, err := Parse("TZ=America/New_York 0 0 23 * * * * except 2017-12-25", 0)
+		So(err, ShouldBeNil)
+		now := time.Date(2017, 12, 25, 0, 0, 0, 0, time.UTC) // Dec 24, 19:00 EST
+		So(sched.Next(now, time.Time{}), ShouldResemble, time.Date(2017, 12, 25, 4, 0, 0, 0, time.UTC))
+		now = time.Date(2017, 12, 25, 5, 0, 0, 0, time.UTC) // Dec 25, 0:00 EST
+		So(sched.Next(now, time.Time{}), ShouldResemble, time.Date(2017, 12, 27, 4, 0, 0, 0, time.UTC))
+	})
+
+	Convey("Relative schedule waits for the end of excluded dates", t, func() {
+		sched, _ := Parse("with 1h interval except 2017-12-25", 0)
+		prev := time.Date(2017, 12, 24, 23, 30, 0, 0, time.UTC)
+		So(sched.Next(prev, prev), ShouldResemble, time.Date(2017, 12, 26, 0, 0, 0, 0, time.UTC))
+		prev = time.Date(2017, 12, 24, 20, 0, 0, 0, time.UTC)
+		So(sched.Next(prev, prev), ShouldResemble, time.Date(2017, 12, 24, 21, 0, 0, 0, time.UTC))
+	})
+}
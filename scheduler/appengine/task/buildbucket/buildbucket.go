@@ -133,8 +133,9 @@ func (m TaskManager) LaunchTask(c context.Context, ctl task.Controller) error {
 	// At this point config is already validated by ValidateProtoMessage.
 	cfg := ctl.Task().(*messages.BuildbucketTask)
 
-	// Default set of tags.
+	// Default set of tags, plus tags describing the triggering commit (if any).
 	tags := utils.KVListFromMap(defaultTags(c, ctl, cfg)).Pack(':')
+	tags = append(tags, utils.TriggerTags(ctl.Triggers()).Pack(':')...)
 	tags = append(tags, cfg.Tags...)
 
 	// Prepare parameters blob.
@@ -147,6 +148,14 @@ func (m TaskManager) LaunchTask(c context.Context, ctl task.Controller) error {
 	for _, kv := range utils.UnpackKVList(cfg.Properties, ':') {
 		params.Properties[kv.Key] = kv.Value
 	}
+
+	// Pass the commit the invocation was triggered for (if any) to the build.
+	if g := utils.LastGitilesTrigger(ctl.Triggers()); g != nil {
+		ctl.DebugLog("Building revision %s of %s (%s)", g.Revision, g.Repo, g.Ref)
+		params.Properties["repository"] = g.Repo
+		params.Properties["revision"] = g.Revision
+		params.Properties["branch"] = g.Ref
+	}
 	paramsJSON, err := json.Marshal(&params)
 	if err != nil {
 		return fmt.Errorf("failed to marshal parameters JSON - %s", err)
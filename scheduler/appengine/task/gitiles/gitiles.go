@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/url"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
@@ -136,6 +137,7 @@ func (m TaskManager) LaunchTask(c context.Context, ctl task.Controller) error {
 	}()
 
 	var log gerrit.Log
+	refOf := map[string]string{} // commit SHA1 -> ref it was discovered on
 	for r := range ch {
 		if r.err != nil {
 			ctl.DebugLog("Failed to fetch log - %s", r.err)
@@ -148,13 +150,26 @@ func (m TaskManager) LaunchTask(c context.Context, ctl task.Controller) error {
 		if len(r.log) > 0 {
 			heads[r.ref] = r.log[len(r.log)-1].Commit
 		}
+		for _, commit := range r.log {
+			refOf[commit.Commit] = r.ref
+		}
 		log = append(log, r.log...)
 	}
 
 	sort.Sort(log)
 	for _, commit := range log {
-		// TODO(phosek): Trigger buildbucket job here.
 		ctl.DebugLog("Trigger build for commit %s", commit.Commit)
+		ref := refOf[commit.Commit]
+		ctl.EmitTrigger(c, task.Trigger{
+			ID:    fmt.Sprintf("%s/+/%s@%s", cfg.Repo, ref, commit.Commit),
+			Title: commitTitle(commit.Message),
+			URL:   fmt.Sprintf("%s/+/%s", cfg.Repo, commit.Commit),
+			Gitiles: &task.GitilesTrigger{
+				Repo:     cfg.Repo,
+				Ref:      ref,
+				Revision: commit.Commit,
+			},
+		})
 	}
 	if err := m.save(c, ctl.JobID(), u, heads); err != nil {
 		return err
@@ -164,6 +179,14 @@ func (m TaskManager) LaunchTask(c context.Context, ctl task.Controller) error {
 	return nil
 }
 
+// commitTitle returns the first line of a commit message.
+func commitTitle(msg string) string {
+	if idx := strings.IndexByte(msg, '\n'); idx != -1 {
+		return msg[:idx]
+	}
+	return msg
+}
+
 // AbortTask is part of Manager interface.
 func (m TaskManager) AbortTask(c context.Context, ctl task.Controller) error {
 	return nil
@@ -16,6 +16,7 @@ import (
 	ds "github.com/luci/gae/service/datastore"
 	"github.com/luci/gae/service/urlfetch"
 	"github.com/luci/luci-go/scheduler/appengine/messages"
+	"github.com/luci/luci-go/scheduler/appengine/task"
 	"github.com/luci/luci-go/scheduler/appengine/task/utils/tasktest"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -86,6 +87,18 @@ func TestTriggerBuild(t *testing.T) {
 		// Launch.
 		So(m.LaunchTask(c, ctl), ShouldBeNil)
 		So(ctl.Log[0], ShouldEqual, "Trigger build for commit baddcafe")
+		So(ctl.EmittedTriggers, ShouldResemble, []task.Trigger{
+			{
+				ID:    ts.URL + "/+/refs/heads/master@baddcafe",
+				Title: "test",
+				URL:   ts.URL + "/+/baddcafe",
+				Gitiles: &task.GitilesTrigger{
+					Repo:     ts.URL,
+					Ref:      "refs/heads/master",
+					Revision: "baddcafe",
+				},
+			},
+		})
 		r := Repository{ID: "proj/job:" + ts.URL}
 		So(ds.Get(c, &r), ShouldBeNil)
 		So(r, ShouldResemble, Repository{
@@ -145,5 +158,6 @@ func TestTriggerBuild(t *testing.T) {
 
 		So(m.LaunchTask(c, ctl), ShouldBeNil)
 		So(len(ctl.Log), ShouldEqual, 0)
+		So(ctl.EmittedTriggers, ShouldBeNil)
 	})
 }
@@ -171,6 +171,7 @@ func (m TaskManager) LaunchTask(c context.Context, ctl task.Controller) error {
 
 	// Default set of tags.
 	tags := utils.KVListFromMap(defaultTags(c, ctl)).Pack(':')
+	tags = append(tags, utils.TriggerTags(ctl.Triggers()).Pack(':')...)
 	tags = append(tags, cfg.Tags...)
 
 	// How long to keep a task in swarming queue (not running) before marking it
@@ -205,6 +206,17 @@ func (m TaskManager) LaunchTask(c context.Context, ctl task.Controller) error {
 	}
 	ctl.DebugLog("PubSub topic is %q", topic)
 
+	// Pass the commit the invocation was triggered for (if any) to the task via
+	// environment variables.
+	env := kvListToStringPairs(cfg.Env, '=')
+	if g := utils.LastGitilesTrigger(ctl.Triggers()); g != nil {
+		ctl.DebugLog("Running for revision %s of %s (%s)", g.Revision, g.Repo, g.Ref)
+		env = append(env,
+			&swarming.SwarmingRpcsStringPair{Key: "SCHEDULER_GITILES_REPO", Value: g.Repo},
+			&swarming.SwarmingRpcsStringPair{Key: "SCHEDULER_GITILES_REF", Value: g.Ref},
+			&swarming.SwarmingRpcsStringPair{Key: "SCHEDULER_GITILES_REVISION", Value: g.Revision})
+	}
+
 	// Prepare the request.
 	request := swarming.SwarmingRpcsNewTaskRequest{
 		Name:            fmt.Sprintf("scheduler:%s/%d", ctl.JobID(), ctl.InvocationID()),
@@ -215,7 +227,7 @@ func (m TaskManager) LaunchTask(c context.Context, ctl task.Controller) error {
 		Tags:            tags,
 		Properties: &swarming.SwarmingRpcsTaskProperties{
 			Dimensions:           kvListToStringPairs(cfg.Dimensions, ':'),
-			Env:                  kvListToStringPairs(cfg.Env, '='),
+			Env:                  env,
 			ExecutionTimeoutSecs: executionTimeoutSecs,
 			ExtraArgs:            cfg.ExtraArgs,
 			GracePeriodSecs:      int64(gracePeriodSecs),
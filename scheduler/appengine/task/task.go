@@ -184,6 +184,12 @@ type Controller interface {
 	// return value.
 	Task() proto.Message
 
+	// Triggers returns triggers that caused this invocation to start.
+	//
+	// Empty if the invocation was started on schedule or manually via
+	// "Run now" button.
+	Triggers() []Trigger
+
 	// State returns a mutable portion of task invocation state.
 	//
 	// TaskManager can modify it in-place and then call Controller.Save to persist
@@ -213,6 +219,15 @@ type Controller interface {
 	// on HandleTimer transient errors.
 	AddTimer(c context.Context, delay time.Duration, name string, payload []byte)
 
+	// EmitTrigger delivers a trigger to all jobs triggered by the current job.
+	//
+	// The list of such jobs is defined by 'triggers' field in the job config.
+	// Does nothing if the job doesn't trigger any other jobs.
+	//
+	// All triggers are actually emitted in Save(), in the same transaction that
+	// updates the invocation state.
+	EmitTrigger(c context.Context, trigger Trigger)
+
 	// PrepareTopic create PubSub topic for notifications related to the task and
 	// adds given publisher to its ACL.
 	//
@@ -228,6 +243,17 @@ type Controller interface {
 	// component expose this endpoint.
 	PrepareTopic(c context.Context, publisher string) (topic string, token string, err error)
 
+	// PrepareCallbackURL returns an URL that external services can POST
+	// notifications related to the task to.
+	//
+	// It is an alternative to PrepareTopic for services that can't publish
+	// PubSub messages. The URL embeds an HMAC protected token that identifies
+	// the invocation, so callers don't need any other credentials to use it.
+	// Bodies of POST requests are routed to Manager.HandleNotification as
+	// base64-encoded 'Data' field of PubsubMessage (just like bodies of real
+	// PubSub messages).
+	PrepareCallbackURL(c context.Context) (string, error)
+
 	// GetClient returns http.Client that is configured to use job's service
 	// account credentials to talk to other services.
 	//
@@ -257,3 +283,34 @@ type State struct {
 	TaskData []byte // storage for TaskManager-specific task data
 	ViewURL  string // URL to human readable task page, shows in UI
 }
+
+// Trigger is an event emitted by some job (via Controller.EmitTrigger) and
+// delivered to all jobs it triggers.
+//
+// Each delivered trigger results in an invocation of the triggered job (see
+// Controller.Triggers).
+//
+// It will be serialized to JSON, so all fields are public.
+type Trigger struct {
+	// ID is unique identifier of the trigger, used for deduplication.
+	//
+	// Triggers with the same ID delivered to the same job are considered
+	// identical, only one of them will be processed.
+	ID string `json:"id"`
+
+	// Title is optional one-line human readable description of the trigger.
+	Title string `json:"title,omitempty"`
+
+	// URL is optional link to a page with the trigger details.
+	URL string `json:"url,omitempty"`
+
+	// Gitiles is set for triggers emitted by Gitiles poller.
+	Gitiles *GitilesTrigger `json:"gitiles,omitempty"`
+}
+
+// GitilesTrigger describes a commit discovered by Gitiles poller.
+type GitilesTrigger struct {
+	Repo     string `json:"repo"`     // URL of the repository
+	Ref      string `json:"ref"`      // ref the commit was discovered on
+	Revision string `json:"revision"` // SHA1 of the commit
+}
@@ -29,15 +29,18 @@ type TestController struct {
 	OverrideInvID    int64  // return value of InvocationID() if not 0
 	OverrideInvNonce int64  // return value of InvocationNonce() if not 0
 
-	TaskMessage proto.Message // return value of Task
-	TaskState   task.State    // return value of State(), mutated in place
-	Client      *http.Client  // return value by GetClient()
-	Log         []string      // individual log lines passed to DebugLog()
+	TaskMessage      proto.Message  // return value of Task
+	IncomingTriggers []task.Trigger // return value of Triggers
+	TaskState        task.State     // return value of State(), mutated in place
+	Client           *http.Client   // return value by GetClient()
+	Log              []string       // individual log lines passed to DebugLog()
 
 	SaveCallback         func() error                         // mock for Save()
 	PrepareTopicCallback func(string) (string, string, error) // mock for PrepareTopic()
+	CallbackURL          string                               // return value of PrepareCallbackURL() if not ""
 
-	Timers []TimerSpec
+	Timers          []TimerSpec
+	EmittedTriggers []task.Trigger // triggers passed to EmitTrigger
 }
 
 // JobID is part of Controller interface.
@@ -69,6 +72,11 @@ func (c *TestController) Task() proto.Message {
 	return c.TaskMessage
 }
 
+// Triggers is part of Controller interface.
+func (c *TestController) Triggers() []task.Trigger {
+	return c.IncomingTriggers
+}
+
 // State is part of Controller interface.
 func (c *TestController) State() *task.State {
 	return &c.TaskState
@@ -83,6 +91,11 @@ func (c *TestController) AddTimer(ctx context.Context, delay time.Duration, name
 	})
 }
 
+// EmitTrigger is part of Controller interface.
+func (c *TestController) EmitTrigger(ctx context.Context, trigger task.Trigger) {
+	c.EmittedTriggers = append(c.EmittedTriggers, trigger)
+}
+
 // DebugLog is part of Controller interface.
 func (c *TestController) DebugLog(format string, args ...interface{}) {
 	c.Log = append(c.Log, fmt.Sprintf(format, args...))
@@ -104,6 +117,14 @@ func (c *TestController) PrepareTopic(ctx context.Context, publisher string) (to
 	return "", "", errors.New("PrepareTopic must not be called (not mocked)")
 }
 
+// PrepareCallbackURL is part of Controller interface.
+func (c *TestController) PrepareCallbackURL(ctx context.Context) (string, error) {
+	if c.CallbackURL != "" {
+		return c.CallbackURL, nil
+	}
+	return "", errors.New("PrepareCallbackURL must not be called (not mocked)")
+}
+
 // GetClient is part of Controller interface.
 func (c *TestController) GetClient(ctx context.Context, timeout time.Duration) (*http.Client, error) {
 	if c.Client != nil {
@@ -0,0 +1,55 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/luci/luci-go/scheduler/appengine/task"
+)
+
+// LastGitilesTrigger returns the most recent Gitiles trigger from the list or
+// nil if there are no Gitiles triggers there.
+func LastGitilesTrigger(triggers []task.Trigger) *task.GitilesTrigger {
+	for i := len(triggers) - 1; i >= 0; i-- {
+		if triggers[i].Gitiles != nil {
+			return triggers[i].Gitiles
+		}
+	}
+	return nil
+}
+
+// TriggerTags returns a list of "key:value" tags describing the commit the
+// invocation was triggered for, if any.
+//
+// They are supposed to be attached to tasks launched by the invocation (e.g.
+// Swarming tasks or Buildbucket builds).
+func TriggerTags(triggers []task.Trigger) KVList {
+	g := LastGitilesTrigger(triggers)
+	if g == nil {
+		return nil
+	}
+	out := KVList{}
+	if buildset := gitilesBuildset(g); buildset != "" {
+		out = append(out, KV{Key: "buildset", Value: buildset})
+	}
+	if g.Ref != "" {
+		out = append(out, KV{Key: "gitiles_ref", Value: g.Ref})
+	}
+	return out
+}
+
+// gitilesBuildset returns "commit/gitiles/<host>/<project>/+/<revision>"
+// string or "" if repo URL is malformed.
+func gitilesBuildset(g *task.GitilesTrigger) string {
+	u, err := url.Parse(g.Repo)
+	if err != nil || u.Host == "" || g.Revision == "" {
+		return ""
+	}
+	project := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	return fmt.Sprintf("commit/gitiles/%s/%s/+/%s", u.Host, project, g.Revision)
+}
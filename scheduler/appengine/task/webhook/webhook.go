@@ -0,0 +1,311 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package webhook implements tasks that notify external services via HTTP and
+// wait for them to report completion via signed HTTP callbacks.
+package webhook
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/api/pubsub/v1"
+
+	"github.com/luci/gae/service/urlfetch"
+
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/retry"
+	"github.com/luci/luci-go/common/retry/transient"
+	"github.com/luci/luci-go/scheduler/appengine/messages"
+	"github.com/luci/luci-go/scheduler/appengine/task"
+)
+
+const (
+	defaultTimeoutSec           = 60
+	defaultCompletionTimeoutSec = 24 * 3600
+
+	// completionTimeoutTimer is a name of a timer that fires when the external
+	// service doesn't report completion in time.
+	completionTimeoutTimer = "completion-timeout"
+)
+
+// TaskManager implements task.Manager interface for tasks defined with
+// WebhookTask proto message.
+type TaskManager struct {
+}
+
+// Request is JSON body of a request sent to the webhook URL when the task
+// starts.
+type Request struct {
+	JobID           string         `json:"job_id"`
+	InvocationID    int64          `json:"invocation_id"`
+	InvocationNonce int64          `json:"invocation_nonce"`
+	CallbackURL     string         `json:"callback_url"`
+	Triggers        []task.Trigger `json:"triggers,omitempty"`
+}
+
+// Callback is JSON body of a request the external service sends to the
+// callback URL when the work is done.
+type Callback struct {
+	Status  string `json:"status"`             // "SUCCEEDED" or "FAILED"
+	Message string `json:"message,omitempty"`  // optional message for the debug log
+	ViewURL string `json:"view_url,omitempty"` // optional link to the results
+}
+
+// ParseCallback parses and validates a body of a callback request.
+func ParseCallback(blob []byte) (*Callback, error) {
+	cb := &Callback{}
+	if err := json.Unmarshal(blob, cb); err != nil {
+		return nil, fmt.Errorf("can't parse callback body as JSON - %s", err)
+	}
+	if _, err := cb.taskStatus(); err != nil {
+		return nil, err
+	}
+	return cb, nil
+}
+
+// taskStatus converts the callback status to task.Status.
+func (cb *Callback) taskStatus() (task.Status, error) {
+	switch cb.Status {
+	case "SUCCEEDED":
+		return task.StatusSucceeded, nil
+	case "FAILED":
+		return task.StatusFailed, nil
+	default:
+		return "", fmt.Errorf("unrecognized status %q in the callback", cb.Status)
+	}
+}
+
+// Name is part of Manager interface.
+func (m TaskManager) Name() string {
+	return "webhook"
+}
+
+// ProtoMessageType is part of Manager interface.
+func (m TaskManager) ProtoMessageType() proto.Message {
+	return (*messages.WebhookTask)(nil)
+}
+
+// Traits is part of Manager interface.
+func (m TaskManager) Traits() task.Traits {
+	return task.Traits{
+		Multistage: true, // we use task.StatusRunning state
+	}
+}
+
+// ValidateProtoMessage is part of Manager interface.
+func (m TaskManager) ValidateProtoMessage(msg proto.Message) error {
+	cfg, ok := msg.(*messages.WebhookTask)
+	if !ok {
+		return fmt.Errorf("wrong type %T, expecting *messages.WebhookTask", msg)
+	}
+	if cfg == nil {
+		return fmt.Errorf("expecting a non-empty WebhookTask")
+	}
+
+	// Validate 'url' field.
+	if cfg.Url == "" {
+		return fmt.Errorf("field 'url' is required")
+	}
+	u, err := url.Parse(cfg.Url)
+	if err != nil {
+		return fmt.Errorf("invalid URL %q: %s", cfg.Url, err)
+	}
+	if !u.IsAbs() {
+		return fmt.Errorf("not an absolute url: %q", cfg.Url)
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("only https:// URLs are allowed, got %q", cfg.Url)
+	}
+
+	// Validate 'timeout_sec' field. Same limits as in url_fetch tasks.
+	if cfg.TimeoutSec != 0 {
+		if cfg.TimeoutSec < 1 {
+			return fmt.Errorf("minimum allowed 'timeout_sec' is 1 sec, got %d", cfg.TimeoutSec)
+		}
+		if cfg.TimeoutSec > 480 {
+			return fmt.Errorf("maximum allowed 'timeout_sec' is 480 sec, got %d", cfg.TimeoutSec)
+		}
+	}
+
+	// Validate 'completion_timeout_sec' field. Callback tokens expire in 8 days,
+	// so don't allow to wait longer than 7 days.
+	if cfg.CompletionTimeoutSec != 0 {
+		if cfg.CompletionTimeoutSec < 1 {
+			return fmt.Errorf("minimum allowed 'completion_timeout_sec' is 1 sec, got %d", cfg.CompletionTimeoutSec)
+		}
+		if cfg.CompletionTimeoutSec > 7*24*3600 {
+			return fmt.Errorf("maximum allowed 'completion_timeout_sec' is 7 days, got %d", cfg.CompletionTimeoutSec)
+		}
+	}
+
+	return nil
+}
+
+// LaunchTask is part of Manager interface.
+func (m TaskManager) LaunchTask(c context.Context, ctl task.Controller) error {
+	cfg := ctl.Task().(*messages.WebhookTask)
+
+	timeout := cfg.TimeoutSec
+	if timeout == 0 {
+		timeout = defaultTimeoutSec
+	}
+	completionTimeout := cfg.CompletionTimeoutSec
+	if completionTimeout == 0 {
+		completionTimeout = defaultCompletionTimeoutSec
+	}
+
+	callbackURL, err := ctl.PrepareCallbackURL(c)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(&Request{
+		JobID:           ctl.JobID(),
+		InvocationID:    ctl.InvocationID(),
+		InvocationNonce: ctl.InvocationNonce(),
+		CallbackURL:     callbackURL,
+		Triggers:        ctl.Triggers(),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Save the invocation as running before the callback URL is sent out: the
+	// external service may call back before the webhook replies, and the
+	// callback must find the invocation ready to receive it. The engine can't
+	// retry LaunchTask after this point, so transient errors are retried here.
+	ctl.DebugLog("POST %s", cfg.Url)
+	ctl.DebugLog("Waiting for the callback (up to %s)", time.Duration(completionTimeout)*time.Second)
+	ctl.State().Status = task.StatusRunning
+	ctl.AddTimer(c, time.Duration(completionTimeout)*time.Second, completionTimeoutTimer, nil)
+	if err := ctl.Save(c); err != nil {
+		// Nothing was sent yet, let the engine retry LaunchTask.
+		ctl.State().Status = task.StatusStarting
+		return transient.Tag.Apply(err)
+	}
+
+	// The URL is provided by the project config, so do not send any credentials
+	// there.
+	client := &http.Client{
+		Transport: urlfetch.Get(c),
+		Timeout:   time.Duration(timeout) * time.Second,
+	}
+	err = retry.Retry(c, transient.Only(retryParams), func() error {
+		return postWebhook(c, ctl, client, cfg.Url, body)
+	}, retry.LogCallback(c, "webhook"))
+	if err != nil {
+		ctl.DebugLog("Giving up - %s", err)
+		ctl.State().Status = task.StatusFailed
+	}
+	return nil
+}
+
+// retryParams defines how to retry transient webhook failures.
+func retryParams() retry.Iterator {
+	return &retry.ExponentialBackoff{
+		Limited: retry.Limited{
+			Delay:    time.Second,
+			Retries:  5,
+			MaxTotal: 2 * time.Minute,
+		},
+		MaxDelay:   30 * time.Second,
+		Multiplier: 2,
+	}
+}
+
+// postWebhook sends the request to the webhook URL.
+//
+// Transport errors and 5xx replies are returned as transient errors. Replies
+// are put into the debug log only on failures: the invocation may be modified
+// by the callback concurrently, so LaunchTask should not change it on success.
+func postWebhook(c context.Context, ctl task.Controller, client *http.Client, webhookURL string, body []byte) error {
+	resp, err := client.Post(webhookURL, "application/json; charset=utf-8", bytes.NewReader(body))
+	if err != nil {
+		ctl.DebugLog("Webhook call failed - %s", err)
+		return transient.Tag.Apply(err)
+	}
+	defer resp.Body.Close()
+
+	logging.Infof(c, "Webhook replied with %s", resp.Status)
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	// Read 4K only, it is used only for the debug log.
+	buf := bytes.Buffer{}
+	io.CopyN(&buf, resp.Body, 4096)
+	ctl.DebugLog("Webhook replied with %s", resp.Status)
+	if buf.Len() != 0 {
+		ctl.DebugLog("%s", buf.String())
+	}
+
+	err = fmt.Errorf("webhook replied with %s", resp.Status)
+	if resp.StatusCode >= 500 {
+		return transient.Tag.Apply(err)
+	}
+	return err
+}
+
+// AbortTask is part of Manager interface.
+func (m TaskManager) AbortTask(c context.Context, ctl task.Controller) error {
+	// The external service isn't notified about aborts. Its callback, if any,
+	// will be ignored, since the invocation is already finished by then.
+	return nil
+}
+
+// HandleNotification is part of Manager interface.
+//
+// Bad callbacks are logged and ignored, so they can't break the invocation.
+// The HTTP handler rejects them before they get here (see ParseCallback).
+func (m TaskManager) HandleNotification(c context.Context, ctl task.Controller, msg *pubsub.PubsubMessage) error {
+	if ctl.State().Status.Final() {
+		logging.Warningf(c, "Ignoring callback for already finished invocation")
+		return nil
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(msg.Data)
+	if err != nil {
+		logging.Errorf(c, "Ignoring callback, can't decode its body - %s", err)
+		return nil
+	}
+	cb, err := ParseCallback(blob)
+	if err != nil {
+		logging.Errorf(c, "Ignoring bad callback - %s", err)
+		ctl.DebugLog("Ignoring bad callback - %s", err)
+		return nil
+	}
+	status, _ := cb.taskStatus()
+
+	ctl.DebugLog("Callback received with status %s", cb.Status)
+	if cb.Message != "" {
+		ctl.DebugLog("%s", cb.Message)
+	}
+	if cb.ViewURL != "" {
+		ctl.State().ViewURL = cb.ViewURL
+	}
+	ctl.State().Status = status
+	return nil
+}
+
+// HandleTimer is part of Manager interface.
+func (m TaskManager) HandleTimer(c context.Context, ctl task.Controller, name string, payload []byte) error {
+	if name != completionTimeoutTimer {
+		return errors.New("unexpected timer")
+	}
+	if ctl.State().Status.Final() {
+		return nil
+	}
+	ctl.DebugLog("The callback wasn't received in time, giving up")
+	ctl.State().Status = task.StatusFailed
+	return nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package webhook
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/api/pubsub/v1"
+
+	"github.com/luci/gae/service/urlfetch"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/retry/transient"
+	"github.com/luci/luci-go/scheduler/appengine/messages"
+	"github.com/luci/luci-go/scheduler/appengine/task"
+	"github.com/luci/luci-go/scheduler/appengine/task/utils/tasktest"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidateProtoMessage(t *testing.T) {
+	tm := TaskManager{}
+
+	Convey("ValidateProtoMessage passes good msg", t, func() {
+		So(tm.ValidateProtoMessage(&messages.WebhookTask{
+			Url: "https://blah.com",
+		}), ShouldBeNil)
+	})
+
+	Convey("ValidateProtoMessage wrong type", t, func() {
+		So(tm.ValidateProtoMessage(&messages.NoopTask{}), ShouldErrLike, "wrong type")
+	})
+
+	Convey("ValidateProtoMessage empty", t, func() {
+		So(tm.ValidateProtoMessage(tm.ProtoMessageType()), ShouldErrLike, "expecting a non-empty WebhookTask")
+	})
+
+	Convey("ValidateProtoMessage no URL", t, func() {
+		So(tm.ValidateProtoMessage(&messages.WebhookTask{}), ShouldErrLike, "field 'url' is required")
+	})
+
+	Convey("ValidateProtoMessage non-absolute URL", t, func() {
+		So(tm.ValidateProtoMessage(&messages.WebhookTask{
+			Url: "/abc",
+		}), ShouldErrLike, "not an absolute url")
+	})
+
+	Convey("ValidateProtoMessage http URL", t, func() {
+		So(tm.ValidateProtoMessage(&messages.WebhookTask{
+			Url: "http://blah.com",
+		}), ShouldErrLike, "only https:// URLs are allowed")
+	})
+
+	Convey("ValidateProtoMessage bad timeout", t, func() {
+		So(tm.ValidateProtoMessage(&messages.WebhookTask{
+			Url:        "https://blah.com",
+			TimeoutSec: 10000,
+		}), ShouldErrLike, "maximum allowed 'timeout_sec' is 480 sec")
+	})
+
+	Convey("ValidateProtoMessage bad completion timeout", t, func() {
+		So(tm.ValidateProtoMessage(&messages.WebhookTask{
+			Url:                  "https://blah.com",
+			CompletionTimeoutSec: 8 * 24 * 3600,
+		}), ShouldErrLike, "maximum allowed 'completion_timeout_sec' is 7 days")
+	})
+}
+
+func TestFullFlow(t *testing.T) {
+	tm := TaskManager{}
+	c := context.Background()
+
+	Convey("With fake webhook", t, func() {
+		c := urlfetch.Set(c, http.DefaultTransport)
+		c, tc := testclock.UseTime(c, testclock.TestRecentTimeUTC)
+		tc.SetTimerCallback(func(d time.Duration, t clock.Timer) { tc.Add(d) })
+
+		var received Request
+		var authHeader string
+		var calls int
+		codes := []int{200}
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authHeader = r.Header.Get("Authorization")
+			json.NewDecoder(r.Body).Decode(&received)
+			code := codes[len(codes)-1]
+			if calls < len(codes) {
+				code = codes[calls]
+			}
+			calls++
+			w.WriteHeader(code)
+		}))
+		defer ts.Close()
+
+		ctl := &tasktest.TestController{
+			TaskMessage:      &messages.WebhookTask{Url: ts.URL},
+			CallbackURL:      "https://example.com/callback?token=abc",
+			OverrideJobID:    "proj/job",
+			OverrideInvID:    123,
+			IncomingTriggers: []task.Trigger{{ID: "trigger"}},
+		}
+
+		// The invocation must be saved as running before the callback URL is sent.
+		var saved []task.Status
+		ctl.SaveCallback = func() error {
+			So(calls, ShouldEqual, 0)
+			saved = append(saved, ctl.TaskState.Status)
+			return nil
+		}
+
+		callback := func(cb Callback) error {
+			blob, _ := json.Marshal(&cb)
+			return tm.HandleNotification(c, ctl, &pubsub.PubsubMessage{
+				Data: base64.StdEncoding.EncodeToString(blob),
+			})
+		}
+
+		Convey("Success", func() {
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(saved, ShouldResemble, []task.Status{task.StatusRunning})
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusRunning)
+			So(authHeader, ShouldEqual, "")
+			So(received, ShouldResemble, Request{
+				JobID:           "proj/job",
+				InvocationID:    123,
+				InvocationNonce: 2,
+				CallbackURL:     "https://example.com/callback?token=abc",
+				Triggers:        []task.Trigger{{ID: "trigger"}},
+			})
+			So(ctl.Timers, ShouldResemble, []tasktest.TimerSpec{
+				{Delay: 24 * time.Hour, Name: "completion-timeout"},
+			})
+
+			So(callback(Callback{
+				Status:  "SUCCEEDED",
+				ViewURL: "https://example.com/result",
+			}), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusSucceeded)
+			So(ctl.TaskState.ViewURL, ShouldEqual, "https://example.com/result")
+
+			// Late timer is ignored.
+			So(tm.HandleTimer(c, ctl, "completion-timeout", nil), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusSucceeded)
+		})
+
+		Convey("Webhook refuses", func() {
+			codes = []int{403}
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusFailed)
+			So(calls, ShouldEqual, 1)
+		})
+
+		Convey("Webhook fails transiently", func() {
+			codes = []int{503, 502, 200}
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusRunning)
+			So(calls, ShouldEqual, 3)
+		})
+
+		Convey("Webhook keeps failing", func() {
+			codes = []int{503}
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusFailed)
+			So(calls, ShouldEqual, 6)
+		})
+
+		Convey("Webhook is unreachable", func() {
+			ts.Close()
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusFailed)
+		})
+
+		Convey("Failed save is retried by the engine", func() {
+			ctl.SaveCallback = func() error { return errors.New("boom") }
+			err := tm.LaunchTask(c, ctl)
+			So(transient.Tag.In(err), ShouldBeTrue)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusStarting)
+			So(calls, ShouldEqual, 0)
+		})
+
+		Convey("Bad callback is ignored", func() {
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(callback(Callback{Status: "HUH"}), ShouldBeNil)
+			So(tm.HandleNotification(c, ctl, &pubsub.PubsubMessage{Data: "???"}), ShouldBeNil)
+			So(tm.HandleNotification(c, ctl, &pubsub.PubsubMessage{
+				Data: base64.StdEncoding.EncodeToString([]byte("not json")),
+			}), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusRunning)
+
+			// A good callback still works.
+			So(callback(Callback{Status: "FAILED"}), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusFailed)
+		})
+
+		Convey("Completion timeout", func() {
+			So(tm.LaunchTask(c, ctl), ShouldBeNil)
+			So(tm.HandleTimer(c, ctl, "completion-timeout", nil), ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusFailed)
+		})
+	})
+}
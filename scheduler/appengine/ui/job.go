@@ -13,6 +13,7 @@ import (
 
 	mc "github.com/luci/gae/service/memcache"
 	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/scheduler/appengine/engine"
 	"github.com/luci/luci-go/scheduler/appengine/presentation"
 	"github.com/luci/luci-go/server/auth"
 	"github.com/luci/luci-go/server/router"
@@ -42,6 +43,20 @@ func jobPage(ctx *router.Context) {
 		panic(err)
 	}
 
+	// Grab all currently running invocations. There can be many of them if the
+	// job allows concurrent invocations. Most recent first.
+	running := job.State.RunningInvocations()
+	runningInvs := make([]*engine.Invocation, 0, len(running))
+	for i := len(running) - 1; i >= 0; i-- {
+		inv, err := config(c).Engine.GetInvocation(c, job.JobID, running[i])
+		if err != nil {
+			panic(err)
+		}
+		if inv != nil {
+			runningInvs = append(runningInvs, inv)
+		}
+	}
+
 	// memcacheKey hashes cursor to reduce its length, since full cursor doesn't
 	// fit into memcache key length limits. Use 'v2' scheme for this ('v1' was
 	// used before hashing was added).
@@ -76,12 +91,17 @@ func jobPage(ctx *router.Context) {
 	for i, inv := range invs {
 		invsUI[i] = makeInvocation(jobUI, inv)
 	}
+	runningUI := make([]*invocation, len(runningInvs))
+	for i, inv := range runningInvs {
+		runningUI[i] = makeInvocation(jobUI, inv)
+	}
 
 	templates.MustRender(c, w, "pages/job.html", map[string]interface{}{
-		"Job":         jobUI,
-		"Invocations": invsUI,
-		"PrevCursor":  prevCursor,
-		"NextCursor":  nextCursor,
+		"Job":                jobUI,
+		"Invocations":        invsUI,
+		"RunningInvocations": runningUI,
+		"PrevCursor":         prevCursor,
+		"NextCursor":         nextCursor,
 	})
 }
 